@@ -22,8 +22,9 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_EpochInfos_FullMethodName   = "/aizel.epochs.v1.Query/EpochInfos"
-	Query_CurrentEpoch_FullMethodName = "/aizel.epochs.v1.Query/CurrentEpoch"
+	Query_EpochInfos_FullMethodName         = "/aizel.epochs.v1.Query/EpochInfos"
+	Query_CurrentEpoch_FullMethodName       = "/aizel.epochs.v1.Query/CurrentEpoch"
+	Query_EpochSubscriptions_FullMethodName = "/aizel.epochs.v1.Query/EpochSubscriptions"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +35,9 @@ type QueryClient interface {
 	EpochInfos(ctx context.Context, in *QueryEpochsInfoRequest, opts ...grpc.CallOption) (*QueryEpochsInfoResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error)
+	// EpochSubscriptions provide the contracts subscribed to the end of the
+	// specified epoch
+	EpochSubscriptions(ctx context.Context, in *QueryEpochSubscriptionsRequest, opts ...grpc.CallOption) (*QueryEpochSubscriptionsResponse, error)
 }
 
 type queryClient struct {
@@ -62,6 +66,15 @@ func (c *queryClient) CurrentEpoch(ctx context.Context, in *QueryCurrentEpochReq
 	return out, nil
 }
 
+func (c *queryClient) EpochSubscriptions(ctx context.Context, in *QueryEpochSubscriptionsRequest, opts ...grpc.CallOption) (*QueryEpochSubscriptionsResponse, error) {
+	out := new(QueryEpochSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, Query_EpochSubscriptions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -70,6 +83,9 @@ type QueryServer interface {
 	EpochInfos(context.Context, *QueryEpochsInfoRequest) (*QueryEpochsInfoResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	CurrentEpoch(context.Context, *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error)
+	// EpochSubscriptions provide the contracts subscribed to the end of the
+	// specified epoch
+	EpochSubscriptions(context.Context, *QueryEpochSubscriptionsRequest) (*QueryEpochSubscriptionsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -83,6 +99,9 @@ func (UnimplementedQueryServer) EpochInfos(context.Context, *QueryEpochsInfoRequ
 func (UnimplementedQueryServer) CurrentEpoch(context.Context, *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CurrentEpoch not implemented")
 }
+func (UnimplementedQueryServer) EpochSubscriptions(context.Context, *QueryEpochSubscriptionsRequest) (*QueryEpochSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochSubscriptions not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -132,6 +151,24 @@ func _Query_CurrentEpoch_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EpochSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EpochSubscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochSubscriptions(ctx, req.(*QueryEpochSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -147,6 +184,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CurrentEpoch",
 			Handler:    _Query_CurrentEpoch_Handler,
 		},
+		{
+			MethodName: "EpochSubscriptions",
+			Handler:    _Query_EpochSubscriptions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "aizel/epochs/v1/query.proto",
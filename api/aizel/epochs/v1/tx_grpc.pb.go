@@ -0,0 +1,245 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: aizel/epochs/v1/tx.proto
+
+package epochsv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Msg_CreateEpoch_FullMethodName      = "/aizel.epochs.v1.Msg/CreateEpoch"
+	Msg_DeleteEpoch_FullMethodName      = "/aizel.epochs.v1.Msg/DeleteEpoch"
+	Msg_SubscribeEpoch_FullMethodName   = "/aizel.epochs.v1.Msg/SubscribeEpoch"
+	Msg_UnsubscribeEpoch_FullMethodName = "/aizel.epochs.v1.Msg/UnsubscribeEpoch"
+)
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateEpoch defines a governance operation for registering a new epoch.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	CreateEpoch(ctx context.Context, in *MsgCreateEpoch, opts ...grpc.CallOption) (*MsgCreateEpochResponse, error)
+	// DeleteEpoch defines a governance operation for removing an epoch that is
+	// not used by any module.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	DeleteEpoch(ctx context.Context, in *MsgDeleteEpoch, opts ...grpc.CallOption) (*MsgDeleteEpochResponse, error)
+	// SubscribeEpoch defines a governance operation for subscribing an EVM
+	// contract to the end of an epoch.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	SubscribeEpoch(ctx context.Context, in *MsgSubscribeEpoch, opts ...grpc.CallOption) (*MsgSubscribeEpochResponse, error)
+	// UnsubscribeEpoch defines a governance operation for removing an EVM
+	// contract subscription to the end of an epoch.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UnsubscribeEpoch(ctx context.Context, in *MsgUnsubscribeEpoch, opts ...grpc.CallOption) (*MsgUnsubscribeEpochResponse, error)
+}
+
+type msgClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgClient(cc grpc.ClientConnInterface) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateEpoch(ctx context.Context, in *MsgCreateEpoch, opts ...grpc.CallOption) (*MsgCreateEpochResponse, error) {
+	out := new(MsgCreateEpochResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateEpoch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteEpoch(ctx context.Context, in *MsgDeleteEpoch, opts ...grpc.CallOption) (*MsgDeleteEpochResponse, error) {
+	out := new(MsgDeleteEpochResponse)
+	err := c.cc.Invoke(ctx, Msg_DeleteEpoch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubscribeEpoch(ctx context.Context, in *MsgSubscribeEpoch, opts ...grpc.CallOption) (*MsgSubscribeEpochResponse, error) {
+	out := new(MsgSubscribeEpochResponse)
+	err := c.cc.Invoke(ctx, Msg_SubscribeEpoch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnsubscribeEpoch(ctx context.Context, in *MsgUnsubscribeEpoch, opts ...grpc.CallOption) (*MsgUnsubscribeEpochResponse, error) {
+	out := new(MsgUnsubscribeEpochResponse)
+	err := c.cc.Invoke(ctx, Msg_UnsubscribeEpoch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+// All implementations must embed UnimplementedMsgServer
+// for forward compatibility
+type MsgServer interface {
+	// CreateEpoch defines a governance operation for registering a new epoch.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	CreateEpoch(context.Context, *MsgCreateEpoch) (*MsgCreateEpochResponse, error)
+	// DeleteEpoch defines a governance operation for removing an epoch that is
+	// not used by any module.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	DeleteEpoch(context.Context, *MsgDeleteEpoch) (*MsgDeleteEpochResponse, error)
+	// SubscribeEpoch defines a governance operation for subscribing an EVM
+	// contract to the end of an epoch.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	SubscribeEpoch(context.Context, *MsgSubscribeEpoch) (*MsgSubscribeEpochResponse, error)
+	// UnsubscribeEpoch defines a governance operation for removing an EVM
+	// contract subscription to the end of an epoch.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UnsubscribeEpoch(context.Context, *MsgUnsubscribeEpoch) (*MsgUnsubscribeEpochResponse, error)
+	mustEmbedUnimplementedMsgServer()
+}
+
+// UnimplementedMsgServer must be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (UnimplementedMsgServer) CreateEpoch(context.Context, *MsgCreateEpoch) (*MsgCreateEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEpoch not implemented")
+}
+func (UnimplementedMsgServer) DeleteEpoch(context.Context, *MsgDeleteEpoch) (*MsgDeleteEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEpoch not implemented")
+}
+func (UnimplementedMsgServer) SubscribeEpoch(context.Context, *MsgSubscribeEpoch) (*MsgSubscribeEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubscribeEpoch not implemented")
+}
+func (UnimplementedMsgServer) UnsubscribeEpoch(context.Context, *MsgUnsubscribeEpoch) (*MsgUnsubscribeEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsubscribeEpoch not implemented")
+}
+func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
+
+// UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgServer will
+// result in compilation errors.
+type UnsafeMsgServer interface {
+	mustEmbedUnimplementedMsgServer()
+}
+
+func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
+	s.RegisterService(&Msg_ServiceDesc, srv)
+}
+
+func _Msg_CreateEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateEpoch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateEpoch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateEpoch(ctx, req.(*MsgCreateEpoch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteEpoch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DeleteEpoch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteEpoch(ctx, req.(*MsgDeleteEpoch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubscribeEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubscribeEpoch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubscribeEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SubscribeEpoch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubscribeEpoch(ctx, req.(*MsgSubscribeEpoch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnsubscribeEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnsubscribeEpoch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnsubscribeEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UnsubscribeEpoch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnsubscribeEpoch(ctx, req.(*MsgUnsubscribeEpoch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Msg_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "aizel.epochs.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateEpoch",
+			Handler:    _Msg_CreateEpoch_Handler,
+		},
+		{
+			MethodName: "DeleteEpoch",
+			Handler:    _Msg_DeleteEpoch_Handler,
+		},
+		{
+			MethodName: "SubscribeEpoch",
+			Handler:    _Msg_SubscribeEpoch_Handler,
+		},
+		{
+			MethodName: "UnsubscribeEpoch",
+			Handler:    _Msg_UnsubscribeEpoch_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "aizel/epochs/v1/tx.proto",
+}
@@ -22,10 +22,15 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_ConvertERC20_FullMethodName     = "/aizel.erc20.v1.Msg/ConvertERC20"
-	Msg_UpdateParams_FullMethodName     = "/aizel.erc20.v1.Msg/UpdateParams"
-	Msg_RegisterERC20_FullMethodName    = "/aizel.erc20.v1.Msg/RegisterERC20"
-	Msg_ToggleConversion_FullMethodName = "/aizel.erc20.v1.Msg/ToggleConversion"
+	Msg_ConvertERC20_FullMethodName          = "/aizel.erc20.v1.Msg/ConvertERC20"
+	Msg_UpdateParams_FullMethodName          = "/aizel.erc20.v1.Msg/UpdateParams"
+	Msg_RegisterERC20_FullMethodName         = "/aizel.erc20.v1.Msg/RegisterERC20"
+	Msg_ToggleConversion_FullMethodName      = "/aizel.erc20.v1.Msg/ToggleConversion"
+	Msg_RegisterERC20ByOwner_FullMethodName  = "/aizel.erc20.v1.Msg/RegisterERC20ByOwner"
+	Msg_SyncTokenPairMetadata_FullMethodName = "/aizel.erc20.v1.Msg/SyncTokenPairMetadata"
+	Msg_UpdateDenomMetadata_FullMethodName   = "/aizel.erc20.v1.Msg/UpdateDenomMetadata"
+	Msg_UpdateRateLimit_FullMethodName       = "/aizel.erc20.v1.Msg/UpdateRateLimit"
+	Msg_ResumeConversion_FullMethodName      = "/aizel.erc20.v1.Msg/ResumeConversion"
 )
 
 // MsgClient is the client API for Msg service.
@@ -44,6 +49,24 @@ type MsgClient interface {
 	// ToggleConversion defines a governance operation for enabling/disablen a token pair conversion.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	ToggleConversion(ctx context.Context, in *MsgToggleConversion, opts ...grpc.CallOption) (*MsgToggleConversionResponse, error)
+	// RegisterERC20ByOwner defines a permissionless operation for registering a token pair
+	// for an erc20 contract owned by the sender, paying the registration fee.
+	RegisterERC20ByOwner(ctx context.Context, in *MsgRegisterERC20ByOwner, opts ...grpc.CallOption) (*MsgRegisterERC20ByOwnerResponse, error)
+	// SyncTokenPairMetadata defines an operation for refreshing the bank denom metadata of an
+	// externally owned token pair from the ERC20 contract. It can be executed either by the
+	// x/gov module account or by the owner of the ERC20 contract.
+	SyncTokenPairMetadata(ctx context.Context, in *MsgSyncTokenPairMetadata, opts ...grpc.CallOption) (*MsgSyncTokenPairMetadataResponse, error)
+	// UpdateDenomMetadata defines a governance operation for overriding the bank denom metadata
+	// of a registered token pair.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateDenomMetadata(ctx context.Context, in *MsgUpdateDenomMetadata, opts ...grpc.CallOption) (*MsgUpdateDenomMetadataResponse, error)
+	// UpdateRateLimit defines a governance operation for setting the conversion quotas of a token pair.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateRateLimit(ctx context.Context, in *MsgUpdateRateLimit, opts ...grpc.CallOption) (*MsgUpdateRateLimitResponse, error)
+	// ResumeConversion defines an operation for resuming the conversions of a token pair paused by
+	// its rate limit. It can be executed either by the x/gov module account or by the rate limit
+	// guardian.
+	ResumeConversion(ctx context.Context, in *MsgResumeConversion, opts ...grpc.CallOption) (*MsgResumeConversionResponse, error)
 }
 
 type msgClient struct {
@@ -90,6 +113,51 @@ func (c *msgClient) ToggleConversion(ctx context.Context, in *MsgToggleConversio
 	return out, nil
 }
 
+func (c *msgClient) RegisterERC20ByOwner(ctx context.Context, in *MsgRegisterERC20ByOwner, opts ...grpc.CallOption) (*MsgRegisterERC20ByOwnerResponse, error) {
+	out := new(MsgRegisterERC20ByOwnerResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterERC20ByOwner_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SyncTokenPairMetadata(ctx context.Context, in *MsgSyncTokenPairMetadata, opts ...grpc.CallOption) (*MsgSyncTokenPairMetadataResponse, error) {
+	out := new(MsgSyncTokenPairMetadataResponse)
+	err := c.cc.Invoke(ctx, Msg_SyncTokenPairMetadata_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateDenomMetadata(ctx context.Context, in *MsgUpdateDenomMetadata, opts ...grpc.CallOption) (*MsgUpdateDenomMetadataResponse, error) {
+	out := new(MsgUpdateDenomMetadataResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateDenomMetadata_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateRateLimit(ctx context.Context, in *MsgUpdateRateLimit, opts ...grpc.CallOption) (*MsgUpdateRateLimitResponse, error) {
+	out := new(MsgUpdateRateLimitResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateRateLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResumeConversion(ctx context.Context, in *MsgResumeConversion, opts ...grpc.CallOption) (*MsgResumeConversionResponse, error) {
+	out := new(MsgResumeConversionResponse)
+	err := c.cc.Invoke(ctx, Msg_ResumeConversion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -106,6 +174,24 @@ type MsgServer interface {
 	// ToggleConversion defines a governance operation for enabling/disablen a token pair conversion.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	ToggleConversion(context.Context, *MsgToggleConversion) (*MsgToggleConversionResponse, error)
+	// RegisterERC20ByOwner defines a permissionless operation for registering a token pair
+	// for an erc20 contract owned by the sender, paying the registration fee.
+	RegisterERC20ByOwner(context.Context, *MsgRegisterERC20ByOwner) (*MsgRegisterERC20ByOwnerResponse, error)
+	// SyncTokenPairMetadata defines an operation for refreshing the bank denom metadata of an
+	// externally owned token pair from the ERC20 contract. It can be executed either by the
+	// x/gov module account or by the owner of the ERC20 contract.
+	SyncTokenPairMetadata(context.Context, *MsgSyncTokenPairMetadata) (*MsgSyncTokenPairMetadataResponse, error)
+	// UpdateDenomMetadata defines a governance operation for overriding the bank denom metadata
+	// of a registered token pair.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateDenomMetadata(context.Context, *MsgUpdateDenomMetadata) (*MsgUpdateDenomMetadataResponse, error)
+	// UpdateRateLimit defines a governance operation for setting the conversion quotas of a token pair.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateRateLimit(context.Context, *MsgUpdateRateLimit) (*MsgUpdateRateLimitResponse, error)
+	// ResumeConversion defines an operation for resuming the conversions of a token pair paused by
+	// its rate limit. It can be executed either by the x/gov module account or by the rate limit
+	// guardian.
+	ResumeConversion(context.Context, *MsgResumeConversion) (*MsgResumeConversionResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -125,6 +211,21 @@ func (UnimplementedMsgServer) RegisterERC20(context.Context, *MsgRegisterERC20)
 func (UnimplementedMsgServer) ToggleConversion(context.Context, *MsgToggleConversion) (*MsgToggleConversionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ToggleConversion not implemented")
 }
+func (UnimplementedMsgServer) RegisterERC20ByOwner(context.Context, *MsgRegisterERC20ByOwner) (*MsgRegisterERC20ByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterERC20ByOwner not implemented")
+}
+func (UnimplementedMsgServer) SyncTokenPairMetadata(context.Context, *MsgSyncTokenPairMetadata) (*MsgSyncTokenPairMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncTokenPairMetadata not implemented")
+}
+func (UnimplementedMsgServer) UpdateDenomMetadata(context.Context, *MsgUpdateDenomMetadata) (*MsgUpdateDenomMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDenomMetadata not implemented")
+}
+func (UnimplementedMsgServer) UpdateRateLimit(context.Context, *MsgUpdateRateLimit) (*MsgUpdateRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRateLimit not implemented")
+}
+func (UnimplementedMsgServer) ResumeConversion(context.Context, *MsgResumeConversion) (*MsgResumeConversionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeConversion not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -210,6 +311,96 @@ func _Msg_ToggleConversion_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RegisterERC20ByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterERC20ByOwner)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterERC20ByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterERC20ByOwner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterERC20ByOwner(ctx, req.(*MsgRegisterERC20ByOwner))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SyncTokenPairMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSyncTokenPairMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SyncTokenPairMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SyncTokenPairMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SyncTokenPairMetadata(ctx, req.(*MsgSyncTokenPairMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateDenomMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDenomMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDenomMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateDenomMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDenomMetadata(ctx, req.(*MsgUpdateDenomMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateRateLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateRateLimit(ctx, req.(*MsgUpdateRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResumeConversion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResumeConversion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResumeConversion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ResumeConversion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResumeConversion(ctx, req.(*MsgResumeConversion))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -233,6 +424,26 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ToggleConversion",
 			Handler:    _Msg_ToggleConversion_Handler,
 		},
+		{
+			MethodName: "RegisterERC20ByOwner",
+			Handler:    _Msg_RegisterERC20ByOwner_Handler,
+		},
+		{
+			MethodName: "SyncTokenPairMetadata",
+			Handler:    _Msg_SyncTokenPairMetadata_Handler,
+		},
+		{
+			MethodName: "UpdateDenomMetadata",
+			Handler:    _Msg_UpdateDenomMetadata_Handler,
+		},
+		{
+			MethodName: "UpdateRateLimit",
+			Handler:    _Msg_UpdateRateLimit_Handler,
+		},
+		{
+			MethodName: "ResumeConversion",
+			Handler:    _Msg_ResumeConversion_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "aizel/erc20/v1/tx.proto",
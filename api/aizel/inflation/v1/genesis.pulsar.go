@@ -21,6 +21,7 @@ var (
 	fd_GenesisState_epoch_identifier  protoreflect.FieldDescriptor
 	fd_GenesisState_epochs_per_period protoreflect.FieldDescriptor
 	fd_GenesisState_skipped_epochs    protoreflect.FieldDescriptor
+	fd_GenesisState_inflation_rate    protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -31,6 +32,7 @@ func init() {
 	fd_GenesisState_epoch_identifier = md_GenesisState.Fields().ByName("epoch_identifier")
 	fd_GenesisState_epochs_per_period = md_GenesisState.Fields().ByName("epochs_per_period")
 	fd_GenesisState_skipped_epochs = md_GenesisState.Fields().ByName("skipped_epochs")
+	fd_GenesisState_inflation_rate = md_GenesisState.Fields().ByName("inflation_rate")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -128,6 +130,12 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.InflationRate != "" {
+		value := protoreflect.ValueOfString(x.InflationRate)
+		if !f(fd_GenesisState_inflation_rate, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -153,6 +161,8 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return x.EpochsPerPeriod != int64(0)
 	case "aizel.inflation.v1.GenesisState.skipped_epochs":
 		return x.SkippedEpochs != uint64(0)
+	case "aizel.inflation.v1.GenesisState.inflation_rate":
+		return x.InflationRate != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.GenesisState"))
@@ -179,6 +189,8 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.EpochsPerPeriod = int64(0)
 	case "aizel.inflation.v1.GenesisState.skipped_epochs":
 		x.SkippedEpochs = uint64(0)
+	case "aizel.inflation.v1.GenesisState.inflation_rate":
+		x.InflationRate = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.GenesisState"))
@@ -210,6 +222,9 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "aizel.inflation.v1.GenesisState.skipped_epochs":
 		value := x.SkippedEpochs
 		return protoreflect.ValueOfUint64(value)
+	case "aizel.inflation.v1.GenesisState.inflation_rate":
+		value := x.InflationRate
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.GenesisState"))
@@ -240,6 +255,8 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		x.EpochsPerPeriod = value.Int()
 	case "aizel.inflation.v1.GenesisState.skipped_epochs":
 		x.SkippedEpochs = value.Uint()
+	case "aizel.inflation.v1.GenesisState.inflation_rate":
+		x.InflationRate = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.GenesisState"))
@@ -273,6 +290,8 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 		panic(fmt.Errorf("field epochs_per_period of message aizel.inflation.v1.GenesisState is not mutable"))
 	case "aizel.inflation.v1.GenesisState.skipped_epochs":
 		panic(fmt.Errorf("field skipped_epochs of message aizel.inflation.v1.GenesisState is not mutable"))
+	case "aizel.inflation.v1.GenesisState.inflation_rate":
+		panic(fmt.Errorf("field inflation_rate of message aizel.inflation.v1.GenesisState is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.GenesisState"))
@@ -297,6 +316,8 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfInt64(int64(0))
 	case "aizel.inflation.v1.GenesisState.skipped_epochs":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "aizel.inflation.v1.GenesisState.inflation_rate":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.GenesisState"))
@@ -383,6 +404,10 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 		if x.SkippedEpochs != 0 {
 			n += 1 + runtime.Sov(uint64(x.SkippedEpochs))
 		}
+		l = len(x.InflationRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -412,6 +437,13 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.InflationRate) > 0 {
+			i -= len(x.InflationRate)
+			copy(dAtA[i:], x.InflationRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InflationRate)))
+			i--
+			dAtA[i] = 0x32
+		}
 		if x.SkippedEpochs != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.SkippedEpochs))
 			i--
@@ -622,6 +654,38 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InflationRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InflationRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -658,11 +722,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_Params                         protoreflect.MessageDescriptor
-	fd_Params_mint_denom              protoreflect.FieldDescriptor
-	fd_Params_exponential_calculation protoreflect.FieldDescriptor
-	fd_Params_inflation_distribution  protoreflect.FieldDescriptor
-	fd_Params_enable_inflation        protoreflect.FieldDescriptor
+	md_Params                           protoreflect.MessageDescriptor
+	fd_Params_mint_denom                protoreflect.FieldDescriptor
+	fd_Params_exponential_calculation   protoreflect.FieldDescriptor
+	fd_Params_inflation_distribution    protoreflect.FieldDescriptor
+	fd_Params_enable_inflation          protoreflect.FieldDescriptor
+	fd_Params_inflation_model           protoreflect.FieldDescriptor
+	fd_Params_halving_calculation       protoreflect.FieldDescriptor
+	fd_Params_target_bonded_calculation protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -672,6 +739,9 @@ func init() {
 	fd_Params_exponential_calculation = md_Params.Fields().ByName("exponential_calculation")
 	fd_Params_inflation_distribution = md_Params.Fields().ByName("inflation_distribution")
 	fd_Params_enable_inflation = md_Params.Fields().ByName("enable_inflation")
+	fd_Params_inflation_model = md_Params.Fields().ByName("inflation_model")
+	fd_Params_halving_calculation = md_Params.Fields().ByName("halving_calculation")
+	fd_Params_target_bonded_calculation = md_Params.Fields().ByName("target_bonded_calculation")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -763,6 +833,24 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.InflationModel != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.InflationModel))
+		if !f(fd_Params_inflation_model, value) {
+			return
+		}
+	}
+	if x.HalvingCalculation != nil {
+		value := protoreflect.ValueOfMessage(x.HalvingCalculation.ProtoReflect())
+		if !f(fd_Params_halving_calculation, value) {
+			return
+		}
+	}
+	if x.TargetBondedCalculation != nil {
+		value := protoreflect.ValueOfMessage(x.TargetBondedCalculation.ProtoReflect())
+		if !f(fd_Params_target_bonded_calculation, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -786,6 +874,12 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.InflationDistribution != nil
 	case "aizel.inflation.v1.Params.enable_inflation":
 		return x.EnableInflation != false
+	case "aizel.inflation.v1.Params.inflation_model":
+		return x.InflationModel != 0
+	case "aizel.inflation.v1.Params.halving_calculation":
+		return x.HalvingCalculation != nil
+	case "aizel.inflation.v1.Params.target_bonded_calculation":
+		return x.TargetBondedCalculation != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.Params"))
@@ -810,6 +904,12 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.InflationDistribution = nil
 	case "aizel.inflation.v1.Params.enable_inflation":
 		x.EnableInflation = false
+	case "aizel.inflation.v1.Params.inflation_model":
+		x.InflationModel = 0
+	case "aizel.inflation.v1.Params.halving_calculation":
+		x.HalvingCalculation = nil
+	case "aizel.inflation.v1.Params.target_bonded_calculation":
+		x.TargetBondedCalculation = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.Params"))
@@ -838,6 +938,15 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "aizel.inflation.v1.Params.enable_inflation":
 		value := x.EnableInflation
 		return protoreflect.ValueOfBool(value)
+	case "aizel.inflation.v1.Params.inflation_model":
+		value := x.InflationModel
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "aizel.inflation.v1.Params.halving_calculation":
+		value := x.HalvingCalculation
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "aizel.inflation.v1.Params.target_bonded_calculation":
+		value := x.TargetBondedCalculation
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.Params"))
@@ -866,6 +975,12 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.InflationDistribution = value.Message().Interface().(*InflationDistribution)
 	case "aizel.inflation.v1.Params.enable_inflation":
 		x.EnableInflation = value.Bool()
+	case "aizel.inflation.v1.Params.inflation_model":
+		x.InflationModel = (InflationModel)(value.Enum())
+	case "aizel.inflation.v1.Params.halving_calculation":
+		x.HalvingCalculation = value.Message().Interface().(*HalvingCalculation)
+	case "aizel.inflation.v1.Params.target_bonded_calculation":
+		x.TargetBondedCalculation = value.Message().Interface().(*TargetBondedCalculation)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.Params"))
@@ -896,10 +1011,22 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 			x.InflationDistribution = new(InflationDistribution)
 		}
 		return protoreflect.ValueOfMessage(x.InflationDistribution.ProtoReflect())
+	case "aizel.inflation.v1.Params.halving_calculation":
+		if x.HalvingCalculation == nil {
+			x.HalvingCalculation = new(HalvingCalculation)
+		}
+		return protoreflect.ValueOfMessage(x.HalvingCalculation.ProtoReflect())
+	case "aizel.inflation.v1.Params.target_bonded_calculation":
+		if x.TargetBondedCalculation == nil {
+			x.TargetBondedCalculation = new(TargetBondedCalculation)
+		}
+		return protoreflect.ValueOfMessage(x.TargetBondedCalculation.ProtoReflect())
 	case "aizel.inflation.v1.Params.mint_denom":
 		panic(fmt.Errorf("field mint_denom of message aizel.inflation.v1.Params is not mutable"))
 	case "aizel.inflation.v1.Params.enable_inflation":
 		panic(fmt.Errorf("field enable_inflation of message aizel.inflation.v1.Params is not mutable"))
+	case "aizel.inflation.v1.Params.inflation_model":
+		panic(fmt.Errorf("field inflation_model of message aizel.inflation.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.Params"))
@@ -923,6 +1050,14 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	case "aizel.inflation.v1.Params.enable_inflation":
 		return protoreflect.ValueOfBool(false)
+	case "aizel.inflation.v1.Params.inflation_model":
+		return protoreflect.ValueOfEnum(0)
+	case "aizel.inflation.v1.Params.halving_calculation":
+		m := new(HalvingCalculation)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "aizel.inflation.v1.Params.target_bonded_calculation":
+		m := new(TargetBondedCalculation)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.Params"))
@@ -1007,6 +1142,17 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.EnableInflation {
 			n += 2
 		}
+		if x.InflationModel != 0 {
+			n += 1 + runtime.Sov(uint64(x.InflationModel))
+		}
+		if x.HalvingCalculation != nil {
+			l = options.Size(x.HalvingCalculation)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TargetBondedCalculation != nil {
+			l = options.Size(x.TargetBondedCalculation)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1036,6 +1182,39 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.TargetBondedCalculation != nil {
+			encoded, err := options.Marshal(x.TargetBondedCalculation)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.HalvingCalculation != nil {
+			encoded, err := options.Marshal(x.HalvingCalculation)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.InflationModel != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InflationModel))
+			i--
+			dAtA[i] = 0x28
+		}
 		if x.EnableInflation {
 			i--
 			if x.EnableInflation {
@@ -1254,6 +1433,97 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.EnableInflation = bool(v != 0)
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InflationModel", wireType)
+				}
+				x.InflationModel = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InflationModel |= InflationModel(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HalvingCalculation", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.HalvingCalculation == nil {
+					x.HalvingCalculation = &HalvingCalculation{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.HalvingCalculation); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetBondedCalculation", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TargetBondedCalculation == nil {
+					x.TargetBondedCalculation = &TargetBondedCalculation{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TargetBondedCalculation); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1321,6 +1591,9 @@ type GenesisState struct {
 	EpochsPerPeriod int64 `protobuf:"varint,4,opt,name=epochs_per_period,json=epochsPerPeriod,proto3" json:"epochs_per_period,omitempty"`
 	// skipped_epochs is the number of epochs that have passed while inflation is disabled
 	SkippedEpochs uint64 `protobuf:"varint,5,opt,name=skipped_epochs,json=skippedEpochs,proto3" json:"skipped_epochs,omitempty"`
+	// inflation_rate is the current yearly inflation rate of the target bonded
+	// inflation model
+	InflationRate string `protobuf:"bytes,6,opt,name=inflation_rate,json=inflationRate,proto3" json:"inflation_rate,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -1378,6 +1651,13 @@ func (x *GenesisState) GetSkippedEpochs() uint64 {
 	return 0
 }
 
+func (x *GenesisState) GetInflationRate() string {
+	if x != nil {
+		return x.InflationRate
+	}
+	return ""
+}
+
 // Params holds parameters for the inflation module.
 type Params struct {
 	state         protoimpl.MessageState
@@ -1392,6 +1672,13 @@ type Params struct {
 	InflationDistribution *InflationDistribution `protobuf:"bytes,3,opt,name=inflation_distribution,json=inflationDistribution,proto3" json:"inflation_distribution,omitempty"`
 	// enable_inflation is the parameter that enables inflation and halts increasing the skipped_epochs
 	EnableInflation bool `protobuf:"varint,4,opt,name=enable_inflation,json=enableInflation,proto3" json:"enable_inflation,omitempty"`
+	// inflation_model defines the curve used to calculate the inflation
+	InflationModel InflationModel `protobuf:"varint,5,opt,name=inflation_model,json=inflationModel,proto3,enum=aizel.inflation.v1.InflationModel" json:"inflation_model,omitempty"`
+	// halving_calculation takes in the variables to calculate the halving inflation
+	HalvingCalculation *HalvingCalculation `protobuf:"bytes,6,opt,name=halving_calculation,json=halvingCalculation,proto3" json:"halving_calculation,omitempty"`
+	// target_bonded_calculation takes in the variables to calculate the target
+	// bonded inflation
+	TargetBondedCalculation *TargetBondedCalculation `protobuf:"bytes,7,opt,name=target_bonded_calculation,json=targetBondedCalculation,proto3" json:"target_bonded_calculation,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -1442,6 +1729,27 @@ func (x *Params) GetEnableInflation() bool {
 	return false
 }
 
+func (x *Params) GetInflationModel() InflationModel {
+	if x != nil {
+		return x.InflationModel
+	}
+	return InflationModel_INFLATION_MODEL_EXPONENTIAL
+}
+
+func (x *Params) GetHalvingCalculation() *HalvingCalculation {
+	if x != nil {
+		return x.HalvingCalculation
+	}
+	return nil
+}
+
+func (x *Params) GetTargetBondedCalculation() *TargetBondedCalculation {
+	if x != nil {
+		return x.TargetBondedCalculation
+	}
+	return nil
+}
+
 var File_aizel_inflation_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_aizel_inflation_v1_genesis_proto_rawDesc = []byte{
@@ -1453,7 +1761,7 @@ var file_aizel_inflation_v1_genesis_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x22, 0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 	0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0xe3, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53,
+	0x6f, 0x74, 0x6f, 0x22, 0xb4, 0x02, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53,
 	0x74, 0x61, 0x74, 0x65, 0x12, 0x3d, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2e, 0x69, 0x6e, 0x66,
 	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
@@ -1467,39 +1775,62 @@ var file_aizel_inflation_v1_genesis_proto_rawDesc = []byte{
 	0x03, 0x52, 0x0f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x50, 0x65, 0x72, 0x50, 0x65, 0x72, 0x69,
 	0x6f, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x65, 0x70,
 	0x6f, 0x63, 0x68, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x73, 0x6b, 0x69, 0x70,
-	0x70, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x22, 0xaf, 0x02, 0x0a, 0x06, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x6e,
-	0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x74, 0x44, 0x65,
-	0x6e, 0x6f, 0x6d, 0x12, 0x6e, 0x0a, 0x17, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x69,
-	0x61, 0x6c, 0x5f, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2e, 0x69, 0x6e, 0x66,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x6e, 0x65,
-	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x16, 0x65, 0x78, 0x70,
-	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x6b, 0x0a, 0x16, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2e, 0x69, 0x6e, 0x66, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09,
-	0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x15, 0x69, 0x6e, 0x66, 0x6c, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x29, 0x0a, 0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6c, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x65, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0xc1, 0x01, 0x0a, 0x16,
-	0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
-	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2f, 0x69,
-	0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x69, 0x6e, 0x66, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x49, 0x58, 0xaa, 0x02, 0x12,
-	0x45, 0x76, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x56, 0x31, 0xca, 0x02, 0x12, 0x45, 0x76, 0x6d, 0x6f, 0x73, 0x5c, 0x49, 0x6e, 0x66, 0x6c, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1e, 0x45, 0x76, 0x6d, 0x6f, 0x73, 0x5c,
-	0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x45, 0x76, 0x6d, 0x6f, 0x73,
-	0x3a, 0x3a, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x12, 0x4f, 0x0a, 0x0e, 0x69, 0x6e, 0x66,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67,
+	0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x69, 0x6e, 0x66,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x22, 0xd4, 0x04, 0x0a, 0x06, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x64, 0x65,
+	0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x74, 0x44,
+	0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x6e, 0x0a, 0x17, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x5f, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2e, 0x69, 0x6e,
+	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x16, 0x65, 0x78,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6b, 0x0a, 0x16, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2e, 0x69, 0x6e, 0x66,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x15, 0x69, 0x6e, 0x66, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4b, 0x0a, 0x0f,
+	0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2e, 0x69, 0x6e,
+	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x66, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x0e, 0x69, 0x6e, 0x66, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x62, 0x0a, 0x13, 0x68, 0x61, 0x6c,
+	0x76, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2e, 0x69,
+	0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x6c, 0x76,
+	0x69, 0x6e, 0x67, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09,
+	0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x12, 0x68, 0x61, 0x6c, 0x76, 0x69,
+	0x6e, 0x67, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x72, 0x0a,
+	0x19, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x63,
+	0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2b, 0x2e, 0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x42, 0x6f, 0x6e, 0x64,
+	0x65, 0x64, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0xc8,
+	0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x17, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x42, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0xc1, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2e,
+	0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65,
+	0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65,
+	0x76, 0x6d, 0x6f, 0x73, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76,
+	0x31, 0x3b, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x76, 0x31, 0xa2, 0x02, 0x03,
+	0x45, 0x49, 0x58, 0xaa, 0x02, 0x12, 0x45, 0x76, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x66, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x12, 0x45, 0x76, 0x6d, 0x6f, 0x73,
+	0x5c, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1e,
+	0x45, 0x76, 0x6d, 0x6f, 0x73, 0x5c, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5c,
+	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x14, 0x45, 0x76, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1516,20 +1847,26 @@ func file_aizel_inflation_v1_genesis_proto_rawDescGZIP() []byte {
 
 var file_aizel_inflation_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_aizel_inflation_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil),           // 0: aizel.inflation.v1.GenesisState
-	(*Params)(nil),                 // 1: aizel.inflation.v1.Params
-	(*ExponentialCalculation)(nil), // 2: aizel.inflation.v1.ExponentialCalculation
-	(*InflationDistribution)(nil),  // 3: aizel.inflation.v1.InflationDistribution
+	(*GenesisState)(nil),            // 0: aizel.inflation.v1.GenesisState
+	(*Params)(nil),                  // 1: aizel.inflation.v1.Params
+	(*ExponentialCalculation)(nil),  // 2: aizel.inflation.v1.ExponentialCalculation
+	(*InflationDistribution)(nil),   // 3: aizel.inflation.v1.InflationDistribution
+	(InflationModel)(0),             // 4: aizel.inflation.v1.InflationModel
+	(*HalvingCalculation)(nil),      // 5: aizel.inflation.v1.HalvingCalculation
+	(*TargetBondedCalculation)(nil), // 6: aizel.inflation.v1.TargetBondedCalculation
 }
 var file_aizel_inflation_v1_genesis_proto_depIdxs = []int32{
 	1, // 0: aizel.inflation.v1.GenesisState.params:type_name -> aizel.inflation.v1.Params
 	2, // 1: aizel.inflation.v1.Params.exponential_calculation:type_name -> aizel.inflation.v1.ExponentialCalculation
 	3, // 2: aizel.inflation.v1.Params.inflation_distribution:type_name -> aizel.inflation.v1.InflationDistribution
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	4, // 3: aizel.inflation.v1.Params.inflation_model:type_name -> aizel.inflation.v1.InflationModel
+	5, // 4: aizel.inflation.v1.Params.halving_calculation:type_name -> aizel.inflation.v1.HalvingCalculation
+	6, // 5: aizel.inflation.v1.Params.target_bonded_calculation:type_name -> aizel.inflation.v1.TargetBondedCalculation
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_aizel_inflation_v1_genesis_proto_init() }
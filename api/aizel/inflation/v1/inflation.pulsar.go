@@ -14,19 +14,70 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_InflationDistribution_4_list)(nil)
+
+type _InflationDistribution_4_list struct {
+	list *[]*DistributionTarget
+}
+
+func (x *_InflationDistribution_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_InflationDistribution_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_InflationDistribution_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DistributionTarget)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_InflationDistribution_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DistributionTarget)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_InflationDistribution_4_list) AppendMutable() protoreflect.Value {
+	v := new(DistributionTarget)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_InflationDistribution_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_InflationDistribution_4_list) NewElement() protoreflect.Value {
+	v := new(DistributionTarget)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_InflationDistribution_4_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_InflationDistribution                  protoreflect.MessageDescriptor
-	fd_InflationDistribution_staking_rewards  protoreflect.FieldDescriptor
-	fd_InflationDistribution_usage_incentives protoreflect.FieldDescriptor
-	fd_InflationDistribution_community_pool   protoreflect.FieldDescriptor
+	md_InflationDistribution                 protoreflect.MessageDescriptor
+	fd_InflationDistribution_staking_rewards protoreflect.FieldDescriptor
+	fd_InflationDistribution_community_pool  protoreflect.FieldDescriptor
+	fd_InflationDistribution_targets         protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_aizel_inflation_v1_inflation_proto_init()
 	md_InflationDistribution = File_aizel_inflation_v1_inflation_proto.Messages().ByName("InflationDistribution")
 	fd_InflationDistribution_staking_rewards = md_InflationDistribution.Fields().ByName("staking_rewards")
-	fd_InflationDistribution_usage_incentives = md_InflationDistribution.Fields().ByName("usage_incentives")
 	fd_InflationDistribution_community_pool = md_InflationDistribution.Fields().ByName("community_pool")
+	fd_InflationDistribution_targets = md_InflationDistribution.Fields().ByName("targets")
 }
 
 var _ protoreflect.Message = (*fastReflection_InflationDistribution)(nil)
@@ -100,18 +151,18 @@ func (x *fastReflection_InflationDistribution) Range(f func(protoreflect.FieldDe
 			return
 		}
 	}
-	if x.UsageIncentives != "" {
-		value := protoreflect.ValueOfString(x.UsageIncentives)
-		if !f(fd_InflationDistribution_usage_incentives, value) {
-			return
-		}
-	}
 	if x.CommunityPool != "" {
 		value := protoreflect.ValueOfString(x.CommunityPool)
 		if !f(fd_InflationDistribution_community_pool, value) {
 			return
 		}
 	}
+	if len(x.Targets) != 0 {
+		value := protoreflect.ValueOfList(&_InflationDistribution_4_list{list: &x.Targets})
+		if !f(fd_InflationDistribution_targets, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -129,10 +180,10 @@ func (x *fastReflection_InflationDistribution) Has(fd protoreflect.FieldDescript
 	switch fd.FullName() {
 	case "aizel.inflation.v1.InflationDistribution.staking_rewards":
 		return x.StakingRewards != ""
-	case "aizel.inflation.v1.InflationDistribution.usage_incentives":
-		return x.UsageIncentives != ""
 	case "aizel.inflation.v1.InflationDistribution.community_pool":
 		return x.CommunityPool != ""
+	case "aizel.inflation.v1.InflationDistribution.targets":
+		return len(x.Targets) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.InflationDistribution"))
@@ -151,10 +202,10 @@ func (x *fastReflection_InflationDistribution) Clear(fd protoreflect.FieldDescri
 	switch fd.FullName() {
 	case "aizel.inflation.v1.InflationDistribution.staking_rewards":
 		x.StakingRewards = ""
-	case "aizel.inflation.v1.InflationDistribution.usage_incentives":
-		x.UsageIncentives = ""
 	case "aizel.inflation.v1.InflationDistribution.community_pool":
 		x.CommunityPool = ""
+	case "aizel.inflation.v1.InflationDistribution.targets":
+		x.Targets = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.InflationDistribution"))
@@ -174,12 +225,15 @@ func (x *fastReflection_InflationDistribution) Get(descriptor protoreflect.Field
 	case "aizel.inflation.v1.InflationDistribution.staking_rewards":
 		value := x.StakingRewards
 		return protoreflect.ValueOfString(value)
-	case "aizel.inflation.v1.InflationDistribution.usage_incentives":
-		value := x.UsageIncentives
-		return protoreflect.ValueOfString(value)
 	case "aizel.inflation.v1.InflationDistribution.community_pool":
 		value := x.CommunityPool
 		return protoreflect.ValueOfString(value)
+	case "aizel.inflation.v1.InflationDistribution.targets":
+		if len(x.Targets) == 0 {
+			return protoreflect.ValueOfList(&_InflationDistribution_4_list{})
+		}
+		listValue := &_InflationDistribution_4_list{list: &x.Targets}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.InflationDistribution"))
@@ -202,10 +256,12 @@ func (x *fastReflection_InflationDistribution) Set(fd protoreflect.FieldDescript
 	switch fd.FullName() {
 	case "aizel.inflation.v1.InflationDistribution.staking_rewards":
 		x.StakingRewards = value.Interface().(string)
-	case "aizel.inflation.v1.InflationDistribution.usage_incentives":
-		x.UsageIncentives = value.Interface().(string)
 	case "aizel.inflation.v1.InflationDistribution.community_pool":
 		x.CommunityPool = value.Interface().(string)
+	case "aizel.inflation.v1.InflationDistribution.targets":
+		lv := value.List()
+		clv := lv.(*_InflationDistribution_4_list)
+		x.Targets = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.InflationDistribution"))
@@ -226,10 +282,14 @@ func (x *fastReflection_InflationDistribution) Set(fd protoreflect.FieldDescript
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_InflationDistribution) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "aizel.inflation.v1.InflationDistribution.targets":
+		if x.Targets == nil {
+			x.Targets = []*DistributionTarget{}
+		}
+		value := &_InflationDistribution_4_list{list: &x.Targets}
+		return protoreflect.ValueOfList(value)
 	case "aizel.inflation.v1.InflationDistribution.staking_rewards":
 		panic(fmt.Errorf("field staking_rewards of message aizel.inflation.v1.InflationDistribution is not mutable"))
-	case "aizel.inflation.v1.InflationDistribution.usage_incentives":
-		panic(fmt.Errorf("field usage_incentives of message aizel.inflation.v1.InflationDistribution is not mutable"))
 	case "aizel.inflation.v1.InflationDistribution.community_pool":
 		panic(fmt.Errorf("field community_pool of message aizel.inflation.v1.InflationDistribution is not mutable"))
 	default:
@@ -247,10 +307,11 @@ func (x *fastReflection_InflationDistribution) NewField(fd protoreflect.FieldDes
 	switch fd.FullName() {
 	case "aizel.inflation.v1.InflationDistribution.staking_rewards":
 		return protoreflect.ValueOfString("")
-	case "aizel.inflation.v1.InflationDistribution.usage_incentives":
-		return protoreflect.ValueOfString("")
 	case "aizel.inflation.v1.InflationDistribution.community_pool":
 		return protoreflect.ValueOfString("")
+	case "aizel.inflation.v1.InflationDistribution.targets":
+		list := []*DistributionTarget{}
+		return protoreflect.ValueOfList(&_InflationDistribution_4_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.InflationDistribution"))
@@ -324,14 +385,16 @@ func (x *fastReflection_InflationDistribution) ProtoMethods() *protoiface.Method
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.UsageIncentives)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		l = len(x.CommunityPool)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.Targets) > 0 {
+			for _, e := range x.Targets {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -361,6 +424,22 @@ func (x *fastReflection_InflationDistribution) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Targets) > 0 {
+			for iNdEx := len(x.Targets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Targets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
 		if len(x.CommunityPool) > 0 {
 			i -= len(x.CommunityPool)
 			copy(dAtA[i:], x.CommunityPool)
@@ -368,13 +447,6 @@ func (x *fastReflection_InflationDistribution) ProtoMethods() *protoiface.Method
 			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.UsageIncentives) > 0 {
-			i -= len(x.UsageIncentives)
-			copy(dAtA[i:], x.UsageIncentives)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.UsageIncentives)))
-			i--
-			dAtA[i] = 0x12
-		}
 		if len(x.StakingRewards) > 0 {
 			i -= len(x.StakingRewards)
 			copy(dAtA[i:], x.StakingRewards)
@@ -463,9 +535,9 @@ func (x *fastReflection_InflationDistribution) ProtoMethods() *protoiface.Method
 				}
 				x.StakingRewards = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 2:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UsageIncentives", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -493,13 +565,13 @@ func (x *fastReflection_InflationDistribution) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.UsageIncentives = string(dAtA[iNdEx:postIndex])
+				x.CommunityPool = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
+			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Targets", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -509,23 +581,25 @@ func (x *fastReflection_InflationDistribution) ProtoMethods() *protoiface.Method
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.CommunityPool = string(dAtA[iNdEx:postIndex])
+				x.Targets = append(x.Targets, &DistributionTarget{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Targets[len(x.Targets)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -563,33 +637,29 @@ func (x *fastReflection_InflationDistribution) ProtoMethods() *protoiface.Method
 }
 
 var (
-	md_ExponentialCalculation                protoreflect.MessageDescriptor
-	fd_ExponentialCalculation_a              protoreflect.FieldDescriptor
-	fd_ExponentialCalculation_r              protoreflect.FieldDescriptor
-	fd_ExponentialCalculation_c              protoreflect.FieldDescriptor
-	fd_ExponentialCalculation_bonding_target protoreflect.FieldDescriptor
-	fd_ExponentialCalculation_max_variance   protoreflect.FieldDescriptor
+	md_DistributionTarget             protoreflect.MessageDescriptor
+	fd_DistributionTarget_target_type protoreflect.FieldDescriptor
+	fd_DistributionTarget_address     protoreflect.FieldDescriptor
+	fd_DistributionTarget_weight      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_aizel_inflation_v1_inflation_proto_init()
-	md_ExponentialCalculation = File_aizel_inflation_v1_inflation_proto.Messages().ByName("ExponentialCalculation")
-	fd_ExponentialCalculation_a = md_ExponentialCalculation.Fields().ByName("a")
-	fd_ExponentialCalculation_r = md_ExponentialCalculation.Fields().ByName("r")
-	fd_ExponentialCalculation_c = md_ExponentialCalculation.Fields().ByName("c")
-	fd_ExponentialCalculation_bonding_target = md_ExponentialCalculation.Fields().ByName("bonding_target")
-	fd_ExponentialCalculation_max_variance = md_ExponentialCalculation.Fields().ByName("max_variance")
+	md_DistributionTarget = File_aizel_inflation_v1_inflation_proto.Messages().ByName("DistributionTarget")
+	fd_DistributionTarget_target_type = md_DistributionTarget.Fields().ByName("target_type")
+	fd_DistributionTarget_address = md_DistributionTarget.Fields().ByName("address")
+	fd_DistributionTarget_weight = md_DistributionTarget.Fields().ByName("weight")
 }
 
-var _ protoreflect.Message = (*fastReflection_ExponentialCalculation)(nil)
+var _ protoreflect.Message = (*fastReflection_DistributionTarget)(nil)
 
-type fastReflection_ExponentialCalculation ExponentialCalculation
+type fastReflection_DistributionTarget DistributionTarget
 
-func (x *ExponentialCalculation) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ExponentialCalculation)(x)
+func (x *DistributionTarget) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DistributionTarget)(x)
 }
 
-func (x *ExponentialCalculation) slowProtoReflect() protoreflect.Message {
+func (x *DistributionTarget) slowProtoReflect() protoreflect.Message {
 	mi := &file_aizel_inflation_v1_inflation_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -601,43 +671,43 @@ func (x *ExponentialCalculation) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ExponentialCalculation_messageType fastReflection_ExponentialCalculation_messageType
-var _ protoreflect.MessageType = fastReflection_ExponentialCalculation_messageType{}
+var _fastReflection_DistributionTarget_messageType fastReflection_DistributionTarget_messageType
+var _ protoreflect.MessageType = fastReflection_DistributionTarget_messageType{}
 
-type fastReflection_ExponentialCalculation_messageType struct{}
+type fastReflection_DistributionTarget_messageType struct{}
 
-func (x fastReflection_ExponentialCalculation_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ExponentialCalculation)(nil)
+func (x fastReflection_DistributionTarget_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DistributionTarget)(nil)
 }
-func (x fastReflection_ExponentialCalculation_messageType) New() protoreflect.Message {
-	return new(fastReflection_ExponentialCalculation)
+func (x fastReflection_DistributionTarget_messageType) New() protoreflect.Message {
+	return new(fastReflection_DistributionTarget)
 }
-func (x fastReflection_ExponentialCalculation_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ExponentialCalculation
+func (x fastReflection_DistributionTarget_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DistributionTarget
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ExponentialCalculation) Descriptor() protoreflect.MessageDescriptor {
-	return md_ExponentialCalculation
+func (x *fastReflection_DistributionTarget) Descriptor() protoreflect.MessageDescriptor {
+	return md_DistributionTarget
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ExponentialCalculation) Type() protoreflect.MessageType {
-	return _fastReflection_ExponentialCalculation_messageType
+func (x *fastReflection_DistributionTarget) Type() protoreflect.MessageType {
+	return _fastReflection_DistributionTarget_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ExponentialCalculation) New() protoreflect.Message {
-	return new(fastReflection_ExponentialCalculation)
+func (x *fastReflection_DistributionTarget) New() protoreflect.Message {
+	return new(fastReflection_DistributionTarget)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ExponentialCalculation) Interface() protoreflect.ProtoMessage {
-	return (*ExponentialCalculation)(x)
+func (x *fastReflection_DistributionTarget) Interface() protoreflect.ProtoMessage {
+	return (*DistributionTarget)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -645,34 +715,22 @@ func (x *fastReflection_ExponentialCalculation) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ExponentialCalculation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.A != "" {
-		value := protoreflect.ValueOfString(x.A)
-		if !f(fd_ExponentialCalculation_a, value) {
+func (x *fastReflection_DistributionTarget) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TargetType != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.TargetType))
+		if !f(fd_DistributionTarget_target_type, value) {
 			return
 		}
 	}
-	if x.R != "" {
-		value := protoreflect.ValueOfString(x.R)
-		if !f(fd_ExponentialCalculation_r, value) {
-			return
-		}
-	}
-	if x.C != "" {
-		value := protoreflect.ValueOfString(x.C)
-		if !f(fd_ExponentialCalculation_c, value) {
-			return
-		}
-	}
-	if x.BondingTarget != "" {
-		value := protoreflect.ValueOfString(x.BondingTarget)
-		if !f(fd_ExponentialCalculation_bonding_target, value) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_DistributionTarget_address, value) {
 			return
 		}
 	}
-	if x.MaxVariance != "" {
-		value := protoreflect.ValueOfString(x.MaxVariance)
-		if !f(fd_ExponentialCalculation_max_variance, value) {
+	if x.Weight != "" {
+		value := protoreflect.ValueOfString(x.Weight)
+		if !f(fd_DistributionTarget_weight, value) {
 			return
 		}
 	}
@@ -689,23 +747,19 @@ func (x *fastReflection_ExponentialCalculation) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ExponentialCalculation) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_DistributionTarget) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "aizel.inflation.v1.ExponentialCalculation.a":
-		return x.A != ""
-	case "aizel.inflation.v1.ExponentialCalculation.r":
-		return x.R != ""
-	case "aizel.inflation.v1.ExponentialCalculation.c":
-		return x.C != ""
-	case "aizel.inflation.v1.ExponentialCalculation.bonding_target":
-		return x.BondingTarget != ""
-	case "aizel.inflation.v1.ExponentialCalculation.max_variance":
-		return x.MaxVariance != ""
+	case "aizel.inflation.v1.DistributionTarget.target_type":
+		return x.TargetType != 0
+	case "aizel.inflation.v1.DistributionTarget.address":
+		return x.Address != ""
+	case "aizel.inflation.v1.DistributionTarget.weight":
+		return x.Weight != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.ExponentialCalculation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.DistributionTarget"))
 		}
-		panic(fmt.Errorf("message aizel.inflation.v1.ExponentialCalculation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message aizel.inflation.v1.DistributionTarget does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -715,23 +769,19 @@ func (x *fastReflection_ExponentialCalculation) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ExponentialCalculation) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_DistributionTarget) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "aizel.inflation.v1.ExponentialCalculation.a":
-		x.A = ""
-	case "aizel.inflation.v1.ExponentialCalculation.r":
-		x.R = ""
-	case "aizel.inflation.v1.ExponentialCalculation.c":
-		x.C = ""
-	case "aizel.inflation.v1.ExponentialCalculation.bonding_target":
-		x.BondingTarget = ""
-	case "aizel.inflation.v1.ExponentialCalculation.max_variance":
-		x.MaxVariance = ""
+	case "aizel.inflation.v1.DistributionTarget.target_type":
+		x.TargetType = 0
+	case "aizel.inflation.v1.DistributionTarget.address":
+		x.Address = ""
+	case "aizel.inflation.v1.DistributionTarget.weight":
+		x.Weight = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.ExponentialCalculation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.DistributionTarget"))
 		}
-		panic(fmt.Errorf("message aizel.inflation.v1.ExponentialCalculation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message aizel.inflation.v1.DistributionTarget does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -741,28 +791,22 @@ func (x *fastReflection_ExponentialCalculation) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ExponentialCalculation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DistributionTarget) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "aizel.inflation.v1.ExponentialCalculation.a":
-		value := x.A
-		return protoreflect.ValueOfString(value)
-	case "aizel.inflation.v1.ExponentialCalculation.r":
-		value := x.R
-		return protoreflect.ValueOfString(value)
-	case "aizel.inflation.v1.ExponentialCalculation.c":
-		value := x.C
-		return protoreflect.ValueOfString(value)
-	case "aizel.inflation.v1.ExponentialCalculation.bonding_target":
-		value := x.BondingTarget
+	case "aizel.inflation.v1.DistributionTarget.target_type":
+		value := x.TargetType
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "aizel.inflation.v1.DistributionTarget.address":
+		value := x.Address
 		return protoreflect.ValueOfString(value)
-	case "aizel.inflation.v1.ExponentialCalculation.max_variance":
-		value := x.MaxVariance
+	case "aizel.inflation.v1.DistributionTarget.weight":
+		value := x.Weight
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.ExponentialCalculation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.DistributionTarget"))
 		}
-		panic(fmt.Errorf("message aizel.inflation.v1.ExponentialCalculation does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message aizel.inflation.v1.DistributionTarget does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -776,23 +820,19 @@ func (x *fastReflection_ExponentialCalculation) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ExponentialCalculation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_DistributionTarget) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "aizel.inflation.v1.ExponentialCalculation.a":
-		x.A = value.Interface().(string)
-	case "aizel.inflation.v1.ExponentialCalculation.r":
-		x.R = value.Interface().(string)
-	case "aizel.inflation.v1.ExponentialCalculation.c":
-		x.C = value.Interface().(string)
-	case "aizel.inflation.v1.ExponentialCalculation.bonding_target":
-		x.BondingTarget = value.Interface().(string)
-	case "aizel.inflation.v1.ExponentialCalculation.max_variance":
-		x.MaxVariance = value.Interface().(string)
+	case "aizel.inflation.v1.DistributionTarget.target_type":
+		x.TargetType = (DistributionTargetType)(value.Enum())
+	case "aizel.inflation.v1.DistributionTarget.address":
+		x.Address = value.Interface().(string)
+	case "aizel.inflation.v1.DistributionTarget.weight":
+		x.Weight = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.ExponentialCalculation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.DistributionTarget"))
 		}
-		panic(fmt.Errorf("message aizel.inflation.v1.ExponentialCalculation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message aizel.inflation.v1.DistributionTarget does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -806,56 +846,48 @@ func (x *fastReflection_ExponentialCalculation) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ExponentialCalculation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DistributionTarget) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "aizel.inflation.v1.ExponentialCalculation.a":
-		panic(fmt.Errorf("field a of message aizel.inflation.v1.ExponentialCalculation is not mutable"))
-	case "aizel.inflation.v1.ExponentialCalculation.r":
-		panic(fmt.Errorf("field r of message aizel.inflation.v1.ExponentialCalculation is not mutable"))
-	case "aizel.inflation.v1.ExponentialCalculation.c":
-		panic(fmt.Errorf("field c of message aizel.inflation.v1.ExponentialCalculation is not mutable"))
-	case "aizel.inflation.v1.ExponentialCalculation.bonding_target":
-		panic(fmt.Errorf("field bonding_target of message aizel.inflation.v1.ExponentialCalculation is not mutable"))
-	case "aizel.inflation.v1.ExponentialCalculation.max_variance":
-		panic(fmt.Errorf("field max_variance of message aizel.inflation.v1.ExponentialCalculation is not mutable"))
+	case "aizel.inflation.v1.DistributionTarget.target_type":
+		panic(fmt.Errorf("field target_type of message aizel.inflation.v1.DistributionTarget is not mutable"))
+	case "aizel.inflation.v1.DistributionTarget.address":
+		panic(fmt.Errorf("field address of message aizel.inflation.v1.DistributionTarget is not mutable"))
+	case "aizel.inflation.v1.DistributionTarget.weight":
+		panic(fmt.Errorf("field weight of message aizel.inflation.v1.DistributionTarget is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.ExponentialCalculation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.DistributionTarget"))
 		}
-		panic(fmt.Errorf("message aizel.inflation.v1.ExponentialCalculation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message aizel.inflation.v1.DistributionTarget does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ExponentialCalculation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DistributionTarget) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "aizel.inflation.v1.ExponentialCalculation.a":
+	case "aizel.inflation.v1.DistributionTarget.target_type":
+		return protoreflect.ValueOfEnum(0)
+	case "aizel.inflation.v1.DistributionTarget.address":
 		return protoreflect.ValueOfString("")
-	case "aizel.inflation.v1.ExponentialCalculation.r":
-		return protoreflect.ValueOfString("")
-	case "aizel.inflation.v1.ExponentialCalculation.c":
-		return protoreflect.ValueOfString("")
-	case "aizel.inflation.v1.ExponentialCalculation.bonding_target":
-		return protoreflect.ValueOfString("")
-	case "aizel.inflation.v1.ExponentialCalculation.max_variance":
+	case "aizel.inflation.v1.DistributionTarget.weight":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.ExponentialCalculation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.DistributionTarget"))
 		}
-		panic(fmt.Errorf("message aizel.inflation.v1.ExponentialCalculation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message aizel.inflation.v1.DistributionTarget does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ExponentialCalculation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_DistributionTarget) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in aizel.inflation.v1.ExponentialCalculation", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in aizel.inflation.v1.DistributionTarget", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -863,7 +895,7 @@ func (x *fastReflection_ExponentialCalculation) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ExponentialCalculation) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_DistributionTarget) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -874,7 +906,7 @@ func (x *fastReflection_ExponentialCalculation) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ExponentialCalculation) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_DistributionTarget) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -886,7 +918,7 @@ func (x *fastReflection_ExponentialCalculation) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ExponentialCalculation) IsValid() bool {
+func (x *fastReflection_DistributionTarget) IsValid() bool {
 	return x != nil
 }
 
@@ -896,9 +928,9 @@ func (x *fastReflection_ExponentialCalculation) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ExponentialCalculation) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_DistributionTarget) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ExponentialCalculation)
+		x := input.Message.Interface().(*DistributionTarget)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -910,23 +942,14 @@ func (x *fastReflection_ExponentialCalculation) ProtoMethods() *protoiface.Metho
 		var n int
 		var l int
 		_ = l
-		l = len(x.A)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.R)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.C)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.TargetType != 0 {
+			n += 1 + runtime.Sov(uint64(x.TargetType))
 		}
-		l = len(x.BondingTarget)
+		l = len(x.Address)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.MaxVariance)
+		l = len(x.Weight)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -940,7 +963,7 @@ func (x *fastReflection_ExponentialCalculation) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ExponentialCalculation)
+		x := input.Message.Interface().(*DistributionTarget)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -959,40 +982,24 @@ func (x *fastReflection_ExponentialCalculation) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.MaxVariance) > 0 {
-			i -= len(x.MaxVariance)
-			copy(dAtA[i:], x.MaxVariance)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxVariance)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.BondingTarget) > 0 {
-			i -= len(x.BondingTarget)
-			copy(dAtA[i:], x.BondingTarget)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BondingTarget)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.C) > 0 {
-			i -= len(x.C)
-			copy(dAtA[i:], x.C)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.C)))
+		if len(x.Weight) > 0 {
+			i -= len(x.Weight)
+			copy(dAtA[i:], x.Weight)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Weight)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.R) > 0 {
-			i -= len(x.R)
-			copy(dAtA[i:], x.R)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.R)))
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.A) > 0 {
-			i -= len(x.A)
-			copy(dAtA[i:], x.A)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.A)))
+		if x.TargetType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TargetType))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -1005,7 +1012,7 @@ func (x *fastReflection_ExponentialCalculation) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ExponentialCalculation)
+		x := input.Message.Interface().(*DistributionTarget)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1037,17 +1044,17 @@ func (x *fastReflection_ExponentialCalculation) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExponentialCalculation: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DistributionTarget: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExponentialCalculation: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DistributionTarget: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field A", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetType", wireType)
 				}
-				var stringLen uint64
+				x.TargetType = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1057,27 +1064,1770 @@ func (x *fastReflection_ExponentialCalculation) ProtoMethods() *protoiface.Metho
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.TargetType |= DistributionTargetType(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Weight = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ExponentialCalculation                protoreflect.MessageDescriptor
+	fd_ExponentialCalculation_a              protoreflect.FieldDescriptor
+	fd_ExponentialCalculation_r              protoreflect.FieldDescriptor
+	fd_ExponentialCalculation_c              protoreflect.FieldDescriptor
+	fd_ExponentialCalculation_bonding_target protoreflect.FieldDescriptor
+	fd_ExponentialCalculation_max_variance   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_aizel_inflation_v1_inflation_proto_init()
+	md_ExponentialCalculation = File_aizel_inflation_v1_inflation_proto.Messages().ByName("ExponentialCalculation")
+	fd_ExponentialCalculation_a = md_ExponentialCalculation.Fields().ByName("a")
+	fd_ExponentialCalculation_r = md_ExponentialCalculation.Fields().ByName("r")
+	fd_ExponentialCalculation_c = md_ExponentialCalculation.Fields().ByName("c")
+	fd_ExponentialCalculation_bonding_target = md_ExponentialCalculation.Fields().ByName("bonding_target")
+	fd_ExponentialCalculation_max_variance = md_ExponentialCalculation.Fields().ByName("max_variance")
+}
+
+var _ protoreflect.Message = (*fastReflection_ExponentialCalculation)(nil)
+
+type fastReflection_ExponentialCalculation ExponentialCalculation
+
+func (x *ExponentialCalculation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ExponentialCalculation)(x)
+}
+
+func (x *ExponentialCalculation) slowProtoReflect() protoreflect.Message {
+	mi := &file_aizel_inflation_v1_inflation_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ExponentialCalculation_messageType fastReflection_ExponentialCalculation_messageType
+var _ protoreflect.MessageType = fastReflection_ExponentialCalculation_messageType{}
+
+type fastReflection_ExponentialCalculation_messageType struct{}
+
+func (x fastReflection_ExponentialCalculation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ExponentialCalculation)(nil)
+}
+func (x fastReflection_ExponentialCalculation_messageType) New() protoreflect.Message {
+	return new(fastReflection_ExponentialCalculation)
+}
+func (x fastReflection_ExponentialCalculation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExponentialCalculation
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ExponentialCalculation) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExponentialCalculation
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ExponentialCalculation) Type() protoreflect.MessageType {
+	return _fastReflection_ExponentialCalculation_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ExponentialCalculation) New() protoreflect.Message {
+	return new(fastReflection_ExponentialCalculation)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ExponentialCalculation) Interface() protoreflect.ProtoMessage {
+	return (*ExponentialCalculation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ExponentialCalculation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.A != "" {
+		value := protoreflect.ValueOfString(x.A)
+		if !f(fd_ExponentialCalculation_a, value) {
+			return
+		}
+	}
+	if x.R != "" {
+		value := protoreflect.ValueOfString(x.R)
+		if !f(fd_ExponentialCalculation_r, value) {
+			return
+		}
+	}
+	if x.C != "" {
+		value := protoreflect.ValueOfString(x.C)
+		if !f(fd_ExponentialCalculation_c, value) {
+			return
+		}
+	}
+	if x.BondingTarget != "" {
+		value := protoreflect.ValueOfString(x.BondingTarget)
+		if !f(fd_ExponentialCalculation_bonding_target, value) {
+			return
+		}
+	}
+	if x.MaxVariance != "" {
+		value := protoreflect.ValueOfString(x.MaxVariance)
+		if !f(fd_ExponentialCalculation_max_variance, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ExponentialCalculation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "aizel.inflation.v1.ExponentialCalculation.a":
+		return x.A != ""
+	case "aizel.inflation.v1.ExponentialCalculation.r":
+		return x.R != ""
+	case "aizel.inflation.v1.ExponentialCalculation.c":
+		return x.C != ""
+	case "aizel.inflation.v1.ExponentialCalculation.bonding_target":
+		return x.BondingTarget != ""
+	case "aizel.inflation.v1.ExponentialCalculation.max_variance":
+		return x.MaxVariance != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.ExponentialCalculation"))
+		}
+		panic(fmt.Errorf("message aizel.inflation.v1.ExponentialCalculation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExponentialCalculation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "aizel.inflation.v1.ExponentialCalculation.a":
+		x.A = ""
+	case "aizel.inflation.v1.ExponentialCalculation.r":
+		x.R = ""
+	case "aizel.inflation.v1.ExponentialCalculation.c":
+		x.C = ""
+	case "aizel.inflation.v1.ExponentialCalculation.bonding_target":
+		x.BondingTarget = ""
+	case "aizel.inflation.v1.ExponentialCalculation.max_variance":
+		x.MaxVariance = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.ExponentialCalculation"))
+		}
+		panic(fmt.Errorf("message aizel.inflation.v1.ExponentialCalculation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ExponentialCalculation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "aizel.inflation.v1.ExponentialCalculation.a":
+		value := x.A
+		return protoreflect.ValueOfString(value)
+	case "aizel.inflation.v1.ExponentialCalculation.r":
+		value := x.R
+		return protoreflect.ValueOfString(value)
+	case "aizel.inflation.v1.ExponentialCalculation.c":
+		value := x.C
+		return protoreflect.ValueOfString(value)
+	case "aizel.inflation.v1.ExponentialCalculation.bonding_target":
+		value := x.BondingTarget
+		return protoreflect.ValueOfString(value)
+	case "aizel.inflation.v1.ExponentialCalculation.max_variance":
+		value := x.MaxVariance
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.ExponentialCalculation"))
+		}
+		panic(fmt.Errorf("message aizel.inflation.v1.ExponentialCalculation does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExponentialCalculation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "aizel.inflation.v1.ExponentialCalculation.a":
+		x.A = value.Interface().(string)
+	case "aizel.inflation.v1.ExponentialCalculation.r":
+		x.R = value.Interface().(string)
+	case "aizel.inflation.v1.ExponentialCalculation.c":
+		x.C = value.Interface().(string)
+	case "aizel.inflation.v1.ExponentialCalculation.bonding_target":
+		x.BondingTarget = value.Interface().(string)
+	case "aizel.inflation.v1.ExponentialCalculation.max_variance":
+		x.MaxVariance = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.ExponentialCalculation"))
+		}
+		panic(fmt.Errorf("message aizel.inflation.v1.ExponentialCalculation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExponentialCalculation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "aizel.inflation.v1.ExponentialCalculation.a":
+		panic(fmt.Errorf("field a of message aizel.inflation.v1.ExponentialCalculation is not mutable"))
+	case "aizel.inflation.v1.ExponentialCalculation.r":
+		panic(fmt.Errorf("field r of message aizel.inflation.v1.ExponentialCalculation is not mutable"))
+	case "aizel.inflation.v1.ExponentialCalculation.c":
+		panic(fmt.Errorf("field c of message aizel.inflation.v1.ExponentialCalculation is not mutable"))
+	case "aizel.inflation.v1.ExponentialCalculation.bonding_target":
+		panic(fmt.Errorf("field bonding_target of message aizel.inflation.v1.ExponentialCalculation is not mutable"))
+	case "aizel.inflation.v1.ExponentialCalculation.max_variance":
+		panic(fmt.Errorf("field max_variance of message aizel.inflation.v1.ExponentialCalculation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.ExponentialCalculation"))
+		}
+		panic(fmt.Errorf("message aizel.inflation.v1.ExponentialCalculation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ExponentialCalculation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "aizel.inflation.v1.ExponentialCalculation.a":
+		return protoreflect.ValueOfString("")
+	case "aizel.inflation.v1.ExponentialCalculation.r":
+		return protoreflect.ValueOfString("")
+	case "aizel.inflation.v1.ExponentialCalculation.c":
+		return protoreflect.ValueOfString("")
+	case "aizel.inflation.v1.ExponentialCalculation.bonding_target":
+		return protoreflect.ValueOfString("")
+	case "aizel.inflation.v1.ExponentialCalculation.max_variance":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.ExponentialCalculation"))
+		}
+		panic(fmt.Errorf("message aizel.inflation.v1.ExponentialCalculation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ExponentialCalculation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in aizel.inflation.v1.ExponentialCalculation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ExponentialCalculation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExponentialCalculation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ExponentialCalculation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ExponentialCalculation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ExponentialCalculation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.A)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.R)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.C)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BondingTarget)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxVariance)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ExponentialCalculation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MaxVariance) > 0 {
+			i -= len(x.MaxVariance)
+			copy(dAtA[i:], x.MaxVariance)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxVariance)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.BondingTarget) > 0 {
+			i -= len(x.BondingTarget)
+			copy(dAtA[i:], x.BondingTarget)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BondingTarget)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.C) > 0 {
+			i -= len(x.C)
+			copy(dAtA[i:], x.C)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.C)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.R) > 0 {
+			i -= len(x.R)
+			copy(dAtA[i:], x.R)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.R)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.A) > 0 {
+			i -= len(x.A)
+			copy(dAtA[i:], x.A)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.A)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ExponentialCalculation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExponentialCalculation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExponentialCalculation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field A", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.A = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.R = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field C", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.C = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BondingTarget", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BondingTarget = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxVariance", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxVariance = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_HalvingCalculation                   protoreflect.MessageDescriptor
+	fd_HalvingCalculation_initial_provision protoreflect.FieldDescriptor
+	fd_HalvingCalculation_halving_interval  protoreflect.FieldDescriptor
+	fd_HalvingCalculation_max_supply        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_aizel_inflation_v1_inflation_proto_init()
+	md_HalvingCalculation = File_aizel_inflation_v1_inflation_proto.Messages().ByName("HalvingCalculation")
+	fd_HalvingCalculation_initial_provision = md_HalvingCalculation.Fields().ByName("initial_provision")
+	fd_HalvingCalculation_halving_interval = md_HalvingCalculation.Fields().ByName("halving_interval")
+	fd_HalvingCalculation_max_supply = md_HalvingCalculation.Fields().ByName("max_supply")
+}
+
+var _ protoreflect.Message = (*fastReflection_HalvingCalculation)(nil)
+
+type fastReflection_HalvingCalculation HalvingCalculation
+
+func (x *HalvingCalculation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_HalvingCalculation)(x)
+}
+
+func (x *HalvingCalculation) slowProtoReflect() protoreflect.Message {
+	mi := &file_aizel_inflation_v1_inflation_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_HalvingCalculation_messageType fastReflection_HalvingCalculation_messageType
+var _ protoreflect.MessageType = fastReflection_HalvingCalculation_messageType{}
+
+type fastReflection_HalvingCalculation_messageType struct{}
+
+func (x fastReflection_HalvingCalculation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_HalvingCalculation)(nil)
+}
+func (x fastReflection_HalvingCalculation_messageType) New() protoreflect.Message {
+	return new(fastReflection_HalvingCalculation)
+}
+func (x fastReflection_HalvingCalculation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_HalvingCalculation
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_HalvingCalculation) Descriptor() protoreflect.MessageDescriptor {
+	return md_HalvingCalculation
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_HalvingCalculation) Type() protoreflect.MessageType {
+	return _fastReflection_HalvingCalculation_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_HalvingCalculation) New() protoreflect.Message {
+	return new(fastReflection_HalvingCalculation)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_HalvingCalculation) Interface() protoreflect.ProtoMessage {
+	return (*HalvingCalculation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_HalvingCalculation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.InitialProvision != "" {
+		value := protoreflect.ValueOfString(x.InitialProvision)
+		if !f(fd_HalvingCalculation_initial_provision, value) {
+			return
+		}
+	}
+	if x.HalvingInterval != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.HalvingInterval)
+		if !f(fd_HalvingCalculation_halving_interval, value) {
+			return
+		}
+	}
+	if x.MaxSupply != "" {
+		value := protoreflect.ValueOfString(x.MaxSupply)
+		if !f(fd_HalvingCalculation_max_supply, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_HalvingCalculation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "aizel.inflation.v1.HalvingCalculation.initial_provision":
+		return x.InitialProvision != ""
+	case "aizel.inflation.v1.HalvingCalculation.halving_interval":
+		return x.HalvingInterval != uint64(0)
+	case "aizel.inflation.v1.HalvingCalculation.max_supply":
+		return x.MaxSupply != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.HalvingCalculation"))
+		}
+		panic(fmt.Errorf("message aizel.inflation.v1.HalvingCalculation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_HalvingCalculation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "aizel.inflation.v1.HalvingCalculation.initial_provision":
+		x.InitialProvision = ""
+	case "aizel.inflation.v1.HalvingCalculation.halving_interval":
+		x.HalvingInterval = uint64(0)
+	case "aizel.inflation.v1.HalvingCalculation.max_supply":
+		x.MaxSupply = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.HalvingCalculation"))
+		}
+		panic(fmt.Errorf("message aizel.inflation.v1.HalvingCalculation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_HalvingCalculation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "aizel.inflation.v1.HalvingCalculation.initial_provision":
+		value := x.InitialProvision
+		return protoreflect.ValueOfString(value)
+	case "aizel.inflation.v1.HalvingCalculation.halving_interval":
+		value := x.HalvingInterval
+		return protoreflect.ValueOfUint64(value)
+	case "aizel.inflation.v1.HalvingCalculation.max_supply":
+		value := x.MaxSupply
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.HalvingCalculation"))
+		}
+		panic(fmt.Errorf("message aizel.inflation.v1.HalvingCalculation does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_HalvingCalculation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "aizel.inflation.v1.HalvingCalculation.initial_provision":
+		x.InitialProvision = value.Interface().(string)
+	case "aizel.inflation.v1.HalvingCalculation.halving_interval":
+		x.HalvingInterval = value.Uint()
+	case "aizel.inflation.v1.HalvingCalculation.max_supply":
+		x.MaxSupply = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.HalvingCalculation"))
+		}
+		panic(fmt.Errorf("message aizel.inflation.v1.HalvingCalculation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_HalvingCalculation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "aizel.inflation.v1.HalvingCalculation.initial_provision":
+		panic(fmt.Errorf("field initial_provision of message aizel.inflation.v1.HalvingCalculation is not mutable"))
+	case "aizel.inflation.v1.HalvingCalculation.halving_interval":
+		panic(fmt.Errorf("field halving_interval of message aizel.inflation.v1.HalvingCalculation is not mutable"))
+	case "aizel.inflation.v1.HalvingCalculation.max_supply":
+		panic(fmt.Errorf("field max_supply of message aizel.inflation.v1.HalvingCalculation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.HalvingCalculation"))
+		}
+		panic(fmt.Errorf("message aizel.inflation.v1.HalvingCalculation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_HalvingCalculation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "aizel.inflation.v1.HalvingCalculation.initial_provision":
+		return protoreflect.ValueOfString("")
+	case "aizel.inflation.v1.HalvingCalculation.halving_interval":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "aizel.inflation.v1.HalvingCalculation.max_supply":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.HalvingCalculation"))
+		}
+		panic(fmt.Errorf("message aizel.inflation.v1.HalvingCalculation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_HalvingCalculation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in aizel.inflation.v1.HalvingCalculation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_HalvingCalculation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_HalvingCalculation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_HalvingCalculation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_HalvingCalculation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*HalvingCalculation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.InitialProvision)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.HalvingInterval != 0 {
+			n += 1 + runtime.Sov(uint64(x.HalvingInterval))
+		}
+		l = len(x.MaxSupply)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*HalvingCalculation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MaxSupply) > 0 {
+			i -= len(x.MaxSupply)
+			copy(dAtA[i:], x.MaxSupply)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxSupply)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.HalvingInterval != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.HalvingInterval))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.InitialProvision) > 0 {
+			i -= len(x.InitialProvision)
+			copy(dAtA[i:], x.InitialProvision)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InitialProvision)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*HalvingCalculation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: HalvingCalculation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: HalvingCalculation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InitialProvision", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InitialProvision = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HalvingInterval", wireType)
+				}
+				x.HalvingInterval = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.HalvingInterval |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxSupply = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TargetBondedCalculation                 protoreflect.MessageDescriptor
+	fd_TargetBondedCalculation_inflation_min   protoreflect.FieldDescriptor
+	fd_TargetBondedCalculation_inflation_max   protoreflect.FieldDescriptor
+	fd_TargetBondedCalculation_bonding_target  protoreflect.FieldDescriptor
+	fd_TargetBondedCalculation_adjustment_rate protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_aizel_inflation_v1_inflation_proto_init()
+	md_TargetBondedCalculation = File_aizel_inflation_v1_inflation_proto.Messages().ByName("TargetBondedCalculation")
+	fd_TargetBondedCalculation_inflation_min = md_TargetBondedCalculation.Fields().ByName("inflation_min")
+	fd_TargetBondedCalculation_inflation_max = md_TargetBondedCalculation.Fields().ByName("inflation_max")
+	fd_TargetBondedCalculation_bonding_target = md_TargetBondedCalculation.Fields().ByName("bonding_target")
+	fd_TargetBondedCalculation_adjustment_rate = md_TargetBondedCalculation.Fields().ByName("adjustment_rate")
+}
+
+var _ protoreflect.Message = (*fastReflection_TargetBondedCalculation)(nil)
+
+type fastReflection_TargetBondedCalculation TargetBondedCalculation
+
+func (x *TargetBondedCalculation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TargetBondedCalculation)(x)
+}
+
+func (x *TargetBondedCalculation) slowProtoReflect() protoreflect.Message {
+	mi := &file_aizel_inflation_v1_inflation_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TargetBondedCalculation_messageType fastReflection_TargetBondedCalculation_messageType
+var _ protoreflect.MessageType = fastReflection_TargetBondedCalculation_messageType{}
+
+type fastReflection_TargetBondedCalculation_messageType struct{}
+
+func (x fastReflection_TargetBondedCalculation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TargetBondedCalculation)(nil)
+}
+func (x fastReflection_TargetBondedCalculation_messageType) New() protoreflect.Message {
+	return new(fastReflection_TargetBondedCalculation)
+}
+func (x fastReflection_TargetBondedCalculation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TargetBondedCalculation
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TargetBondedCalculation) Descriptor() protoreflect.MessageDescriptor {
+	return md_TargetBondedCalculation
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TargetBondedCalculation) Type() protoreflect.MessageType {
+	return _fastReflection_TargetBondedCalculation_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TargetBondedCalculation) New() protoreflect.Message {
+	return new(fastReflection_TargetBondedCalculation)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TargetBondedCalculation) Interface() protoreflect.ProtoMessage {
+	return (*TargetBondedCalculation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TargetBondedCalculation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.InflationMin != "" {
+		value := protoreflect.ValueOfString(x.InflationMin)
+		if !f(fd_TargetBondedCalculation_inflation_min, value) {
+			return
+		}
+	}
+	if x.InflationMax != "" {
+		value := protoreflect.ValueOfString(x.InflationMax)
+		if !f(fd_TargetBondedCalculation_inflation_max, value) {
+			return
+		}
+	}
+	if x.BondingTarget != "" {
+		value := protoreflect.ValueOfString(x.BondingTarget)
+		if !f(fd_TargetBondedCalculation_bonding_target, value) {
+			return
+		}
+	}
+	if x.AdjustmentRate != "" {
+		value := protoreflect.ValueOfString(x.AdjustmentRate)
+		if !f(fd_TargetBondedCalculation_adjustment_rate, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TargetBondedCalculation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "aizel.inflation.v1.TargetBondedCalculation.inflation_min":
+		return x.InflationMin != ""
+	case "aizel.inflation.v1.TargetBondedCalculation.inflation_max":
+		return x.InflationMax != ""
+	case "aizel.inflation.v1.TargetBondedCalculation.bonding_target":
+		return x.BondingTarget != ""
+	case "aizel.inflation.v1.TargetBondedCalculation.adjustment_rate":
+		return x.AdjustmentRate != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.TargetBondedCalculation"))
+		}
+		panic(fmt.Errorf("message aizel.inflation.v1.TargetBondedCalculation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TargetBondedCalculation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "aizel.inflation.v1.TargetBondedCalculation.inflation_min":
+		x.InflationMin = ""
+	case "aizel.inflation.v1.TargetBondedCalculation.inflation_max":
+		x.InflationMax = ""
+	case "aizel.inflation.v1.TargetBondedCalculation.bonding_target":
+		x.BondingTarget = ""
+	case "aizel.inflation.v1.TargetBondedCalculation.adjustment_rate":
+		x.AdjustmentRate = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.TargetBondedCalculation"))
+		}
+		panic(fmt.Errorf("message aizel.inflation.v1.TargetBondedCalculation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TargetBondedCalculation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "aizel.inflation.v1.TargetBondedCalculation.inflation_min":
+		value := x.InflationMin
+		return protoreflect.ValueOfString(value)
+	case "aizel.inflation.v1.TargetBondedCalculation.inflation_max":
+		value := x.InflationMax
+		return protoreflect.ValueOfString(value)
+	case "aizel.inflation.v1.TargetBondedCalculation.bonding_target":
+		value := x.BondingTarget
+		return protoreflect.ValueOfString(value)
+	case "aizel.inflation.v1.TargetBondedCalculation.adjustment_rate":
+		value := x.AdjustmentRate
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.TargetBondedCalculation"))
+		}
+		panic(fmt.Errorf("message aizel.inflation.v1.TargetBondedCalculation does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TargetBondedCalculation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "aizel.inflation.v1.TargetBondedCalculation.inflation_min":
+		x.InflationMin = value.Interface().(string)
+	case "aizel.inflation.v1.TargetBondedCalculation.inflation_max":
+		x.InflationMax = value.Interface().(string)
+	case "aizel.inflation.v1.TargetBondedCalculation.bonding_target":
+		x.BondingTarget = value.Interface().(string)
+	case "aizel.inflation.v1.TargetBondedCalculation.adjustment_rate":
+		x.AdjustmentRate = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.TargetBondedCalculation"))
+		}
+		panic(fmt.Errorf("message aizel.inflation.v1.TargetBondedCalculation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TargetBondedCalculation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "aizel.inflation.v1.TargetBondedCalculation.inflation_min":
+		panic(fmt.Errorf("field inflation_min of message aizel.inflation.v1.TargetBondedCalculation is not mutable"))
+	case "aizel.inflation.v1.TargetBondedCalculation.inflation_max":
+		panic(fmt.Errorf("field inflation_max of message aizel.inflation.v1.TargetBondedCalculation is not mutable"))
+	case "aizel.inflation.v1.TargetBondedCalculation.bonding_target":
+		panic(fmt.Errorf("field bonding_target of message aizel.inflation.v1.TargetBondedCalculation is not mutable"))
+	case "aizel.inflation.v1.TargetBondedCalculation.adjustment_rate":
+		panic(fmt.Errorf("field adjustment_rate of message aizel.inflation.v1.TargetBondedCalculation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.TargetBondedCalculation"))
+		}
+		panic(fmt.Errorf("message aizel.inflation.v1.TargetBondedCalculation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TargetBondedCalculation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "aizel.inflation.v1.TargetBondedCalculation.inflation_min":
+		return protoreflect.ValueOfString("")
+	case "aizel.inflation.v1.TargetBondedCalculation.inflation_max":
+		return protoreflect.ValueOfString("")
+	case "aizel.inflation.v1.TargetBondedCalculation.bonding_target":
+		return protoreflect.ValueOfString("")
+	case "aizel.inflation.v1.TargetBondedCalculation.adjustment_rate":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: aizel.inflation.v1.TargetBondedCalculation"))
+		}
+		panic(fmt.Errorf("message aizel.inflation.v1.TargetBondedCalculation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TargetBondedCalculation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in aizel.inflation.v1.TargetBondedCalculation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TargetBondedCalculation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TargetBondedCalculation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TargetBondedCalculation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TargetBondedCalculation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TargetBondedCalculation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.InflationMin)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.InflationMax)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BondingTarget)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AdjustmentRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TargetBondedCalculation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.AdjustmentRate) > 0 {
+			i -= len(x.AdjustmentRate)
+			copy(dAtA[i:], x.AdjustmentRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AdjustmentRate)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.BondingTarget) > 0 {
+			i -= len(x.BondingTarget)
+			copy(dAtA[i:], x.BondingTarget)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BondingTarget)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.InflationMax) > 0 {
+			i -= len(x.InflationMax)
+			copy(dAtA[i:], x.InflationMax)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InflationMax)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.InflationMin) > 0 {
+			i -= len(x.InflationMin)
+			copy(dAtA[i:], x.InflationMin)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InflationMin)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TargetBondedCalculation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.A = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TargetBondedCalculation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TargetBondedCalculation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InflationMin", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1105,11 +2855,11 @@ func (x *fastReflection_ExponentialCalculation) ProtoMethods() *protoiface.Metho
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.R = string(dAtA[iNdEx:postIndex])
+				x.InflationMin = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field C", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InflationMax", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1137,9 +2887,9 @@ func (x *fastReflection_ExponentialCalculation) ProtoMethods() *protoiface.Metho
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.C = string(dAtA[iNdEx:postIndex])
+				x.InflationMax = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 4:
+			case 3:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BondingTarget", wireType)
 				}
@@ -1171,9 +2921,9 @@ func (x *fastReflection_ExponentialCalculation) ProtoMethods() *protoiface.Metho
 				}
 				x.BondingTarget = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 5:
+			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxVariance", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AdjustmentRate", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1201,7 +2951,7 @@ func (x *fastReflection_ExponentialCalculation) ProtoMethods() *protoiface.Metho
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MaxVariance = string(dAtA[iNdEx:postIndex])
+				x.AdjustmentRate = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -1254,10 +3004,129 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// DistributionTargetType defines the type of recipient of a distribution target
+type DistributionTargetType int32
+
+const (
+	// DISTRIBUTION_TARGET_TYPE_UNSPECIFIED defines an invalid target type
+	DistributionTargetType_DISTRIBUTION_TARGET_TYPE_UNSPECIFIED DistributionTargetType = 0
+	// DISTRIBUTION_TARGET_TYPE_MODULE defines a module account target, identified
+	// by the module name
+	DistributionTargetType_DISTRIBUTION_TARGET_TYPE_MODULE DistributionTargetType = 1
+	// DISTRIBUTION_TARGET_TYPE_ADDRESS defines an arbitrary account target,
+	// identified by its bech32 address
+	DistributionTargetType_DISTRIBUTION_TARGET_TYPE_ADDRESS DistributionTargetType = 2
+	// DISTRIBUTION_TARGET_TYPE_CONTRACT defines an EVM contract target, identified
+	// by its hex address, that is notified after receiving the minted coins
+	DistributionTargetType_DISTRIBUTION_TARGET_TYPE_CONTRACT DistributionTargetType = 3
+)
+
+// Enum value maps for DistributionTargetType.
+var (
+	DistributionTargetType_name = map[int32]string{
+		0: "DISTRIBUTION_TARGET_TYPE_UNSPECIFIED",
+		1: "DISTRIBUTION_TARGET_TYPE_MODULE",
+		2: "DISTRIBUTION_TARGET_TYPE_ADDRESS",
+		3: "DISTRIBUTION_TARGET_TYPE_CONTRACT",
+	}
+	DistributionTargetType_value = map[string]int32{
+		"DISTRIBUTION_TARGET_TYPE_UNSPECIFIED": 0,
+		"DISTRIBUTION_TARGET_TYPE_MODULE":      1,
+		"DISTRIBUTION_TARGET_TYPE_ADDRESS":     2,
+		"DISTRIBUTION_TARGET_TYPE_CONTRACT":    3,
+	}
+)
+
+func (x DistributionTargetType) Enum() *DistributionTargetType {
+	p := new(DistributionTargetType)
+	*p = x
+	return p
+}
+
+func (x DistributionTargetType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DistributionTargetType) Descriptor() protoreflect.EnumDescriptor {
+	return file_aizel_inflation_v1_inflation_proto_enumTypes[0].Descriptor()
+}
+
+func (DistributionTargetType) Type() protoreflect.EnumType {
+	return &file_aizel_inflation_v1_inflation_proto_enumTypes[0]
+}
+
+func (x DistributionTargetType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DistributionTargetType.Descriptor instead.
+func (DistributionTargetType) EnumDescriptor() ([]byte, []int) {
+	return file_aizel_inflation_v1_inflation_proto_rawDescGZIP(), []int{0}
+}
+
+// InflationModel defines the curve used to calculate the inflation minted on
+// each epoch
+type InflationModel int32
+
+const (
+	// INFLATION_MODEL_EXPONENTIAL defines the exponential decay curve calculated
+	// with the ExponentialCalculation factors
+	InflationModel_INFLATION_MODEL_EXPONENTIAL InflationModel = 0
+	// INFLATION_MODEL_HALVING defines a curve that halves the provision after a
+	// fixed number of periods, up to a maximum supply, calculated with the
+	// HalvingCalculation factors
+	InflationModel_INFLATION_MODEL_HALVING InflationModel = 1
+	// INFLATION_MODEL_TARGET_BONDED defines a dynamic inflation rate that is
+	// adjusted on each epoch towards a target bonded ratio, calculated with the
+	// TargetBondedCalculation factors
+	InflationModel_INFLATION_MODEL_TARGET_BONDED InflationModel = 2
+)
+
+// Enum value maps for InflationModel.
+var (
+	InflationModel_name = map[int32]string{
+		0: "INFLATION_MODEL_EXPONENTIAL",
+		1: "INFLATION_MODEL_HALVING",
+		2: "INFLATION_MODEL_TARGET_BONDED",
+	}
+	InflationModel_value = map[string]int32{
+		"INFLATION_MODEL_EXPONENTIAL":   0,
+		"INFLATION_MODEL_HALVING":       1,
+		"INFLATION_MODEL_TARGET_BONDED": 2,
+	}
+)
+
+func (x InflationModel) Enum() *InflationModel {
+	p := new(InflationModel)
+	*p = x
+	return p
+}
+
+func (x InflationModel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InflationModel) Descriptor() protoreflect.EnumDescriptor {
+	return file_aizel_inflation_v1_inflation_proto_enumTypes[1].Descriptor()
+}
+
+func (InflationModel) Type() protoreflect.EnumType {
+	return &file_aizel_inflation_v1_inflation_proto_enumTypes[1]
+}
+
+func (x InflationModel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InflationModel.Descriptor instead.
+func (InflationModel) EnumDescriptor() ([]byte, []int) {
+	return file_aizel_inflation_v1_inflation_proto_rawDescGZIP(), []int{1}
+}
+
 // InflationDistribution defines the distribution in which inflation is
-// allocated through minting on each epoch (staking, incentives, community). It
+// allocated through minting on each epoch (staking, community pool and targets). It
 // excludes the team vesting distribution, as this is minted once at genesis.
-// The initial InflationDistribution can be calculated from the Evmos Token
+// The initial InflationDistribution can be calculated from the Aizel Token
 // Model like this:
 // mintDistribution1 = distribution1 / (1 - teamVestingDistribution)
 // 0.5333333         = 40%           / (1 - 25%)
@@ -1269,14 +3138,12 @@ type InflationDistribution struct {
 	// staking_rewards defines the proportion of the minted minted_denom that is
 	// to be allocated as staking rewards
 	StakingRewards string `protobuf:"bytes,1,opt,name=staking_rewards,json=stakingRewards,proto3" json:"staking_rewards,omitempty"`
-	// Deprecated: usage_incentives defines the proportion of the minted minted_denom that is
-	// to be allocated to the incentives module address
-	//
-	// Deprecated: Do not use.
-	UsageIncentives string `protobuf:"bytes,2,opt,name=usage_incentives,json=usageIncentives,proto3" json:"usage_incentives,omitempty"`
 	// community_pool defines the proportion of the minted minted_denom that is to
 	// be allocated to the community pool
 	CommunityPool string `protobuf:"bytes,3,opt,name=community_pool,json=communityPool,proto3" json:"community_pool,omitempty"`
+	// targets defines the additional recipients of the minted minted_denom and
+	// their proportions
+	Targets []*DistributionTarget `protobuf:"bytes,4,rep,name=targets,proto3" json:"targets,omitempty"`
 }
 
 func (x *InflationDistribution) Reset() {
@@ -1306,17 +3173,74 @@ func (x *InflationDistribution) GetStakingRewards() string {
 	return ""
 }
 
-// Deprecated: Do not use.
-func (x *InflationDistribution) GetUsageIncentives() string {
+func (x *InflationDistribution) GetCommunityPool() string {
 	if x != nil {
-		return x.UsageIncentives
+		return x.CommunityPool
 	}
 	return ""
 }
 
-func (x *InflationDistribution) GetCommunityPool() string {
+func (x *InflationDistribution) GetTargets() []*DistributionTarget {
 	if x != nil {
-		return x.CommunityPool
+		return x.Targets
+	}
+	return nil
+}
+
+// DistributionTarget defines a recipient of a proportion of the inflation
+// minted on each epoch
+type DistributionTarget struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// target_type defines the type of the recipient
+	TargetType DistributionTargetType `protobuf:"varint,1,opt,name=target_type,json=targetType,proto3,enum=aizel.inflation.v1.DistributionTargetType" json:"target_type,omitempty"`
+	// address is the module name, bech32 address or hex contract address of the
+	// recipient, depending on the target type
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// weight defines the proportion of the minted minted_denom that is to be
+	// allocated to the recipient
+	Weight string `protobuf:"bytes,3,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (x *DistributionTarget) Reset() {
+	*x = DistributionTarget{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aizel_inflation_v1_inflation_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DistributionTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DistributionTarget) ProtoMessage() {}
+
+// Deprecated: Use DistributionTarget.ProtoReflect.Descriptor instead.
+func (*DistributionTarget) Descriptor() ([]byte, []int) {
+	return file_aizel_inflation_v1_inflation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DistributionTarget) GetTargetType() DistributionTargetType {
+	if x != nil {
+		return x.TargetType
+	}
+	return DistributionTargetType_DISTRIBUTION_TARGET_TYPE_UNSPECIFIED
+}
+
+func (x *DistributionTarget) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *DistributionTarget) GetWeight() string {
+	if x != nil {
+		return x.Weight
 	}
 	return ""
 }
@@ -1346,7 +3270,7 @@ type ExponentialCalculation struct {
 func (x *ExponentialCalculation) Reset() {
 	*x = ExponentialCalculation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aizel_inflation_v1_inflation_proto_msgTypes[1]
+		mi := &file_aizel_inflation_v1_inflation_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1360,7 +3284,7 @@ func (*ExponentialCalculation) ProtoMessage() {}
 
 // Deprecated: Use ExponentialCalculation.ProtoReflect.Descriptor instead.
 func (*ExponentialCalculation) Descriptor() ([]byte, []int) {
-	return file_aizel_inflation_v1_inflation_proto_rawDescGZIP(), []int{1}
+	return file_aizel_inflation_v1_inflation_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *ExponentialCalculation) GetA() string {
@@ -1398,6 +3322,133 @@ func (x *ExponentialCalculation) GetMaxVariance() string {
 	return ""
 }
 
+// HalvingCalculation holds factors to calculate the inflation with halvings on
+// each period. Calculation reference:
+// periodProvision = initial_provision / 2 ^ floor(period / halving_interval)
+// The minted amount is capped so that the supply never exceeds max_supply.
+type HalvingCalculation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// initial_provision defines the provision minted on the first period
+	InitialProvision string `protobuf:"bytes,1,opt,name=initial_provision,json=initialProvision,proto3" json:"initial_provision,omitempty"`
+	// halving_interval defines the number of periods after which the provision
+	// is halved
+	HalvingInterval uint64 `protobuf:"varint,2,opt,name=halving_interval,json=halvingInterval,proto3" json:"halving_interval,omitempty"`
+	// max_supply defines the maximum supply of the mint denom
+	MaxSupply string `protobuf:"bytes,3,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
+}
+
+func (x *HalvingCalculation) Reset() {
+	*x = HalvingCalculation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aizel_inflation_v1_inflation_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HalvingCalculation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HalvingCalculation) ProtoMessage() {}
+
+// Deprecated: Use HalvingCalculation.ProtoReflect.Descriptor instead.
+func (*HalvingCalculation) Descriptor() ([]byte, []int) {
+	return file_aizel_inflation_v1_inflation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *HalvingCalculation) GetInitialProvision() string {
+	if x != nil {
+		return x.InitialProvision
+	}
+	return ""
+}
+
+func (x *HalvingCalculation) GetHalvingInterval() uint64 {
+	if x != nil {
+		return x.HalvingInterval
+	}
+	return 0
+}
+
+func (x *HalvingCalculation) GetMaxSupply() string {
+	if x != nil {
+		return x.MaxSupply
+	}
+	return ""
+}
+
+// TargetBondedCalculation holds factors to calculate a dynamic inflation rate
+// that is adjusted on each epoch proportionally to the deviation of the bonded
+// ratio from the bonding target. Calculation reference:
+// rateChange = (1 - bondedRatio / bonding_target) * adjustment_rate / epochsPerPeriod
+// rate       = min(max(rate + rateChange, inflation_min), inflation_max)
+type TargetBondedCalculation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// inflation_min defines the minimum yearly inflation rate
+	InflationMin string `protobuf:"bytes,1,opt,name=inflation_min,json=inflationMin,proto3" json:"inflation_min,omitempty"`
+	// inflation_max defines the maximum yearly inflation rate
+	InflationMax string `protobuf:"bytes,2,opt,name=inflation_max,json=inflationMax,proto3" json:"inflation_max,omitempty"`
+	// bonding_target defines the targeted bonded ratio
+	BondingTarget string `protobuf:"bytes,3,opt,name=bonding_target,json=bondingTarget,proto3" json:"bonding_target,omitempty"`
+	// adjustment_rate defines the maximum yearly change of the inflation rate
+	AdjustmentRate string `protobuf:"bytes,4,opt,name=adjustment_rate,json=adjustmentRate,proto3" json:"adjustment_rate,omitempty"`
+}
+
+func (x *TargetBondedCalculation) Reset() {
+	*x = TargetBondedCalculation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aizel_inflation_v1_inflation_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TargetBondedCalculation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TargetBondedCalculation) ProtoMessage() {}
+
+// Deprecated: Use TargetBondedCalculation.ProtoReflect.Descriptor instead.
+func (*TargetBondedCalculation) Descriptor() ([]byte, []int) {
+	return file_aizel_inflation_v1_inflation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TargetBondedCalculation) GetInflationMin() string {
+	if x != nil {
+		return x.InflationMin
+	}
+	return ""
+}
+
+func (x *TargetBondedCalculation) GetInflationMax() string {
+	if x != nil {
+		return x.InflationMax
+	}
+	return ""
+}
+
+func (x *TargetBondedCalculation) GetBondingTarget() string {
+	if x != nil {
+		return x.BondingTarget
+	}
+	return ""
+}
+
+func (x *TargetBondedCalculation) GetAdjustmentRate() string {
+	if x != nil {
+		return x.AdjustmentRate
+	}
+	return ""
+}
+
 var File_aizel_inflation_v1_inflation_proto protoreflect.FileDescriptor
 
 var file_aizel_inflation_v1_inflation_proto_rawDesc = []byte{
@@ -1407,59 +3458,127 @@ var file_aizel_inflation_v1_inflation_proto_rawDesc = []byte{
 	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f,
 	0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67,
 	0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x92, 0x02, 0x0a, 0x15, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44,
+	0x6f, 0x22, 0xa0, 0x02, 0x0a, 0x15, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44,
 	0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x51, 0x0a, 0x0f, 0x73,
 	0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f,
 	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
 	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e,
-	0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x55,
-	0x0a, 0x10, 0x75, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x76,
-	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2a, 0x18, 0x01, 0xc8, 0xde, 0x1f, 0x00,
+	0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x4f,
+	0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x12,
+	0x4b, 0x0a, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x4a, 0x04, 0x08, 0x02,
+	0x10, 0x03, 0x52, 0x10, 0x75, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x63, 0x65, 0x6e, 0x74,
+	0x69, 0x76, 0x65, 0x73, 0x22, 0xbd, 0x01, 0x0a, 0x12, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x4b, 0x0a, 0x0b, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x2a, 0x2e, 0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x40, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x22, 0xde, 0x02, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x36, 0x0a, 0x01, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00,
 	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
 	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8,
-	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x63, 0x65, 0x6e,
-	0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x4f, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69,
-	0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8,
-	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
-	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
-	0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69,
-	0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x22, 0xde, 0x02, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x6f, 0x6e,
-	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x36, 0x0a, 0x01, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde,
-	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
-	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
-	0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x01, 0x61, 0x12, 0x36, 0x0a, 0x01, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
-	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x01,
-	0x72, 0x12, 0x36, 0x0a, 0x01, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde,
-	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
-	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
-	0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x01, 0x63, 0x12, 0x4f, 0x0a, 0x0e, 0x62, 0x6f, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67,
-	0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x62, 0x6f, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x4b, 0x0a, 0x0c, 0x6d, 0x61,
-	0x78, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61,
-	0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x56,
-	0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x42, 0xc3, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e,
-	0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x76, 0x31, 0x42, 0x0e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
-	0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2f, 0x69, 0x6e, 0x66,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x49, 0x58, 0xaa, 0x02, 0x12, 0x45, 0x76,
-	0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x31,
-	0xca, 0x02, 0x12, 0x45, 0x76, 0x6d, 0x6f, 0x73, 0x5c, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1e, 0x45, 0x76, 0x6d, 0x6f, 0x73, 0x5c, 0x49, 0x6e,
-	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x45, 0x76, 0x6d, 0x6f, 0x73, 0x3a, 0x3a,
-	0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x01, 0x61, 0x12, 0x36, 0x0a, 0x01, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x01, 0x72, 0x12,
+	0x36, 0x0a, 0x01, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x01, 0x63, 0x12, 0x4f, 0x0a, 0x0e, 0x62, 0x6f, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x62, 0x6f, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x4b, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f,
+	0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79,
+	0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x56, 0x61, 0x72,
+	0x69, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xdf, 0x01, 0x0a, 0x12, 0x48, 0x61, 0x6c, 0x76, 0x69, 0x6e,
+	0x67, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x55, 0x0a, 0x11,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x10, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x68, 0x61, 0x6c, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x68,
+	0x61, 0x6c, 0x76, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x47,
+	0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x6d, 0x61,
+	0x78, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x22, 0xdb, 0x02, 0x0a, 0x17, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x42, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x0d, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x69, 0x6e, 0x12, 0x4d, 0x0a, 0x0d, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61,
+	0x78, 0x12, 0x4f, 0x0a, 0x0e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x12, 0x51, 0x0a, 0x0f, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x61, 0x74, 0x65, 0x2a, 0xba, 0x01, 0x0a, 0x16, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x28, 0x0a, 0x24, 0x44, 0x49, 0x53, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x44, 0x49,
+	0x53, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x55, 0x4c, 0x45, 0x10, 0x01, 0x12,
+	0x24, 0x0a, 0x20, 0x44, 0x49, 0x53, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x52,
+	0x45, 0x53, 0x53, 0x10, 0x02, 0x12, 0x25, 0x0a, 0x21, 0x44, 0x49, 0x53, 0x54, 0x52, 0x49, 0x42,
+	0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x41, 0x43, 0x54, 0x10, 0x03, 0x1a, 0x04, 0x88, 0xa3,
+	0x1e, 0x00, 0x2a, 0x77, 0x0a, 0x0e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x12, 0x1f, 0x0a, 0x1b, 0x49, 0x4e, 0x46, 0x4c, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54,
+	0x49, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x49, 0x4e, 0x46, 0x4c, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x5f, 0x48, 0x41, 0x4c, 0x56, 0x49, 0x4e, 0x47,
+	0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x49, 0x4e, 0x46, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x42, 0x4f, 0x4e,
+	0x44, 0x45, 0x44, 0x10, 0x02, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xc3, 0x01, 0x0a, 0x16,
+	0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x0e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x76, 0x6d, 0x6f, 0x73,
+	0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x69, 0x6e,
+	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x49, 0x58, 0xaa,
+	0x02, 0x12, 0x45, 0x76, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x12, 0x45, 0x76, 0x6d, 0x6f, 0x73, 0x5c, 0x49, 0x6e, 0x66,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1e, 0x45, 0x76, 0x6d, 0x6f,
+	0x73, 0x5c, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x45, 0x76, 0x6d,
+	0x6f, 0x73, 0x3a, 0x3a, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x56,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1474,17 +3593,25 @@ func file_aizel_inflation_v1_inflation_proto_rawDescGZIP() []byte {
 	return file_aizel_inflation_v1_inflation_proto_rawDescData
 }
 
-var file_aizel_inflation_v1_inflation_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_aizel_inflation_v1_inflation_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_aizel_inflation_v1_inflation_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_aizel_inflation_v1_inflation_proto_goTypes = []interface{}{
-	(*InflationDistribution)(nil),  // 0: aizel.inflation.v1.InflationDistribution
-	(*ExponentialCalculation)(nil), // 1: aizel.inflation.v1.ExponentialCalculation
+	(DistributionTargetType)(0),     // 0: aizel.inflation.v1.DistributionTargetType
+	(InflationModel)(0),             // 1: aizel.inflation.v1.InflationModel
+	(*InflationDistribution)(nil),   // 2: aizel.inflation.v1.InflationDistribution
+	(*DistributionTarget)(nil),      // 3: aizel.inflation.v1.DistributionTarget
+	(*ExponentialCalculation)(nil),  // 4: aizel.inflation.v1.ExponentialCalculation
+	(*HalvingCalculation)(nil),      // 5: aizel.inflation.v1.HalvingCalculation
+	(*TargetBondedCalculation)(nil), // 6: aizel.inflation.v1.TargetBondedCalculation
 }
 var file_aizel_inflation_v1_inflation_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	3, // 0: aizel.inflation.v1.InflationDistribution.targets:type_name -> aizel.inflation.v1.DistributionTarget
+	0, // 1: aizel.inflation.v1.DistributionTarget.target_type:type_name -> aizel.inflation.v1.DistributionTargetType
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_aizel_inflation_v1_inflation_proto_init() }
@@ -1506,6 +3633,18 @@ func file_aizel_inflation_v1_inflation_proto_init() {
 			}
 		}
 		file_aizel_inflation_v1_inflation_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DistributionTarget); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aizel_inflation_v1_inflation_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ExponentialCalculation); i {
 			case 0:
 				return &v.state
@@ -1517,19 +3656,44 @@ func file_aizel_inflation_v1_inflation_proto_init() {
 				return nil
 			}
 		}
+		file_aizel_inflation_v1_inflation_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HalvingCalculation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aizel_inflation_v1_inflation_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TargetBondedCalculation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_aizel_inflation_v1_inflation_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      2,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_aizel_inflation_v1_inflation_proto_goTypes,
 		DependencyIndexes: file_aizel_inflation_v1_inflation_proto_depIdxs,
+		EnumInfos:         file_aizel_inflation_v1_inflation_proto_enumTypes,
 		MessageInfos:      file_aizel_inflation_v1_inflation_proto_msgTypes,
 	}.Build()
 	File_aizel_inflation_v1_inflation_proto = out.File
@@ -22,7 +22,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Balances_FullMethodName = "/aizel.vesting.v2.Query/Balances"
+	Query_Balances_FullMethodName       = "/aizel.vesting.v2.Query/Balances"
+	Query_Params_FullMethodName         = "/aizel.vesting.v2.Query/Params"
+	Query_UnlockSchedule_FullMethodName = "/aizel.vesting.v2.Query/UnlockSchedule"
+	Query_NextUnlock_FullMethodName     = "/aizel.vesting.v2.Query/NextUnlock"
+	Query_UnvestedSupply_FullMethodName = "/aizel.vesting.v2.Query/UnvestedSupply"
 )
 
 // QueryClient is the client API for Query service.
@@ -31,6 +35,17 @@ const (
 type QueryClient interface {
 	// Balances retrieves the unvested, vested and locked tokens for a vesting account
 	Balances(ctx context.Context, in *QueryBalancesRequest, opts ...grpc.CallOption) (*QueryBalancesResponse, error)
+	// Params retrieves the vesting module params
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// UnlockSchedule retrieves the full vesting and unlocking timeline of a
+	// vesting account
+	UnlockSchedule(ctx context.Context, in *QueryUnlockScheduleRequest, opts ...grpc.CallOption) (*QueryUnlockScheduleResponse, error)
+	// NextUnlock retrieves the next time at which tokens of a vesting account
+	// become spendable
+	NextUnlock(ctx context.Context, in *QueryNextUnlockRequest, opts ...grpc.CallOption) (*QueryNextUnlockResponse, error)
+	// UnvestedSupply retrieves the aggregate amount of unvested and locked tokens
+	// of a page of clawback vesting accounts
+	UnvestedSupply(ctx context.Context, in *QueryUnvestedSupplyRequest, opts ...grpc.CallOption) (*QueryUnvestedSupplyResponse, error)
 }
 
 type queryClient struct {
@@ -50,12 +65,59 @@ func (c *queryClient) Balances(ctx context.Context, in *QueryBalancesRequest, op
 	return out, nil
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnlockSchedule(ctx context.Context, in *QueryUnlockScheduleRequest, opts ...grpc.CallOption) (*QueryUnlockScheduleResponse, error) {
+	out := new(QueryUnlockScheduleResponse)
+	err := c.cc.Invoke(ctx, Query_UnlockSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NextUnlock(ctx context.Context, in *QueryNextUnlockRequest, opts ...grpc.CallOption) (*QueryNextUnlockResponse, error) {
+	out := new(QueryNextUnlockResponse)
+	err := c.cc.Invoke(ctx, Query_NextUnlock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnvestedSupply(ctx context.Context, in *QueryUnvestedSupplyRequest, opts ...grpc.CallOption) (*QueryUnvestedSupplyResponse, error) {
+	out := new(QueryUnvestedSupplyResponse)
+	err := c.cc.Invoke(ctx, Query_UnvestedSupply_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
 type QueryServer interface {
 	// Balances retrieves the unvested, vested and locked tokens for a vesting account
 	Balances(context.Context, *QueryBalancesRequest) (*QueryBalancesResponse, error)
+	// Params retrieves the vesting module params
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// UnlockSchedule retrieves the full vesting and unlocking timeline of a
+	// vesting account
+	UnlockSchedule(context.Context, *QueryUnlockScheduleRequest) (*QueryUnlockScheduleResponse, error)
+	// NextUnlock retrieves the next time at which tokens of a vesting account
+	// become spendable
+	NextUnlock(context.Context, *QueryNextUnlockRequest) (*QueryNextUnlockResponse, error)
+	// UnvestedSupply retrieves the aggregate amount of unvested and locked tokens
+	// of a page of clawback vesting accounts
+	UnvestedSupply(context.Context, *QueryUnvestedSupplyRequest) (*QueryUnvestedSupplyResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -66,6 +128,18 @@ type UnimplementedQueryServer struct {
 func (UnimplementedQueryServer) Balances(context.Context, *QueryBalancesRequest) (*QueryBalancesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Balances not implemented")
 }
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) UnlockSchedule(context.Context, *QueryUnlockScheduleRequest) (*QueryUnlockScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockSchedule not implemented")
+}
+func (UnimplementedQueryServer) NextUnlock(context.Context, *QueryNextUnlockRequest) (*QueryNextUnlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextUnlock not implemented")
+}
+func (UnimplementedQueryServer) UnvestedSupply(context.Context, *QueryUnvestedSupplyRequest) (*QueryUnvestedSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnvestedSupply not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -97,6 +171,78 @@ func _Query_Balances_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnlockSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnlockScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnlockSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_UnlockSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnlockSchedule(ctx, req.(*QueryUnlockScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NextUnlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextUnlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NextUnlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_NextUnlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NextUnlock(ctx, req.(*QueryNextUnlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnvestedSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnvestedSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnvestedSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_UnvestedSupply_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnvestedSupply(ctx, req.(*QueryUnvestedSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -108,6 +254,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Balances",
 			Handler:    _Query_Balances_Handler,
 		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "UnlockSchedule",
+			Handler:    _Query_UnlockSchedule_Handler,
+		},
+		{
+			MethodName: "NextUnlock",
+			Handler:    _Query_NextUnlock_Handler,
+		},
+		{
+			MethodName: "UnvestedSupply",
+			Handler:    _Query_UnvestedSupply_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "aizel/vesting/v2/query.proto",
@@ -22,11 +22,14 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_CreateClawbackVestingAccount_FullMethodName = "/aizel.vesting.v2.Msg/CreateClawbackVestingAccount"
-	Msg_FundVestingAccount_FullMethodName           = "/aizel.vesting.v2.Msg/FundVestingAccount"
-	Msg_Clawback_FullMethodName                     = "/aizel.vesting.v2.Msg/Clawback"
-	Msg_UpdateVestingFunder_FullMethodName          = "/aizel.vesting.v2.Msg/UpdateVestingFunder"
-	Msg_ConvertVestingAccount_FullMethodName        = "/aizel.vesting.v2.Msg/ConvertVestingAccount"
+	Msg_CreateClawbackVestingAccount_FullMethodName   = "/aizel.vesting.v2.Msg/CreateClawbackVestingAccount"
+	Msg_FundVestingAccount_FullMethodName             = "/aizel.vesting.v2.Msg/FundVestingAccount"
+	Msg_Clawback_FullMethodName                       = "/aizel.vesting.v2.Msg/Clawback"
+	Msg_UpdateVestingFunder_FullMethodName            = "/aizel.vesting.v2.Msg/UpdateVestingFunder"
+	Msg_ConvertVestingAccount_FullMethodName          = "/aizel.vesting.v2.Msg/ConvertVestingAccount"
+	Msg_FundVestingAccountWithTemplate_FullMethodName = "/aizel.vesting.v2.Msg/FundVestingAccountWithTemplate"
+	Msg_SetUnvestedStaking_FullMethodName             = "/aizel.vesting.v2.Msg/SetUnvestedStaking"
+	Msg_UpdateParams_FullMethodName                   = "/aizel.vesting.v2.Msg/UpdateParams"
 )
 
 // MsgClient is the client API for Msg service.
@@ -45,6 +48,15 @@ type MsgClient interface {
 	UpdateVestingFunder(ctx context.Context, in *MsgUpdateVestingFunder, opts ...grpc.CallOption) (*MsgUpdateVestingFunderResponse, error)
 	// ConvertVestingAccount converts a ClawbackVestingAccount to an Eth account
 	ConvertVestingAccount(ctx context.Context, in *MsgConvertVestingAccount, opts ...grpc.CallOption) (*MsgConvertVestingAccountResponse, error)
+	// FundVestingAccountWithTemplate funds an existing ClawbackVestingAccount with
+	// tokens according to the schedule of a vesting template.
+	FundVestingAccountWithTemplate(ctx context.Context, in *MsgFundVestingAccountWithTemplate, opts ...grpc.CallOption) (*MsgFundVestingAccountWithTemplateResponse, error)
+	// SetUnvestedStaking enables or disables the delegation of unvested coins of
+	// an existing ClawbackVestingAccount.
+	SetUnvestedStaking(ctx context.Context, in *MsgSetUnvestedStaking, opts ...grpc.CallOption) (*MsgSetUnvestedStakingResponse, error)
+	// UpdateParams defines a governance operation for updating the x/vesting module parameters.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -100,6 +112,33 @@ func (c *msgClient) ConvertVestingAccount(ctx context.Context, in *MsgConvertVes
 	return out, nil
 }
 
+func (c *msgClient) FundVestingAccountWithTemplate(ctx context.Context, in *MsgFundVestingAccountWithTemplate, opts ...grpc.CallOption) (*MsgFundVestingAccountWithTemplateResponse, error) {
+	out := new(MsgFundVestingAccountWithTemplateResponse)
+	err := c.cc.Invoke(ctx, Msg_FundVestingAccountWithTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetUnvestedStaking(ctx context.Context, in *MsgSetUnvestedStaking, opts ...grpc.CallOption) (*MsgSetUnvestedStakingResponse, error) {
+	out := new(MsgSetUnvestedStakingResponse)
+	err := c.cc.Invoke(ctx, Msg_SetUnvestedStaking_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -116,6 +155,15 @@ type MsgServer interface {
 	UpdateVestingFunder(context.Context, *MsgUpdateVestingFunder) (*MsgUpdateVestingFunderResponse, error)
 	// ConvertVestingAccount converts a ClawbackVestingAccount to an Eth account
 	ConvertVestingAccount(context.Context, *MsgConvertVestingAccount) (*MsgConvertVestingAccountResponse, error)
+	// FundVestingAccountWithTemplate funds an existing ClawbackVestingAccount with
+	// tokens according to the schedule of a vesting template.
+	FundVestingAccountWithTemplate(context.Context, *MsgFundVestingAccountWithTemplate) (*MsgFundVestingAccountWithTemplateResponse, error)
+	// SetUnvestedStaking enables or disables the delegation of unvested coins of
+	// an existing ClawbackVestingAccount.
+	SetUnvestedStaking(context.Context, *MsgSetUnvestedStaking) (*MsgSetUnvestedStakingResponse, error)
+	// UpdateParams defines a governance operation for updating the x/vesting module parameters.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -138,6 +186,15 @@ func (UnimplementedMsgServer) UpdateVestingFunder(context.Context, *MsgUpdateVes
 func (UnimplementedMsgServer) ConvertVestingAccount(context.Context, *MsgConvertVestingAccount) (*MsgConvertVestingAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConvertVestingAccount not implemented")
 }
+func (UnimplementedMsgServer) FundVestingAccountWithTemplate(context.Context, *MsgFundVestingAccountWithTemplate) (*MsgFundVestingAccountWithTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundVestingAccountWithTemplate not implemented")
+}
+func (UnimplementedMsgServer) SetUnvestedStaking(context.Context, *MsgSetUnvestedStaking) (*MsgSetUnvestedStakingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUnvestedStaking not implemented")
+}
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -241,6 +298,60 @@ func _Msg_ConvertVestingAccount_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_FundVestingAccountWithTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundVestingAccountWithTemplate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundVestingAccountWithTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_FundVestingAccountWithTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundVestingAccountWithTemplate(ctx, req.(*MsgFundVestingAccountWithTemplate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetUnvestedStaking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetUnvestedStaking)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetUnvestedStaking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetUnvestedStaking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetUnvestedStaking(ctx, req.(*MsgSetUnvestedStaking))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -268,6 +379,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ConvertVestingAccount",
 			Handler:    _Msg_ConvertVestingAccount_Handler,
 		},
+		{
+			MethodName: "FundVestingAccountWithTemplate",
+			Handler:    _Msg_FundVestingAccountWithTemplate_Handler,
+		},
+		{
+			MethodName: "SetUnvestedStaking",
+			Handler:    _Msg_SetUnvestedStaking_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "aizel/vesting/v2/tx.proto",
@@ -14,6 +14,52 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Params_16_list)(nil)
+
+type _Params_16_list struct {
+	list *[]string
+}
+
+func (x *_Params_16_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_16_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_16_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_16_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_16_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field FeeDenoms as it is not of Message kind"))
+}
+
+func (x *_Params_16_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_16_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_16_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_Params                             protoreflect.MessageDescriptor
 	fd_Params_no_base_fee                 protoreflect.FieldDescriptor
@@ -23,6 +69,15 @@ var (
 	fd_Params_base_fee                    protoreflect.FieldDescriptor
 	fd_Params_min_gas_price               protoreflect.FieldDescriptor
 	fd_Params_min_gas_multiplier          protoreflect.FieldDescriptor
+	fd_Params_base_fee_algorithm          protoreflect.FieldDescriptor
+	fd_Params_window_size                 protoreflect.FieldDescriptor
+	fd_Params_min_base_fee                protoreflect.FieldDescriptor
+	fd_Params_max_base_fee                protoreflect.FieldDescriptor
+	fd_Params_aimd                        protoreflect.FieldDescriptor
+	fd_Params_history_size                protoreflect.FieldDescriptor
+	fd_Params_cosmos_block_gas_share      protoreflect.FieldDescriptor
+	fd_Params_fee_denoms                  protoreflect.FieldDescriptor
+	fd_Params_max_price_age               protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -35,6 +90,15 @@ func init() {
 	fd_Params_base_fee = md_Params.Fields().ByName("base_fee")
 	fd_Params_min_gas_price = md_Params.Fields().ByName("min_gas_price")
 	fd_Params_min_gas_multiplier = md_Params.Fields().ByName("min_gas_multiplier")
+	fd_Params_base_fee_algorithm = md_Params.Fields().ByName("base_fee_algorithm")
+	fd_Params_window_size = md_Params.Fields().ByName("window_size")
+	fd_Params_min_base_fee = md_Params.Fields().ByName("min_base_fee")
+	fd_Params_max_base_fee = md_Params.Fields().ByName("max_base_fee")
+	fd_Params_aimd = md_Params.Fields().ByName("aimd")
+	fd_Params_history_size = md_Params.Fields().ByName("history_size")
+	fd_Params_cosmos_block_gas_share = md_Params.Fields().ByName("cosmos_block_gas_share")
+	fd_Params_fee_denoms = md_Params.Fields().ByName("fee_denoms")
+	fd_Params_max_price_age = md_Params.Fields().ByName("max_price_age")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -144,6 +208,60 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.BaseFeeAlgorithm != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.BaseFeeAlgorithm))
+		if !f(fd_Params_base_fee_algorithm, value) {
+			return
+		}
+	}
+	if x.WindowSize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.WindowSize)
+		if !f(fd_Params_window_size, value) {
+			return
+		}
+	}
+	if x.MinBaseFee != "" {
+		value := protoreflect.ValueOfString(x.MinBaseFee)
+		if !f(fd_Params_min_base_fee, value) {
+			return
+		}
+	}
+	if x.MaxBaseFee != "" {
+		value := protoreflect.ValueOfString(x.MaxBaseFee)
+		if !f(fd_Params_max_base_fee, value) {
+			return
+		}
+	}
+	if x.Aimd != nil {
+		value := protoreflect.ValueOfMessage(x.Aimd.ProtoReflect())
+		if !f(fd_Params_aimd, value) {
+			return
+		}
+	}
+	if x.HistorySize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.HistorySize)
+		if !f(fd_Params_history_size, value) {
+			return
+		}
+	}
+	if x.CosmosBlockGasShare != "" {
+		value := protoreflect.ValueOfString(x.CosmosBlockGasShare)
+		if !f(fd_Params_cosmos_block_gas_share, value) {
+			return
+		}
+	}
+	if len(x.FeeDenoms) != 0 {
+		value := protoreflect.ValueOfList(&_Params_16_list{list: &x.FeeDenoms})
+		if !f(fd_Params_fee_denoms, value) {
+			return
+		}
+	}
+	if x.MaxPriceAge != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxPriceAge)
+		if !f(fd_Params_max_price_age, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -173,6 +291,24 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.MinGasPrice != ""
 	case "ethermint.feemarket.v1.Params.min_gas_multiplier":
 		return x.MinGasMultiplier != ""
+	case "ethermint.feemarket.v1.Params.base_fee_algorithm":
+		return x.BaseFeeAlgorithm != 0
+	case "ethermint.feemarket.v1.Params.window_size":
+		return x.WindowSize != uint64(0)
+	case "ethermint.feemarket.v1.Params.min_base_fee":
+		return x.MinBaseFee != ""
+	case "ethermint.feemarket.v1.Params.max_base_fee":
+		return x.MaxBaseFee != ""
+	case "ethermint.feemarket.v1.Params.aimd":
+		return x.Aimd != nil
+	case "ethermint.feemarket.v1.Params.history_size":
+		return x.HistorySize != uint64(0)
+	case "ethermint.feemarket.v1.Params.cosmos_block_gas_share":
+		return x.CosmosBlockGasShare != ""
+	case "ethermint.feemarket.v1.Params.fee_denoms":
+		return len(x.FeeDenoms) != 0
+	case "ethermint.feemarket.v1.Params.max_price_age":
+		return x.MaxPriceAge != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.Params"))
@@ -203,6 +339,24 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.MinGasPrice = ""
 	case "ethermint.feemarket.v1.Params.min_gas_multiplier":
 		x.MinGasMultiplier = ""
+	case "ethermint.feemarket.v1.Params.base_fee_algorithm":
+		x.BaseFeeAlgorithm = 0
+	case "ethermint.feemarket.v1.Params.window_size":
+		x.WindowSize = uint64(0)
+	case "ethermint.feemarket.v1.Params.min_base_fee":
+		x.MinBaseFee = ""
+	case "ethermint.feemarket.v1.Params.max_base_fee":
+		x.MaxBaseFee = ""
+	case "ethermint.feemarket.v1.Params.aimd":
+		x.Aimd = nil
+	case "ethermint.feemarket.v1.Params.history_size":
+		x.HistorySize = uint64(0)
+	case "ethermint.feemarket.v1.Params.cosmos_block_gas_share":
+		x.CosmosBlockGasShare = ""
+	case "ethermint.feemarket.v1.Params.fee_denoms":
+		x.FeeDenoms = nil
+	case "ethermint.feemarket.v1.Params.max_price_age":
+		x.MaxPriceAge = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.Params"))
@@ -240,6 +394,36 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "ethermint.feemarket.v1.Params.min_gas_multiplier":
 		value := x.MinGasMultiplier
 		return protoreflect.ValueOfString(value)
+	case "ethermint.feemarket.v1.Params.base_fee_algorithm":
+		value := x.BaseFeeAlgorithm
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "ethermint.feemarket.v1.Params.window_size":
+		value := x.WindowSize
+		return protoreflect.ValueOfUint64(value)
+	case "ethermint.feemarket.v1.Params.min_base_fee":
+		value := x.MinBaseFee
+		return protoreflect.ValueOfString(value)
+	case "ethermint.feemarket.v1.Params.max_base_fee":
+		value := x.MaxBaseFee
+		return protoreflect.ValueOfString(value)
+	case "ethermint.feemarket.v1.Params.aimd":
+		value := x.Aimd
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ethermint.feemarket.v1.Params.history_size":
+		value := x.HistorySize
+		return protoreflect.ValueOfUint64(value)
+	case "ethermint.feemarket.v1.Params.cosmos_block_gas_share":
+		value := x.CosmosBlockGasShare
+		return protoreflect.ValueOfString(value)
+	case "ethermint.feemarket.v1.Params.fee_denoms":
+		if len(x.FeeDenoms) == 0 {
+			return protoreflect.ValueOfList(&_Params_16_list{})
+		}
+		listValue := &_Params_16_list{list: &x.FeeDenoms}
+		return protoreflect.ValueOfList(listValue)
+	case "ethermint.feemarket.v1.Params.max_price_age":
+		value := x.MaxPriceAge
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.Params"))
@@ -274,6 +458,26 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.MinGasPrice = value.Interface().(string)
 	case "ethermint.feemarket.v1.Params.min_gas_multiplier":
 		x.MinGasMultiplier = value.Interface().(string)
+	case "ethermint.feemarket.v1.Params.base_fee_algorithm":
+		x.BaseFeeAlgorithm = (BaseFeeAlgorithm)(value.Enum())
+	case "ethermint.feemarket.v1.Params.window_size":
+		x.WindowSize = value.Uint()
+	case "ethermint.feemarket.v1.Params.min_base_fee":
+		x.MinBaseFee = value.Interface().(string)
+	case "ethermint.feemarket.v1.Params.max_base_fee":
+		x.MaxBaseFee = value.Interface().(string)
+	case "ethermint.feemarket.v1.Params.aimd":
+		x.Aimd = value.Message().Interface().(*AIMDParams)
+	case "ethermint.feemarket.v1.Params.history_size":
+		x.HistorySize = value.Uint()
+	case "ethermint.feemarket.v1.Params.cosmos_block_gas_share":
+		x.CosmosBlockGasShare = value.Interface().(string)
+	case "ethermint.feemarket.v1.Params.fee_denoms":
+		lv := value.List()
+		clv := lv.(*_Params_16_list)
+		x.FeeDenoms = *clv.list
+	case "ethermint.feemarket.v1.Params.max_price_age":
+		x.MaxPriceAge = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.Params"))
@@ -294,6 +498,17 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "ethermint.feemarket.v1.Params.aimd":
+		if x.Aimd == nil {
+			x.Aimd = new(AIMDParams)
+		}
+		return protoreflect.ValueOfMessage(x.Aimd.ProtoReflect())
+	case "ethermint.feemarket.v1.Params.fee_denoms":
+		if x.FeeDenoms == nil {
+			x.FeeDenoms = []string{}
+		}
+		value := &_Params_16_list{list: &x.FeeDenoms}
+		return protoreflect.ValueOfList(value)
 	case "ethermint.feemarket.v1.Params.no_base_fee":
 		panic(fmt.Errorf("field no_base_fee of message ethermint.feemarket.v1.Params is not mutable"))
 	case "ethermint.feemarket.v1.Params.base_fee_change_denominator":
@@ -308,6 +523,20 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field min_gas_price of message ethermint.feemarket.v1.Params is not mutable"))
 	case "ethermint.feemarket.v1.Params.min_gas_multiplier":
 		panic(fmt.Errorf("field min_gas_multiplier of message ethermint.feemarket.v1.Params is not mutable"))
+	case "ethermint.feemarket.v1.Params.base_fee_algorithm":
+		panic(fmt.Errorf("field base_fee_algorithm of message ethermint.feemarket.v1.Params is not mutable"))
+	case "ethermint.feemarket.v1.Params.window_size":
+		panic(fmt.Errorf("field window_size of message ethermint.feemarket.v1.Params is not mutable"))
+	case "ethermint.feemarket.v1.Params.min_base_fee":
+		panic(fmt.Errorf("field min_base_fee of message ethermint.feemarket.v1.Params is not mutable"))
+	case "ethermint.feemarket.v1.Params.max_base_fee":
+		panic(fmt.Errorf("field max_base_fee of message ethermint.feemarket.v1.Params is not mutable"))
+	case "ethermint.feemarket.v1.Params.history_size":
+		panic(fmt.Errorf("field history_size of message ethermint.feemarket.v1.Params is not mutable"))
+	case "ethermint.feemarket.v1.Params.cosmos_block_gas_share":
+		panic(fmt.Errorf("field cosmos_block_gas_share of message ethermint.feemarket.v1.Params is not mutable"))
+	case "ethermint.feemarket.v1.Params.max_price_age":
+		panic(fmt.Errorf("field max_price_age of message ethermint.feemarket.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.Params"))
@@ -335,6 +564,26 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfString("")
 	case "ethermint.feemarket.v1.Params.min_gas_multiplier":
 		return protoreflect.ValueOfString("")
+	case "ethermint.feemarket.v1.Params.base_fee_algorithm":
+		return protoreflect.ValueOfEnum(0)
+	case "ethermint.feemarket.v1.Params.window_size":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ethermint.feemarket.v1.Params.min_base_fee":
+		return protoreflect.ValueOfString("")
+	case "ethermint.feemarket.v1.Params.max_base_fee":
+		return protoreflect.ValueOfString("")
+	case "ethermint.feemarket.v1.Params.aimd":
+		m := new(AIMDParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "ethermint.feemarket.v1.Params.history_size":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ethermint.feemarket.v1.Params.cosmos_block_gas_share":
+		return protoreflect.ValueOfString("")
+	case "ethermint.feemarket.v1.Params.fee_denoms":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_16_list{list: &list})
+	case "ethermint.feemarket.v1.Params.max_price_age":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.Params"))
@@ -428,6 +677,40 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.BaseFeeAlgorithm != 0 {
+			n += 1 + runtime.Sov(uint64(x.BaseFeeAlgorithm))
+		}
+		if x.WindowSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.WindowSize))
+		}
+		l = len(x.MinBaseFee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxBaseFee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Aimd != nil {
+			l = options.Size(x.Aimd)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.HistorySize != 0 {
+			n += 1 + runtime.Sov(uint64(x.HistorySize))
+		}
+		l = len(x.CosmosBlockGasShare)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.FeeDenoms) > 0 {
+			for _, s := range x.FeeDenoms {
+				l = len(s)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MaxPriceAge != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxPriceAge))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -457,6 +740,74 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.MaxPriceAge != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxPriceAge))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x88
+		}
+		if len(x.FeeDenoms) > 0 {
+			for iNdEx := len(x.FeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.FeeDenoms[iNdEx])
+				copy(dAtA[i:], x.FeeDenoms[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FeeDenoms[iNdEx])))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x82
+			}
+		}
+		if len(x.CosmosBlockGasShare) > 0 {
+			i -= len(x.CosmosBlockGasShare)
+			copy(dAtA[i:], x.CosmosBlockGasShare)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CosmosBlockGasShare)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if x.HistorySize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.HistorySize))
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.Aimd != nil {
+			encoded, err := options.Marshal(x.Aimd)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if len(x.MaxBaseFee) > 0 {
+			i -= len(x.MaxBaseFee)
+			copy(dAtA[i:], x.MaxBaseFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxBaseFee)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if len(x.MinBaseFee) > 0 {
+			i -= len(x.MinBaseFee)
+			copy(dAtA[i:], x.MinBaseFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinBaseFee)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if x.WindowSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WindowSize))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.BaseFeeAlgorithm != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BaseFeeAlgorithm))
+			i--
+			dAtA[i] = 0x48
+		}
 		if len(x.MinGasMultiplier) > 0 {
 			i -= len(x.MinGasMultiplier)
 			copy(dAtA[i:], x.MinGasMultiplier)
@@ -725,22 +1076,2034 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				}
 				x.MinGasMultiplier = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseFeeAlgorithm", wireType)
+				}
+				x.BaseFeeAlgorithm = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BaseFeeAlgorithm |= BaseFeeAlgorithm(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WindowSize", wireType)
+				}
+				x.WindowSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.WindowSize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinBaseFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxBaseFee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxBaseFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Aimd", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Aimd == nil {
+					x.Aimd = &AIMDParams{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Aimd); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				iNdEx = postIndex
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HistorySize", wireType)
+				}
+				x.HistorySize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.HistorySize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CosmosBlockGasShare", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.CosmosBlockGasShare = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeDenoms", wireType)
 				}
-				iNdEx += skippy
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeDenoms = append(x.FeeDenoms, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 17:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAge", wireType)
+				}
+				x.MaxPriceAge = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxPriceAge |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_AIMDParams                   protoreflect.MessageDescriptor
+	fd_AIMDParams_alpha             protoreflect.FieldDescriptor
+	fd_AIMDParams_beta              protoreflect.FieldDescriptor
+	fd_AIMDParams_gamma             protoreflect.FieldDescriptor
+	fd_AIMDParams_min_learning_rate protoreflect.FieldDescriptor
+	fd_AIMDParams_max_learning_rate protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_ethermint_feemarket_v1_feemarket_proto_init()
+	md_AIMDParams = File_ethermint_feemarket_v1_feemarket_proto.Messages().ByName("AIMDParams")
+	fd_AIMDParams_alpha = md_AIMDParams.Fields().ByName("alpha")
+	fd_AIMDParams_beta = md_AIMDParams.Fields().ByName("beta")
+	fd_AIMDParams_gamma = md_AIMDParams.Fields().ByName("gamma")
+	fd_AIMDParams_min_learning_rate = md_AIMDParams.Fields().ByName("min_learning_rate")
+	fd_AIMDParams_max_learning_rate = md_AIMDParams.Fields().ByName("max_learning_rate")
+}
+
+var _ protoreflect.Message = (*fastReflection_AIMDParams)(nil)
+
+type fastReflection_AIMDParams AIMDParams
+
+func (x *AIMDParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AIMDParams)(x)
+}
+
+func (x *AIMDParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_ethermint_feemarket_v1_feemarket_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AIMDParams_messageType fastReflection_AIMDParams_messageType
+var _ protoreflect.MessageType = fastReflection_AIMDParams_messageType{}
+
+type fastReflection_AIMDParams_messageType struct{}
+
+func (x fastReflection_AIMDParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AIMDParams)(nil)
+}
+func (x fastReflection_AIMDParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_AIMDParams)
+}
+func (x fastReflection_AIMDParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AIMDParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AIMDParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_AIMDParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AIMDParams) Type() protoreflect.MessageType {
+	return _fastReflection_AIMDParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AIMDParams) New() protoreflect.Message {
+	return new(fastReflection_AIMDParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AIMDParams) Interface() protoreflect.ProtoMessage {
+	return (*AIMDParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AIMDParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Alpha != "" {
+		value := protoreflect.ValueOfString(x.Alpha)
+		if !f(fd_AIMDParams_alpha, value) {
+			return
+		}
+	}
+	if x.Beta != "" {
+		value := protoreflect.ValueOfString(x.Beta)
+		if !f(fd_AIMDParams_beta, value) {
+			return
+		}
+	}
+	if x.Gamma != "" {
+		value := protoreflect.ValueOfString(x.Gamma)
+		if !f(fd_AIMDParams_gamma, value) {
+			return
+		}
+	}
+	if x.MinLearningRate != "" {
+		value := protoreflect.ValueOfString(x.MinLearningRate)
+		if !f(fd_AIMDParams_min_learning_rate, value) {
+			return
+		}
+	}
+	if x.MaxLearningRate != "" {
+		value := protoreflect.ValueOfString(x.MaxLearningRate)
+		if !f(fd_AIMDParams_max_learning_rate, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AIMDParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "ethermint.feemarket.v1.AIMDParams.alpha":
+		return x.Alpha != ""
+	case "ethermint.feemarket.v1.AIMDParams.beta":
+		return x.Beta != ""
+	case "ethermint.feemarket.v1.AIMDParams.gamma":
+		return x.Gamma != ""
+	case "ethermint.feemarket.v1.AIMDParams.min_learning_rate":
+		return x.MinLearningRate != ""
+	case "ethermint.feemarket.v1.AIMDParams.max_learning_rate":
+		return x.MaxLearningRate != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.AIMDParams"))
+		}
+		panic(fmt.Errorf("message ethermint.feemarket.v1.AIMDParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AIMDParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "ethermint.feemarket.v1.AIMDParams.alpha":
+		x.Alpha = ""
+	case "ethermint.feemarket.v1.AIMDParams.beta":
+		x.Beta = ""
+	case "ethermint.feemarket.v1.AIMDParams.gamma":
+		x.Gamma = ""
+	case "ethermint.feemarket.v1.AIMDParams.min_learning_rate":
+		x.MinLearningRate = ""
+	case "ethermint.feemarket.v1.AIMDParams.max_learning_rate":
+		x.MaxLearningRate = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.AIMDParams"))
+		}
+		panic(fmt.Errorf("message ethermint.feemarket.v1.AIMDParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AIMDParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "ethermint.feemarket.v1.AIMDParams.alpha":
+		value := x.Alpha
+		return protoreflect.ValueOfString(value)
+	case "ethermint.feemarket.v1.AIMDParams.beta":
+		value := x.Beta
+		return protoreflect.ValueOfString(value)
+	case "ethermint.feemarket.v1.AIMDParams.gamma":
+		value := x.Gamma
+		return protoreflect.ValueOfString(value)
+	case "ethermint.feemarket.v1.AIMDParams.min_learning_rate":
+		value := x.MinLearningRate
+		return protoreflect.ValueOfString(value)
+	case "ethermint.feemarket.v1.AIMDParams.max_learning_rate":
+		value := x.MaxLearningRate
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.AIMDParams"))
+		}
+		panic(fmt.Errorf("message ethermint.feemarket.v1.AIMDParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AIMDParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "ethermint.feemarket.v1.AIMDParams.alpha":
+		x.Alpha = value.Interface().(string)
+	case "ethermint.feemarket.v1.AIMDParams.beta":
+		x.Beta = value.Interface().(string)
+	case "ethermint.feemarket.v1.AIMDParams.gamma":
+		x.Gamma = value.Interface().(string)
+	case "ethermint.feemarket.v1.AIMDParams.min_learning_rate":
+		x.MinLearningRate = value.Interface().(string)
+	case "ethermint.feemarket.v1.AIMDParams.max_learning_rate":
+		x.MaxLearningRate = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.AIMDParams"))
+		}
+		panic(fmt.Errorf("message ethermint.feemarket.v1.AIMDParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AIMDParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ethermint.feemarket.v1.AIMDParams.alpha":
+		panic(fmt.Errorf("field alpha of message ethermint.feemarket.v1.AIMDParams is not mutable"))
+	case "ethermint.feemarket.v1.AIMDParams.beta":
+		panic(fmt.Errorf("field beta of message ethermint.feemarket.v1.AIMDParams is not mutable"))
+	case "ethermint.feemarket.v1.AIMDParams.gamma":
+		panic(fmt.Errorf("field gamma of message ethermint.feemarket.v1.AIMDParams is not mutable"))
+	case "ethermint.feemarket.v1.AIMDParams.min_learning_rate":
+		panic(fmt.Errorf("field min_learning_rate of message ethermint.feemarket.v1.AIMDParams is not mutable"))
+	case "ethermint.feemarket.v1.AIMDParams.max_learning_rate":
+		panic(fmt.Errorf("field max_learning_rate of message ethermint.feemarket.v1.AIMDParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.AIMDParams"))
+		}
+		panic(fmt.Errorf("message ethermint.feemarket.v1.AIMDParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AIMDParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ethermint.feemarket.v1.AIMDParams.alpha":
+		return protoreflect.ValueOfString("")
+	case "ethermint.feemarket.v1.AIMDParams.beta":
+		return protoreflect.ValueOfString("")
+	case "ethermint.feemarket.v1.AIMDParams.gamma":
+		return protoreflect.ValueOfString("")
+	case "ethermint.feemarket.v1.AIMDParams.min_learning_rate":
+		return protoreflect.ValueOfString("")
+	case "ethermint.feemarket.v1.AIMDParams.max_learning_rate":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.AIMDParams"))
+		}
+		panic(fmt.Errorf("message ethermint.feemarket.v1.AIMDParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AIMDParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ethermint.feemarket.v1.AIMDParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AIMDParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AIMDParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AIMDParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AIMDParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AIMDParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Alpha)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Beta)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Gamma)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MinLearningRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxLearningRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AIMDParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MaxLearningRate) > 0 {
+			i -= len(x.MaxLearningRate)
+			copy(dAtA[i:], x.MaxLearningRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxLearningRate)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.MinLearningRate) > 0 {
+			i -= len(x.MinLearningRate)
+			copy(dAtA[i:], x.MinLearningRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinLearningRate)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Gamma) > 0 {
+			i -= len(x.Gamma)
+			copy(dAtA[i:], x.Gamma)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Gamma)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Beta) > 0 {
+			i -= len(x.Beta)
+			copy(dAtA[i:], x.Beta)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Beta)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Alpha) > 0 {
+			i -= len(x.Alpha)
+			copy(dAtA[i:], x.Alpha)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Alpha)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AIMDParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AIMDParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AIMDParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Alpha", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Alpha = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Beta", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Beta = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Gamma", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Gamma = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinLearningRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinLearningRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxLearningRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxLearningRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_BaseFeeRecord            protoreflect.MessageDescriptor
+	fd_BaseFeeRecord_height     protoreflect.FieldDescriptor
+	fd_BaseFeeRecord_base_fee   protoreflect.FieldDescriptor
+	fd_BaseFeeRecord_gas_wanted protoreflect.FieldDescriptor
+	fd_BaseFeeRecord_max_gas    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_ethermint_feemarket_v1_feemarket_proto_init()
+	md_BaseFeeRecord = File_ethermint_feemarket_v1_feemarket_proto.Messages().ByName("BaseFeeRecord")
+	fd_BaseFeeRecord_height = md_BaseFeeRecord.Fields().ByName("height")
+	fd_BaseFeeRecord_base_fee = md_BaseFeeRecord.Fields().ByName("base_fee")
+	fd_BaseFeeRecord_gas_wanted = md_BaseFeeRecord.Fields().ByName("gas_wanted")
+	fd_BaseFeeRecord_max_gas = md_BaseFeeRecord.Fields().ByName("max_gas")
+}
+
+var _ protoreflect.Message = (*fastReflection_BaseFeeRecord)(nil)
+
+type fastReflection_BaseFeeRecord BaseFeeRecord
+
+func (x *BaseFeeRecord) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BaseFeeRecord)(x)
+}
+
+func (x *BaseFeeRecord) slowProtoReflect() protoreflect.Message {
+	mi := &file_ethermint_feemarket_v1_feemarket_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BaseFeeRecord_messageType fastReflection_BaseFeeRecord_messageType
+var _ protoreflect.MessageType = fastReflection_BaseFeeRecord_messageType{}
+
+type fastReflection_BaseFeeRecord_messageType struct{}
+
+func (x fastReflection_BaseFeeRecord_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BaseFeeRecord)(nil)
+}
+func (x fastReflection_BaseFeeRecord_messageType) New() protoreflect.Message {
+	return new(fastReflection_BaseFeeRecord)
+}
+func (x fastReflection_BaseFeeRecord_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BaseFeeRecord
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BaseFeeRecord) Descriptor() protoreflect.MessageDescriptor {
+	return md_BaseFeeRecord
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BaseFeeRecord) Type() protoreflect.MessageType {
+	return _fastReflection_BaseFeeRecord_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BaseFeeRecord) New() protoreflect.Message {
+	return new(fastReflection_BaseFeeRecord)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BaseFeeRecord) Interface() protoreflect.ProtoMessage {
+	return (*BaseFeeRecord)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BaseFeeRecord) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_BaseFeeRecord_height, value) {
+			return
+		}
+	}
+	if x.BaseFee != "" {
+		value := protoreflect.ValueOfString(x.BaseFee)
+		if !f(fd_BaseFeeRecord_base_fee, value) {
+			return
+		}
+	}
+	if x.GasWanted != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasWanted)
+		if !f(fd_BaseFeeRecord_gas_wanted, value) {
+			return
+		}
+	}
+	if x.MaxGas != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MaxGas)
+		if !f(fd_BaseFeeRecord_max_gas, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BaseFeeRecord) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "ethermint.feemarket.v1.BaseFeeRecord.height":
+		return x.Height != int64(0)
+	case "ethermint.feemarket.v1.BaseFeeRecord.base_fee":
+		return x.BaseFee != ""
+	case "ethermint.feemarket.v1.BaseFeeRecord.gas_wanted":
+		return x.GasWanted != uint64(0)
+	case "ethermint.feemarket.v1.BaseFeeRecord.max_gas":
+		return x.MaxGas != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.BaseFeeRecord"))
+		}
+		panic(fmt.Errorf("message ethermint.feemarket.v1.BaseFeeRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BaseFeeRecord) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "ethermint.feemarket.v1.BaseFeeRecord.height":
+		x.Height = int64(0)
+	case "ethermint.feemarket.v1.BaseFeeRecord.base_fee":
+		x.BaseFee = ""
+	case "ethermint.feemarket.v1.BaseFeeRecord.gas_wanted":
+		x.GasWanted = uint64(0)
+	case "ethermint.feemarket.v1.BaseFeeRecord.max_gas":
+		x.MaxGas = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.BaseFeeRecord"))
+		}
+		panic(fmt.Errorf("message ethermint.feemarket.v1.BaseFeeRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BaseFeeRecord) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "ethermint.feemarket.v1.BaseFeeRecord.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "ethermint.feemarket.v1.BaseFeeRecord.base_fee":
+		value := x.BaseFee
+		return protoreflect.ValueOfString(value)
+	case "ethermint.feemarket.v1.BaseFeeRecord.gas_wanted":
+		value := x.GasWanted
+		return protoreflect.ValueOfUint64(value)
+	case "ethermint.feemarket.v1.BaseFeeRecord.max_gas":
+		value := x.MaxGas
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.BaseFeeRecord"))
+		}
+		panic(fmt.Errorf("message ethermint.feemarket.v1.BaseFeeRecord does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BaseFeeRecord) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "ethermint.feemarket.v1.BaseFeeRecord.height":
+		x.Height = value.Int()
+	case "ethermint.feemarket.v1.BaseFeeRecord.base_fee":
+		x.BaseFee = value.Interface().(string)
+	case "ethermint.feemarket.v1.BaseFeeRecord.gas_wanted":
+		x.GasWanted = value.Uint()
+	case "ethermint.feemarket.v1.BaseFeeRecord.max_gas":
+		x.MaxGas = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.BaseFeeRecord"))
+		}
+		panic(fmt.Errorf("message ethermint.feemarket.v1.BaseFeeRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BaseFeeRecord) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ethermint.feemarket.v1.BaseFeeRecord.height":
+		panic(fmt.Errorf("field height of message ethermint.feemarket.v1.BaseFeeRecord is not mutable"))
+	case "ethermint.feemarket.v1.BaseFeeRecord.base_fee":
+		panic(fmt.Errorf("field base_fee of message ethermint.feemarket.v1.BaseFeeRecord is not mutable"))
+	case "ethermint.feemarket.v1.BaseFeeRecord.gas_wanted":
+		panic(fmt.Errorf("field gas_wanted of message ethermint.feemarket.v1.BaseFeeRecord is not mutable"))
+	case "ethermint.feemarket.v1.BaseFeeRecord.max_gas":
+		panic(fmt.Errorf("field max_gas of message ethermint.feemarket.v1.BaseFeeRecord is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.BaseFeeRecord"))
+		}
+		panic(fmt.Errorf("message ethermint.feemarket.v1.BaseFeeRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BaseFeeRecord) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ethermint.feemarket.v1.BaseFeeRecord.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "ethermint.feemarket.v1.BaseFeeRecord.base_fee":
+		return protoreflect.ValueOfString("")
+	case "ethermint.feemarket.v1.BaseFeeRecord.gas_wanted":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ethermint.feemarket.v1.BaseFeeRecord.max_gas":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.BaseFeeRecord"))
+		}
+		panic(fmt.Errorf("message ethermint.feemarket.v1.BaseFeeRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BaseFeeRecord) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ethermint.feemarket.v1.BaseFeeRecord", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BaseFeeRecord) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BaseFeeRecord) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BaseFeeRecord) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BaseFeeRecord) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BaseFeeRecord)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		l = len(x.BaseFee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.GasWanted != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasWanted))
+		}
+		if x.MaxGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxGas))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BaseFeeRecord)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MaxGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxGas))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.GasWanted != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasWanted))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.BaseFee) > 0 {
+			i -= len(x.BaseFee)
+			copy(dAtA[i:], x.BaseFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BaseFee)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BaseFeeRecord)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BaseFeeRecord: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BaseFeeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BaseFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasWanted", wireType)
+				}
+				x.GasWanted = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasWanted |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxGas", wireType)
+				}
+				x.MaxGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxGas |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_FeeDenomPrice             protoreflect.MessageDescriptor
+	fd_FeeDenomPrice_denom       protoreflect.FieldDescriptor
+	fd_FeeDenomPrice_price       protoreflect.FieldDescriptor
+	fd_FeeDenomPrice_update_time protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_ethermint_feemarket_v1_feemarket_proto_init()
+	md_FeeDenomPrice = File_ethermint_feemarket_v1_feemarket_proto.Messages().ByName("FeeDenomPrice")
+	fd_FeeDenomPrice_denom = md_FeeDenomPrice.Fields().ByName("denom")
+	fd_FeeDenomPrice_price = md_FeeDenomPrice.Fields().ByName("price")
+	fd_FeeDenomPrice_update_time = md_FeeDenomPrice.Fields().ByName("update_time")
+}
+
+var _ protoreflect.Message = (*fastReflection_FeeDenomPrice)(nil)
+
+type fastReflection_FeeDenomPrice FeeDenomPrice
+
+func (x *FeeDenomPrice) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeeDenomPrice)(x)
+}
+
+func (x *FeeDenomPrice) slowProtoReflect() protoreflect.Message {
+	mi := &file_ethermint_feemarket_v1_feemarket_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FeeDenomPrice_messageType fastReflection_FeeDenomPrice_messageType
+var _ protoreflect.MessageType = fastReflection_FeeDenomPrice_messageType{}
+
+type fastReflection_FeeDenomPrice_messageType struct{}
+
+func (x fastReflection_FeeDenomPrice_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeeDenomPrice)(nil)
+}
+func (x fastReflection_FeeDenomPrice_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeeDenomPrice)
+}
+func (x fastReflection_FeeDenomPrice_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeDenomPrice
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FeeDenomPrice) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeDenomPrice
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FeeDenomPrice) Type() protoreflect.MessageType {
+	return _fastReflection_FeeDenomPrice_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FeeDenomPrice) New() protoreflect.Message {
+	return new(fastReflection_FeeDenomPrice)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FeeDenomPrice) Interface() protoreflect.ProtoMessage {
+	return (*FeeDenomPrice)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FeeDenomPrice) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_FeeDenomPrice_denom, value) {
+			return
+		}
+	}
+	if x.Price != "" {
+		value := protoreflect.ValueOfString(x.Price)
+		if !f(fd_FeeDenomPrice_price, value) {
+			return
+		}
+	}
+	if x.UpdateTime != int64(0) {
+		value := protoreflect.ValueOfInt64(x.UpdateTime)
+		if !f(fd_FeeDenomPrice_update_time, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FeeDenomPrice) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "ethermint.feemarket.v1.FeeDenomPrice.denom":
+		return x.Denom != ""
+	case "ethermint.feemarket.v1.FeeDenomPrice.price":
+		return x.Price != ""
+	case "ethermint.feemarket.v1.FeeDenomPrice.update_time":
+		return x.UpdateTime != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.FeeDenomPrice"))
+		}
+		panic(fmt.Errorf("message ethermint.feemarket.v1.FeeDenomPrice does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeDenomPrice) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "ethermint.feemarket.v1.FeeDenomPrice.denom":
+		x.Denom = ""
+	case "ethermint.feemarket.v1.FeeDenomPrice.price":
+		x.Price = ""
+	case "ethermint.feemarket.v1.FeeDenomPrice.update_time":
+		x.UpdateTime = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.FeeDenomPrice"))
+		}
+		panic(fmt.Errorf("message ethermint.feemarket.v1.FeeDenomPrice does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FeeDenomPrice) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "ethermint.feemarket.v1.FeeDenomPrice.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "ethermint.feemarket.v1.FeeDenomPrice.price":
+		value := x.Price
+		return protoreflect.ValueOfString(value)
+	case "ethermint.feemarket.v1.FeeDenomPrice.update_time":
+		value := x.UpdateTime
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.FeeDenomPrice"))
+		}
+		panic(fmt.Errorf("message ethermint.feemarket.v1.FeeDenomPrice does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeDenomPrice) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "ethermint.feemarket.v1.FeeDenomPrice.denom":
+		x.Denom = value.Interface().(string)
+	case "ethermint.feemarket.v1.FeeDenomPrice.price":
+		x.Price = value.Interface().(string)
+	case "ethermint.feemarket.v1.FeeDenomPrice.update_time":
+		x.UpdateTime = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.FeeDenomPrice"))
+		}
+		panic(fmt.Errorf("message ethermint.feemarket.v1.FeeDenomPrice does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeDenomPrice) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ethermint.feemarket.v1.FeeDenomPrice.denom":
+		panic(fmt.Errorf("field denom of message ethermint.feemarket.v1.FeeDenomPrice is not mutable"))
+	case "ethermint.feemarket.v1.FeeDenomPrice.price":
+		panic(fmt.Errorf("field price of message ethermint.feemarket.v1.FeeDenomPrice is not mutable"))
+	case "ethermint.feemarket.v1.FeeDenomPrice.update_time":
+		panic(fmt.Errorf("field update_time of message ethermint.feemarket.v1.FeeDenomPrice is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.FeeDenomPrice"))
+		}
+		panic(fmt.Errorf("message ethermint.feemarket.v1.FeeDenomPrice does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FeeDenomPrice) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ethermint.feemarket.v1.FeeDenomPrice.denom":
+		return protoreflect.ValueOfString("")
+	case "ethermint.feemarket.v1.FeeDenomPrice.price":
+		return protoreflect.ValueOfString("")
+	case "ethermint.feemarket.v1.FeeDenomPrice.update_time":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.FeeDenomPrice"))
+		}
+		panic(fmt.Errorf("message ethermint.feemarket.v1.FeeDenomPrice does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FeeDenomPrice) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ethermint.feemarket.v1.FeeDenomPrice", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FeeDenomPrice) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeDenomPrice) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FeeDenomPrice) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FeeDenomPrice) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FeeDenomPrice)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Price)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.UpdateTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.UpdateTime))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FeeDenomPrice)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.UpdateTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UpdateTime))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Price) > 0 {
+			i -= len(x.Price)
+			copy(dAtA[i:], x.Price)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Price)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FeeDenomPrice)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeDenomPrice: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeDenomPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Price = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
+				}
+				x.UpdateTime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.UpdateTime |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
 			}
 		}
 
@@ -776,6 +3139,63 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// BaseFeeAlgorithm defines the algorithm used to adjust the base fee.
+type BaseFeeAlgorithm int32
+
+const (
+	// BASE_FEE_ALGORITHM_EIP1559 adjusts the base fee following EIP-1559 using
+	// the gas of the parent block.
+	BaseFeeAlgorithm_BASE_FEE_ALGORITHM_EIP1559 BaseFeeAlgorithm = 0
+	// BASE_FEE_ALGORITHM_AIMD adjusts the base fee exponentially with a learning
+	// rate that increases additively when the block gas is far from the target
+	// and decreases multiplicatively otherwise.
+	BaseFeeAlgorithm_BASE_FEE_ALGORITHM_AIMD BaseFeeAlgorithm = 1
+	// BASE_FEE_ALGORITHM_MOVING_WINDOW adjusts the base fee following EIP-1559
+	// using the average gas of the last window_size blocks.
+	BaseFeeAlgorithm_BASE_FEE_ALGORITHM_MOVING_WINDOW BaseFeeAlgorithm = 2
+)
+
+// Enum value maps for BaseFeeAlgorithm.
+var (
+	BaseFeeAlgorithm_name = map[int32]string{
+		0: "BASE_FEE_ALGORITHM_EIP1559",
+		1: "BASE_FEE_ALGORITHM_AIMD",
+		2: "BASE_FEE_ALGORITHM_MOVING_WINDOW",
+	}
+	BaseFeeAlgorithm_value = map[string]int32{
+		"BASE_FEE_ALGORITHM_EIP1559":       0,
+		"BASE_FEE_ALGORITHM_AIMD":          1,
+		"BASE_FEE_ALGORITHM_MOVING_WINDOW": 2,
+	}
+)
+
+func (x BaseFeeAlgorithm) Enum() *BaseFeeAlgorithm {
+	p := new(BaseFeeAlgorithm)
+	*p = x
+	return p
+}
+
+func (x BaseFeeAlgorithm) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BaseFeeAlgorithm) Descriptor() protoreflect.EnumDescriptor {
+	return file_ethermint_feemarket_v1_feemarket_proto_enumTypes[0].Descriptor()
+}
+
+func (BaseFeeAlgorithm) Type() protoreflect.EnumType {
+	return &file_ethermint_feemarket_v1_feemarket_proto_enumTypes[0]
+}
+
+func (x BaseFeeAlgorithm) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BaseFeeAlgorithm.Descriptor instead.
+func (BaseFeeAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return file_ethermint_feemarket_v1_feemarket_proto_rawDescGZIP(), []int{0}
+}
+
 // Params defines the feemarket module parameters
 type Params struct {
 	state         protoimpl.MessageState
@@ -799,6 +3219,36 @@ type Params struct {
 	// min_gas_multiplier bounds the minimum gas used to be charged
 	// to senders based on gas limit
 	MinGasMultiplier string `protobuf:"bytes,8,opt,name=min_gas_multiplier,json=minGasMultiplier,proto3" json:"min_gas_multiplier,omitempty"`
+	// base_fee_algorithm defines the algorithm used to adjust the base fee
+	// between blocks.
+	BaseFeeAlgorithm BaseFeeAlgorithm `protobuf:"varint,9,opt,name=base_fee_algorithm,json=baseFeeAlgorithm,proto3,enum=ethermint.feemarket.v1.BaseFeeAlgorithm" json:"base_fee_algorithm,omitempty"`
+	// window_size is the number of blocks used by the moving window and AIMD
+	// algorithms to compute the gas usage of the chain.
+	WindowSize uint64 `protobuf:"varint,10,opt,name=window_size,json=windowSize,proto3" json:"window_size,omitempty"`
+	// min_base_fee is the lower bound of the base fee. Zero disables the bound.
+	MinBaseFee string `protobuf:"bytes,11,opt,name=min_base_fee,json=minBaseFee,proto3" json:"min_base_fee,omitempty"`
+	// max_base_fee is the upper bound of the base fee. Zero disables the bound.
+	MaxBaseFee string `protobuf:"bytes,12,opt,name=max_base_fee,json=maxBaseFee,proto3" json:"max_base_fee,omitempty"`
+	// aimd defines the parameters of the AIMD base fee algorithm.
+	Aimd *AIMDParams `protobuf:"bytes,13,opt,name=aimd,proto3" json:"aimd,omitempty"`
+	// history_size is the number of blocks for which the base fee and the block
+	// gas are kept in the store. It must be greater or equal than window_size.
+	HistorySize uint64 `protobuf:"varint,14,opt,name=history_size,json=historySize,proto3" json:"history_size,omitempty"`
+	// cosmos_block_gas_share is the share of the block gas reserved for the
+	// Cosmos transactions. The Ethereum transactions of a block cannot use more
+	// than the remaining share of the block gas.
+	CosmosBlockGasShare string `protobuf:"bytes,15,opt,name=cosmos_block_gas_share,json=cosmosBlockGasShare,proto3" json:"cosmos_block_gas_share,omitempty"`
+	// fee_denoms are the denominations, besides the evm denom, accepted to pay
+	// the fees of transactions. The fees are converted with the price of the
+	// denomination provided by the fee denom price feed.
+	// NOTE: the fees paid in a fee denom are not swapped to the evm denom. They
+	// are forwarded to the fee collector as they are and distributed to the
+	// stakers in the fee denom. They are never burned.
+	FeeDenoms []string `protobuf:"bytes,16,rep,name=fee_denoms,json=feeDenoms,proto3" json:"fee_denoms,omitempty"`
+	// max_price_age is the max number of seconds since the last update of the
+	// price of a fee denom for the price to be used. Older prices are stale and
+	// the fee denom is rejected until the price is updated.
+	MaxPriceAge uint64 `protobuf:"varint,17,opt,name=max_price_age,json=maxPriceAge,proto3" json:"max_price_age,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -870,6 +3320,266 @@ func (x *Params) GetMinGasMultiplier() string {
 	return ""
 }
 
+func (x *Params) GetBaseFeeAlgorithm() BaseFeeAlgorithm {
+	if x != nil {
+		return x.BaseFeeAlgorithm
+	}
+	return BaseFeeAlgorithm_BASE_FEE_ALGORITHM_EIP1559
+}
+
+func (x *Params) GetWindowSize() uint64 {
+	if x != nil {
+		return x.WindowSize
+	}
+	return 0
+}
+
+func (x *Params) GetMinBaseFee() string {
+	if x != nil {
+		return x.MinBaseFee
+	}
+	return ""
+}
+
+func (x *Params) GetMaxBaseFee() string {
+	if x != nil {
+		return x.MaxBaseFee
+	}
+	return ""
+}
+
+func (x *Params) GetAimd() *AIMDParams {
+	if x != nil {
+		return x.Aimd
+	}
+	return nil
+}
+
+func (x *Params) GetHistorySize() uint64 {
+	if x != nil {
+		return x.HistorySize
+	}
+	return 0
+}
+
+func (x *Params) GetCosmosBlockGasShare() string {
+	if x != nil {
+		return x.CosmosBlockGasShare
+	}
+	return ""
+}
+
+func (x *Params) GetFeeDenoms() []string {
+	if x != nil {
+		return x.FeeDenoms
+	}
+	return nil
+}
+
+func (x *Params) GetMaxPriceAge() uint64 {
+	if x != nil {
+		return x.MaxPriceAge
+	}
+	return 0
+}
+
+// AIMDParams defines the parameters of the additive increase, multiplicative
+// decrease (AIMD) base fee algorithm.
+type AIMDParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// alpha is the amount added to the learning rate when the window utilization
+	// is outside of the [gamma, 1 - gamma] range.
+	Alpha string `protobuf:"bytes,1,opt,name=alpha,proto3" json:"alpha,omitempty"`
+	// beta is the factor the learning rate is multiplied by when the window
+	// utilization is within the [gamma, 1 - gamma] range.
+	Beta string `protobuf:"bytes,2,opt,name=beta,proto3" json:"beta,omitempty"`
+	// gamma is the utilization threshold used to adjust the learning rate.
+	Gamma string `protobuf:"bytes,3,opt,name=gamma,proto3" json:"gamma,omitempty"`
+	// min_learning_rate is the lower bound of the learning rate.
+	MinLearningRate string `protobuf:"bytes,4,opt,name=min_learning_rate,json=minLearningRate,proto3" json:"min_learning_rate,omitempty"`
+	// max_learning_rate is the upper bound of the learning rate.
+	MaxLearningRate string `protobuf:"bytes,5,opt,name=max_learning_rate,json=maxLearningRate,proto3" json:"max_learning_rate,omitempty"`
+}
+
+func (x *AIMDParams) Reset() {
+	*x = AIMDParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ethermint_feemarket_v1_feemarket_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AIMDParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AIMDParams) ProtoMessage() {}
+
+// Deprecated: Use AIMDParams.ProtoReflect.Descriptor instead.
+func (*AIMDParams) Descriptor() ([]byte, []int) {
+	return file_ethermint_feemarket_v1_feemarket_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AIMDParams) GetAlpha() string {
+	if x != nil {
+		return x.Alpha
+	}
+	return ""
+}
+
+func (x *AIMDParams) GetBeta() string {
+	if x != nil {
+		return x.Beta
+	}
+	return ""
+}
+
+func (x *AIMDParams) GetGamma() string {
+	if x != nil {
+		return x.Gamma
+	}
+	return ""
+}
+
+func (x *AIMDParams) GetMinLearningRate() string {
+	if x != nil {
+		return x.MinLearningRate
+	}
+	return ""
+}
+
+func (x *AIMDParams) GetMaxLearningRate() string {
+	if x != nil {
+		return x.MaxLearningRate
+	}
+	return ""
+}
+
+// BaseFeeRecord defines the base fee and the gas wanted of a block.
+type BaseFeeRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// height of the block
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// base_fee of the block
+	BaseFee string `protobuf:"bytes,2,opt,name=base_fee,json=baseFee,proto3" json:"base_fee,omitempty"`
+	// gas_wanted is the gas wanted of the block used for the base fee calculation
+	GasWanted uint64 `protobuf:"varint,3,opt,name=gas_wanted,json=gasWanted,proto3" json:"gas_wanted,omitempty"`
+	// max_gas is the maximum gas of the block. -1 means that the block gas is
+	// unlimited.
+	MaxGas int64 `protobuf:"varint,4,opt,name=max_gas,json=maxGas,proto3" json:"max_gas,omitempty"`
+}
+
+func (x *BaseFeeRecord) Reset() {
+	*x = BaseFeeRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ethermint_feemarket_v1_feemarket_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BaseFeeRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BaseFeeRecord) ProtoMessage() {}
+
+// Deprecated: Use BaseFeeRecord.ProtoReflect.Descriptor instead.
+func (*BaseFeeRecord) Descriptor() ([]byte, []int) {
+	return file_ethermint_feemarket_v1_feemarket_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BaseFeeRecord) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *BaseFeeRecord) GetBaseFee() string {
+	if x != nil {
+		return x.BaseFee
+	}
+	return ""
+}
+
+func (x *BaseFeeRecord) GetGasWanted() uint64 {
+	if x != nil {
+		return x.GasWanted
+	}
+	return 0
+}
+
+func (x *BaseFeeRecord) GetMaxGas() int64 {
+	if x != nil {
+		return x.MaxGas
+	}
+	return 0
+}
+
+// FeeDenomPrice defines the price of a fee denom in the evm denom.
+type FeeDenomPrice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// denom is the fee denom
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// price is the amount of the evm denom worth one unit of the fee denom
+	Price string `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	// update_time is the unix time, in seconds, of the block in which the price
+	// was updated
+	UpdateTime int64 `protobuf:"varint,3,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+}
+
+func (x *FeeDenomPrice) Reset() {
+	*x = FeeDenomPrice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ethermint_feemarket_v1_feemarket_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeDenomPrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeDenomPrice) ProtoMessage() {}
+
+// Deprecated: Use FeeDenomPrice.ProtoReflect.Descriptor instead.
+func (*FeeDenomPrice) Descriptor() ([]byte, []int) {
+	return file_ethermint_feemarket_v1_feemarket_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FeeDenomPrice) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *FeeDenomPrice) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *FeeDenomPrice) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
 var File_ethermint_feemarket_v1_feemarket_proto protoreflect.FileDescriptor
 
 var file_ethermint_feemarket_v1_feemarket_proto_rawDesc = []byte{
@@ -879,7 +3589,7 @@ var file_ethermint_feemarket_v1_feemarket_proto_rawDesc = []byte{
 	0x69, 0x6e, 0x74, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31,
 	0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67,
-	0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe3, 0x03, 0x0a, 0x06, 0x50, 0x61,
+	0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfc, 0x07, 0x0a, 0x06, 0x50, 0x61,
 	0x72, 0x61, 0x6d, 0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x6e, 0x6f, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f,
 	0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6e, 0x6f, 0x42, 0x61, 0x73,
 	0x65, 0x46, 0x65, 0x65, 0x12, 0x3d, 0x0a, 0x1b, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65,
@@ -906,25 +3616,109 @@ var file_ethermint_feemarket_v1_feemarket_proto_rawDesc = []byte{
 	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
 	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
 	0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x10, 0x6d, 0x69, 0x6e, 0x47, 0x61, 0x73, 0x4d, 0x75,
-	0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x3a, 0x1d, 0x8a, 0xe7, 0xb0, 0x2a, 0x18, 0x65,
-	0x76, 0x6d, 0x6f, 0x73, 0x2f, 0x78, 0x2f, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x52, 0x10, 0x69,
-	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x42,
-	0xdb, 0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e,
-	0x74, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0e,
-	0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x33, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x66, 0x65, 0x65,
-	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x46, 0x58, 0xaa, 0x02, 0x16, 0x45, 0x74,
-	0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x16, 0x45, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74,
-	0x5c, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x22,
-	0x45, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x5c, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x18, 0x45, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x3a, 0x3a,
-	0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x56, 0x0a, 0x12, 0x62, 0x61, 0x73, 0x65,
+	0x5f, 0x66, 0x65, 0x65, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74,
+	0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61,
+	0x73, 0x65, 0x46, 0x65, 0x65, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x10,
+	0x62, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d,
+	0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x4a, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x12, 0x4a, 0x0a,
+	0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c,
+	0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x6d,
+	0x61, 0x78, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x12, 0x41, 0x0a, 0x04, 0x61, 0x69, 0x6d,
+	0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6d,
+	0x69, 0x6e, 0x74, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x49, 0x4d, 0x44, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f,
+	0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x04, 0x61, 0x69, 0x6d, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x5d, 0x0a, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x67, 0x61, 0x73, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x13, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x47, 0x61, 0x73, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x66, 0x65, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x18, 0x10, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x09, 0x66, 0x65, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x12, 0x22, 0x0a,
+	0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x67,
+	0x65, 0x3a, 0x1d, 0x8a, 0xe7, 0xb0, 0x2a, 0x18, 0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2f, 0x78, 0x2f,
+	0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x52, 0x10, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f,
+	0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x22, 0xf6, 0x02, 0x0a, 0x0a, 0x41, 0x49, 0x4d,
+	0x44, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x3e, 0x0a, 0x05, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x05, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x12, 0x3c, 0x0a, 0x04, 0x62, 0x65, 0x74, 0x61, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x04, 0x62, 0x65, 0x74, 0x61, 0x12, 0x3e, 0x0a, 0x05, 0x67, 0x61, 0x6d, 0x6d, 0x61, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05,
+	0x67, 0x61, 0x6d, 0x6d, 0x61, 0x12, 0x54, 0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x61,
+	0x72, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x6d, 0x69, 0x6e, 0x4c,
+	0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x12, 0x54, 0x0a, 0x11, 0x6d,
+	0x61, 0x78, 0x5f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x0f, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74,
+	0x65, 0x22, 0xa4, 0x01, 0x0a, 0x0d, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x43, 0x0a, 0x08, 0x62,
+	0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
+	0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x62, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x61, 0x73, 0x5f, 0x77, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x67, 0x61, 0x73, 0x57, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x6d, 0x61, 0x78, 0x47, 0x61, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x0d, 0x46, 0x65, 0x65,
+	0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65,
+	0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x12, 0x3e, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x2a, 0x7b, 0x0a, 0x10, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x41, 0x6c, 0x67, 0x6f,
+	0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x1e, 0x0a, 0x1a, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x46, 0x45,
+	0x45, 0x5f, 0x41, 0x4c, 0x47, 0x4f, 0x52, 0x49, 0x54, 0x48, 0x4d, 0x5f, 0x45, 0x49, 0x50, 0x31,
+	0x35, 0x35, 0x39, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x46, 0x45,
+	0x45, 0x5f, 0x41, 0x4c, 0x47, 0x4f, 0x52, 0x49, 0x54, 0x48, 0x4d, 0x5f, 0x41, 0x49, 0x4d, 0x44,
+	0x10, 0x01, 0x12, 0x24, 0x0a, 0x20, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x46, 0x45, 0x45, 0x5f, 0x41,
+	0x4c, 0x47, 0x4f, 0x52, 0x49, 0x54, 0x48, 0x4d, 0x5f, 0x4d, 0x4f, 0x56, 0x49, 0x4e, 0x47, 0x5f,
+	0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x10, 0x02, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xdb,
+	0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74,
+	0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0e, 0x46,
+	0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x33, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x66, 0x65, 0x65, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x46, 0x58, 0xaa, 0x02, 0x16, 0x45, 0x74, 0x68,
+	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x2e, 0x56, 0x31, 0xca, 0x02, 0x16, 0x45, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x5c,
+	0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x22, 0x45,
+	0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x5c, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0xea, 0x02, 0x18, 0x45, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x3a, 0x3a, 0x46,
+	0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -939,16 +3733,23 @@ func file_ethermint_feemarket_v1_feemarket_proto_rawDescGZIP() []byte {
 	return file_ethermint_feemarket_v1_feemarket_proto_rawDescData
 }
 
-var file_ethermint_feemarket_v1_feemarket_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_ethermint_feemarket_v1_feemarket_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_ethermint_feemarket_v1_feemarket_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_ethermint_feemarket_v1_feemarket_proto_goTypes = []interface{}{
-	(*Params)(nil), // 0: ethermint.feemarket.v1.Params
+	(BaseFeeAlgorithm)(0), // 0: ethermint.feemarket.v1.BaseFeeAlgorithm
+	(*Params)(nil),        // 1: ethermint.feemarket.v1.Params
+	(*AIMDParams)(nil),    // 2: ethermint.feemarket.v1.AIMDParams
+	(*BaseFeeRecord)(nil), // 3: ethermint.feemarket.v1.BaseFeeRecord
+	(*FeeDenomPrice)(nil), // 4: ethermint.feemarket.v1.FeeDenomPrice
 }
 var file_ethermint_feemarket_v1_feemarket_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0, // 0: ethermint.feemarket.v1.Params.base_fee_algorithm:type_name -> ethermint.feemarket.v1.BaseFeeAlgorithm
+	2, // 1: ethermint.feemarket.v1.Params.aimd:type_name -> ethermint.feemarket.v1.AIMDParams
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_ethermint_feemarket_v1_feemarket_proto_init() }
@@ -969,19 +3770,56 @@ func file_ethermint_feemarket_v1_feemarket_proto_init() {
 				return nil
 			}
 		}
+		file_ethermint_feemarket_v1_feemarket_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AIMDParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ethermint_feemarket_v1_feemarket_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BaseFeeRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ethermint_feemarket_v1_feemarket_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeDenomPrice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ethermint_feemarket_v1_feemarket_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      1,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_ethermint_feemarket_v1_feemarket_proto_goTypes,
 		DependencyIndexes: file_ethermint_feemarket_v1_feemarket_proto_depIdxs,
+		EnumInfos:         file_ethermint_feemarket_v1_feemarket_proto_enumTypes,
 		MessageInfos:      file_ethermint_feemarket_v1_feemarket_proto_msgTypes,
 	}.Build()
 	File_ethermint_feemarket_v1_feemarket_proto = out.File
@@ -22,7 +22,8 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName = "/ethermint.feemarket.v1.Msg/UpdateParams"
+	Msg_UpdateParams_FullMethodName     = "/ethermint.feemarket.v1.Msg/UpdateParams"
+	Msg_SetFeeDenomPrice_FullMethodName = "/ethermint.feemarket.v1.Msg/SetFeeDenomPrice"
 )
 
 // MsgClient is the client API for Msg service.
@@ -32,6 +33,10 @@ type MsgClient interface {
 	// UpdateParams defined a governance operation for updating the x/feemarket module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetFeeDenomPrice defines a governance operation for updating the price of
+	// a fee denom in the evm denom.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	SetFeeDenomPrice(ctx context.Context, in *MsgSetFeeDenomPrice, opts ...grpc.CallOption) (*MsgSetFeeDenomPriceResponse, error)
 }
 
 type msgClient struct {
@@ -51,6 +56,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SetFeeDenomPrice(ctx context.Context, in *MsgSetFeeDenomPrice, opts ...grpc.CallOption) (*MsgSetFeeDenomPriceResponse, error) {
+	out := new(MsgSetFeeDenomPriceResponse)
+	err := c.cc.Invoke(ctx, Msg_SetFeeDenomPrice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -58,6 +72,10 @@ type MsgServer interface {
 	// UpdateParams defined a governance operation for updating the x/feemarket module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetFeeDenomPrice defines a governance operation for updating the price of
+	// a fee denom in the evm denom.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	SetFeeDenomPrice(context.Context, *MsgSetFeeDenomPrice) (*MsgSetFeeDenomPriceResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -68,6 +86,9 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) SetFeeDenomPrice(context.Context, *MsgSetFeeDenomPrice) (*MsgSetFeeDenomPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeeDenomPrice not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -99,6 +120,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetFeeDenomPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFeeDenomPrice)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFeeDenomPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetFeeDenomPrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFeeDenomPrice(ctx, req.(*MsgSetFeeDenomPrice))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -110,6 +149,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetFeeDenomPrice",
+			Handler:    _Msg_SetFeeDenomPrice_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/feemarket/v1/tx.proto",
@@ -225,16 +225,6 @@ func VerifySignature(
 			FeePayer: feePayer,
 		}
 
-		typedData, err := eip712.LegacyWrapTxToTypedData(aizelCodec, extOpt.TypedDataChainID, msgs[0], txBytes, feeDelegation)
-		if err != nil {
-			return errorsmod.Wrap(err, "failed to create EIP-712 typed data from tx")
-		}
-
-		sigHash, _, err := apitypes.TypedDataAndHash(typedData)
-		if err != nil {
-			return err
-		}
-
 		feePayerSig := extOpt.FeePayerSig
 		if len(feePayerSig) != ethcrypto.SignatureLength {
 			return errorsmod.Wrap(errortypes.ErrorInvalidSigner, "signature length doesn't match typical [R||S||V] signature 65 bytes")
@@ -245,38 +235,69 @@ func VerifySignature(
 			feePayerSig[ethcrypto.RecoveryIDOffset] -= 27
 		}
 
-		feePayerPubkey, err := secp256k1.RecoverPubkey(sigHash, feePayerSig)
-		if err != nil {
-			return errorsmod.Wrap(err, "failed to recover delegated fee payer from sig")
+		typedData, err := eip712.SchemaWrapTxToTypedData(aizelCodec.InterfaceRegistry(), extOpt.TypedDataChainID, msgs, txBytes, feeDelegation)
+		if err == nil {
+			err = verifyTypedDataSignature(pubKey, feePayer, typedData, feePayerSig)
 		}
-
-		ecPubKey, err := ethcrypto.UnmarshalPubkey(feePayerPubkey)
-		if err != nil {
-			return errorsmod.Wrap(err, "failed to unmarshal recovered fee payer pubkey")
+		if err == nil {
+			return nil
 		}
 
-		pk := &ethsecp256k1.PubKey{
-			Key: ethcrypto.CompressPubkey(ecPubKey),
+		// Fall back to the legacy typed data of the first message, still used
+		// by the clients that do not derive the types from the proto schema.
+		legacyTypedData, legacyErr := eip712.LegacyWrapTxToTypedData(aizelCodec, extOpt.TypedDataChainID, msgs[0], txBytes, feeDelegation)
+		if legacyErr != nil {
+			return errorsmod.Wrap(err, "failed to verify EIP-712 typed data signature")
 		}
 
-		if !pubKey.Equals(pk) {
-			return errorsmod.Wrapf(errortypes.ErrInvalidPubKey, "feePayer pubkey %s is different from transaction pubkey %s", pubKey, pk)
-		}
+		return verifyTypedDataSignature(pubKey, feePayer, legacyTypedData, feePayerSig)
+	default:
+		return errorsmod.Wrapf(errortypes.ErrTooManySignatures, "unexpected SignatureData %T", sigData)
+	}
+}
+
+// verifyTypedDataSignature verifies that the given [R||S||V] signature of the
+// EIP-712 typed data was made by the fee payer with the given pubkey.
+func verifyTypedDataSignature(
+	pubKey cryptotypes.PubKey,
+	feePayer sdk.AccAddress,
+	typedData apitypes.TypedData,
+	feePayerSig []byte,
+) error {
+	sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return err
+	}
 
-		recoveredFeePayerAcc := sdk.AccAddress(pk.Address().Bytes())
+	feePayerPubkey, err := secp256k1.RecoverPubkey(sigHash, feePayerSig)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to recover delegated fee payer from sig")
+	}
 
-		if !recoveredFeePayerAcc.Equals(feePayer) {
-			return errorsmod.Wrapf(errortypes.ErrorInvalidSigner, "failed to verify delegated fee payer %s signature", recoveredFeePayerAcc)
-		}
+	ecPubKey, err := ethcrypto.UnmarshalPubkey(feePayerPubkey)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to unmarshal recovered fee payer pubkey")
+	}
 
-		// VerifySignature of ethsecp256k1 accepts 64 byte signature [R||S]
-		// WARNING! Under NO CIRCUMSTANCES try to use pubKey.VerifySignature there
-		if !secp256k1.VerifySignature(pubKey.Bytes(), sigHash, feePayerSig[:len(feePayerSig)-1]) {
-			return errorsmod.Wrap(errortypes.ErrorInvalidSigner, "unable to verify signer signature of EIP712 typed data")
-		}
+	pk := &ethsecp256k1.PubKey{
+		Key: ethcrypto.CompressPubkey(ecPubKey),
+	}
 
-		return nil
-	default:
-		return errorsmod.Wrapf(errortypes.ErrTooManySignatures, "unexpected SignatureData %T", sigData)
+	if !pubKey.Equals(pk) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidPubKey, "feePayer pubkey %s is different from transaction pubkey %s", pubKey, pk)
+	}
+
+	recoveredFeePayerAcc := sdk.AccAddress(pk.Address().Bytes())
+
+	if !recoveredFeePayerAcc.Equals(feePayer) {
+		return errorsmod.Wrapf(errortypes.ErrorInvalidSigner, "failed to verify delegated fee payer %s signature", recoveredFeePayerAcc)
+	}
+
+	// VerifySignature of ethsecp256k1 accepts 64 byte signature [R||S]
+	// WARNING! Under NO CIRCUMSTANCES try to use pubKey.VerifySignature there
+	if !secp256k1.VerifySignature(pubKey.Bytes(), sigHash, feePayerSig[:len(feePayerSig)-1]) {
+		return errorsmod.Wrap(errortypes.ErrorInvalidSigner, "unable to verify signer signature of EIP712 typed data")
 	}
+
+	return nil
 }
@@ -0,0 +1,129 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+package cosmos
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	anteutils "github.com/AizelNetwork/CosmEvm/app/ante/utils"
+	"github.com/AizelNetwork/CosmEvm/server/config"
+	erc20types "github.com/AizelNetwork/CosmEvm/x/erc20/types"
+)
+
+// firewallTxType is the metrics label of the Cosmos txs rejected by the
+// firewall.
+const firewallTxType = "cosmos"
+
+// FirewallDecorator rejects the Cosmos txs received on CheckTx whose signers
+// or recipients are denied by the node firewall, or whose signers exceeded
+// their rate limit. It must run after the signature verification so that the
+// txs are not counted for a forged signer.
+type FirewallDecorator struct {
+	firewall *anteutils.Firewall
+}
+
+// NewFirewallDecorator creates a new FirewallDecorator. A nil firewall
+// accepts all the txs.
+func NewFirewallDecorator(firewall *anteutils.Firewall) FirewallDecorator {
+	return FirewallDecorator{
+		firewall: firewall,
+	}
+}
+
+// AnteHandle checks the signers and the recipients of the tx msgs, including
+// the ones executed within authz, against the firewall. The txs are only
+// counted for the rate limit on CheckTx, as they are already counted when
+// they are rechecked.
+func (fd FirewallDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if fd.firewall == nil || !ctx.IsCheckTx() || simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(errortypes.ErrTxDecode, "invalid transaction type")
+	}
+
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return ctx, err
+	}
+
+	senders := make([]common.Address, 0, len(signers))
+	for _, signer := range signers {
+		sender := common.BytesToAddress(signer)
+		if err := fd.firewall.CheckSender(sender); err != nil {
+			anteutils.IncrRejectedTxCounter(firewallTxType, anteutils.FirewallReasonSender)
+			return ctx, err
+		}
+		senders = append(senders, sender)
+	}
+
+	if err := fd.checkRecipients(tx.GetMsgs(), 1); err != nil {
+		anteutils.IncrRejectedTxCounter(firewallTxType, anteutils.FirewallReasonRecipient)
+		return ctx, err
+	}
+
+	if !ctx.IsReCheckTx() {
+		if err := fd.firewall.RateLimit(ctx, senders...); err != nil {
+			anteutils.IncrRejectedTxCounter(firewallTxType, anteutils.FirewallReasonRateLimit)
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// checkRecipients returns an error if any of the msgs transfers funds to a
+// denied recipient. The msgs wrapped in authz MsgExec are checked up to the
+// maxNestedMsgs threshold.
+func (fd FirewallDecorator) checkRecipients(msgs []sdk.Msg, nestedLvl int) error {
+	if nestedLvl >= maxNestedMsgs {
+		return fmt.Errorf("found more nested msgs than permited. Limit is : %d", maxNestedMsgs)
+	}
+
+	for _, msg := range msgs {
+		var recipients []string
+		switch msg := msg.(type) {
+		case *authz.MsgExec:
+			innerMsgs, err := msg.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err := fd.checkRecipients(innerMsgs, nestedLvl+1); err != nil {
+				return err
+			}
+		case *banktypes.MsgSend:
+			recipients = append(recipients, msg.ToAddress)
+		case *banktypes.MsgMultiSend:
+			for _, output := range msg.Outputs {
+				recipients = append(recipients, output.Address)
+			}
+		case *erc20types.MsgConvertERC20:
+			recipients = append(recipients, msg.Receiver)
+		case *erc20types.MsgConvertCoin:
+			recipients = append(recipients, msg.Receiver)
+		}
+
+		for _, recipient := range recipients {
+			// invalid addresses are rejected by the msg validation
+			addr, err := config.ParseFirewallAddress(recipient)
+			if err != nil {
+				continue
+			}
+			if err := fd.firewall.CheckRecipient(addr); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
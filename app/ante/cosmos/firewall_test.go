@@ -0,0 +1,110 @@
+package cosmos_test
+
+import (
+	"fmt"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	cosmosante "github.com/AizelNetwork/CosmEvm/app/ante/cosmos"
+	anteutils "github.com/AizelNetwork/CosmEvm/app/ante/utils"
+	"github.com/AizelNetwork/CosmEvm/server/config"
+	"github.com/AizelNetwork/CosmEvm/testutil"
+	"github.com/AizelNetwork/CosmEvm/testutil/integration/aizel/network"
+	utiltx "github.com/AizelNetwork/CosmEvm/testutil/tx"
+	evmtypes "github.com/AizelNetwork/CosmEvm/x/evm/types"
+)
+
+func TestFirewallDecorator(t *testing.T) {
+	nw := network.New()
+	txCfg := nw.GetEncodingConfig().TxConfig
+	testPrivKeys, testAddresses, err := generatePrivKeyAddressPairs(4)
+	require.NoError(t, err)
+
+	firewall, err := anteutils.NewFirewall(config.FirewallConfig{
+		DenySenders:     []string{utiltx.GenerateAddress().Hex(), testAddresses[2].String()},
+		DenyRecipients:  []string{testAddresses[3].String()},
+		RateLimitTxs:    1,
+		RateLimitBlocks: 1,
+	})
+	require.NoError(t, err)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(evmtypes.GetEVMCoinDenom(), 100e6))
+
+	testCases := []struct {
+		name        string
+		signer      int
+		msgs        []sdk.Msg
+		checkTx     bool
+		expectedErr error
+	}{
+		{
+			"pass - allowed sender and recipient",
+			0,
+			[]sdk.Msg{banktypes.NewMsgSend(testAddresses[0], testAddresses[1], coins)},
+			true,
+			nil,
+		},
+		{
+			"fail - sender exceeded the rate limit",
+			0,
+			[]sdk.Msg{banktypes.NewMsgSend(testAddresses[0], testAddresses[1], coins)},
+			true,
+			errortypes.ErrInvalidRequest,
+		},
+		{
+			"fail - denied sender",
+			2,
+			[]sdk.Msg{banktypes.NewMsgSend(testAddresses[2], testAddresses[1], coins)},
+			true,
+			errortypes.ErrUnauthorized,
+		},
+		{
+			"fail - denied recipient",
+			1,
+			[]sdk.Msg{banktypes.NewMsgSend(testAddresses[1], testAddresses[3], coins)},
+			true,
+			errortypes.ErrUnauthorized,
+		},
+		{
+			"fail - denied recipient in MsgExec",
+			1,
+			[]sdk.Msg{
+				newMsgExec(
+					testAddresses[1],
+					[]sdk.Msg{banktypes.NewMsgSend(testAddresses[0], testAddresses[3], coins)},
+				),
+			},
+			true,
+			errortypes.ErrUnauthorized,
+		},
+		{
+			"pass - denied sender on DeliverTx",
+			2,
+			[]sdk.Msg{banktypes.NewMsgSend(testAddresses[2], testAddresses[3], coins)},
+			false,
+			nil,
+		},
+	}
+
+	decorator := cosmosante.NewFirewallDecorator(firewall)
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("Case %s", tc.name), func(t *testing.T) {
+			ctx := sdk.Context{}.WithIsCheckTx(tc.checkTx).WithBlockHeader(cmtproto.Header{Height: 1})
+			tx, err := createTx(ctx, txCfg, testPrivKeys[tc.signer], tc.msgs...)
+			require.NoError(t, err)
+
+			_, err = decorator.AnteHandle(ctx, tx, false, testutil.NextFn)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
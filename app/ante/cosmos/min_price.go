@@ -45,11 +45,13 @@ func (mpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 		return ctx, err
 	}
 
-	// only allow user to pass in the base denom as transaction fees
+	// only allow user to pass in the base denom or one of the fee denoms
+	// accepted by the fee market as transaction fees
 	// allow use stake native tokens for fees is just for unit tests to pass
 	validFees := len(feeCoins) == 0 || (len(feeCoins) == 1 && slices.Contains([]string{baseDenom}, feeCoins.GetDenomByIndex(0)))
-	if !validFees && !simulate {
-		return ctx, fmt.Errorf("expected only use native token %s for fee, but got %s", baseDenom, feeCoins.String())
+	isFeeDenom := len(feeCoins) == 1 && !validFees && mpd.feemarketKeeper.GetParams(ctx).IsFeeDenom(feeCoins.GetDenomByIndex(0))
+	if !validFees && !isFeeDenom && !simulate {
+		return ctx, fmt.Errorf("expected only use native token %s for fee or a fee denom, but got %s", baseDenom, feeCoins.String())
 	}
 
 	// Short-circuit if min gas price is 0 or if simulating
@@ -64,6 +66,21 @@ func (mpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 		},
 	}
 
+	// convert the min gas price to the fee denom used to pay the fees
+	if isFeeDenom {
+		feeDenomPrice, err := mpd.feemarketKeeper.GetValidFeeDenomPrice(ctx, feeCoins.GetDenomByIndex(0))
+		if err != nil {
+			return ctx, errorsmod.Wrap(errortypes.ErrInsufficientFee, err.Error())
+		}
+
+		minGasPrices = sdk.DecCoins{
+			{
+				Denom:  feeDenomPrice.Denom,
+				Amount: feeDenomPrice.FromEVMDenom(minGasPrice),
+			},
+		}
+	}
+
 	gas := feeTx.GetGas()
 
 	requiredFees := make(sdk.Coins, 0)
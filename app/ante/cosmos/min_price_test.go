@@ -8,6 +8,7 @@ import (
 	cosmosante "github.com/AizelNetwork/CosmEvm/app/ante/cosmos"
 	"github.com/AizelNetwork/CosmEvm/testutil"
 	testutiltx "github.com/AizelNetwork/CosmEvm/testutil/tx"
+	feemarkettypes "github.com/AizelNetwork/CosmEvm/x/feemarket/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
@@ -123,6 +124,64 @@ func (suite *AnteTestSuite) TestMinGasPriceDecorator() {
 			"expected only use native token",
 			true,
 		},
+		{
+			"valid cosmos tx with MinGasPrices = 10, fee denom gasPrice = 5 with price 2",
+			func() sdk.Tx {
+				params := nw.App.FeeMarketKeeper.GetParams(ctx)
+				params.MinGasPrice = math.LegacyNewDec(10)
+				params.FeeDenoms = []string{"uusdc"}
+				params.MaxPriceAge = 60
+				err := nw.App.FeeMarketKeeper.SetParams(ctx, params)
+				suite.Require().NoError(err)
+				err = nw.App.FeeMarketKeeper.UpdateFeeDenomPrice(ctx, "uusdc", math.LegacyNewDec(2))
+				suite.Require().NoError(err)
+
+				txBuilder := suite.CreateTestCosmosTxBuilder(math.NewInt(5), "uusdc", &testMsg)
+				return txBuilder.GetTx()
+			},
+			true,
+			"",
+			true,
+		},
+		{
+			"invalid cosmos tx with MinGasPrices = 10, fee denom gasPrice = 4 with price 2",
+			func() sdk.Tx {
+				params := nw.App.FeeMarketKeeper.GetParams(ctx)
+				params.MinGasPrice = math.LegacyNewDec(10)
+				params.FeeDenoms = []string{"uusdc"}
+				params.MaxPriceAge = 60
+				err := nw.App.FeeMarketKeeper.SetParams(ctx, params)
+				suite.Require().NoError(err)
+				err = nw.App.FeeMarketKeeper.UpdateFeeDenomPrice(ctx, "uusdc", math.LegacyNewDec(2))
+				suite.Require().NoError(err)
+
+				txBuilder := suite.CreateTestCosmosTxBuilder(math.NewInt(4), "uusdc", &testMsg)
+				return txBuilder.GetTx()
+			},
+			false,
+			"provided fee < minimum global fee",
+			true,
+		},
+		{
+			"invalid cosmos tx with MinGasPrices = 10, fee denom with stale price",
+			func() sdk.Tx {
+				params := nw.App.FeeMarketKeeper.GetParams(ctx)
+				params.MinGasPrice = math.LegacyNewDec(10)
+				params.FeeDenoms = []string{"uusdc"}
+				params.MaxPriceAge = 60
+				err := nw.App.FeeMarketKeeper.SetParams(ctx, params)
+				suite.Require().NoError(err)
+				nw.App.FeeMarketKeeper.SetFeeDenomPriceRecord(
+					ctx, feemarkettypes.NewFeeDenomPrice("uusdc", math.LegacyNewDec(2), ctx.BlockTime().Unix()-61),
+				)
+
+				txBuilder := suite.CreateTestCosmosTxBuilder(math.NewInt(5), "uusdc", &testMsg)
+				return txBuilder.GetTx()
+			},
+			false,
+			"fee denom price is stale",
+			true,
+		},
 		{
 			"valid cosmos tx with MinGasPrices = 0, gasPrice = 0, valid fee",
 			func() sdk.Tx {
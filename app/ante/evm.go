@@ -15,9 +15,12 @@ func newMonoEVMAnteHandler(options HandlerOptions) sdk.AnteHandler {
 			options.BankKeeper,
 			options.FeeMarketKeeper,
 			options.EvmKeeper,
+			options.FeegrantKeeper,
 			options.DistributionKeeper,
 			options.StakingKeeper,
 			options.MaxTxGasWanted,
+			options.QueueFutureNonces,
 		),
+		evmante.NewFirewallDecorator(options.Firewall),
 	)
 }
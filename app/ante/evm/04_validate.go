@@ -75,13 +75,37 @@ func ValidateTx(tx sdktypes.Tx) (*tx.Fee, error) {
 
 	protoTx := wrapperTx.GetProtoTx()
 	body := protoTx.Body
-	if body.Memo != "" || body.TimeoutHeight != uint64(0) || len(body.NonCriticalExtensionOptions) > 0 {
+	// NOTE: the timeout height is only allowed for bundles, where it defines
+	// the max block number of the bundle.
+	hasTimeoutHeight := body.TimeoutHeight != uint64(0) && !evmtypes.IsBundle(tx.GetMsgs())
+	if body.Memo != "" || hasTimeoutHeight || len(body.NonCriticalExtensionOptions) > 0 {
 		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest,
 			"for eth tx body Memo TimeoutHeight NonCriticalExtensionOptions should be empty")
 	}
 
-	if len(body.ExtensionOptions) != 1 {
-		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx length of ExtensionOptions should be 1")
+	// NOTE: a single Ethereum message can be followed by the extension options
+	// of the sponsor paying its fees, of the fee denom used to pay them and of
+	// the multisig signature of its sender.
+	extOpts := body.ExtensionOptions
+	if len(extOpts) == 0 || len(extOpts) > 4 {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx length of ExtensionOptions should be between 1 and 4")
+	}
+
+	if len(extOpts) > 1 && len(tx.GetMsgs()) != 1 {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest,
+			"for eth tx the additional ExtensionOptions are only allowed for a single Ethereum message")
+	}
+
+	seenOpts := make(map[string]bool, len(extOpts)-1)
+	for _, opt := range extOpts[1:] {
+		typeURL := opt.TypeUrl
+		if (typeURL != evmtypes.SponsorExtensionOptionTypeURL &&
+			typeURL != evmtypes.FeeDenomExtensionOptionTypeURL &&
+			typeURL != evmtypes.MultisigExtensionOptionTypeURL) || seenOpts[typeURL] {
+			return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest,
+				"for eth tx the additional ExtensionOptions should be the sponsor, the fee denom and the multisig")
+		}
+		seenOpts[typeURL] = true
 	}
 
 	authInfo := protoTx.AuthInfo
@@ -101,6 +125,29 @@ func ValidateTx(tx sdktypes.Tx) (*tx.Fee, error) {
 	return authInfo.Fee, nil
 }
 
+// ValidateBundle validates the size and the max block number of an Ethereum
+// tx bundle, i.e. a tx with multiple Ethereum messages.
+func ValidateBundle(ctx sdktypes.Context, tx sdktypes.Tx) error {
+	if err := evmtypes.ValidateBundleSize(len(tx.GetMsgs())); err != nil {
+		return err
+	}
+
+	timeoutTx, ok := tx.(sdktypes.TxWithTimeoutHeight)
+	if !ok {
+		return errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid tx type %T, expected %T", tx, (sdktypes.TxWithTimeoutHeight)(nil))
+	}
+
+	timeoutHeight := timeoutTx.GetTimeoutHeight()
+	if timeoutHeight > 0 && uint64(ctx.BlockHeight()) > timeoutHeight { //#nosec G115 -- block height is positive
+		return errorsmod.Wrapf(
+			errortypes.ErrTxTimeoutHeight,
+			"bundle max block number (%d) reached at height %d", timeoutHeight, ctx.BlockHeight(),
+		)
+	}
+
+	return nil
+}
+
 // CheckTxFee checks if the Amount and GasLimit fields of the txFeeInfo input
 // are equal to the txFee coins and the txGasLimit value.
 // The function expects txFeeInfo to contains coins in the original decimal
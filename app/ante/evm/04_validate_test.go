@@ -7,13 +7,16 @@ import (
 	"math/big"
 
 	"cosmossdk.io/math"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/AizelNetwork/CosmEvm/app/ante/evm"
+	"github.com/AizelNetwork/CosmEvm/encoding"
 	testkeyring "github.com/AizelNetwork/CosmEvm/testutil/integration/aizel/keyring"
 	"github.com/AizelNetwork/CosmEvm/types"
 	evmtypes "github.com/AizelNetwork/CosmEvm/x/evm/types"
@@ -285,3 +288,131 @@ func (suite *EvmAnteTestSuite) TestCheckTxFee() {
 		}
 	}
 }
+
+func (suite *EvmAnteTestSuite) TestValidateBundle() {
+	encodingConfig := encoding.MakeConfig()
+
+	newBundleTx := func(size int, timeoutHeight uint64) sdktypes.Tx {
+		msgs := make([]sdktypes.Msg, size)
+		for i := range msgs {
+			msgs[i] = evmtypes.NewTx(&evmtypes.EvmTxArgs{Nonce: uint64(i), GasLimit: 21000}) //#nosec G115
+		}
+		txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+		suite.Require().NoError(txBuilder.SetMsgs(msgs...))
+		txBuilder.SetTimeoutHeight(timeoutHeight)
+		return txBuilder.GetTx()
+	}
+
+	testCases := []struct {
+		name     string
+		tx       sdktypes.Tx
+		expError error
+	}{
+		{
+			name:     "pass: no max block number",
+			tx:       newBundleTx(2, 0),
+			expError: nil,
+		},
+		{
+			name:     "pass: max block number not reached",
+			tx:       newBundleTx(2, 10),
+			expError: nil,
+		},
+		{
+			name:     "fail: max block number reached",
+			tx:       newBundleTx(2, 9),
+			expError: errortypes.ErrTxTimeoutHeight,
+		},
+		{
+			name:     "fail: too many txs",
+			tx:       newBundleTx(evmtypes.MaxBundleTxs+1, 0),
+			expError: evmtypes.ErrInvalidBundle,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx := sdktypes.Context{}.WithBlockHeight(10)
+
+			err := evm.ValidateBundle(ctx, tc.tx)
+			if tc.expError != nil {
+				suite.Require().ErrorIs(err, tc.expError)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (suite *EvmAnteTestSuite) TestValidateTxExtensionOptions() {
+	encodingConfig := encoding.MakeConfig()
+
+	newTx := func(typeURLs ...string) sdktypes.Tx {
+		txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+		suite.Require().NoError(txBuilder.SetMsgs(evmtypes.NewTx(&evmtypes.EvmTxArgs{GasLimit: 21000})))
+
+		extBuilder, ok := txBuilder.(authtx.ExtensionOptionsTxBuilder)
+		suite.Require().True(ok)
+		opts := make([]*codectypes.Any, len(typeURLs))
+		for i, typeURL := range typeURLs {
+			opts[i] = &codectypes.Any{TypeUrl: typeURL}
+		}
+		extBuilder.SetExtensionOptions(opts...)
+		return txBuilder.GetTx()
+	}
+
+	ethTxTypeURL := "/ethermint.evm.v1.ExtensionOptionsEthereumTx"
+
+	testCases := []struct {
+		name     string
+		tx       sdktypes.Tx
+		expError string
+	}{
+		{
+			name: "pass: ethereum tx option",
+			tx:   newTx(ethTxTypeURL),
+		},
+		{
+			name: "pass: all the additional options",
+			tx: newTx(
+				ethTxTypeURL,
+				evmtypes.SponsorExtensionOptionTypeURL,
+				evmtypes.FeeDenomExtensionOptionTypeURL,
+				evmtypes.MultisigExtensionOptionTypeURL,
+			),
+		},
+		{
+			name:     "fail: no options",
+			tx:       newTx(),
+			expError: "length of ExtensionOptions should be between 1 and 4",
+		},
+		{
+			name: "fail: more than 4 options",
+			tx: newTx(
+				ethTxTypeURL,
+				evmtypes.SponsorExtensionOptionTypeURL,
+				evmtypes.FeeDenomExtensionOptionTypeURL,
+				evmtypes.MultisigExtensionOptionTypeURL,
+				evmtypes.SponsorExtensionOptionTypeURL,
+			),
+			expError: "length of ExtensionOptions should be between 1 and 4",
+		},
+		{
+			name:     "fail: duplicated option",
+			tx:       newTx(ethTxTypeURL, evmtypes.SponsorExtensionOptionTypeURL, evmtypes.SponsorExtensionOptionTypeURL),
+			expError: "the additional ExtensionOptions should be",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := evm.ValidateTx(tc.tx)
+			if tc.expError != "" {
+				suite.Require().ErrorIs(err, errortypes.ErrInvalidRequest)
+				suite.Require().ErrorContains(err, tc.expError)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	evmtypes "github.com/AizelNetwork/CosmEvm/x/evm/types"
 )
@@ -41,12 +42,33 @@ func (esvd EthSigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, s
 		return ctx, errorsmod.Wrap(errortypes.ErrUnknownRequest, "invalid transaction. Transaction without messages")
 	}
 
+	multisigOption, err := evmtypes.GetMultisigOption(tx)
+	if err != nil {
+		return ctx, err
+	}
+	if multisigOption != nil {
+		if err := multisigOption.ValidateBasic(); err != nil {
+			return ctx, err
+		}
+	}
+	feeDenom, err := evmtypes.GetFeeDenom(tx)
+	if err != nil {
+		return ctx, err
+	}
+
 	for _, msg := range msgs {
 		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
 		if !ok {
 			return ctx, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil))
 		}
 
+		if multisigOption != nil {
+			if _, err := MultisigSignatureVerification(msgEthTx, signer, multisigOption, feeDenom); err != nil {
+				return ctx, err
+			}
+			continue
+		}
+
 		err := SignatureVerification(msgEthTx, signer, allowUnprotectedTxs)
 		if err != nil {
 			return ctx, err
@@ -86,3 +108,34 @@ func SignatureVerification(
 	msg.From = sender.Hex()
 	return nil
 }
+
+// MultisigSignatureVerification checks that the Ethereum transaction of the
+// message carries the multisig signature of the sender, which commits its hash
+// to the sender, and that the multisig extension option holds the
+// signatures of the threshold of the multisig keys over its signing hash, which
+// commits to the chain id, and the fee denom of the tx. The function sets the
+// field from of the given message equal to the sender derived from the multisig
+// public key, and returns it.
+func MultisigSignatureVerification(
+	msg *evmtypes.MsgEthereumTx,
+	signer ethtypes.Signer,
+	option *evmtypes.ExtensionOptionMultisig,
+	feeDenom string,
+) (common.Address, error) {
+	sender, err := option.VerifySender(signer, msg.AsTransaction(), feeDenom)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	// the from address is kept in the tx for clients to know the sender of
+	// the multisig transaction
+	if msg.From != "" && common.HexToAddress(msg.From) != sender {
+		return common.Address{}, errorsmod.Wrapf(
+			evmtypes.ErrInvalidMultisig,
+			"from address %s doesn't match the multisig sender %s", msg.From, sender,
+		)
+	}
+
+	msg.From = sender.Hex()
+	return sender, nil
+}
@@ -45,3 +45,38 @@ func VerifyAccountBalance(
 
 	return nil
 }
+
+// VerifyAccountValueBalance checks that the account balance is greater than
+// the value of a transaction whose fees are not paid by the account in the evm
+// denom, i.e. fees paid by a sponsor or in a fee denom.
+// The account will be set to store if it doesn't exist, i.e. cannot be found on store.
+func VerifyAccountValueBalance(
+	ctx sdk.Context,
+	accountKeeper evmtypes.AccountKeeper,
+	account *statedb.Account,
+	from common.Address,
+	txData evmtypes.TxData,
+) error {
+	// Only EOA are allowed to send transactions.
+	if account != nil && account.IsContract() {
+		return errorsmod.Wrapf(
+			errortypes.ErrInvalidType,
+			"the sender is not EOA: address %s", from,
+		)
+	}
+
+	if account == nil {
+		acc := accountKeeper.NewAccountWithAddress(ctx, from.Bytes())
+		accountKeeper.SetAccount(ctx, acc)
+		account = statedb.NewEmptyAccount()
+	}
+
+	if value := txData.GetValue(); value != nil && account.Balance.Cmp(value) < 0 {
+		return errorsmod.Wrapf(
+			errortypes.ErrInsufficientFunds,
+			"sender balance < tx value (%s < %s)", account.Balance, value,
+		)
+	}
+
+	return nil
+}
@@ -8,8 +8,11 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	"github.com/AizelNetwork/CosmEvm/types"
 	evmtypes "github.com/AizelNetwork/CosmEvm/x/evm/types"
+	feemarkettypes "github.com/AizelNetwork/CosmEvm/x/feemarket/types"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -34,7 +37,8 @@ func UpdateCumulativeGasWanted(
 }
 
 type ConsumeGasKeepers struct {
-	Evm EVMKeeper
+	Evm  EVMKeeper
+	Bank evmtypes.BankKeeper
 }
 
 // ConsumeFeesAndEmitEvent deduces fees from sender and emits the event
@@ -73,6 +77,19 @@ func deductFees(
 		return nil
 	}
 
+	// fees paid in a fee denom are sent to the fee collector as they are, in
+	// their original decimals. They are not swapped to the evm denom and are
+	// distributed in the fee denom, without being burned.
+	if len(fees) == 1 && fees[0].Denom != evmtypes.GetEVMCoinDenom() {
+		if keepers.Bank == nil {
+			return errorsmod.Wrapf(evmtypes.ErrInvalidFeeDenom, "cannot deduct fees in %s", fees[0].Denom)
+		}
+		if err := keepers.Bank.SendCoinsFromAccountToModule(ctx, feePayer, authtypes.FeeCollectorName, fees); err != nil {
+			return errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "failed to deduct fees %s from %s: %s", fees, feePayer, err)
+		}
+		return nil
+	}
+
 	if err := keepers.Evm.DeductTxCostsFromUserBalance(
 		ctx,
 		fees,
@@ -84,6 +101,93 @@ func deductFees(
 	return nil
 }
 
+// VerifySponsor checks that the fees of a sponsored Ethereum tx don't exceed
+// the fee limit of the sponsor and returns the sponsor address. The sponsor
+// either signs the Ethereum tx hash and the fee limit, or grants a fee
+// allowance to the sender, which is used here with the paid fees.
+func VerifySponsor(
+	ctx sdktypes.Context,
+	feegrantKeeper ante.FeegrantKeeper,
+	option *evmtypes.ExtensionOptionSponsor,
+	fees sdktypes.Coins,
+	paidFees sdktypes.Coins,
+	sender sdktypes.AccAddress,
+	txHash common.Hash,
+	msgs []sdktypes.Msg,
+) (sdktypes.AccAddress, error) {
+	sponsor, err := sdktypes.AccAddressFromBech32(option.Sponsor)
+	if err != nil {
+		return nil, errorsmod.Wrapf(evmtypes.ErrInvalidSponsor, "invalid sponsor address: %s", err)
+	}
+
+	if feeAmt := fees.AmountOf(evmtypes.GetEVMCoinDenom()); feeAmt.GT(option.FeeLimit) {
+		return nil, errorsmod.Wrapf(
+			evmtypes.ErrInvalidSponsor,
+			"tx fee exceeds the sponsor fee limit (%s > %s)", feeAmt, option.FeeLimit,
+		)
+	}
+
+	if len(option.Signature) > 0 {
+		if err := option.VerifySignature(txHash); err != nil {
+			return nil, err
+		}
+		return sponsor, nil
+	}
+
+	if feegrantKeeper == nil {
+		return nil, errorsmod.Wrap(evmtypes.ErrInvalidSponsor, "fee grants are not enabled")
+	}
+
+	// NOTE: the allowance is represented in the original decimals
+	if err := feegrantKeeper.UseGrantedFees(ctx, sponsor, sender, paidFees, msgs); err != nil {
+		return nil, errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", sponsor, sender)
+	}
+
+	return sponsor, nil
+}
+
+// VerifyFeeDenom checks that the fee denom of an Ethereum tx was authorised by
+// its fee payer, since the fee denom extension option isn't covered by the
+// Ethereum signature of the tx. The fee denom is signed by the sponsor if it
+// signs the tx, or else by the sender. The fee denom of a multisig sender is
+// already covered by the signatures of the multisig keys.
+func VerifyFeeDenom(
+	option *evmtypes.ExtensionOptionFeeDenom,
+	sponsorOption *evmtypes.ExtensionOptionSponsor,
+	sender common.Address,
+	isMultisig bool,
+	txHash common.Hash,
+) error {
+	if sponsorOption != nil && len(sponsorOption.Signature) > 0 {
+		sponsor, err := sdktypes.AccAddressFromBech32(sponsorOption.Sponsor)
+		if err != nil {
+			return errorsmod.Wrapf(evmtypes.ErrInvalidSponsor, "invalid sponsor address: %s", err)
+		}
+		return option.VerifySignature(txHash, common.BytesToAddress(sponsor))
+	}
+
+	if isMultisig {
+		return nil
+	}
+
+	return option.VerifySignature(txHash, sender)
+}
+
+// ConvertFeesToFeeDenom converts the fees of an Ethereum tx, in the evm denom
+// with 18 decimals, to the fee denom with the given price. The converted
+// amount is rounded up.
+func ConvertFeesToFeeDenom(fees sdktypes.Coins, price feemarkettypes.FeeDenomPrice) sdktypes.Coins {
+	evmAmount := evmtypes.ConvertBigIntFrom18DecimalsToLegacyDec(fees.AmountOf(evmtypes.GetEVMCoinDenom()).BigInt())
+
+	// NOTE: create a new coins slice without having to validate the denom
+	return sdktypes.Coins{
+		{
+			Denom:  price.Denom,
+			Amount: price.FromEVMDenom(evmAmount).Ceil().TruncateInt(),
+		},
+	}
+}
+
 // GetMsgPriority returns the priority of an Eth Tx capped by the minimum priority
 func GetMsgPriority(
 	txData evmtypes.TxData,
@@ -71,3 +71,24 @@ func (suite *EvmAnteTestSuite) TestIncrementSequence() {
 		})
 	}
 }
+
+func (suite *EvmAnteTestSuite) TestCheckQueuedNonce() {
+	keyring := testkeyring.New(1)
+	unitNetwork := network.NewUnitTestNetwork(
+		network.WithChainID(suite.chainID),
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	grpcHandler := grpc.NewIntegrationHandler(unitNetwork)
+
+	account, err := grpcHandler.GetAccount(keyring.GetAccAddr(0).String())
+	suite.Require().NoError(err)
+	suite.Require().NoError(account.SetSequence(2))
+
+	// future nonces are accepted without incrementing the sequence
+	suite.Require().NoError(evm.CheckQueuedNonce(account, 2))
+	suite.Require().NoError(evm.CheckQueuedNonce(account, 7))
+	suite.Require().Equal(uint64(2), account.GetSequence())
+
+	err = evm.CheckQueuedNonce(account, 1)
+	suite.Require().ErrorContains(err, errortypes.ErrInvalidSequence.Error())
+}
@@ -38,3 +38,21 @@ func IncrementNonce(
 	accountKeeper.SetAccount(ctx, account)
 	return nil
 }
+
+// CheckQueuedNonce verifies the nonce of an Ethereum tx when future nonces are
+// queued by the app-side mempool. Txs with a nonce equal to or above the
+// account sequence are accepted and the sequence is not incremented, so that
+// txs with a gap can be queued and pending txs can be replaced by fee. It must
+// only be used on CheckTx, as the mempool only selects txs with consecutive
+// nonces for proposals.
+func CheckQueuedNonce(account sdk.AccountI, txNonce uint64) error {
+	nonce := account.GetSequence()
+	if txNonce < nonce {
+		return errorsmod.Wrapf(
+			errortypes.ErrInvalidSequence,
+			"invalid nonce; got %d, expected %d or higher", txNonce, nonce,
+		)
+	}
+
+	return nil
+}
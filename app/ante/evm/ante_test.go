@@ -7,18 +7,22 @@ import (
 	"time"
 
 	sdkmath "cosmossdk.io/math"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 
+	"github.com/AizelNetwork/CosmEvm/crypto/ethsecp256k1"
 	utiltx "github.com/AizelNetwork/CosmEvm/testutil/tx"
 	evmtypes "github.com/AizelNetwork/CosmEvm/x/evm/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	ethparams "github.com/ethereum/go-ethereum/params"
 )
 
@@ -917,6 +921,109 @@ func (suite *AnteTestSuite) TestAnteHandler() {
 	}
 }
 
+func (suite *AnteTestSuite) TestAnteHandlerFeeDenom() {
+	var (
+		ctx     sdk.Context
+		privKey cryptotypes.PrivKey
+	)
+	to := utiltx.GenerateAddress()
+	feeDenom := "uusdc"
+
+	setup := func() {
+		suite.WithFeemarketEnabled(false)
+		baseFee := sdkmath.LegacyNewDec(100)
+		suite.WithBaseFee(&baseFee)
+		suite.SetupTest() // reset
+
+		fromKey := suite.GetKeyring().GetKey(0)
+		privKey = fromKey.Priv
+		nw := suite.GetNetwork()
+		ctx = nw.GetContext()
+
+		params := nw.App.FeeMarketKeeper.GetParams(ctx)
+		params.FeeDenoms = []string{feeDenom}
+		params.MaxPriceAge = 60
+		suite.Require().NoError(nw.App.FeeMarketKeeper.SetParams(ctx, params))
+		suite.Require().NoError(nw.App.FeeMarketKeeper.UpdateFeeDenomPrice(ctx, feeDenom, sdkmath.LegacyNewDec(2)))
+		suite.Require().NoError(nw.FundAccount(fromKey.AccAddr, sdk.NewCoins(sdk.NewCoin(feeDenom, sdkmath.NewInt(1e18)))))
+	}
+
+	ethTxParams := evmtypes.EvmTxArgs{
+		ChainID:  evmtypes.GetEthChainConfig().ChainID,
+		To:       &to,
+		Nonce:    0,
+		Amount:   big.NewInt(10),
+		GasLimit: 100000,
+		GasPrice: big.NewInt(150),
+	}
+
+	// sign signs the fee denom of the tx with the given key, nil leaves the
+	// fee denom unsigned as when the tx is re-wrapped by a third party
+	sign := func(key cryptotypes.PrivKey, txHash common.Hash) []byte {
+		if key == nil {
+			return nil
+		}
+		ecdsaKey, err := key.(*ethsecp256k1.PrivKey).ToECDSA()
+		suite.Require().NoError(err)
+		sig, err := crypto.Sign(evmtypes.FeeDenomSignBytes(txHash, feeDenom), ecdsaKey)
+		suite.Require().NoError(err)
+		return sig
+	}
+
+	testCases := []struct {
+		name    string
+		signer  func() cryptotypes.PrivKey
+		expPass bool
+	}{
+		{
+			"success - fee denom signed by the sender",
+			func() cryptotypes.PrivKey { return privKey },
+			true,
+		},
+		{
+			"fail - fee denom added by a re-wrapper of the tx",
+			func() cryptotypes.PrivKey { return nil },
+			false,
+		},
+		{
+			"fail - fee denom signed by another account",
+			func() cryptotypes.PrivKey { return suite.GetKeyring().GetKey(1).Priv },
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			setup()
+
+			tx, err := suite.GetTxFactory().GenerateSignedEthTx(privKey, ethTxParams)
+			suite.Require().NoError(err)
+			ethMsg, ok := tx.GetMsgs()[0].(*evmtypes.MsgEthereumTx)
+			suite.Require().True(ok)
+
+			ethOption, err := codectypes.NewAnyWithValue(&evmtypes.ExtensionOptionsEthereumTx{})
+			suite.Require().NoError(err)
+			feeDenomOption, err := codectypes.NewAnyWithValue(&evmtypes.ExtensionOptionFeeDenom{
+				Denom:     feeDenom,
+				Signature: sign(tc.signer(), ethMsg.AsTransaction().Hash()),
+			})
+			suite.Require().NoError(err)
+			txBuilder, err := suite.GetClientCtx().TxConfig.WrapTxBuilder(tx)
+			suite.Require().NoError(err)
+			builder, ok := txBuilder.(authtx.ExtensionOptionsTxBuilder)
+			suite.Require().True(ok)
+			builder.SetExtensionOptions(ethOption, feeDenomOption)
+
+			_, err = suite.GetAnteHandler()(ctx, txBuilder.GetTx(), false)
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, evmtypes.ErrInvalidFeeDenom)
+			}
+		})
+	}
+}
+
 func (suite *AnteTestSuite) TestAnteHandlerWithDynamicTxFee() {
 	addr, privKey := utiltx.NewAddrKey()
 	to := utiltx.GenerateAddress()
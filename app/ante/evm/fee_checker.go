@@ -4,12 +4,14 @@ package evm
 
 import (
 	"math"
+	"math/big"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 
 	aizeltypes "github.com/AizelNetwork/CosmEvm/types"
 	"github.com/AizelNetwork/CosmEvm/x/evm/types"
+	feemarkettypes "github.com/AizelNetwork/CosmEvm/x/feemarket/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
@@ -61,6 +63,14 @@ func feeChecker(
 		baseFee = sdkmath.LegacyZeroDec()
 	}
 
+	// Ethereum txs carry the fee cap and the priority tip cap in the messages
+	// data instead of the tx fee and the dynamic fee extension option.
+	if msgs := feeTx.GetMsgs(); len(msgs) > 0 {
+		if _, ok := msgs[0].(*types.MsgEthereumTx); ok {
+			return ethereumTxFeeChecker(baseFee, msgs)
+		}
+	}
+
 	// default to `MaxInt64` when there's no extension option.
 	maxPriorityPrice := sdkmath.LegacyNewDec(math.MaxInt64)
 
@@ -90,6 +100,16 @@ func feeChecker(
 	feeCoins := feeTx.GetFee()
 	feeAmtDec := sdkmath.LegacyNewDecFromInt(feeCoins.AmountOfNoDenomValidation(denom))
 
+	// fees paid in a fee denom are converted to the evm denom with the price
+	// of the fee denom
+	feeDenomPrice, isFeeDenom, err := getFeeDenomPrice(ctx, k, feeCoins)
+	if err != nil {
+		return nil, 0, err
+	}
+	if isFeeDenom {
+		feeAmtDec = feeDenomPrice.ToEVMDenom(sdkmath.LegacyNewDecFromInt(feeCoins[0].Amount))
+	}
+
 	feeCap := feeAmtDec.QuoInt(gas)
 
 	if feeCap.LT(baseFee) {
@@ -107,6 +127,15 @@ func feeChecker(
 		},
 	}
 
+	if isFeeDenom {
+		effectiveFee = sdk.Coins{
+			{
+				Denom:  feeDenomPrice.Denom,
+				Amount: feeDenomPrice.FromEVMDenom(effectivePrice.MulInt(gas)).Ceil().RoundInt(),
+			},
+		}
+	}
+
 	priorityInt := effectivePrice.Sub(baseFee).QuoInt(types.DefaultPriorityReduction).TruncateInt()
 	priority := int64(math.MaxInt64)
 
@@ -117,6 +146,66 @@ func feeChecker(
 	return effectiveFee, priority, nil
 }
 
+// getFeeDenomPrice returns the price of the fee denom used to pay the given
+// fees, if the fees are paid with a single coin that isn't the evm denom.
+func getFeeDenomPrice(
+	ctx sdk.Context,
+	k FeeMarketKeeper,
+	fees sdk.Coins,
+) (feemarkettypes.FeeDenomPrice, bool, error) {
+	if len(fees) != 1 || fees[0].Denom == types.GetEVMCoinDenom() {
+		return feemarkettypes.FeeDenomPrice{}, false, nil
+	}
+
+	price, err := k.GetValidFeeDenomPrice(ctx, fees[0].Denom)
+	if err != nil {
+		return feemarkettypes.FeeDenomPrice{}, false, errorsmod.Wrap(errortypes.ErrInsufficientFee, err.Error())
+	}
+
+	return price, true, nil
+}
+
+// ethereumTxFeeChecker returns the effective fee and priority of an Ethereum
+// tx using the EIP-1559 effective gas price of each message. The priority of
+// the tx is the lowest priority of its messages.
+func ethereumTxFeeChecker(baseFee sdkmath.LegacyDec, msgs []sdk.Msg) (sdk.Coins, int64, error) {
+	// the messages data is represented in 18 decimals
+	baseFeeWei := types.ConvertAmountTo18DecimalsLegacy(baseFee).TruncateInt().BigInt()
+
+	fee := new(big.Int)
+	priority := int64(math.MaxInt64)
+	for _, msg := range msgs {
+		ethMsg, ok := msg.(*types.MsgEthereumTx)
+		if !ok {
+			return nil, 0, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid message type %T, expected %T", msg, (*types.MsgEthereumTx)(nil))
+		}
+
+		txData, err := types.UnpackTxData(ethMsg.Data)
+		if err != nil {
+			return nil, 0, errorsmod.Wrap(err, "failed to unpack tx data")
+		}
+
+		if txData.GetGasFeeCap().Cmp(baseFeeWei) < 0 {
+			return nil, 0, errorsmod.Wrapf(errortypes.ErrInsufficientFee, "gas fee cap too low, got: %s required: %s", txData.GetGasFeeCap(), baseFeeWei)
+		}
+
+		fee.Add(fee, txData.EffectiveFee(baseFeeWei))
+		if msgPriority := types.GetTxPriority(txData, baseFeeWei); msgPriority < priority {
+			priority = msgPriority
+		}
+	}
+
+	// NOTE: create a new coins slice without having to validate the denom
+	effectiveFee := sdk.Coins{
+		{
+			Denom:  types.GetEVMCoinDenom(),
+			Amount: sdkmath.NewIntFromBigInt(types.ConvertAmountFrom18DecimalsBigInt(fee)),
+		},
+	}
+
+	return effectiveFee, priority, nil
+}
+
 // checkTxFeeWithValidatorMinGasPrices implements the default fee logic, where the minimum price per
 // unit of gas is fixed and set by each validator, and the tx priority is computed from the gas price.
 func checkTxFeeWithValidatorMinGasPrices(ctx sdk.Context, tx sdk.FeeTx) (sdk.Coins, int64, error) {
@@ -22,7 +22,8 @@ import (
 var _ evm.FeeMarketKeeper = MockFeemarketKeeper{}
 
 type MockFeemarketKeeper struct {
-	BaseFee math.LegacyDec
+	BaseFee       math.LegacyDec
+	FeeDenomPrice feemarkettypes.FeeDenomPrice
 }
 
 func (m MockFeemarketKeeper) GetBaseFee(_ sdk.Context) math.LegacyDec {
@@ -41,6 +42,13 @@ func (m MockFeemarketKeeper) GetParams(_ sdk.Context) (params feemarkettypes.Par
 	return feemarkettypes.DefaultParams()
 }
 
+func (m MockFeemarketKeeper) GetValidFeeDenomPrice(_ sdk.Context, denom string) (feemarkettypes.FeeDenomPrice, error) {
+	if denom != m.FeeDenomPrice.Denom {
+		return feemarkettypes.FeeDenomPrice{}, feemarkettypes.ErrInvalidFeeDenom
+	}
+	return m.FeeDenomPrice, nil
+}
+
 func TestSDKTxFeeChecker(t *testing.T) {
 	// testCases:
 	//   fallback
@@ -239,6 +247,103 @@ func TestSDKTxFeeChecker(t *testing.T) {
 			0,
 			false,
 		},
+		{
+			"success, dynamic fee in fee denom",
+			deliverTxCtx,
+			MockFeemarketKeeper{
+				BaseFee:       math.LegacyNewDec(10),
+				FeeDenomPrice: feemarkettypes.NewFeeDenomPrice("uusdc", math.LegacyNewDec(2), 0),
+			},
+			func() sdk.FeeTx {
+				txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+				txBuilder.SetGasLimit(1)
+				txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(10).Mul(evmtypes.DefaultPriorityReduction).Add(math.NewInt(10)))))
+				return txBuilder.GetTx()
+			},
+			true,
+			"10000010uusdc",
+			20,
+			true,
+		},
+		{
+			"fail, dynamic fee in fee denom below base fee",
+			deliverTxCtx,
+			MockFeemarketKeeper{
+				BaseFee:       math.LegacyNewDec(10),
+				FeeDenomPrice: feemarkettypes.NewFeeDenomPrice("uusdc", math.LegacyNewDec(2), 0),
+			},
+			func() sdk.FeeTx {
+				txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+				txBuilder.SetGasLimit(1)
+				txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(4))))
+				return txBuilder.GetTx()
+			},
+			true,
+			"",
+			0,
+			false,
+		},
+		{
+			"fail, dynamic fee in a denom not accepted",
+			deliverTxCtx,
+			MockFeemarketKeeper{
+				BaseFee: math.LegacyNewDec(10),
+			},
+			func() sdk.FeeTx {
+				txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+				txBuilder.SetGasLimit(1)
+				txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(100))))
+				return txBuilder.GetTx()
+			},
+			true,
+			"",
+			0,
+			false,
+		},
+		{
+			"success, ethereum tx tip cap",
+			deliverTxCtx,
+			MockFeemarketKeeper{
+				BaseFee: math.LegacyNewDec(10),
+			},
+			func() sdk.FeeTx {
+				msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+					ChainID:   evmtypes.GetEthChainConfig().ChainID,
+					GasLimit:  1,
+					GasFeeCap: new(big.Int).Add(new(big.Int).Mul(big.NewInt(5), evmtypes.DefaultPriorityReduction.BigInt()), big.NewInt(10)),
+					GasTipCap: new(big.Int).Mul(big.NewInt(2), evmtypes.DefaultPriorityReduction.BigInt()),
+				})
+				tx, err := msg.BuildTx(encodingConfig.TxConfig.NewTxBuilder(), baseDenom)
+				require.NoError(t, err)
+				return tx
+			},
+			true,
+			"2000010aaizel",
+			2,
+			true,
+		},
+		{
+			"fail, ethereum tx fee cap below base fee",
+			deliverTxCtx,
+			MockFeemarketKeeper{
+				BaseFee: math.LegacyNewDec(10),
+			},
+			func() sdk.FeeTx {
+				msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+					ChainID:   evmtypes.GetEthChainConfig().ChainID,
+					GasLimit:  1,
+					GasFeeCap: big.NewInt(9),
+					GasTipCap: big.NewInt(1),
+				})
+				tx, err := msg.BuildTx(encodingConfig.TxConfig.NewTxBuilder(), baseDenom)
+				require.NoError(t, err)
+				return tx
+			},
+			true,
+			"",
+			0,
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
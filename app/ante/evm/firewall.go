@@ -0,0 +1,78 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+package evm
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	anteutils "github.com/AizelNetwork/CosmEvm/app/ante/utils"
+	evmtypes "github.com/AizelNetwork/CosmEvm/x/evm/types"
+)
+
+// firewallTxType is the metrics label of the Ethereum txs rejected by the
+// firewall.
+const firewallTxType = "evm"
+
+// FirewallDecorator rejects the Ethereum txs received on CheckTx whose sender,
+// recipient or function selector is denied by the node firewall, or whose
+// sender exceeded its rate limit. It must run after the signature
+// verification so that the txs are not counted for a forged sender.
+type FirewallDecorator struct {
+	firewall *anteutils.Firewall
+}
+
+// NewFirewallDecorator creates a new FirewallDecorator. A nil firewall
+// accepts all the txs.
+func NewFirewallDecorator(firewall *anteutils.Firewall) FirewallDecorator {
+	return FirewallDecorator{
+		firewall: firewall,
+	}
+}
+
+// AnteHandle checks the Ethereum txs against the firewall. The txs are only
+// counted for the rate limit on CheckTx, as they are already counted when
+// they are rechecked.
+func (fd FirewallDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if fd.firewall == nil || !ctx.IsCheckTx() || simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	msgs := tx.GetMsgs()
+	senders := make([]common.Address, 0, len(msgs))
+	for _, msg := range msgs {
+		ethMsg, txData, err := evmtypes.UnpackEthMsg(msg)
+		if err != nil {
+			return ctx, err
+		}
+
+		sender := common.BytesToAddress(ethMsg.GetFrom())
+		if err := fd.firewall.CheckSender(sender); err != nil {
+			anteutils.IncrRejectedTxCounter(firewallTxType, anteutils.FirewallReasonSender)
+			return ctx, err
+		}
+
+		if to := txData.GetTo(); to != nil {
+			if err := fd.firewall.CheckRecipient(*to); err != nil {
+				anteutils.IncrRejectedTxCounter(firewallTxType, anteutils.FirewallReasonRecipient)
+				return ctx, err
+			}
+
+			if err := fd.firewall.CheckSelector(txData.GetData()); err != nil {
+				anteutils.IncrRejectedTxCounter(firewallTxType, anteutils.FirewallReasonSelector)
+				return ctx, err
+			}
+		}
+
+		senders = append(senders, sender)
+	}
+
+	if !ctx.IsReCheckTx() {
+		if err := fd.firewall.RateLimit(ctx, senders...); err != nil {
+			anteutils.IncrRejectedTxCounter(firewallTxType, anteutils.FirewallReasonRateLimit)
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -40,6 +40,9 @@ type FeeMarketKeeper interface {
 	AddTransientGasWanted(ctx sdk.Context, gasWanted uint64) (uint64, error)
 	GetBaseFeeEnabled(ctx sdk.Context) bool
 	GetBaseFee(ctx sdk.Context) math.LegacyDec
+	// GetValidFeeDenomPrice returns the price of a denom accepted to pay fees
+	// besides the evm denom, failing if the price is stale
+	GetValidFeeDenomPrice(ctx sdk.Context, denom string) (feemarkettypes.FeeDenomPrice, error)
 }
 
 type protoTxProvider interface {
@@ -12,6 +12,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -20,6 +21,7 @@ import (
 	anteutils "github.com/AizelNetwork/CosmEvm/app/ante/utils"
 	evmkeeper "github.com/AizelNetwork/CosmEvm/x/evm/keeper"
 	evmtypes "github.com/AizelNetwork/CosmEvm/x/evm/types"
+	feemarkettypes "github.com/AizelNetwork/CosmEvm/x/feemarket/types"
 )
 
 var _ sdk.AnteDecorator = &EthSetupContextDecorator{}
@@ -31,9 +33,11 @@ type MonoDecorator struct {
 	bankKeeper         evmtypes.BankKeeper
 	feeMarketKeeper    FeeMarketKeeper
 	evmKeeper          EVMKeeper
+	feegrantKeeper     ante.FeegrantKeeper
 	distributionKeeper anteutils.DistributionKeeper
 	stakingKeeper      anteutils.StakingKeeper
 	maxGasWanted       uint64
+	queueFutureNonces  bool
 }
 
 type DecoratorUtils struct {
@@ -56,18 +60,22 @@ func NewMonoDecorator(
 	bankKeeper evmtypes.BankKeeper,
 	feeMarketKeeper FeeMarketKeeper,
 	evmKeeper EVMKeeper,
+	feegrantKeeper ante.FeegrantKeeper,
 	distributionKeeper anteutils.DistributionKeeper,
 	stakingKeeper anteutils.StakingKeeper,
 	maxGasWanted uint64,
+	queueFutureNonces bool,
 ) MonoDecorator {
 	return MonoDecorator{
 		accountKeeper:      accountKeeper,
 		bankKeeper:         bankKeeper,
 		feeMarketKeeper:    feeMarketKeeper,
 		evmKeeper:          evmKeeper,
+		feegrantKeeper:     feegrantKeeper,
 		distributionKeeper: distributionKeeper,
 		stakingKeeper:      stakingKeeper,
 		maxGasWanted:       maxGasWanted,
+		queueFutureNonces:  queueFutureNonces,
 	}
 }
 
@@ -141,6 +149,56 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		}
 	}
 
+	// the txs of a bundle are executed atomically, a failed tx reverts the
+	// whole bundle
+	if evmtypes.IsBundle(tx.GetMsgs()) {
+		if err := ValidateBundle(ctx, tx); err != nil {
+			return ctx, err
+		}
+		ctx = evmtypes.ContextWithBundle(ctx)
+	}
+
+	// the fees of a sponsored tx are paid by the sponsor, which also receives
+	// the refund of the leftover gas
+	sponsorOption, err := evmtypes.GetSponsorOption(tx)
+	if err != nil {
+		return ctx, err
+	}
+	if sponsorOption != nil {
+		if err := sponsorOption.ValidateBasic(); err != nil {
+			return ctx, err
+		}
+	}
+
+	// the fees paid in a fee denom are converted from the evm denom with the
+	// price of the fee denom, which is also used to refund the leftover gas
+	feeDenomOption, err := evmtypes.GetFeeDenomOption(tx)
+	if err != nil {
+		return ctx, err
+	}
+	var feeDenomPrice feemarkettypes.FeeDenomPrice
+	if feeDenomOption != nil {
+		if err := feeDenomOption.ValidateBasic(); err != nil {
+			return ctx, err
+		}
+		feeDenomPrice, err = md.feeMarketKeeper.GetValidFeeDenomPrice(ctx, feeDenomOption.Denom)
+		if err != nil {
+			return ctx, errorsmod.Wrap(evmtypes.ErrInvalidFeeDenom, err.Error())
+		}
+	}
+
+	// the tx of a multisig sender is signed by the threshold of the multisig
+	// keys in the multisig extension option
+	multisigOption, err := evmtypes.GetMultisigOption(tx)
+	if err != nil {
+		return ctx, err
+	}
+	if multisigOption != nil {
+		if err := multisigOption.ValidateBasic(); err != nil {
+			return ctx, err
+		}
+	}
+
 	// 1. setup ctx
 	ctx, err = SetupContextAndResetTransientGas(ctx, tx, md.evmKeeper)
 	if err != nil {
@@ -158,7 +216,7 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		return ctx, errorsmod.Wrap(errortypes.ErrUnknownRequest, "invalid transaction. Transaction without messages")
 	}
 
-	// NOTE: the protocol does not support multiple EVM messages currently so
+	// NOTE: multiple EVM messages are only supported in bundles, otherwise
 	// this loop will complete after the first message.
 	for i, msg := range msgs {
 		ethMsg, txData, err := evmtypes.UnpackEthMsg(msg)
@@ -198,16 +256,39 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		}
 
 		// 4. validate msg contents
+		// NOTE: the from address of a multisig sender is verified against the
+		// multisig public key
+		msgFrom := ethMsg.GetFrom()
+		if multisigOption != nil {
+			msgFrom = nil
+		}
 		if err := ValidateMsg(
 			decUtils.EvmParams,
 			txData,
-			ethMsg.GetFrom(),
+			msgFrom,
 		); err != nil {
 			return ctx, err
 		}
 
 		// 5. signature verification
-		if err := SignatureVerification(
+		signer := decUtils.Signer
+		if multisigOption != nil {
+			var feeDenom string
+			if feeDenomOption != nil {
+				feeDenom = feeDenomOption.Denom
+			}
+			sender, err := MultisigSignatureVerification(
+				ethMsg,
+				decUtils.Signer,
+				multisigOption,
+				feeDenom,
+			)
+			if err != nil {
+				return ctx, err
+			}
+			signer = evmtypes.NewMultisigSigner(decUtils.Signer, sender)
+			ctx = evmtypes.ContextWithMultisigSender(ctx, sender)
+		} else if err := SignatureVerification(
 			ethMsg,
 			decUtils.Signer,
 			decUtils.EvmParams.AllowUnprotectedTxs,
@@ -223,7 +304,11 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		// using a wrapper of the bank keeper as a dependency to scale all
 		// balances to 18 decimals.
 		account := md.evmKeeper.GetAccount(ctx, fromAddr)
-		if err := VerifyAccountBalance(
+		verifyBalance := VerifyAccountBalance
+		if sponsorOption != nil || feeDenomOption != nil {
+			verifyBalance = VerifyAccountValueBalance
+		}
+		if err := verifyBalance(
 			ctx,
 			md.accountKeeper,
 			account,
@@ -234,11 +319,11 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		}
 
 		// 7. can transfer
-		coreMsg, err := ethMsg.AsMessage(decUtils.Signer, decUtils.BaseFee)
+		coreMsg, err := ethMsg.AsMessage(signer, decUtils.BaseFee)
 		if err != nil {
 			return ctx, errorsmod.Wrapf(
 				err,
-				"failed to create an ethereum core.Message from signer %T", decUtils.Signer,
+				"failed to create an ethereum core.Message from signer %T", signer,
 			)
 		}
 
@@ -286,13 +371,56 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 			return ctx, err
 		}
 
+		// NOTE: the paid fees are represented in the original decimals when
+		// paid in a fee denom
+		paidFees := msgFees
+		if feeDenomOption != nil {
+			paidFees = ConvertFeesToFeeDenom(msgFees, feeDenomPrice)
+			ctx = evmtypes.ContextWithFeeDenom(ctx, feeDenomPrice)
+		}
+
+		feePayer := from
+		if sponsorOption != nil {
+			grantedFees := paidFees
+			if feeDenomOption == nil {
+				grantedFees = evmtypes.ConvertCoinsFrom18Decimals(msgFees)
+			}
+			feePayer, err = VerifySponsor(
+				ctx,
+				md.feegrantKeeper,
+				sponsorOption,
+				msgFees,
+				grantedFees,
+				from,
+				ethMsg.AsTransaction().Hash(),
+				msgs,
+			)
+			if err != nil {
+				return ctx, err
+			}
+			ctx = evmtypes.ContextWithSponsor(ctx, feePayer)
+		}
+
+		if feeDenomOption != nil {
+			if err := VerifyFeeDenom(
+				feeDenomOption,
+				sponsorOption,
+				fromAddr,
+				multisigOption != nil,
+				ethMsg.AsTransaction().Hash(),
+			); err != nil {
+				return ctx, err
+			}
+		}
+
 		err = ConsumeFeesAndEmitEvent(
 			ctx,
 			&ConsumeGasKeepers{
-				Evm: md.evmKeeper,
+				Evm:  md.evmKeeper,
+				Bank: md.bankKeeper,
 			},
-			msgFees,
-			from,
+			paidFees,
+			feePayer,
 		)
 		if err != nil {
 			return ctx, err
@@ -322,7 +450,13 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		decUtils.TxGasLimit += gas
 
 		// 10. increment sequence
-		if err := IncrementNonce(ctx, md.accountKeeper, acc, txData.GetNonce()); err != nil {
+		// NOTE: when future nonces are queued by the app-side mempool, the
+		// sequence is only incremented when the tx is executed in a block.
+		if ctx.IsCheckTx() && md.queueFutureNonces {
+			if err := CheckQueuedNonce(acc, txData.GetNonce()); err != nil {
+				return ctx, err
+			}
+		} else if err := IncrementNonce(ctx, md.accountKeeper, acc, txData.GetNonce()); err != nil {
 			return ctx, err
 		}
 
@@ -36,6 +36,12 @@ type HandlerOptions struct {
 	SigGasConsumer         func(meter storetypes.GasMeter, sig signing.SignatureV2, params authtypes.Params) error
 	MaxTxGasWanted         uint64
 	TxFeeChecker           ante.TxFeeChecker
+	// QueueFutureNonces accepts Ethereum txs with a nonce above the account
+	// sequence on CheckTx, so that they are queued by the app-side mempool.
+	QueueFutureNonces bool
+	// Firewall filters the txs received on CheckTx with the node-local deny
+	// lists and rate limits. A nil firewall accepts all the txs.
+	Firewall *anteutils.Firewall
 }
 
 // Validate checks if the keepers are defined
@@ -0,0 +1,199 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package utils
+
+import (
+	"strings"
+	"sync"
+
+	errorsmod "cosmossdk.io/errors"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/hashicorp/go-metrics"
+	"github.com/spf13/cast"
+
+	"github.com/AizelNetwork/CosmEvm/server/config"
+	srvflags "github.com/AizelNetwork/CosmEvm/server/flags"
+)
+
+const (
+	// FirewallReasonSender is the metrics label of the txs rejected because
+	// of a denied sender.
+	FirewallReasonSender = "sender"
+	// FirewallReasonRecipient is the metrics label of the txs rejected because
+	// of a denied recipient.
+	FirewallReasonRecipient = "recipient"
+	// FirewallReasonSelector is the metrics label of the txs rejected because
+	// of a denied function selector.
+	FirewallReasonSelector = "selector"
+	// FirewallReasonRateLimit is the metrics label of the txs rejected because
+	// the sender exceeded the rate limit.
+	FirewallReasonRateLimit = "rate_limit"
+)
+
+// GetFirewallConfig returns the firewall configuration from the app options.
+// The default values are used for the options that are not set.
+func GetFirewallConfig(appOpts servertypes.AppOptions) config.FirewallConfig {
+	cfg := *config.DefaultFirewallConfig()
+	if v := appOpts.Get(srvflags.FirewallDenySenders); v != nil {
+		cfg.DenySenders = toStringSlice(v)
+	}
+	if v := appOpts.Get(srvflags.FirewallDenyRecipients); v != nil {
+		cfg.DenyRecipients = toStringSlice(v)
+	}
+	if v := appOpts.Get(srvflags.FirewallDenySelectors); v != nil {
+		cfg.DenySelectors = toStringSlice(v)
+	}
+	if v := appOpts.Get(srvflags.FirewallRateLimitTxs); v != nil {
+		cfg.RateLimitTxs = cast.ToUint64(v)
+	}
+	if v := appOpts.Get(srvflags.FirewallRateLimitBlocks); v != nil {
+		cfg.RateLimitBlocks = cast.ToUint64(v)
+	}
+	return cfg
+}
+
+// toStringSlice returns the values of a list option, which can also be set as
+// a comma separated string.
+func toStringSlice(v interface{}) []string {
+	var values []string
+	for _, s := range cast.ToStringSlice(v) {
+		for _, value := range strings.Split(s, ",") {
+			if value = strings.TrimSpace(value); value != "" {
+				values = append(values, value)
+			}
+		}
+	}
+	return values
+}
+
+// Firewall filters the txs received by the node with deny lists of senders,
+// recipients and function selectors, and limits the number of txs of each
+// sender per window of blocks. Its configuration is local to the node, so it
+// must only be applied on CheckTx.
+type Firewall struct {
+	denySenders    map[common.Address]struct{}
+	denyRecipients map[common.Address]struct{}
+	denySelectors  map[[4]byte]struct{}
+
+	rateLimitTxs    uint64
+	rateLimitBlocks uint64
+
+	mtx sync.Mutex
+	// window is the index of the current rate limit window
+	window int64
+	// txCounts is the number of txs accepted per sender in the current window
+	txCounts map[common.Address]uint64
+}
+
+// NewFirewall returns a new Firewall with the given configuration.
+func NewFirewall(cfg config.FirewallConfig) (*Firewall, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	f := &Firewall{
+		denySenders:     make(map[common.Address]struct{}, len(cfg.DenySenders)),
+		denyRecipients:  make(map[common.Address]struct{}, len(cfg.DenyRecipients)),
+		denySelectors:   make(map[[4]byte]struct{}, len(cfg.DenySelectors)),
+		rateLimitTxs:    cfg.RateLimitTxs,
+		rateLimitBlocks: cfg.RateLimitBlocks,
+		txCounts:        make(map[common.Address]uint64),
+	}
+
+	for _, addr := range cfg.DenySenders {
+		sender, _ := config.ParseFirewallAddress(addr)
+		f.denySenders[sender] = struct{}{}
+	}
+	for _, addr := range cfg.DenyRecipients {
+		recipient, _ := config.ParseFirewallAddress(addr)
+		f.denyRecipients[recipient] = struct{}{}
+	}
+	for _, s := range cfg.DenySelectors {
+		selector, _ := config.ParseFirewallSelector(s)
+		f.denySelectors[selector] = struct{}{}
+	}
+
+	return f, nil
+}
+
+// CheckSender returns an error if the sender is denied.
+func (f *Firewall) CheckSender(sender common.Address) error {
+	if _, found := f.denySenders[sender]; found {
+		return errorsmod.Wrapf(errortypes.ErrUnauthorized, "sender %s is denied by the node firewall", sender)
+	}
+	return nil
+}
+
+// CheckRecipient returns an error if the recipient is denied.
+func (f *Firewall) CheckRecipient(recipient common.Address) error {
+	if _, found := f.denyRecipients[recipient]; found {
+		return errorsmod.Wrapf(errortypes.ErrUnauthorized, "recipient %s is denied by the node firewall", recipient)
+	}
+	return nil
+}
+
+// CheckSelector returns an error if the function selector of the given call
+// data is denied.
+func (f *Firewall) CheckSelector(data []byte) error {
+	if len(data) < 4 {
+		return nil
+	}
+	if _, found := f.denySelectors[[4]byte(data[:4])]; found {
+		return errorsmod.Wrapf(errortypes.ErrUnauthorized, "function selector %x is denied by the node firewall", data[:4])
+	}
+	return nil
+}
+
+// RateLimit counts the txs of the given senders in the window of the current
+// block and returns an error if any of them exceeds the rate limit, in which
+// case none of the txs are counted.
+func (f *Firewall) RateLimit(ctx sdk.Context, senders ...common.Address) error {
+	if f.rateLimitTxs == 0 {
+		return nil
+	}
+
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	//nolint:gosec // G115 block window is always positive
+	if window := ctx.BlockHeight() / int64(f.rateLimitBlocks); window != f.window {
+		f.window = window
+		f.txCounts = make(map[common.Address]uint64)
+	}
+
+	counts := make(map[common.Address]uint64, len(senders))
+	for _, sender := range senders {
+		counts[sender]++
+		if f.txCounts[sender]+counts[sender] > f.rateLimitTxs {
+			return errorsmod.Wrapf(
+				errortypes.ErrInvalidRequest,
+				"sender %s exceeded the node firewall rate limit of %d txs per %d blocks",
+				sender, f.rateLimitTxs, f.rateLimitBlocks,
+			)
+		}
+	}
+
+	for sender, count := range counts {
+		f.txCounts[sender] += count
+	}
+
+	return nil
+}
+
+// IncrRejectedTxCounter increments the counter of the txs of the given type
+// rejected by the firewall for the given reason.
+func IncrRejectedTxCounter(txType, reason string) {
+	telemetry.IncrCounterWithLabels(
+		[]string{"tx", "firewall", "rejected"},
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel("type", txType),
+			telemetry.NewLabel("reason", reason),
+		},
+	)
+}
@@ -0,0 +1,82 @@
+package utils_test
+
+import (
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	anteutils "github.com/AizelNetwork/CosmEvm/app/ante/utils"
+	"github.com/AizelNetwork/CosmEvm/server/config"
+	srvflags "github.com/AizelNetwork/CosmEvm/server/flags"
+)
+
+func TestGetFirewallConfig(t *testing.T) {
+	cfg := anteutils.GetFirewallConfig(simtestutil.AppOptionsMap{})
+	require.Equal(t, *config.DefaultFirewallConfig(), cfg)
+
+	cfg = anteutils.GetFirewallConfig(simtestutil.AppOptionsMap{
+		srvflags.FirewallDenySenders:     []interface{}{"0x1111111111111111111111111111111111111111"},
+		srvflags.FirewallDenySelectors:   "0xa9059cbb, 0x095ea7b3",
+		srvflags.FirewallRateLimitTxs:    int64(5),
+		srvflags.FirewallRateLimitBlocks: int64(10),
+	})
+	require.Equal(t, []string{"0x1111111111111111111111111111111111111111"}, cfg.DenySenders)
+	require.Equal(t, []string{}, cfg.DenyRecipients)
+	require.Equal(t, []string{"0xa9059cbb", "0x095ea7b3"}, cfg.DenySelectors)
+	require.Equal(t, uint64(5), cfg.RateLimitTxs)
+	require.Equal(t, uint64(10), cfg.RateLimitBlocks)
+}
+
+func TestFirewallDenyLists(t *testing.T) {
+	denied := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	allowed := common.HexToAddress("0x2222222222222222222222222222222222222222")
+
+	_, err := anteutils.NewFirewall(config.FirewallConfig{DenySelectors: []string{"0x1234"}})
+	require.Error(t, err)
+
+	firewall, err := anteutils.NewFirewall(config.FirewallConfig{
+		DenySenders:    []string{denied.Hex()},
+		DenyRecipients: []string{denied.Hex()},
+		DenySelectors:  []string{"0xa9059cbb"},
+	})
+	require.NoError(t, err)
+
+	require.Error(t, firewall.CheckSender(denied))
+	require.NoError(t, firewall.CheckSender(allowed))
+	require.Error(t, firewall.CheckRecipient(denied))
+	require.NoError(t, firewall.CheckRecipient(allowed))
+	require.Error(t, firewall.CheckSelector(common.FromHex("0xa9059cbb0000")))
+	require.NoError(t, firewall.CheckSelector(common.FromHex("0x095ea7b30000")))
+	require.NoError(t, firewall.CheckSelector(common.FromHex("0xa905")))
+}
+
+func TestFirewallRateLimit(t *testing.T) {
+	sender := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	other := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	ctxAt := func(height int64) sdk.Context {
+		return sdk.Context{}.WithBlockHeader(cmtproto.Header{Height: height})
+	}
+
+	unlimited, err := anteutils.NewFirewall(*config.DefaultFirewallConfig())
+	require.NoError(t, err)
+	for i := 0; i < 100; i++ {
+		require.NoError(t, unlimited.RateLimit(ctxAt(1), sender))
+	}
+
+	firewall, err := anteutils.NewFirewall(config.FirewallConfig{RateLimitTxs: 2, RateLimitBlocks: 5})
+	require.NoError(t, err)
+
+	require.NoError(t, firewall.RateLimit(ctxAt(5), sender))
+	// the txs are not counted when any of them exceeds the limit
+	require.Error(t, firewall.RateLimit(ctxAt(6), sender, sender))
+	require.NoError(t, firewall.RateLimit(ctxAt(7), sender, other))
+	require.Error(t, firewall.RateLimit(ctxAt(9), sender))
+	require.NoError(t, firewall.RateLimit(ctxAt(9), other))
+
+	// the counts are reset on the next window
+	require.NoError(t, firewall.RateLimit(ctxAt(10), sender, sender))
+}
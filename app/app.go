@@ -55,7 +55,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/runtime"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	sigtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/version"
@@ -112,6 +111,9 @@ import (
 	ratelimittypes "github.com/cosmos/ibc-apps/modules/rate-limiting/v8/types"
 
 	ica "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts"
+	icacontroller "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/keeper"
+	icacontrollertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
 	icahost "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host"
 	icahostkeeper "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/keeper"
 	icahosttypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
@@ -140,9 +142,12 @@ import (
 
 	"github.com/AizelNetwork/CosmEvm/app/ante"
 	ethante "github.com/AizelNetwork/CosmEvm/app/ante/evm"
+	anteutils "github.com/AizelNetwork/CosmEvm/app/ante/utils"
+	evmmempool "github.com/AizelNetwork/CosmEvm/app/mempool"
 	"github.com/AizelNetwork/CosmEvm/app/post"
 	v9 "github.com/AizelNetwork/CosmEvm/app/upgrades/evm-v9"
 	v20 "github.com/AizelNetwork/CosmEvm/app/upgrades/v20"
+	icaprecompile "github.com/AizelNetwork/CosmEvm/precompiles/ica"
 	srvflags "github.com/AizelNetwork/CosmEvm/server/flags"
 	"github.com/AizelNetwork/CosmEvm/x/erc20"
 	erc20keeper "github.com/AizelNetwork/CosmEvm/x/erc20/keeper"
@@ -150,6 +155,9 @@ import (
 	"github.com/AizelNetwork/CosmEvm/x/feemarket"
 	feemarketkeeper "github.com/AizelNetwork/CosmEvm/x/feemarket/keeper"
 	feemarkettypes "github.com/AizelNetwork/CosmEvm/x/feemarket/types"
+	"github.com/AizelNetwork/CosmEvm/x/revenue"
+	revenuekeeper "github.com/AizelNetwork/CosmEvm/x/revenue/keeper"
+	revenuetypes "github.com/AizelNetwork/CosmEvm/x/revenue/types"
 	"github.com/AizelNetwork/CosmEvm/x/staking"
 	stakingkeeper "github.com/AizelNetwork/CosmEvm/x/staking/keeper"
 	"github.com/AizelNetwork/CosmEvm/x/vesting"
@@ -157,6 +165,9 @@ import (
 	vestingtypes "github.com/AizelNetwork/CosmEvm/x/vesting/types"
 
 	// NOTE: override ICS20 keeper to support IBC transfers of ERC20 tokens
+	ibccallbacks "github.com/AizelNetwork/CosmEvm/x/ibc/callbacks"
+	ibccallbackskeeper "github.com/AizelNetwork/CosmEvm/x/ibc/callbacks/keeper"
+	ibccallbackstypes "github.com/AizelNetwork/CosmEvm/x/ibc/callbacks/types"
 	"github.com/AizelNetwork/CosmEvm/x/ibc/transfer"
 	transferkeeper "github.com/AizelNetwork/CosmEvm/x/ibc/transfer/keeper"
 
@@ -203,13 +214,15 @@ var (
 		inflationtypes.ModuleName:      {authtypes.Minter},
 		erc20types.ModuleName:          {authtypes.Minter, authtypes.Burner},
 		ratelimittypes.ModuleName:      nil,
+		epochstypes.ModuleName:         nil,
 	}
 )
 
 var (
-	_ servertypes.Application = (*Evmos)(nil)
-	_ ibctesting.TestingApp   = (*Evmos)(nil)
-	_ runtime.AppI            = (*Evmos)(nil)
+	_ servertypes.Application          = (*Evmos)(nil)
+	_ ibctesting.TestingApp            = (*Evmos)(nil)
+	_ runtime.AppI                     = (*Evmos)(nil)
+	_ evmostypes.PrivateTxPoolProvider = (*Evmos)(nil)
 )
 
 // Evmos implements an extended ABCI application. It is an application
@@ -245,6 +258,7 @@ type Evmos struct {
 	AuthzKeeper           authzkeeper.Keeper
 	IBCKeeper             *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
 	ICAHostKeeper         icahostkeeper.Keeper
+	ICAControllerKeeper   icacontrollerkeeper.Keeper
 	EvidenceKeeper        evidencekeeper.Keeper
 	TransferKeeper        transferkeeper.Keeper
 	ConsensusParamsKeeper consensusparamkeeper.Keeper
@@ -263,6 +277,10 @@ type Evmos struct {
 	Erc20Keeper     erc20keeper.Keeper
 	EpochsKeeper    epochskeeper.Keeper
 	VestingKeeper   vestingkeeper.Keeper
+	RevenueKeeper   revenuekeeper.Keeper
+
+	// IBC callbacks keeper
+	IBCCallbacksKeeper ibccallbackskeeper.Keeper
 
 	// the module manager
 	mm                 *module.Manager
@@ -277,6 +295,9 @@ type Evmos struct {
 	// queryMultistore used on versionDB build
 	qms storetypes.MultiStore
 
+	// node-local pool of the private Ethereum txs
+	privatePool *evmmempool.PrivatePool
+
 	tpsCounter *tpsCounter
 }
 
@@ -306,15 +327,6 @@ func NewEvmos(
 	// setup memiavl if it's enabled in config
 	baseAppOptions = memiavlstore.SetupMemIAVL(logger, homePath, appOpts, false, false, baseAppOptions)
 
-	// Setup Mempool and Proposal Handlers
-	baseAppOptions = append(baseAppOptions, func(app *baseapp.BaseApp) {
-		mempool := mempool.NoOpMempool{}
-		app.SetMempool(mempool)
-		handler := baseapp.NewDefaultProposalHandler(mempool, app)
-		app.SetPrepareProposal(handler.PrepareProposalHandler())
-		app.SetProcessProposal(handler.ProcessProposalHandler())
-	})
-
 	// NOTE we use custom transaction decoder that supports the sdk.Tx interface instead of sdk.StdTx
 	bApp := baseapp.NewBaseApp(
 		Name,
@@ -365,6 +377,7 @@ func NewEvmos(
 	scopedIBCKeeper := app.CapabilityKeeper.ScopeToModule(ibcexported.ModuleName)
 	scopedTransferKeeper := app.CapabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
 	scopedICAHostKeeper := app.CapabilityKeeper.ScopeToModule(icahosttypes.SubModuleName)
+	scopedICAControllerKeeper := app.CapabilityKeeper.ScopeToModule(icacontrollertypes.SubModuleName)
 
 	// Applications that wish to enforce statically created ScopedKeepers should call `Seal` after creating
 	// their scoped modules in `NewApp` with `ScopeToModule`
@@ -468,7 +481,7 @@ func NewEvmos(
 	// Evmos Keeper
 	app.InflationKeeper = inflationkeeper.NewKeeper(
 		keys[inflationtypes.StoreKey], appCodec, authtypes.NewModuleAddress(govtypes.ModuleName),
-		app.AccountKeeper, app.BankKeeper, app.DistrKeeper, stakingKeeper,
+		app.AccountKeeper, app.BankKeeper, app.DistrKeeper, stakingKeeper, app.EvmKeeper,
 		authtypes.FeeCollectorName,
 	)
 
@@ -492,7 +505,16 @@ func NewEvmos(
 	app.Erc20Keeper = erc20keeper.NewKeeper(
 		keys[erc20types.StoreKey], appCodec, authtypes.NewModuleAddress(govtypes.ModuleName),
 		app.AccountKeeper, app.BankKeeper, app.EvmKeeper, app.StakingKeeper,
-		app.AuthzKeeper, &app.TransferKeeper,
+		app.AuthzKeeper, &app.TransferKeeper, app.DistrKeeper,
+	)
+
+	app.RevenueKeeper = revenuekeeper.NewKeeper(
+		keys[revenuetypes.StoreKey], appCodec, authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.BankKeeper, app.EvmKeeper, authtypes.FeeCollectorName,
+	)
+
+	app.IBCCallbacksKeeper = ibccallbackskeeper.NewKeeper(
+		keys[ibccallbackstypes.StoreKey], appCodec, authtypes.NewModuleAddress(govtypes.ModuleName),
 	)
 
 	// Create the rate limit keeper
@@ -515,11 +537,15 @@ func NewEvmos(
 		authAddr,
 	)
 
-	epochsKeeper := epochskeeper.NewKeeper(appCodec, keys[epochstypes.StoreKey])
+	epochsKeeper := epochskeeper.NewKeeper(
+		appCodec, keys[epochstypes.StoreKey], authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.AccountKeeper, app.EvmKeeper,
+	)
 	app.EpochsKeeper = *epochsKeeper.SetHooks(
 		epochskeeper.NewMultiEpochHooks(
 			// insert epoch hooks receivers here
 			app.InflationKeeper.Hooks(),
+			app.Erc20Keeper.Hooks(),
 		),
 	)
 
@@ -529,9 +555,22 @@ func NewEvmos(
 		),
 	)
 
+	// Create the app.ICAControllerKeeper
+	app.ICAControllerKeeper = icacontrollerkeeper.NewKeeper(
+		appCodec, app.keys[icacontrollertypes.StoreKey],
+		app.GetSubspace(icacontrollertypes.SubModuleName),
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.PortKeeper,
+		scopedICAControllerKeeper,
+		bApp.MsgServiceRouter(),
+		authAddr,
+	)
+
 	// We call this after setting the hooks to ensure that the hooks are set on the keeper
 	evmKeeper.WithStaticPrecompiles(
 		evmkeeper.NewAvailableStaticPrecompiles(
+			evmKeeper,
 			*stakingKeeper,
 			app.DistrKeeper,
 			app.BankKeeper,
@@ -543,6 +582,9 @@ func NewEvmos(
 			app.GovKeeper,
 			app.SlashingKeeper,
 			app.EvidenceKeeper,
+			app.InflationKeeper,
+			app.EpochsKeeper,
+			app.ICAControllerKeeper,
 		),
 	)
 
@@ -566,10 +608,15 @@ func NewEvmos(
 	// create host IBC module
 	icaHostIBCModule := icahost.NewIBCModule(app.ICAHostKeeper)
 
+	// create controller IBC stack, delivering the packet callbacks of the
+	// interchain accounts registered through the ICA precompile to their owner contracts
+	icaControllerStack := icacontroller.NewIBCMiddleware(icaprecompile.NewIBCModule(app.EvmKeeper), app.ICAControllerKeeper)
+
 	/*
 		Create Transfer Stack
 
 		transfer stack contains (from bottom to top):
+			- IBC Callbacks Middleware
 			- ERC-20 Middleware
 		 	- Recovery Middleware
 			- IBC Transfer
@@ -578,7 +625,7 @@ func NewEvmos(
 		 	transferKeeper.SendPacket -> claim.SendPacket -> recovery.SendPacket -> erc20.SendPacket -> channel.SendPacket
 
 		RecvPacket, message that originates from core IBC and goes down to app, the flow is the other way
-			channel.RecvPacket -> erc20.OnRecvPacket -> recovery.OnRecvPacket -> claim.OnRecvPacket -> transfer.OnRecvPacket
+			channel.RecvPacket -> callbacks.OnRecvPacket -> erc20.OnRecvPacket -> recovery.OnRecvPacket -> claim.OnRecvPacket -> transfer.OnRecvPacket
 	*/
 
 	// create IBC module from top to bottom of stack
@@ -587,11 +634,13 @@ func NewEvmos(
 	transferStack = transfer.NewIBCModule(app.TransferKeeper)
 	transferStack = ratelimit.NewIBCMiddleware(app.RateLimitKeeper, transferStack)
 	transferStack = erc20.NewIBCMiddleware(app.Erc20Keeper, transferStack)
+	transferStack = ibccallbacks.NewIBCMiddleware(app.IBCCallbacksKeeper, app.EvmKeeper, transferStack)
 
 	// Create static IBC router, add transfer route, then set and seal it
 	ibcRouter := porttypes.NewRouter()
 	ibcRouter.
 		AddRoute(icahosttypes.SubModuleName, icaHostIBCModule).
+		AddRoute(icacontrollertypes.SubModuleName, icaControllerStack).
 		AddRoute(ibctransfertypes.ModuleName, transferStack)
 
 	app.IBCKeeper.SetRouter(ibcRouter)
@@ -634,7 +683,7 @@ func NewEvmos(
 
 		// ibc modules
 		ibc.NewAppModule(app.IBCKeeper),
-		ica.NewAppModule(nil, &app.ICAHostKeeper),
+		ica.NewAppModule(&app.ICAControllerKeeper, &app.ICAHostKeeper),
 		transferModule,
 		ibctm.NewAppModule(),
 		ratelimit.NewAppModule(appCodec, app.RateLimitKeeper),
@@ -648,6 +697,8 @@ func NewEvmos(
 			app.GetSubspace(erc20types.ModuleName)),
 		epochs.NewAppModule(appCodec, app.EpochsKeeper),
 		vesting.NewAppModule(app.VestingKeeper, app.AccountKeeper, app.BankKeeper, *app.StakingKeeper.Keeper),
+		revenue.NewAppModule(app.RevenueKeeper),
+		ibccallbacks.NewAppModule(app.IBCCallbacksKeeper),
 	)
 
 	// BasicModuleManager defines the module BasicManager which is in charge of setting up basic,
@@ -701,6 +752,7 @@ func NewEvmos(
 		stakingtypes.ModuleName,
 		evmtypes.ModuleName,
 		feemarkettypes.ModuleName,
+		erc20types.ModuleName,
 		feegrant.ModuleName,
 	)
 
@@ -736,6 +788,9 @@ func NewEvmos(
 		erc20types.ModuleName,
 		epochstypes.ModuleName,
 		ratelimittypes.ModuleName,
+		vestingtypes.ModuleName,
+		revenuetypes.ModuleName,
+		ibccallbackstypes.ModuleName,
 	)
 
 	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
@@ -795,8 +850,16 @@ func NewEvmos(
 
 	maxGasWanted := cast.ToUint64(appOpts.Get(srvflags.EVMMaxTxGasWanted))
 
-	app.setAnteHandler(app.txConfig, maxGasWanted)
+	firewall, err := anteutils.NewFirewall(anteutils.GetFirewallConfig(appOpts))
+	if err != nil {
+		panic(errorsmod.Wrap(err, "error on firewall setup"))
+	}
+
+	mempoolCfg := evmmempool.GetConfig(appOpts)
+
+	app.setAnteHandler(app.txConfig, maxGasWanted, mempoolCfg.QueueFutureNonces, firewall)
 	app.setPostHandler()
+	app.setMempool(mempoolCfg)
 	app.SetEndBlocker(app.EndBlocker)
 	app.setupUpgradeHandlers()
 
@@ -854,7 +917,7 @@ func NewEvmos(
 // Name returns the name of the App
 func (app *Evmos) Name() string { return app.BaseApp.Name() }
 
-func (app *Evmos) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64) {
+func (app *Evmos) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64, queueFutureNonces bool, firewall *anteutils.Firewall) {
 	options := ante.HandlerOptions{
 		Cdc:                    app.appCodec,
 		AccountKeeper:          app.AccountKeeper,
@@ -870,6 +933,8 @@ func (app *Evmos) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64)
 		SigGasConsumer:         ante.SigVerificationGasConsumer,
 		MaxTxGasWanted:         maxGasWanted,
 		TxFeeChecker:           ethante.NewDynamicFeeChecker(app.FeeMarketKeeper),
+		QueueFutureNonces:      queueFutureNonces,
+		Firewall:               firewall,
 	}
 
 	if err := options.Validate(); err != nil {
@@ -883,6 +948,8 @@ func (app *Evmos) setPostHandler() {
 	options := post.HandlerOptions{
 		FeeCollectorName: authtypes.FeeCollectorName,
 		BankKeeper:       app.BankKeeper,
+		EvmKeeper:        app.EvmKeeper,
+		RevenueKeeper:    app.RevenueKeeper,
 	}
 
 	if err := options.Validate(); err != nil {
@@ -892,6 +959,38 @@ func (app *Evmos) setPostHandler() {
 	app.SetPostHandler(post.NewPostHandler(options))
 }
 
+// setMempool sets the app-side mempool, which queues the Ethereum txs per
+// sender and nonce, and the proposal handlers that pack the txs from it within
+// the block gas and the share reserved for the Cosmos txs. The private pool,
+// whose txs are only included in the blocks proposed by the node, is pruned
+// after each commit.
+func (app *Evmos) setMempool(cfg evmmempool.Config) {
+	mempool := evmmempool.NewEVMMempool(cfg, app.AccountKeeper, ethante.NewDynamicFeeChecker(app.FeeMarketKeeper))
+	app.SetMempool(mempool)
+
+	app.privatePool = evmmempool.NewPrivatePool(cfg, app.AccountKeeper, app.txConfig.TxDecoder(), app.checkPrivateTx)
+	app.SetPrepareCheckStater(app.privatePool.Prune)
+
+	handler := evmmempool.NewProposalHandler(mempool, app.privatePool, app, app.EvmKeeper, app.FeeMarketKeeper)
+	app.SetPrepareProposal(handler.PrepareProposalHandler())
+	app.SetProcessProposal(handler.ProcessProposalHandler())
+}
+
+// checkPrivateTx runs the ante handler on a branch of the check state to
+// verify a private tx as in CheckTx, without adding it to the mempool.
+func (app *Evmos) checkPrivateTx(tx sdk.Tx, txBytes []byte) (err error) {
+	// recover from the panics of the ante handler, e.g. out of gas, as in CheckTx
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to check private tx: %v", r)
+		}
+	}()
+
+	ctx, _ := app.GetContextForCheckTx(txBytes).CacheContext()
+	_, err = app.AnteHandler()(ctx, tx, false)
+	return err
+}
+
 // BeginBlocker runs the Tendermint ABCI BeginBlock logic. It executes state changes at the beginning
 // of the new block for every registered module. If there is a registered fork at the current height,
 // BeginBlocker will schedule the upgrade plan and perform the state migration (if any).
@@ -1121,6 +1220,11 @@ func (app *Evmos) GetTxConfig() client.TxConfig {
 	return app.txConfig
 }
 
+// PrivateTxPool returns the node-local pool of the private Ethereum txs.
+func (app *Evmos) PrivateTxPool() evmostypes.PrivateTxPool {
+	return app.privatePool
+}
+
 // AutoCliOpts returns the autocli options for the app.
 func (app *Evmos) AutoCliOpts() autocli.AppOptions {
 	modules := make(map[string]appmodule.AppModule, 0)
@@ -1182,6 +1286,7 @@ func initParamsKeeper(
 	paramsKeeper.Subspace(ibcexported.ModuleName).WithKeyTable(keyTable)
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName).WithKeyTable(ibctransfertypes.ParamKeyTable())
 	paramsKeeper.Subspace(icahosttypes.SubModuleName).WithKeyTable(icahosttypes.ParamKeyTable())
+	paramsKeeper.Subspace(icacontrollertypes.SubModuleName).WithKeyTable(icacontrollertypes.ParamKeyTable())
 	// FIX: do we need a keytable?
 	paramsKeeper.Subspace(ratelimittypes.ModuleName)
 	// ethermint subspaces
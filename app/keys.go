@@ -12,7 +12,9 @@ import (
 	erc20types "github.com/AizelNetwork/CosmEvm/x/erc20/types"
 	evmtypes "github.com/AizelNetwork/CosmEvm/x/evm/types"
 	feemarkettypes "github.com/AizelNetwork/CosmEvm/x/feemarket/types"
+	ibccallbackstypes "github.com/AizelNetwork/CosmEvm/x/ibc/callbacks/types"
 	inflationtypes "github.com/AizelNetwork/CosmEvm/x/inflation/v1/types"
+	revenuetypes "github.com/AizelNetwork/CosmEvm/x/revenue/types"
 	vestingtypes "github.com/AizelNetwork/CosmEvm/x/vesting/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
@@ -25,6 +27,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	ratelimittypes "github.com/cosmos/ibc-apps/modules/rate-limiting/v8/types"
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	icacontrollertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
 	icahosttypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
@@ -47,7 +50,7 @@ func StoreKeys() (
 		// ibc keys
 		ibcexported.StoreKey, ibctransfertypes.StoreKey,
 		// ica keys
-		icahosttypes.StoreKey,
+		icahosttypes.StoreKey, icacontrollertypes.StoreKey,
 		// ibc rate-limit keys
 		ratelimittypes.StoreKey,
 		// ethermint keys
@@ -55,6 +58,9 @@ func StoreKeys() (
 		// aizel keys
 		inflationtypes.StoreKey, erc20types.StoreKey,
 		epochstypes.StoreKey, vestingtypes.StoreKey,
+		revenuetypes.StoreKey,
+		// ibc callbacks keys
+		ibccallbackstypes.StoreKey,
 	}
 
 	keys := storetypes.NewKVStoreKeys(storeKeys...)
@@ -0,0 +1,167 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+package mempool
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmtypes "github.com/AizelNetwork/CosmEvm/x/evm/types"
+)
+
+// bundle is an Ethereum tx bundle stored in the mempool. The bundle is
+// selected as a single tx, so that its txs are included contiguously.
+type bundle struct {
+	*evmTx
+
+	// txs are the sender and nonce of each tx of the bundle, in execution
+	// order
+	txs []bundleTx
+	// timeoutHeight is the max block number of the bundle, zero if unset
+	timeoutHeight uint64
+}
+
+// bundleTx is the sender and nonce of a tx of a bundle.
+type bundleTx struct {
+	sender common.Address
+	nonce  uint64
+}
+
+// insertBundle adds an Ethereum tx bundle to the mempool. The nonces of each
+// sender must be consecutive within the bundle. It must be called with the
+// lock held.
+func (m *EVMMempool) insertBundle(ctx sdk.Context, tx sdk.Tx, msgs []*evmtypes.MsgEthereumTx) error {
+	hash := evmtypes.BundleHash(msgs)
+	if _, found := m.bundles[hash]; found {
+		return ErrTxAlreadyKnown
+	}
+
+	txs := make([]bundleTx, len(msgs))
+	lastNonces := make(map[common.Address]uint64)
+	for i, msg := range msgs {
+		sender, err := getSender(msg)
+		if err != nil {
+			return err
+		}
+
+		txData, err := evmtypes.UnpackTxData(msg.Data)
+		if err != nil {
+			return err
+		}
+
+		nonce := txData.GetNonce()
+		if last, found := lastNonces[sender]; found && nonce != last+1 {
+			return errorsmod.Wrapf(
+				evmtypes.ErrInvalidBundle,
+				"non consecutive nonce %d of sender %s, expected %d", nonce, sender, last+1,
+			)
+		}
+		lastNonces[sender] = nonce
+		txs[i] = bundleTx{sender: sender, nonce: nonce}
+	}
+
+	_, priority, err := m.feeChecker(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	var timeoutHeight uint64
+	if timeoutTx, ok := tx.(sdk.TxWithTimeoutHeight); ok {
+		timeoutHeight = timeoutTx.GetTimeoutHeight()
+	}
+
+	if m.countTx() >= m.config.MaxTxs && !m.evictLowestPriority(priority) {
+		return sdkmempool.ErrMempoolTxMaxCapacity
+	}
+
+	m.bundles[hash] = &bundle{
+		evmTx: &evmTx{
+			tx:         tx,
+			hash:       hash.Hex(),
+			sender:     txs[0].sender,
+			nonce:      txs[0].nonce,
+			priority:   priority,
+			insertedAt: m.now(),
+		},
+		txs:           txs,
+		timeoutHeight: timeoutHeight,
+	}
+
+	return nil
+}
+
+// selectBundles returns the bundles that can be executed in the next block,
+// i.e. the ones where the first nonce of each sender is the sender sequence.
+// The bundles past their max block number or with an already used nonce are
+// removed. It must be called with the lock held.
+func (m *EVMMempool) selectBundles(ctx sdk.Context) senderHeap {
+	var selected senderHeap
+	for hash, b := range m.bundles {
+		if b.timeoutHeight > 0 && uint64(ctx.BlockHeight()) > b.timeoutHeight { //#nosec G115 -- block height is positive
+			delete(m.bundles, hash)
+			continue
+		}
+
+		executable, stale := m.checkBundleNonces(ctx, b)
+		if stale {
+			delete(m.bundles, hash)
+			continue
+		}
+		if !executable {
+			continue
+		}
+
+		_, priority, err := m.feeChecker(ctx, b.tx)
+		if err != nil {
+			continue
+		}
+
+		selected = append(selected, []selectedTx{{evmTx: b.evmTx, priority: priority}})
+	}
+
+	return selected
+}
+
+// checkBundleNonces compares the first nonce of each sender of the bundle with
+// the sender sequence. The bundle is executable if they are all equal, and
+// stale if any of them is lower.
+func (m *EVMMempool) checkBundleNonces(ctx sdk.Context, b *bundle) (executable, stale bool) {
+	checked := make(map[common.Address]bool)
+	executable = true
+	for _, tx := range b.txs {
+		if checked[tx.sender] {
+			continue
+		}
+		checked[tx.sender] = true
+
+		// an error is returned if the account doesn't exist, in which case the
+		// sequence is zero
+		sequence, err := m.accountKeeper.GetSequence(ctx, tx.sender.Bytes())
+		if err != nil {
+			sequence = 0
+		}
+
+		switch {
+		case tx.nonce < sequence:
+			return false, true
+		case tx.nonce > sequence:
+			executable = false
+		}
+	}
+
+	return executable, false
+}
+
+// removeBundle removes the bundle with the given txs. It must be called with
+// the lock held.
+func (m *EVMMempool) removeBundle(msgs []*evmtypes.MsgEthereumTx) error {
+	hash := evmtypes.BundleHash(msgs)
+	if _, found := m.bundles[hash]; !found {
+		return sdkmempool.ErrTxNotFound
+	}
+
+	delete(m.bundles, hash)
+	return nil
+}
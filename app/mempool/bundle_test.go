@@ -0,0 +1,69 @@
+package mempool
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AizelNetwork/CosmEvm/encoding"
+	evmtypes "github.com/AizelNetwork/CosmEvm/x/evm/types"
+)
+
+func newBundleTx(t *testing.T, timeoutHeight uint64, txs ...sdk.Tx) sdk.Tx {
+	var (
+		msgs []sdk.Msg
+		gas  uint64
+	)
+	for _, tx := range txs {
+		msg := tx.GetMsgs()[0].(*evmtypes.MsgEthereumTx)
+		msgs = append(msgs, msg)
+		gas += msg.GetGas()
+	}
+
+	builder := encoding.MakeConfig().TxConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(msgs...))
+	builder.SetGasLimit(gas)
+	builder.SetTimeoutHeight(timeoutHeight)
+	return builder.GetTx()
+}
+
+func TestBundles(t *testing.T) {
+	senderA := common.BigToAddress(big.NewInt(10))
+	senderB := common.BigToAddress(big.NewInt(11))
+	accounts := mockAccountKeeper{
+		sdk.AccAddress(senderA.Bytes()).String(): 1,
+	}
+	ctx, mp := setupMempool(DefaultConfig(), accounts, new(int64))
+
+	// the nonces of sender A are not consecutive
+	invalid := newBundleTx(t, 0, newEthTx(t, senderA, 1, 10, 1), newEthTx(t, senderA, 3, 10, 1))
+	require.ErrorIs(t, mp.Insert(ctx, invalid), evmtypes.ErrInvalidBundle)
+
+	executable := newBundleTx(t, 0, newEthTx(t, senderA, 1, 10, 1), newEthTx(t, senderB, 0, 10, 2), newEthTx(t, senderA, 2, 10, 1))
+	// nonce 0 of sender A was already executed
+	stale := newBundleTx(t, 0, newEthTx(t, senderB, 0, 10, 5), newEthTx(t, senderA, 0, 10, 5))
+	// nonce 1 of sender B is pending the executable bundle
+	future := newBundleTx(t, 0, newEthTx(t, senderB, 1, 10, 5), newEthTx(t, senderB, 2, 10, 5))
+	expired := newBundleTx(t, 1, newEthTx(t, senderB, 0, 10, 5), newEthTx(t, senderB, 1, 10, 5))
+	for _, tx := range []sdk.Tx{executable, stale, future, expired} {
+		require.NoError(t, mp.Insert(ctx, tx))
+	}
+	require.ErrorIs(t, mp.Insert(ctx, executable), ErrTxAlreadyKnown)
+	require.Equal(t, 4, mp.CountTx())
+
+	// the expired bundle is valid until its max block number
+	require.Equal(t, []sdk.Tx{expired, executable}, selectTxs(ctx, mp))
+	require.Equal(t, 3, mp.CountTx())
+
+	ctx = ctx.WithBlockHeight(2)
+	require.Equal(t, []sdk.Tx{executable}, selectTxs(ctx, mp))
+	require.Equal(t, 2, mp.CountTx())
+
+	require.NoError(t, mp.Remove(executable))
+	require.ErrorIs(t, mp.Remove(executable), sdkmempool.ErrTxNotFound)
+	require.Equal(t, 1, mp.CountTx())
+}
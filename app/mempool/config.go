@@ -0,0 +1,78 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+package mempool
+
+import (
+	"time"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+
+	"github.com/AizelNetwork/CosmEvm/server/config"
+	srvflags "github.com/AizelNetwork/CosmEvm/server/flags"
+)
+
+// Config defines the limits of the app-side mempool.
+type Config struct {
+	// MaxTxs is the maximum number of txs in the mempool.
+	MaxTxs int
+	// MaxTxsPerSender is the maximum number of Ethereum txs of a single sender,
+	// including the ones queued because of a nonce gap.
+	MaxTxsPerSender int
+	// MaxTxAge is the duration after which an Ethereum tx is evicted. Zero
+	// disables the age eviction.
+	MaxTxAge time.Duration
+	// PriceBump is the minimum fee increase, in percent, required to replace
+	// an Ethereum tx with the same sender and nonce.
+	PriceBump uint64
+	// QueueFutureNonces defines if the Ethereum txs with a nonce above the
+	// sender sequence are accepted on CheckTx and queued until the nonce gap
+	// is filled.
+	QueueFutureNonces bool
+	// PrivateTxMaxBlocks is the maximum number of blocks during which a private
+	// Ethereum tx can be included. Zero disables the private txs.
+	PrivateTxMaxBlocks uint64
+	// PrivateTxBroadcast defines if the expired private Ethereum txs are
+	// broadcast publicly instead of being dropped.
+	PrivateTxBroadcast bool
+}
+
+// DefaultConfig returns the default mempool configuration.
+func DefaultConfig() Config {
+	return Config{
+		MaxTxs:             config.DefaultMempoolMaxTxs,
+		MaxTxsPerSender:    config.DefaultMempoolMaxTxsPerSender,
+		MaxTxAge:           config.DefaultMempoolMaxTxAge,
+		PriceBump:          config.DefaultMempoolPriceBump,
+		QueueFutureNonces:  config.DefaultMempoolQueueFutureNonces,
+		PrivateTxMaxBlocks: config.DefaultPrivateTxMaxBlocks,
+	}
+}
+
+// GetConfig returns the mempool configuration from the app options. The
+// default values are used for the options that are not set.
+func GetConfig(appOpts servertypes.AppOptions) Config {
+	cfg := DefaultConfig()
+	if v := appOpts.Get(srvflags.EVMMempoolMaxTxs); v != nil {
+		cfg.MaxTxs = cast.ToInt(v)
+	}
+	if v := appOpts.Get(srvflags.EVMMempoolMaxTxsPerSender); v != nil {
+		cfg.MaxTxsPerSender = cast.ToInt(v)
+	}
+	if v := appOpts.Get(srvflags.EVMMempoolMaxTxAge); v != nil {
+		cfg.MaxTxAge = cast.ToDuration(v)
+	}
+	if v := appOpts.Get(srvflags.EVMMempoolPriceBump); v != nil {
+		cfg.PriceBump = cast.ToUint64(v)
+	}
+	if v := appOpts.Get(srvflags.EVMMempoolQueueFutureNonces); v != nil {
+		cfg.QueueFutureNonces = cast.ToBool(v)
+	}
+	if v := appOpts.Get(srvflags.EVMPrivateTxMaxBlocks); v != nil {
+		cfg.PrivateTxMaxBlocks = cast.ToUint64(v)
+	}
+	if v := appOpts.Get(srvflags.EVMPrivateTxBroadcast); v != nil {
+		cfg.PrivateTxBroadcast = cast.ToBool(v)
+	}
+	return cfg
+}
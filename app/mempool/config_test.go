@@ -0,0 +1,37 @@
+package mempool
+
+import (
+	"testing"
+	"time"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	"github.com/stretchr/testify/require"
+
+	srvflags "github.com/AizelNetwork/CosmEvm/server/flags"
+)
+
+func TestGetConfig(t *testing.T) {
+	cfg := GetConfig(simtestutil.AppOptionsMap{})
+	require.Equal(t, DefaultConfig(), cfg)
+	require.True(t, cfg.QueueFutureNonces)
+	require.Zero(t, cfg.PrivateTxMaxBlocks)
+
+	cfg = GetConfig(simtestutil.AppOptionsMap{
+		srvflags.EVMMempoolMaxTxs:            100,
+		srvflags.EVMMempoolMaxTxsPerSender:   10,
+		srvflags.EVMMempoolMaxTxAge:          "1m",
+		srvflags.EVMMempoolPriceBump:         uint64(20),
+		srvflags.EVMMempoolQueueFutureNonces: false,
+		srvflags.EVMPrivateTxMaxBlocks:       uint64(5),
+		srvflags.EVMPrivateTxBroadcast:       true,
+	})
+	require.Equal(t, Config{
+		MaxTxs:             100,
+		MaxTxsPerSender:    10,
+		MaxTxAge:           time.Minute,
+		PriceBump:          20,
+		QueueFutureNonces:  false,
+		PrivateTxMaxBlocks: 5,
+		PrivateTxBroadcast: true,
+	}, cfg)
+}
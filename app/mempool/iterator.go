@@ -0,0 +1,128 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+package mempool
+
+import (
+	"bytes"
+	"container/heap"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+var _ sdkmempool.Iterator = &iterator{}
+
+// selectedTx is an executable Ethereum tx with its priority for the proposal.
+type selectedTx struct {
+	*evmTx
+	priority int64
+}
+
+// senderHeap is a max heap of the executable Ethereum txs of each sender,
+// ordered by the priority of the lowest nonce tx of each sender.
+type senderHeap [][]selectedTx
+
+func (h senderHeap) Len() int { return len(h) }
+
+func (h senderHeap) Less(i, j int) bool {
+	if h[i][0].priority != h[j][0].priority {
+		return h[i][0].priority > h[j][0].priority
+	}
+	// break ties by arrival time and then by sender, so that the order is
+	// deterministic
+	if !h[i][0].insertedAt.Equal(h[j][0].insertedAt) {
+		return h[i][0].insertedAt.Before(h[j][0].insertedAt)
+	}
+	return bytes.Compare(h[i][0].sender.Bytes(), h[j][0].sender.Bytes()) < 0
+}
+
+func (h senderHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *senderHeap) Push(x any) { *h = append(*h, x.([]selectedTx)) }
+
+func (h *senderHeap) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
+// iterator merges the executable Ethereum txs and the Cosmos txs of the
+// mempool in descending priority order.
+type iterator struct {
+	ctx        sdk.Context
+	feeChecker authante.TxFeeChecker
+
+	evmTxs         senderHeap
+	cosmosTxs      sdkmempool.Iterator
+	cosmosPriority int64
+
+	tx sdk.Tx
+}
+
+// newIterator returns a new iterator over the given Ethereum and Cosmos txs.
+func newIterator(
+	ctx sdk.Context,
+	feeChecker authante.TxFeeChecker,
+	evmTxs senderHeap,
+	cosmosTxs sdkmempool.Iterator,
+) *iterator {
+	heap.Init(&evmTxs)
+	it := &iterator{
+		ctx:        ctx,
+		feeChecker: feeChecker,
+		evmTxs:     evmTxs,
+		cosmosTxs:  cosmosTxs,
+	}
+	it.updateCosmosPriority()
+	return it
+}
+
+// Tx returns the tx at the current position of the iterator.
+func (it *iterator) Tx() sdk.Tx {
+	return it.tx
+}
+
+// Next returns the iterator positioned at the next tx, or nil if there are no
+// more txs.
+func (it *iterator) Next() sdkmempool.Iterator {
+	return it.next()
+}
+
+// next moves the iterator to the tx with the highest priority. It returns nil
+// if there are no more txs.
+func (it *iterator) next() sdkmempool.Iterator {
+	switch {
+	case it.evmTxs.Len() > 0 && (it.cosmosTxs == nil || it.evmTxs[0][0].priority >= it.cosmosPriority):
+		pending := heap.Pop(&it.evmTxs).([]selectedTx)
+		it.tx = pending[0].tx
+		if len(pending) > 1 {
+			heap.Push(&it.evmTxs, pending[1:])
+		}
+	case it.cosmosTxs != nil:
+		it.tx = it.cosmosTxs.Tx()
+		it.cosmosTxs = it.cosmosTxs.Next()
+		it.updateCosmosPriority()
+	default:
+		return nil
+	}
+
+	return it
+}
+
+// updateCosmosPriority sets the priority of the next Cosmos tx. Txs that fail
+// the fee check have the lowest priority, they are removed from the mempool
+// if they fail the proposal verification.
+func (it *iterator) updateCosmosPriority() {
+	if it.cosmosTxs == nil {
+		return
+	}
+
+	_, priority, err := it.feeChecker(it.ctx, it.cosmosTxs.Tx())
+	if err != nil {
+		priority = 0
+	}
+	it.cosmosPriority = priority
+}
@@ -0,0 +1,427 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+package mempool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmtypes "github.com/AizelNetwork/CosmEvm/x/evm/types"
+)
+
+var (
+	// ErrReplacementUnderpriced is returned when an Ethereum tx replaces a tx
+	// with the same sender and nonce without the minimum fee increase.
+	ErrReplacementUnderpriced = errors.New("replacement transaction underpriced")
+	// ErrTxAlreadyKnown is returned when an Ethereum tx is already in the mempool.
+	ErrTxAlreadyKnown = errors.New("transaction already known")
+	// ErrSenderTxLimit is returned when the sender of an Ethereum tx reached
+	// the maximum number of txs in the mempool.
+	ErrSenderTxLimit = errors.New("sender reached the max number of transactions in the mempool")
+	// ErrMixedMsgs is returned when a tx contains both Ethereum and Cosmos
+	// messages.
+	ErrMixedMsgs = errors.New("transactions with both ethereum and cosmos messages are not supported by the mempool")
+)
+
+var _ sdkmempool.Mempool = &EVMMempool{}
+
+// AccountKeeper defines the expected account keeper used to get the nonce of
+// the Ethereum tx senders.
+type AccountKeeper interface {
+	GetSequence(ctx context.Context, addr sdk.AccAddress) (uint64, error)
+}
+
+// EVMMempool is an app-side mempool aware of Ethereum txs. Ethereum txs are
+// queued per sender and nonce, so that txs with a nonce above the sender
+// sequence are kept until the gap is filled. A tx with the same sender and
+// nonce as a pending one replaces it if it bumps the fee cap and the tip cap
+// by at least the configured price bump. Cosmos txs are kept in a priority
+// nonce mempool.
+//
+// Ethereum tx bundles, i.e. txs with multiple Ethereum messages, are kept
+// separately and selected as a single tx once the first nonce of each of their
+// senders matches the sender sequence.
+//
+// Txs are selected for proposals in descending effective tip order, as
+// returned by the fee checker for the proposal context, while keeping the
+// nonce order of each sender. Only the Ethereum txs with consecutive nonces
+// from the sender sequence are selected.
+//
+// When the mempool is full, the Ethereum tx with the lowest tip among the
+// last nonce txs of each sender is evicted if the new tx has a higher
+// priority. Ethereum txs older than the max tx age are evicted as well.
+type EVMMempool struct {
+	mtx sync.Mutex
+
+	config        Config
+	accountKeeper AccountKeeper
+	feeChecker    authante.TxFeeChecker
+
+	cosmosTxs  *sdkmempool.PriorityNonceMempool[int64]
+	senders    map[common.Address]map[uint64]*evmTx
+	evmTxCount int
+	bundles    map[common.Hash]*bundle
+
+	// now returns the current time, it's replaced in tests
+	now func() time.Time
+}
+
+// evmTx is an Ethereum tx stored in the mempool.
+type evmTx struct {
+	tx         sdk.Tx
+	hash       string
+	sender     common.Address
+	nonce      uint64
+	gasFeeCap  *big.Int
+	gasTipCap  *big.Int
+	priority   int64
+	insertedAt time.Time
+}
+
+// NewEVMMempool returns a new EVMMempool with the given configuration. The fee
+// checker is used to compute the priority of the txs.
+func NewEVMMempool(cfg Config, accountKeeper AccountKeeper, feeChecker authante.TxFeeChecker) *EVMMempool {
+	cosmosConfig := sdkmempool.DefaultPriorityNonceMempoolConfig()
+	cosmosConfig.MaxTx = cfg.MaxTxs
+
+	return &EVMMempool{
+		config:        cfg,
+		accountKeeper: accountKeeper,
+		feeChecker:    feeChecker,
+		cosmosTxs:     sdkmempool.NewPriorityMempool(cosmosConfig),
+		senders:       make(map[common.Address]map[uint64]*evmTx),
+		bundles:       make(map[common.Hash]*bundle),
+		now:           time.Now,
+	}
+}
+
+// Insert adds a tx to the mempool. Ethereum txs replace the tx with the same
+// sender and nonce if they bump its fees by the configured price bump.
+func (m *EVMMempool) Insert(goCtx context.Context, tx sdk.Tx) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.evictExpired()
+
+	msgs, err := getEthereumMsgs(tx)
+	if err != nil {
+		return err
+	}
+
+	if len(msgs) == 0 {
+		if m.countTx() >= m.config.MaxTxs && !m.evictLowestPriority(ctx.Priority()) {
+			return sdkmempool.ErrMempoolTxMaxCapacity
+		}
+		return m.cosmosTxs.Insert(goCtx, tx)
+	}
+
+	if len(msgs) > 1 {
+		return m.insertBundle(ctx, tx, msgs)
+	}
+
+	newTx, err := m.newEVMTx(ctx, tx, msgs[0])
+	if err != nil {
+		return err
+	}
+
+	queue := m.senders[newTx.sender]
+	if existing, found := queue[newTx.nonce]; found {
+		if existing.hash == newTx.hash {
+			return ErrTxAlreadyKnown
+		}
+		if !isReplacement(existing, newTx, m.config.PriceBump) {
+			return fmt.Errorf(
+				"%w: fee cap %s and tip cap %s must be increased by %d%%",
+				ErrReplacementUnderpriced, existing.gasFeeCap, existing.gasTipCap, m.config.PriceBump,
+			)
+		}
+		queue[newTx.nonce] = newTx
+		return nil
+	}
+
+	if len(queue) >= m.config.MaxTxsPerSender {
+		return fmt.Errorf("%w: %d", ErrSenderTxLimit, m.config.MaxTxsPerSender)
+	}
+
+	if m.countTx() >= m.config.MaxTxs && !m.evictLowestPriority(newTx.priority) {
+		return sdkmempool.ErrMempoolTxMaxCapacity
+	}
+
+	// the sender queue might have been removed by the eviction
+	queue = m.senders[newTx.sender]
+	if queue == nil {
+		queue = make(map[uint64]*evmTx)
+		m.senders[newTx.sender] = queue
+	}
+	queue[newTx.nonce] = newTx
+	m.evmTxCount++
+
+	return nil
+}
+
+// Select returns an iterator over the txs that can be included in the next
+// block, in descending priority order. The txs argument is ignored, as the
+// txs are selected from the mempool.
+func (m *EVMMempool) Select(goCtx context.Context, _ [][]byte) sdkmempool.Iterator {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.evictExpired()
+
+	heads := make(senderHeap, 0, len(m.senders)+len(m.bundles))
+	for sender, queue := range m.senders {
+		// an error is returned if the account doesn't exist, in which case the
+		// sequence is zero
+		nonce, err := m.accountKeeper.GetSequence(ctx, sender.Bytes())
+		if err != nil {
+			nonce = 0
+		}
+
+		// remove the txs of the nonces that were already used
+		for txNonce := range queue {
+			if txNonce < nonce {
+				m.removeEVMTx(sender, txNonce)
+			}
+		}
+
+		var pending []selectedTx
+		for ; ; nonce++ {
+			tx, found := queue[nonce]
+			if !found {
+				break
+			}
+
+			// the txs that don't pay the current base fee can't be executed,
+			// so the following nonces can't be executed either
+			_, priority, err := m.feeChecker(ctx, tx.tx)
+			if err != nil {
+				break
+			}
+			pending = append(pending, selectedTx{evmTx: tx, priority: priority})
+		}
+
+		if len(pending) > 0 {
+			heads = append(heads, pending)
+		}
+	}
+
+	heads = append(heads, m.selectBundles(ctx)...)
+
+	return newIterator(ctx, m.feeChecker, heads, m.cosmosTxs.Select(goCtx, nil)).next()
+}
+
+// CountTx returns the number of txs in the mempool.
+func (m *EVMMempool) CountTx() int {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	return m.countTx()
+}
+
+// Remove removes a tx from the mempool. It returns ErrTxNotFound if the tx is
+// not in the mempool.
+func (m *EVMMempool) Remove(tx sdk.Tx) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	msgs, err := getEthereumMsgs(tx)
+	if err != nil {
+		return err
+	}
+
+	if len(msgs) == 0 {
+		return m.cosmosTxs.Remove(tx)
+	}
+
+	if len(msgs) > 1 {
+		return m.removeBundle(msgs)
+	}
+
+	msg := msgs[0]
+	sender, err := getSender(msg)
+	if err != nil {
+		return err
+	}
+
+	txData, err := evmtypes.UnpackTxData(msg.Data)
+	if err != nil {
+		return err
+	}
+
+	existing, found := m.senders[sender][txData.GetNonce()]
+	if !found || existing.hash != msg.Hash {
+		return sdkmempool.ErrTxNotFound
+	}
+
+	m.removeEVMTx(sender, txData.GetNonce())
+	return nil
+}
+
+// newEVMTx returns the mempool representation of an Ethereum tx.
+func (m *EVMMempool) newEVMTx(ctx sdk.Context, tx sdk.Tx, msg *evmtypes.MsgEthereumTx) (*evmTx, error) {
+	sender, err := getSender(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	txData, err := evmtypes.UnpackTxData(msg.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	_, priority, err := m.feeChecker(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &evmTx{
+		tx:         tx,
+		hash:       msg.Hash,
+		sender:     sender,
+		nonce:      txData.GetNonce(),
+		gasFeeCap:  txData.GetGasFeeCap(),
+		gasTipCap:  txData.GetGasTipCap(),
+		priority:   priority,
+		insertedAt: m.now(),
+	}, nil
+}
+
+// countTx returns the number of txs in the mempool. It must be called with the
+// lock held.
+func (m *EVMMempool) countTx() int {
+	return m.evmTxCount + len(m.bundles) + m.cosmosTxs.CountTx()
+}
+
+// removeEVMTx removes the Ethereum tx with the given sender and nonce. It must
+// be called with the lock held.
+func (m *EVMMempool) removeEVMTx(sender common.Address, nonce uint64) {
+	queue := m.senders[sender]
+	if _, found := queue[nonce]; !found {
+		return
+	}
+
+	delete(queue, nonce)
+	m.evmTxCount--
+	if len(queue) == 0 {
+		delete(m.senders, sender)
+	}
+}
+
+// evictExpired removes the Ethereum txs and bundles older than the max tx age.
+// It must be called with the lock held.
+func (m *EVMMempool) evictExpired() {
+	if m.config.MaxTxAge == 0 {
+		return
+	}
+
+	cutoff := m.now().Add(-m.config.MaxTxAge)
+	for sender, queue := range m.senders {
+		for nonce, tx := range queue {
+			if tx.insertedAt.Before(cutoff) {
+				m.removeEVMTx(sender, nonce)
+			}
+		}
+	}
+
+	for hash, b := range m.bundles {
+		if b.insertedAt.Before(cutoff) {
+			delete(m.bundles, hash)
+		}
+	}
+}
+
+// evictLowestPriority removes the Ethereum tx with the lowest priority among
+// the highest nonce txs of each sender, so that no nonce gap is created. It
+// returns false if there is no tx with a lower priority than the given one.
+// It must be called with the lock held.
+func (m *EVMMempool) evictLowestPriority(priority int64) bool {
+	var lowest *evmTx
+	for _, queue := range m.senders {
+		var last *evmTx
+		for _, tx := range queue {
+			if last == nil || tx.nonce > last.nonce {
+				last = tx
+			}
+		}
+
+		if lowest == nil || last.priority < lowest.priority ||
+			(last.priority == lowest.priority && last.insertedAt.After(lowest.insertedAt)) {
+			lowest = last
+		}
+	}
+
+	if lowest == nil || lowest.priority >= priority {
+		return false
+	}
+
+	m.removeEVMTx(lowest.sender, lowest.nonce)
+	return true
+}
+
+// isReplacement returns true if the new tx bumps both the fee cap and the tip
+// cap of the existing tx by at least the given percentage.
+func isReplacement(existing, newTx *evmTx, priceBump uint64) bool {
+	return isBumped(existing.gasFeeCap, newTx.gasFeeCap, priceBump) &&
+		isBumped(existing.gasTipCap, newTx.gasTipCap, priceBump)
+}
+
+// isBumped returns true if the new value is higher than the old value and
+// increases it by at least the given percentage.
+func isBumped(oldValue, newValue *big.Int, priceBump uint64) bool {
+	if newValue.Cmp(oldValue) <= 0 {
+		return false
+	}
+
+	// threshold = oldValue * (100 + priceBump) / 100
+	threshold := new(big.Int).Mul(oldValue, new(big.Int).SetUint64(100+priceBump))
+	threshold.Quo(threshold, big.NewInt(100))
+	return newValue.Cmp(threshold) >= 0
+}
+
+// getEthereumMsgs returns the Ethereum messages of the tx, or nil if the tx is
+// a Cosmos tx.
+func getEthereumMsgs(tx sdk.Tx) ([]*evmtypes.MsgEthereumTx, error) {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return nil, nil
+	}
+
+	if _, ok := msgs[0].(*evmtypes.MsgEthereumTx); !ok {
+		return nil, nil
+	}
+
+	ethMsgs := make([]*evmtypes.MsgEthereumTx, len(msgs))
+	for i, msg := range msgs {
+		ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return nil, ErrMixedMsgs
+		}
+		ethMsgs[i] = ethMsg
+	}
+
+	return ethMsgs, nil
+}
+
+// getSender returns the sender of the Ethereum message. The sender is set by
+// the ante handler signature verification, which verifies the sender of the
+// tx of a multisig account, otherwise it's recovered from the signature.
+func getSender(msg *evmtypes.MsgEthereumTx) (common.Address, error) {
+	if from := msg.GetFrom(); !from.Empty() {
+		return common.BytesToAddress(from), nil
+	}
+
+	return msg.GetSender(evmtypes.GetEthChainConfig().ChainID)
+}
@@ -0,0 +1,187 @@
+package mempool
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AizelNetwork/CosmEvm/encoding"
+	evmtypes "github.com/AizelNetwork/CosmEvm/x/evm/types"
+)
+
+type mockAccountKeeper map[string]uint64
+
+func (m mockAccountKeeper) GetSequence(_ context.Context, addr sdk.AccAddress) (uint64, error) {
+	seq, found := m[addr.String()]
+	if !found {
+		return 0, errors.New("account not found")
+	}
+	return seq, nil
+}
+
+// tipFeeChecker uses the tip cap of the Ethereum txs as priority and rejects
+// the txs with a fee cap below the base fee.
+func tipFeeChecker(baseFee *int64) func(sdk.Context, sdk.Tx) (sdk.Coins, int64, error) {
+	return func(_ sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+		msg := tx.GetMsgs()[0].(*evmtypes.MsgEthereumTx)
+		txData, err := evmtypes.UnpackTxData(msg.Data)
+		if err != nil {
+			return nil, 0, err
+		}
+		if txData.GetGasFeeCap().Int64() < *baseFee {
+			return nil, 0, errors.New("fee cap below base fee")
+		}
+		return nil, txData.GetGasTipCap().Int64(), nil
+	}
+}
+
+func newEthTx(t *testing.T, sender common.Address, nonce uint64, feeCap, tipCap int64) sdk.Tx {
+	msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:   big.NewInt(9001),
+		Nonce:     nonce,
+		GasLimit:  21000,
+		GasFeeCap: big.NewInt(feeCap),
+		GasTipCap: big.NewInt(tipCap),
+		To:        &common.Address{},
+	})
+	builder := encoding.MakeConfig().TxConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(msg))
+	builder.SetGasLimit(msg.GetGas())
+	// the sender is set by the ante handler signature verification
+	msg.From = sender.Hex()
+	return builder.GetTx()
+}
+
+func selectTxs(ctx sdk.Context, mp *EVMMempool) []sdk.Tx {
+	var txs []sdk.Tx
+	for it := mp.Select(ctx, nil); it != nil; it = it.Next() {
+		txs = append(txs, it.Tx())
+	}
+	return txs
+}
+
+func setupMempool(cfg Config, accounts mockAccountKeeper, baseFee *int64) (sdk.Context, *EVMMempool) {
+	ctx := sdk.NewContext(nil, tmproto.Header{Height: 1}, true, log.NewNopLogger())
+	return ctx, NewEVMMempool(cfg, accounts, tipFeeChecker(baseFee))
+}
+
+func TestFutureNonceQueue(t *testing.T) {
+	sender := common.BigToAddress(big.NewInt(1))
+	ctx, mp := setupMempool(DefaultConfig(), mockAccountKeeper{}, new(int64))
+
+	tx1 := newEthTx(t, sender, 1, 10, 1)
+	require.NoError(t, mp.Insert(ctx, tx1))
+	// nonce 0 is missing, so the tx is queued
+	require.Empty(t, selectTxs(ctx, mp))
+
+	tx0 := newEthTx(t, sender, 0, 10, 1)
+	require.NoError(t, mp.Insert(ctx, tx0))
+	tx3 := newEthTx(t, sender, 3, 10, 1)
+	require.NoError(t, mp.Insert(ctx, tx3))
+	require.Equal(t, 3, mp.CountTx())
+
+	// the tx with nonce 3 stays queued until nonce 2 is received
+	require.Equal(t, []sdk.Tx{tx0, tx1}, selectTxs(ctx, mp))
+
+	require.ErrorIs(t, mp.Insert(ctx, tx0), ErrTxAlreadyKnown)
+
+	require.NoError(t, mp.Remove(tx0))
+	require.ErrorIs(t, mp.Remove(tx0), sdkmempool.ErrTxNotFound)
+	require.Equal(t, 2, mp.CountTx())
+}
+
+func TestReplaceByFee(t *testing.T) {
+	sender := common.BigToAddress(big.NewInt(2))
+	ctx, mp := setupMempool(DefaultConfig(), mockAccountKeeper{}, new(int64))
+
+	require.NoError(t, mp.Insert(ctx, newEthTx(t, sender, 0, 100, 10)))
+
+	// a 5% bump is below the default price bump
+	err := mp.Insert(ctx, newEthTx(t, sender, 0, 105, 11))
+	require.ErrorIs(t, err, ErrReplacementUnderpriced)
+
+	// both the fee cap and the tip cap must be bumped
+	err = mp.Insert(ctx, newEthTx(t, sender, 0, 200, 10))
+	require.ErrorIs(t, err, ErrReplacementUnderpriced)
+
+	replacement := newEthTx(t, sender, 0, 110, 11)
+	require.NoError(t, mp.Insert(ctx, replacement))
+	require.Equal(t, 1, mp.CountTx())
+	require.Equal(t, []sdk.Tx{replacement}, selectTxs(ctx, mp))
+}
+
+func TestSelectOrder(t *testing.T) {
+	senderA := common.BigToAddress(big.NewInt(3))
+	senderB := common.BigToAddress(big.NewInt(4))
+	senderC := common.BigToAddress(big.NewInt(5))
+	accounts := mockAccountKeeper{
+		sdk.AccAddress(senderA.Bytes()).String(): 1,
+	}
+	baseFee := int64(0)
+	ctx, mp := setupMempool(DefaultConfig(), accounts, &baseFee)
+
+	// nonce 0 of sender A was already executed
+	staleA := newEthTx(t, senderA, 0, 100, 100)
+	txA1 := newEthTx(t, senderA, 1, 100, 1)
+	txA2 := newEthTx(t, senderA, 2, 100, 20)
+	txB0 := newEthTx(t, senderB, 0, 100, 5)
+	txC0 := newEthTx(t, senderC, 0, 10, 30)
+	for _, tx := range []sdk.Tx{staleA, txA1, txA2, txB0, txC0} {
+		require.NoError(t, mp.Insert(ctx, tx))
+	}
+
+	// sender C doesn't pay the base fee anymore
+	baseFee = 50
+
+	// the txs of each sender are selected in nonce order, even if a later
+	// nonce has a higher tip
+	require.Equal(t, []sdk.Tx{txB0, txA1, txA2}, selectTxs(ctx, mp))
+	// the stale tx is removed
+	require.Equal(t, 4, mp.CountTx())
+}
+
+func TestEviction(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.MaxTxs = 2
+	cfg.MaxTxsPerSender = 1
+	cfg.MaxTxAge = time.Hour
+	ctx, mp := setupMempool(cfg, mockAccountKeeper{}, new(int64))
+
+	now := time.Now()
+	mp.now = func() time.Time { return now }
+
+	senderA := common.BigToAddress(big.NewInt(6))
+	senderB := common.BigToAddress(big.NewInt(7))
+	senderC := common.BigToAddress(big.NewInt(8))
+	senderD := common.BigToAddress(big.NewInt(9))
+
+	require.NoError(t, mp.Insert(ctx, newEthTx(t, senderA, 0, 100, 1)))
+	require.ErrorIs(t, mp.Insert(ctx, newEthTx(t, senderA, 1, 100, 1)), ErrSenderTxLimit)
+
+	txB0 := newEthTx(t, senderB, 0, 100, 2)
+	require.NoError(t, mp.Insert(ctx, txB0))
+
+	// the tx of sender A has the lowest tip and is evicted
+	txC0 := newEthTx(t, senderC, 0, 100, 3)
+	require.NoError(t, mp.Insert(ctx, txC0))
+	require.Equal(t, 2, mp.CountTx())
+	require.Equal(t, []sdk.Tx{txC0, txB0}, selectTxs(ctx, mp))
+
+	// the mempool is full of txs with a higher tip
+	err := mp.Insert(ctx, newEthTx(t, senderD, 0, 100, 1))
+	require.ErrorIs(t, err, sdkmempool.ErrMempoolTxMaxCapacity)
+
+	// the txs older than the max tx age are evicted
+	now = now.Add(2 * time.Hour)
+	require.Empty(t, selectTxs(ctx, mp))
+	require.Equal(t, 0, mp.CountTx())
+}
@@ -0,0 +1,271 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+package mempool
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/AizelNetwork/CosmEvm/types"
+)
+
+var (
+	// ErrPrivateTxsDisabled is returned when a private tx is sent to a node
+	// with the private txs disabled.
+	ErrPrivateTxsDisabled = errors.New("private transactions are disabled")
+	// ErrPrivatePoolNotReady is returned when a private tx is sent before the
+	// node committed its first block.
+	ErrPrivatePoolNotReady = errors.New("private transaction pool is not ready")
+)
+
+var _ types.PrivateTxPool = &PrivatePool{}
+
+// TxChecker verifies a tx against the check state, as in CheckTx, without
+// adding it to the mempool.
+type TxChecker func(tx sdk.Tx, txBytes []byte) error
+
+// PrivatePool is a node-local pool of private Ethereum txs. Private txs are
+// not gossiped through CometBFT, they are only included in the blocks proposed
+// by the node, to protect them from front-running.
+//
+// The private txs are verified as in CheckTx when they are added, and the txs
+// that fail the verification when a block is proposed are evicted. Each
+// private tx has a max block height, at most the configured max number of
+// blocks after the last committed block. The txs that were not included
+// before their max block height are dropped, or broadcast publicly if enabled
+// in the configuration. The txs with a nonce below the sender sequence, i.e.
+// included or replaced, are removed after each commit.
+type PrivatePool struct {
+	mtx sync.Mutex
+
+	config        Config
+	accountKeeper AccountKeeper
+	txDecoder     sdk.TxDecoder
+	txChecker     TxChecker
+	broadcast     func(txBytes []byte) error
+
+	txs map[common.Hash]*privateTx
+	// nextSeq is the insertion sequence of the next tx
+	nextSeq uint64
+	// height is the last committed block height
+	height int64
+}
+
+// privateTx is a private Ethereum tx stored in the pool.
+type privateTx struct {
+	tx        sdk.Tx
+	txBytes   []byte
+	sender    common.Address
+	nonce     uint64
+	maxHeight int64
+	seq       uint64
+}
+
+// NewPrivatePool returns a new PrivatePool with the given configuration. The
+// tx checker verifies the txs before they are added to the pool.
+func NewPrivatePool(cfg Config, accountKeeper AccountKeeper, txDecoder sdk.TxDecoder, txChecker TxChecker) *PrivatePool {
+	return &PrivatePool{
+		config:        cfg,
+		accountKeeper: accountKeeper,
+		txDecoder:     txDecoder,
+		txChecker:     txChecker,
+		txs:           make(map[common.Hash]*privateTx),
+	}
+}
+
+// SetBroadcaster sets the function used to broadcast the expired txs.
+func (p *PrivatePool) SetBroadcaster(broadcast func(txBytes []byte) error) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	p.broadcast = broadcast
+}
+
+// AddTx verifies an encoded Ethereum tx and adds it to the pool. The tx can be
+// included up to the given block height, which cannot exceed the max number of
+// blocks after the last committed block. Zero uses the max number of blocks.
+func (p *PrivatePool) AddTx(txBytes []byte, maxBlockHeight int64) (common.Hash, error) {
+	if p.config.PrivateTxMaxBlocks == 0 {
+		return common.Hash{}, ErrPrivateTxsDisabled
+	}
+
+	tx, err := p.txDecoder(txBytes)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	msgs, err := getEthereumMsgs(tx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if len(msgs) != 1 {
+		return common.Hash{}, errors.New("private transactions must contain a single ethereum message")
+	}
+
+	sender, err := getSender(msgs[0])
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	if err := p.txChecker(tx, txBytes); err != nil {
+		return common.Hash{}, err
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if p.height == 0 {
+		return common.Hash{}, ErrPrivatePoolNotReady
+	}
+
+	lastHeight := p.height + int64(p.config.PrivateTxMaxBlocks) // #nosec G115 -- max blocks is a node config value
+	switch {
+	case maxBlockHeight == 0:
+		maxBlockHeight = lastHeight
+	case maxBlockHeight <= p.height:
+		return common.Hash{}, fmt.Errorf("max block number %d is not after the latest block %d", maxBlockHeight, p.height)
+	case maxBlockHeight > lastHeight:
+		return common.Hash{}, fmt.Errorf(
+			"max block number %d exceeds the max number of blocks %d of the private transactions",
+			maxBlockHeight, p.config.PrivateTxMaxBlocks,
+		)
+	}
+
+	hash := msgs[0].AsTransaction().Hash()
+	if _, found := p.txs[hash]; found {
+		return common.Hash{}, ErrTxAlreadyKnown
+	}
+	if len(p.txs) >= p.config.MaxTxs {
+		return common.Hash{}, sdkmempool.ErrMempoolTxMaxCapacity
+	}
+
+	p.txs[hash] = &privateTx{
+		tx:        tx,
+		txBytes:   txBytes,
+		sender:    sender,
+		nonce:     msgs[0].AsTransaction().Nonce(),
+		maxHeight: maxBlockHeight,
+		seq:       p.nextSeq,
+	}
+	p.nextSeq++
+
+	return hash, nil
+}
+
+// CancelTx removes the tx with the given Ethereum hash from the pool. It
+// returns false if the tx isn't in the pool or wasn't sent by the given
+// sender, so that the private txs can only be canceled by their sender.
+func (p *PrivatePool) CancelTx(hash common.Hash, sender common.Address) bool {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	ptx, found := p.txs[hash]
+	if !found || ptx.sender != sender {
+		return false
+	}
+
+	delete(p.txs, hash)
+	return true
+}
+
+// RemoveTx removes the given tx from the pool. It's used to evict the txs that
+// fail the verification when a block is proposed.
+func (p *PrivatePool) RemoveTx(tx sdk.Tx) {
+	msgs, err := getEthereumMsgs(tx)
+	if err != nil || len(msgs) != 1 {
+		return
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	delete(p.txs, msgs[0].AsTransaction().Hash())
+}
+
+// CountTx returns the number of txs in the pool.
+func (p *PrivatePool) CountTx() int {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	return len(p.txs)
+}
+
+// Select returns the txs that can be included in the block with the given
+// height, in ascending nonce order so that the txs of each sender are kept in
+// order.
+func (p *PrivatePool) Select(height int64) []sdk.Tx {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	selected := make([]*privateTx, 0, len(p.txs))
+	for _, ptx := range p.txs {
+		if ptx.maxHeight >= height {
+			selected = append(selected, ptx)
+		}
+	}
+
+	sortPrivateTxs(selected)
+
+	txs := make([]sdk.Tx, len(selected))
+	for i, ptx := range selected {
+		txs[i] = ptx.tx
+	}
+	return txs
+}
+
+// Prune removes the txs with a nonce below the sender sequence and the txs
+// that cannot be included anymore after the committed block. It's called
+// after each commit with the check state context.
+func (p *PrivatePool) Prune(ctx sdk.Context) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	p.height = ctx.BlockHeight()
+
+	var expired []*privateTx
+	for hash, ptx := range p.txs {
+		seq, err := p.accountKeeper.GetSequence(ctx, ptx.sender.Bytes())
+		if err == nil && ptx.nonce < seq {
+			delete(p.txs, hash)
+			continue
+		}
+
+		if ptx.maxHeight <= p.height {
+			delete(p.txs, hash)
+			expired = append(expired, ptx)
+		}
+	}
+
+	if len(expired) == 0 || !p.config.PrivateTxBroadcast || p.broadcast == nil {
+		return
+	}
+
+	// NOTE: the txs are broadcast asynchronously, as the CometBFT mempool is
+	// locked during the commit.
+	sortPrivateTxs(expired)
+	logger := ctx.Logger()
+	broadcast := p.broadcast
+	go func() {
+		for _, ptx := range expired {
+			if err := broadcast(ptx.txBytes); err != nil {
+				logger.Error("failed to broadcast expired private tx", "error", err.Error())
+			}
+		}
+	}()
+}
+
+// sortPrivateTxs sorts the txs in ascending nonce and insertion order.
+func sortPrivateTxs(txs []*privateTx) {
+	sort.Slice(txs, func(i, j int) bool {
+		if txs[i].nonce != txs[j].nonce {
+			return txs[i].nonce < txs[j].nonce
+		}
+		return txs[i].seq < txs[j].seq
+	})
+}
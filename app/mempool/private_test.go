@@ -0,0 +1,147 @@
+package mempool
+
+import (
+	"errors"
+	"testing"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	evmtypes "github.com/AizelNetwork/CosmEvm/x/evm/types"
+)
+
+func encodeTx(t *testing.T, encCfg sdktestutil.TestEncodingConfig, tx sdk.Tx) []byte {
+	txBz, err := encCfg.TxConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+	return txBz
+}
+
+func getNonces(txs []sdk.Tx) []uint64 {
+	nonces := make([]uint64, len(txs))
+	for i, tx := range txs {
+		nonces[i] = tx.GetMsgs()[0].(*evmtypes.MsgEthereumTx).AsTransaction().Nonce()
+	}
+	return nonces
+}
+
+// checkTx accepts all the txs.
+func checkTx(sdk.Tx, []byte) error { return nil }
+
+func TestPrivatePool(t *testing.T) {
+	_, encCfg, _, _, _ := setupProposalHandler(t)
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+
+	cfg := DefaultConfig()
+	cfg.PrivateTxMaxBlocks = 10
+	cfg.PrivateTxBroadcast = true
+	accounts := mockAccountKeeper{}
+	pool := NewPrivatePool(cfg, accounts, encCfg.TxConfig.TxDecoder(), checkTx)
+
+	broadcasted := make(chan []byte, 1)
+	pool.SetBroadcaster(func(txBytes []byte) error {
+		broadcasted <- txBytes
+		return nil
+	})
+
+	tx0 := signEthTx(t, encCfg, key, 0)
+	tx0Bz := encodeTx(t, encCfg, tx0)
+	tx1 := signEthTx(t, encCfg, key, 1)
+	tx1Bz := encodeTx(t, encCfg, tx1)
+
+	// no block was committed yet
+	_, err = pool.AddTx(tx0Bz, 0)
+	require.ErrorIs(t, err, ErrPrivatePoolNotReady)
+
+	ctx := sdk.NewContext(nil, tmproto.Header{Height: 5}, true, log.NewNopLogger())
+	pool.Prune(ctx)
+
+	hash, err := pool.AddTx(tx0Bz, 0)
+	require.NoError(t, err)
+	require.Equal(t, tx0.GetMsgs()[0].(*evmtypes.MsgEthereumTx).AsTransaction().Hash(), hash)
+	_, err = pool.AddTx(tx0Bz, 0)
+	require.ErrorIs(t, err, ErrTxAlreadyKnown)
+
+	_, err = pool.AddTx(tx1Bz, 5)
+	require.ErrorContains(t, err, "is not after the latest block")
+	_, err = pool.AddTx(tx1Bz, 16)
+	require.ErrorContains(t, err, "exceeds the max number of blocks")
+	hash1, err := pool.AddTx(tx1Bz, 6)
+	require.NoError(t, err)
+
+	require.Equal(t, []uint64{0, 1}, getNonces(pool.Select(6)))
+	require.Equal(t, []uint64{0}, getNonces(pool.Select(7)))
+
+	// only the sender can cancel the tx
+	require.False(t, pool.CancelTx(hash1, common.HexToAddress("0x01")))
+	require.True(t, pool.CancelTx(hash1, sender))
+	require.False(t, pool.CancelTx(hash1, sender))
+	_, err = pool.AddTx(tx1Bz, 6)
+	require.NoError(t, err)
+
+	// the tx with nonce 0 was included and the tx with nonce 1 expired
+	accounts[sdk.AccAddress(sender.Bytes()).String()] = 1
+	pool.Prune(ctx.WithBlockHeight(6))
+	require.Equal(t, 0, pool.CountTx())
+	require.Equal(t, tx1Bz, <-broadcasted)
+
+	// the txs that fail the check are rejected
+	errCheck := errors.New("insufficient funds")
+	invalidPool := NewPrivatePool(cfg, accounts, encCfg.TxConfig.TxDecoder(), func(sdk.Tx, []byte) error { return errCheck })
+	invalidPool.Prune(ctx)
+	_, err = invalidPool.AddTx(tx1Bz, 0)
+	require.ErrorIs(t, err, errCheck)
+	require.Equal(t, 0, invalidPool.CountTx())
+
+	cfg.PrivateTxMaxBlocks = 0
+	_, err = NewPrivatePool(cfg, accounts, encCfg.TxConfig.TxDecoder(), checkTx).AddTx(tx1Bz, 0)
+	require.ErrorIs(t, err, ErrPrivateTxsDisabled)
+}
+
+func TestPrepareProposalPrivateTxs(t *testing.T) {
+	ctx, encCfg, mp, verifier, handler := setupProposalHandler(t)
+
+	cfg := DefaultConfig()
+	cfg.PrivateTxMaxBlocks = 10
+	handler.privatePool = NewPrivatePool(cfg, mockAccountKeeper{}, encCfg.TxConfig.TxDecoder(), checkTx)
+	handler.privatePool.Prune(ctx)
+
+	keyA, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	keyB, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	for _, tx := range []sdk.Tx{signEthTx(t, encCfg, keyA, 0), signEthTx(t, encCfg, keyB, 0)} {
+		_, err := handler.privatePool.AddTx(encodeTx(t, encCfg, tx), 0)
+		require.NoError(t, err)
+	}
+	privateTxs := handler.privatePool.Select(ctx.BlockHeight() + 1)
+	require.Len(t, privateTxs, 2)
+	verifier.invalid[privateTxs[1]] = true
+
+	// the public tx of the sender of the invalid private tx is still selected
+	publicTx := newEthTx(t, crypto.PubkeyToAddress(keyB.PublicKey), 0, 10, 1)
+	require.NoError(t, mp.Insert(ctx, publicTx))
+
+	res, err := handler.PrepareProposalHandler()(ctx, &abci.RequestPrepareProposal{
+		MaxTxBytes: 1_000_000,
+		Height:     ctx.BlockHeight() + 1,
+	})
+	require.NoError(t, err)
+
+	expTxs := [][]byte{encodeTx(t, encCfg, privateTxs[0]), encodeTx(t, encCfg, publicTx)}
+	require.Equal(t, expTxs, res.Txs)
+
+	// the valid private tx is kept until it's pruned and the invalid one is
+	// evicted
+	require.Equal(t, []sdk.Tx{privateTxs[0]}, handler.privatePool.Select(ctx.BlockHeight()+1))
+	require.Equal(t, []sdk.Tx{publicTx}, mp.txs)
+}
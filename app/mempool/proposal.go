@@ -0,0 +1,358 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+package mempool
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmante "github.com/AizelNetwork/CosmEvm/app/ante/evm"
+	"github.com/AizelNetwork/CosmEvm/types"
+	evmtypes "github.com/AizelNetwork/CosmEvm/x/evm/types"
+	feemarkettypes "github.com/AizelNetwork/CosmEvm/x/feemarket/types"
+)
+
+// EVMKeeper defines the expected EVM keeper used to verify the signatures of
+// the Ethereum txs of a proposal.
+type EVMKeeper interface {
+	GetParams(ctx sdk.Context) evmtypes.Params
+}
+
+// FeeMarketKeeper defines the expected fee market keeper used to get the share
+// of the block gas reserved for the Cosmos txs.
+type FeeMarketKeeper interface {
+	GetParams(ctx sdk.Context) feemarkettypes.Params
+}
+
+// ProposalHandler builds and verifies the block proposals. It replaces the
+// default SDK proposal handler, which packs the txs regardless of their type.
+//
+// The txs are selected from the mempool in descending priority order until
+// the block gas limit or the max block bytes are reached. The gas of each tx is
+// its gas limit, and not the gas wanted returned in CheckTx, which is capped by
+// the MaxTxGasWanted EVM option. The Ethereum txs cannot use more than the
+// block gas left after the share reserved for the Cosmos txs, as defined in
+// the fee market parameters.
+//
+// When a private pool is set, its txs are selected before the mempool txs,
+// as they can only be included in the blocks proposed by the node. The
+// private txs that fail the verification are evicted from the pool, and the
+// ones that don't fit in the block are kept until they are pruned. They don't
+// affect the selection of the mempool txs of their signers.
+//
+// Proposals that exceed the block gas limit or the Ethereum share of the
+// block, or that contain an Ethereum tx with an invalid signature or an
+// invalid or expired bundle, are rejected.
+type ProposalHandler struct {
+	mempool         sdkmempool.Mempool
+	privatePool     *PrivatePool
+	txVerifier      baseapp.ProposalTxVerifier
+	evmKeeper       EVMKeeper
+	feeMarketKeeper FeeMarketKeeper
+
+	signerExtAdapter sdkmempool.SignerExtractionAdapter
+}
+
+// NewProposalHandler returns a new ProposalHandler. The private pool is
+// optional.
+func NewProposalHandler(
+	mp sdkmempool.Mempool,
+	privatePool *PrivatePool,
+	txVerifier baseapp.ProposalTxVerifier,
+	evmKeeper EVMKeeper,
+	feeMarketKeeper FeeMarketKeeper,
+) *ProposalHandler {
+	return &ProposalHandler{
+		mempool:          mp,
+		privatePool:      privatePool,
+		txVerifier:       txVerifier,
+		evmKeeper:        evmKeeper,
+		feeMarketKeeper:  feeMarketKeeper,
+		signerExtAdapter: sdkmempool.NewDefaultSignerExtractionAdapter(),
+	}
+}
+
+// blockGas tracks the gas used by the txs of a proposal.
+type blockGas struct {
+	maxGas    uint64
+	maxEVMGas uint64
+	gas       uint64
+	evmGas    uint64
+}
+
+// newBlockGas returns the gas limits of a proposal, using the block gas limit
+// of the consensus parameters and the share of the block gas reserved for the
+// Cosmos txs.
+func (h *ProposalHandler) newBlockGas(ctx sdk.Context) blockGas {
+	maxGas := types.BlockGasLimit(ctx)
+	share := h.feeMarketKeeper.GetParams(ctx).CosmosBlockGasShare
+	if share.IsNil() {
+		share = math.LegacyZeroDec()
+	}
+
+	maxEVMGas := math.LegacyNewDecFromInt(math.NewIntFromUint64(maxGas)).
+		Mul(math.LegacyOneDec().Sub(share)).
+		TruncateInt().
+		Uint64()
+
+	return blockGas{maxGas: maxGas, maxEVMGas: maxEVMGas}
+}
+
+// fits returns true if a tx with the given gas can be added to the block.
+func (bg blockGas) fits(gas uint64, isEthereumTx bool) bool {
+	if gas > bg.maxGas-bg.gas {
+		return false
+	}
+	return !isEthereumTx || gas <= bg.maxEVMGas-bg.evmGas
+}
+
+// add adds the gas of a tx to the block.
+func (bg *blockGas) add(gas uint64, isEthereumTx bool) {
+	bg.gas += gas
+	if isEthereumTx {
+		bg.evmGas += gas
+	}
+}
+
+// PrepareProposalHandler returns the handler that selects the txs of a block
+// proposal from the private pool and the mempool.
+func (h *ProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		var (
+			selectedTxs [][]byte
+			totalBytes  int64
+			gas         = h.newBlockGas(ctx)
+			// signers with a skipped tx, their next txs are skipped as well
+			// to avoid nonce gaps
+			skippedSigners = make(map[string]struct{})
+		)
+
+		if h.privatePool != nil {
+			for _, tx := range h.privatePool.Select(req.Height) {
+				txGas := getTxGas(tx)
+				if !gas.fits(txGas, true) {
+					continue
+				}
+
+				txBz, err := h.txVerifier.PrepareProposalVerifyTx(tx)
+				if err != nil {
+					h.privatePool.RemoveTx(tx)
+					continue
+				}
+
+				txSize := int64(len(txBz))
+				if totalBytes+txSize > req.MaxTxBytes {
+					continue
+				}
+
+				selectedTxs = append(selectedTxs, txBz)
+				totalBytes += txSize
+				gas.add(txGas, true)
+			}
+		}
+
+		for it := h.mempool.Select(ctx, req.Txs); it != nil; it = it.Next() {
+			tx := it.Tx()
+
+			signers, isEthereumTx, err := h.getSigners(tx)
+			if err != nil {
+				return nil, err
+			}
+			if hasSkippedSigner(skippedSigners, signers) {
+				continue
+			}
+
+			txGas := getTxGas(tx)
+			if !gas.fits(txGas, isEthereumTx) {
+				skipSigners(skippedSigners, signers)
+				continue
+			}
+
+			txBz, err := h.txVerifier.PrepareProposalVerifyTx(tx)
+			if err != nil {
+				skipSigners(skippedSigners, signers)
+				if err := h.mempool.Remove(tx); err != nil && !errors.Is(err, sdkmempool.ErrTxNotFound) {
+					return nil, err
+				}
+				continue
+			}
+
+			txSize := int64(len(txBz))
+			if totalBytes+txSize > req.MaxTxBytes {
+				skipSigners(skippedSigners, signers)
+				continue
+			}
+
+			selectedTxs = append(selectedTxs, txBz)
+			totalBytes += txSize
+			gas.add(txGas, isEthereumTx)
+
+			if totalBytes >= req.MaxTxBytes || gas.gas >= gas.maxGas {
+				break
+			}
+		}
+
+		return &abci.ResponsePrepareProposal{Txs: selectedTxs}, nil
+	}
+}
+
+// ProcessProposalHandler returns the handler that verifies the txs of a block
+// proposal.
+func (h *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		reject := &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
+		gas := h.newBlockGas(ctx)
+
+		evmParams := h.evmKeeper.GetParams(ctx)
+		signer := ethtypes.MakeSigner(evmtypes.GetEthChainConfig(), big.NewInt(ctx.BlockHeight()))
+
+		for _, txBz := range req.Txs {
+			tx, err := h.txVerifier.TxDecode(txBz)
+			if err != nil {
+				return reject, nil
+			}
+
+			isEthereumTx, err := verifyEthereumSignatures(tx, signer, evmParams.AllowUnprotectedTxs)
+			if err != nil {
+				ctx.Logger().Debug("rejected proposal with an invalid ethereum tx", "error", err.Error())
+				return reject, nil
+			}
+
+			if evmtypes.IsBundle(tx.GetMsgs()) {
+				if err := evmante.ValidateBundle(ctx, tx); err != nil {
+					ctx.Logger().Debug("rejected proposal with an invalid bundle", "error", err.Error())
+					return reject, nil
+				}
+			}
+
+			txGas := getTxGas(tx)
+			if !gas.fits(txGas, isEthereumTx) {
+				ctx.Logger().Debug(
+					"rejected proposal exceeding the block gas",
+					"gas", gas.gas, "evm_gas", gas.evmGas, "tx_gas", txGas,
+					"max_gas", gas.maxGas, "max_evm_gas", gas.maxEVMGas,
+				)
+				return reject, nil
+			}
+			gas.add(txGas, isEthereumTx)
+
+			if _, err := h.txVerifier.ProcessProposalVerifyTx(txBz); err != nil {
+				return reject, nil
+			}
+		}
+
+		return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+	}
+}
+
+// getSigners returns the signers of the tx and whether it's an Ethereum tx.
+func (h *ProposalHandler) getSigners(tx sdk.Tx) ([]string, bool, error) {
+	msgs, err := getEthereumMsgs(tx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if len(msgs) > 0 {
+		signers := make([]string, len(msgs))
+		for i, msg := range msgs {
+			sender, err := getSender(msg)
+			if err != nil {
+				return nil, false, err
+			}
+			signers[i] = sdk.AccAddress(sender.Bytes()).String()
+		}
+		return signers, true, nil
+	}
+
+	signerData, err := h.signerExtAdapter.GetSigners(tx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	signers := make([]string, len(signerData))
+	for i, data := range signerData {
+		signers[i] = data.Signer.String()
+	}
+	return signers, false, nil
+}
+
+// verifyEthereumSignatures verifies the signatures of the Ethereum messages of
+// the tx, or the multisig signatures of its multisig extension option. It
+// returns false if the tx isn't an Ethereum tx.
+func verifyEthereumSignatures(tx sdk.Tx, signer ethtypes.Signer, allowUnprotectedTxs bool) (bool, error) {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return false, nil
+	}
+
+	if _, ok := msgs[0].(*evmtypes.MsgEthereumTx); !ok {
+		return false, nil
+	}
+
+	// the tx of a multisig sender is verified with the multisig extension
+	// option, as in the ante handler
+	multisigOption, err := evmtypes.GetMultisigOption(tx)
+	if err != nil {
+		return true, err
+	}
+	if multisigOption != nil {
+		if err := multisigOption.ValidateBasic(); err != nil {
+			return true, err
+		}
+	}
+	feeDenom, err := evmtypes.GetFeeDenom(tx)
+	if err != nil {
+		return true, err
+	}
+
+	for _, msg := range msgs {
+		ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return true, fmt.Errorf("invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil))
+		}
+
+		if multisigOption != nil {
+			if _, err := evmante.MultisigSignatureVerification(ethMsg, signer, multisigOption, feeDenom); err != nil {
+				return true, err
+			}
+			continue
+		}
+
+		if err := evmante.SignatureVerification(ethMsg, signer, allowUnprotectedTxs); err != nil {
+			return true, err
+		}
+	}
+
+	return true, nil
+}
+
+// getTxGas returns the gas limit of the tx.
+func getTxGas(tx sdk.Tx) uint64 {
+	if gasTx, ok := tx.(baseapp.GasTx); ok {
+		return gasTx.GetGas()
+	}
+	return 0
+}
+
+func hasSkippedSigner(skipped map[string]struct{}, signers []string) bool {
+	for _, signer := range signers {
+		if _, found := skipped[signer]; found {
+			return true
+		}
+	}
+	return false
+}
+
+func skipSigners(skipped map[string]struct{}, signers []string) {
+	for _, signer := range signers {
+		skipped[signer] = struct{}{}
+	}
+}
@@ -0,0 +1,367 @@
+package mempool
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	sdktestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AizelNetwork/CosmEvm/crypto/ethsecp256k1"
+	"github.com/AizelNetwork/CosmEvm/encoding"
+	evmtypes "github.com/AizelNetwork/CosmEvm/x/evm/types"
+	feemarkettypes "github.com/AizelNetwork/CosmEvm/x/feemarket/types"
+)
+
+// sliceMempool returns the txs in insertion order.
+type sliceMempool struct {
+	txs []sdk.Tx
+}
+
+type sliceIterator struct {
+	txs []sdk.Tx
+}
+
+func (it *sliceIterator) Next() sdkmempool.Iterator {
+	if len(it.txs) <= 1 {
+		return nil
+	}
+	return &sliceIterator{txs: it.txs[1:]}
+}
+
+func (it *sliceIterator) Tx() sdk.Tx { return it.txs[0] }
+
+func (m *sliceMempool) Insert(_ context.Context, tx sdk.Tx) error {
+	m.txs = append(m.txs, tx)
+	return nil
+}
+
+func (m *sliceMempool) Select(context.Context, [][]byte) sdkmempool.Iterator {
+	if len(m.txs) == 0 {
+		return nil
+	}
+	return &sliceIterator{txs: append([]sdk.Tx{}, m.txs...)}
+}
+
+func (m *sliceMempool) CountTx() int { return len(m.txs) }
+
+func (m *sliceMempool) Remove(tx sdk.Tx) error {
+	for i, memTx := range m.txs {
+		if memTx == tx {
+			m.txs = append(m.txs[:i], m.txs[i+1:]...)
+			return nil
+		}
+	}
+	return sdkmempool.ErrTxNotFound
+}
+
+// mockTxVerifier encodes the txs without running the ante handler. The txs in
+// the invalid set fail the proposal verification.
+type mockTxVerifier struct {
+	encCfg  sdktestutil.TestEncodingConfig
+	invalid map[sdk.Tx]bool
+}
+
+func (v mockTxVerifier) PrepareProposalVerifyTx(tx sdk.Tx) ([]byte, error) {
+	if v.invalid[tx] {
+		return nil, errors.New("invalid tx")
+	}
+	return v.TxEncode(tx)
+}
+
+func (v mockTxVerifier) ProcessProposalVerifyTx(txBz []byte) (sdk.Tx, error) {
+	return v.TxDecode(txBz)
+}
+
+func (v mockTxVerifier) TxDecode(txBz []byte) (sdk.Tx, error) {
+	return v.encCfg.TxConfig.TxDecoder()(txBz)
+}
+
+func (v mockTxVerifier) TxEncode(tx sdk.Tx) ([]byte, error) {
+	return v.encCfg.TxConfig.TxEncoder()(tx)
+}
+
+type mockEVMKeeper struct{}
+
+func (mockEVMKeeper) GetParams(sdk.Context) evmtypes.Params { return evmtypes.DefaultParams() }
+
+type mockFeeMarketKeeper struct {
+	cosmosBlockGasShare math.LegacyDec
+}
+
+func (k mockFeeMarketKeeper) GetParams(sdk.Context) feemarkettypes.Params {
+	params := feemarkettypes.DefaultParams()
+	params.CosmosBlockGasShare = k.cosmosBlockGasShare
+	return params
+}
+
+func newSignedEthTx(t *testing.T, encCfg sdktestutil.TestEncodingConfig, nonce uint64) sdk.Tx {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	tx := signEthTx(t, encCfg, key, nonce)
+	tx.GetMsgs()[0].(*evmtypes.MsgEthereumTx).From = crypto.PubkeyToAddress(key.PublicKey).Hex()
+	return tx
+}
+
+// signEthTx returns a Cosmos tx with a signed Ethereum tx, as built by the
+// JSON-RPC backend.
+func signEthTx(t *testing.T, encCfg sdktestutil.TestEncodingConfig, key *ecdsa.PrivateKey, nonce uint64) sdk.Tx {
+	chainID := evmtypes.GetEthChainConfig().ChainID
+	ethTx, err := ethtypes.SignNewTx(key, ethtypes.LatestSignerForChainID(chainID), &ethtypes.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     nonce,
+		Gas:       21000,
+		GasFeeCap: big.NewInt(10),
+		GasTipCap: big.NewInt(1),
+		To:        &common.Address{},
+	})
+	require.NoError(t, err)
+
+	msg := &evmtypes.MsgEthereumTx{}
+	require.NoError(t, msg.FromEthereumTx(ethTx))
+
+	builder := encCfg.TxConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(msg))
+	builder.SetGasLimit(ethTx.Gas())
+	return builder.GetTx()
+}
+
+// newMultisigEthTx returns a Cosmos tx with the unsigned Ethereum tx of a 2 of 3
+// multisig sender, signed by the given keys in the multisig extension option.
+// The from address is set as by the ante handler.
+func newMultisigEthTx(t *testing.T, encCfg sdktestutil.TestEncodingConfig, nonce uint64, signers ...int) sdk.Tx {
+	privKeys := make([]*ethsecp256k1.PrivKey, 3)
+	pubKeys := make([]cryptotypes.PubKey, 3)
+	for i := range privKeys {
+		privKey, err := ethsecp256k1.GenerateKey()
+		require.NoError(t, err)
+		privKeys[i] = privKey
+		pubKeys[i] = privKey.PubKey()
+	}
+	multisigKey := kmultisig.NewLegacyAminoPubKey(2, pubKeys)
+
+	chainID := evmtypes.GetEthChainConfig().ChainID
+	ethTx := ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     nonce,
+		Gas:       21000,
+		GasFeeCap: big.NewInt(10),
+		GasTipCap: big.NewInt(1),
+		To:        &common.Address{},
+	})
+
+	sender := common.BytesToAddress(multisigKey.Address())
+	ethTx, err := ethTx.WithSignature(ethtypes.LatestSignerForChainID(chainID), evmtypes.MultisigSignature(sender))
+	require.NoError(t, err)
+
+	msg := &evmtypes.MsgEthereumTx{}
+	require.NoError(t, msg.FromEthereumTx(ethTx))
+	msg.From = sender.Hex()
+
+	signBytes := evmtypes.MultisigSignBytes(ethtypes.LatestSignerForChainID(chainID), ethTx, "")
+	bitarray := cryptotypes.NewCompactBitArray(len(pubKeys))
+	sigs := make([][]byte, 0, len(signers))
+	for _, i := range signers {
+		sig, err := privKeys[i].Sign(signBytes)
+		require.NoError(t, err)
+		bitarray.SetIndex(i, true)
+		sigs = append(sigs, sig)
+	}
+
+	pubKeyAny, err := codectypes.NewAnyWithValue(multisigKey)
+	require.NoError(t, err)
+	multisigOption, err := codectypes.NewAnyWithValue(&evmtypes.ExtensionOptionMultisig{
+		PubKey:     pubKeyAny,
+		Bitarray:   bitarray,
+		Signatures: sigs,
+	})
+	require.NoError(t, err)
+	ethOption, err := codectypes.NewAnyWithValue(&evmtypes.ExtensionOptionsEthereumTx{})
+	require.NoError(t, err)
+
+	builder, ok := encCfg.TxConfig.NewTxBuilder().(authtx.ExtensionOptionsTxBuilder)
+	require.True(t, ok)
+	builder.SetExtensionOptions(ethOption, multisigOption)
+	require.NoError(t, builder.SetMsgs(msg))
+	builder.SetGasLimit(ethTx.Gas())
+	return builder.GetTx()
+}
+
+func newCosmosTx(t *testing.T, encCfg sdktestutil.TestEncodingConfig, gas uint64) sdk.Tx {
+	builder := encCfg.TxConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(&banktypes.MsgSend{}))
+	builder.SetGasLimit(gas)
+	return builder.GetTx()
+}
+
+func setupProposalHandler(t *testing.T) (sdk.Context, sdktestutil.TestEncodingConfig, *sliceMempool, mockTxVerifier, *ProposalHandler) {
+	configurator := evmtypes.NewEVMConfigurator()
+	configurator.ResetTestConfig()
+	require.NoError(t, configurator.Configure())
+
+	encCfg := encoding.MakeConfig()
+	evmtypes.RegisterInterfaces(encCfg.InterfaceRegistry)
+	banktypes.RegisterInterfaces(encCfg.InterfaceRegistry)
+
+	ctx := sdk.NewContext(nil, tmproto.Header{Height: 1}, false, log.NewNopLogger()).
+		WithConsensusParams(tmproto.ConsensusParams{Block: &tmproto.BlockParams{MaxGas: 100000}})
+
+	mp := &sliceMempool{}
+	verifier := mockTxVerifier{encCfg: encCfg, invalid: make(map[sdk.Tx]bool)}
+	// half of the block gas is reserved for the Cosmos txs
+	feeMarketKeeper := mockFeeMarketKeeper{cosmosBlockGasShare: math.LegacyNewDecWithPrec(5, 1)}
+
+	return ctx, encCfg, mp, verifier, NewProposalHandler(mp, nil, verifier, mockEVMKeeper{}, feeMarketKeeper)
+}
+
+func TestPrepareProposal(t *testing.T) {
+	ctx, encCfg, mp, verifier, handler := setupProposalHandler(t)
+
+	senderA := common.BigToAddress(big.NewInt(1))
+	senderB := common.BigToAddress(big.NewInt(2))
+	senderC := common.BigToAddress(big.NewInt(3))
+
+	invalidTx := newEthTx(t, senderA, 0, 10, 1)
+	verifier.invalid[invalidTx] = true
+	nextTx := newEthTx(t, senderA, 1, 10, 1)
+	txB := newEthTx(t, senderB, 0, 10, 1)
+	txC := newEthTx(t, senderC, 0, 10, 1)
+	txD := newEthTx(t, senderC, 1, 10, 1)
+	cosmosTx := newCosmosTx(t, encCfg, 40000)
+	for _, tx := range []sdk.Tx{invalidTx, nextTx, txB, txC, txD, cosmosTx} {
+		require.NoError(t, mp.Insert(ctx, tx))
+	}
+
+	res, err := handler.PrepareProposalHandler()(ctx, &abci.RequestPrepareProposal{MaxTxBytes: 1_000_000})
+	require.NoError(t, err)
+
+	var expTxs [][]byte
+	// the second tx of sender C exceeds the Ethereum share of the block gas
+	for _, tx := range []sdk.Tx{txB, txC, cosmosTx} {
+		txBz, err := verifier.TxEncode(tx)
+		require.NoError(t, err)
+		expTxs = append(expTxs, txBz)
+	}
+	require.Equal(t, expTxs, res.Txs)
+
+	// the invalid tx is removed, the next tx of the sender is kept
+	require.Equal(t, []sdk.Tx{nextTx, txB, txC, txD, cosmosTx}, mp.txs)
+
+	// the max tx bytes are respected
+	res, err = handler.PrepareProposalHandler()(ctx, &abci.RequestPrepareProposal{MaxTxBytes: int64(len(expTxs[0]))})
+	require.NoError(t, err)
+	require.Equal(t, expTxs[:1], res.Txs)
+}
+
+func TestProcessProposal(t *testing.T) {
+	ctx, encCfg, _, verifier, handler := setupProposalHandler(t)
+
+	encode := func(txs ...sdk.Tx) [][]byte {
+		var txsBz [][]byte
+		for _, tx := range txs {
+			txBz, err := verifier.TxEncode(tx)
+			require.NoError(t, err)
+			txsBz = append(txsBz, txBz)
+		}
+		return txsBz
+	}
+
+	unsignedTx := newEthTx(t, common.BigToAddress(big.NewInt(1)), 0, 10, 1)
+
+	testCases := []struct {
+		name      string
+		txs       [][]byte
+		expStatus abci.ResponseProcessProposal_ProposalStatus
+	}{
+		{
+			"accept - within the block gas",
+			encode(newSignedEthTx(t, encCfg, 0), newSignedEthTx(t, encCfg, 0), newCosmosTx(t, encCfg, 50000)),
+			abci.ResponseProcessProposal_ACCEPT,
+		},
+		{
+			"reject - Ethereum txs exceed their share of the block gas",
+			encode(newSignedEthTx(t, encCfg, 0), newSignedEthTx(t, encCfg, 0), newSignedEthTx(t, encCfg, 0)),
+			abci.ResponseProcessProposal_REJECT,
+		},
+		{
+			"reject - block gas exceeded",
+			encode(newSignedEthTx(t, encCfg, 0), newCosmosTx(t, encCfg, 80000)),
+			abci.ResponseProcessProposal_REJECT,
+		},
+		{
+			"reject - invalid Ethereum signature",
+			encode(unsignedTx),
+			abci.ResponseProcessProposal_REJECT,
+		},
+		{
+			"reject - invalid tx bytes",
+			[][]byte{{0x1}},
+			abci.ResponseProcessProposal_REJECT,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := handler.ProcessProposalHandler()(ctx, &abci.RequestProcessProposal{Txs: tc.txs})
+			require.NoError(t, err)
+			require.Equal(t, tc.expStatus, res.Status)
+		})
+	}
+}
+
+func TestProposalMultisigEthTx(t *testing.T) {
+	ctx, encCfg, mp, verifier, handler := setupProposalHandler(t)
+
+	multisigTx := newMultisigEthTx(t, encCfg, 0, 0, 2)
+	require.NoError(t, mp.Insert(ctx, multisigTx))
+
+	prepareRes, err := handler.PrepareProposalHandler()(ctx, &abci.RequestPrepareProposal{MaxTxBytes: 1_000_000})
+	require.NoError(t, err)
+
+	txBz, err := verifier.TxEncode(multisigTx)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{txBz}, prepareRes.Txs)
+
+	// the proposal with the multisig tx is accepted
+	processRes, err := handler.ProcessProposalHandler()(ctx, &abci.RequestProcessProposal{Txs: prepareRes.Txs})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, processRes.Status)
+
+	// a multisig tx below the threshold is rejected
+	invalidBz, err := verifier.TxEncode(newMultisigEthTx(t, encCfg, 0, 1))
+	require.NoError(t, err)
+	processRes, err = handler.ProcessProposalHandler()(ctx, &abci.RequestProcessProposal{Txs: [][]byte{invalidBz}})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, processRes.Status)
+
+	// a multisig tx with a from address other than the multisig sender is rejected
+	spoofedTx := newMultisigEthTx(t, encCfg, 0, 0, 1)
+	spoofedMsg := spoofedTx.GetMsgs()[0].(*evmtypes.MsgEthereumTx)
+	spoofedMsg.From = common.BigToAddress(big.NewInt(1)).Hex()
+	builder, err := encCfg.TxConfig.WrapTxBuilder(spoofedTx)
+	require.NoError(t, err)
+	require.NoError(t, builder.SetMsgs(spoofedMsg))
+	spoofedBz, err := verifier.TxEncode(builder.GetTx())
+	require.NoError(t, err)
+	processRes, err = handler.ProcessProposalHandler()(ctx, &abci.RequestProcessProposal{Txs: [][]byte{spoofedBz}})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, processRes.Status)
+}
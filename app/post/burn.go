@@ -18,7 +18,11 @@ import (
 
 var _ sdk.PostDecorator = &BurnDecorator{}
 
-// BurnDecorator is the decorator that burns all the transaction fees from Cosmos transactions.
+// BurnDecorator is the decorator that burns the transaction fees from Cosmos
+// transactions paid in the EVM denom. The fees paid in other denoms, e.g. the
+// IBC vouchers of the fee market fee denoms, are left in the fee collector, as
+// burning them would destroy coins backed by the escrow of the counterparty
+// chain.
 type BurnDecorator struct {
 	feeCollectorName string
 	bankKeeper       bankkeeper.Keeper
@@ -32,8 +36,8 @@ func NewBurnDecorator(feeCollector string, bankKeeper bankkeeper.Keeper) sdk.Pos
 	}
 }
 
-// PostHandle burns all the transaction fees from Cosmos transactions. If an Ethereum transaction is present, this logic
-// is skipped.
+// PostHandle burns the EVM denom transaction fees from Cosmos transactions. If an Ethereum transaction is present, this
+// logic is skipped.
 func (bd BurnDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (newCtx sdk.Context, err error) {
 	feeTx, ok := tx.(sdk.FeeTx)
 	if !ok {
@@ -55,9 +59,13 @@ func (bd BurnDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success
 		return next(ctx, tx, simulate, success)
 	}
 
-	// burn min(balance, fee)
+	// burn min(balance, fee) of the EVM denom
 	var burnedCoins sdk.Coins
 	for _, fee := range fees {
+		if fee.Denom != evmtypes.GetEVMCoinDenom() {
+			continue
+		}
+
 		balance := bd.bankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(bd.feeCollectorName), fee.Denom)
 		if !balance.IsPositive() {
 			continue
@@ -68,6 +76,10 @@ func (bd BurnDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success
 		burnedCoins = append(burnedCoins, sdk.Coin{Denom: fee.Denom, Amount: amount})
 	}
 
+	if burnedCoins.IsZero() {
+		return next(ctx, tx, simulate, success)
+	}
+
 	// NOTE: since all Cosmos tx fees are pooled by the fee collector module account,
 	// we burn them directly from it
 	if err := bd.bankKeeper.BurnCoins(ctx, bd.feeCollectorName, burnedCoins); err != nil {
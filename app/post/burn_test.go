@@ -31,7 +31,7 @@ func (s *PostTestSuite) TestPostHandle() {
 		{
 			name: "pass - burn fees of a single token with empty end balance",
 			tx: func() sdk.Tx {
-				feeAmount := sdk.Coins{sdk.Coin{Amount: sdkmath.NewInt(10), Denom: "btc"}}
+				feeAmount := sdk.Coins{sdk.Coin{Amount: sdkmath.NewInt(10), Denom: "aaizel"}}
 				amount := feeAmount
 				s.MintCoinsForFeeCollector(amount)
 
@@ -44,28 +44,46 @@ func (s *PostTestSuite) TestPostHandle() {
 				s.Require().Equal(expected, balance)
 			},
 		},
+		{
+			name: "pass - keep the fees paid in a fee denom",
+			tx: func() sdk.Tx {
+				feeDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+				feeAmount := sdk.Coins{sdk.Coin{Amount: sdkmath.NewInt(10), Denom: feeDenom}}
+				amount := feeAmount
+				s.MintCoinsForFeeCollector(amount)
+
+				return s.BuildCosmosTxWithNSendMsg(1, feeAmount)
+			},
+			expPass: true,
+			postChecks: func() {
+				feeDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+				expected := sdk.Coins{sdk.Coin{Amount: sdkmath.NewInt(10), Denom: feeDenom}}
+				balance := s.GetFeeCollectorBalance()
+				s.Require().Equal(expected, balance)
+			},
+		},
 		{
 			name: "pass - burn fees of a single token with non-empty end balance",
 			tx: func() sdk.Tx {
-				feeAmount := sdk.Coins{sdk.Coin{Amount: sdkmath.NewInt(10), Denom: "aizel"}}
-				amount := sdk.Coins{sdk.Coin{Amount: sdkmath.NewInt(20), Denom: "aizel"}}
+				feeAmount := sdk.Coins{sdk.Coin{Amount: sdkmath.NewInt(10), Denom: "aaizel"}}
+				amount := sdk.Coins{sdk.Coin{Amount: sdkmath.NewInt(20), Denom: "aaizel"}}
 				s.MintCoinsForFeeCollector(amount)
 
 				return s.BuildCosmosTxWithNSendMsg(1, feeAmount)
 			},
 			expPass: true,
 			postChecks: func() {
-				expected := sdk.Coins{sdk.Coin{Amount: sdkmath.NewInt(10), Denom: "aizel"}}
+				expected := sdk.Coins{sdk.Coin{Amount: sdkmath.NewInt(10), Denom: "aaizel"}}
 				balance := s.GetFeeCollectorBalance()
 				s.Require().Equal(expected, balance)
 			},
 		},
 		{
-			name: "pass - burn fees of multiple tokens with empty end balance",
+			name: "pass - burn the EVM denom fees of multiple tokens",
 			tx: func() sdk.Tx {
 				feeAmount := sdk.Coins{
 					sdk.Coin{Amount: sdkmath.NewInt(10), Denom: "eth"},
-					sdk.Coin{Amount: sdkmath.NewInt(10), Denom: "aizel"},
+					sdk.Coin{Amount: sdkmath.NewInt(10), Denom: "aaizel"},
 				}
 				amount := feeAmount
 				s.MintCoinsForFeeCollector(amount)
@@ -74,8 +92,9 @@ func (s *PostTestSuite) TestPostHandle() {
 			},
 			expPass: true,
 			postChecks: func() {
+				expected := sdk.Coins{sdk.Coin{Amount: sdkmath.NewInt(10), Denom: "eth"}}
 				balance := s.GetFeeCollectorBalance()
-				s.Require().Equal(sdk.Coins{}, balance)
+				s.Require().Equal(expected, balance)
 			},
 		},
 		{ //nolint:dupl
@@ -83,11 +102,11 @@ func (s *PostTestSuite) TestPostHandle() {
 			tx: func() sdk.Tx {
 				feeAmount := sdk.Coins{
 					sdk.Coin{Amount: sdkmath.NewInt(10), Denom: "btc"},
-					sdk.Coin{Amount: sdkmath.NewInt(10), Denom: "aizel"},
+					sdk.Coin{Amount: sdkmath.NewInt(10), Denom: "aaizel"},
 				}
 				amount := sdk.Coins{
 					sdk.Coin{Amount: sdkmath.NewInt(20), Denom: "btc"},
-					sdk.Coin{Amount: sdkmath.NewInt(10), Denom: "aizel"},
+					sdk.Coin{Amount: sdkmath.NewInt(10), Denom: "aaizel"},
 					sdk.Coin{Amount: sdkmath.NewInt(3), Denom: "osmo"},
 				}
 				s.MintCoinsForFeeCollector(amount)
@@ -97,7 +116,7 @@ func (s *PostTestSuite) TestPostHandle() {
 			expPass: true,
 			postChecks: func() {
 				expected := sdk.Coins{
-					sdk.Coin{Amount: sdkmath.NewInt(10), Denom: "btc"},
+					sdk.Coin{Amount: sdkmath.NewInt(20), Denom: "btc"},
 					sdk.Coin{Amount: sdkmath.NewInt(3), Denom: "osmo"},
 				}
 				balance := s.GetFeeCollectorBalance()
@@ -109,11 +128,11 @@ func (s *PostTestSuite) TestPostHandle() {
 			tx: func() sdk.Tx {
 				feeAmount := sdk.Coins{
 					sdk.Coin{Amount: sdkmath.NewInt(10), Denom: "btc"},
-					sdk.Coin{Amount: sdkmath.NewInt(10), Denom: "aizel"},
+					sdk.Coin{Amount: sdkmath.NewInt(10), Denom: "aaizel"},
 				}
 				amount := sdk.Coins{
 					sdk.Coin{Amount: sdkmath.NewInt(20), Denom: "btc"},
-					sdk.Coin{Amount: sdkmath.NewInt(10), Denom: "aizel"},
+					sdk.Coin{Amount: sdkmath.NewInt(10), Denom: "aaizel"},
 					sdk.Coin{Amount: sdkmath.NewInt(3), Denom: "osmo"},
 				}
 				s.MintCoinsForFeeCollector(amount)
@@ -123,7 +142,7 @@ func (s *PostTestSuite) TestPostHandle() {
 			expPass: true,
 			postChecks: func() {
 				expected := sdk.Coins{
-					sdk.Coin{Amount: sdkmath.NewInt(10), Denom: "btc"},
+					sdk.Coin{Amount: sdkmath.NewInt(20), Denom: "btc"},
 					sdk.Coin{Amount: sdkmath.NewInt(3), Denom: "osmo"},
 				}
 				balance := s.GetFeeCollectorBalance()
@@ -135,8 +154,8 @@ func (s *PostTestSuite) TestPostHandle() {
 			tx: func() sdk.Tx {
 				amt, ok := sdkmath.NewIntFromString("10000000000000000000000000000000000")
 				s.Require().True(ok)
-				feeAmount := sdk.Coins{sdk.Coin{Amount: amt, Denom: "aizel"}}
-				amount := sdk.Coins{sdk.Coin{Amount: amt, Denom: "aizel"}}
+				feeAmount := sdk.Coins{sdk.Coin{Amount: amt, Denom: "aaizel"}}
+				amount := sdk.Coins{sdk.Coin{Amount: amt, Denom: "aaizel"}}
 				s.MintCoinsForFeeCollector(amount)
 
 				return s.BuildCosmosTxWithNSendMsg(1, feeAmount)
@@ -0,0 +1,24 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package post
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// EVMKeeper defines the expected keeper interface used on the post handler to
+// retrieve the gas used by the Ethereum txs
+type EVMKeeper interface {
+	GetBaseFee(ctx sdk.Context) *big.Int
+	GetTxGasUsedTransient(ctx sdk.Context, txHash common.Hash) (uint64, bool)
+}
+
+// RevenueKeeper defines the expected keeper interface used on the post handler
+// to distribute the Ethereum tx fees
+type RevenueKeeper interface {
+	DistributeFees(ctx sdk.Context, txHash string, contract *common.Address, fees sdk.Coins) (burned, developer sdk.Coins, err error)
+}
@@ -14,6 +14,8 @@ import (
 type HandlerOptions struct {
 	FeeCollectorName string
 	BankKeeper       bankkeeper.Keeper
+	EvmKeeper        EVMKeeper
+	RevenueKeeper    RevenueKeeper
 }
 
 func (h HandlerOptions) Validate() error {
@@ -25,6 +27,14 @@ func (h HandlerOptions) Validate() error {
 		return errors.New("bank keeper cannot be nil")
 	}
 
+	if h.EvmKeeper == nil {
+		return errors.New("evm keeper cannot be nil")
+	}
+
+	if h.RevenueKeeper == nil {
+		return errors.New("revenue keeper cannot be nil")
+	}
+
 	return nil
 }
 
@@ -32,6 +42,7 @@ func (h HandlerOptions) Validate() error {
 func NewPostHandler(ho HandlerOptions) sdk.PostHandler {
 	postDecorators := []sdk.PostDecorator{
 		NewBurnDecorator(ho.FeeCollectorName, ho.BankKeeper),
+		NewRevenueDecorator(ho.EvmKeeper, ho.RevenueKeeper),
 	}
 
 	return sdk.ChainPostDecorators(postDecorators...)
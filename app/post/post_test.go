@@ -12,33 +12,61 @@ import (
 func (s *PostTestSuite) TestPostHandlerOptions() {
 	validBankKeeper := s.unitNetwork.App.BankKeeper
 	validFeeCollector := authtypes.FeeCollectorName
+	validEvmKeeper := s.unitNetwork.App.EvmKeeper
+	validRevenueKeeper := s.unitNetwork.App.RevenueKeeper
 
 	testCases := []struct {
-		name         string
-		feeCollector string
-		bankKeeper   bankkeeper.Keeper
-		expPass      bool
-		errContains  string
+		name          string
+		feeCollector  string
+		bankKeeper    bankkeeper.Keeper
+		evmKeeper     post.EVMKeeper
+		revenueKeeper post.RevenueKeeper
+		expPass       bool
+		errContains   string
 	}{
 		{
-			name:         "fail - empty fee collector name",
-			feeCollector: "",
-			bankKeeper:   validBankKeeper,
-			expPass:      false,
-			errContains:  "fee collector name cannot be empty",
+			name:          "fail - empty fee collector name",
+			feeCollector:  "",
+			bankKeeper:    validBankKeeper,
+			evmKeeper:     validEvmKeeper,
+			revenueKeeper: validRevenueKeeper,
+			expPass:       false,
+			errContains:   "fee collector name cannot be empty",
 		},
 		{
-			name:         "fail - nil bank keeper",
-			feeCollector: validFeeCollector,
-			bankKeeper:   nil,
-			expPass:      false,
-			errContains:  "bank keeper cannot be nil",
+			name:          "fail - nil bank keeper",
+			feeCollector:  validFeeCollector,
+			bankKeeper:    nil,
+			evmKeeper:     validEvmKeeper,
+			revenueKeeper: validRevenueKeeper,
+			expPass:       false,
+			errContains:   "bank keeper cannot be nil",
 		},
 		{
-			name:         "pass - correct inputs",
-			feeCollector: validFeeCollector,
-			bankKeeper:   validBankKeeper,
-			expPass:      true,
+			name:          "fail - nil evm keeper",
+			feeCollector:  validFeeCollector,
+			bankKeeper:    validBankKeeper,
+			evmKeeper:     nil,
+			revenueKeeper: validRevenueKeeper,
+			expPass:       false,
+			errContains:   "evm keeper cannot be nil",
+		},
+		{
+			name:          "fail - nil revenue keeper",
+			feeCollector:  validFeeCollector,
+			bankKeeper:    validBankKeeper,
+			evmKeeper:     validEvmKeeper,
+			revenueKeeper: nil,
+			expPass:       false,
+			errContains:   "revenue keeper cannot be nil",
+		},
+		{
+			name:          "pass - correct inputs",
+			feeCollector:  validFeeCollector,
+			bankKeeper:    validBankKeeper,
+			evmKeeper:     validEvmKeeper,
+			revenueKeeper: validRevenueKeeper,
+			expPass:       true,
 		},
 	}
 
@@ -54,6 +82,8 @@ func (s *PostTestSuite) TestPostHandlerOptions() {
 			handlerOptions := post.HandlerOptions{
 				FeeCollectorName: tc.feeCollector,
 				BankKeeper:       tc.bankKeeper,
+				EvmKeeper:        tc.evmKeeper,
+				RevenueKeeper:    tc.revenueKeeper,
 			}
 
 			err = handlerOptions.Validate()
@@ -0,0 +1,110 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package post
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/hashicorp/go-metrics"
+
+	evmtypes "github.com/AizelNetwork/CosmEvm/x/evm/types"
+)
+
+var _ sdk.PostDecorator = &RevenueDecorator{}
+
+// RevenueDecorator is the decorator that distributes the fees of the Ethereum
+// transactions between burn, validators and the developers of the called
+// contracts.
+type RevenueDecorator struct {
+	evmKeeper     EVMKeeper
+	revenueKeeper RevenueKeeper
+}
+
+// NewRevenueDecorator creates a new instance of the RevenueDecorator.
+func NewRevenueDecorator(evmKeeper EVMKeeper, revenueKeeper RevenueKeeper) sdk.PostDecorator {
+	return &RevenueDecorator{
+		evmKeeper:     evmKeeper,
+		revenueKeeper: revenueKeeper,
+	}
+}
+
+// PostHandle distributes the fees paid for the gas used by each Ethereum
+// transaction. Transactions that have not been executed, e.g. on CheckTx, are
+// skipped.
+func (rd RevenueDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (newCtx sdk.Context, err error) {
+	var baseFee *big.Int
+	for _, msg := range tx.GetMsgs() {
+		ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			continue
+		}
+
+		gasUsed, found := rd.evmKeeper.GetTxGasUsedTransient(ctx, common.HexToHash(ethMsg.Hash))
+		if !found || gasUsed == 0 {
+			continue
+		}
+
+		txData, err := evmtypes.UnpackTxData(ethMsg.Data)
+		if err != nil {
+			return ctx, err
+		}
+
+		if baseFee == nil {
+			baseFee = rd.evmKeeper.GetBaseFee(ctx)
+		}
+
+		// the fees kept by the fee collector are the gas used at the price the
+		// leftover gas was refunded
+		gasPrice := txData.GetGasPrice()
+		if baseFee != nil {
+			gasPrice = txData.EffectiveGasPrice(baseFee)
+		}
+
+		fees := ethereumTxFees(ctx, new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(gasUsed)))
+		if !fees.IsAllPositive() {
+			continue
+		}
+
+		burned, _, err := rd.revenueKeeper.DistributeFees(ctx, ethMsg.Hash, txData.GetTo(), fees)
+		if err != nil {
+			return ctx, err
+		}
+
+		if ctx.IsCheckTx() || ctx.IsReCheckTx() {
+			continue
+		}
+
+		for _, c := range burned {
+			// if fee amount is higher than uint64, skip the counter
+			if !c.Amount.IsUint64() {
+				continue
+			}
+			telemetry.IncrCounterWithLabels(
+				[]string{"burned", "tx", "fee", "amount"},
+				float32(c.Amount.Uint64()),
+				[]metrics.Label{
+					telemetry.NewLabel("denom", c.Denom),
+				},
+			)
+		}
+	}
+
+	return next(ctx, tx, simulate, success)
+}
+
+// ethereumTxFees returns the given fee amount with 18 decimals as coins in the
+// denom the fees were paid with.
+func ethereumTxFees(ctx sdk.Context, amount *big.Int) sdk.Coins {
+	if price, ok := evmtypes.FeeDenomFromContext(ctx); ok {
+		feeAmt := price.FromEVMDenom(evmtypes.ConvertBigIntFrom18DecimalsToLegacyDec(amount)).TruncateInt()
+		return sdk.Coins{sdk.NewCoin(price.Denom, feeAmt)}
+	}
+
+	feeAmt := sdkmath.NewIntFromBigInt(evmtypes.ConvertAmountFrom18DecimalsBigInt(amount))
+	return sdk.Coins{sdk.NewCoin(evmtypes.GetEVMCoinDenom(), feeAmt)}
+}
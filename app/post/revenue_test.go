@@ -0,0 +1,102 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package post_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/AizelNetwork/CosmEvm/app/post"
+	evmtypes "github.com/AizelNetwork/CosmEvm/x/evm/types"
+	revenuetypes "github.com/AizelNetwork/CosmEvm/x/revenue/types"
+)
+
+func (s *PostTestSuite) TestRevenuePostHandle() {
+	var gasUsed uint64 = 21_000
+	fees := sdk.Coins{sdk.NewCoin(evmtypes.GetEVMCoinDenom(), sdkmath.NewIntFromUint64(gasUsed))}
+	developer := s.keyring.GetAccAddr(0)
+
+	testCases := []struct {
+		name       string
+		malleate   func(tx sdk.Tx)
+		postChecks func()
+	}{
+		{
+			name:     "pass - noop with tx not executed",
+			malleate: func(sdk.Tx) {},
+			postChecks: func() {
+				s.Require().Equal(fees, s.GetFeeCollectorBalance())
+			},
+		},
+		{
+			name: "pass - fees kept by the validators when the contract is not registered",
+			malleate: func(tx sdk.Tx) {
+				s.setTxGasUsed(tx, gasUsed)
+			},
+			postChecks: func() {
+				s.Require().Equal(fees, s.GetFeeCollectorBalance())
+			},
+		},
+		{
+			name: "pass - developer shares sent to the withdrawer of the contract",
+			malleate: func(tx sdk.Tx) {
+				s.setTxGasUsed(tx, gasUsed)
+				s.unitNetwork.App.RevenueKeeper.SetRevenue(
+					s.unitNetwork.GetContext(),
+					revenuetypes.NewRevenue(s.to, developer, nil),
+				)
+			},
+			postChecks: func() {
+				// the default params split the fees in halves between
+				// validators and developers
+				half := sdk.Coins{sdk.NewCoin(evmtypes.GetEVMCoinDenom(), sdkmath.NewIntFromUint64(gasUsed/2))}
+				s.Require().Equal(half, s.GetFeeCollectorBalance())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.SetupTest()
+		s.Run(tc.name, func() {
+			err := s.unitNetwork.NextBlock()
+			s.Require().NoError(err)
+
+			s.MintCoinsForFeeCollector(fees)
+			tx := s.BuildEthTx()
+			tc.malleate(tx)
+
+			revenueDecorator := post.NewRevenueDecorator(
+				s.unitNetwork.App.EvmKeeper,
+				s.unitNetwork.App.RevenueKeeper,
+			)
+
+			terminator := sdk.ChainPostDecorators(sdk.Terminator{}) //nolint:staticcheck
+			_, err = revenueDecorator.PostHandle(
+				s.unitNetwork.GetContext(),
+				tx,
+				false,
+				true,
+				terminator,
+			)
+			s.Require().NoError(err)
+
+			tc.postChecks()
+		})
+	}
+}
+
+// setTxGasUsed records the gas used by the Ethereum messages of the tx as it
+// would be done on their execution.
+func (s *PostTestSuite) setTxGasUsed(tx sdk.Tx, gasUsed uint64) {
+	for _, msg := range tx.GetMsgs() {
+		ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+		s.Require().True(ok)
+		s.unitNetwork.App.EvmKeeper.SetTxGasUsedTransient(
+			s.unitNetwork.GetContext(),
+			common.HexToHash(ethMsg.Hash),
+			gasUsed,
+		)
+	}
+}
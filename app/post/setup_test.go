@@ -116,6 +116,7 @@ func (s *PostTestSuite) BuildCosmosTxWithNSendMsg(n int, feeAmount sdk.Coins) sd
 // MintCoinsForFeeCollector allows to mint a specific amount of coins from the bank
 // and to transfer them to the FeeCollector.
 func (s *PostTestSuite) MintCoinsForFeeCollector(amount sdk.Coins) {
+	amount = amount.Sort()
 	// Minting tokens for the FeeCollector to simulate fee accrued.
 	err := s.unitNetwork.App.BankKeeper.MintCoins(
 		s.unitNetwork.GetContext(),
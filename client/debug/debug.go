@@ -22,6 +22,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 )
 
 var flagPrefix = "prefix"
@@ -156,11 +158,12 @@ func RawBytesCmd() *cobra.Command {
 	}
 }
 
-// LegacyEIP712Cmd outputs types of legacy EIP712 typed data
+// LegacyEIP712Cmd outputs types of the EIP712 typed data of a legacy Amino
+// JSON signed transaction, derived from the proto schema of its messages
 func LegacyEIP712Cmd() *cobra.Command {
 	return &cobra.Command{
 		Use:     "legacy-eip712 [file]",
-		Short:   "Output types of legacy eip712 typed data according to the given transaction",
+		Short:   "Output types of the eip712 typed data of the given legacy Amino JSON signed transaction",
 		Example: fmt.Sprintf(`$ %s debug legacy-eip712 tx.json --chain-id aizeld_9000-1`, version.AppName),
 		Args:    cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -174,9 +177,9 @@ func LegacyEIP712Cmd() *cobra.Command {
 				return errors.Wrap(err, "read tx from file")
 			}
 
-			txBytes, err := clientCtx.TxConfig.TxJSONEncoder()(stdTx)
-			if err != nil {
-				return errors.Wrap(err, "encode tx")
+			tx, ok := stdTx.(authsigning.Tx)
+			if !ok {
+				return errors.New("invalid transaction type")
 			}
 
 			chainID, err := aizel.ParseChainID(clientCtx.ChainID)
@@ -184,7 +187,18 @@ func LegacyEIP712Cmd() *cobra.Command {
 				return errors.Wrap(err, "invalid chain ID passed as argument")
 			}
 
-			td, err := eip712.LegacyWrapTxToTypedData(clientCtx.Codec, chainID.Uint64(), stdTx.GetMsgs()[0], txBytes, nil)
+			// the types do not depend on the account number and sequence
+			txBytes := legacytx.StdSignBytes(
+				clientCtx.ChainID,
+				0,
+				0,
+				tx.GetTimeoutHeight(),
+				legacytx.StdFee{Amount: tx.GetFee(), Gas: tx.GetGas()},
+				tx.GetMsgs(),
+				tx.GetMemo(),
+			)
+
+			td, err := eip712.SchemaWrapTxToTypedData(clientCtx.InterfaceRegistry, chainID.Uint64(), tx.GetMsgs(), txBytes, nil)
 			if err != nil {
 				return errors.Wrap(err, "wrap tx to typed data")
 			}
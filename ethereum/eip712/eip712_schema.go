@@ -0,0 +1,369 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+package eip712
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	aminov1 "cosmossdk.io/api/amino"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+const (
+	anyFullName   = "google.protobuf.Any"
+	anyTypePrefix = "Any"
+
+	anyTypeField  = "type"
+	anyValueField = "value"
+)
+
+// SchemaWrapTxToTypedData wraps an Amino-encoded Cosmos Tx JSON SignDoc
+// bytestream into an EIP712-compatible TypedData request, whose types are
+// derived from the proto descriptors of the messages resolved with the given
+// resolver (e.g. the app interface registry).
+//
+// All the messages are wrapped in a single Tx envelope, as msg{i} fields typed
+// with their own Any type. Repeated Any fields, which can hold messages of
+// different types (e.g. authz MsgExec or gov MsgSubmitProposal), are
+// represented the same way, since EIP-712 arrays must be homogeneous.
+func SchemaWrapTxToTypedData(
+	resolver protodesc.Resolver,
+	chainID uint64,
+	msgs []sdk.Msg,
+	data []byte,
+	feeDelegation *FeeDelegationOptions,
+) (typedData apitypes.TypedData, err error) {
+	defer doRecover(&err)
+
+	txData := make(map[string]interface{})
+	if err := json.Unmarshal(data, &txData); err != nil {
+		return apitypes.TypedData{}, errorsmod.Wrap(errortypes.ErrJSONUnmarshal, "failed to JSON unmarshal data")
+	}
+
+	jsonMsgs, ok := txData[payloadMsgsField].([]interface{})
+	if !ok || len(jsonMsgs) != len(msgs) {
+		return apitypes.TypedData{}, errorsmod.Wrap(errortypes.ErrInvalidType, "tx data messages do not match the tx messages")
+	}
+
+	encoder := schemaEncoder{
+		resolver: resolver,
+		types:    schemaRootTypes(),
+	}
+
+	for i, msg := range msgs {
+		m, err := encoder.dynamicMessage(gogoproto.MessageName(msg), msg)
+		if err != nil {
+			return apitypes.TypedData{}, err
+		}
+
+		field := msgFieldForIndex(i)
+		msgType, value, err := encoder.anyTypeForMessage(m, jsonMsgs[i])
+		if err != nil {
+			return apitypes.TypedData{}, errorsmod.Wrapf(err, "failed to derive EIP-712 types of %s", field)
+		}
+
+		txData[field] = value
+		addMsgTypeDefToTxSchema(encoder.types, field, msgType)
+	}
+	delete(txData, payloadMsgsField)
+
+	if feeDelegation != nil {
+		feeInfo, ok := txData["fee"].(map[string]interface{})
+		if !ok {
+			return apitypes.TypedData{}, errorsmod.Wrap(errortypes.ErrInvalidType, "cannot parse fee from tx data")
+		}
+
+		feeInfo["feePayer"] = feeDelegation.FeePayer.String()
+		encoder.types["Fee"] = []apitypes.Type{
+			{Name: "feePayer", Type: "string"},
+			{Name: "amount", Type: "Coin[]"},
+			{Name: "gas", Type: "string"},
+		}
+	}
+
+	return apitypes.TypedData{
+		Types:       encoder.types,
+		PrimaryType: txField,
+		Domain:      createEIP712Domain(chainID),
+		Message:     txData,
+	}, nil
+}
+
+// schemaRootTypes returns the EIP-712 types of the domain and of the Tx
+// envelope, without its messages.
+func schemaRootTypes() apitypes.Types {
+	return apitypes.Types{
+		"EIP712Domain": {
+			{Name: "name", Type: "string"},
+			{Name: "version", Type: "string"},
+			{Name: "chainId", Type: "uint256"},
+			{Name: "verifyingContract", Type: "string"},
+			{Name: "salt", Type: "string"},
+		},
+		txField: {
+			{Name: "account_number", Type: "string"},
+			{Name: "chain_id", Type: "string"},
+			{Name: "fee", Type: "Fee"},
+			{Name: "memo", Type: "string"},
+			{Name: "sequence", Type: "string"},
+		},
+		"Fee": {
+			{Name: "amount", Type: "Coin[]"},
+			{Name: "gas", Type: "string"},
+		},
+		"Coin": {
+			{Name: "denom", Type: "string"},
+			{Name: "amount", Type: "string"},
+		},
+	}
+}
+
+// schemaEncoder derives the EIP-712 types of Amino JSON values from the proto
+// descriptors of their messages.
+type schemaEncoder struct {
+	resolver protodesc.Resolver
+	types    apitypes.Types
+}
+
+// dynamicMessage decodes the given message into a dynamic message of the
+// descriptor with the given full name.
+func (e schemaEncoder) dynamicMessage(name string, msg gogoproto.Message) (protoreflect.Message, error) {
+	bz, err := gogoproto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	return e.unmarshalDynamicMessage(name, bz)
+}
+
+// unmarshalDynamicMessage decodes the given bytes into a dynamic message of
+// the descriptor with the given full name.
+func (e schemaEncoder) unmarshalDynamicMessage(name string, bz []byte) (protoreflect.Message, error) {
+	desc, err := e.resolver.FindDescriptorByName(protoreflect.FullName(name))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to find descriptor of %s", name)
+	}
+
+	md, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidType, "%s is not a message", name)
+	}
+
+	m := dynamicpb.NewMessage(md)
+	if err := proto.Unmarshal(bz, m); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to unmarshal %s", name)
+	}
+
+	return m, nil
+}
+
+// anyTypeForMessage adds the types of the Amino JSON {type, value} envelope of
+// the given message and returns the envelope type.
+func (e schemaEncoder) anyTypeForMessage(m protoreflect.Message, jsonValue interface{}) (string, interface{}, error) {
+	envelope, ok := jsonValue.(map[string]interface{})
+	if !ok || len(envelope) != 2 {
+		return "", nil, errorsmod.Wrapf(errortypes.ErrInvalidType, "expected Amino JSON envelope of %s", m.Descriptor().FullName())
+	}
+
+	if _, ok := envelope[anyTypeField].(string); !ok {
+		return "", nil, errorsmod.Wrapf(errortypes.ErrInvalidType, "expected Amino JSON type of %s", m.Descriptor().FullName())
+	}
+
+	valueType, value, err := e.messageType(m, envelope[anyValueField])
+	if err != nil {
+		return "", nil, err
+	}
+	envelope[anyValueField] = value
+
+	typeDef := sanitizeTypedef(fmt.Sprintf("%s.%s", anyTypePrefix, m.Descriptor().FullName()))
+	envelopeType, err := addTypesToRoot(e.types, typeDef, []apitypes.Type{
+		{Name: anyTypeField, Type: ethString},
+		{Name: anyValueField, Type: valueType},
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	return envelopeType, envelope, nil
+}
+
+// anyType resolves the message packed in the given Any and adds the types of
+// its Amino JSON envelope.
+func (e schemaEncoder) anyType(anyMsg protoreflect.Message, jsonValue interface{}) (string, interface{}, error) {
+	fields := anyMsg.Descriptor().Fields()
+	typeURL := anyMsg.Get(fields.ByName("type_url")).String()
+	name := typeURL[strings.LastIndex(typeURL, "/")+1:]
+
+	m, err := e.unmarshalDynamicMessage(name, anyMsg.Get(fields.ByName("value")).Bytes())
+	if err != nil {
+		return "", nil, err
+	}
+
+	return e.anyTypeForMessage(m, jsonValue)
+}
+
+// messageType adds the types of the given message, restricted to the fields
+// present in its Amino JSON value, and returns the message type. Messages with
+// a custom Amino JSON encoding as a primitive (e.g. timestamps) are typed
+// after their JSON value.
+func (e schemaEncoder) messageType(m protoreflect.Message, jsonValue interface{}) (string, interface{}, error) {
+	obj, ok := jsonValue.(map[string]interface{})
+	if !ok {
+		ethType, err := ethTypeForJSONValue(jsonValue)
+		return ethType, jsonValue, err
+	}
+
+	md := m.Descriptor()
+	fields := md.Fields()
+	types := make([]apitypes.Type, 0, len(obj))
+
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		name := aminoFieldName(fd)
+
+		fieldJSON, found := obj[name]
+		if !found {
+			continue
+		}
+
+		fieldType, value, err := e.fieldType(m, fd, fieldJSON)
+		if err != nil {
+			return "", nil, errorsmod.Wrapf(err, "field %s of %s", name, md.FullName())
+		}
+
+		obj[name] = value
+		types = appendedTypesList(types, name, fieldType)
+	}
+
+	if len(types) != len(obj) {
+		return "", nil, errorsmod.Wrapf(errortypes.ErrInvalidType, "Amino JSON of %s has fields missing from its schema", md.FullName())
+	}
+
+	typeDef, err := addTypesToRoot(e.types, sanitizeTypedef(string(md.FullName())), types)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return typeDef, obj, nil
+}
+
+// fieldType adds the types of the given field of the message and returns the
+// field type.
+func (e schemaEncoder) fieldType(m protoreflect.Message, fd protoreflect.FieldDescriptor, jsonValue interface{}) (string, interface{}, error) {
+	if fd.IsMap() {
+		return "", nil, errorsmod.Wrap(errortypes.ErrNotSupported, "map fields are not supported")
+	}
+
+	if !fd.IsList() {
+		return e.singularFieldType(fd, m.Get(fd), jsonValue)
+	}
+
+	list := m.Get(fd).List()
+	array, ok := jsonValue.([]interface{})
+	if !ok || len(array) != list.Len() {
+		return "", nil, errorsmod.Wrap(errortypes.ErrInvalidType, "Amino JSON value does not match the repeated field")
+	}
+
+	// Since EIP-712 arrays must be homogeneous, the elements of a repeated Any
+	// are flattened as msg{i} fields of an object.
+	if fd.Message() != nil && fd.Message().FullName() == anyFullName {
+		types := make([]apitypes.Type, len(array))
+		obj := make(map[string]interface{}, len(array))
+		for i, elem := range array {
+			elemType, value, err := e.anyType(list.Get(i).Message(), elem)
+			if err != nil {
+				return "", nil, err
+			}
+
+			field := msgFieldForIndex(i)
+			obj[field] = value
+			types[i] = apitypes.Type{Name: field, Type: elemType}
+		}
+
+		typeDef, err := addTypesToRoot(e.types, sanitizeTypedef(string(fd.FullName())), types)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return typeDef, obj, nil
+	}
+
+	if len(array) == 0 {
+		// Arbitrarily use the string[] type for empty arrays, consistent with
+		// the flattened payload types.
+		return "string[]", array, nil
+	}
+
+	var listType string
+	for i, elem := range array {
+		elemType, value, err := e.singularFieldType(fd, list.Get(i), elem)
+		if err != nil {
+			return "", nil, err
+		}
+		if i > 0 && elemType != listType {
+			return "", nil, errorsmod.Wrapf(errortypes.ErrInvalidType, "repeated field elements have different types %s and %s", listType, elemType)
+		}
+
+		listType = elemType
+		array[i] = value
+	}
+
+	return listType + "[]", array, nil
+}
+
+// singularFieldType adds the types of a single value of the given field and
+// returns its type.
+func (e schemaEncoder) singularFieldType(fd protoreflect.FieldDescriptor, v protoreflect.Value, jsonValue interface{}) (string, interface{}, error) {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if fd.Message().FullName() == anyFullName {
+			return e.anyType(v.Message(), jsonValue)
+		}
+		return e.messageType(v.Message(), jsonValue)
+	case protoreflect.StringKind, protoreflect.BytesKind:
+		return ethString, jsonValue, nil
+	case protoreflect.BoolKind:
+		return ethBool, jsonValue, nil
+	case protoreflect.EnumKind, protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return "int32", jsonValue, nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return "uint32", jsonValue, nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return ethInt64, jsonValue, nil
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return "uint64", jsonValue, nil
+	default:
+		return "", nil, errorsmod.Wrapf(errortypes.ErrNotSupported, "field kind %s is not supported", fd.Kind())
+	}
+}
+
+// aminoFieldName returns the name of the field in the Amino JSON encoding.
+func aminoFieldName(fd protoreflect.FieldDescriptor) string {
+	if name, ok := proto.GetExtension(fd.Options(), aminov1.E_FieldName).(string); ok && name != "" {
+		return name
+	}
+	return string(fd.Name())
+}
+
+// ethTypeForJSONValue returns the EIP-712 type of a primitive JSON value.
+func ethTypeForJSONValue(jsonValue interface{}) (string, error) {
+	switch jsonValue.(type) {
+	case string:
+		return ethString, nil
+	case bool:
+		return ethBool, nil
+	case float64:
+		return ethInt64, nil
+	default:
+		return "", errorsmod.Wrapf(errortypes.ErrInvalidType, "unexpected Amino JSON value %v", jsonValue)
+	}
+}
@@ -0,0 +1,124 @@
+package eip712_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	"github.com/AizelNetwork/CosmEvm/ethereum/eip712"
+)
+
+// TestSchemaTypedData tests the typed data derived from the proto descriptors
+// of the messages, including messages with nested Any fields.
+func (suite *EIP712TestSuite) TestSchemaTypedData() {
+	suite.SetupTest()
+
+	from := suite.createTestAddress()
+	to := suite.createTestAddress()
+	amount := suite.makeCoins(suite.denom, math.NewInt(1))
+
+	msgSend := banktypes.NewMsgSend(from, to, amount)
+	msgDelegate := stakingtypes.NewMsgDelegate(
+		from.String(),
+		sdk.ValAddress(to).String(),
+		sdk.NewCoin(suite.denom, math.NewInt(1)),
+	)
+	msgExec := authz.NewMsgExec(from, []sdk.Msg{msgSend, msgDelegate})
+	msgSubmitProposal, err := govtypesv1.NewMsgSubmitProposal(
+		[]sdk.Msg{msgSend},
+		amount,
+		from.String(),
+		"metadata",
+		"title",
+		"summary",
+		false,
+	)
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name     string
+		msgs     []sdk.Msg
+		expTypes []string
+	}{
+		{
+			"single message",
+			[]sdk.Msg{msgSend},
+			[]string{"AnyCosmosBankV1beta1MsgSend0", "CosmosBankV1beta1MsgSend0", "CosmosBaseV1beta1Coin0"},
+		},
+		{
+			"messages of different types",
+			[]sdk.Msg{msgSend, msgDelegate},
+			[]string{"AnyCosmosBankV1beta1MsgSend0", "AnyCosmosStakingV1beta1MsgDelegate0"},
+		},
+		{
+			"authz exec with messages of different types",
+			[]sdk.Msg{&msgExec},
+			[]string{"CosmosAuthzV1beta1MsgExecMsgs0", "AnyCosmosBankV1beta1MsgSend0", "AnyCosmosStakingV1beta1MsgDelegate0"},
+		},
+		{
+			"gov proposal with messages",
+			[]sdk.Msg{msgSubmitProposal},
+			[]string{"CosmosGovV1MsgSubmitProposal0", "CosmosGovV1MsgSubmitProposalMessages0", "AnyCosmosBankV1beta1MsgSend0"},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			fee := legacytx.NewStdFee(20000, amount) //nolint:staticcheck
+			data := legacytx.StdSignBytes("aizel_9000-1", 1, 2, 0, fee, tc.msgs, "memo")
+
+			typedData, err := eip712.SchemaWrapTxToTypedData(
+				suite.config.InterfaceRegistry,
+				9000,
+				tc.msgs,
+				data,
+				&eip712.FeeDelegationOptions{FeePayer: from},
+			)
+			suite.Require().NoError(err)
+
+			for _, typeDef := range tc.expTypes {
+				suite.Require().Contains(typedData.Types, typeDef)
+			}
+			suite.Require().Len(typedData.Types["Tx"], 5+len(tc.msgs))
+
+			_, _, err = apitypes.TypedDataAndHash(typedData)
+			suite.Require().NoError(err)
+		})
+	}
+}
+
+// TestSchemaTypedDataErrorHandling tests the error handling of the typed data
+// derived from the proto descriptors of the messages.
+func (suite *EIP712TestSuite) TestSchemaTypedDataErrorHandling() {
+	suite.SetupTest()
+
+	registry := suite.config.InterfaceRegistry
+	msgSend := banktypes.NewMsgSend(suite.createTestAddress(), suite.createTestAddress(), suite.makeCoins(suite.denom, math.NewInt(1)))
+
+	// Invalid JSON
+	_, err := eip712.SchemaWrapTxToTypedData(registry, 9000, []sdk.Msg{msgSend}, []byte("{"), nil)
+	suite.Require().ErrorContains(err, "failed to JSON unmarshal data")
+
+	// Messages not matching the tx data
+	_, err = eip712.SchemaWrapTxToTypedData(registry, 9000, []sdk.Msg{msgSend}, []byte(`{"msgs": []}`), nil)
+	suite.Require().ErrorContains(err, "do not match the tx messages")
+
+	// Message without Amino JSON envelope
+	_, err = eip712.SchemaWrapTxToTypedData(registry, 9000, []sdk.Msg{msgSend}, []byte(`{"msgs": [{"from_address": "addr"}]}`), nil)
+	suite.Require().ErrorContains(err, "expected Amino JSON envelope")
+
+	// Fields missing from the message schema
+	_, err = eip712.SchemaWrapTxToTypedData(
+		registry,
+		9000,
+		[]sdk.Msg{msgSend},
+		[]byte(`{"msgs": [{"type": "cosmos-sdk/MsgSend", "value": {"unknown": "field"}}]}`),
+		nil,
+	)
+	suite.Require().ErrorContains(err, "missing from its schema")
+}
@@ -0,0 +1,31 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/// @dev The IBatch contract's address.
+address constant BATCH_PRECOMPILE_ADDRESS = 0x000000000000000000000000000000000000080a;
+
+/// @dev The IBatch contract's instance.
+IBatch constant BATCH_CONTRACT = IBatch(BATCH_PRECOMPILE_ADDRESS);
+
+/// @dev Call defines a call to a precompiled contract.
+struct Call {
+    /// @dev target is the address of the precompiled contract
+    address target;
+    /// @dev callData is the ABI encoded method call
+    bytes callData;
+}
+
+/// @author Evmos Team
+/// @title Batch Precompiled Contract
+/// @dev The interface through which solidity contracts execute several precompile
+/// calls atomically in a single context. The calls are executed on behalf of the
+/// caller of the batch precompile, as if it called each precompile directly.
+/// Only the staking, distribution, gov and ICS20 precompiles can be batched.
+/// @custom:address 0x000000000000000000000000000000000000080a
+interface IBatch {
+    /// @dev Batch executes the given calls in order. If any of the calls fails,
+    /// all of them are reverted.
+    /// @param calls The calls to execute
+    /// @return results The return data of each call
+    function batch(Call[] calldata calls) external returns (bytes[] memory results);
+}
@@ -0,0 +1,147 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package batch
+
+import (
+	"embed"
+	"fmt"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/AizelNetwork/CosmEvm/precompiles/common"
+	"github.com/AizelNetwork/CosmEvm/x/evm/core/vm"
+	evmtypes "github.com/AizelNetwork/CosmEvm/x/evm/types"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// EVMKeeper defines the expected EVM keeper interface used to check whether
+// the batched precompiles are active.
+type EVMKeeper interface {
+	GetParams(ctx sdk.Context) evmtypes.Params
+	IsAvailableStaticPrecompile(params *evmtypes.Params, address common.Address) bool
+}
+
+// Precompile defines the precompiled contract for batching precompile calls.
+type Precompile struct {
+	cmn.Precompile
+	evmKeeper   EVMKeeper
+	precompiles map[common.Address]cmn.BatchablePrecompile
+}
+
+// LoadABI loads the batch ABI from the embedded abi.json file
+// for the batch precompile.
+func LoadABI() (abi.ABI, error) {
+	return cmn.LoadABI(f, "abi.json")
+}
+
+// NewPrecompile creates a new batch Precompile instance as a
+// PrecompiledContract interface, given the precompiles whose
+// calls can be batched.
+func NewPrecompile(
+	evmKeeper EVMKeeper,
+	precompiles ...cmn.BatchablePrecompile,
+) (*Precompile, error) {
+	abi, err := LoadABI()
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Precompile{
+		Precompile: cmn.Precompile{
+			ABI:                  abi,
+			KvGasConfig:          storetypes.KVGasConfig(),
+			TransientKVGasConfig: storetypes.TransientGasConfig(),
+		},
+		evmKeeper:   evmKeeper,
+		precompiles: make(map[common.Address]cmn.BatchablePrecompile, len(precompiles)),
+	}
+
+	for _, precompile := range precompiles {
+		p.precompiles[precompile.Address()] = precompile
+	}
+
+	// SetAddress defines the address of the batch precompiled contract.
+	p.SetAddress(common.HexToAddress(evmtypes.BatchPrecompileAddress))
+
+	return p, nil
+}
+
+// RequiredGas calculates the precompiled contract's base gas rate.
+// The gas required by each of the batched calls is charged during execution.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return 0
+	}
+	methodID := input[:4]
+
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method))
+}
+
+// Run executes the precompiled contract batch methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	ctx, stateDB, snapshot, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// This handles any out of gas errors that may occur during the execution of a precompile tx or query.
+	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
+	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
+
+	var entries []cmn.BalanceChangeEntry
+	switch method.Name {
+	case BatchMethod:
+		bz, entries, err = p.Batch(ctx, evm, contract, stateDB, method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	p.SetBalanceChangeEntries(entries...)
+
+	cost := ctx.GasMeter().GasConsumed() - initialGas
+
+	if !contract.UseGas(cost) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	if err := p.AddJournalEntries(stateDB, snapshot); err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+//
+// Available batch transactions are:
+//   - Batch
+func (Precompile) IsTransaction(method *abi.Method) bool {
+	return method.Name == BatchMethod
+}
+
+// Logger returns a precompile-specific logger.
+func (p Precompile) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("evm extension", "batch")
+}
@@ -0,0 +1,21 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package batch
+
+const (
+	// ErrEmptyBatch is raised when the batch does not contain any calls.
+	ErrEmptyBatch = "batch must contain at least one call"
+	// ErrTooManyCalls is raised when the batch contains more calls than allowed.
+	ErrTooManyCalls = "batch contains %d calls, maximum is %d"
+	// ErrInvalidCalls is raised when the calls argument is invalid.
+	ErrInvalidCalls = "invalid calls: %v"
+	// ErrTargetNotBatchable is raised when the call target is not a precompile that can be batched.
+	ErrTargetNotBatchable = "call %d: target %s is not a batchable precompile"
+	// ErrTargetNotActive is raised when the call target is not an active precompile.
+	ErrTargetNotActive = "call %d: precompile %s is not active"
+	// ErrInvalidCallData is raised when the call data of a call cannot be decoded.
+	ErrInvalidCallData = "call %d: invalid call data: %v"
+	// ErrCallFailed is raised when the execution of a call fails.
+	ErrCallFailed = "call %d to %s failed: %v"
+)
@@ -0,0 +1,69 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package batch_test
+
+import (
+	"testing"
+
+	"github.com/AizelNetwork/CosmEvm/precompiles/batch"
+	govprecompile "github.com/AizelNetwork/CosmEvm/precompiles/gov"
+	stakingprecompile "github.com/AizelNetwork/CosmEvm/precompiles/staking"
+	"github.com/AizelNetwork/CosmEvm/testutil/integration/aizel/factory"
+	"github.com/AizelNetwork/CosmEvm/testutil/integration/aizel/grpc"
+	testkeyring "github.com/AizelNetwork/CosmEvm/testutil/integration/aizel/keyring"
+	"github.com/AizelNetwork/CosmEvm/testutil/integration/aizel/network"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	network *network.UnitTestNetwork
+	factory factory.TxFactory
+	keyring testkeyring.Keyring
+
+	precompile        *batch.Precompile
+	stakingPrecompile *stakingprecompile.Precompile
+	govPrecompile     *govprecompile.Precompile
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompileTestSuite))
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	keyring := testkeyring.New(2)
+	nw := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	grpcHandler := grpc.NewIntegrationHandler(nw)
+
+	s.network = nw
+	s.factory = factory.New(nw, grpcHandler)
+	s.keyring = keyring
+
+	var err error
+	if s.stakingPrecompile, err = stakingprecompile.NewPrecompile(
+		s.network.App.StakingKeeper,
+		s.network.App.AuthzKeeper,
+	); err != nil {
+		panic(err)
+	}
+
+	if s.govPrecompile, err = govprecompile.NewPrecompile(
+		s.network.App.GovKeeper,
+		s.network.App.AuthzKeeper,
+	); err != nil {
+		panic(err)
+	}
+
+	if s.precompile, err = batch.NewPrecompile(
+		s.network.App.EvmKeeper,
+		s.stakingPrecompile,
+		s.govPrecompile,
+	); err != nil {
+		panic(err)
+	}
+}
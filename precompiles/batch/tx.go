@@ -0,0 +1,93 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package batch
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	cmn "github.com/AizelNetwork/CosmEvm/precompiles/common"
+	"github.com/AizelNetwork/CosmEvm/x/evm/core/vm"
+)
+
+const (
+	// BatchMethod defines the ABI method name for the batch transaction.
+	BatchMethod = "batch"
+)
+
+// Batch executes the given precompile calls in order on behalf of the caller.
+// All the calls share a single cache context, which is only written if every
+// call succeeds, so that the batch is executed atomically. The balance changes
+// of all the calls are returned to be added to the journal in a single entry.
+func (p Precompile) Batch(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, []cmn.BalanceChangeEntry, error) {
+	calls, err := ParseBatchArgs(method, args)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	params := p.evmKeeper.GetParams(ctx)
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	results := make([][]byte, len(calls))
+	var entries []cmn.BalanceChangeEntry
+
+	for i, call := range calls {
+		target, ok := p.precompiles[call.Target]
+		if !ok {
+			return nil, nil, fmt.Errorf(ErrTargetNotBatchable, i, call.Target)
+		}
+
+		if !p.evmKeeper.IsAvailableStaticPrecompile(&params, call.Target) {
+			return nil, nil, fmt.Errorf(ErrTargetNotActive, i, call.Target)
+		}
+
+		if len(call.CallData) < 4 {
+			return nil, nil, fmt.Errorf(ErrInvalidCallData, i, "call data is too short")
+		}
+
+		targetMethod, err := target.MethodById(call.CallData[:4])
+		if err != nil {
+			return nil, nil, fmt.Errorf(ErrInvalidCallData, i, err)
+		}
+
+		targetArgs, err := targetMethod.Inputs.Unpack(call.CallData[4:])
+		if err != nil {
+			return nil, nil, fmt.Errorf(ErrInvalidCallData, i, err)
+		}
+
+		// charge the base gas of the call as if the target was called directly
+		cacheCtx.GasMeter().ConsumeGas(target.RequiredGas(call.CallData), "batch call")
+
+		// the call is executed on behalf of the caller of the batch precompile
+		targetContract := vm.NewContract(vm.AccountRef(contract.CallerAddress), vm.AccountRef(call.Target), big.NewInt(0), contract.Gas)
+		targetContract.Input = call.CallData
+
+		bz, callEntries, err := target.Execute(cacheCtx, evm, targetContract, stateDB, targetMethod, targetArgs)
+		if err != nil {
+			return nil, nil, fmt.Errorf(ErrCallFailed, i, call.Target, err)
+		}
+
+		results[i] = bz
+		entries = append(entries, callEntries...)
+	}
+
+	writeCache()
+
+	bz, err := method.Outputs.Pack(results)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return bz, entries, nil
+}
@@ -0,0 +1,244 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package batch_test
+
+import (
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/AizelNetwork/CosmEvm/app"
+	"github.com/AizelNetwork/CosmEvm/precompiles/batch"
+	cmn "github.com/AizelNetwork/CosmEvm/precompiles/common"
+	govprecompile "github.com/AizelNetwork/CosmEvm/precompiles/gov"
+	stakingprecompile "github.com/AizelNetwork/CosmEvm/precompiles/staking"
+	"github.com/AizelNetwork/CosmEvm/precompiles/testutil"
+	"github.com/AizelNetwork/CosmEvm/x/evm/core/vm"
+	"github.com/AizelNetwork/CosmEvm/x/evm/statedb"
+	evmtypes "github.com/AizelNetwork/CosmEvm/x/evm/types"
+)
+
+func (s *PrecompileTestSuite) TestBatch() {
+	method := s.precompile.Methods[batch.BatchMethod]
+
+	validatorCall := func() batch.Call {
+		valAddr, err := sdk.ValAddressFromBech32(s.network.GetValidators()[0].OperatorAddress)
+		s.Require().NoError(err)
+		input, err := s.stakingPrecompile.Pack(stakingprecompile.ValidatorMethod, common.BytesToAddress(valAddr))
+		s.Require().NoError(err)
+		return batch.Call{Target: s.stakingPrecompile.Address(), CallData: input}
+	}
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expResults  int
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} { return []interface{}{} },
+			0,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - empty batch",
+			func() []interface{} { return []interface{}{[]batch.Call{}} },
+			0,
+			batch.ErrEmptyBatch,
+		},
+		{
+			"fail - target is not batchable",
+			func() []interface{} {
+				target := common.HexToAddress(evmtypes.BankPrecompileAddress)
+				return []interface{}{[]batch.Call{validatorCall(), {Target: target, CallData: []byte{1, 2, 3, 4}}}}
+			},
+			0,
+			fmt.Sprintf(batch.ErrTargetNotBatchable, 1, common.HexToAddress(evmtypes.BankPrecompileAddress)),
+		},
+		{
+			"fail - target is not active",
+			func() []interface{} {
+				params := s.network.App.EvmKeeper.GetParams(s.network.GetContext())
+				params.ActiveStaticPrecompiles = []string{evmtypes.GovPrecompileAddress}
+				s.Require().NoError(s.network.App.EvmKeeper.SetParams(s.network.GetContext(), params))
+				return []interface{}{[]batch.Call{validatorCall()}}
+			},
+			0,
+			fmt.Sprintf(batch.ErrTargetNotActive, 0, s.stakingPrecompile.Address()),
+		},
+		{
+			"fail - call data too short",
+			func() []interface{} {
+				return []interface{}{[]batch.Call{{Target: s.stakingPrecompile.Address(), CallData: []byte{1}}}}
+			},
+			0,
+			fmt.Sprintf(batch.ErrInvalidCallData, 0, "call data is too short"),
+		},
+		{
+			"fail - call fails",
+			func() []interface{} {
+				input, err := s.govPrecompile.Pack(govprecompile.GetProposalMethod, uint64(1000))
+				s.Require().NoError(err)
+				return []interface{}{[]batch.Call{validatorCall(), {Target: s.govPrecompile.Address(), CallData: input}}}
+			},
+			0,
+			"call 1 to " + s.govPrecompile.Address().String() + " failed",
+		},
+		{
+			"success - queries",
+			func() []interface{} {
+				return []interface{}{[]batch.Call{validatorCall(), validatorCall()}}
+			},
+			2,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			args := tc.malleate()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200000)
+
+			bz, entries, err := s.precompile.Batch(ctx, nil, contract, s.network.GetStateDB(), &method, args)
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Empty(entries)
+
+			var results [][]byte
+			s.Require().NoError(s.precompile.UnpackIntoInterface(&results, batch.BatchMethod, bz))
+			s.Require().Len(results, tc.expResults)
+		})
+	}
+}
+
+// TestRun tests that the batched transactions are executed atomically.
+func (s *PrecompileTestSuite) TestRun() {
+	delegationAmt := big.NewInt(1e18)
+
+	delegateCall := func() batch.Call {
+		input, err := s.stakingPrecompile.Pack(
+			stakingprecompile.DelegateMethod,
+			s.keyring.GetAddr(0),
+			s.network.GetValidators()[0].OperatorAddress,
+			delegationAmt,
+		)
+		s.Require().NoError(err)
+		return batch.Call{Target: s.stakingPrecompile.Address(), CallData: input}
+	}
+
+	testCases := []struct {
+		name          string
+		calls         func() []batch.Call
+		expDelegation bool
+		errContains   string
+	}{
+		{
+			"pass - delegate twice",
+			func() []batch.Call {
+				return []batch.Call{delegateCall(), delegateCall()}
+			},
+			true,
+			"",
+		},
+		{
+			"fail - delegation reverted when a subsequent call fails",
+			func() []batch.Call {
+				input, err := s.govPrecompile.Pack(govprecompile.VoteMethod, s.keyring.GetAddr(0), uint64(1000), uint8(1), "")
+				s.Require().NoError(err)
+				return []batch.Call{delegateCall(), {Target: s.govPrecompile.Address(), CallData: input}}
+			},
+			false,
+			"call 1 to " + s.govPrecompile.Address().String() + " failed",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+
+			// enable the batch precompile
+			params := s.network.App.EvmKeeper.GetParams(ctx)
+			params.ActiveStaticPrecompiles = append(params.ActiveStaticPrecompiles, evmtypes.BatchPrecompileAddress)
+			s.Require().NoError(s.network.App.EvmKeeper.SetParams(ctx, params))
+
+			valAddr, err := sdk.ValAddressFromBech32(s.network.GetValidators()[0].OperatorAddress)
+			s.Require().NoError(err)
+			initialShares := s.delegationShares(ctx, valAddr)
+
+			input, err := s.precompile.Pack(batch.BatchMethod, tc.calls())
+			s.Require().NoError(err)
+
+			contract := vm.NewPrecompile(vm.AccountRef(s.keyring.GetAddr(0)), s.precompile, big.NewInt(0), uint64(1e6))
+			contract.Input = input
+			contractAddr := contract.Address()
+
+			baseFee := s.network.App.EvmKeeper.GetBaseFee(ctx)
+			txArgs := evmtypes.EvmTxArgs{
+				ChainID:   evmtypes.GetEthChainConfig().ChainID,
+				Nonce:     0,
+				To:        &contractAddr,
+				GasLimit:  1_000_000,
+				GasPrice:  app.MainnetMinGasPrices.BigInt(),
+				GasFeeCap: baseFee,
+				GasTipCap: big.NewInt(1),
+				Accesses:  &ethtypes.AccessList{},
+			}
+			msg, err := s.factory.GenerateGethCoreMsg(s.keyring.GetPrivKey(0), txArgs)
+			s.Require().NoError(err)
+
+			cfg, err := s.network.App.EvmKeeper.EVMConfig(ctx, ctx.BlockHeader().ProposerAddress)
+			s.Require().NoError(err, "failed to instantiate EVM config")
+
+			stDB := statedb.New(ctx, s.network.App.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+			evm := s.network.App.EvmKeeper.NewEVM(ctx, msg, cfg, nil, stDB)
+
+			precompiles, found, err := s.network.App.EvmKeeper.GetPrecompileInstance(ctx, contractAddr)
+			s.Require().NoError(err, "failed to instantiate precompile")
+			s.Require().True(found, "not found precompile")
+			evm.WithPrecompiles(precompiles.Map, precompiles.Addresses)
+
+			bz, err := s.precompile.Run(evm, contract, false)
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Nil(bz)
+			} else {
+				s.Require().NoError(err)
+
+				var results [][]byte
+				s.Require().NoError(s.precompile.UnpackIntoInterface(&results, batch.BatchMethod, bz))
+				s.Require().Len(results, 2)
+			}
+
+			s.Require().NoError(stDB.Commit())
+
+			shares := s.delegationShares(ctx, valAddr)
+			if tc.expDelegation {
+				s.Require().True(shares.GT(initialShares), "expected delegation shares to increase")
+			} else {
+				s.Require().Equal(initialShares, shares, "expected delegation shares to be unchanged")
+			}
+		})
+	}
+}
+
+// delegationShares returns the delegation shares of the first keyring account on the given validator.
+func (s *PrecompileTestSuite) delegationShares(ctx sdk.Context, valAddr sdk.ValAddress) math.LegacyDec {
+	delegation, err := s.network.App.StakingKeeper.GetDelegation(ctx, s.keyring.GetAccAddr(0), valAddr)
+	if err != nil {
+		return math.LegacyZeroDec()
+	}
+	return delegation.Shares
+}
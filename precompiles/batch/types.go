@@ -0,0 +1,46 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package batch
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/AizelNetwork/CosmEvm/precompiles/common"
+)
+
+// MaxCalls defines the maximum number of calls that can be executed in a single batch.
+const MaxCalls = 32
+
+// Call defines a single precompile call of a batch.
+type Call struct {
+	Target   common.Address `abi:"target"`
+	CallData []byte         `abi:"callData"`
+}
+
+// ParseBatchArgs parses the arguments of the batch method and returns the calls.
+func ParseBatchArgs(method *abi.Method, args []interface{}) ([]Call, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	var input struct {
+		Calls []Call
+	}
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf(ErrInvalidCalls, err)
+	}
+
+	switch {
+	case len(input.Calls) == 0:
+		return nil, errors.New(ErrEmptyBatch)
+	case len(input.Calls) > MaxCalls:
+		return nil, fmt.Errorf(ErrTooManyCalls, len(input.Calls), MaxCalls)
+	}
+
+	return input.Calls, nil
+}
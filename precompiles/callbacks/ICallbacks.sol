@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/// @author Evmos Team
+/// @title IBC Packet Lifecycle Callbacks
+/// @dev The interface that contracts designated in the memo of an IBC packet can
+/// implement to be notified about the packet lifecycle, following ADR-008.
+///
+/// The memo must contain a JSON object with the callback contract and an optional gas limit:
+///   {"src_callback": {"address": "0x...", "gas_limit": "100000"}}
+///   {"dest_callback": {"address": "0x...", "gas_limit": "100000"}}
+/// The source callback contract must be the sender of the packet.
+///
+/// The callbacks are invoked with the ICS20 precompile address as msg.sender.
+/// A failing callback never affects the outcome of the packet.
+interface ICallbacks {
+    /// @dev OnPacketAcknowledgement is called on the source callback contract when
+    /// an acknowledgement is received for the packet.
+    /// @param channelId The source channel identifier of the packet
+    /// @param portId The source port identifier of the packet
+    /// @param sequence The sequence number of the packet
+    /// @param data The packet data
+    /// @param acknowledgement The acknowledgement of the packet
+    function onPacketAcknowledgement(
+        string calldata channelId,
+        string calldata portId,
+        uint64 sequence,
+        bytes calldata data,
+        bytes calldata acknowledgement
+    ) external;
+
+    /// @dev OnPacketTimeout is called on the source callback contract when the packet times out.
+    /// @param channelId The source channel identifier of the packet
+    /// @param portId The source port identifier of the packet
+    /// @param sequence The sequence number of the packet
+    /// @param data The packet data
+    function onPacketTimeout(
+        string calldata channelId,
+        string calldata portId,
+        uint64 sequence,
+        bytes calldata data
+    ) external;
+
+    /// @dev OnPacketReceive is called on the destination callback contract after
+    /// the packet has been successfully received.
+    /// @param channelId The destination channel identifier of the packet
+    /// @param portId The destination port identifier of the packet
+    /// @param sequence The sequence number of the packet
+    /// @param data The packet data
+    function onPacketReceive(
+        string calldata channelId,
+        string calldata portId,
+        uint64 sequence,
+        bytes calldata data
+    ) external;
+}
@@ -0,0 +1,34 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package callbacks
+
+import (
+	"embed"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	cmn "github.com/AizelNetwork/CosmEvm/precompiles/common"
+)
+
+const (
+	// PacketAcknowledgementMethod defines the ABI method name of the callback
+	// invoked when a packet is acknowledged.
+	PacketAcknowledgementMethod = "onPacketAcknowledgement"
+	// PacketTimeoutMethod defines the ABI method name of the callback invoked
+	// when a packet times out.
+	PacketTimeoutMethod = "onPacketTimeout"
+	// PacketReceiveMethod defines the ABI method name of the callback invoked
+	// when a packet is received.
+	PacketReceiveMethod = "onPacketReceive"
+)
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// LoadABI loads the IBC packet callbacks ABI from the embedded abi.json file.
+func LoadABI() (abi.ABI, error) {
+	return cmn.LoadABI(f, "abi.json")
+}
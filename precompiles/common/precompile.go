@@ -25,7 +25,26 @@ type Precompile struct {
 	KvGasConfig          storetypes.GasConfig
 	TransientKVGasConfig storetypes.GasConfig
 	address              common.Address
-	journalEntries       []balanceChangeEntry
+	journalEntries       []BalanceChangeEntry
+}
+
+// BatchablePrecompile defines the interface of the precompiles whose methods can be
+// executed by the batch precompile within its own context, without running the
+// precompile setup and journal handling for each call.
+type BatchablePrecompile interface {
+	vm.PrecompiledContract
+	MethodById(sigdata []byte) (*abi.Method, error)
+	IsTransaction(method *abi.Method) bool
+	// Execute executes the given method and returns the balance changes that
+	// need to be added to the stateDB journal.
+	Execute(
+		ctx sdk.Context,
+		evm *vm.EVM,
+		contract *vm.Contract,
+		stateDB vm.StateDB,
+		method *abi.Method,
+		args []interface{},
+	) ([]byte, []BalanceChangeEntry, error)
 }
 
 // Operation is a type that defines if the precompile call
@@ -37,14 +56,15 @@ const (
 	Add
 )
 
-type balanceChangeEntry struct {
+// BalanceChangeEntry defines a balance change of an account produced by a precompile call
+type BalanceChangeEntry struct {
 	Account common.Address
 	Amount  *big.Int
 	Op      Operation
 }
 
-func NewBalanceChangeEntry(acc common.Address, amt *big.Int, op Operation) balanceChangeEntry { //nolint:revive
-	return balanceChangeEntry{acc, amt, op}
+func NewBalanceChangeEntry(acc common.Address, amt *big.Int, op Operation) BalanceChangeEntry {
+	return BalanceChangeEntry{acc, amt, op}
 }
 
 // snapshot contains all state and events previous to the precompile call
@@ -200,10 +220,16 @@ func (p Precompile) AddJournalEntries(stateDB *statedb.StateDB, s snapshot) erro
 // as the journalEntries field of the precompile.
 // These entries will be added to the stateDB's journal
 // when calling the AddJournalEntries function
-func (p *Precompile) SetBalanceChangeEntries(entries ...balanceChangeEntry) {
+func (p *Precompile) SetBalanceChangeEntries(entries ...BalanceChangeEntry) {
 	p.journalEntries = entries
 }
 
+// BalanceChangeEntries returns the balanceChange entries
+// set on the precompile.
+func (p Precompile) BalanceChangeEntries() []BalanceChangeEntry {
+	return p.journalEntries
+}
+
 func (p Precompile) Address() common.Address {
 	return p.address
 }
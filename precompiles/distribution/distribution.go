@@ -12,6 +12,7 @@ import (
 	"github.com/AizelNetwork/CosmEvm/x/evm/core/vm"
 	evmtypes "github.com/AizelNetwork/CosmEvm/x/evm/types"
 	stakingkeeper "github.com/AizelNetwork/CosmEvm/x/staking/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	distributionkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -91,6 +92,37 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
 	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
 
+	var entries []cmn.BalanceChangeEntry
+	bz, entries, err = p.Execute(ctx, evm, contract, stateDB, method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	p.SetBalanceChangeEntries(entries...)
+
+	cost := ctx.GasMeter().GasConsumed() - initialGas
+
+	if !contract.UseGas(cost) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	if err := p.AddJournalEntries(stateDB, snapshot); err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
+
+// Execute executes the distribution method with the given arguments and returns the
+// balance changes to be added to the stateDB journal.
+func (p Precompile) Execute(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) (bz []byte, entries []cmn.BalanceChangeEntry, err error) {
 	switch method.Name {
 	// Custom transactions
 	case ClaimRewardsMethod:
@@ -123,21 +155,7 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		bz, err = p.DelegatorWithdrawAddress(ctx, contract, method, args)
 	}
 
-	if err != nil {
-		return nil, err
-	}
-
-	cost := ctx.GasMeter().GasConsumed() - initialGas
-
-	if !contract.UseGas(cost) {
-		return nil, vm.ErrOutOfGas
-	}
-
-	if err := p.AddJournalEntries(stateDB, snapshot); err != nil {
-		return nil, err
-	}
-
-	return bz, nil
+	return bz, p.BalanceChangeEntries(), err
 }
 
 // IsTransaction checks if the given method name corresponds to a transaction or query.
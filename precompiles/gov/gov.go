@@ -96,6 +96,37 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
 	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
 
+	var entries []cmn.BalanceChangeEntry
+	bz, entries, err = p.Execute(ctx, evm, contract, stateDB, method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	p.SetBalanceChangeEntries(entries...)
+
+	cost := ctx.GasMeter().GasConsumed() - initialGas
+
+	if !contract.UseGas(cost) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	if err := p.AddJournalEntries(stateDB, snapshot); err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
+
+// Execute executes the gov method with the given arguments and returns the
+// balance changes to be added to the stateDB journal.
+func (p Precompile) Execute(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) (bz []byte, entries []cmn.BalanceChangeEntry, err error) {
 	switch method.Name {
 	// gov transactions
 	case VoteMethod:
@@ -118,24 +149,10 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 	case GetProposalsMethod:
 		bz, err = p.GetProposals(ctx, method, contract, args)
 	default:
-		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
-	}
-
-	if err != nil {
-		return nil, err
-	}
-
-	cost := ctx.GasMeter().GasConsumed() - initialGas
-
-	if !contract.UseGas(cost) {
-		return nil, vm.ErrOutOfGas
-	}
-
-	if err := p.AddJournalEntries(stateDB, snapshot); err != nil {
-		return nil, err
+		return nil, nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
 	}
 
-	return bz, nil
+	return bz, p.BalanceChangeEntries(), err
 }
 
 // IsTransaction checks if the given method name corresponds to a transaction or query.
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/// @dev The IICA contract's address.
+address constant ICA_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000809;
+
+/// @dev The IICA contract's instance.
+IICA constant ICA_CONTRACT = IICA(ICA_PRECOMPILE_ADDRESS);
+
+/// @author Evmos Team
+/// @title ICS27 Interchain Accounts Controller Precompiled Contract
+/// @dev The interface through which solidity contracts register and control
+/// interchain accounts on counterparty chains. The interchain account is owned
+/// by the contract (or EOA) that calls the precompile.
+/// Acknowledgements and timeouts of the packets sent through sendTx are delivered
+/// back to the owner contract via the IICACallbacks interface, when implemented.
+/// @custom:address 0x0000000000000000000000000000000000000809
+interface IICA {
+    /// @dev Emitted when a new interchain account registration is initiated.
+    /// @param owner The address of the interchain account owner
+    /// @param connectionId The connection identifier on the controller chain
+    /// @param portId The controller port identifier bound for the owner
+    event RegisterInterchainAccount(address indexed owner, string connectionId, string portId);
+
+    /// @dev Emitted when an interchain account packet is sent.
+    /// @param owner The address of the interchain account owner
+    /// @param connectionId The connection identifier on the controller chain
+    /// @param sequence The sequence number of the sent packet
+    event SendTx(address indexed owner, string connectionId, uint64 sequence);
+
+    /// @dev RegisterInterchainAccount initiates the channel handshake to register an
+    /// interchain account owned by the caller on the chain at the other end of the connection.
+    /// @param connectionId The connection identifier on the controller chain
+    /// @param version The ICS27 channel version. An empty string uses the default metadata of the connection
+    /// @return success Whether or not the registration was initiated successfully
+    function registerInterchainAccount(
+        string calldata connectionId,
+        string calldata version
+    ) external returns (bool success);
+
+    /// @dev SendTx sends the given transaction data to be executed by the interchain account
+    /// of the caller on the chain at the other end of the connection.
+    /// @param connectionId The connection identifier on the controller chain
+    /// @param data The encoded CosmosTx to be executed by the interchain account
+    /// @param memo The memo of the interchain account packet
+    /// @param timeoutTimestamp The absolute timeout in nanoseconds. Zero uses the default relative timeout
+    /// @return sequence The sequence number of the sent packet
+    function sendTx(
+        string calldata connectionId,
+        bytes calldata data,
+        string calldata memo,
+        uint64 timeoutTimestamp
+    ) external returns (uint64 sequence);
+
+    /// @dev InterchainAccountAddress returns the address of the interchain account
+    /// owned by the given address on the chain at the other end of the connection.
+    /// @param owner The address of the interchain account owner
+    /// @param connectionId The connection identifier on the controller chain
+    /// @return accountAddress The address of the interchain account on the host chain
+    function interchainAccountAddress(
+        address owner,
+        string calldata connectionId
+    ) external view returns (string memory accountAddress);
+}
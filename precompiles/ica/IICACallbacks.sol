@@ -0,0 +1,28 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/// @author Evmos Team
+/// @title ICS27 Interchain Accounts Callbacks
+/// @dev The interface that interchain account owner contracts can implement to be
+/// notified of the outcome of the packets sent through the ICA precompile.
+/// The callbacks are invoked with the ICA precompile address as msg.sender.
+/// A failing callback does not affect the packet lifecycle.
+interface IICACallbacks {
+    /// @dev OnICAAcknowledgement is called when an acknowledgement is received
+    /// for a packet sent by the owner contract.
+    /// @param channelId The controller channel identifier of the packet
+    /// @param sequence The sequence number of the packet
+    /// @param success Whether or not the transaction was executed successfully on the host chain
+    /// @param result The transaction result on success, or the error message otherwise
+    function onICAAcknowledgement(
+        string calldata channelId,
+        uint64 sequence,
+        bool success,
+        bytes calldata result
+    ) external;
+
+    /// @dev OnICATimeout is called when a packet sent by the owner contract times out.
+    /// @param channelId The controller channel identifier of the packet
+    /// @param sequence The sequence number of the packet
+    function onICATimeout(string calldata channelId, uint64 sequence) external;
+}
@@ -0,0 +1,19 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package ica
+
+const (
+	// ErrInvalidConnectionID is raised when the connection identifier is invalid.
+	ErrInvalidConnectionID = "invalid connection id: %v"
+	// ErrInvalidVersion is raised when the channel version is invalid.
+	ErrInvalidVersion = "invalid version: %v"
+	// ErrInvalidOwner is raised when the interchain account owner is invalid.
+	ErrInvalidOwner = "invalid owner: %v"
+	// ErrInvalidPacketData is raised when the interchain account packet data is invalid.
+	ErrInvalidPacketData = "invalid packet data: %v"
+	// ErrInvalidMemo is raised when the memo is invalid.
+	ErrInvalidMemo = "invalid memo: %v"
+	// ErrInvalidTimeoutTimestamp is raised when the timeout timestamp is invalid.
+	ErrInvalidTimeoutTimestamp = "invalid timeout timestamp: %v"
+)
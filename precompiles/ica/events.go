@@ -0,0 +1,98 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package ica
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	cmn "github.com/AizelNetwork/CosmEvm/precompiles/common"
+	"github.com/AizelNetwork/CosmEvm/x/evm/core/vm"
+)
+
+const (
+	// EventTypeRegisterInterchainAccount defines the event type for the ICS27 RegisterInterchainAccount transaction.
+	EventTypeRegisterInterchainAccount = "RegisterInterchainAccount"
+	// EventTypeSendTx defines the event type for the ICS27 SendTx transaction.
+	EventTypeSendTx = "SendTx"
+)
+
+// EmitRegisterInterchainAccountEvent creates a new event emitted on a RegisterInterchainAccount transaction.
+func (p Precompile) EmitRegisterInterchainAccountEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	owner common.Address,
+	connectionID, portID string,
+) error {
+	event := p.ABI.Events[EventTypeRegisterInterchainAccount]
+
+	// Prepare the event topics
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(owner)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data: connectionId, portId
+	arguments := abi.Arguments{event.Inputs[1], event.Inputs[2]}
+	packed, err := arguments.Pack(connectionID, portID)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
+
+// EmitSendTxEvent creates a new event emitted on a SendTx transaction.
+func (p Precompile) EmitSendTxEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	owner common.Address,
+	connectionID string,
+	sequence uint64,
+) error {
+	event := p.ABI.Events[EventTypeSendTx]
+
+	// Prepare the event topics
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(owner)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data: connectionId, sequence
+	arguments := abi.Arguments{event.Inputs[1], event.Inputs[2]}
+	packed, err := arguments.Pack(connectionID, sequence)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
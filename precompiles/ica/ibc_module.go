@@ -0,0 +1,266 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package ica
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/AizelNetwork/CosmEvm/x/evm/statedb"
+	evmtypes "github.com/AizelNetwork/CosmEvm/x/evm/types"
+	callbackstypes "github.com/AizelNetwork/CosmEvm/x/ibc/callbacks/types"
+)
+
+const (
+	// OnAcknowledgementCallback defines the ABI method name of the callback
+	// invoked on the owner contract when a packet is acknowledged.
+	OnAcknowledgementCallback = "onICAAcknowledgement"
+	// OnTimeoutCallback defines the ABI method name of the callback invoked
+	// on the owner contract when a packet times out.
+	OnTimeoutCallback = "onICATimeout"
+
+	// CallbackGasLimit defines the gas limit of the callbacks of the owner
+	// contracts, which the relayer of the packet must provide. The gas used is
+	// charged to the relayer.
+	CallbackGasLimit uint64 = 1_000_000
+
+	// EventTypeICACallback defines the event type emitted for every callback
+	// delivered to an owner contract.
+	EventTypeICACallback = "ica_callback"
+
+	AttributeKeyCallback      = "callback"
+	AttributeKeyOwner         = "owner"
+	AttributeKeyChannelID     = "channel_id"
+	AttributeKeySequence      = "sequence"
+	AttributeKeySuccess       = "success"
+	AttributeKeyGasUsed       = "gas_used"
+	AttributeKeyCallbackError = "error"
+)
+
+// EVMKeeper defines the expected EVM keeper interface used to deliver the
+// packet callbacks to the owner contracts.
+type EVMKeeper interface {
+	GetAccountWithoutBalance(ctx sdk.Context, addr common.Address) *statedb.Account
+	SetAccount(ctx sdk.Context, address common.Address, account statedb.Account) error
+	CallEVMWithGasLimit(ctx sdk.Context, abi abi.ABI, from, contract common.Address, gasLimit uint64, method string, args ...interface{}) (*evmtypes.MsgEthereumTxResponse, error)
+}
+
+var _ porttypes.IBCModule = IBCModule{}
+
+// IBCModule implements the ICS26 callbacks of the authentication module that
+// sits below the ICS27 controller middleware. It delivers the acknowledgements
+// and timeouts of the packets sent through the ICA precompile to the contracts
+// that own the interchain accounts.
+//
+// Callbacks follow the ADR-008 gas policy with a gas limit of CallbackGasLimit:
+// the packet fails if the relayer doesn't provide the gas limit, while a
+// callback reverted by the owner contract, including one running out of gas,
+// never affects the packet lifecycle: the error is logged and emitted as an
+// event. The gas used is consumed on the packet context.
+type IBCModule struct {
+	evmKeeper    EVMKeeper
+	callbacksABI abi.ABI
+}
+
+// NewIBCModule creates a new IBCModule given the EVM keeper.
+func NewIBCModule(evmKeeper EVMKeeper) IBCModule {
+	callbacksABI, err := LoadCallbacksABI()
+	if err != nil {
+		panic(err)
+	}
+
+	return IBCModule{
+		evmKeeper:    evmKeeper,
+		callbacksABI: callbacksABI,
+	}
+}
+
+// OnChanOpenInit implements the IBCModule interface. The version is defined by
+// the controller middleware, so it is returned unchanged.
+func (IBCModule) OnChanOpenInit(
+	_ sdk.Context,
+	_ channeltypes.Order,
+	_ []string,
+	_ string,
+	_ string,
+	_ *capabilitytypes.Capability,
+	_ channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	return version, nil
+}
+
+// OnChanOpenTry implements the IBCModule interface.
+func (IBCModule) OnChanOpenTry(
+	_ sdk.Context,
+	_ channeltypes.Order,
+	_ []string,
+	_,
+	_ string,
+	_ *capabilitytypes.Capability,
+	_ channeltypes.Counterparty,
+	_ string,
+) (string, error) {
+	return "", errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "channel handshake must be initiated by controller chain")
+}
+
+// OnChanOpenAck implements the IBCModule interface.
+func (IBCModule) OnChanOpenAck(
+	_ sdk.Context,
+	_,
+	_ string,
+	_ string,
+	_ string,
+) error {
+	return nil
+}
+
+// OnChanOpenConfirm implements the IBCModule interface.
+func (IBCModule) OnChanOpenConfirm(
+	_ sdk.Context,
+	_,
+	_ string,
+) error {
+	return errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "channel handshake must be initiated by controller chain")
+}
+
+// OnChanCloseInit implements the IBCModule interface.
+func (IBCModule) OnChanCloseInit(
+	_ sdk.Context,
+	_,
+	_ string,
+) error {
+	return errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "user cannot close channel")
+}
+
+// OnChanCloseConfirm implements the IBCModule interface.
+func (IBCModule) OnChanCloseConfirm(
+	_ sdk.Context,
+	_,
+	_ string,
+) error {
+	return nil
+}
+
+// OnRecvPacket implements the IBCModule interface. Packets are never received
+// on the controller chain.
+func (IBCModule) OnRecvPacket(
+	_ sdk.Context,
+	_ channeltypes.Packet,
+	_ sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	return channeltypes.NewErrorAcknowledgement(
+		errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "cannot receive packet on controller chain"),
+	)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. It delivers the
+// acknowledgement to the owner contract of the interchain account.
+func (im IBCModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	_ sdk.AccAddress,
+) error {
+	var ack channeltypes.Acknowledgement
+	if err := icatypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal ICS-27 packet acknowledgement: %v", err)
+	}
+
+	result := ack.GetResult()
+	if !ack.Success() {
+		result = []byte(ack.GetError())
+	}
+
+	return im.callback(ctx, packet, OnAcknowledgementCallback, ack.Success(), packet.SourceChannel, packet.Sequence, ack.Success(), result)
+}
+
+// OnTimeoutPacket implements the IBCModule interface. It notifies the owner
+// contract of the interchain account about the packet timeout.
+func (im IBCModule) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	_ sdk.AccAddress,
+) error {
+	return im.callback(ctx, packet, OnTimeoutCallback, false, packet.SourceChannel, packet.Sequence)
+}
+
+// callback calls the given method on the owner contract of the packet source port.
+// The call is skipped if the owner is not a contract. Any state changes are only
+// committed if the callback succeeds. It returns an error, failing the packet,
+// only if the callback fails for another reason than a revert of the contract.
+func (im IBCModule) callback(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	method string,
+	success bool,
+	args ...interface{},
+) error {
+	owner, err := OwnerFromPortID(packet.SourcePort)
+	if err != nil {
+		return nil
+	}
+
+	account := im.evmKeeper.GetAccountWithoutBalance(ctx, owner)
+	if account == nil || !account.IsContract() {
+		return nil
+	}
+
+	event := sdk.NewEvent(
+		EventTypeICACallback,
+		sdk.NewAttribute(AttributeKeyCallback, method),
+		sdk.NewAttribute(AttributeKeyOwner, owner.String()),
+		sdk.NewAttribute(AttributeKeyChannelID, packet.SourceChannel),
+		sdk.NewAttribute(AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+		sdk.NewAttribute(AttributeKeySuccess, strconv.FormatBool(success)),
+	)
+
+	gasUsed, revertErr, err := callbackstypes.ExecuteCallback(
+		ctx, CallbackGasLimit, "ica callback", im.call(owner, method, args...),
+	)
+	if err != nil {
+		return errorsmod.Wrapf(err, "failed to deliver %s callback", method)
+	}
+
+	if revertErr != nil {
+		ctx.Logger().With("evm extension", "ica").Error(
+			"failed to deliver packet callback",
+			"callback", method,
+			"owner", owner.String(),
+			"channel", packet.SourceChannel,
+			"sequence", packet.Sequence,
+			"error", revertErr.Error(),
+		)
+		event = event.AppendAttributes(sdk.NewAttribute(AttributeKeyCallbackError, revertErr.Error()))
+	}
+
+	event = event.AppendAttributes(sdk.NewAttribute(AttributeKeyGasUsed, strconv.FormatUint(gasUsed, 10)))
+	ctx.EventManager().EmitEvent(event)
+	return nil
+}
+
+// call returns the call of the callback on the owner contract using the ICA
+// precompile address as the sender, so that contracts can authenticate the
+// callbacks.
+func (im IBCModule) call(owner common.Address, method string, args ...interface{}) callbackstypes.ContractCall {
+	return func(ctx sdk.Context, gasLimit uint64) (*evmtypes.MsgEthereumTxResponse, error) {
+		sender := common.HexToAddress(evmtypes.ICAPrecompileAddress)
+		if im.evmKeeper.GetAccountWithoutBalance(ctx, sender) == nil {
+			if err := im.evmKeeper.SetAccount(ctx, sender, *statedb.NewEmptyAccount()); err != nil {
+				return nil, err
+			}
+		}
+
+		return im.evmKeeper.CallEVMWithGasLimit(ctx, im.callbacksABI, sender, owner, gasLimit, method, args...)
+	}
+}
@@ -0,0 +1,224 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package ica_test
+
+import (
+	"errors"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/AizelNetwork/CosmEvm/precompiles/ica"
+	"github.com/AizelNetwork/CosmEvm/x/evm/statedb"
+	evmtypes "github.com/AizelNetwork/CosmEvm/x/evm/types"
+)
+
+// mockEVMKeeper treats every address as a contract and records the gas limit
+// of the callbacks.
+type mockEVMKeeper struct {
+	gasLimit uint64
+	gasUsed  uint64
+	err      error
+	panics   bool
+}
+
+func (k *mockEVMKeeper) GetAccountWithoutBalance(sdk.Context, common.Address) *statedb.Account {
+	return &statedb.Account{CodeHash: common.Hash{1}.Bytes()}
+}
+
+func (k *mockEVMKeeper) SetAccount(sdk.Context, common.Address, statedb.Account) error {
+	return nil
+}
+
+func (k *mockEVMKeeper) CallEVMWithGasLimit(
+	_ sdk.Context, _ abi.ABI, _, _ common.Address, gasLimit uint64, _ string, _ ...interface{},
+) (*evmtypes.MsgEthereumTxResponse, error) {
+	k.gasLimit = gasLimit
+	if k.panics {
+		panic(storetypes.ErrorOutOfGas{Descriptor: "callback"})
+	}
+	if k.err != nil {
+		return nil, k.err
+	}
+	return &evmtypes.MsgEthereumTxResponse{GasUsed: k.gasUsed}, nil
+}
+
+func (s *PrecompileTestSuite) TestOwnerFromPortID() {
+	owner := s.keyring.GetAddr(0)
+	portID, err := ica.NewControllerPortID(owner)
+	s.Require().NoError(err)
+
+	res, err := ica.OwnerFromPortID(portID)
+	s.Require().NoError(err)
+	s.Require().Equal(owner, res)
+
+	_, err = ica.OwnerFromPortID("transfer")
+	s.Require().Error(err)
+}
+
+func (s *PrecompileTestSuite) TestIBCModuleCallbacks() {
+	module := ica.NewIBCModule(s.network.App.EvmKeeper)
+	portID, err := ica.NewControllerPortID(s.keyring.GetAddr(0))
+	s.Require().NoError(err)
+
+	packet := channeltypes.Packet{
+		Sequence:      1,
+		SourcePort:    portID,
+		SourceChannel: "channel-0",
+	}
+
+	s.Run("fail - invalid acknowledgement", func() {
+		ctx := s.network.GetContext()
+		err := module.OnAcknowledgementPacket(ctx, packet, []byte("invalid"), nil)
+		s.Require().Error(err)
+	})
+
+	s.Run("success - acknowledgement for a non-contract owner is skipped", func() {
+		ctx := s.network.GetContext()
+		ack := channeltypes.NewResultAcknowledgement([]byte{1})
+		err := module.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement(), nil)
+		s.Require().NoError(err)
+
+		for _, event := range ctx.EventManager().Events() {
+			s.Require().NotEqual(ica.EventTypeICACallback, event.Type)
+		}
+	})
+
+	s.Run("success - timeout for a non-contract owner is skipped", func() {
+		ctx := s.network.GetContext()
+		err := module.OnTimeoutPacket(ctx, packet, nil)
+		s.Require().NoError(err)
+
+		for _, event := range ctx.EventManager().Events() {
+			s.Require().NotEqual(ica.EventTypeICACallback, event.Type)
+		}
+	})
+
+	s.Run("success - receiving packets is rejected", func() {
+		ack := module.OnRecvPacket(s.network.GetContext(), packet, nil)
+		s.Require().False(ack.Success())
+	})
+}
+
+func (s *PrecompileTestSuite) TestIBCModuleCallbackGas() {
+	portID, err := ica.NewControllerPortID(s.keyring.GetAddr(0))
+	s.Require().NoError(err)
+
+	packet := channeltypes.Packet{
+		Sequence:      1,
+		SourcePort:    portID,
+		SourceChannel: "channel-0",
+	}
+
+	testCases := []struct {
+		name         string
+		keeper       *mockEVMKeeper
+		gasLimit     uint64
+		expGasLimit  uint64
+		expGasUsed   uint64
+		expRevertErr bool
+		expErr       bool
+		expPanic     bool
+	}{
+		{
+			"success - the gas used is charged",
+			&mockEVMKeeper{gasUsed: 50_000},
+			10_000_000,
+			ica.CallbackGasLimit,
+			50_000,
+			false,
+			false,
+			false,
+		},
+		{
+			"success - a reverted callback uses its full gas limit",
+			&mockEVMKeeper{err: errorsmod.Wrap(evmtypes.ErrVMExecution, "out of gas")},
+			10_000_000,
+			ica.CallbackGasLimit,
+			ica.CallbackGasLimit,
+			true,
+			false,
+			false,
+		},
+		{
+			"fail - the relayer doesn't provide the callback gas limit",
+			&mockEVMKeeper{gasUsed: 50_000},
+			ica.CallbackGasLimit - 1,
+			0,
+			0,
+			false,
+			false,
+			true,
+		},
+		{
+			"fail - an error other than a revert fails the packet",
+			&mockEVMKeeper{err: errors.New("internal error")},
+			10_000_000,
+			ica.CallbackGasLimit,
+			0,
+			false,
+			true,
+			false,
+		},
+		{
+			"fail - an out of gas panic fails the packet",
+			&mockEVMKeeper{panics: true},
+			10_000_000,
+			ica.CallbackGasLimit,
+			0,
+			false,
+			false,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			module := ica.NewIBCModule(tc.keeper)
+			ctx := s.network.GetContext().
+				WithGasMeter(storetypes.NewGasMeter(tc.gasLimit)).
+				WithEventManager(sdk.NewEventManager())
+
+			if tc.expPanic {
+				func() {
+					defer func() {
+						_, ok := recover().(storetypes.ErrorOutOfGas)
+						s.Require().True(ok, "expected an out of gas panic")
+					}()
+					_ = module.OnTimeoutPacket(ctx, packet, nil)
+				}()
+				s.Require().Equal(tc.expGasLimit, tc.keeper.gasLimit)
+				return
+			}
+
+			err := module.OnTimeoutPacket(ctx, packet, nil)
+			s.Require().Equal(tc.expGasLimit, tc.keeper.gasLimit)
+			if tc.expErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expGasUsed, ctx.GasMeter().GasConsumed())
+
+			var found bool
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type != ica.EventTypeICACallback {
+					continue
+				}
+				found = true
+				_, hasErr := event.GetAttribute(ica.AttributeKeyCallbackError)
+				s.Require().Equal(tc.expRevertErr, hasErr)
+				gasUsed, ok := event.GetAttribute(ica.AttributeKeyGasUsed)
+				s.Require().True(ok)
+				s.Require().Equal(strconv.FormatUint(tc.expGasUsed, 10), gasUsed.Value)
+			}
+			s.Require().True(found)
+		})
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package ica
+
+import (
+	"embed"
+	"fmt"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/AizelNetwork/CosmEvm/precompiles/common"
+	"github.com/AizelNetwork/CosmEvm/x/evm/core/vm"
+	evmtypes "github.com/AizelNetwork/CosmEvm/x/evm/types"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Embed abi json files to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json callbacks_abi.json
+var f embed.FS
+
+// Precompile defines the precompiled contract for the ICS27 interchain accounts controller.
+type Precompile struct {
+	cmn.Precompile
+	controllerKeeper icacontrollerkeeper.Keeper
+}
+
+// LoadABI loads the ICA ABI from the embedded abi.json file
+// for the ICA precompile.
+func LoadABI() (abi.ABI, error) {
+	return cmn.LoadABI(f, "abi.json")
+}
+
+// LoadCallbacksABI loads the ABI of the callbacks that interchain account owner
+// contracts can implement from the embedded callbacks_abi.json file.
+func LoadCallbacksABI() (abi.ABI, error) {
+	return cmn.LoadABI(f, "callbacks_abi.json")
+}
+
+// NewPrecompile creates a new ICA Precompile instance as a
+// PrecompiledContract interface.
+func NewPrecompile(
+	controllerKeeper icacontrollerkeeper.Keeper,
+) (*Precompile, error) {
+	abi, err := LoadABI()
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Precompile{
+		Precompile: cmn.Precompile{
+			ABI:                  abi,
+			KvGasConfig:          storetypes.KVGasConfig(),
+			TransientKVGasConfig: storetypes.TransientGasConfig(),
+		},
+		controllerKeeper: controllerKeeper,
+	}
+
+	// SetAddress defines the address of the ICA precompiled contract.
+	p.SetAddress(common.HexToAddress(evmtypes.ICAPrecompileAddress))
+
+	return p, nil
+}
+
+// RequiredGas calculates the precompiled contract's base gas rate.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return 0
+	}
+	methodID := input[:4]
+
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method))
+}
+
+// Run executes the precompiled contract ICA methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	ctx, stateDB, snapshot, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// This handles any out of gas errors that may occur during the execution of a precompile tx or query.
+	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
+	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
+
+	switch method.Name {
+	// ICS27 transactions
+	case RegisterInterchainAccountMethod:
+		bz, err = p.RegisterInterchainAccount(ctx, contract, stateDB, method, args)
+	case SendTxMethod:
+		bz, err = p.SendTx(ctx, contract, stateDB, method, args)
+	// ICS27 queries
+	case InterchainAccountAddressMethod:
+		bz, err = p.InterchainAccountAddress(ctx, method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	cost := ctx.GasMeter().GasConsumed() - initialGas
+
+	if !contract.UseGas(cost) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	if err := p.AddJournalEntries(stateDB, snapshot); err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+//
+// Available ICA transactions are:
+//   - RegisterInterchainAccount
+//   - SendTx
+func (Precompile) IsTransaction(method *abi.Method) bool {
+	switch method.Name {
+	case RegisterInterchainAccountMethod, SendTxMethod:
+		return true
+	default:
+		return false
+	}
+}
+
+// Logger returns a precompile-specific logger.
+func (p Precompile) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("evm extension", "ica")
+}
@@ -0,0 +1,37 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package ica
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+const (
+	// InterchainAccountAddressMethod defines the ABI method name for the ICS27
+	// InterchainAccountAddress query.
+	InterchainAccountAddressMethod = "interchainAccountAddress"
+)
+
+// InterchainAccountAddress returns the address of the interchain account owned by
+// the given address on the given connection. It returns an empty string if the
+// interchain account is not registered.
+func (p Precompile) InterchainAccountAddress(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	owner, connectionID, err := ParseInterchainAccountAddressArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	portID, err := NewControllerPortID(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	address, _ := p.controllerKeeper.GetInterchainAccountAddress(ctx, connectionID, portID)
+	return method.Outputs.Pack(address)
+}
@@ -0,0 +1,74 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package ica_test
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/AizelNetwork/CosmEvm/precompiles/common"
+	"github.com/AizelNetwork/CosmEvm/precompiles/ica"
+)
+
+func (s *PrecompileTestSuite) TestInterchainAccountAddress() {
+	method := s.precompile.Methods[ica.InterchainAccountAddressMethod]
+	hostAddress := "cosmos1hostaccount"
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expAddress  string
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} { return []interface{}{} },
+			"",
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - empty owner",
+			func() []interface{} { return []interface{}{common.Address{}, "connection-0"} },
+			"",
+			fmt.Sprintf(ica.ErrInvalidOwner, common.Address{}),
+		},
+		{
+			"success - not registered",
+			func() []interface{} { return []interface{}{s.keyring.GetAddr(0), "connection-0"} },
+			"",
+			"",
+		},
+		{
+			"success - registered",
+			func() []interface{} {
+				owner := s.keyring.GetAddr(0)
+				portID, err := ica.NewControllerPortID(owner)
+				s.Require().NoError(err)
+				s.network.App.ICAControllerKeeper.SetInterchainAccountAddress(s.network.GetContext(), "connection-0", portID, hostAddress)
+				return []interface{}{owner, "connection-0"}
+			},
+			hostAddress,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			args := tc.malleate()
+			bz, err := s.precompile.InterchainAccountAddress(s.network.GetContext(), &method, args)
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expAddress, out[0].(string))
+		})
+	}
+}
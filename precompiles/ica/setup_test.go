@@ -0,0 +1,44 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package ica_test
+
+import (
+	"testing"
+
+	"github.com/AizelNetwork/CosmEvm/precompiles/ica"
+	testkeyring "github.com/AizelNetwork/CosmEvm/testutil/integration/aizel/keyring"
+	"github.com/AizelNetwork/CosmEvm/testutil/integration/aizel/network"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	network *network.UnitTestNetwork
+	keyring testkeyring.Keyring
+
+	precompile *ica.Precompile
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompileTestSuite))
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	keyring := testkeyring.New(2)
+	nw := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+
+	s.network = nw
+	s.keyring = keyring
+
+	var err error
+	if s.precompile, err = ica.NewPrecompile(
+		s.network.App.ICAControllerKeeper,
+	); err != nil {
+		panic(err)
+	}
+}
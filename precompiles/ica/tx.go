@@ -0,0 +1,89 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package ica
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	"github.com/AizelNetwork/CosmEvm/x/evm/core/vm"
+)
+
+const (
+	// RegisterInterchainAccountMethod defines the ABI method name for the ICS27
+	// RegisterInterchainAccount transaction.
+	RegisterInterchainAccountMethod = "registerInterchainAccount"
+	// SendTxMethod defines the ABI method name for the ICS27 SendTx transaction.
+	SendTxMethod = "sendTx"
+)
+
+// RegisterInterchainAccount initiates the registration of an interchain account
+// owned by the contract caller on the given connection.
+func (p Precompile) RegisterInterchainAccount(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	connectionID, version, err := ParseRegisterInterchainAccountArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := contract.CallerAddress
+	portID, err := NewControllerPortID(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: the legacy API is used on purpose, as it enables the controller middleware
+	// so that the packet callbacks are routed to the callbacks IBC module.
+	if err := p.controllerKeeper.RegisterInterchainAccount(ctx, connectionID, NewOwner(owner), version); err != nil { //nolint:staticcheck // see note above
+		return nil, err
+	}
+
+	if err := p.EmitRegisterInterchainAccountEvent(ctx, stateDB, owner, connectionID, portID); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// SendTx sends an interchain account packet to be executed by the interchain
+// account of the contract caller on the given connection.
+func (p Precompile) SendTx(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	connectionID, packetData, timeoutTimestamp, err := ParseSendTxArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if timeoutTimestamp == 0 {
+		timeoutTimestamp = uint64(ctx.BlockTime().UnixNano()) + icatypes.DefaultRelativePacketTimeoutTimestamp //nolint:gosec // G115
+	}
+
+	owner := contract.CallerAddress
+	portID, err := NewControllerPortID(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	sequence, err := p.controllerKeeper.SendTx(ctx, nil, connectionID, portID, packetData, timeoutTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitSendTxEvent(ctx, stateDB, owner, connectionID, sequence); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(sequence)
+}
@@ -0,0 +1,106 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package ica_test
+
+import (
+	"fmt"
+
+	cmn "github.com/AizelNetwork/CosmEvm/precompiles/common"
+	"github.com/AizelNetwork/CosmEvm/precompiles/ica"
+	"github.com/AizelNetwork/CosmEvm/precompiles/testutil"
+)
+
+func (s *PrecompileTestSuite) TestRegisterInterchainAccount() {
+	method := s.precompile.Methods[ica.RegisterInterchainAccountMethod]
+
+	testCases := []struct {
+		name        string
+		args        []interface{}
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			[]interface{}{},
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid connection id",
+			[]interface{}{"channel-0", ""},
+			fmt.Sprintf(ica.ErrInvalidConnectionID, "channel-0"),
+		},
+		{
+			"fail - invalid version type",
+			[]interface{}{"connection-0", 1},
+			fmt.Sprintf(ica.ErrInvalidVersion, 1),
+		},
+		{
+			"fail - connection not found",
+			[]interface{}{"connection-0", ""},
+			"connection-0",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200000)
+
+			_, err := s.precompile.RegisterInterchainAccount(ctx, contract, s.network.GetStateDB(), &method, tc.args)
+			s.Require().ErrorContains(err, tc.errContains)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestSendTx() {
+	method := s.precompile.Methods[ica.SendTxMethod]
+
+	testCases := []struct {
+		name        string
+		args        []interface{}
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			[]interface{}{},
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"fail - invalid connection id",
+			[]interface{}{"", []byte{1}, "", uint64(0)},
+			fmt.Sprintf(ica.ErrInvalidConnectionID, ""),
+		},
+		{
+			"fail - empty packet data",
+			[]interface{}{"connection-0", []byte{}, "", uint64(0)},
+			fmt.Sprintf(ica.ErrInvalidPacketData, []byte{}),
+		},
+		{
+			"fail - invalid memo type",
+			[]interface{}{"connection-0", []byte{1}, 1, uint64(0)},
+			fmt.Sprintf(ica.ErrInvalidMemo, 1),
+		},
+		{
+			"fail - invalid timeout timestamp type",
+			[]interface{}{"connection-0", []byte{1}, "", 1},
+			fmt.Sprintf(ica.ErrInvalidTimeoutTimestamp, 1),
+		},
+		{
+			"fail - no active channel for the caller",
+			[]interface{}{"connection-0", []byte{1}, "", uint64(0)},
+			"failed to retrieve active channel",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200000)
+
+			_, err := s.precompile.SendTx(ctx, contract, s.network.GetStateDB(), &method, tc.args)
+			s.Require().ErrorContains(err, tc.errContains)
+		})
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package ica
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/AizelNetwork/CosmEvm/precompiles/common"
+)
+
+// NewOwner returns the interchain account owner string used by the ICS27
+// controller for the given hex address.
+func NewOwner(addr common.Address) string {
+	return sdk.AccAddress(addr.Bytes()).String()
+}
+
+// NewControllerPortID returns the ICS27 controller port identifier bound for
+// the given hex address.
+func NewControllerPortID(addr common.Address) (string, error) {
+	return icatypes.NewControllerPortID(NewOwner(addr))
+}
+
+// OwnerFromPortID returns the hex address of the interchain account owner
+// from the given ICS27 controller port identifier.
+func OwnerFromPortID(portID string) (common.Address, error) {
+	owner, found := strings.CutPrefix(portID, icatypes.ControllerPortPrefix)
+	if !found {
+		return common.Address{}, fmt.Errorf(ErrInvalidOwner, portID)
+	}
+
+	ownerAddr, err := sdk.AccAddressFromBech32(owner)
+	if err != nil {
+		return common.Address{}, fmt.Errorf(ErrInvalidOwner, owner)
+	}
+
+	return common.BytesToAddress(ownerAddr), nil
+}
+
+// ParseRegisterInterchainAccountArgs parses the arguments of the RegisterInterchainAccount transaction.
+func ParseRegisterInterchainAccountArgs(args []interface{}) (connectionID, version string, err error) {
+	if len(args) != 2 {
+		return "", "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	connectionID, err = parseConnectionID(args[0])
+	if err != nil {
+		return "", "", err
+	}
+
+	version, ok := args[1].(string)
+	if !ok {
+		return "", "", fmt.Errorf(ErrInvalidVersion, args[1])
+	}
+
+	return connectionID, version, nil
+}
+
+// ParseSendTxArgs parses the arguments of the SendTx transaction. The returned
+// timeout timestamp is zero when the default relative timeout should be used.
+func ParseSendTxArgs(args []interface{}) (
+	connectionID string, packetData icatypes.InterchainAccountPacketData, timeoutTimestamp uint64, err error,
+) {
+	if len(args) != 4 {
+		return "", packetData, 0, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	connectionID, err = parseConnectionID(args[0])
+	if err != nil {
+		return "", packetData, 0, err
+	}
+
+	data, ok := args[1].([]byte)
+	if !ok || len(data) == 0 {
+		return "", packetData, 0, fmt.Errorf(ErrInvalidPacketData, args[1])
+	}
+
+	memo, ok := args[2].(string)
+	if !ok {
+		return "", packetData, 0, fmt.Errorf(ErrInvalidMemo, args[2])
+	}
+
+	timeoutTimestamp, ok = args[3].(uint64)
+	if !ok {
+		return "", packetData, 0, fmt.Errorf(ErrInvalidTimeoutTimestamp, args[3])
+	}
+
+	packetData = icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+		Memo: memo,
+	}
+
+	if err := packetData.ValidateBasic(); err != nil {
+		return "", packetData, 0, err
+	}
+
+	return connectionID, packetData, timeoutTimestamp, nil
+}
+
+// ParseInterchainAccountAddressArgs parses the arguments of the InterchainAccountAddress query.
+func ParseInterchainAccountAddressArgs(args []interface{}) (owner common.Address, connectionID string, err error) {
+	if len(args) != 2 {
+		return common.Address{}, "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	owner, ok := args[0].(common.Address)
+	if !ok || owner == (common.Address{}) {
+		return common.Address{}, "", fmt.Errorf(ErrInvalidOwner, args[0])
+	}
+
+	connectionID, err = parseConnectionID(args[1])
+	if err != nil {
+		return common.Address{}, "", err
+	}
+
+	return owner, connectionID, nil
+}
+
+// parseConnectionID parses and validates the connection identifier argument.
+func parseConnectionID(arg interface{}) (string, error) {
+	connectionID, ok := arg.(string)
+	if !ok {
+		return "", fmt.Errorf(ErrInvalidConnectionID, arg)
+	}
+
+	if err := host.ConnectionIdentifierValidator(connectionID); err != nil {
+		return "", fmt.Errorf(ErrInvalidConnectionID, connectionID)
+	}
+
+	return connectionID, nil
+}
@@ -46,7 +46,8 @@ interface ICS20I is IICS20Authorization {
     /// @dev Transfer defines a method for performing an IBC transfer.
     /// @param sourcePort the port on which the packet will be sent
     /// @param sourceChannel the channel by which the packet will be sent
-    /// @param denom the denomination of the Coin to be transferred to the receiver
+    /// @param denom the denomination of the Coin to be transferred to the receiver, or
+    /// the hex address of a registered ERC20 contract to transfer the ERC20 tokens
     /// @param amount the amount of the Coin to be transferred to the receiver
     /// @param sender the hex address of the sender
     /// @param receiver the bech32 address of the receiver
@@ -24,4 +24,6 @@ const (
 	ErrDifferentOriginFromSender = "origin address %s is not the same as sender address %s"
 	// ErrTraceNotFound is raised when the denom trace for the specified request does not exist.
 	ErrTraceNotFound = "denomination trace not found"
+	// ErrTokenPairNotFound is raised when there is no enabled token pair for the ERC20 contract address.
+	ErrTokenPairNotFound = "token pair not found or disabled for ERC20 contract: %s"
 )
@@ -14,6 +14,7 @@ import (
 	evmtypes "github.com/AizelNetwork/CosmEvm/x/evm/types"
 	transferkeeper "github.com/AizelNetwork/CosmEvm/x/ibc/transfer/keeper"
 	stakingkeeper "github.com/AizelNetwork/CosmEvm/x/staking/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	channelkeeper "github.com/cosmos/ibc-go/v8/modules/core/04-channel/keeper"
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -98,6 +99,37 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
 	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
 
+	var entries []cmn.BalanceChangeEntry
+	bz, entries, err = p.Execute(ctx, evm, contract, stateDB, method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	p.SetBalanceChangeEntries(entries...)
+
+	cost := ctx.GasMeter().GasConsumed() - initialGas
+
+	if !contract.UseGas(cost) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	if err := p.AddJournalEntries(stateDB, snapshot); err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
+
+// Execute executes the ICS20 method with the given arguments and returns the
+// balance changes to be added to the stateDB journal.
+func (p Precompile) Execute(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) (bz []byte, entries []cmn.BalanceChangeEntry, err error) {
 	switch method.Name {
 	// TODO Approval transactions => need cosmos-sdk v0.46 & ibc-go v6.2.0
 	// Authorization Methods:
@@ -122,24 +154,10 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 	case authorization.AllowanceMethod:
 		bz, err = p.Allowance(ctx, method, args)
 	default:
-		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
-	}
-
-	if err != nil {
-		return nil, err
-	}
-
-	cost := ctx.GasMeter().GasConsumed() - initialGas
-
-	if !contract.UseGas(cost) {
-		return nil, vm.ErrOutOfGas
-	}
-
-	if err := p.AddJournalEntries(stateDB, snapshot); err != nil {
-		return nil, err
+		return nil, nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
 	}
 
-	return bz, nil
+	return bz, p.BalanceChangeEntries(), err
 }
 
 // IsTransaction checks if the given method name corresponds to a transaction or query.
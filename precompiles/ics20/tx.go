@@ -53,13 +53,29 @@ func (p *Precompile) Transfer(
 		return nil, fmt.Errorf(ErrDifferentOriginFromSender, origin.String(), sender.String())
 	}
 
+	// ERC20 tokens are authorized with the denomination of their Cosmos coin representation
+	authzMsg := msg
+	if common.IsHexAddress(msg.Token.Denom) {
+		pair, found := p.transferKeeper.GetERC20TokenPair(ctx, common.HexToAddress(msg.Token.Denom))
+		if !found {
+			return nil, fmt.Errorf(ErrTokenPairNotFound, msg.Token.Denom)
+		}
+
+		authzMsg = transfertypes.NewMsgTransfer(
+			msg.SourcePort, msg.SourceChannel, sdk.NewCoin(pair.Denom, msg.Token.Amount),
+			msg.Sender, msg.Receiver, msg.TimeoutHeight, msg.TimeoutTimestamp, msg.Memo,
+		)
+	}
+
 	// no need to have authorization when the contract caller is the same as origin (owner of funds)
 	// and the sender is the origin
-	resp, expiration, err := CheckAndAcceptAuthorizationIfNeeded(ctx, contract, origin, p.AuthzKeeper, msg)
+	resp, expiration, err := CheckAndAcceptAuthorizationIfNeeded(ctx, contract, origin, p.AuthzKeeper, authzMsg)
 	if err != nil {
 		return nil, err
 	}
 
+	// NOTE: the transfer keeper sets the token pair denomination on the message
+	// after converting the ERC20 tokens
 	res, err := p.transferKeeper.Transfer(ctx, msg)
 	if err != nil {
 		return nil, err
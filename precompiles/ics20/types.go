@@ -17,6 +17,7 @@ import (
 	"cosmossdk.io/math"
 	"github.com/AizelNetwork/CosmEvm/precompiles/authorization"
 	cmn "github.com/AizelNetwork/CosmEvm/precompiles/common"
+	erc20types "github.com/AizelNetwork/CosmEvm/x/erc20/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
@@ -152,17 +153,29 @@ func NewMsgTransfer(method *abi.Method, args []interface{}) (*transfertypes.MsgT
 		return nil, common.Address{}, fmt.Errorf(ErrInvalidMemo, args[8])
 	}
 
+	// ERC20 contract addresses are not valid coin denominations, so the message
+	// is validated with the denomination of the Cosmos coin representation of
+	// external ERC20 tokens and the contract address is set back afterwards.
+	isERC20 := common.IsHexAddress(denom)
+	if isERC20 {
+		denom = common.HexToAddress(denom).Hex()
+	}
+
 	// Use instance to prevent errors on denom or amount
 	token := sdk.Coin{
 		Denom:  denom,
 		Amount: math.NewIntFromBigInt(amount),
 	}
+	if isERC20 {
+		token.Denom = erc20types.CreateDenom(denom)
+	}
 
 	msg, err := CreateAndValidateMsgTransfer(sourcePort, sourceChannel, token, sdk.AccAddress(sender.Bytes()).String(), receiver, input.TimeoutHeight, timeoutTimestamp, memo)
 	if err != nil {
 		return nil, common.Address{}, err
 	}
 
+	msg.Token.Denom = denom
 	return msg, sender, nil
 }
 
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+import "../common/Types.sol";
+
+/// @dev The IInflation contract's address.
+address constant INFLATION_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000808;
+
+/// @dev The IInflation contract's instance.
+IInflation constant INFLATION_CONTRACT = IInflation(INFLATION_PRECOMPILE_ADDRESS);
+
+/// @dev EpochInfo defines the information of a running epoch.
+struct EpochInfo {
+    /// @dev identifier of the epoch (e.g. "day" or "week")
+    string identifier;
+    /// @dev startTime is the unix timestamp at which the epoch counting started
+    int64 startTime;
+    /// @dev duration of each epoch in seconds
+    int64 duration;
+    /// @dev currentEpoch is the number of the current epoch
+    int64 currentEpoch;
+    /// @dev currentEpochStartTime is the unix timestamp at which the current epoch started
+    int64 currentEpochStartTime;
+    /// @dev epochCountingStarted reflects if the counting for the epoch has started
+    bool epochCountingStarted;
+    /// @dev currentEpochStartHeight is the block height at which the current epoch started
+    int64 currentEpochStartHeight;
+}
+
+/// @author Evmos Team
+/// @title Inflation Precompiled Contract
+/// @dev The interface through which solidity contracts will query the x/inflation
+/// and x/epochs modules.
+/// @custom:address 0x0000000000000000000000000000000000000808
+interface IInflation {
+    /// @dev InflationRate returns the inflation rate of the current period.
+    /// @return inflationRate The inflation rate as a percentage (e.g. 5.0 for 5%)
+    function inflationRate() external view returns (Dec memory inflationRate);
+
+    /// @dev EpochMintProvision returns the amount of tokens minted at the end of each epoch.
+    /// @return epochMintProvision The amount minted per epoch, in the mint denomination
+    function epochMintProvision() external view returns (DecCoin memory epochMintProvision);
+
+    /// @dev CirculatingSupply returns the total supply in circulation.
+    /// @return circulatingSupply The circulating supply, in the mint denomination
+    function circulatingSupply() external view returns (DecCoin memory circulatingSupply);
+
+    /// @dev Period returns the current inflation period.
+    /// @return period The current period
+    function period() external view returns (uint64 period);
+
+    /// @dev CurrentEpoch returns the current epoch number for the given identifier.
+    /// @param identifier The epoch identifier (e.g. "day")
+    /// @return currentEpoch The current epoch number
+    function currentEpoch(string calldata identifier) external view returns (int64 currentEpoch);
+
+    /// @dev EpochInfos returns the information of all running epochs.
+    /// @param pagination Pagination configuration for the query
+    /// @return epochInfos The list of epoch infos
+    /// @return pageResponse Pagination information for the response
+    function epochInfos(
+        PageRequest calldata pagination
+    ) external view returns (EpochInfo[] memory epochInfos, PageResponse memory pageResponse);
+}
@@ -0,0 +1,9 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package inflation
+
+const (
+	// ErrInvalidEpochIdentifier is raised when the epoch identifier is not a string.
+	ErrInvalidEpochIdentifier = "invalid epoch identifier: %v"
+)
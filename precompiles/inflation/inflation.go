@@ -0,0 +1,144 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package inflation
+
+import (
+	"embed"
+	"fmt"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/AizelNetwork/CosmEvm/precompiles/common"
+	epochskeeper "github.com/AizelNetwork/CosmEvm/x/epochs/keeper"
+	"github.com/AizelNetwork/CosmEvm/x/evm/core/vm"
+	evmtypes "github.com/AizelNetwork/CosmEvm/x/evm/types"
+	inflationkeeper "github.com/AizelNetwork/CosmEvm/x/inflation/v1/keeper"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// Precompile defines the precompiled contract for inflation and epochs queries.
+type Precompile struct {
+	cmn.Precompile
+	inflationKeeper inflationkeeper.Keeper
+	epochsKeeper    epochskeeper.Keeper
+}
+
+// LoadABI loads the inflation ABI from the embedded abi.json file
+// for the inflation precompile.
+func LoadABI() (abi.ABI, error) {
+	return cmn.LoadABI(f, "abi.json")
+}
+
+// NewPrecompile creates a new inflation Precompile instance as a
+// PrecompiledContract interface.
+func NewPrecompile(
+	inflationKeeper inflationkeeper.Keeper,
+	epochsKeeper epochskeeper.Keeper,
+) (*Precompile, error) {
+	abi, err := LoadABI()
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Precompile{
+		Precompile: cmn.Precompile{
+			ABI:                  abi,
+			KvGasConfig:          storetypes.KVGasConfig(),
+			TransientKVGasConfig: storetypes.TransientGasConfig(),
+		},
+		inflationKeeper: inflationKeeper,
+		epochsKeeper:    epochsKeeper,
+	}
+
+	// SetAddress defines the address of the inflation precompiled contract.
+	p.SetAddress(common.HexToAddress(evmtypes.InflationPrecompileAddress))
+
+	return p, nil
+}
+
+// RequiredGas calculates the precompiled contract's base gas rate.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return 0
+	}
+	methodID := input[:4]
+
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method))
+}
+
+// Run executes the precompiled contract inflation methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	ctx, stateDB, snapshot, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// This handles any out of gas errors that may occur during the execution of a precompile query.
+	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
+	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
+
+	switch method.Name {
+	// inflation queries
+	case InflationRateMethod:
+		bz, err = p.InflationRate(ctx, method, args)
+	case EpochMintProvisionMethod:
+		bz, err = p.EpochMintProvision(ctx, method, args)
+	case CirculatingSupplyMethod:
+		bz, err = p.CirculatingSupply(ctx, method, args)
+	case PeriodMethod:
+		bz, err = p.Period(ctx, method, args)
+	// epochs queries
+	case CurrentEpochMethod:
+		bz, err = p.CurrentEpoch(ctx, method, args)
+	case EpochInfosMethod:
+		bz, err = p.EpochInfos(ctx, method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	cost := ctx.GasMeter().GasConsumed() - initialGas
+
+	if !contract.UseGas(cost) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	if err := p.AddJournalEntries(stateDB, snapshot); err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+//
+// The inflation precompile is read-only, so all available methods are queries.
+func (Precompile) IsTransaction(_ *abi.Method) bool {
+	return false
+}
+
+// Logger returns a precompile-specific logger.
+func (p Precompile) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("evm extension", "inflation")
+}
@@ -0,0 +1,141 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package inflation
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	cmn "github.com/AizelNetwork/CosmEvm/precompiles/common"
+	inflationtypes "github.com/AizelNetwork/CosmEvm/x/inflation/v1/types"
+)
+
+const (
+	// InflationRateMethod defines the ABI method name for the inflation InflationRate query.
+	InflationRateMethod = "inflationRate"
+	// EpochMintProvisionMethod defines the ABI method name for the inflation EpochMintProvision query.
+	EpochMintProvisionMethod = "epochMintProvision"
+	// CirculatingSupplyMethod defines the ABI method name for the inflation CirculatingSupply query.
+	CirculatingSupplyMethod = "circulatingSupply"
+	// PeriodMethod defines the ABI method name for the inflation Period query.
+	PeriodMethod = "period"
+	// CurrentEpochMethod defines the ABI method name for the epochs CurrentEpoch query.
+	CurrentEpochMethod = "currentEpoch"
+	// EpochInfosMethod defines the ABI method name for the epochs EpochInfos query.
+	EpochInfosMethod = "epochInfos"
+)
+
+// InflationRate implements the query logic for getting the inflation rate of the current period.
+func (p Precompile) InflationRate(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	res, err := p.inflationKeeper.InflationRate(ctx, &inflationtypes.QueryInflationRateRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(NewDec(res.InflationRate))
+}
+
+// EpochMintProvision implements the query logic for getting the amount minted per epoch.
+func (p Precompile) EpochMintProvision(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	res, err := p.inflationKeeper.EpochMintProvision(ctx, &inflationtypes.QueryEpochMintProvisionRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(NewDecCoin(res.EpochMintProvision))
+}
+
+// CirculatingSupply implements the query logic for getting the total supply in circulation.
+func (p Precompile) CirculatingSupply(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	res, err := p.inflationKeeper.CirculatingSupply(ctx, &inflationtypes.QueryCirculatingSupplyRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(NewDecCoin(res.CirculatingSupply))
+}
+
+// Period implements the query logic for getting the current inflation period.
+func (p Precompile) Period(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	res, err := p.inflationKeeper.Period(ctx, &inflationtypes.QueryPeriodRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Period)
+}
+
+// CurrentEpoch implements the query logic for getting the current epoch number
+// of the given epoch identifier.
+func (p Precompile) CurrentEpoch(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseCurrentEpochArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.epochsKeeper.CurrentEpoch(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.CurrentEpoch)
+}
+
+// EpochInfos implements the query logic for getting the information of all running epochs.
+func (p Precompile) EpochInfos(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseEpochInfosArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.epochsKeeper.EpochInfos(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(EpochInfosOutput).FromResponse(res)
+	return method.Outputs.Pack(out.EpochInfos, out.PageResponse)
+}
@@ -0,0 +1,261 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package inflation_test
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	cmn "github.com/AizelNetwork/CosmEvm/precompiles/common"
+	"github.com/AizelNetwork/CosmEvm/precompiles/inflation"
+	"github.com/AizelNetwork/CosmEvm/precompiles/testutil"
+	epochstypes "github.com/AizelNetwork/CosmEvm/x/epochs/types"
+)
+
+func (s *PrecompileTestSuite) TestInflationQueries() {
+	testCases := []struct {
+		name      string
+		method    string
+		postCheck func(out []interface{})
+	}{
+		{
+			"success - inflation rate",
+			inflation.InflationRateMethod,
+			func(out []interface{}) {
+				ctx := s.network.GetContext()
+				mintDenom := s.network.App.InflationKeeper.GetParams(ctx).MintDenom
+				expRate := s.network.App.InflationKeeper.GetInflationRate(ctx, mintDenom)
+
+				rate := abi.ConvertType(out[0], new(cmn.Dec)).(*cmn.Dec)
+				s.Require().Equal(expRate.BigInt(), rate.Value)
+				s.Require().Equal(uint8(math.LegacyPrecision), rate.Precision)
+			},
+		},
+		{
+			"success - epoch mint provision",
+			inflation.EpochMintProvisionMethod,
+			func(out []interface{}) {
+				ctx := s.network.GetContext()
+				expProvision := s.network.App.InflationKeeper.GetEpochMintProvision(ctx)
+
+				provision := abi.ConvertType(out[0], new(cmn.DecCoin)).(*cmn.DecCoin)
+				s.Require().Equal(s.network.App.InflationKeeper.GetParams(ctx).MintDenom, provision.Denom)
+				s.Require().Equal(expProvision.BigInt(), provision.Amount)
+			},
+		},
+		{
+			"success - circulating supply",
+			inflation.CirculatingSupplyMethod,
+			func(out []interface{}) {
+				ctx := s.network.GetContext()
+				mintDenom := s.network.App.InflationKeeper.GetParams(ctx).MintDenom
+				expSupply := s.network.App.InflationKeeper.GetCirculatingSupply(ctx, mintDenom)
+
+				supply := abi.ConvertType(out[0], new(cmn.DecCoin)).(*cmn.DecCoin)
+				s.Require().Equal(mintDenom, supply.Denom)
+				s.Require().Equal(expSupply.BigInt(), supply.Amount)
+				s.Require().True(supply.Amount.Sign() > 0)
+			},
+		},
+		{
+			"success - period",
+			inflation.PeriodMethod,
+			func(out []interface{}) {
+				expPeriod := s.network.App.InflationKeeper.GetPeriod(s.network.GetContext())
+				s.Require().Equal(expPeriod, out[0].(uint64))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			method := s.precompile.Methods[tc.method]
+
+			_, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200000)
+
+			var (
+				bz  []byte
+				err error
+			)
+			switch tc.method {
+			case inflation.InflationRateMethod:
+				bz, err = s.precompile.InflationRate(ctx, &method, []interface{}{})
+			case inflation.EpochMintProvisionMethod:
+				bz, err = s.precompile.EpochMintProvision(ctx, &method, []interface{}{})
+			case inflation.CirculatingSupplyMethod:
+				bz, err = s.precompile.CirculatingSupply(ctx, &method, []interface{}{})
+			case inflation.PeriodMethod:
+				bz, err = s.precompile.Period(ctx, &method, []interface{}{})
+			}
+			s.Require().NoError(err)
+
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+			s.Require().Len(out, 1)
+			tc.postCheck(out)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestInflationQueriesInvalidArgs() {
+	for _, name := range []string{
+		inflation.InflationRateMethod,
+		inflation.EpochMintProvisionMethod,
+		inflation.CirculatingSupplyMethod,
+		inflation.PeriodMethod,
+	} {
+		method := s.precompile.Methods[name]
+		args := []interface{}{"extra"}
+		ctx := s.network.GetContext()
+
+		var err error
+		switch name {
+		case inflation.InflationRateMethod:
+			_, err = s.precompile.InflationRate(ctx, &method, args)
+		case inflation.EpochMintProvisionMethod:
+			_, err = s.precompile.EpochMintProvision(ctx, &method, args)
+		case inflation.CirculatingSupplyMethod:
+			_, err = s.precompile.CirculatingSupply(ctx, &method, args)
+		case inflation.PeriodMethod:
+			_, err = s.precompile.Period(ctx, &method, args)
+		}
+		s.Require().ErrorContains(err, fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 0, 1), name)
+	}
+}
+
+func (s *PrecompileTestSuite) TestCurrentEpoch() {
+	method := s.precompile.Methods[inflation.CurrentEpochMethod]
+
+	testCases := []struct {
+		name        string
+		args        []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			[]interface{}{},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - invalid identifier type",
+			[]interface{}{uint64(1)},
+			true,
+			fmt.Sprintf(inflation.ErrInvalidEpochIdentifier, uint64(1)),
+		},
+		{
+			"fail - blank identifier",
+			[]interface{}{" "},
+			true,
+			"blank epoch identifier",
+		},
+		{
+			"fail - unknown identifier",
+			[]interface{}{"unknown"},
+			true,
+			"epoch info not found",
+		},
+		{
+			"success - day epoch",
+			[]interface{}{epochstypes.DayEpochID},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			_, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200000)
+
+			bz, err := s.precompile.CurrentEpoch(ctx, &method, tc.args)
+			if tc.expError {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+
+			info, found := s.network.App.EpochsKeeper.GetEpochInfo(ctx, epochstypes.DayEpochID)
+			s.Require().True(found)
+			s.Require().Equal(info.CurrentEpoch, out[0].(int64))
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestEpochInfos() {
+	method := s.precompile.Methods[inflation.EpochInfosMethod]
+
+	testCases := []struct {
+		name        string
+		args        []interface{}
+		postCheck   func(out *inflation.EpochInfosOutput)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			[]interface{}{},
+			nil,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"success - all epoch infos",
+			[]interface{}{query.PageRequest{Limit: 10, CountTotal: true}},
+			func(out *inflation.EpochInfosOutput) {
+				expEpochs := s.network.App.EpochsKeeper.AllEpochInfos(s.network.GetContext())
+				s.Require().Len(out.EpochInfos, len(expEpochs))
+				s.Require().Equal(uint64(len(expEpochs)), out.PageResponse.Total)
+				for i, epoch := range expEpochs {
+					s.Require().Equal(epoch.Identifier, out.EpochInfos[i].Identifier)
+					s.Require().Equal(int64(epoch.Duration.Seconds()), out.EpochInfos[i].Duration)
+					s.Require().Equal(epoch.CurrentEpoch, out.EpochInfos[i].CurrentEpoch)
+				}
+			},
+			false,
+			"",
+		},
+		{
+			"success - paginated epoch infos",
+			[]interface{}{query.PageRequest{Limit: 1, CountTotal: true}},
+			func(out *inflation.EpochInfosOutput) {
+				s.Require().Len(out.EpochInfos, 1)
+				s.Require().NotNil(out.PageResponse.NextKey)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			_, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200000)
+
+			bz, err := s.precompile.EpochInfos(ctx, &method, tc.args)
+			if tc.expError {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			var out inflation.EpochInfosOutput
+			err = s.precompile.UnpackIntoInterface(&out, inflation.EpochInfosMethod, bz)
+			s.Require().NoError(err)
+			tc.postCheck(&out)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package inflation_test
+
+import (
+	"testing"
+
+	"github.com/AizelNetwork/CosmEvm/precompiles/inflation"
+	testkeyring "github.com/AizelNetwork/CosmEvm/testutil/integration/aizel/keyring"
+	"github.com/AizelNetwork/CosmEvm/testutil/integration/aizel/network"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	network *network.UnitTestNetwork
+	keyring testkeyring.Keyring
+
+	precompile *inflation.Precompile
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompileTestSuite))
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	keyring := testkeyring.New(2)
+	nw := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+
+	s.network = nw
+	s.keyring = keyring
+
+	var err error
+	if s.precompile, err = inflation.NewPrecompile(
+		s.network.App.InflationKeeper,
+		s.network.App.EpochsKeeper,
+	); err != nil {
+		panic(err)
+	}
+}
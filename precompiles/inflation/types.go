@@ -0,0 +1,115 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package inflation
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	cmn "github.com/AizelNetwork/CosmEvm/precompiles/common"
+	epochstypes "github.com/AizelNetwork/CosmEvm/x/epochs/types"
+)
+
+// EpochInfo represents the Solidity EpochInfo struct.
+type EpochInfo struct {
+	Identifier              string `abi:"identifier"`
+	StartTime               int64  `abi:"startTime"`
+	Duration                int64  `abi:"duration"`
+	CurrentEpoch            int64  `abi:"currentEpoch"`
+	CurrentEpochStartTime   int64  `abi:"currentEpochStartTime"`
+	EpochCountingStarted    bool   `abi:"epochCountingStarted"`
+	CurrentEpochStartHeight int64  `abi:"currentEpochStartHeight"`
+}
+
+// EpochInfosInput defines the input for the EpochInfos query.
+type EpochInfosInput struct {
+	Pagination query.PageRequest `abi:"pagination"`
+}
+
+// EpochInfosOutput defines the output for the EpochInfos query.
+type EpochInfosOutput struct {
+	EpochInfos   []EpochInfo        `abi:"epochInfos"`
+	PageResponse query.PageResponse `abi:"pageResponse"`
+}
+
+// NewDec converts a legacy decimal to its EVM representation.
+func NewDec(dec math.LegacyDec) cmn.Dec {
+	return cmn.Dec{
+		Value:     dec.BigInt(),
+		Precision: math.LegacyPrecision,
+	}
+}
+
+// NewDecCoin converts a decimal coin to its EVM representation, keeping
+// the full decimal precision of the amount.
+func NewDecCoin(coin sdk.DecCoin) cmn.DecCoin {
+	return cmn.DecCoin{
+		Denom:     coin.Denom,
+		Amount:    coin.Amount.BigInt(),
+		Precision: math.LegacyPrecision,
+	}
+}
+
+// ParseCurrentEpochArgs parses the arguments for the CurrentEpoch query.
+func ParseCurrentEpochArgs(args []interface{}) (*epochstypes.QueryCurrentEpochRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	identifier, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidEpochIdentifier, args[0])
+	}
+
+	if err := epochstypes.ValidateEpochIdentifierString(identifier); err != nil {
+		return nil, err
+	}
+
+	return &epochstypes.QueryCurrentEpochRequest{
+		Identifier: identifier,
+	}, nil
+}
+
+// ParseEpochInfosArgs parses the arguments for the EpochInfos query.
+func ParseEpochInfosArgs(method *abi.Method, args []interface{}) (*epochstypes.QueryEpochsInfoRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	var input EpochInfosInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to EpochInfosInput: %s", err)
+	}
+
+	return &epochstypes.QueryEpochsInfoRequest{
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// FromResponse populates the EpochInfosOutput from a QueryEpochsInfoResponse.
+func (eo *EpochInfosOutput) FromResponse(res *epochstypes.QueryEpochsInfoResponse) *EpochInfosOutput {
+	eo.EpochInfos = make([]EpochInfo, len(res.Epochs))
+	for i, epoch := range res.Epochs {
+		eo.EpochInfos[i] = EpochInfo{
+			Identifier:              epoch.Identifier,
+			StartTime:               epoch.StartTime.Unix(),
+			Duration:                int64(epoch.Duration.Seconds()),
+			CurrentEpoch:            epoch.CurrentEpoch,
+			CurrentEpochStartTime:   epoch.CurrentEpochStartTime.Unix(),
+			EpochCountingStarted:    epoch.EpochCountingStarted,
+			CurrentEpochStartHeight: epoch.CurrentEpochStartHeight,
+		}
+	}
+
+	if res.Pagination != nil {
+		eo.PageResponse.Total = res.Pagination.Total
+		eo.PageResponse.NextKey = res.Pagination.NextKey
+	}
+
+	return eo
+}
@@ -116,6 +116,19 @@ struct UnbondingDelegationOutput {
     UnbondingDelegationEntry[] entries;
 }
 
+/// @dev Represents a delegation of a delegator to a validator.
+struct Delegation {
+    string delegatorAddress;
+    string validatorAddress;
+    uint256 shares;
+}
+
+/// @dev Represents a delegation together with the balance of the delegation.
+struct DelegationResponse {
+    Delegation delegation;
+    Coin balance;
+}
+
 /// @dev The status of the validator.
 enum BondStatus {
     Unspecified,
@@ -217,6 +230,22 @@ interface StakingI is authorization.AuthorizationI {
         uint256 creationHeight
     ) external returns (bool success);
 
+    /// @dev Defines a method for transferring a delegation from a delegator to a recipient.
+    /// The delegated tokens remain bonded to the validator and are not subject to the unbonding period.
+    /// Calls from a contract different from the delegator require an undelegate authorization.
+    /// @param delegatorAddress The address of the delegator
+    /// @param recipientAddress The address that receives the delegation
+    /// @param validatorAddress The address of the validator
+    /// @param amount The amount of the bond denomination to be transferred.
+    /// This amount should use the bond denomination precision stored in the bank metadata.
+    /// @return success Whether or not the delegation transfer was successful
+    function transferDelegation(
+        address delegatorAddress,
+        address recipientAddress,
+        string memory validatorAddress,
+        uint256 amount
+    ) external returns (bool success);
+
     /// @dev Queries the given amount of the bond denomination to a validator.
     /// @param delegatorAddress The address of the delegator.
     /// @param validatorAddress The address of the validator.
@@ -296,6 +325,21 @@ interface StakingI is authorization.AuthorizationI {
             PageResponse calldata pageResponse
         );
 
+    /// @dev Queries all delegations of a given delegator.
+    /// @param delegatorAddress The address of the delegator.
+    /// @param pageRequest Defines an optional pagination for the request.
+    /// @return response The delegations of the delegator with their balances.
+    function delegationsOf(
+        address delegatorAddress,
+        PageRequest calldata pageRequest
+    )
+        external
+        view
+        returns (
+            DelegationResponse[] calldata response,
+            PageResponse calldata pageResponse
+        );
+
     /// @dev CreateValidator defines an Event emitted when a create a new validator.
     /// @param validatorAddress The address of the validator
     /// @param value The amount of coin being self delegated
@@ -355,6 +399,22 @@ interface StakingI is authorization.AuthorizationI {
         uint256 completionTime
     );
 
+    /// @dev TransferDelegation defines an Event emitted when a given amount of delegated tokens
+    /// is transferred from the delegator address to the recipient address.
+    /// @param delegatorAddress The address of the delegator
+    /// @param recipientAddress The address of the recipient
+    /// @param validatorAddress The address of the validator
+    /// @param amount The amount of bond denomination being transferred
+    /// This amount has the bond denomination precision stored in the bank metadata.
+    /// @param newShares The new delegation shares held by the recipient
+    event TransferDelegation(
+        address indexed delegatorAddress,
+        address indexed recipientAddress,
+        address indexed validatorAddress,
+        uint256 amount,
+        uint256 newShares
+    );
+
     /// @dev CancelUnbondingDelegation defines an Event emitted when a given amount of tokens
     /// that are in the process of unbonding from the validator address are bonded again.
     /// @param delegatorAddress The address of the delegator
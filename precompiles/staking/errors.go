@@ -12,6 +12,8 @@ const (
 	ErrNoDelegationFound = "delegation with delegator %s not found for validator %s"
 	// ErrDifferentOriginFromValidator is raised when the origin address is not the same as the validator address.
 	ErrDifferentOriginFromValidator = "origin address %s is not the same as validator operator address %s"
+	// ErrInvalidRecipient is raised when the recipient address of a delegation transfer is invalid.
+	ErrInvalidRecipient = "invalid recipient address: %v"
 	// ErrCannotCallFromContract is raised when a function cannot be called from a smart contract.
 	ErrCannotCallFromContract = "this method can only be called directly to the precompile, not from a smart contract"
 )
@@ -8,6 +8,7 @@ import (
 	"math/big"
 	"reflect"
 
+	"cosmossdk.io/math"
 	"github.com/AizelNetwork/CosmEvm/precompiles/authorization"
 
 	cmn "github.com/AizelNetwork/CosmEvm/precompiles/common"
@@ -32,6 +33,8 @@ const (
 	EventTypeRedelegate = "Redelegate"
 	// EventTypeCancelUnbondingDelegation defines the event type for the staking CancelUnbondingDelegation transaction.
 	EventTypeCancelUnbondingDelegation = "CancelUnbondingDelegation"
+	// EventTypeTransferDelegation defines the event type for the staking TransferDelegation transaction.
+	EventTypeTransferDelegation = "TransferDelegation"
 )
 
 // EmitApprovalEvent creates a new approval event emitted on an Approve, IncreaseAllowance and DecreaseAllowance transactions.
@@ -321,6 +324,35 @@ func (p Precompile) EmitCancelUnbondingDelegationEvent(ctx sdk.Context, stateDB
 	return nil
 }
 
+// EmitTransferDelegationEvent creates a new transfer delegation event emitted on a TransferDelegation transaction.
+func (p Precompile) EmitTransferDelegationEvent(ctx sdk.Context, stateDB vm.StateDB, delegatorAddr common.Address, args *TransferDelegationArgs, newShares math.LegacyDec) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeTransferDelegation]
+	topics, err := p.createStakingTxTopics(4, event, delegatorAddr, args.RecipientAddress)
+	if err != nil {
+		return err
+	}
+
+	topics[3], err = cmn.MakeTopic(common.BytesToAddress(args.ValidatorAddress.Bytes()))
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data
+	var b bytes.Buffer
+	b.Write(cmn.PackNum(reflect.ValueOf(args.Amount.BigInt())))
+	b.Write(cmn.PackNum(reflect.ValueOf(newShares.BigInt())))
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        b.Bytes(),
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
+
 // createStakingTxTopics creates the topics for staking transactions Delegate, Undelegate, Redelegate and CancelUnbondingDelegation.
 func (p Precompile) createStakingTxTopics(topicsLen uint64, event abi.Event, delegatorAddr common.Address, validatorAddr common.Address) ([]common.Hash, error) {
 	topics := make([]common.Hash, topicsLen)
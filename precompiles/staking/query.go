@@ -36,6 +36,9 @@ const (
 	// RedelegationsMethod defines the ABI method name for the staking
 	// Redelegations query.
 	RedelegationsMethod = "redelegations"
+	// DelegationsOfMethod defines the ABI method name for the staking
+	// DelegatorDelegations query.
+	DelegationsOfMethod = "delegationsOf"
 )
 
 // Delegation returns the delegation that a delegator has with a specific validator.
@@ -200,6 +203,30 @@ func (p Precompile) Redelegations(
 	return out.Pack(method.Outputs)
 }
 
+// DelegationsOf returns all the delegations of the given delegator with pagination.
+func (p Precompile) DelegationsOf(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewDelegatorDelegationsRequest(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	queryServer := stakingkeeper.Querier{Keeper: p.stakingKeeper.Keeper}
+
+	res, err := queryServer.DelegatorDelegations(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(DelegationsOfOutput).FromResponse(res)
+
+	return out.Pack(method.Outputs)
+}
+
 // Allowance returns the remaining allowance of a grantee to the contract.
 func (p Precompile) Allowance(
 	ctx sdk.Context,
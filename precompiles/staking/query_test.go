@@ -787,3 +787,122 @@ func (s *PrecompileTestSuite) TestAllowance() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestDelegationsOf() {
+	method := s.precompile.Methods[staking.DelegationsOfMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(bz []byte)
+		gas         uint64
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func([]byte) {},
+			100000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - empty delegator address",
+			func() []interface{} {
+				return []interface{}{
+					common.Address{},
+					query.PageRequest{},
+				}
+			},
+			func([]byte) {},
+			100000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidDelegator, common.Address{}),
+		},
+		{
+			"success - delegator without delegations",
+			func() []interface{} {
+				return []interface{}{
+					testutiltx.GenerateAddress(),
+					query.PageRequest{},
+				}
+			},
+			func(data []byte) {
+				var out staking.DelegationsOfOutput
+				err := s.precompile.UnpackIntoInterface(&out, staking.DelegationsOfMethod, data)
+				s.Require().NoError(err, "failed to unpack output")
+				s.Require().Empty(out.Response)
+			},
+			100000,
+			false,
+			"",
+		},
+		{
+			"success - all delegations of the delegator",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					query.PageRequest{},
+				}
+			},
+			func(data []byte) {
+				var out staking.DelegationsOfOutput
+				err := s.precompile.UnpackIntoInterface(&out, staking.DelegationsOfMethod, data)
+				s.Require().NoError(err, "failed to unpack output")
+				s.Require().Len(out.Response, len(s.network.GetValidators()))
+				for _, res := range out.Response {
+					s.Require().Equal(s.keyring.GetAccAddr(0).String(), res.Delegation.DelegatorAddress)
+					s.Require().Equal(s.bondDenom, res.Balance.Denom)
+					s.Require().Equal(big.NewInt(1e18), res.Balance.Amount)
+				}
+			},
+			100000,
+			false,
+			"",
+		},
+		{
+			"success - delegations of the delegator w/pagination",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					query.PageRequest{
+						Limit:      1,
+						CountTotal: true,
+					},
+				}
+			},
+			func(data []byte) {
+				var out staking.DelegationsOfOutput
+				err := s.precompile.UnpackIntoInterface(&out, staking.DelegationsOfMethod, data)
+				s.Require().NoError(err, "failed to unpack output")
+				s.Require().Len(out.Response, 1)
+				s.Require().Equal(uint64(len(s.network.GetValidators())), out.PageResponse.Total)
+				s.Require().NotEmpty(out.PageResponse.NextKey)
+			},
+			100000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+			contract := vm.NewContract(vm.AccountRef(s.keyring.GetAddr(0)), s.precompile, big.NewInt(0), tc.gas)
+
+			bz, err := s.precompile.DelegationsOf(s.network.GetContext(), &method, contract, tc.malleate())
+
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NotNil(bz)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
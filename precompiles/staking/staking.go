@@ -95,6 +95,37 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
 	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
 
+	var entries []cmn.BalanceChangeEntry
+	bz, entries, err = p.Execute(ctx, evm, contract, stateDB, method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	p.SetBalanceChangeEntries(entries...)
+
+	cost := ctx.GasMeter().GasConsumed() - initialGas
+
+	if !contract.UseGas(cost) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	if err := p.AddJournalEntries(stateDB, snapshot); err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
+
+// Execute executes the staking method with the given arguments and returns the
+// balance changes to be added to the stateDB journal.
+func (p Precompile) Execute(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) (bz []byte, entries []cmn.BalanceChangeEntry, err error) {
 	switch method.Name {
 	// Authorization transactions
 	case authorization.ApproveMethod:
@@ -118,6 +149,8 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		bz, err = p.Redelegate(ctx, evm.Origin, contract, stateDB, method, args)
 	case CancelUnbondingDelegationMethod:
 		bz, err = p.CancelUnbondingDelegation(ctx, evm.Origin, contract, stateDB, method, args)
+	case TransferDelegationMethod:
+		bz, err = p.TransferDelegation(ctx, evm.Origin, contract, stateDB, method, args)
 	// Staking queries
 	case DelegationMethod:
 		bz, err = p.Delegation(ctx, contract, method, args)
@@ -131,26 +164,14 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		bz, err = p.Redelegation(ctx, method, contract, args)
 	case RedelegationsMethod:
 		bz, err = p.Redelegations(ctx, method, contract, args)
+	case DelegationsOfMethod:
+		bz, err = p.DelegationsOf(ctx, method, contract, args)
 	// Authorization queries
 	case authorization.AllowanceMethod:
 		bz, err = p.Allowance(ctx, method, contract, args)
 	}
 
-	if err != nil {
-		return nil, err
-	}
-
-	cost := ctx.GasMeter().GasConsumed() - initialGas
-
-	if !contract.UseGas(cost) {
-		return nil, vm.ErrOutOfGas
-	}
-
-	if err := p.AddJournalEntries(stateDB, snapshot); err != nil {
-		return nil, err
-	}
-
-	return bz, nil
+	return bz, p.BalanceChangeEntries(), err
 }
 
 // IsTransaction checks if the given method name corresponds to a transaction or query.
@@ -162,6 +183,7 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 //   - Undelegate
 //   - Redelegate
 //   - CancelUnbondingDelegation
+//   - TransferDelegation
 //
 // Available authorization transactions are:
 //   - Approve
@@ -176,6 +198,7 @@ func (Precompile) IsTransaction(method *abi.Method) bool {
 		UndelegateMethod,
 		RedelegateMethod,
 		CancelUnbondingDelegationMethod,
+		TransferDelegationMethod,
 		authorization.ApproveMethod,
 		authorization.RevokeMethod,
 		authorization.IncreaseAllowanceMethod,
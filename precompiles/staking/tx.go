@@ -37,6 +37,9 @@ const (
 	// CancelUnbondingDelegationMethod defines the ABI method name for the staking
 	// CancelUnbondingDelegation transaction.
 	CancelUnbondingDelegationMethod = "cancelUnbondingDelegation"
+	// TransferDelegationMethod defines the ABI method name for the staking
+	// TransferDelegation transaction.
+	TransferDelegationMethod = "transferDelegation"
 )
 
 const (
@@ -500,3 +503,104 @@ func (p Precompile) CancelUnbondingDelegation(
 
 	return method.Outputs.Pack(true)
 }
+
+// TransferDelegation transfers a delegation on a validator from the delegator to a recipient
+// without going through the unbonding period. When called from a contract, an undelegate
+// authorization from the delegator to the caller is required.
+func (p Precompile) TransferDelegation(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	transferArgs, err := NewTransferDelegationArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	delegatorHexAddr := transferArgs.DelegatorAddress
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ delegator_address: %s, recipient_address: %s, validator_address: %s, amount: %s }",
+			delegatorHexAddr,
+			transferArgs.RecipientAddress,
+			transferArgs.ValidatorAddress,
+			transferArgs.Amount,
+		),
+	)
+
+	bondDenom, err := p.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		// stakeAuthz is the authorization grant for the caller and the delegator address
+		stakeAuthz *stakingtypes.StakeAuthorization
+		// expiration is the expiration time of the authorization grant
+		expiration *time.Time
+
+		// isCallerOrigin is true when the contract caller is the same as the origin
+		isCallerOrigin = contract.CallerAddress == origin
+		// isCallerDelegator is true when the contract caller is the same as the delegator
+		isCallerDelegator = contract.CallerAddress == delegatorHexAddr
+	)
+
+	// The provided delegator address should always be equal to the origin address.
+	// In case the contract caller address is the same as the delegator address provided,
+	// update the delegator address to be equal to the origin address.
+	// Otherwise, if the provided delegator address is different from the origin address,
+	// return an error because is a forbidden operation
+	if isCallerDelegator {
+		delegatorHexAddr = origin
+	} else if origin != delegatorHexAddr {
+		return nil, fmt.Errorf(ErrDifferentOriginFromDelegator, origin.String(), delegatorHexAddr.String())
+	}
+
+	// The delegation leaves the delegator account as in an undelegation,
+	// so the undelegate authorization is used to approve the transfer.
+	msg := &stakingtypes.MsgUndelegate{
+		DelegatorAddress: sdk.AccAddress(delegatorHexAddr.Bytes()).String(),
+		ValidatorAddress: transferArgs.ValidatorAddress.String(),
+		Amount:           sdk.NewCoin(bondDenom, transferArgs.Amount),
+	}
+
+	// no need to have authorization when the contract caller is the same as origin (owner of funds)
+	if !isCallerOrigin {
+		// Check if the authorization grant exists for the caller and the origin
+		stakeAuthz, expiration, err = authorization.CheckAuthzAndAllowanceForGranter(ctx, p.AuthzKeeper, contract.CallerAddress, delegatorHexAddr, &msg.Amount, UndelegateMsg)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	newShares, err := p.stakingKeeper.TransferDelegation(
+		ctx,
+		delegatorHexAddr.Bytes(),
+		transferArgs.RecipientAddress.Bytes(),
+		transferArgs.ValidatorAddress,
+		transferArgs.Amount,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only update the authorization if the contract caller is different from the origin
+	if !isCallerOrigin {
+		if err := p.UpdateStakingAuthorization(ctx, contract.CallerAddress, delegatorHexAddr, stakeAuthz, expiration, UndelegateMsg, msg); err != nil {
+			return nil, err
+		}
+	}
+
+	// Emit the event for the transfer delegation transaction
+	if err = p.EmitTransferDelegationEvent(ctx, stateDB, delegatorHexAddr, transferArgs, newShares); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/AizelNetwork/CosmEvm/x/evm/core/vm"
 	"github.com/AizelNetwork/CosmEvm/x/evm/statedb"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 )
@@ -1405,3 +1406,160 @@ func (s *PrecompileTestSuite) TestCancelUnbondingDelegation() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestTransferDelegation() {
+	var (
+		ctx  sdk.Context
+		stDB *statedb.StateDB
+	)
+	method := s.precompile.Methods[staking.TransferDelegationMethod]
+	transferAmt := big.NewInt(5e17)
+
+	testCases := []struct {
+		name        string
+		malleate    func(delegator, grantee, recipient testkeyring.Key, operatorAddress string) (common.Address, []interface{})
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func(delegator, _, _ testkeyring.Key, _ string) (common.Address, []interface{}) {
+				return delegator.Addr, []interface{}{}
+			},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"fail - invalid recipient address",
+			func(delegator, _, _ testkeyring.Key, operatorAddress string) (common.Address, []interface{}) {
+				return delegator.Addr, []interface{}{
+					delegator.Addr,
+					common.Address{},
+					operatorAddress,
+					transferAmt,
+				}
+			},
+			200000,
+			true,
+			fmt.Sprintf(staking.ErrInvalidRecipient, common.Address{}),
+		},
+		{
+			"fail - different origin than delegator",
+			func(delegator, _, recipient testkeyring.Key, operatorAddress string) (common.Address, []interface{}) {
+				return delegator.Addr, []interface{}{
+					aizelutiltx.GenerateAddress(),
+					recipient.Addr,
+					operatorAddress,
+					transferAmt,
+				}
+			},
+			200000,
+			true,
+			"is not the same as delegator",
+		},
+		{
+			"fail - contract caller without authorization",
+			func(delegator, grantee, recipient testkeyring.Key, operatorAddress string) (common.Address, []interface{}) {
+				return grantee.Addr, []interface{}{
+					delegator.Addr,
+					recipient.Addr,
+					operatorAddress,
+					transferAmt,
+				}
+			},
+			200000,
+			true,
+			"does not exist or is expired",
+		},
+		{
+			"success - delegator as caller",
+			func(delegator, _, recipient testkeyring.Key, operatorAddress string) (common.Address, []interface{}) {
+				return delegator.Addr, []interface{}{
+					delegator.Addr,
+					recipient.Addr,
+					operatorAddress,
+					transferAmt,
+				}
+			},
+			200000,
+			false,
+			"",
+		},
+		{
+			"success - contract caller with undelegate authorization",
+			func(delegator, grantee, recipient testkeyring.Key, operatorAddress string) (common.Address, []interface{}) {
+				valAddr, err := sdk.ValAddressFromBech32(operatorAddress)
+				s.Require().NoError(err)
+				stakeAuthz, err := stakingtypes.NewStakeAuthorization([]sdk.ValAddress{valAddr}, nil, staking.UndelegateAuthz, nil)
+				s.Require().NoError(err)
+				expiration := ctx.BlockTime().Add(cmn.DefaultExpirationDuration).UTC()
+				err = s.network.App.AuthzKeeper.SaveGrant(ctx, grantee.AccAddr, delegator.AccAddr, stakeAuthz, &expiration)
+				s.Require().NoError(err)
+				return grantee.Addr, []interface{}{
+					delegator.Addr,
+					recipient.Addr,
+					operatorAddress,
+					transferAmt,
+				}
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			stDB = s.network.GetStateDB()
+
+			delegator := s.keyring.GetKey(0)
+			grantee := s.keyring.GetKey(1)
+			recipient := s.keyring.GetKey(1)
+			operatorAddress := s.network.GetValidators()[0].OperatorAddress
+
+			valAddr, err := sdk.ValAddressFromBech32(operatorAddress)
+			s.Require().NoError(err)
+
+			caller, transferArgs := tc.malleate(delegator, grantee, recipient, operatorAddress)
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, caller, s.precompile, tc.gas)
+
+			bz, err := s.precompile.TransferDelegation(ctx, delegator.Addr, contract, stDB, &method, transferArgs)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+			success, err := s.precompile.Unpack(staking.TransferDelegationMethod, bz)
+			s.Require().NoError(err)
+			s.Require().Equal(true, success[0])
+
+			// the recipient delegation corresponds to the transferred amount
+			delegation, err := s.network.App.StakingKeeper.GetDelegation(ctx, recipient.AccAddr, valAddr)
+			s.Require().NoError(err)
+			validator, err := s.network.App.StakingKeeper.GetValidator(ctx, valAddr)
+			s.Require().NoError(err)
+			s.Require().Equal(math.NewIntFromBigInt(transferAmt), validator.TokensFromShares(delegation.Shares).TruncateInt())
+
+			// no unbonding delegation is created
+			undelegations, err := s.network.App.StakingKeeper.GetAllUnbondingDelegations(ctx, delegator.AccAddr)
+			s.Require().NoError(err)
+			s.Require().Empty(undelegations)
+
+			// check the event emitted
+			log := stDB.Logs()[0]
+			s.Require().Equal(log.Address, s.precompile.Address())
+			event := s.precompile.ABI.Events[staking.EventTypeTransferDelegation]
+			s.Require().Equal(event.ID, common.HexToHash(log.Topics[0].Hex()))
+			s.Require().Len(log.Topics, 4)
+		})
+	}
+}
@@ -67,6 +67,15 @@ type EventRedelegate struct {
 	CompletionTime      *big.Int
 }
 
+// EventTransferDelegation defines the event data for the staking TransferDelegation transaction.
+type EventTransferDelegation struct {
+	DelegatorAddress common.Address
+	RecipientAddress common.Address
+	ValidatorAddress common.Address
+	Amount           *big.Int
+	NewShares        *big.Int
+}
+
 // EventCancelUnbonding defines the event data for the staking CancelUnbond transaction.
 type EventCancelUnbonding struct {
 	DelegatorAddress common.Address
@@ -345,6 +354,54 @@ func NewMsgCancelUnbondingDelegation(args []interface{}, denom string) (*staking
 	return msg, delegatorAddr, nil
 }
 
+// TransferDelegationArgs defines the arguments of the staking TransferDelegation transaction.
+type TransferDelegationArgs struct {
+	DelegatorAddress common.Address
+	RecipientAddress common.Address
+	ValidatorAddress sdk.ValAddress
+	Amount           math.Int
+}
+
+// NewTransferDelegationArgs creates a new TransferDelegationArgs instance and does sanity checks
+// on the given arguments.
+func NewTransferDelegationArgs(args []interface{}) (*TransferDelegationArgs, error) {
+	if len(args) != 4 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	delegatorAddr, ok := args[0].(common.Address)
+	if !ok || delegatorAddr == (common.Address{}) {
+		return nil, fmt.Errorf(cmn.ErrInvalidDelegator, args[0])
+	}
+
+	recipientAddr, ok := args[1].(common.Address)
+	if !ok || recipientAddr == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidRecipient, args[1])
+	}
+
+	validatorAddress, ok := args[2].(string)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "validatorAddress", "string", args[2])
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(validatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, ok := args[3].(*big.Int)
+	if !ok || amount == nil {
+		return nil, fmt.Errorf(cmn.ErrInvalidAmount, args[3])
+	}
+
+	return &TransferDelegationArgs{
+		DelegatorAddress: delegatorAddr,
+		RecipientAddress: recipientAddr,
+		ValidatorAddress: valAddr,
+		Amount:           math.NewIntFromBigInt(amount),
+	}, nil
+}
+
 // NewDelegationRequest creates a new QueryDelegationRequest instance and does sanity checks
 // on the given arguments before populating the request.
 func NewDelegationRequest(args []interface{}) (*stakingtypes.QueryDelegationRequest, error) {
@@ -407,6 +464,32 @@ func NewValidatorsRequest(method *abi.Method, args []interface{}) (*stakingtypes
 	}, nil
 }
 
+// NewDelegatorDelegationsRequest creates a new QueryDelegatorDelegationsRequest instance and does sanity checks
+// on the given arguments before populating the request.
+func NewDelegatorDelegationsRequest(method *abi.Method, args []interface{}) (*stakingtypes.QueryDelegatorDelegationsRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input DelegationsOfInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to DelegationsOfInput struct: %s", err)
+	}
+
+	if input.DelegatorAddress == (common.Address{}) {
+		return nil, fmt.Errorf(cmn.ErrInvalidDelegator, input.DelegatorAddress)
+	}
+
+	if bytes.Equal(input.PageRequest.Key, []byte{0}) {
+		input.PageRequest.Key = nil
+	}
+
+	return &stakingtypes.QueryDelegatorDelegationsRequest{
+		DelegatorAddr: sdk.AccAddress(input.DelegatorAddress.Bytes()).String(), // bech32 formatted
+		Pagination:    &input.PageRequest,
+	}, nil
+}
+
 // NewRedelegationRequest create a new QueryRedelegationRequest instance and does sanity checks
 // on the given arguments before populating the request.
 func NewRedelegationRequest(args []interface{}) (*RedelegationRequest, error) {
@@ -561,6 +644,63 @@ func (do *DelegationOutput) Pack(args abi.Arguments) ([]byte, error) {
 	return args.Pack(do.Shares, do.Balance)
 }
 
+// DelegationsOfInput is a struct to represent the input information for
+// the delegationsOf query. Needed to unpack arguments into the PageRequest struct.
+type DelegationsOfInput struct {
+	DelegatorAddress common.Address
+	PageRequest      query.PageRequest
+}
+
+// Delegation is a struct to represent a delegation of a delegator to a validator.
+type Delegation struct {
+	DelegatorAddress string
+	ValidatorAddress string
+	Shares           *big.Int
+}
+
+// DelegationResponse is a struct to represent a delegation with its balance.
+type DelegationResponse struct {
+	Delegation Delegation
+	Balance    cmn.Coin
+}
+
+// DelegationsOfOutput is a struct to represent the key information from
+// a delegator delegations response.
+type DelegationsOfOutput struct {
+	Response     []DelegationResponse
+	PageResponse query.PageResponse
+}
+
+// FromResponse populates the DelegationsOfOutput from a QueryDelegatorDelegationsResponse.
+func (do *DelegationsOfOutput) FromResponse(res *stakingtypes.QueryDelegatorDelegationsResponse) *DelegationsOfOutput {
+	do.Response = make([]DelegationResponse, len(res.DelegationResponses))
+	for i, resp := range res.DelegationResponses {
+		do.Response[i] = DelegationResponse{
+			Delegation: Delegation{
+				DelegatorAddress: resp.Delegation.DelegatorAddress,
+				ValidatorAddress: resp.Delegation.ValidatorAddress,
+				Shares:           resp.Delegation.Shares.BigInt(),
+			},
+			Balance: cmn.Coin{
+				Denom:  resp.Balance.Denom,
+				Amount: resp.Balance.Amount.BigInt(),
+			},
+		}
+	}
+
+	if res.Pagination != nil {
+		do.PageResponse.Total = res.Pagination.Total
+		do.PageResponse.NextKey = res.Pagination.NextKey
+	}
+
+	return do
+}
+
+// Pack packs a given slice of abi arguments into a byte array.
+func (do *DelegationsOfOutput) Pack(args abi.Arguments) ([]byte, error) {
+	return args.Pack(do.Response, do.PageResponse)
+}
+
 // ValidatorInfo is a struct to represent the key information from
 // a validator response.
 type ValidatorInfo struct {
@@ -104,6 +104,9 @@ type EVMBackend interface {
 	// Send Transaction
 	Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, gasLimit *hexutil.Uint64) (common.Hash, error)
 	SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
+	SendBundle(args rpctypes.SendBundleArgs) (*rpctypes.SendBundleResult, error)
+	SendPrivateTransaction(args rpctypes.SendPrivateTxArgs) (common.Hash, error)
+	CancelPrivateTransaction(args rpctypes.CancelPrivateTxArgs) (bool, error)
 	SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error)
 	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error)
 	DoCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber) (*evmtypes.MsgEthereumTxResponse, error)
@@ -132,6 +135,7 @@ type Backend struct {
 	cfg                 config.Config
 	allowUnprotectedTxs bool
 	indexer             aizeltypes.EVMTxIndexer
+	privatePool         aizeltypes.PrivateTxPool
 }
 
 // NewBackend creates a new Backend instance for cosmos and ethereum namespaces
@@ -141,6 +145,7 @@ func NewBackend(
 	clientCtx client.Context,
 	allowUnprotectedTxs bool,
 	indexer aizeltypes.EVMTxIndexer,
+	privatePool aizeltypes.PrivateTxPool,
 ) *Backend {
 	chainID, err := aizeltypes.ParseChainID(clientCtx.ChainID)
 	if err != nil {
@@ -167,5 +172,6 @@ func NewBackend(
 		cfg:                 appConf,
 		allowUnprotectedTxs: allowUnprotectedTxs,
 		indexer:             indexer,
+		privatePool:         privatePool,
 	}
 }
@@ -11,13 +11,16 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	rpctypes "github.com/AizelNetwork/CosmEvm/rpc/types"
+	aizeltypes "github.com/AizelNetwork/CosmEvm/types"
 	"github.com/AizelNetwork/CosmEvm/x/evm/core/vm"
 	evmtypes "github.com/AizelNetwork/CosmEvm/x/evm/types"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -98,27 +101,8 @@ func (b *Backend) Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, g
 
 // SendRawTransaction send a raw Ethereum transaction.
 func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
-	// RLP decode raw transaction bytes
-	tx := &ethtypes.Transaction{}
-	if err := tx.UnmarshalBinary(data); err != nil {
-		b.logger.Error("transaction decoding failed", "error", err.Error())
-		return common.Hash{}, err
-	}
-
-	// check the local node config in case unprotected txs are disabled
-	if !b.UnprotectedAllowed() && !tx.Protected() {
-		// Ensure only eip155 signed transactions are submitted if EIP155Required is set.
-		return common.Hash{}, errors.New("only replay-protected (EIP-155) transactions allowed over RPC")
-	}
-
-	ethereumTx := &evmtypes.MsgEthereumTx{}
-	if err := ethereumTx.FromEthereumTx(tx); err != nil {
-		b.logger.Error("transaction converting failed", "error", err.Error())
-		return common.Hash{}, err
-	}
-
-	if err := ethereumTx.ValidateBasic(); err != nil {
-		b.logger.Debug("tx failed basic validation", "error", err.Error())
+	ethereumTx, err := b.decodeRawTransaction(data)
+	if err != nil {
 		return common.Hash{}, err
 	}
 
@@ -152,6 +136,165 @@ func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
 	return txHash, nil
 }
 
+// SendBundle sends an Ethereum tx bundle. The txs of the bundle are broadcasted
+// in a single Cosmos tx, so that they are included contiguously and
+// atomically. If a tx of the bundle fails, the bundle is reverted, but its
+// txs still consume their nonces and pay for their full gas limit.
+func (b *Backend) SendBundle(args rpctypes.SendBundleArgs) (*rpctypes.SendBundleResult, error) {
+	if err := evmtypes.ValidateBundleSize(len(args.Txs)); err != nil {
+		return nil, err
+	}
+
+	msgs := make([]*evmtypes.MsgEthereumTx, len(args.Txs))
+	for i, data := range args.Txs {
+		ethereumTx, err := b.decodeRawTransaction(data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid bundle tx %d: %w", i, err)
+		}
+		msgs[i] = ethereumTx
+	}
+
+	bundleHash := evmtypes.BundleHash(msgs)
+
+	cosmosTx, err := evmtypes.BuildBundleTx(
+		b.clientCtx.TxConfig.NewTxBuilder(),
+		msgs,
+		evmtypes.GetEVMCoinDenom(),
+		uint64(args.MaxBlockNumber),
+	)
+	if err != nil {
+		b.logger.Error("failed to build cosmos tx", "error", err.Error())
+		return nil, err
+	}
+
+	txBytes, err := b.clientCtx.TxConfig.TxEncoder()(cosmosTx)
+	if err != nil {
+		b.logger.Error("failed to encode bundle using default encoder", "error", err.Error())
+		return nil, err
+	}
+
+	syncCtx := b.clientCtx.WithBroadcastMode(flags.BroadcastSync)
+	rsp, err := syncCtx.BroadcastTx(txBytes)
+	if rsp != nil && rsp.Code != 0 {
+		err = errorsmod.ABCIError(rsp.Codespace, rsp.Code, rsp.RawLog)
+	}
+	if err != nil {
+		b.logger.Error("failed to broadcast bundle", "error", err.Error())
+		return nil, err
+	}
+
+	return &rpctypes.SendBundleResult{BundleHash: bundleHash}, nil
+}
+
+// SendPrivateTransaction adds a raw Ethereum tx to the node-local private
+// pool. The tx is not broadcasted, it's only included in the blocks proposed
+// by this node up to the max block number.
+func (b *Backend) SendPrivateTransaction(args rpctypes.SendPrivateTxArgs) (common.Hash, error) {
+	if b.privatePool == nil {
+		return common.Hash{}, errors.New("private transactions are not supported by the node")
+	}
+
+	ethereumTx, err := b.decodeRawTransaction(args.Tx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	// the signature is verified by the ante handler only when the tx is
+	// proposed, so it's checked here to reject invalid txs early
+	if _, err := ethereumTx.GetSender(b.ChainConfig().ChainID); err != nil {
+		return common.Hash{}, errors.Wrap(err, "invalid transaction signature")
+	}
+
+	cosmosTx, err := ethereumTx.BuildTx(b.clientCtx.TxConfig.NewTxBuilder(), evmtypes.GetEVMCoinDenom())
+	if err != nil {
+		b.logger.Error("failed to build cosmos tx", "error", err.Error())
+		return common.Hash{}, err
+	}
+
+	txBytes, err := b.clientCtx.TxConfig.TxEncoder()(cosmosTx)
+	if err != nil {
+		b.logger.Error("failed to encode eth tx using default encoder", "error", err.Error())
+		return common.Hash{}, err
+	}
+
+	maxBlockNumber, err := aizeltypes.SafeInt64(uint64(args.MaxBlockNumber))
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return b.privatePool.AddTx(txBytes, maxBlockNumber)
+}
+
+// CancelPrivateTransaction removes a private Ethereum tx from the node-local
+// private pool. The cancellation must be signed by the sender of the tx, with
+// a personal_sign signature of the tx hash. It returns false if the tx isn't in
+// the pool, e.g. because it was already included, or wasn't sent by the
+// signer.
+func (b *Backend) CancelPrivateTransaction(args rpctypes.CancelPrivateTxArgs) (bool, error) {
+	if b.privatePool == nil {
+		return false, errors.New("private transactions are not supported by the node")
+	}
+
+	signer, err := recoverPersonalSigner(args.TxHash.Bytes(), args.Signature)
+	if err != nil {
+		return false, errors.Wrap(err, "invalid cancellation signature")
+	}
+
+	return b.privatePool.CancelTx(args.TxHash, signer), nil
+}
+
+// recoverPersonalSigner returns the address that signed the data with
+// personal_sign, whose signature V value is 27 or 28.
+func recoverPersonalSigner(data []byte, sig hexutil.Bytes) (common.Address, error) {
+	if len(sig) != crypto.SignatureLength {
+		return common.Address{}, fmt.Errorf("signature must be %d bytes long", crypto.SignatureLength)
+	}
+	if sig[crypto.RecoveryIDOffset] != 27 && sig[crypto.RecoveryIDOffset] != 28 {
+		return common.Address{}, errors.New("invalid Ethereum signature (V is not 27 or 28)")
+	}
+
+	// transform the yellow paper V from 27/28 to 0/1 on a copy of the signature
+	sig = bytes.Clone(sig)
+	sig[crypto.RecoveryIDOffset] -= 27
+
+	pubkey, err := crypto.SigToPub(accounts.TextHash(data), sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return crypto.PubkeyToAddress(*pubkey), nil
+}
+
+// decodeRawTransaction decodes a raw Ethereum transaction and performs its
+// basic validation.
+func (b *Backend) decodeRawTransaction(data hexutil.Bytes) (*evmtypes.MsgEthereumTx, error) {
+	// RLP decode raw transaction bytes
+	tx := &ethtypes.Transaction{}
+	if err := tx.UnmarshalBinary(data); err != nil {
+		b.logger.Error("transaction decoding failed", "error", err.Error())
+		return nil, err
+	}
+
+	// check the local node config in case unprotected txs are disabled
+	if !b.UnprotectedAllowed() && !tx.Protected() {
+		// Ensure only eip155 signed transactions are submitted if EIP155Required is set.
+		return nil, errors.New("only replay-protected (EIP-155) transactions allowed over RPC")
+	}
+
+	ethereumTx := &evmtypes.MsgEthereumTx{}
+	if err := ethereumTx.FromEthereumTx(tx); err != nil {
+		b.logger.Error("transaction converting failed", "error", err.Error())
+		return nil, err
+	}
+
+	if err := ethereumTx.ValidateBasic(); err != nil {
+		b.logger.Debug("tx failed basic validation", "error", err.Error())
+		return nil, err
+	}
+
+	return ethereumTx, nil
+}
+
 // SetTxDefaults populates tx message with default values in case they are not
 // provided on the args
 func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error) {
@@ -10,9 +10,12 @@ import (
 	rpctypes "github.com/AizelNetwork/CosmEvm/rpc/types"
 	utiltx "github.com/AizelNetwork/CosmEvm/testutil/tx"
 	evmtypes "github.com/AizelNetwork/CosmEvm/x/evm/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/rlp"
 	"google.golang.org/grpc/metadata"
 )
@@ -368,6 +371,100 @@ func (suite *BackendTestSuite) TestSendRawTransaction() {
 	}
 }
 
+// mockPrivateTxPool records the txs added to the private pool.
+type mockPrivateTxPool struct {
+	txBytes        []byte
+	maxBlockHeight int64
+	canceledBy     common.Address
+}
+
+func (p *mockPrivateTxPool) AddTx(txBytes []byte, maxBlockHeight int64) (common.Hash, error) {
+	p.txBytes = txBytes
+	p.maxBlockHeight = maxBlockHeight
+	return common.Hash{}, nil
+}
+
+func (p *mockPrivateTxPool) CancelTx(_ common.Hash, sender common.Address) bool {
+	p.canceledBy = sender
+	return p.txBytes != nil
+}
+
+func (p *mockPrivateTxPool) SetBroadcaster(func([]byte) error) {}
+
+func (suite *BackendTestSuite) TestSendPrivateTransaction() {
+	ethTx, _ := suite.buildEthereumTx()
+
+	ethSigner := ethtypes.LatestSigner(suite.backend.ChainConfig())
+	err := ethTx.Sign(ethSigner, suite.signer)
+	suite.Require().NoError(err)
+
+	rlpEncodedBz, _ := rlp.EncodeToBytes(ethTx.AsTransaction())
+
+	testCases := []struct {
+		name    string
+		pool    *mockPrivateTxPool
+		args    rpctypes.SendPrivateTxArgs
+		expPass bool
+	}{
+		{
+			"fail - private transactions not supported",
+			nil,
+			rpctypes.SendPrivateTxArgs{Tx: rlpEncodedBz},
+			false,
+		},
+		{
+			"fail - empty bytes",
+			&mockPrivateTxPool{},
+			rpctypes.SendPrivateTxArgs{Tx: []byte{}},
+			false,
+		},
+		{
+			"pass - tx added to the private pool",
+			&mockPrivateTxPool{},
+			rpctypes.SendPrivateTxArgs{Tx: rlpEncodedBz, MaxBlockNumber: 10},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			suite.backend.allowUnprotectedTxs = true
+			if tc.pool != nil {
+				suite.backend.privatePool = tc.pool
+			}
+
+			_, err := suite.backend.SendPrivateTransaction(tc.args)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(int64(10), tc.pool.maxBlockHeight)
+
+				tx, err := suite.backend.clientCtx.TxConfig.TxDecoder()(tc.pool.txBytes)
+				suite.Require().NoError(err)
+				msg := tx.GetMsgs()[0].(*evmtypes.MsgEthereumTx)
+				suite.Require().Equal(ethTx.AsTransaction().Hash(), msg.AsTransaction().Hash())
+
+				// the cancellation must be signed with personal_sign
+				txHash := msg.AsTransaction().Hash()
+				_, err = suite.backend.CancelPrivateTransaction(rpctypes.CancelPrivateTxArgs{TxHash: txHash})
+				suite.Require().Error(err)
+
+				sig, _, err := suite.signer.Sign("", accounts.TextHash(txHash.Bytes()), signingtypes.SignMode_SIGN_MODE_TEXTUAL)
+				suite.Require().NoError(err)
+				sig[crypto.RecoveryIDOffset] += 27
+
+				canceled, err := suite.backend.CancelPrivateTransaction(rpctypes.CancelPrivateTxArgs{TxHash: txHash, Signature: sig})
+				suite.Require().NoError(err)
+				suite.Require().True(canceled)
+				suite.Require().Equal(suite.from, tc.pool.canceledBy)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestDoCall() {
 	_, bz := suite.buildEthereumTx()
 	gasPrice := (*hexutil.Big)(big.NewInt(1))
@@ -230,6 +230,11 @@ func (b *Backend) FeeHistory(
 		}
 	}
 
+	// the fee market base fee algorithm can differ from EIP-1559, so the base
+	// fees derived from the block headers are replaced by the ones tracked by
+	// the fee market module when available
+	b.applyBaseFeeHistory(blockStart, blockEnd, thisBaseFee)
+
 	feeHistory := rpctypes.FeeHistoryResult{
 		OldestBlock:  oldestBlock,
 		BaseFee:      thisBaseFee,
@@ -243,6 +248,32 @@ func (b *Backend) FeeHistory(
 	return &feeHistory, nil
 }
 
+// applyBaseFeeHistory sets the base fees of the blocks in the [blockStart,
+// blockEnd] range and the base fee of the next block from the fee market base
+// fee history. The base fees are left untouched if the history can't be
+// queried.
+func (b *Backend) applyBaseFeeHistory(blockStart, blockEnd int64, baseFees []*hexutil.Big) {
+	res, err := b.queryClient.FeeMarket.BaseFeeHistory(
+		rpctypes.ContextWithHeight(blockEnd),
+		&feemarkettypes.QueryBaseFeeHistoryRequest{Count: uint64(blockEnd - blockStart + 1)}, //#nosec G115 -- blockEnd >= blockStart
+	)
+	if err != nil {
+		b.logger.Debug("failed to query base fee history", "height", blockEnd, "error", err.Error())
+		return
+	}
+
+	for _, record := range res.History {
+		if record.Height < blockStart || record.Height > blockEnd || record.BaseFee.IsNil() {
+			continue
+		}
+		baseFees[record.Height-blockStart] = (*hexutil.Big)(record.BaseFee.TruncateInt().BigInt())
+	}
+
+	if res.NextBaseFee != nil && !res.NextBaseFee.IsNil() {
+		baseFees[len(baseFees)-1] = (*hexutil.Big)(res.NextBaseFee.TruncateInt().BigInt())
+	}
+}
+
 // SuggestGasTipCap returns the suggested tip cap
 // Although we don't support tx prioritization yet, but we return a positive value to help client to
 // mitigate the base fee changes.
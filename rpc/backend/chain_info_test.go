@@ -19,6 +19,7 @@ import (
 	rpc "github.com/AizelNetwork/CosmEvm/rpc/types"
 	utiltx "github.com/AizelNetwork/CosmEvm/testutil/tx"
 	evmtypes "github.com/AizelNetwork/CosmEvm/x/evm/types"
+	feemarkettypes "github.com/AizelNetwork/CosmEvm/x/feemarket/types"
 )
 
 func (suite *BackendTestSuite) TestBaseFee() {
@@ -423,6 +424,8 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 				RegisterValidatorAccount(queryClient, validator)
 				RegisterConsensusParams(client, 1)
 				RegisterParams(queryClient, &header, 1)
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterFeeMarketBaseFeeHistoryError(feeMarketClient, 1, 1)
 			},
 			1,
 			1,
@@ -435,6 +438,40 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 			sdk.AccAddress(utiltx.GenerateAddress().Bytes()),
 			true,
 		},
+		{
+			"pass - base fees from the fee market base fee history",
+			func(validator sdk.AccAddress) {
+				var header metadata.MD
+				baseFee := math.NewInt(1)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				suite.backend.cfg.JSONRPC.FeeHistoryCap = 2
+				_, err := RegisterBlock(client, ethrpc.BlockNumber(1).Int64(), nil)
+				suite.Require().NoError(err)
+				_, err = RegisterBlockResults(client, 1)
+				suite.Require().NoError(err)
+				RegisterBaseFee(queryClient, baseFee)
+				RegisterValidatorAccount(queryClient, validator)
+				RegisterConsensusParams(client, 1)
+				RegisterParams(queryClient, &header, 1)
+				RegisterFeeMarketBaseFeeHistory(
+					feeMarketClient, 1, 1,
+					[]feemarkettypes.BaseFeeRecord{{Height: 1, BaseFee: math.LegacyNewDec(5)}},
+					math.LegacyNewDec(7),
+				)
+			},
+			1,
+			1,
+			&rpc.FeeHistoryResult{
+				OldestBlock:  (*hexutil.Big)(big.NewInt(1)),
+				BaseFee:      []*hexutil.Big{(*hexutil.Big)(big.NewInt(5)), (*hexutil.Big)(big.NewInt(7))},
+				GasUsedRatio: []float64{0},
+				Reward:       [][]*hexutil.Big{{(*hexutil.Big)(big.NewInt(0)), (*hexutil.Big)(big.NewInt(0)), (*hexutil.Big)(big.NewInt(0)), (*hexutil.Big)(big.NewInt(0))}},
+			},
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()),
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
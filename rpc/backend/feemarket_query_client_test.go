@@ -1,6 +1,7 @@
 package backend
 
 import (
+	"cosmossdk.io/math"
 	"github.com/AizelNetwork/CosmEvm/rpc/backend/mocks"
 	rpc "github.com/AizelNetwork/CosmEvm/rpc/types"
 	feemarkettypes "github.com/AizelNetwork/CosmEvm/x/feemarket/types"
@@ -19,3 +20,20 @@ func RegisterFeeMarketParamsError(feeMarketClient *mocks.FeeMarketQueryClient, h
 	feeMarketClient.On("Params", rpc.ContextWithHeight(height), &feemarkettypes.QueryParamsRequest{}).
 		Return(nil, sdkerrors.ErrInvalidRequest)
 }
+
+// BaseFeeHistory
+func RegisterFeeMarketBaseFeeHistory(
+	feeMarketClient *mocks.FeeMarketQueryClient,
+	height int64,
+	count uint64,
+	history []feemarkettypes.BaseFeeRecord,
+	nextBaseFee math.LegacyDec,
+) {
+	feeMarketClient.On("BaseFeeHistory", rpc.ContextWithHeight(height), &feemarkettypes.QueryBaseFeeHistoryRequest{Count: count}).
+		Return(&feemarkettypes.QueryBaseFeeHistoryResponse{History: history, NextBaseFee: &nextBaseFee}, nil)
+}
+
+func RegisterFeeMarketBaseFeeHistoryError(feeMarketClient *mocks.FeeMarketQueryClient, height int64, count uint64) {
+	feeMarketClient.On("BaseFeeHistory", rpc.ContextWithHeight(height), &feemarkettypes.QueryBaseFeeHistoryRequest{Count: count}).
+		Return(nil, sdkerrors.ErrInvalidRequest)
+}
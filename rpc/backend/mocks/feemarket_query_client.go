@@ -47,6 +47,36 @@ func (_m *FeeMarketQueryClient) BaseFee(ctx context.Context, in *types.QueryBase
 	return r0, r1
 }
 
+// BaseFeeHistory provides a mock function with given fields: ctx, in, opts
+func (_m *FeeMarketQueryClient) BaseFeeHistory(ctx context.Context, in *types.QueryBaseFeeHistoryRequest, opts ...grpc.CallOption) (*types.QueryBaseFeeHistoryResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryBaseFeeHistoryResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryBaseFeeHistoryRequest, ...grpc.CallOption) *types.QueryBaseFeeHistoryResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryBaseFeeHistoryResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryBaseFeeHistoryRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // BlockGas provides a mock function with given fields: ctx, in, opts
 func (_m *FeeMarketQueryClient) BlockGas(ctx context.Context, in *types.QueryBlockGasRequest, opts ...grpc.CallOption) (*types.QueryBlockGasResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -77,6 +107,36 @@ func (_m *FeeMarketQueryClient) BlockGas(ctx context.Context, in *types.QueryBlo
 	return r0, r1
 }
 
+// FeeDenomPrices provides a mock function with given fields: ctx, in, opts
+func (_m *FeeMarketQueryClient) FeeDenomPrices(ctx context.Context, in *types.QueryFeeDenomPricesRequest, opts ...grpc.CallOption) (*types.QueryFeeDenomPricesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryFeeDenomPricesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryFeeDenomPricesRequest, ...grpc.CallOption) *types.QueryFeeDenomPricesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryFeeDenomPricesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryFeeDenomPricesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Params provides a mock function with given fields: ctx, in, opts
 func (_m *FeeMarketQueryClient) Params(ctx context.Context, in *types.QueryParamsRequest, opts ...grpc.CallOption) (*types.QueryParamsResponse, error) {
 	_va := make([]interface{}, len(opts))
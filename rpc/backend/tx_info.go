@@ -194,7 +194,7 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		return nil, err
 	}
 
-	from, err := ethMsg.GetSender(chainID.ToInt())
+	from, err := evmtypes.GetTxSender(tx, ethMsg, chainID.ToInt())
 	if err != nil {
 		return nil, err
 	}
@@ -90,7 +90,7 @@ func (b *Backend) getAccountNonce(accAddr common.Address, pending bool, height i
 				break
 			}
 
-			sender, err := ethMsg.GetSender(b.chainID)
+			sender, err := evmtypes.GetTxSender(*tx, ethMsg, b.chainID)
 			if err != nil {
 				continue
 			}
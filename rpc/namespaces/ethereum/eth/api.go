@@ -55,8 +55,9 @@ type EthereumAPI interface {
 	// on-chain, and interact with smart contracts.
 	SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
 	SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error)
-	// eth_sendPrivateTransaction
-	// eth_cancel	PrivateTransaction
+	SendBundle(args rpctypes.SendBundleArgs) (*rpctypes.SendBundleResult, error)
+	SendPrivateTransaction(args rpctypes.SendPrivateTxArgs) (common.Hash, error)
+	CancelPrivateTransaction(args rpctypes.CancelPrivateTxArgs) (bool, error)
 
 	// Account Information
 	//
@@ -217,6 +218,28 @@ func (e *PublicAPI) SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
 	return e.backend.SendRawTransaction(data)
 }
 
+// SendBundle sends an Ethereum tx bundle, whose txs are included contiguously
+// and atomically. A reverted bundle still consumes the nonces of its txs and
+// pays for their full gas limit.
+func (e *PublicAPI) SendBundle(args rpctypes.SendBundleArgs) (*rpctypes.SendBundleResult, error) {
+	e.logger.Debug("eth_sendBundle", "txs", len(args.Txs), "max_block_number", args.MaxBlockNumber)
+	return e.backend.SendBundle(args)
+}
+
+// SendPrivateTransaction sends a private Ethereum transaction, which is not
+// gossiped and only included in the blocks proposed by the node.
+func (e *PublicAPI) SendPrivateTransaction(args rpctypes.SendPrivateTxArgs) (common.Hash, error) {
+	e.logger.Debug("eth_sendPrivateTransaction", "length", len(args.Tx), "max_block_number", args.MaxBlockNumber)
+	return e.backend.SendPrivateTransaction(args)
+}
+
+// CancelPrivateTransaction cancels a private Ethereum transaction that wasn't
+// included yet.
+func (e *PublicAPI) CancelPrivateTransaction(args rpctypes.CancelPrivateTxArgs) (bool, error) {
+	e.logger.Debug("eth_cancelPrivateTransaction", "hash", args.TxHash.Hex())
+	return e.backend.CancelPrivateTransaction(args)
+}
+
 // SendTransaction sends an Ethereum transaction.
 func (e *PublicAPI) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error) {
 	e.logger.Debug("eth_sendTransaction", "args", args.String())
@@ -89,3 +89,38 @@ type OneFeeHistory struct {
 	Reward               []*big.Int // each element of the array will have the tip provided to miners for the percentile given
 	GasUsedRatio         float64    // the ratio of gas used to the gas limit for each block
 }
+
+// SendBundleArgs represents the arguments of an Ethereum tx bundle. The txs are
+// included contiguously in the given order, and a failed tx reverts the whole
+// bundle.
+type SendBundleArgs struct {
+	// Txs are the RLP encoded signed txs of the bundle
+	Txs []hexutil.Bytes `json:"txs"`
+	// MaxBlockNumber is the last block in which the bundle can be included.
+	// Zero means the bundle doesn't expire.
+	MaxBlockNumber hexutil.Uint64 `json:"maxBlockNumber,omitempty"`
+}
+
+// SendBundleResult represents the result of an Ethereum tx bundle submission.
+type SendBundleResult struct {
+	BundleHash common.Hash `json:"bundleHash"`
+}
+
+// SendPrivateTxArgs represents the arguments of a private Ethereum tx, which is
+// not gossiped and only included in the blocks proposed by the node.
+type SendPrivateTxArgs struct {
+	// Tx is the RLP encoded signed tx
+	Tx hexutil.Bytes `json:"tx"`
+	// MaxBlockNumber is the last block in which the tx can be included. Zero
+	// means the max number of blocks configured in the node.
+	MaxBlockNumber hexutil.Uint64 `json:"maxBlockNumber,omitempty"`
+}
+
+// CancelPrivateTxArgs represents the arguments of a private Ethereum tx
+// cancellation.
+type CancelPrivateTxArgs struct {
+	TxHash common.Hash `json:"txHash"`
+	// Signature is the personal_sign signature of the tx hash by the sender of
+	// the tx
+	Signature hexutil.Bytes `json:"signature"`
+}
@@ -16,7 +16,10 @@ import (
 	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	"github.com/cosmos/rosetta"
 
@@ -70,6 +73,31 @@ const (
 	// DefaultMaxTxGasWanted is the default gas wanted for each eth tx returned in ante handler in check tx mode
 	DefaultMaxTxGasWanted = 0
 
+	// DefaultMempoolMaxTxs is the default maximum number of txs in the app-side mempool
+	DefaultMempoolMaxTxs = 5000
+
+	// DefaultMempoolMaxTxsPerSender is the default maximum number of Ethereum txs of a single sender in the app-side mempool
+	DefaultMempoolMaxTxsPerSender = 64
+
+	// DefaultMempoolMaxTxAge is the default duration after which a tx is evicted from the app-side mempool
+	DefaultMempoolMaxTxAge = 3 * time.Hour
+
+	// DefaultMempoolPriceBump is the default minimum fee increase, in percent, to replace an Ethereum tx in the app-side mempool
+	DefaultMempoolPriceBump uint64 = 10
+
+	// DefaultMempoolQueueFutureNonces is the default to queue the Ethereum txs with a nonce gap in the app-side mempool
+	DefaultMempoolQueueFutureNonces = true
+
+	// DefaultPrivateTxMaxBlocks is the default number of blocks during which a private Ethereum tx can be included.
+	// The private txs are disabled by default, as they are only included by the nodes that propose blocks.
+	DefaultPrivateTxMaxBlocks uint64 = 0
+
+	// DefaultFirewallRateLimitTxs is the default maximum number of txs of a sender accepted by the firewall per window (0=unlimited)
+	DefaultFirewallRateLimitTxs uint64 = 0
+
+	// DefaultFirewallRateLimitBlocks is the default number of blocks of the firewall rate limit window
+	DefaultFirewallRateLimitBlocks uint64 = 1
+
 	// DefaultGasCap is the default cap on gas that can be used in eth_call/estimateGas
 	DefaultGasCap uint64 = 25000000
 
@@ -160,10 +188,11 @@ var evmTracers = []string{"json", "markdown", "struct", "access_list"}
 type Config struct {
 	config.Config `mapstructure:",squash"`
 
-	EVM     EVMConfig     `mapstructure:"evm"`
-	JSONRPC JSONRPCConfig `mapstructure:"json-rpc"`
-	TLS     TLSConfig     `mapstructure:"tls"`
-	Rosetta RosettaConfig `mapstructure:"rosetta"`
+	EVM      EVMConfig      `mapstructure:"evm"`
+	JSONRPC  JSONRPCConfig  `mapstructure:"json-rpc"`
+	TLS      TLSConfig      `mapstructure:"tls"`
+	Rosetta  RosettaConfig  `mapstructure:"rosetta"`
+	Firewall FirewallConfig `mapstructure:"firewall"`
 
 	MemIAVL   MemIAVLConfig   `mapstructure:"memiavl"`
 	VersionDB VersionDBConfig `mapstructure:"versiondb"`
@@ -176,6 +205,47 @@ type EVMConfig struct {
 	Tracer string `mapstructure:"tracer"`
 	// MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 	MaxTxGasWanted uint64 `mapstructure:"max-tx-gas-wanted"`
+	// MempoolMaxTxs defines the maximum number of txs in the app-side mempool.
+	MempoolMaxTxs int `mapstructure:"mempool-max-txs"`
+	// MempoolMaxTxsPerSender defines the maximum number of Ethereum txs of a
+	// single sender in the app-side mempool, including the queued ones.
+	MempoolMaxTxsPerSender int `mapstructure:"mempool-max-txs-per-sender"`
+	// MempoolMaxTxAge defines the duration after which an Ethereum tx is
+	// evicted from the app-side mempool. Zero disables the age eviction.
+	MempoolMaxTxAge time.Duration `mapstructure:"mempool-max-tx-age"`
+	// MempoolPriceBump defines the minimum fee increase, in percent, required
+	// to replace an Ethereum tx with the same nonce in the app-side mempool.
+	MempoolPriceBump uint64 `mapstructure:"mempool-price-bump"`
+	// MempoolQueueFutureNonces defines if the Ethereum txs with a nonce above
+	// the sender sequence are accepted on CheckTx and queued in the app-side
+	// mempool until the nonce gap is filled.
+	MempoolQueueFutureNonces bool `mapstructure:"mempool-queue-future-nonces"`
+	// PrivateTxMaxBlocks defines the maximum number of blocks during which a
+	// private Ethereum tx can be included by the node. Zero disables the
+	// private txs.
+	PrivateTxMaxBlocks uint64 `mapstructure:"private-tx-max-blocks"`
+	// PrivateTxBroadcast defines if the private Ethereum txs that were not
+	// included before their max block are broadcast publicly instead of being
+	// dropped.
+	PrivateTxBroadcast bool `mapstructure:"private-tx-broadcast"`
+}
+
+// FirewallConfig defines the node-local filters applied by the ante handler to
+// the txs received on CheckTx.
+type FirewallConfig struct {
+	// DenySenders defines the hex or bech32 addresses whose txs are rejected.
+	DenySenders []string `mapstructure:"deny-senders"`
+	// DenyRecipients defines the hex or bech32 addresses that cannot be the
+	// recipient of a tx.
+	DenyRecipients []string `mapstructure:"deny-recipients"`
+	// DenySelectors defines the hex encoded 4-byte function selectors that
+	// cannot be called by an Ethereum tx.
+	DenySelectors []string `mapstructure:"deny-selectors"`
+	// RateLimitTxs defines the maximum number of txs of a single sender
+	// accepted per window. Zero disables the rate limit.
+	RateLimitTxs uint64 `mapstructure:"rate-limit-txs"`
+	// RateLimitBlocks defines the number of blocks of the rate limit window.
+	RateLimitBlocks uint64 `mapstructure:"rate-limit-blocks"`
 }
 
 // JSONRPCConfig defines configuration for the EVM RPC server.
@@ -274,6 +344,7 @@ func AppConfig(denom string) (string, interface{}) {
 	customAppTemplate := config.DefaultConfigTemplate +
 		DefaultEVMConfigTemplate +
 		DefaultRosettaConfigTemplate +
+		DefaultFirewallConfigTemplate +
 		DefaultVersionDBTemplate +
 		memiavlcfg.DefaultConfigTemplate
 
@@ -294,6 +365,7 @@ func DefaultConfig() *Config {
 		JSONRPC:   *DefaultJSONRPCConfig(),
 		TLS:       *DefaultTLSConfig(),
 		Rosetta:   *DefaultRosettaConfig(),
+		Firewall:  *DefaultFirewallConfig(),
 		MemIAVL:   *DefaultMemIAVLConfig(),
 		VersionDB: *DefaultVersionDBConfig(),
 	}
@@ -302,20 +374,103 @@ func DefaultConfig() *Config {
 // DefaultEVMConfig returns the default EVM configuration
 func DefaultEVMConfig() *EVMConfig {
 	return &EVMConfig{
-		Tracer:         DefaultEVMTracer,
-		MaxTxGasWanted: DefaultMaxTxGasWanted,
+		Tracer:                   DefaultEVMTracer,
+		MaxTxGasWanted:           DefaultMaxTxGasWanted,
+		MempoolMaxTxs:            DefaultMempoolMaxTxs,
+		MempoolMaxTxsPerSender:   DefaultMempoolMaxTxsPerSender,
+		MempoolMaxTxAge:          DefaultMempoolMaxTxAge,
+		MempoolPriceBump:         DefaultMempoolPriceBump,
+		MempoolQueueFutureNonces: DefaultMempoolQueueFutureNonces,
+		PrivateTxMaxBlocks:       DefaultPrivateTxMaxBlocks,
 	}
 }
 
-// Validate returns an error if the tracer type is invalid.
+// Validate returns an error if the tracer type or the mempool values are invalid.
 func (c EVMConfig) Validate() error {
 	if c.Tracer != "" && !strings.StringInSlice(c.Tracer, evmTracers) {
 		return fmt.Errorf("invalid tracer type %s, available types: %v", c.Tracer, evmTracers)
 	}
 
+	if c.MempoolMaxTxs <= 0 {
+		return errors.New("mempool max txs must be positive")
+	}
+
+	if c.MempoolMaxTxsPerSender <= 0 || c.MempoolMaxTxsPerSender > c.MempoolMaxTxs {
+		return fmt.Errorf("mempool max txs per sender must be in the [1, %d] range", c.MempoolMaxTxs)
+	}
+
+	if c.MempoolMaxTxAge < 0 {
+		return errors.New("mempool max tx age cannot be negative")
+	}
+
+	return nil
+}
+
+// DefaultFirewallConfig returns the default firewall configuration, which
+// does not filter any tx
+func DefaultFirewallConfig() *FirewallConfig {
+	return &FirewallConfig{
+		DenySenders:     []string{},
+		DenyRecipients:  []string{},
+		DenySelectors:   []string{},
+		RateLimitTxs:    DefaultFirewallRateLimitTxs,
+		RateLimitBlocks: DefaultFirewallRateLimitBlocks,
+	}
+}
+
+// Validate returns an error if the firewall addresses, selectors or rate
+// limit window are invalid.
+func (c FirewallConfig) Validate() error {
+	for _, addr := range append(c.DenySenders, c.DenyRecipients...) {
+		if _, err := ParseFirewallAddress(addr); err != nil {
+			return err
+		}
+	}
+
+	for _, selector := range c.DenySelectors {
+		if _, err := ParseFirewallSelector(selector); err != nil {
+			return err
+		}
+	}
+
+	if c.RateLimitTxs > 0 && c.RateLimitBlocks == 0 {
+		return errors.New("firewall rate limit blocks must be positive")
+	}
+
 	return nil
 }
 
+// ParseFirewallAddress returns the address of the given hex or bech32 string.
+func ParseFirewallAddress(addr string) (common.Address, error) {
+	if common.IsHexAddress(addr) {
+		return common.HexToAddress(addr), nil
+	}
+
+	_, bz, err := bech32.DecodeAndConvert(addr)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("invalid firewall address %s: %w", addr, err)
+	}
+	if len(bz) != common.AddressLength {
+		return common.Address{}, fmt.Errorf("invalid firewall address %s: expected %d bytes, got %d", addr, common.AddressLength, len(bz))
+	}
+
+	return common.BytesToAddress(bz), nil
+}
+
+// ParseFirewallSelector returns the 4-byte function selector of the given hex
+// string.
+func ParseFirewallSelector(selector string) ([4]byte, error) {
+	bz, err := hexutil.Decode(selector)
+	if err != nil {
+		return [4]byte{}, fmt.Errorf("invalid firewall selector %s: %w", selector, err)
+	}
+	if len(bz) != 4 {
+		return [4]byte{}, fmt.Errorf("invalid firewall selector %s: expected 4 bytes, got %d", selector, len(bz))
+	}
+
+	return [4]byte(bz), nil
+}
+
 // GetDefaultAPINamespaces returns the default list of JSON-RPC namespaces that should be enabled
 func GetDefaultAPINamespaces() []string {
 	return []string{"eth", "net", "web3"}
@@ -503,6 +658,10 @@ func (c Config) ValidateBasic() error {
 		return errorsmod.Wrapf(errortypes.ErrAppConfig, "invalid tls config value: %s", err.Error())
 	}
 
+	if err := c.Firewall.Validate(); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrAppConfig, "invalid firewall config value: %s", err.Error())
+	}
+
 	if err := c.MemIAVL.Validate(); err != nil {
 		return errorsmod.Wrapf(errortypes.ErrAppConfig, "invalid memIAVL config value: %s", err.Error())
 	}
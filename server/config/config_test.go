@@ -5,6 +5,8 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 
@@ -68,3 +70,41 @@ func TestGetConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestFirewallConfigValidate(t *testing.T) {
+	bech32Addr, err := bech32.ConvertAndEncode("aizel", common.HexToAddress("0x1111111111111111111111111111111111111111").Bytes())
+	require.NoError(t, err)
+	bech32ShortAddr, err := bech32.ConvertAndEncode("aizel", []byte{1, 2, 3})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name    string
+		cfg     FirewallConfig
+		expPass bool
+	}{
+		{"default", *DefaultFirewallConfig(), true},
+		{
+			"valid - hex and bech32 addresses",
+			FirewallConfig{
+				DenySenders:    []string{"0x1111111111111111111111111111111111111111"},
+				DenyRecipients: []string{bech32Addr},
+				DenySelectors:  []string{"0xa9059cbb"},
+			},
+			true,
+		},
+		{"invalid - address", FirewallConfig{DenySenders: []string{"0x1234"}}, false},
+		{"invalid - bech32 address length", FirewallConfig{DenyRecipients: []string{bech32ShortAddr}}, false},
+		{"invalid - selector length", FirewallConfig{DenySelectors: []string{"0xa9059c"}}, false},
+		{"invalid - selector encoding", FirewallConfig{DenySelectors: []string{"a9059cbb"}}, false},
+		{"invalid - rate limit without window", FirewallConfig{RateLimitTxs: 10}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.cfg.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
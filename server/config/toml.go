@@ -18,6 +18,34 @@ tracer = "{{ .EVM.Tracer }}"
 # MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 max-tx-gas-wanted = {{ .EVM.MaxTxGasWanted }}
 
+# MempoolMaxTxs defines the maximum number of txs in the app-side mempool.
+mempool-max-txs = {{ .EVM.MempoolMaxTxs }}
+
+# MempoolMaxTxsPerSender defines the maximum number of Ethereum txs of a single sender in the
+# app-side mempool, including the txs queued because of a nonce gap.
+mempool-max-txs-per-sender = {{ .EVM.MempoolMaxTxsPerSender }}
+
+# MempoolMaxTxAge defines the duration after which an Ethereum tx is evicted from the app-side
+# mempool (0=never).
+mempool-max-tx-age = "{{ .EVM.MempoolMaxTxAge }}"
+
+# MempoolPriceBump defines the minimum fee increase, in percent, required to replace an Ethereum
+# tx with the same nonce in the app-side mempool.
+mempool-price-bump = {{ .EVM.MempoolPriceBump }}
+
+# MempoolQueueFutureNonces defines if the Ethereum txs with a nonce above the sender sequence are
+# accepted and queued in the app-side mempool until the nonce gap is filled.
+mempool-queue-future-nonces = {{ .EVM.MempoolQueueFutureNonces }}
+
+# PrivateTxMaxBlocks defines the maximum number of blocks during which a private Ethereum tx,
+# sent with eth_sendPrivateTransaction, can be included by the node (0=disabled). It must only be
+# enabled on validator nodes, as the private txs are only included in the blocks proposed by the node.
+private-tx-max-blocks = {{ .EVM.PrivateTxMaxBlocks }}
+
+# PrivateTxBroadcast defines if the private Ethereum txs that were not included before their max
+# block are broadcast publicly instead of being dropped.
+private-tx-broadcast = {{ .EVM.PrivateTxBroadcast }}
+
 ###############################################################################
 ###                           JSON RPC Configuration                        ###
 ###############################################################################
@@ -145,6 +173,34 @@ denom-to-suggest = "{{ .Rosetta.Config.DenomToSuggest }}"
 gas-prices = "{{ .Rosetta.Config.GasPrices }}"
 `
 
+// DefaultFirewallConfigTemplate defines the configuration template for the
+// node-local tx firewall.
+const DefaultFirewallConfigTemplate = `
+###############################################################################
+###                          Firewall Configuration                         ###
+###############################################################################
+
+[firewall]
+
+# DenySenders defines the hex or bech32 addresses whose txs are rejected on CheckTx.
+deny-senders = [{{ range $index, $elmt := .Firewall.DenySenders }}{{ if $index }}, {{ end }}"{{ $elmt }}"{{ end }}]
+
+# DenyRecipients defines the hex or bech32 addresses that cannot be the recipient of a tx
+# received on CheckTx.
+deny-recipients = [{{ range $index, $elmt := .Firewall.DenyRecipients }}{{ if $index }}, {{ end }}"{{ $elmt }}"{{ end }}]
+
+# DenySelectors defines the hex encoded 4-byte function selectors, e.g. "0xa9059cbb", that cannot
+# be called by the Ethereum txs received on CheckTx.
+deny-selectors = [{{ range $index, $elmt := .Firewall.DenySelectors }}{{ if $index }}, {{ end }}"{{ $elmt }}"{{ end }}]
+
+# RateLimitTxs defines the maximum number of txs of a single sender accepted on CheckTx per
+# window (0=unlimited).
+rate-limit-txs = {{ .Firewall.RateLimitTxs }}
+
+# RateLimitBlocks defines the number of blocks of the rate limit window.
+rate-limit-blocks = {{ .Firewall.RateLimitBlocks }}
+`
+
 const DefaultVersionDBTemplate = `
 ###############################################################################
 ###                         VersionDB Configuration                         ###
@@ -62,8 +62,24 @@ const (
 
 // EVM flags
 const (
-	EVMTracer         = "evm.tracer"
-	EVMMaxTxGasWanted = "evm.max-tx-gas-wanted"
+	EVMTracer                   = "evm.tracer"
+	EVMMaxTxGasWanted           = "evm.max-tx-gas-wanted"
+	EVMMempoolMaxTxs            = "evm.mempool-max-txs"
+	EVMMempoolMaxTxsPerSender   = "evm.mempool-max-txs-per-sender"
+	EVMMempoolMaxTxAge          = "evm.mempool-max-tx-age"
+	EVMMempoolPriceBump         = "evm.mempool-price-bump"
+	EVMMempoolQueueFutureNonces = "evm.mempool-queue-future-nonces"
+	EVMPrivateTxMaxBlocks       = "evm.private-tx-max-blocks"
+	EVMPrivateTxBroadcast       = "evm.private-tx-broadcast"
+)
+
+// Firewall flags
+const (
+	FirewallDenySenders     = "firewall.deny-senders"
+	FirewallDenyRecipients  = "firewall.deny-recipients"
+	FirewallDenySelectors   = "firewall.deny-selectors"
+	FirewallRateLimitTxs    = "firewall.rate-limit-txs"
+	FirewallRateLimitBlocks = "firewall.rate-limit-blocks"
 )
 
 // TLS flags
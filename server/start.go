@@ -206,6 +206,19 @@ which accepts a path for the resulting pprof file.
 
 	cmd.Flags().String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
 	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, config.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
+	cmd.Flags().Int(srvflags.EVMMempoolMaxTxs, config.DefaultMempoolMaxTxs, "the maximum number of txs in the app-side mempool")
+	cmd.Flags().Int(srvflags.EVMMempoolMaxTxsPerSender, config.DefaultMempoolMaxTxsPerSender, "the maximum number of Ethereum txs of a single sender in the app-side mempool")
+	cmd.Flags().Duration(srvflags.EVMMempoolMaxTxAge, config.DefaultMempoolMaxTxAge, "the duration after which an Ethereum tx is evicted from the app-side mempool (0=never)")
+	cmd.Flags().Uint64(srvflags.EVMMempoolPriceBump, config.DefaultMempoolPriceBump, "the minimum fee increase, in percent, to replace an Ethereum tx in the app-side mempool")
+	cmd.Flags().Bool(srvflags.EVMMempoolQueueFutureNonces, config.DefaultMempoolQueueFutureNonces, "queue the Ethereum txs with a nonce above the sender sequence in the app-side mempool until the nonce gap is filled")
+	cmd.Flags().Uint64(srvflags.EVMPrivateTxMaxBlocks, config.DefaultPrivateTxMaxBlocks, "the maximum number of blocks during which a private Ethereum tx can be included by the node (0=disabled)")
+	cmd.Flags().Bool(srvflags.EVMPrivateTxBroadcast, false, "broadcast publicly the private Ethereum txs that were not included before their max block instead of dropping them")
+
+	cmd.Flags().StringSlice(srvflags.FirewallDenySenders, []string{}, "the hex or bech32 addresses whose txs are rejected on CheckTx")
+	cmd.Flags().StringSlice(srvflags.FirewallDenyRecipients, []string{}, "the hex or bech32 addresses that cannot be the recipient of a tx received on CheckTx")
+	cmd.Flags().StringSlice(srvflags.FirewallDenySelectors, []string{}, "the 4-byte function selectors that cannot be called by the Ethereum txs received on CheckTx")
+	cmd.Flags().Uint64(srvflags.FirewallRateLimitTxs, config.DefaultFirewallRateLimitTxs, "the maximum number of txs of a single sender accepted on CheckTx per window (0=unlimited)")
+	cmd.Flags().Uint64(srvflags.FirewallRateLimitBlocks, config.DefaultFirewallRateLimitBlocks, "the number of blocks of the firewall rate limit window")
 
 	cmd.Flags().String(srvflags.TLSCertPath, "", "the cert.pem file path for the server TLS configuration")
 	cmd.Flags().String(srvflags.TLSKeyPath, "", "the key.pem file path for the server TLS configuration")
@@ -464,7 +477,19 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 		defer apiSrv.Close()
 	}
 
-	clientCtx, httpSrv, httpSrvDone, err := startJSONRPCServer(svrCtx, clientCtx, g, config, genDocProvider, cfg.RPC.ListenAddress, idxer)
+	var privatePool aizeltypes.PrivateTxPool
+	if provider, ok := app.(aizeltypes.PrivateTxPoolProvider); ok && config.JSONRPC.Enable {
+		privatePool = provider.PrivateTxPool()
+		privatePool.SetBroadcaster(func(txBytes []byte) error {
+			rsp, err := clientCtx.WithBroadcastMode(flags.BroadcastSync).BroadcastTx(txBytes)
+			if rsp != nil && rsp.Code != 0 {
+				err = errorsmod.ABCIError(rsp.Codespace, rsp.Code, rsp.RawLog)
+			}
+			return err
+		})
+	}
+
+	clientCtx, httpSrv, httpSrvDone, err := startJSONRPCServer(svrCtx, clientCtx, g, config, genDocProvider, cfg.RPC.ListenAddress, idxer, privatePool)
 	if httpSrv != nil {
 		defer func() {
 			shutdownCtx, cancelFn := context.WithTimeout(context.Background(), 10*time.Second)
@@ -639,6 +664,7 @@ func startAPIServer(
 // - genDocProvider: A function that provides the Genesis document, used to retrieve the chain ID.
 // - cmtRPCAddr: The address of the CometBFT RPC server for WebSocket connections.
 // - idxer: The EVM transaction indexer for indexing transactions.
+// - privatePool: The node-local pool of the private Ethereum transactions, nil if not supported by the app.
 func startJSONRPCServer(
 	svrCtx *server.Context,
 	clientCtx client.Context,
@@ -647,6 +673,7 @@ func startJSONRPCServer(
 	genDocProvider node.GenesisDocProvider,
 	cmtRPCAddr string,
 	idxer aizeltypes.EVMTxIndexer,
+	privatePool aizeltypes.PrivateTxPool,
 ) (ctx client.Context, httpSrv *http.Server, httpSrvDone chan struct{}, err error) {
 	ctx = clientCtx
 	if !config.JSONRPC.Enable {
@@ -661,7 +688,7 @@ func startJSONRPCServer(
 	ctx = clientCtx.WithChainID(genDoc.ChainID)
 	cmtEndpoint := "/websocket"
 	g.Go(func() error {
-		httpSrv, httpSrvDone, err = StartJSONRPC(svrCtx, clientCtx, cmtRPCAddr, cmtEndpoint, &config, idxer)
+		httpSrv, httpSrvDone, err = StartJSONRPC(svrCtx, clientCtx, cmtRPCAddr, cmtEndpoint, &config, idxer, privatePool)
 		return err
 	})
 	return
@@ -39,7 +39,9 @@ import (
 	epochstypes "github.com/AizelNetwork/CosmEvm/x/epochs/types"
 	erc20types "github.com/AizelNetwork/CosmEvm/x/erc20/types"
 	feemarkettypes "github.com/AizelNetwork/CosmEvm/x/feemarket/types"
+	ibccallbackstypes "github.com/AizelNetwork/CosmEvm/x/ibc/callbacks/types"
 	infltypes "github.com/AizelNetwork/CosmEvm/x/inflation/v1/types"
+	revenuetypes "github.com/AizelNetwork/CosmEvm/x/revenue/types"
 
 	evmtypes "github.com/AizelNetwork/CosmEvm/x/evm/types"
 )
@@ -61,15 +63,17 @@ type defaultGenesisParams struct {
 // genesisSetupFunctions contains the available genesis setup functions
 // that can be used to customize the network genesis
 var genesisSetupFunctions = map[string]genSetupFn{
-	evmtypes.ModuleName:       genStateSetter[*evmtypes.GenesisState](evmtypes.ModuleName),
-	erc20types.ModuleName:     genStateSetter[*erc20types.GenesisState](erc20types.ModuleName),
-	govtypes.ModuleName:       genStateSetter[*govtypesv1.GenesisState](govtypes.ModuleName),
-	infltypes.ModuleName:      genStateSetter[*infltypes.GenesisState](infltypes.ModuleName),
-	feemarkettypes.ModuleName: genStateSetter[*feemarkettypes.GenesisState](feemarkettypes.ModuleName),
-	distrtypes.ModuleName:     genStateSetter[*distrtypes.GenesisState](distrtypes.ModuleName),
-	banktypes.ModuleName:      setBankGenesisState,
-	authtypes.ModuleName:      setAuthGenesisState,
-	epochstypes.ModuleName:    genStateSetter[*epochstypes.GenesisState](epochstypes.ModuleName),
+	evmtypes.ModuleName:          genStateSetter[*evmtypes.GenesisState](evmtypes.ModuleName),
+	erc20types.ModuleName:        genStateSetter[*erc20types.GenesisState](erc20types.ModuleName),
+	govtypes.ModuleName:          genStateSetter[*govtypesv1.GenesisState](govtypes.ModuleName),
+	infltypes.ModuleName:         genStateSetter[*infltypes.GenesisState](infltypes.ModuleName),
+	feemarkettypes.ModuleName:    genStateSetter[*feemarkettypes.GenesisState](feemarkettypes.ModuleName),
+	distrtypes.ModuleName:        genStateSetter[*distrtypes.GenesisState](distrtypes.ModuleName),
+	banktypes.ModuleName:         setBankGenesisState,
+	authtypes.ModuleName:         setAuthGenesisState,
+	epochstypes.ModuleName:       genStateSetter[*epochstypes.GenesisState](epochstypes.ModuleName),
+	revenuetypes.ModuleName:      genStateSetter[*revenuetypes.GenesisState](revenuetypes.ModuleName),
+	ibccallbackstypes.ModuleName: genStateSetter[*ibccallbackstypes.GenesisState](ibccallbackstypes.ModuleName),
 	consensustypes.ModuleName: func(_ *app.Evmos, genesisState aizeltypes.GenesisState, _ interface{}) (aizeltypes.GenesisState, error) {
 		// no-op. Consensus does not have a genesis state on the application
 		// but the params are used on it
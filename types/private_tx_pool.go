@@ -0,0 +1,29 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// PrivateTxPool defines the interface of the node-local pool of private
+// Ethereum txs. Private txs are not gossiped and are only included in the
+// blocks proposed by the node.
+type PrivateTxPool interface {
+	// AddTx adds an encoded tx that can be included up to the given block
+	// height. Zero uses the max number of blocks of the pool.
+	AddTx(txBytes []byte, maxBlockHeight int64) (common.Hash, error)
+	// CancelTx removes the tx with the given Ethereum hash sent by the given
+	// sender. It returns false if the tx isn't in the pool or wasn't sent by
+	// the sender.
+	CancelTx(hash common.Hash, sender common.Address) bool
+	// SetBroadcaster sets the function used to broadcast publicly the txs that
+	// were not included before their max block height.
+	SetBroadcaster(broadcast func(txBytes []byte) error)
+}
+
+// PrivateTxPoolProvider defines the interface of the apps with a private tx
+// pool.
+type PrivateTxPoolProvider interface {
+	PrivateTxPool() PrivateTxPool
+}
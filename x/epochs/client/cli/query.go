@@ -29,6 +29,7 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetCmdEpochsInfos(),
 		GetCmdCurrentEpoch(),
+		GetCmdEpochSubscriptions(),
 	)
 
 	return cmd
@@ -110,3 +111,39 @@ func GetCmdCurrentEpoch() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdEpochSubscriptions provides the contracts subscribed to the end of the
+// specified epoch
+func GetCmdEpochSubscriptions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "subscriptions IDENTIFIER",
+		Short: "Query the contracts subscribed to the end of the specified epoch",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query epochs subscriptions week`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EpochSubscriptions(cmd.Context(), &types.QueryEpochSubscriptionsRequest{
+				Identifier: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
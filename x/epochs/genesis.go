@@ -26,11 +26,16 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 
 		k.SetEpochInfo(ctx, epoch)
 	}
+
+	for _, subscription := range genState.Subscriptions {
+		k.SetEpochSubscription(ctx, subscription)
+	}
 }
 
 // ExportGenesis returns the epochs module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Epochs: k.AllEpochInfos(ctx),
+		Epochs:        k.AllEpochInfos(ctx),
+		Subscriptions: k.AllEpochSubscriptions(ctx),
 	}
 }
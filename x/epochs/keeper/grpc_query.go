@@ -69,3 +69,24 @@ func (k Keeper) CurrentEpoch(
 		CurrentEpoch: info.CurrentEpoch,
 	}, nil
 }
+
+// EpochSubscriptions provides the contracts subscribed to the end of the
+// specified epoch
+func (k Keeper) EpochSubscriptions(
+	c context.Context,
+	req *types.QueryEpochSubscriptionsRequest,
+) (*types.QueryEpochSubscriptionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.ValidateEpochIdentifierString(req.Identifier); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryEpochSubscriptionsResponse{
+		Subscriptions: k.GetEpochSubscriptions(ctx, req.Identifier),
+	}, nil
+}
@@ -8,7 +8,10 @@ import (
 	"github.com/AizelNetwork/CosmEvm/x/epochs/types"
 )
 
-var _ types.EpochHooks = MultiEpochHooks{}
+var (
+	_ types.EpochHooks          = MultiEpochHooks{}
+	_ types.EpochIdentifierUser = MultiEpochHooks{}
+)
 
 // combine multiple epoch hooks, all hook functions are run in array sequence
 type MultiEpochHooks []types.EpochHooks
@@ -33,12 +36,33 @@ func (mh MultiEpochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier stri
 	}
 }
 
-// AfterEpochEnd executes the indicated hook after epochs ends
+// IsEpochIdentifierInUse returns true if any of the hooks that implement the
+// EpochIdentifierUser interface relies on the epoch identifier
+func (mh MultiEpochHooks) IsEpochIdentifierInUse(ctx sdk.Context, epochIdentifier string) bool {
+	for i := range mh {
+		user, ok := mh[i].(types.EpochIdentifierUser)
+		if ok && user.IsEpochIdentifierInUse(ctx, epochIdentifier) {
+			return true
+		}
+	}
+	return false
+}
+
+// AfterEpochEnd executes the indicated hook after epochs ends and notifies the
+// contracts subscribed to the epoch
 func (k Keeper) AfterEpochEnd(ctx sdk.Context, identifier string, epochNumber int64) {
 	k.hooks.AfterEpochEnd(ctx, identifier, epochNumber)
+	k.notifySubscribers(ctx, identifier, epochNumber)
 }
 
 // BeforeEpochStart executes the indicated hook before the epochs
 func (k Keeper) BeforeEpochStart(ctx sdk.Context, identifier string, epochNumber int64) {
 	k.hooks.BeforeEpochStart(ctx, identifier, epochNumber)
 }
+
+// IsEpochIdentifierInUse returns true if a module relies on the epoch with the
+// given identifier
+func (k Keeper) IsEpochIdentifierInUse(ctx sdk.Context, identifier string) bool {
+	user, ok := k.hooks.(types.EpochIdentifierUser)
+	return ok && user.IsEpochIdentifierInUse(ctx, identifier)
+}
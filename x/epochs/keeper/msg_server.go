@@ -0,0 +1,159 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/AizelNetwork/CosmEvm/x/epochs/types"
+)
+
+var _ types.MsgServer = &Keeper{}
+
+// CreateEpoch defines a method for registering a new epoch
+func (k Keeper) CreateEpoch(goCtx context.Context, req *types.MsgCreateEpoch) (*types.MsgCreateEpochResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetEpochInfo(ctx, req.Identifier); found {
+		return nil, errorsmod.Wrapf(types.ErrEpochAlreadyExists, "identifier %s", req.Identifier)
+	}
+
+	startTime := req.StartTime
+	if startTime.IsZero() {
+		startTime = ctx.BlockTime()
+	}
+
+	epoch := types.EpochInfo{
+		Identifier:              req.Identifier,
+		StartTime:               startTime,
+		Duration:                req.Duration,
+		CurrentEpoch:            0,
+		CurrentEpochStartHeight: ctx.BlockHeight(),
+		EpochCountingStarted:    false,
+	}
+	if err := epoch.Validate(); err != nil {
+		return nil, err
+	}
+
+	k.SetEpochInfo(ctx, epoch)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateEpoch,
+			sdk.NewAttribute(types.AttributeEpochIdentifier, epoch.Identifier),
+			sdk.NewAttribute(types.AttributeEpochDuration, epoch.Duration.String()),
+			sdk.NewAttribute(types.AttributeEpochStartTime, strconv.FormatInt(epoch.StartTime.Unix(), 10)),
+		),
+	)
+
+	return &types.MsgCreateEpochResponse{}, nil
+}
+
+// DeleteEpoch defines a method for removing an epoch that is not used by any
+// module. The subscriptions to the epoch are removed as well.
+func (k Keeper) DeleteEpoch(goCtx context.Context, req *types.MsgDeleteEpoch) (*types.MsgDeleteEpochResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetEpochInfo(ctx, req.Identifier); !found {
+		return nil, errorsmod.Wrapf(types.ErrEpochNotFound, "identifier %s", req.Identifier)
+	}
+
+	if k.IsEpochIdentifierInUse(ctx, req.Identifier) {
+		return nil, errorsmod.Wrapf(types.ErrEpochInUse, "identifier %s", req.Identifier)
+	}
+
+	for _, subscription := range k.GetEpochSubscriptions(ctx, req.Identifier) {
+		k.DeleteEpochSubscription(ctx, req.Identifier, common.HexToAddress(subscription.Contract))
+	}
+	k.DeleteEpochInfo(ctx, req.Identifier)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeleteEpoch,
+			sdk.NewAttribute(types.AttributeEpochIdentifier, req.Identifier),
+		),
+	)
+
+	return &types.MsgDeleteEpochResponse{}, nil
+}
+
+// SubscribeEpoch defines a method for subscribing an EVM contract to the end
+// of an epoch
+func (k Keeper) SubscribeEpoch(goCtx context.Context, req *types.MsgSubscribeEpoch) (*types.MsgSubscribeEpochResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetEpochInfo(ctx, req.Identifier); !found {
+		return nil, errorsmod.Wrapf(types.ErrEpochNotFound, "identifier %s", req.Identifier)
+	}
+
+	contract := common.HexToAddress(req.Contract)
+	if _, found := k.GetEpochSubscription(ctx, req.Identifier, contract); found {
+		return nil, errorsmod.Wrapf(
+			types.ErrSubscriptionAlreadyExists,
+			"contract %s is already subscribed to epoch %s", contract, req.Identifier,
+		)
+	}
+
+	subscription := types.NewEpochSubscription(req.Identifier, contract.Hex(), req.GasLimit)
+	if err := subscription.Validate(); err != nil {
+		return nil, err
+	}
+
+	k.SetEpochSubscription(ctx, subscription)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSubscribeEpoch,
+			sdk.NewAttribute(types.AttributeEpochIdentifier, subscription.Identifier),
+			sdk.NewAttribute(types.AttributeContract, subscription.Contract),
+			sdk.NewAttribute(types.AttributeGasLimit, strconv.FormatUint(subscription.GasLimit, 10)),
+		),
+	)
+
+	return &types.MsgSubscribeEpochResponse{}, nil
+}
+
+// UnsubscribeEpoch defines a method for removing an EVM contract subscription
+// to the end of an epoch
+func (k Keeper) UnsubscribeEpoch(goCtx context.Context, req *types.MsgUnsubscribeEpoch) (*types.MsgUnsubscribeEpochResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	contract := common.HexToAddress(req.Contract)
+	if _, found := k.GetEpochSubscription(ctx, req.Identifier, contract); !found {
+		return nil, errorsmod.Wrapf(
+			types.ErrSubscriptionNotFound,
+			"contract %s is not subscribed to epoch %s", contract, req.Identifier,
+		)
+	}
+
+	k.DeleteEpochSubscription(ctx, req.Identifier, contract)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnsubscribeEpoch,
+			sdk.NewAttribute(types.AttributeEpochIdentifier, req.Identifier),
+			sdk.NewAttribute(types.AttributeContract, contract.Hex()),
+		),
+	)
+
+	return &types.MsgUnsubscribeEpochResponse{}, nil
+}
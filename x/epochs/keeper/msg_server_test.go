@@ -0,0 +1,187 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	utiltx "github.com/AizelNetwork/CosmEvm/testutil/tx"
+	"github.com/AizelNetwork/CosmEvm/x/epochs/types"
+)
+
+func TestCreateAndDeleteEpoch(t *testing.T) {
+	suite := SetupTest([]types.EpochInfo{})
+	ctx := suite.network.GetContext()
+	k := suite.network.App.EpochsKeeper
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	_, err := k.CreateEpoch(ctx, types.NewMsgCreateEpoch(suite.keyring.GetAccAddr(0), "hour", time.Hour, time.Time{}))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	_, err = k.CreateEpoch(ctx, types.NewMsgCreateEpoch(authority, types.WeekEpochID, time.Hour, time.Time{}))
+	require.ErrorIs(t, err, types.ErrEpochAlreadyExists)
+
+	_, err = k.CreateEpoch(ctx, types.NewMsgCreateEpoch(authority, "hour", time.Hour, time.Time{}))
+	require.NoError(t, err)
+
+	epochInfo, found := k.GetEpochInfo(ctx, "hour")
+	require.True(t, found)
+	require.Equal(t, time.Hour, epochInfo.Duration)
+	require.Equal(t, ctx.BlockTime(), epochInfo.StartTime)
+	require.False(t, epochInfo.EpochCountingStarted)
+
+	// the day epoch is used by the inflation module
+	_, err = k.DeleteEpoch(ctx, types.NewMsgDeleteEpoch(authority, types.DayEpochID))
+	require.ErrorIs(t, err, types.ErrEpochInUse)
+
+	_, err = k.DeleteEpoch(ctx, types.NewMsgDeleteEpoch(authority, "minute"))
+	require.ErrorIs(t, err, types.ErrEpochNotFound)
+
+	contract := utiltx.GenerateAddress()
+	_, err = k.SubscribeEpoch(ctx, types.NewMsgSubscribeEpoch(authority, "hour", contract.Hex(), 100_000))
+	require.NoError(t, err)
+
+	_, err = k.DeleteEpoch(ctx, types.NewMsgDeleteEpoch(authority, "hour"))
+	require.NoError(t, err)
+
+	_, found = k.GetEpochInfo(ctx, "hour")
+	require.False(t, found)
+	require.Empty(t, k.GetEpochSubscriptions(ctx, "hour"))
+}
+
+func TestSubscribeAndUnsubscribeEpoch(t *testing.T) {
+	suite := SetupTest([]types.EpochInfo{})
+	ctx := suite.network.GetContext()
+	k := suite.network.App.EpochsKeeper
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	contract := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgSubscribeEpoch
+		expErr error
+	}{
+		{
+			"fail - invalid authority",
+			types.NewMsgSubscribeEpoch(suite.keyring.GetAccAddr(0), types.WeekEpochID, contract.Hex(), 100_000),
+			govtypes.ErrInvalidSigner,
+		},
+		{
+			"fail - unknown epoch",
+			types.NewMsgSubscribeEpoch(authority, "hour", contract.Hex(), 100_000),
+			types.ErrEpochNotFound,
+		},
+		{
+			"fail - gas limit above the cap",
+			types.NewMsgSubscribeEpoch(authority, types.WeekEpochID, contract.Hex(), types.MaxSubscriptionGasLimit+1),
+			types.ErrInvalidGasLimit,
+		},
+		{
+			"pass",
+			types.NewMsgSubscribeEpoch(authority, types.WeekEpochID, contract.Hex(), 100_000),
+			nil,
+		},
+		{
+			"fail - already subscribed",
+			types.NewMsgSubscribeEpoch(authority, types.WeekEpochID, contract.Hex(), 100_000),
+			types.ErrSubscriptionAlreadyExists,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := k.SubscribeEpoch(ctx, tc.msg)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	subscriptions := k.GetEpochSubscriptions(ctx, types.WeekEpochID)
+	require.Equal(t, []types.EpochSubscription{
+		types.NewEpochSubscription(types.WeekEpochID, contract.Hex(), 100_000),
+	}, subscriptions)
+	require.Empty(t, k.GetEpochSubscriptions(ctx, types.DayEpochID))
+
+	_, err := k.UnsubscribeEpoch(ctx, types.NewMsgUnsubscribeEpoch(authority, types.DayEpochID, contract.Hex()))
+	require.ErrorIs(t, err, types.ErrSubscriptionNotFound)
+
+	_, err = k.UnsubscribeEpoch(ctx, types.NewMsgUnsubscribeEpoch(authority, types.WeekEpochID, contract.Hex()))
+	require.NoError(t, err)
+	require.Empty(t, k.GetEpochSubscriptions(ctx, types.WeekEpochID))
+}
+
+func TestEpochSubscribersCalledAtEpochEnd(t *testing.T) {
+	now := time.Now().UTC()
+	suite := SetupTest([]types.EpochInfo{
+		{
+			Identifier:            monthIdentifier,
+			StartTime:             now,
+			Duration:              month,
+			CurrentEpochStartTime: now,
+		},
+	})
+	ctx := suite.network.GetContext()
+	k := suite.network.App.EpochsKeeper
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	// the ERC20 contract does not implement the epoch subscriber interface, so
+	// its call reverts without affecting the other subscribers
+	reverting, err := suite.network.App.Erc20Keeper.DeployERC20Contract(ctx, banktypes.Metadata{
+		Description: "test token",
+		Base:        "acoin",
+		Display:     "coin",
+		Name:        "coin",
+		Symbol:      "COIN",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "acoin", Exponent: 0},
+			{Denom: "coin", Exponent: 18},
+		},
+	})
+	require.NoError(t, err)
+	noCode := utiltx.GenerateAddress()
+
+	_, err = k.SubscribeEpoch(ctx, types.NewMsgSubscribeEpoch(authority, monthIdentifier, reverting.Hex(), 100_000))
+	require.NoError(t, err)
+	_, err = k.SubscribeEpoch(ctx, types.NewMsgSubscribeEpoch(authority, monthIdentifier, noCode.Hex(), 100_000))
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(now.Add(time.Second))
+	require.NoError(t, k.BeginBlocker(ctx))
+
+	ctx = ctx.WithBlockHeight(3).WithBlockTime(now.Add(2 * time.Second).Add(month))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.BeginBlocker(ctx))
+
+	epochInfo, found := k.GetEpochInfo(ctx, monthIdentifier)
+	require.True(t, found)
+	require.Equal(t, int64(2), epochInfo.CurrentEpoch)
+
+	results := map[string]string{}
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeEpochSubscriber {
+			continue
+		}
+		var contract, success string
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case types.AttributeContract:
+				contract = attr.Value
+			case types.AttributeSuccess:
+				success = attr.Value
+			}
+		}
+		results[contract] = success
+	}
+	require.Equal(t, map[string]string{
+		reverting.Hex(): "false",
+		noCode.Hex():    "true",
+	}, results)
+}
@@ -34,7 +34,7 @@ func SetupTest(epochsInfo []types.EpochInfo) *KeeperTestSuite {
 	epochsGenesis := types.DefaultGenesisState()
 
 	if len(epochsInfo) > 0 {
-		epochsGenesis = types.NewGenesisState(epochsInfo)
+		epochsGenesis = types.NewGenesisState(epochsInfo, []types.EpochSubscription{})
 	}
 
 	customGenesis[types.ModuleName] = epochsGenesis
@@ -0,0 +1,160 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/AizelNetwork/CosmEvm/x/epochs/types"
+)
+
+// GetEpochSubscription returns the subscription of the contract to the epoch
+// with the given identifier
+func (k Keeper) GetEpochSubscription(ctx sdk.Context, identifier string, contract common.Address) (types.EpochSubscription, bool) {
+	subscription := types.EpochSubscription{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSubscription)
+	bz := store.Get(append(types.SubscriptionKeyPrefix(identifier), contract.Bytes()...))
+	if len(bz) == 0 {
+		return subscription, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &subscription)
+	return subscription, true
+}
+
+// SetEpochSubscription stores the epoch subscription
+func (k Keeper) SetEpochSubscription(ctx sdk.Context, subscription types.EpochSubscription) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSubscription)
+	contract := common.HexToAddress(subscription.Contract)
+	bz := k.cdc.MustMarshal(&subscription)
+	store.Set(append(types.SubscriptionKeyPrefix(subscription.Identifier), contract.Bytes()...), bz)
+}
+
+// DeleteEpochSubscription removes the subscription of the contract to the
+// epoch with the given identifier
+func (k Keeper) DeleteEpochSubscription(ctx sdk.Context, identifier string, contract common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSubscription)
+	store.Delete(append(types.SubscriptionKeyPrefix(identifier), contract.Bytes()...))
+}
+
+// IterateEpochSubscriptions iterates through the subscriptions to the epoch
+// with the given identifier. If the identifier is empty, all subscriptions are
+// iterated.
+func (k Keeper) IterateEpochSubscriptions(ctx sdk.Context, identifier string, fn func(subscription types.EpochSubscription) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSubscription)
+
+	var keyPrefix []byte
+	if identifier != "" {
+		keyPrefix = types.SubscriptionKeyPrefix(identifier)
+	}
+
+	iterator := storetypes.KVStorePrefixIterator(store, keyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		subscription := types.EpochSubscription{}
+		k.cdc.MustUnmarshal(iterator.Value(), &subscription)
+
+		if fn(subscription) {
+			break
+		}
+	}
+}
+
+// GetEpochSubscriptions returns the subscriptions to the epoch with the given
+// identifier
+func (k Keeper) GetEpochSubscriptions(ctx sdk.Context, identifier string) []types.EpochSubscription {
+	subscriptions := []types.EpochSubscription{}
+	k.IterateEpochSubscriptions(ctx, identifier, func(subscription types.EpochSubscription) (stop bool) {
+		subscriptions = append(subscriptions, subscription)
+		return false
+	})
+	return subscriptions
+}
+
+// AllEpochSubscriptions returns every epoch subscription in the store
+func (k Keeper) AllEpochSubscriptions(ctx sdk.Context) []types.EpochSubscription {
+	return k.GetEpochSubscriptions(ctx, "")
+}
+
+// notifySubscribers calls the contracts subscribed to the epoch that has
+// ended. Each call is executed with the gas limit of its subscription in a
+// cached context, so a failing or panicking contract does not affect the state
+// or the other subscribers.
+func (k Keeper) notifySubscribers(ctx sdk.Context, identifier string, epochNumber int64) {
+	subscriptions := k.GetEpochSubscriptions(ctx, identifier)
+	if len(subscriptions) == 0 {
+		return
+	}
+
+	moduleAcc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	from := common.BytesToAddress(moduleAcc.GetAddress().Bytes())
+
+	for _, subscription := range subscriptions {
+		cacheCtx, writeFn := ctx.CacheContext()
+		gasUsed, err := k.notifySubscriber(cacheCtx, from, subscription, identifier, epochNumber)
+		if err != nil {
+			k.Logger(ctx).Error(
+				"failed to notify epoch subscriber contract",
+				"identifier", identifier,
+				"contract", subscription.Contract,
+				"error", err.Error(),
+			)
+		} else {
+			writeFn()
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeEpochSubscriber,
+				sdk.NewAttribute(types.AttributeEpochIdentifier, identifier),
+				sdk.NewAttribute(types.AttributeEpochNumber, strconv.FormatInt(epochNumber, 10)),
+				sdk.NewAttribute(types.AttributeContract, subscription.Contract),
+				sdk.NewAttribute(types.AttributeGasUsed, strconv.FormatUint(gasUsed, 10)),
+				sdk.NewAttribute(types.AttributeSuccess, strconv.FormatBool(err == nil)),
+			),
+		)
+	}
+}
+
+// notifySubscriber calls the subscribed contract with the gas limit of its
+// subscription. It returns the gas used by the execution, which is the full gas
+// limit if the call fails or panics.
+func (k Keeper) notifySubscriber(
+	ctx sdk.Context,
+	from common.Address,
+	subscription types.EpochSubscription,
+	identifier string,
+	epochNumber int64,
+) (gasUsed uint64, err error) {
+	gasLimit := subscription.GasLimit
+	if gasLimit > types.MaxSubscriptionGasLimit {
+		gasLimit = types.MaxSubscriptionGasLimit
+	}
+
+	// recover from any panic raised during the call, e.g. out of gas
+	defer func() {
+		if r := recover(); r != nil {
+			gasUsed = gasLimit
+			err = fmt.Errorf("epoch subscriber panicked: %v", r)
+		}
+	}()
+
+	res, err := k.evmKeeper.CallEVMWithGasLimit(
+		ctx, types.EpochSubscriberABI, from, common.HexToAddress(subscription.Contract), gasLimit,
+		types.EpochSubscriberMethod, identifier, epochNumber,
+	)
+	if err != nil {
+		// the gas used by a failed call is not returned
+		return gasLimit, err
+	}
+
+	return res.GasUsed, nil
+}
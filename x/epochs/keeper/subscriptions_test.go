@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	utiltx "github.com/AizelNetwork/CosmEvm/testutil/tx"
+	"github.com/AizelNetwork/CosmEvm/x/epochs/keeper"
+	"github.com/AizelNetwork/CosmEvm/x/epochs/types"
+	evmtypes "github.com/AizelNetwork/CosmEvm/x/evm/types"
+)
+
+// mockEVMKeeper panics or fails when calling the given contracts and records
+// the contracts that were called.
+type mockEVMKeeper struct {
+	panicking common.Address
+	reverting common.Address
+	called    []common.Address
+}
+
+func (k *mockEVMKeeper) CallEVMWithGasLimit(
+	_ sdk.Context, _ abi.ABI, _, contract common.Address, gasLimit uint64, _ string, _ ...interface{},
+) (*evmtypes.MsgEthereumTxResponse, error) {
+	k.called = append(k.called, contract)
+	if contract == k.panicking {
+		panic(storetypes.ErrorOutOfGas{Descriptor: "epoch subscriber"})
+	}
+	if contract == k.reverting {
+		return nil, errors.New("execution reverted")
+	}
+	return &evmtypes.MsgEthereumTxResponse{GasUsed: gasLimit / 2}, nil
+}
+
+func TestNotifySubscribersRecoversPanics(t *testing.T) {
+	suite := SetupTest([]types.EpochInfo{})
+	app := suite.network.App
+	ctx := suite.network.GetContext().WithEventManager(sdk.NewEventManager())
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	evmKeeper := &mockEVMKeeper{panicking: utiltx.GenerateAddress(), reverting: utiltx.GenerateAddress()}
+	k := keeper.NewKeeper(
+		app.AppCodec(), app.GetKey(types.StoreKey), authority, app.AccountKeeper, evmKeeper,
+	).SetHooks(keeper.NewMultiEpochHooks())
+
+	contract := utiltx.GenerateAddress()
+	for _, addr := range []common.Address{evmKeeper.panicking, evmKeeper.reverting, contract} {
+		_, err := k.SubscribeEpoch(ctx, types.NewMsgSubscribeEpoch(authority, types.WeekEpochID, addr.Hex(), 100_000))
+		require.NoError(t, err)
+	}
+
+	require.NotPanics(t, func() {
+		k.AfterEpochEnd(ctx, types.WeekEpochID, 1)
+	})
+	require.ElementsMatch(t, []common.Address{evmKeeper.panicking, evmKeeper.reverting, contract}, evmKeeper.called)
+
+	expGasUsed := map[string]string{
+		evmKeeper.panicking.Hex(): "100000",
+		evmKeeper.reverting.Hex(): "100000",
+		contract.Hex():            "50000",
+	}
+	expSuccess := map[string]string{
+		evmKeeper.panicking.Hex(): "false",
+		evmKeeper.reverting.Hex(): "false",
+		contract.Hex():            "true",
+	}
+
+	var notified int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeEpochSubscriber {
+			continue
+		}
+		notified++
+
+		attr, ok := event.GetAttribute(types.AttributeContract)
+		require.True(t, ok)
+		gasUsed, ok := event.GetAttribute(types.AttributeGasUsed)
+		require.True(t, ok)
+		require.Equal(t, expGasUsed[attr.Value], gasUsed.Value)
+		success, ok := event.GetAttribute(types.AttributeSuccess)
+		require.True(t, ok)
+		require.Equal(t, expSuccess[attr.Value], success.Value)
+	}
+	require.Equal(t, 3, notified)
+}
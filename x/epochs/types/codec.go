@@ -6,6 +6,51 @@ package types
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
 )
 
-var ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+var (
+	amino = codec.NewLegacyAmino()
+	// ModuleCdc references the global epochs module codec. Note, the codec should
+	// ONLY be used in certain instances of tests and for JSON encoding.
+	ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	// AminoCdc is a amino codec created to support amino JSON compatible msgs.
+	AminoCdc = codec.NewAminoCodec(amino) //nolint:staticcheck
+)
+
+const (
+	// Amino names
+	createEpochName      = "aizel/epochs/MsgCreateEpoch"
+	deleteEpochName      = "aizel/epochs/MsgDeleteEpoch"
+	subscribeEpochName   = "aizel/epochs/MsgSubscribeEpoch"
+	unsubscribeEpochName = "aizel/epochs/MsgUnsubscribeEpoch"
+)
+
+// NOTE: This is required for the GetSignBytes function
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	amino.Seal()
+}
+
+// RegisterInterfaces register implementations
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgCreateEpoch{},
+		&MsgDeleteEpoch{},
+		&MsgSubscribeEpoch{},
+		&MsgUnsubscribeEpoch{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+// RegisterLegacyAminoCodec required for EIP-712
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgCreateEpoch{}, createEpochName, nil)
+	cdc.RegisterConcrete(&MsgDeleteEpoch{}, deleteEpochName, nil)
+	cdc.RegisterConcrete(&MsgSubscribeEpoch{}, subscribeEpochName, nil)
+	cdc.RegisterConcrete(&MsgUnsubscribeEpoch{}, unsubscribeEpochName, nil)
+}
@@ -0,0 +1,18 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrEpochNotFound             = errorsmod.Register(ModuleName, 2, "epoch not found")
+	ErrEpochAlreadyExists        = errorsmod.Register(ModuleName, 3, "epoch already exists")
+	ErrEpochInUse                = errorsmod.Register(ModuleName, 4, "epoch identifier is in use")
+	ErrSubscriptionNotFound      = errorsmod.Register(ModuleName, 5, "epoch subscription not found")
+	ErrSubscriptionAlreadyExists = errorsmod.Register(ModuleName, 6, "epoch subscription already exists")
+	ErrInvalidGasLimit           = errorsmod.Register(ModuleName, 7, "invalid epoch subscription gas limit")
+)
@@ -8,6 +8,18 @@ const (
 	EventTypeEpochEnd   = "epoch_end"
 	EventTypeEpochStart = "epoch_start"
 
-	AttributeEpochNumber    = "epoch_number"
-	AttributeEpochStartTime = "start_time"
+	EventTypeCreateEpoch      = "create_epoch"
+	EventTypeDeleteEpoch      = "delete_epoch"
+	EventTypeSubscribeEpoch   = "subscribe_epoch"
+	EventTypeUnsubscribeEpoch = "unsubscribe_epoch"
+	EventTypeEpochSubscriber  = "epoch_subscriber"
+
+	AttributeEpochNumber     = "epoch_number"
+	AttributeEpochStartTime  = "start_time"
+	AttributeEpochIdentifier = "identifier"
+	AttributeEpochDuration   = "duration"
+	AttributeContract        = "contract"
+	AttributeGasLimit        = "gas_limit"
+	AttributeGasUsed         = "gas_used"
+	AttributeSuccess         = "success"
 )
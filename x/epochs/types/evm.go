@@ -0,0 +1,30 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// EpochSubscriberMethod is the method called on subscribed contracts at the
+// end of an epoch.
+const EpochSubscriberMethod = "onEpochEnd"
+
+// epochSubscriberABIJSON is the ABI of the epoch subscriber interface that
+// subscribed contracts must implement:
+//
+//	function onEpochEnd(string identifier, int64 epochNumber) external;
+const epochSubscriberABIJSON = `[{"inputs":[{"internalType":"string","name":"identifier","type":"string"},{"internalType":"int64","name":"epochNumber","type":"int64"}],"name":"onEpochEnd","outputs":[],"stateMutability":"nonpayable","type":"function"}]`
+
+// EpochSubscriberABI is the ABI used to notify subscribed contracts.
+var EpochSubscriberABI abi.ABI
+
+func init() {
+	var err error
+	if EpochSubscriberABI, err = abi.JSON(strings.NewReader(epochSubscriberABIJSON)); err != nil {
+		panic(err)
+	}
+}
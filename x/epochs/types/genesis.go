@@ -5,12 +5,13 @@ package types
 
 import (
 	"fmt"
+	"strings"
 	"time"
 )
 
 // NewGenesisState creates a new genesis state instance
-func NewGenesisState(epochs []EpochInfo) *GenesisState {
-	return &GenesisState{Epochs: epochs}
+func NewGenesisState(epochs []EpochInfo, subscriptions []EpochSubscription) *GenesisState {
+	return &GenesisState{Epochs: epochs, Subscriptions: subscriptions}
 }
 
 // DefaultGenesisState returns the default epochs genesis state
@@ -35,7 +36,7 @@ func DefaultGenesisState() *GenesisState {
 			EpochCountingStarted:    false,
 		},
 	}
-	return NewGenesisState(epochs)
+	return NewGenesisState(epochs, []EpochSubscription{})
 }
 
 // Validate performs basic genesis state validation returning an error upon any
@@ -53,5 +54,21 @@ func (gs GenesisState) Validate() error {
 		epochIdentifiers[epoch.Identifier] = true
 	}
 
+	subscriptions := make(map[string]bool)
+
+	for _, subscription := range gs.Subscriptions {
+		if err := subscription.Validate(); err != nil {
+			return err
+		}
+		if !epochIdentifiers[subscription.Identifier] {
+			return fmt.Errorf("subscription to unknown epoch %s", subscription.Identifier)
+		}
+		key := subscription.Identifier + "/" + strings.ToLower(subscription.Contract)
+		if subscriptions[key] {
+			return fmt.Errorf("duplicated subscription of contract %s to epoch %s", subscription.Contract, subscription.Identifier)
+		}
+		subscriptions[key] = true
+	}
+
 	return nil
 }
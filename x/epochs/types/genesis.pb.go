@@ -134,6 +134,9 @@ func (m *EpochInfo) GetCurrentEpochStartHeight() int64 {
 type GenesisState struct {
 	// epochs is a slice of EpochInfo that defines the epochs in the genesis state
 	Epochs []EpochInfo `protobuf:"bytes,1,rep,name=epochs,proto3" json:"epochs"`
+	// subscriptions is a slice of EpochSubscription that defines the contracts
+	// called at the end of each epoch
+	Subscriptions []EpochSubscription `protobuf:"bytes,2,rep,name=subscriptions,proto3" json:"subscriptions"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -176,46 +179,123 @@ func (m *GenesisState) GetEpochs() []EpochInfo {
 	return nil
 }
 
+func (m *GenesisState) GetSubscriptions() []EpochSubscription {
+	if m != nil {
+		return m.Subscriptions
+	}
+	return nil
+}
+
+// EpochSubscription defines an EVM contract that is called at the end of every
+// epoch with the given identifier.
+type EpochSubscription struct {
+	// identifier of the epoch the contract is subscribed to
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// contract is the hex address of the subscribed EVM contract
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// gas_limit is the maximum amount of gas the contract call can consume
+	GasLimit uint64 `protobuf:"varint,3,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (m *EpochSubscription) Reset()         { *m = EpochSubscription{} }
+func (m *EpochSubscription) String() string { return proto.CompactTextString(m) }
+func (*EpochSubscription) ProtoMessage()    {}
+func (*EpochSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c74bc0b3e7fa01c2, []int{2}
+}
+func (m *EpochSubscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochSubscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochSubscription.Merge(m, src)
+}
+func (m *EpochSubscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochSubscription proto.InternalMessageInfo
+
+func (m *EpochSubscription) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *EpochSubscription) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *EpochSubscription) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*EpochInfo)(nil), "evmos.epochs.v1.EpochInfo")
 	proto.RegisterType((*GenesisState)(nil), "evmos.epochs.v1.GenesisState")
+	proto.RegisterType((*EpochSubscription)(nil), "evmos.epochs.v1.EpochSubscription")
 }
 
 func init() { proto.RegisterFile("evmos/epochs/v1/genesis.proto", fileDescriptor_c74bc0b3e7fa01c2) }
 
 var fileDescriptor_c74bc0b3e7fa01c2 = []byte{
-	// 484 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x31, 0x6f, 0x13, 0x31,
-	0x14, 0x8e, 0x49, 0x09, 0x8d, 0x29, 0xaa, 0x6a, 0x15, 0x38, 0x22, 0x71, 0x77, 0x3a, 0x96, 0x80,
-	0xd0, 0xb9, 0x2d, 0x88, 0x01, 0xc4, 0x92, 0x82, 0x80, 0x81, 0x25, 0x61, 0x42, 0x42, 0xd1, 0x25,
-	0x71, 0x1c, 0x4b, 0xb5, 0x7d, 0xba, 0x7b, 0x17, 0x91, 0x1f, 0xc0, 0xde, 0x11, 0xfe, 0x01, 0x23,
-	0x3f, 0xa3, 0x63, 0x47, 0xa6, 0x80, 0x92, 0x01, 0x89, 0xb1, 0xbf, 0x00, 0x9d, 0xed, 0x0b, 0xa1,
-	0x05, 0xb1, 0x9c, 0x7c, 0xef, 0x7b, 0xdf, 0xf7, 0xf9, 0x7d, 0x7a, 0xc6, 0xb7, 0xd9, 0x54, 0xea,
-	0x9c, 0xb2, 0x54, 0x0f, 0x27, 0x39, 0x9d, 0xee, 0x53, 0xce, 0x14, 0xcb, 0x45, 0x1e, 0xa7, 0x99,
-	0x06, 0x4d, 0xb6, 0x0d, 0x1c, 0x5b, 0x38, 0x9e, 0xee, 0xb7, 0x76, 0x12, 0x29, 0x94, 0xa6, 0xe6,
-	0x6b, 0x7b, 0x5a, 0xbb, 0x5c, 0x73, 0x6d, 0x8e, 0xb4, 0x3c, 0xb9, 0xaa, 0xcf, 0xb5, 0xe6, 0x47,
-	0x8c, 0x9a, 0xbf, 0x41, 0x31, 0xa6, 0xa3, 0x22, 0x4b, 0x40, 0x68, 0xe5, 0xf0, 0xe0, 0x3c, 0x0e,
-	0x42, 0xb2, 0x1c, 0x12, 0x99, 0xda, 0x86, 0xe8, 0xd3, 0x06, 0x6e, 0x3e, 0x2f, 0x7d, 0x5f, 0xa9,
-	0xb1, 0x26, 0x3e, 0xc6, 0x62, 0xc4, 0x14, 0x88, 0xb1, 0x60, 0x99, 0x87, 0x42, 0xd4, 0x6e, 0x76,
-	0xd7, 0x2a, 0xe4, 0x1d, 0xc6, 0x39, 0x24, 0x19, 0xf4, 0x4b, 0x19, 0xef, 0x52, 0x88, 0xda, 0x57,
-	0x0f, 0x5a, 0xb1, 0xf5, 0x88, 0x2b, 0x8f, 0xf8, 0x4d, 0xe5, 0xd1, 0x89, 0x4e, 0xe6, 0x41, 0xed,
-	0x6c, 0x1e, 0xec, 0xcc, 0x12, 0x79, 0xf4, 0x38, 0xfa, 0xcd, 0x8d, 0x8e, 0xbf, 0x05, 0xe8, 0xf3,
-	0x8f, 0x2f, 0xf7, 0x50, 0xb7, 0x69, 0xaa, 0x25, 0x87, 0x48, 0xbc, 0x59, 0xdd, 0xdf, 0xab, 0x1b,
-	0xf1, 0x5b, 0x17, 0xc4, 0x9f, 0xb9, 0x86, 0xce, 0xa3, 0x52, 0xfb, 0xe7, 0x3c, 0x20, 0x15, 0xe5,
-	0xbe, 0x96, 0x02, 0x98, 0x4c, 0x61, 0x76, 0x36, 0x0f, 0xb6, 0xad, 0x63, 0x85, 0x45, 0x1f, 0x57,
-	0x7e, 0x2b, 0x0b, 0x72, 0x07, 0x5f, 0x1b, 0x16, 0x59, 0xc6, 0x14, 0xf4, 0x4d, 0xf4, 0xde, 0x46,
-	0x88, 0xda, 0xf5, 0xee, 0x96, 0x2b, 0x9a, 0x58, 0xc8, 0x07, 0x84, 0xbd, 0x3f, 0xba, 0xfa, 0x6b,
-	0x09, 0x5c, 0xfe, 0x6f, 0x02, 0x7b, 0x2e, 0x81, 0xc0, 0xde, 0xe7, 0x5f, 0x4a, 0x6b, 0x79, 0x5c,
-	0x5f, 0xb7, 0xef, 0xad, 0xb2, 0x79, 0x88, 0x6f, 0x58, 0xd2, 0x50, 0x17, 0x0a, 0x84, 0xe2, 0x96,
-	0xcd, 0x46, 0x5e, 0x23, 0x44, 0xed, 0xcd, 0xee, 0xae, 0x41, 0x0f, 0x1d, 0xd8, 0xb3, 0x18, 0x79,
-	0x82, 0x5b, 0x7f, 0xb3, 0x9c, 0x30, 0xc1, 0x27, 0xe0, 0x5d, 0x31, 0xf3, 0xde, 0xbc, 0x60, 0xf8,
-	0xd2, 0xc0, 0xd1, 0x6b, 0xbc, 0xf5, 0xc2, 0xee, 0x69, 0x0f, 0x12, 0x60, 0xe4, 0x29, 0x6e, 0xd8,
-	0x15, 0xf5, 0x50, 0x58, 0x37, 0x73, 0x9f, 0xdb, 0xdb, 0x78, 0xb5, 0x49, 0x9d, 0x66, 0x39, 0xb7,
-	0x1d, 0xc8, 0x91, 0x3a, 0x87, 0x27, 0x0b, 0x1f, 0x9d, 0x2e, 0x7c, 0xf4, 0x7d, 0xe1, 0xa3, 0xe3,
-	0xa5, 0x5f, 0x3b, 0x5d, 0xfa, 0xb5, 0xaf, 0x4b, 0xbf, 0xf6, 0xf6, 0x2e, 0x17, 0x30, 0x29, 0x06,
-	0xf1, 0x50, 0x4b, 0xea, 0x5e, 0x8a, 0xf9, 0x4e, 0x0f, 0xf6, 0xe8, 0xfb, 0xea, 0xd5, 0xc0, 0x2c,
-	0x65, 0xf9, 0xa0, 0x61, 0x32, 0x7e, 0xf0, 0x2b, 0x00, 0x00, 0xff, 0xff, 0xcf, 0xf1, 0x62, 0x17,
-	0x52, 0x03, 0x00, 0x00,
+	// 559 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xb1, 0x6f, 0xd3, 0x4e,
+	0x18, 0xcd, 0x35, 0xf9, 0xe5, 0x17, 0x1f, 0xad, 0xaa, 0x9c, 0x0a, 0x98, 0x20, 0xec, 0xc8, 0x2c,
+	0x11, 0x42, 0x36, 0x05, 0xc4, 0x00, 0x62, 0x20, 0xa5, 0xa2, 0x48, 0x88, 0xc1, 0x61, 0x42, 0x42,
+	0x91, 0xe3, 0x5c, 0x2e, 0x27, 0x72, 0x3e, 0xcb, 0x77, 0x0e, 0x84, 0x9d, 0xbd, 0x23, 0xac, 0x4c,
+	0x8c, 0xfc, 0x19, 0x1d, 0x3b, 0x32, 0x05, 0x94, 0x0c, 0x48, 0x8c, 0xfd, 0x0b, 0x90, 0xef, 0xec,
+	0xe0, 0x36, 0x45, 0x5d, 0xac, 0xbb, 0xef, 0x7d, 0xdf, 0x7b, 0xf7, 0x3d, 0x3d, 0xc3, 0x1b, 0x78,
+	0xca, 0xb8, 0xf0, 0x70, 0xcc, 0xc3, 0xb1, 0xf0, 0xa6, 0xbb, 0x1e, 0xc1, 0x11, 0x16, 0x54, 0xb8,
+	0x71, 0xc2, 0x25, 0x47, 0xdb, 0x0a, 0x76, 0x35, 0xec, 0x4e, 0x77, 0x5b, 0xcd, 0x80, 0xd1, 0x88,
+	0x7b, 0xea, 0xab, 0x7b, 0x5a, 0x3b, 0x84, 0x13, 0xae, 0x8e, 0x5e, 0x76, 0xca, 0xab, 0x16, 0xe1,
+	0x9c, 0x4c, 0xb0, 0xa7, 0x6e, 0x83, 0x74, 0xe4, 0x0d, 0xd3, 0x24, 0x90, 0x94, 0x47, 0x39, 0x6e,
+	0x9f, 0xc5, 0x25, 0x65, 0x58, 0xc8, 0x80, 0xc5, 0xba, 0xc1, 0xf9, 0x5c, 0x83, 0xc6, 0x7e, 0xa6,
+	0xfb, 0x3c, 0x1a, 0x71, 0x64, 0x41, 0x48, 0x87, 0x38, 0x92, 0x74, 0x44, 0x71, 0x62, 0x82, 0x36,
+	0xe8, 0x18, 0x7e, 0xa9, 0x82, 0xde, 0x40, 0x28, 0x64, 0x90, 0xc8, 0x7e, 0x46, 0x63, 0x6e, 0xb4,
+	0x41, 0xe7, 0xd2, 0xdd, 0x96, 0xab, 0x35, 0xdc, 0x42, 0xc3, 0x7d, 0x55, 0x68, 0x74, 0x9d, 0xa3,
+	0xb9, 0x5d, 0x39, 0x99, 0xdb, 0xcd, 0x59, 0xc0, 0x26, 0x0f, 0x9d, 0xbf, 0xb3, 0xce, 0xe1, 0x0f,
+	0x1b, 0x7c, 0xfd, 0xf5, 0xed, 0x16, 0xf0, 0x0d, 0x55, 0xcd, 0x66, 0x10, 0x83, 0x8d, 0xe2, 0xfd,
+	0x66, 0x55, 0x91, 0x5f, 0x5b, 0x23, 0x7f, 0x9a, 0x37, 0x74, 0x1f, 0x64, 0xdc, 0xbf, 0xe7, 0x36,
+	0x2a, 0x46, 0x6e, 0x73, 0x46, 0x25, 0x66, 0xb1, 0x9c, 0x9d, 0xcc, 0xed, 0x6d, 0xad, 0x58, 0x60,
+	0xce, 0xa7, 0x95, 0xde, 0x4a, 0x02, 0xdd, 0x84, 0x5b, 0x61, 0x9a, 0x24, 0x38, 0x92, 0x7d, 0x65,
+	0xbd, 0x59, 0x6b, 0x83, 0x4e, 0xd5, 0xdf, 0xcc, 0x8b, 0xca, 0x16, 0xf4, 0x11, 0x40, 0xf3, 0x54,
+	0x57, 0xbf, 0xe4, 0xc0, 0x7f, 0x17, 0x3a, 0x70, 0x27, 0x77, 0xc0, 0xd6, 0xef, 0xf9, 0x17, 0x53,
+	0xc9, 0x8f, 0xcb, 0x65, 0xf9, 0xde, 0xca, 0x9b, 0xfb, 0xf0, 0x8a, 0x1e, 0x0a, 0x79, 0x1a, 0x49,
+	0x1a, 0x11, 0x3d, 0x8d, 0x87, 0x66, 0xbd, 0x0d, 0x3a, 0x0d, 0x7f, 0x47, 0xa1, 0x7b, 0x39, 0xd8,
+	0xd3, 0x18, 0x7a, 0x04, 0x5b, 0xe7, 0x49, 0x8e, 0x31, 0x25, 0x63, 0x69, 0xfe, 0xaf, 0xf6, 0xbd,
+	0xba, 0x26, 0x78, 0xa0, 0x60, 0xe7, 0x0b, 0x80, 0x9b, 0xcf, 0x74, 0x50, 0x7b, 0x32, 0x90, 0x18,
+	0x3d, 0x86, 0x75, 0x9d, 0x51, 0x13, 0xb4, 0xab, 0x6a, 0xf1, 0x33, 0xc1, 0x75, 0x57, 0x51, 0xea,
+	0x1a, 0xd9, 0xe2, 0x7a, 0xa3, 0x7c, 0x08, 0xf5, 0xe0, 0x96, 0x48, 0x07, 0x22, 0x4c, 0x68, 0x9c,
+	0xf9, 0x2f, 0xcc, 0x0d, 0xc5, 0xe2, 0x9c, 0xcf, 0xd2, 0x2b, 0xb5, 0x96, 0xd9, 0x4e, 0x73, 0x38,
+	0x13, 0xd8, 0x5c, 0x6b, 0xbf, 0x30, 0xc7, 0x2d, 0xd8, 0x08, 0x79, 0x24, 0x93, 0x20, 0x94, 0x2a,
+	0xc5, 0x86, 0xbf, 0xba, 0xa3, 0xeb, 0xd0, 0x20, 0x81, 0xe8, 0x4f, 0x28, 0xa3, 0x52, 0xa5, 0xb0,
+	0xe6, 0x37, 0x48, 0x20, 0x5e, 0x64, 0xf7, 0xee, 0xc1, 0xd1, 0xc2, 0x02, 0xc7, 0x0b, 0x0b, 0xfc,
+	0x5c, 0x58, 0xe0, 0x70, 0x69, 0x55, 0x8e, 0x97, 0x56, 0xe5, 0xfb, 0xd2, 0xaa, 0xbc, 0x76, 0x09,
+	0x95, 0xe3, 0x74, 0xe0, 0x86, 0x9c, 0x79, 0x4f, 0xe8, 0x07, 0x3c, 0x79, 0x89, 0xe5, 0x3b, 0x9e,
+	0xbc, 0xf5, 0xf6, 0xb8, 0x60, 0xfb, 0x53, 0xe6, 0xbd, 0x2f, 0x7e, 0x7f, 0x39, 0x8b, 0xb1, 0x18,
+	0xd4, 0x55, 0x58, 0xee, 0xfd, 0x19, 0x00, 0xe8, 0xef, 0x67, 0x2f, 0x1b, 0x04, 0x00, 0x00,
 }
 
 func (m *EpochInfo) Marshal() (dAtA []byte, err error) {
@@ -312,6 +392,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Subscriptions) > 0 {
+		for iNdEx := len(m.Subscriptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subscriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Epochs) > 0 {
 		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -329,6 +423,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EpochSubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochSubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -380,6 +516,32 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Subscriptions) > 0 {
+		for _, e := range m.Subscriptions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EpochSubscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovGenesis(uint64(m.GasLimit))
+	}
 	return n
 }
 
@@ -691,6 +853,173 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscriptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subscriptions = append(m.Subscriptions, EpochSubscription{})
+			if err := m.Subscriptions[len(m.Subscriptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochSubscription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochSubscription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -16,7 +16,7 @@ func TestGenesisTestSuite(t *testing.T) {
 }
 
 func (suite *GenesisTestSuite) TestValidateGenesis() {
-	newGen := NewGenesisState([]EpochInfo{})
+	newGen := NewGenesisState([]EpochInfo{}, []EpochSubscription{})
 
 	testCases := []struct {
 		name     string
@@ -92,6 +92,47 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			false,
 		},
+		{
+			"valid genesis - with subscriptions",
+			&GenesisState{
+				Epochs: DefaultGenesisState().Epochs,
+				Subscriptions: []EpochSubscription{
+					NewEpochSubscription(WeekEpochID, "0x0000000000000000000000000000000000000001", 100_000),
+				},
+			},
+			true,
+		},
+		{
+			"invalid genesis - subscription to unknown epoch",
+			&GenesisState{
+				Epochs: DefaultGenesisState().Epochs,
+				Subscriptions: []EpochSubscription{
+					NewEpochSubscription("hour", "0x0000000000000000000000000000000000000001", 100_000),
+				},
+			},
+			false,
+		},
+		{
+			"invalid genesis - duplicated subscription",
+			&GenesisState{
+				Epochs: DefaultGenesisState().Epochs,
+				Subscriptions: []EpochSubscription{
+					NewEpochSubscription(WeekEpochID, "0x0000000000000000000000000000000000000001", 100_000),
+					NewEpochSubscription(WeekEpochID, "0x0000000000000000000000000000000000000001", 200_000),
+				},
+			},
+			false,
+		},
+		{
+			"invalid genesis - subscription gas limit above the cap",
+			&GenesisState{
+				Epochs: DefaultGenesisState().Epochs,
+				Subscriptions: []EpochSubscription{
+					NewEpochSubscription(WeekEpochID, "0x0000000000000000000000000000000000000001", MaxSubscriptionGasLimit+1),
+				},
+			},
+			false,
+		},
 		{
 			"invalid genesis - invalid Epoch",
 			&GenesisState{
@@ -3,7 +3,15 @@
 
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	context "context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmtypes "github.com/AizelNetwork/CosmEvm/x/evm/types"
+)
 
 // EpochHooks event hooks for epoch processing
 type EpochHooks interface {
@@ -12,3 +20,22 @@ type EpochHooks interface {
 	// new epoch is next block of epoch end block
 	BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64)
 }
+
+// EpochIdentifierUser is implemented by the epoch hooks of modules that depend
+// on specific epoch identifiers, so that epochs in use cannot be deleted.
+type EpochIdentifierUser interface {
+	// IsEpochIdentifierInUse returns true if the module relies on the epoch
+	// with the given identifier
+	IsEpochIdentifierInUse(ctx sdk.Context, epochIdentifier string) bool
+}
+
+// AccountKeeper defines the expected account keeper interface
+type AccountKeeper interface {
+	GetModuleAccount(ctx context.Context, moduleName string) sdk.ModuleAccountI
+}
+
+// EVMKeeper defines the expected EVM keeper interface used to call subscribed
+// contracts
+type EVMKeeper interface {
+	CallEVMWithGasLimit(ctx sdk.Context, abi abi.ABI, from, contract common.Address, gasLimit uint64, method string, args ...interface{}) (*evmtypes.MsgEthereumTxResponse, error)
+}
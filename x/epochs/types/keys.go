@@ -17,7 +17,19 @@ const (
 // prefix bytes for the epochs persistent store
 const (
 	prefixEpoch = iota + 1
+	prefixSubscription
 )
 
-// KeyPrefixEpoch defines prefix key for storing epochs
-var KeyPrefixEpoch = []byte{prefixEpoch}
+// KVStore key prefixes
+var (
+	// KeyPrefixEpoch defines prefix key for storing epochs
+	KeyPrefixEpoch = []byte{prefixEpoch}
+	// KeyPrefixSubscription defines prefix key for storing epoch subscriptions
+	KeyPrefixSubscription = []byte{prefixSubscription}
+)
+
+// SubscriptionKeyPrefix returns the store prefix of the subscriptions to the
+// epoch with the given identifier.
+func SubscriptionKeyPrefix(identifier string) []byte {
+	return append([]byte(identifier), 0)
+}
@@ -0,0 +1,122 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"errors"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	aizeltypes "github.com/AizelNetwork/CosmEvm/types"
+)
+
+var (
+	_ sdk.Msg = &MsgCreateEpoch{}
+	_ sdk.Msg = &MsgDeleteEpoch{}
+	_ sdk.Msg = &MsgSubscribeEpoch{}
+	_ sdk.Msg = &MsgUnsubscribeEpoch{}
+)
+
+// NewMsgCreateEpoch creates a new instance of MsgCreateEpoch
+func NewMsgCreateEpoch(authority sdk.AccAddress, identifier string, duration time.Duration, startTime time.Time) *MsgCreateEpoch {
+	return &MsgCreateEpoch{
+		Authority:  authority.String(),
+		Identifier: identifier,
+		Duration:   duration,
+		StartTime:  startTime,
+	}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgCreateEpoch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+	if err := ValidateEpochIdentifierString(m.Identifier); err != nil {
+		return err
+	}
+	if m.Duration <= 0 {
+		return errors.New("epoch duration must be positive")
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgCreateEpoch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// NewMsgDeleteEpoch creates a new instance of MsgDeleteEpoch
+func NewMsgDeleteEpoch(authority sdk.AccAddress, identifier string) *MsgDeleteEpoch {
+	return &MsgDeleteEpoch{
+		Authority:  authority.String(),
+		Identifier: identifier,
+	}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgDeleteEpoch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+	return ValidateEpochIdentifierString(m.Identifier)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgDeleteEpoch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// NewMsgSubscribeEpoch creates a new instance of MsgSubscribeEpoch
+func NewMsgSubscribeEpoch(authority sdk.AccAddress, identifier, contract string, gasLimit uint64) *MsgSubscribeEpoch {
+	return &MsgSubscribeEpoch{
+		Authority:  authority.String(),
+		Identifier: identifier,
+		Contract:   contract,
+		GasLimit:   gasLimit,
+	}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgSubscribeEpoch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+	return NewEpochSubscription(m.Identifier, m.Contract, m.GasLimit).Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSubscribeEpoch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// NewMsgUnsubscribeEpoch creates a new instance of MsgUnsubscribeEpoch
+func NewMsgUnsubscribeEpoch(authority sdk.AccAddress, identifier, contract string) *MsgUnsubscribeEpoch {
+	return &MsgUnsubscribeEpoch{
+		Authority:  authority.String(),
+		Identifier: identifier,
+		Contract:   contract,
+	}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUnsubscribeEpoch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+	if err := ValidateEpochIdentifierString(m.Identifier); err != nil {
+		return err
+	}
+	if err := aizeltypes.ValidateNonZeroAddress(m.Contract); err != nil {
+		return errorsmod.Wrap(err, "invalid subscription contract")
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUnsubscribeEpoch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
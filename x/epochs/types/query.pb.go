@@ -228,47 +228,148 @@ func (m *QueryCurrentEpochResponse) GetCurrentEpoch() int64 {
 	return 0
 }
 
+// QueryEpochSubscriptionsRequest is the request type for the
+// Query/EpochSubscriptions RPC method.
+type QueryEpochSubscriptionsRequest struct {
+	// identifier of the epoch
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+}
+
+func (m *QueryEpochSubscriptionsRequest) Reset()         { *m = QueryEpochSubscriptionsRequest{} }
+func (m *QueryEpochSubscriptionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochSubscriptionsRequest) ProtoMessage()    {}
+func (*QueryEpochSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d13f5778acd937ff, []int{4}
+}
+func (m *QueryEpochSubscriptionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochSubscriptionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochSubscriptionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochSubscriptionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochSubscriptionsRequest.Merge(m, src)
+}
+func (m *QueryEpochSubscriptionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochSubscriptionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochSubscriptionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochSubscriptionsRequest proto.InternalMessageInfo
+
+func (m *QueryEpochSubscriptionsRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+// QueryEpochSubscriptionsResponse is the response type for the
+// Query/EpochSubscriptions RPC method.
+type QueryEpochSubscriptionsResponse struct {
+	// subscriptions is a slice of the contracts subscribed to the epoch
+	Subscriptions []EpochSubscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions"`
+}
+
+func (m *QueryEpochSubscriptionsResponse) Reset()         { *m = QueryEpochSubscriptionsResponse{} }
+func (m *QueryEpochSubscriptionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochSubscriptionsResponse) ProtoMessage()    {}
+func (*QueryEpochSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d13f5778acd937ff, []int{5}
+}
+func (m *QueryEpochSubscriptionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochSubscriptionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochSubscriptionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochSubscriptionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochSubscriptionsResponse.Merge(m, src)
+}
+func (m *QueryEpochSubscriptionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochSubscriptionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochSubscriptionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochSubscriptionsResponse proto.InternalMessageInfo
+
+func (m *QueryEpochSubscriptionsResponse) GetSubscriptions() []EpochSubscription {
+	if m != nil {
+		return m.Subscriptions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryEpochsInfoRequest)(nil), "evmos.epochs.v1.QueryEpochsInfoRequest")
 	proto.RegisterType((*QueryEpochsInfoResponse)(nil), "evmos.epochs.v1.QueryEpochsInfoResponse")
 	proto.RegisterType((*QueryCurrentEpochRequest)(nil), "evmos.epochs.v1.QueryCurrentEpochRequest")
 	proto.RegisterType((*QueryCurrentEpochResponse)(nil), "evmos.epochs.v1.QueryCurrentEpochResponse")
+	proto.RegisterType((*QueryEpochSubscriptionsRequest)(nil), "evmos.epochs.v1.QueryEpochSubscriptionsRequest")
+	proto.RegisterType((*QueryEpochSubscriptionsResponse)(nil), "evmos.epochs.v1.QueryEpochSubscriptionsResponse")
 }
 
 func init() { proto.RegisterFile("evmos/epochs/v1/query.proto", fileDescriptor_d13f5778acd937ff) }
 
 var fileDescriptor_d13f5778acd937ff = []byte{
-	// 466 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x33, 0x29, 0x16, 0x3a, 0xad, 0x88, 0x83, 0xd8, 0x74, 0xd5, 0x6d, 0x88, 0xd0, 0xa6,
-	0x39, 0xcc, 0x98, 0x78, 0x13, 0x04, 0x69, 0x51, 0xf1, 0xa6, 0x7b, 0xf4, 0xa2, 0x9b, 0xf5, 0x75,
-	0x3a, 0x60, 0x66, 0xb6, 0x3b, 0x93, 0xc5, 0xde, 0xc4, 0xbb, 0x20, 0x78, 0xf3, 0xe6, 0xcd, 0xa3,
-	0x7f, 0x46, 0x8f, 0x05, 0x2f, 0x9e, 0x44, 0x12, 0xc1, 0x7f, 0x43, 0xf6, 0xcd, 0x44, 0xf3, 0x0b,
-	0xe2, 0x65, 0x19, 0xe6, 0xbd, 0xef, 0xf7, 0x7d, 0xde, 0x77, 0x87, 0xde, 0x80, 0x72, 0x60, 0xac,
-	0x80, 0xdc, 0x64, 0x27, 0x56, 0x94, 0x5d, 0x71, 0x3a, 0x84, 0xe2, 0x8c, 0xe7, 0x85, 0x71, 0x86,
-	0x5d, 0xc1, 0x22, 0xf7, 0x45, 0x5e, 0x76, 0xa3, 0xab, 0xe9, 0x40, 0x69, 0x23, 0xf0, 0xeb, 0x7b,
-	0xa2, 0x4e, 0x66, 0x6c, 0xe5, 0xd0, 0x4f, 0x2d, 0x78, 0xb1, 0x28, 0xbb, 0x7d, 0x70, 0x69, 0x57,
-	0xe4, 0xa9, 0x54, 0x3a, 0x75, 0xca, 0xe8, 0xd0, 0x7b, 0x6b, 0x7e, 0x98, 0x04, 0x0d, 0x56, 0xd9,
-	0x50, 0xbe, 0x26, 0x8d, 0x34, 0x78, 0x14, 0xd5, 0x29, 0xdc, 0xde, 0x94, 0xc6, 0xc8, 0xd7, 0x20,
-	0xd2, 0x5c, 0x89, 0x54, 0x6b, 0xe3, 0xd0, 0x31, 0x68, 0x5a, 0x2f, 0xe9, 0xf5, 0x67, 0xd5, 0xd0,
-	0x87, 0xe8, 0xf9, 0x44, 0x1f, 0x9b, 0x04, 0x4e, 0x87, 0x60, 0x1d, 0x7b, 0x44, 0xe9, 0x3f, 0x80,
-	0x06, 0x69, 0x92, 0xf6, 0x66, 0x6f, 0x8f, 0x7b, 0x5a, 0x5e, 0xd1, 0x72, 0xbf, 0x6a, 0xa0, 0xe5,
-	0x4f, 0x53, 0x09, 0x41, 0x9b, 0x4c, 0x29, 0x5b, 0x9f, 0x09, 0xdd, 0x5e, 0x18, 0x61, 0x73, 0xa3,
-	0x2d, 0xb0, 0xfb, 0x74, 0xdd, 0x2f, 0xd3, 0x20, 0xcd, 0xb5, 0xf6, 0x66, 0x2f, 0xe2, 0x73, 0x89,
-	0x71, 0x14, 0x55, 0x9a, 0xc3, 0x8d, 0xf3, 0x1f, 0xbb, 0xb5, 0x2f, 0xbf, 0xbf, 0x76, 0x48, 0x12,
-	0x44, 0xec, 0xf1, 0x0c, 0x62, 0x1d, 0x11, 0xf7, 0x57, 0x22, 0xfa, 0xd9, 0x33, 0x8c, 0xf7, 0x68,
-	0x03, 0x11, 0x8f, 0x86, 0x45, 0x01, 0xda, 0xe1, 0xd0, 0x49, 0x0e, 0x31, 0xa5, 0xea, 0x15, 0x68,
-	0xa7, 0x8e, 0x15, 0x14, 0x98, 0xc3, 0x46, 0x32, 0x75, 0xd3, 0x7a, 0x40, 0x77, 0x96, 0x68, 0xc3,
-	0x82, 0xb7, 0xe9, 0xe5, 0xcc, 0xdf, 0xbf, 0x40, 0x66, 0xd4, 0xaf, 0x25, 0x5b, 0xd9, 0x54, 0x73,
-	0xef, 0x53, 0x9d, 0x5e, 0x42, 0x0b, 0xf6, 0x96, 0x50, 0xfa, 0x77, 0x63, 0xcb, 0xf6, 0x17, 0xe2,
-	0x58, 0xfe, 0xaf, 0xa2, 0xf6, 0xea, 0x46, 0x0f, 0xd4, 0xda, 0x7d, 0xf7, 0xed, 0xd7, 0xc7, 0xfa,
-	0x0e, 0xdb, 0x16, 0xf3, 0x6f, 0x29, 0x64, 0xfa, 0x9e, 0xd0, 0xad, 0xe9, 0x55, 0xd8, 0xc1, 0x72,
-	0xef, 0x25, 0x51, 0x45, 0x9d, 0xff, 0x69, 0x0d, 0x20, 0x7b, 0x08, 0xd2, 0x64, 0xf1, 0x02, 0xc8,
-	0x4c, 0x60, 0x87, 0x47, 0xe7, 0xa3, 0x98, 0x5c, 0x8c, 0x62, 0xf2, 0x73, 0x14, 0x93, 0x0f, 0xe3,
-	0xb8, 0x76, 0x31, 0x8e, 0x6b, 0xdf, 0xc7, 0x71, 0xed, 0xf9, 0x81, 0x54, 0xee, 0x64, 0xd8, 0xe7,
-	0x99, 0x19, 0x4c, 0x3c, 0xf0, 0x5b, 0xf6, 0xee, 0x88, 0x37, 0x13, 0x3f, 0x77, 0x96, 0x83, 0xed,
-	0xaf, 0xe3, 0x63, 0xbf, 0xfb, 0x27, 0x00, 0x00, 0xff, 0xff, 0xe2, 0xc4, 0xde, 0x7f, 0xae, 0x03,
-	0x00, 0x00,
+	// 555 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcf, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0x33, 0x0d, 0x16, 0x3a, 0x6d, 0x11, 0x07, 0xb1, 0xe9, 0xaa, 0x9b, 0xb0, 0x42, 0x1b,
+	0x23, 0xce, 0x98, 0xf4, 0x26, 0x08, 0xb5, 0xa5, 0xfe, 0xb8, 0x88, 0xa6, 0x37, 0x2f, 0xba, 0x59,
+	0xa7, 0xdb, 0xc1, 0x66, 0x66, 0xbb, 0x33, 0x59, 0xad, 0x22, 0x88, 0x77, 0x41, 0xf0, 0x2f, 0xf0,
+	0xe6, 0xc1, 0x83, 0x7f, 0x46, 0xbd, 0x15, 0xbc, 0x78, 0x12, 0x49, 0x04, 0xff, 0x0d, 0xd9, 0x99,
+	0xa9, 0xd9, 0xcd, 0xae, 0x6d, 0x2e, 0x61, 0x98, 0xf7, 0xbe, 0xef, 0x7d, 0xde, 0xfb, 0x4e, 0x16,
+	0x5e, 0xa4, 0x49, 0x5f, 0x48, 0x42, 0x23, 0x11, 0xec, 0x4a, 0x92, 0xb4, 0xc9, 0xfe, 0x80, 0xc6,
+	0x07, 0x38, 0x8a, 0x85, 0x12, 0xe8, 0xac, 0x0e, 0x62, 0x13, 0xc4, 0x49, 0xdb, 0x39, 0xe7, 0xf7,
+	0x19, 0x17, 0x44, 0xff, 0x9a, 0x1c, 0xa7, 0x15, 0x08, 0x99, 0x56, 0xe8, 0xf9, 0x92, 0x1a, 0x31,
+	0x49, 0xda, 0x3d, 0xaa, 0xfc, 0x36, 0x89, 0xfc, 0x90, 0x71, 0x5f, 0x31, 0xc1, 0x6d, 0xee, 0xe5,
+	0xc9, 0x66, 0x21, 0xe5, 0x54, 0x32, 0x69, 0xc3, 0xe7, 0x43, 0x11, 0x0a, 0x7d, 0x24, 0xe9, 0xc9,
+	0xde, 0x5e, 0x0a, 0x85, 0x08, 0xf7, 0x28, 0xf1, 0x23, 0x46, 0x7c, 0xce, 0x85, 0xd2, 0x15, 0xad,
+	0xc6, 0x7b, 0x0a, 0x2f, 0x3c, 0x4a, 0x9b, 0x6e, 0xe9, 0x9a, 0xf7, 0xf9, 0x8e, 0xe8, 0xd2, 0xfd,
+	0x01, 0x95, 0x0a, 0xdd, 0x81, 0x70, 0x0c, 0x50, 0x03, 0x0d, 0xd0, 0x9c, 0xef, 0xac, 0x60, 0x43,
+	0x8b, 0x53, 0x5a, 0x6c, 0x46, 0xb5, 0xb4, 0xf8, 0xa1, 0x1f, 0x52, 0xab, 0xed, 0x66, 0x94, 0xde,
+	0x27, 0x00, 0x97, 0x0a, 0x2d, 0x64, 0x24, 0xb8, 0xa4, 0xe8, 0x16, 0x9c, 0x35, 0xc3, 0xd4, 0x40,
+	0xa3, 0xda, 0x9c, 0xef, 0x38, 0x78, 0x62, 0x63, 0x58, 0x8b, 0x52, 0xcd, 0xc6, 0xdc, 0xe1, 0xcf,
+	0x7a, 0xe5, 0xf3, 0x9f, 0xaf, 0x2d, 0xd0, 0xb5, 0x22, 0x74, 0x37, 0x87, 0x38, 0xa3, 0x11, 0x57,
+	0x4f, 0x45, 0x34, 0xbd, 0x73, 0x8c, 0x37, 0x61, 0x4d, 0x23, 0x6e, 0x0e, 0xe2, 0x98, 0x72, 0xa5,
+	0x9b, 0x1e, 0xef, 0xc1, 0x85, 0x90, 0x3d, 0xa3, 0x5c, 0xb1, 0x1d, 0x46, 0x63, 0xbd, 0x87, 0xb9,
+	0x6e, 0xe6, 0xc6, 0x5b, 0x87, 0xcb, 0x25, 0x5a, 0x3b, 0xe0, 0x15, 0xb8, 0x18, 0x98, 0xfb, 0x27,
+	0x9a, 0x59, 0xeb, 0xab, 0xdd, 0x85, 0x20, 0x93, 0xec, 0xad, 0x43, 0x77, 0xbc, 0xa0, 0xed, 0x41,
+	0x4f, 0x06, 0x31, 0x8b, 0xb4, 0x49, 0xd3, 0x32, 0x24, 0xb0, 0xfe, 0xdf, 0x0a, 0x96, 0x64, 0x1b,
+	0x2e, 0xca, 0x6c, 0xc0, 0x6e, 0xdc, 0x2b, 0xdf, 0x78, 0xb6, 0x46, 0x76, 0xf3, 0xf9, 0x1a, 0x9d,
+	0x6f, 0x55, 0x78, 0x46, 0x37, 0x46, 0x6f, 0x01, 0x84, 0xff, 0xbc, 0x92, 0x68, 0xb5, 0x50, 0xb6,
+	0xfc, 0x95, 0x39, 0xcd, 0xd3, 0x13, 0xcd, 0x00, 0x5e, 0xfd, 0xdd, 0xf7, 0xdf, 0x1f, 0x67, 0x96,
+	0xd1, 0x12, 0x99, 0xfc, 0x17, 0x98, 0x13, 0x7a, 0x0f, 0xe0, 0x42, 0xd6, 0x04, 0x74, 0xb5, 0xbc,
+	0x76, 0x89, 0xc9, 0x4e, 0x6b, 0x9a, 0x54, 0x0b, 0xb2, 0xa2, 0x41, 0x1a, 0xc8, 0x2d, 0x80, 0xe4,
+	0xac, 0x46, 0x5f, 0x00, 0x44, 0x45, 0x43, 0x10, 0x39, 0x61, 0xe2, 0x32, 0xf3, 0x9d, 0x1b, 0xd3,
+	0x0b, 0x2c, 0xe1, 0x9a, 0x26, 0xbc, 0x8e, 0xae, 0x15, 0x08, 0x73, 0xf6, 0x91, 0xd7, 0xe3, 0x27,
+	0xf4, 0x66, 0xe3, 0xde, 0xe1, 0xd0, 0x05, 0x47, 0x43, 0x17, 0xfc, 0x1a, 0xba, 0xe0, 0xc3, 0xc8,
+	0xad, 0x1c, 0x8d, 0xdc, 0xca, 0x8f, 0x91, 0x5b, 0x79, 0x8c, 0x43, 0xa6, 0x76, 0x07, 0x3d, 0x1c,
+	0x88, 0x3e, 0xb9, 0xcd, 0x5e, 0xd1, 0xbd, 0x07, 0x54, 0xbd, 0x10, 0xf1, 0x73, 0xb2, 0x29, 0x64,
+	0x7f, 0x2b, 0xe9, 0x93, 0x97, 0xc7, 0x1d, 0xd4, 0x41, 0x44, 0x65, 0x6f, 0x56, 0x7f, 0x5a, 0xd6,
+	0xfe, 0x0e, 0x00, 0x27, 0xec, 0x9d, 0x3b, 0x1c, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -287,6 +388,9 @@ type QueryClient interface {
 	EpochInfos(ctx context.Context, in *QueryEpochsInfoRequest, opts ...grpc.CallOption) (*QueryEpochsInfoResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error)
+	// EpochSubscriptions provide the contracts subscribed to the end of the
+	// specified epoch
+	EpochSubscriptions(ctx context.Context, in *QueryEpochSubscriptionsRequest, opts ...grpc.CallOption) (*QueryEpochSubscriptionsResponse, error)
 }
 
 type queryClient struct {
@@ -315,12 +419,24 @@ func (c *queryClient) CurrentEpoch(ctx context.Context, in *QueryCurrentEpochReq
 	return out, nil
 }
 
+func (c *queryClient) EpochSubscriptions(ctx context.Context, in *QueryEpochSubscriptionsRequest, opts ...grpc.CallOption) (*QueryEpochSubscriptionsResponse, error) {
+	out := new(QueryEpochSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.epochs.v1.Query/EpochSubscriptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// EpochInfos provide running epochInfos
 	EpochInfos(context.Context, *QueryEpochsInfoRequest) (*QueryEpochsInfoResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	CurrentEpoch(context.Context, *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error)
+	// EpochSubscriptions provide the contracts subscribed to the end of the
+	// specified epoch
+	EpochSubscriptions(context.Context, *QueryEpochSubscriptionsRequest) (*QueryEpochSubscriptionsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -333,6 +449,9 @@ func (*UnimplementedQueryServer) EpochInfos(ctx context.Context, req *QueryEpoch
 func (*UnimplementedQueryServer) CurrentEpoch(ctx context.Context, req *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CurrentEpoch not implemented")
 }
+func (*UnimplementedQueryServer) EpochSubscriptions(ctx context.Context, req *QueryEpochSubscriptionsRequest) (*QueryEpochSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochSubscriptions not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -374,6 +493,25 @@ func _Query_CurrentEpoch_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EpochSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.epochs.v1.Query/EpochSubscriptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochSubscriptions(ctx, req.(*QueryEpochSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "evmos.epochs.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -386,6 +524,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CurrentEpoch",
 			Handler:    _Query_CurrentEpoch_Handler,
 		},
+		{
+			MethodName: "EpochSubscriptions",
+			Handler:    _Query_EpochSubscriptions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/epochs/v1/query.proto",
@@ -533,6 +675,73 @@ func (m *QueryCurrentEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryEpochSubscriptionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochSubscriptionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochSubscriptionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochSubscriptionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochSubscriptionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochSubscriptionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Subscriptions) > 0 {
+		for iNdEx := len(m.Subscriptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subscriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -601,6 +810,34 @@ func (m *QueryCurrentEpochResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryEpochSubscriptionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEpochSubscriptionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Subscriptions) > 0 {
+		for _, e := range m.Subscriptions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -964,6 +1201,172 @@ func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryEpochSubscriptionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochSubscriptionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochSubscriptionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochSubscriptionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochSubscriptionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochSubscriptionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscriptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subscriptions = append(m.Subscriptions, EpochSubscription{})
+			if err := m.Subscriptions[len(m.Subscriptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,46 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	aizeltypes "github.com/AizelNetwork/CosmEvm/types"
+)
+
+// MaxSubscriptionGasLimit is the maximum amount of gas a single subscribed
+// contract can consume when it is called at the end of an epoch.
+const MaxSubscriptionGasLimit uint64 = 500_000
+
+// NewEpochSubscription returns a new EpochSubscription instance
+func NewEpochSubscription(identifier, contract string, gasLimit uint64) EpochSubscription {
+	return EpochSubscription{
+		Identifier: identifier,
+		Contract:   contract,
+		GasLimit:   gasLimit,
+	}
+}
+
+// Validate performs a stateless validation of the subscription fields.
+func (es EpochSubscription) Validate() error {
+	if err := ValidateEpochIdentifierString(es.Identifier); err != nil {
+		return err
+	}
+	if err := aizeltypes.ValidateNonZeroAddress(es.Contract); err != nil {
+		return errorsmod.Wrap(err, "invalid subscription contract")
+	}
+	return ValidateSubscriptionGasLimit(es.GasLimit)
+}
+
+// ValidateSubscriptionGasLimit checks that the gas limit is positive and does
+// not exceed MaxSubscriptionGasLimit.
+func ValidateSubscriptionGasLimit(gasLimit uint64) error {
+	if gasLimit == 0 || gasLimit > MaxSubscriptionGasLimit {
+		return errorsmod.Wrapf(
+			ErrInvalidGasLimit,
+			"gas limit must be between 1 and %d, got %d", MaxSubscriptionGasLimit, gasLimit,
+		)
+	}
+	return nil
+}
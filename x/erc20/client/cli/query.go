@@ -26,6 +26,8 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetTokenPairsCmd(),
 		GetTokenPairCmd(),
+		GetRateLimitsCmd(),
+		GetRateLimitCmd(),
 		GetParamsCmd(),
 	)
 	return cmd
@@ -100,6 +102,75 @@ func GetTokenPairCmd() *cobra.Command {
 	return cmd
 }
 
+// GetRateLimitsCmd queries the conversion rate limits of all token pairs
+func GetRateLimitsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rate-limits",
+		Short: "Gets the conversion rate limits of the token pairs",
+		Long:  "Gets the conversion rate limits of the token pairs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryRateLimitsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.RateLimits(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetRateLimitCmd queries the conversion rate limit of a token pair
+func GetRateLimitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rate-limit TOKEN",
+		Short: "Get the conversion rate limit of a token pair",
+		Long:  "Get the conversion rate limit of a token pair",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRateLimitRequest{
+				Token: args[0],
+			}
+
+			res, err := queryClient.RateLimit(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetParamsCmd queries erc20 module params
 func GetParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -33,6 +33,9 @@ func NewTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		NewConvertERC20Cmd(),
+		NewRegisterERC20ByOwnerCmd(),
+		NewSyncTokenPairMetadataCmd(),
+		NewResumeConversionCmd(),
 	)
 	return txCmd
 }
@@ -83,3 +86,86 @@ func NewConvertERC20Cmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewRegisterERC20ByOwnerCmd returns a CLI command handler for registering a token pair
+// for an ERC20 contract owned by the sender
+func NewRegisterERC20ByOwnerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-erc20 CONTRACT_ADDRESS",
+		Short: "Register a token pair for an ERC20 contract owned by the sender, paying the registration fee set by governance.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract := args[0]
+			if err := aizeltypes.ValidateAddress(contract); err != nil {
+				return fmt.Errorf("invalid ERC20 contract address %w", err)
+			}
+
+			msg := &types.MsgRegisterERC20ByOwner{
+				Sender:          cliCtx.GetFromAddress().String(),
+				ContractAddress: contract,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSyncTokenPairMetadataCmd returns a CLI command handler for refreshing the denom
+// metadata of a token pair from its ERC20 contract
+func NewSyncTokenPairMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sync-metadata TOKEN",
+		Short: "Refresh the denom metadata of a token pair with the name, symbol and decimals of its ERC20 contract. The sender must be the owner of the contract.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSyncTokenPairMetadata{
+				Sender: cliCtx.GetFromAddress().String(),
+				Token:  args[0],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewResumeConversionCmd returns a CLI command handler for resuming the conversions
+// of a token pair paused by its rate limit
+func NewResumeConversionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume-conversion TOKEN",
+		Short: "Resume the conversions of a token pair paused by its rate limit. The sender must be the rate limit guardian.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgResumeConversion{
+				Sender: cliCtx.GetFromAddress().String(),
+				Token:  args[0],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -34,6 +34,10 @@ func InitGenesis(
 	for _, pair := range data.TokenPairs {
 		k.SetToken(ctx, pair)
 	}
+
+	for _, rateLimit := range data.RateLimits {
+		k.SetRateLimit(ctx, rateLimit)
+	}
 }
 
 // ExportGenesis export module status
@@ -41,5 +45,6 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
 		Params:     k.GetParams(ctx),
 		TokenPairs: k.GetTokenPairs(ctx),
+		RateLimits: k.GetRateLimits(ctx),
 	}
 }
@@ -80,6 +80,55 @@ func (k Keeper) TokenPair(c context.Context, req *types.QueryTokenPairRequest) (
 	return &types.QueryTokenPairResponse{TokenPair: pair}, nil
 }
 
+// RateLimits returns the conversion rate limits of all token pairs
+func (k Keeper) RateLimits(c context.Context, req *types.QueryRateLimitsRequest) (*types.QueryRateLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var rateLimits []types.RateLimit
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRateLimit)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var rateLimit types.RateLimit
+		if err := k.cdc.Unmarshal(value, &rateLimit); err != nil {
+			return err
+		}
+		rateLimits = append(rateLimits, rateLimit)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryRateLimitsResponse{
+		RateLimits: rateLimits,
+		Pagination: pageRes,
+	}, nil
+}
+
+// RateLimit returns the conversion rate limit of a given token pair
+func (k Keeper) RateLimit(c context.Context, req *types.QueryRateLimitRequest) (*types.QueryRateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pair, err := k.getTokenPairByToken(ctx, req.Token)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	rateLimit, found := k.GetRateLimit(ctx, pair.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "rate limit for token '%s'", req.Token)
+	}
+
+	return &types.QueryRateLimitResponse{RateLimit: rateLimit}, nil
+}
+
 // Params returns the params of the erc20 module
 func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
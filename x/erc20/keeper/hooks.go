@@ -0,0 +1,76 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochstypes "github.com/AizelNetwork/CosmEvm/x/epochs/types"
+	"github.com/AizelNetwork/CosmEvm/x/erc20/types"
+)
+
+// BeforeEpochStart: noop, We don't need to do anything here
+func (k Keeper) BeforeEpochStart(_ sdk.Context, _ string, _ int64) {
+}
+
+// AfterEpochEnd resets the conversion flows of the rate limits tracked over the
+// ended epoch. Paused rate limits are kept untouched, as they can only be
+// resumed by governance or the rate limit guardian.
+func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, _ int64) {
+	rateLimits := []types.RateLimit{}
+	k.IterateRateLimits(ctx, func(rateLimit types.RateLimit) (stop bool) {
+		if rateLimit.EpochIdentifier == epochIdentifier && !rateLimit.Paused {
+			rateLimits = append(rateLimits, rateLimit)
+		}
+		return false
+	})
+
+	for _, rateLimit := range rateLimits {
+		rateLimit.ResetFlows()
+		k.SetRateLimit(ctx, rateLimit)
+	}
+}
+
+// IsEpochIdentifierInUse returns true if a rate limit tracks its conversion
+// flows over the epoch with the given identifier.
+func (k Keeper) IsEpochIdentifierInUse(ctx sdk.Context, epochIdentifier string) bool {
+	inUse := false
+	k.IterateRateLimits(ctx, func(rateLimit types.RateLimit) (stop bool) {
+		inUse = rateLimit.EpochIdentifier == epochIdentifier
+		return inUse
+	})
+	return inUse
+}
+
+// ___________________________________________________________________________________________________
+
+// Hooks wrapper struct for erc20 keeper
+type Hooks struct {
+	k Keeper
+}
+
+var (
+	_ epochstypes.EpochHooks          = Hooks{}
+	_ epochstypes.EpochIdentifierUser = Hooks{}
+)
+
+// Hooks returns the wrapper struct
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// BeforeEpochStart implements EpochHooks
+func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	h.k.BeforeEpochStart(ctx, epochIdentifier, epochNumber)
+}
+
+// AfterEpochEnd implements EpochHooks
+func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	h.k.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
+}
+
+// IsEpochIdentifierInUse implements EpochIdentifierUser
+func (h Hooks) IsEpochIdentifierInUse(ctx sdk.Context, epochIdentifier string) bool {
+	return h.k.IsEpochIdentifierInUse(ctx, epochIdentifier)
+}
@@ -0,0 +1,47 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+
+	epochstypes "github.com/AizelNetwork/CosmEvm/x/epochs/types"
+	"github.com/AizelNetwork/CosmEvm/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestAfterEpochEnd() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+
+	newRateLimit := func(denom, epochIdentifier string, paused bool) types.RateLimit {
+		rateLimit := types.NewRateLimit(denom, epochIdentifier, math.NewInt(100), math.NewInt(100))
+		rateLimit.Inflow = math.NewInt(100)
+		rateLimit.Outflow = math.NewInt(10)
+		rateLimit.Paused = paused
+		return rateLimit
+	}
+
+	dayRateLimit := newRateLimit("day", epochstypes.DayEpochID, false)
+	weekRateLimit := newRateLimit("week", epochstypes.WeekEpochID, false)
+	pausedRateLimit := newRateLimit("paused", epochstypes.DayEpochID, true)
+
+	for _, rateLimit := range []types.RateLimit{dayRateLimit, weekRateLimit, pausedRateLimit} {
+		suite.network.App.Erc20Keeper.SetRateLimit(ctx, rateLimit)
+	}
+
+	suite.network.App.Erc20Keeper.AfterEpochEnd(ctx, epochstypes.DayEpochID, 1)
+
+	// the flows of the rate limits tracked over the ended epoch are reset
+	rateLimit, found := suite.network.App.Erc20Keeper.GetRateLimit(ctx, dayRateLimit.Denom)
+	suite.Require().True(found)
+	suite.Require().True(rateLimit.Inflow.IsZero())
+	suite.Require().True(rateLimit.Outflow.IsZero())
+
+	// the rate limits tracked over other epochs are not modified
+	rateLimit, found = suite.network.App.Erc20Keeper.GetRateLimit(ctx, weekRateLimit.Denom)
+	suite.Require().True(found)
+	suite.Require().Equal(weekRateLimit, rateLimit)
+
+	// the paused rate limits are not modified
+	rateLimit, found = suite.network.App.Erc20Keeper.GetRateLimit(ctx, pausedRateLimit.Denom)
+	suite.Require().True(found)
+	suite.Require().Equal(pausedRateLimit, rateLimit)
+}
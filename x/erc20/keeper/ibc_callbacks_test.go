@@ -265,6 +265,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 				suite.network.App.StakingKeeper,
 				suite.network.App.AuthzKeeper,
 				&suite.network.App.TransferKeeper,
+				suite.network.App.DistrKeeper,
 			)
 
 			// Fund receiver account with EVMOS, ERC20 coins and IBC vouchers
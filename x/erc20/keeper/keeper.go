@@ -30,6 +30,11 @@ type Keeper struct {
 	stakingKeeper  types.StakingKeeper
 	authzKeeper    authzkeeper.Keeper
 	transferKeeper *transferkeeper.Keeper
+	distrKeeper    types.DistributionKeeper
+
+	// trippedRateLimits are the denominations of the token pairs whose
+	// conversions are paused at the end of the block
+	trippedRateLimits map[string]struct{}
 }
 
 // NewKeeper creates new instances of the erc20 Keeper
@@ -43,6 +48,7 @@ func NewKeeper(
 	sk types.StakingKeeper,
 	authzKeeper authzkeeper.Keeper,
 	transferKeeper *transferkeeper.Keeper,
+	distrKeeper types.DistributionKeeper,
 ) Keeper {
 	// ensure gov module account is set and is not nil
 	if err := sdk.VerifyAddressFormat(authority); err != nil {
@@ -59,6 +65,9 @@ func NewKeeper(
 		stakingKeeper:  sk,
 		authzKeeper:    authzKeeper,
 		transferKeeper: transferKeeper,
+		distrKeeper:    distrKeeper,
+
+		trippedRateLimits: make(map[string]struct{}),
 	}
 }
 
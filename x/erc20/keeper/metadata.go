@@ -0,0 +1,116 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/AizelNetwork/CosmEvm/x/erc20/types"
+)
+
+// syncTokenPairMetadata queries the name, symbol and decimals of the ERC20
+// contract of an externally owned token pair and overwrites the bank denom
+// metadata of the pair with them. Unless the sender is the governance
+// authority, it must be the owner of the ERC20 contract.
+func (k Keeper) syncTokenPairMetadata(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	token string,
+) (types.TokenPair, banktypes.Metadata, error) {
+	pair, err := k.getTokenPairByToken(ctx, token)
+	if err != nil {
+		return types.TokenPair{}, banktypes.Metadata{}, err
+	}
+
+	if !pair.IsNativeERC20() {
+		return types.TokenPair{}, banktypes.Metadata{}, errorsmod.Wrapf(
+			types.ErrTokenPairOwnedByModule,
+			"metadata of token pair %s can only be updated through governance", pair.Denom,
+		)
+	}
+
+	contract := pair.GetERC20Contract()
+	if !sender.Equals(k.authority) {
+		if err := k.validateContractOwner(ctx, contract, common.BytesToAddress(sender)); err != nil {
+			return types.TokenPair{}, banktypes.Metadata{}, err
+		}
+	}
+
+	erc20Data, err := k.QueryERC20(ctx, contract)
+	if err != nil {
+		return types.TokenPair{}, banktypes.Metadata{}, err
+	}
+
+	metadata, err := newCoinMetadata(contract.String(), erc20Data)
+	if err != nil {
+		return types.TokenPair{}, banktypes.Metadata{}, err
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+	return pair, metadata, nil
+}
+
+// updateDenomMetadata overwrites the bank denom metadata of the token pair
+// whose Cosmos coin denomination matches the metadata base denomination.
+func (k Keeper) updateDenomMetadata(
+	ctx sdk.Context,
+	metadata banktypes.Metadata,
+) (types.TokenPair, error) {
+	pair, err := k.getTokenPairByToken(ctx, metadata.Base)
+	if err != nil {
+		return types.TokenPair{}, err
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+	return pair, nil
+}
+
+// getTokenPairByToken returns the token pair registered for the given token,
+// which can be either the hex address of the ERC20 contract or the Cosmos
+// coin denomination.
+func (k Keeper) getTokenPairByToken(ctx sdk.Context, token string) (types.TokenPair, error) {
+	id := k.GetTokenPairID(ctx, token)
+	if len(id) == 0 {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered by id", token,
+		)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered", token,
+		)
+	}
+
+	return pair, nil
+}
+
+// emitUpdateTokenMetadataEvent emits the event with the updated denom metadata
+// of a token pair, so that indexers can refresh the token name and symbol.
+func emitUpdateTokenMetadataEvent(ctx sdk.Context, pair types.TokenPair, metadata banktypes.Metadata) {
+	var decimals uint32
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom == metadata.Display {
+			decimals = unit.Exponent
+			break
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateTokenMetadata,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyName, metadata.Name),
+			sdk.NewAttribute(types.AttributeKeySymbol, metadata.Symbol),
+			sdk.NewAttribute(types.AttributeKeyDecimals, strconv.FormatUint(uint64(decimals), 10)),
+		),
+	)
+}
@@ -77,6 +77,10 @@ func (k Keeper) convertERC20IntoCoinsForNativeToken(
 	receiver sdk.AccAddress,
 	sender common.Address,
 ) (*types.MsgConvertERC20Response, error) {
+	if err := k.checkAndUpdateInflow(ctx, pair.Denom, msg.Amount); err != nil {
+		return nil, err
+	}
+
 	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
 	contract := pair.GetERC20Contract()
 	balanceCoin := k.bankKeeper.GetBalance(ctx, receiver, pair.Denom)
@@ -189,7 +193,7 @@ func (k Keeper) convertERC20IntoCoinsForNativeToken(
 }
 
 // ConvertCoinNativeERC20 handles the coin conversion for a native ERC20 token
-// pair:
+// pair, after checking the outflow quota of its rate limit:
 //   - escrow Coins on module account
 //   - unescrow Tokens that have been previously escrowed with ConvertERC20 and send to receiver
 //   - burn escrowed Coins
@@ -206,6 +210,27 @@ func (k Keeper) ConvertCoinNativeERC20(
 		return nil
 	}
 
+	if err := k.checkAndUpdateOutflow(ctx, pair.Denom, amount); err != nil {
+		return err
+	}
+
+	return k.convertCoinNativeERC20(ctx, pair, amount, receiver, sender)
+}
+
+// convertCoinNativeERC20 performs the coin conversion for a native ERC20 token
+// pair without checking its rate limit. It is used to refund the conversions
+// of failed IBC transfers, which must not be blocked.
+func (k Keeper) convertCoinNativeERC20(
+	ctx sdk.Context,
+	pair types.TokenPair,
+	amount math.Int,
+	receiver common.Address,
+	sender sdk.AccAddress,
+) error {
+	if !amount.IsPositive() {
+		return nil
+	}
+
 	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
 	contract := pair.GetERC20Contract()
 
@@ -343,6 +368,161 @@ func (k *Keeper) ToggleConversion(goCtx context.Context, req *types.MsgToggleCon
 	return &types.MsgToggleConversionResponse{}, nil
 }
 
+// RegisterERC20ByOwner implements the gRPC MsgServer interface. It creates the token pair
+// for an ERC20 contract owned by the sender without a governance proposal, in exchange of
+// the registration fee. The ERC20 metadata and the transfer behavior of the token are
+// checked before the registration, with contract calls whose gas is paid by the sender.
+func (k *Keeper) RegisterERC20ByOwner(goCtx context.Context, req *types.MsgRegisterERC20ByOwner) (*types.MsgRegisterERC20ByOwnerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// Check if the conversion is globally enabled
+	if !k.IsERC20Enabled(ctx) {
+		return nil, types.ErrERC20Disabled.Wrap("registration is currently disabled by governance")
+	}
+
+	params := k.GetParams(ctx)
+	if !params.IsPermissionlessRegistrationEnabled() {
+		return nil, types.ErrRegistrationDisabled
+	}
+
+	sender, err := k.accountKeeper.AddressCodec().StringToBytes(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if !common.IsHexAddress(req.ContractAddress) {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid ERC20 contract address: %s", req.ContractAddress)
+	}
+
+	contract := common.HexToAddress(req.ContractAddress)
+	owner := common.BytesToAddress(sender)
+
+	if err := k.validateContractOwner(ctx, contract, owner); err != nil {
+		return nil, err
+	}
+
+	if err := k.validateTransferBehavior(ctx, contract, owner); err != nil {
+		return nil, errorsmod.Wrap(err, "unsupported ERC20 token transfer behavior")
+	}
+
+	if err := k.chargeRegistrationFee(ctx, sender, params); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to pay registration fee")
+	}
+
+	pair, err := k.registerERC20(ctx, contract)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterERC20,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeySender, req.Sender),
+			sdk.NewAttribute(types.AttributeKeyFee, params.RegistrationFee.String()),
+		),
+	)
+
+	return &types.MsgRegisterERC20ByOwnerResponse{Denom: pair.Denom}, nil
+}
+
+// SyncTokenPairMetadata implements the gRPC MsgServer interface. It refreshes the
+// denom metadata of an externally owned token pair with the current name, symbol and
+// decimals of its ERC20 contract. The sender must be either the Cosmos SDK governance
+// module account or the owner of the ERC20 contract.
+func (k *Keeper) SyncTokenPairMetadata(goCtx context.Context, req *types.MsgSyncTokenPairMetadata) (*types.MsgSyncTokenPairMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := k.accountKeeper.AddressCodec().StringToBytes(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	pair, metadata, err := k.syncTokenPairMetadata(ctx, sender, req.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	emitUpdateTokenMetadataEvent(ctx, pair, metadata)
+
+	return &types.MsgSyncTokenPairMetadataResponse{}, nil
+}
+
+// UpdateDenomMetadata implements the gRPC MsgServer interface. After a successful governance
+// vote it overwrites the denom metadata of a registered token pair if the requested authority
+// is the Cosmos SDK governance module account
+func (k *Keeper) UpdateDenomMetadata(goCtx context.Context, req *types.MsgUpdateDenomMetadata) (*types.MsgUpdateDenomMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	pair, err := k.updateDenomMetadata(ctx, req.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	emitUpdateTokenMetadataEvent(ctx, pair, req.Metadata)
+
+	return &types.MsgUpdateDenomMetadataResponse{}, nil
+}
+
+// UpdateRateLimit implements the gRPC MsgServer interface. After a successful governance vote
+// it sets the conversion quotas of a token pair if the requested authority is the Cosmos SDK
+// governance module account
+func (k *Keeper) UpdateRateLimit(goCtx context.Context, req *types.MsgUpdateRateLimit) (*types.MsgUpdateRateLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	rateLimit, err := k.setRateLimit(ctx, req.Token, req.EpochIdentifier, req.MaxInflow, req.MaxOutflow)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateRateLimit,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, rateLimit.Denom),
+			sdk.NewAttribute(types.AttributeKeyEpochID, rateLimit.EpochIdentifier),
+			sdk.NewAttribute(types.AttributeKeyMaxInflow, rateLimit.MaxInflow.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxOutflow, rateLimit.MaxOutflow.String()),
+		),
+	)
+
+	return &types.MsgUpdateRateLimitResponse{}, nil
+}
+
+// ResumeConversion implements the gRPC MsgServer interface. It resumes the conversions
+// of a token pair paused by its rate limit. The sender must be either the Cosmos SDK
+// governance module account or the rate limit guardian.
+func (k *Keeper) ResumeConversion(goCtx context.Context, req *types.MsgResumeConversion) (*types.MsgResumeConversionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := k.accountKeeper.AddressCodec().StringToBytes(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	rateLimit, err := k.resumeConversion(ctx, sender, req.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeResumeConversion,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, rateLimit.Denom),
+			sdk.NewAttribute(types.AttributeKeySender, req.Sender),
+		),
+	)
+
+	return &types.MsgResumeConversionResponse{}, nil
+}
+
 // validateAuthority is a helper function to validate that the provided authority
 // is the keeper's authority address
 func (k *Keeper) validateAuthority(authority string) error {
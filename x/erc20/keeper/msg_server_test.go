@@ -1,11 +1,16 @@
 package keeper_test
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
 
 	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/AizelNetwork/CosmEvm/contracts"
 	testutils "github.com/AizelNetwork/CosmEvm/testutil/integration/aizel/utils"
+	utiltx "github.com/AizelNetwork/CosmEvm/testutil/tx"
+	aizeltypes "github.com/AizelNetwork/CosmEvm/types"
 	"github.com/AizelNetwork/CosmEvm/x/erc20/keeper"
 	"github.com/AizelNetwork/CosmEvm/x/erc20/types"
 	erc20mocks "github.com/AizelNetwork/CosmEvm/x/erc20/types/mocks"
@@ -13,6 +18,7 @@ import (
 	evmtypes "github.com/AizelNetwork/CosmEvm/x/evm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/golang/mock/gomock"
@@ -127,7 +133,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeERC20() {
 					suite.network.App.GetKey("erc20"), suite.network.App.AppCodec(),
 					authtypes.NewModuleAddress(govtypes.ModuleName), suite.network.App.AccountKeeper,
 					suite.network.App.BankKeeper, mockEVMKeeper, suite.network.App.StakingKeeper,
-					suite.network.App.AuthzKeeper, &suite.network.App.TransferKeeper,
+					suite.network.App.AuthzKeeper, &suite.network.App.TransferKeeper, suite.network.App.DistrKeeper,
 				)
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
@@ -154,7 +160,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeERC20() {
 					suite.network.App.GetKey("erc20"), suite.network.App.AppCodec(),
 					authtypes.NewModuleAddress(govtypes.ModuleName), suite.network.App.AccountKeeper,
 					suite.network.App.BankKeeper, mockEVMKeeper, suite.network.App.StakingKeeper,
-					suite.network.App.AuthzKeeper, &suite.network.App.TransferKeeper,
+					suite.network.App.AuthzKeeper, &suite.network.App.TransferKeeper, suite.network.App.DistrKeeper,
 				)
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
@@ -180,7 +186,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeERC20() {
 					suite.network.App.GetKey("erc20"), suite.network.App.AppCodec(),
 					authtypes.NewModuleAddress(govtypes.ModuleName), suite.network.App.AccountKeeper,
 					suite.network.App.BankKeeper, mockEVMKeeper, suite.network.App.StakingKeeper,
-					suite.network.App.AuthzKeeper, &suite.network.App.TransferKeeper,
+					suite.network.App.AuthzKeeper, &suite.network.App.TransferKeeper, suite.network.App.DistrKeeper,
 				)
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
@@ -207,7 +213,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeERC20() {
 					suite.network.App.GetKey("erc20"), suite.network.App.AppCodec(),
 					authtypes.NewModuleAddress(govtypes.ModuleName), suite.network.App.AccountKeeper,
 					suite.network.App.BankKeeper, mockEVMKeeper, suite.network.App.StakingKeeper,
-					suite.network.App.AuthzKeeper, &suite.network.App.TransferKeeper,
+					suite.network.App.AuthzKeeper, &suite.network.App.TransferKeeper, suite.network.App.DistrKeeper,
 				)
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
@@ -236,7 +242,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeERC20() {
 					suite.network.App.GetKey("erc20"), suite.network.App.AppCodec(),
 					authtypes.NewModuleAddress(govtypes.ModuleName), suite.network.App.AccountKeeper,
 					mockBankKeeper, suite.network.App.EvmKeeper, suite.network.App.StakingKeeper,
-					suite.network.App.AuthzKeeper, &suite.network.App.TransferKeeper,
+					suite.network.App.AuthzKeeper, &suite.network.App.TransferKeeper, suite.network.App.DistrKeeper,
 				)
 
 				mockBankKeeper.EXPECT().MintCoins(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("failed to mint")).AnyTimes()
@@ -261,7 +267,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeERC20() {
 					suite.network.App.GetKey("erc20"), suite.network.App.AppCodec(),
 					authtypes.NewModuleAddress(govtypes.ModuleName), suite.network.App.AccountKeeper,
 					mockBankKeeper, suite.network.App.EvmKeeper, suite.network.App.StakingKeeper,
-					suite.network.App.AuthzKeeper, &suite.network.App.TransferKeeper,
+					suite.network.App.AuthzKeeper, &suite.network.App.TransferKeeper, suite.network.App.DistrKeeper,
 				)
 
 				mockBankKeeper.EXPECT().MintCoins(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
@@ -286,7 +292,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeERC20() {
 					suite.network.App.GetKey("erc20"), suite.network.App.AppCodec(),
 					authtypes.NewModuleAddress(govtypes.ModuleName), suite.network.App.AccountKeeper,
 					mockBankKeeper, suite.network.App.EvmKeeper, suite.network.App.StakingKeeper,
-					suite.network.App.AuthzKeeper, &suite.network.App.TransferKeeper,
+					suite.network.App.AuthzKeeper, &suite.network.App.TransferKeeper, suite.network.App.DistrKeeper,
 				)
 
 				mockBankKeeper.EXPECT().MintCoins(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
@@ -393,3 +399,370 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestRegisterERC20ByOwner() {
+	var (
+		contractAddr common.Address
+		sender       sdk.AccAddress
+		fee          = sdk.NewCoins(sdk.NewCoin(aizeltypes.BaseDenom, math.NewInt(1e18)))
+	)
+
+	enableRegistration := func(burn bool) {
+		params := suite.network.App.Erc20Keeper.GetParams(suite.network.GetContext())
+		params.EnablePermissionlessRegistration = true
+		params.RegistrationFee = fee
+		params.BurnRegistrationFee = burn
+		err := suite.network.App.Erc20Keeper.SetParams(suite.network.GetContext(), params)
+		suite.Require().NoError(err)
+	}
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		burn        bool
+		expPass     bool
+		errContains string
+	}{
+		{
+			"fail - permissionless registration disabled",
+			func() {
+				var err error
+				contractAddr, err = suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+				suite.Require().NoError(err)
+			},
+			false,
+			false,
+			types.ErrRegistrationDisabled.Error(),
+		},
+		{
+			"fail - sender is not the contract owner",
+			func() {
+				var err error
+				contractAddr, err = suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+				suite.Require().NoError(err)
+				sender = suite.keyring.GetAccAddr(1)
+				enableRegistration(false)
+			},
+			false,
+			false,
+			"is not the owner of contract",
+		},
+		{
+			"fail - owner without token balance",
+			func() {
+				var err error
+				contractAddr, err = suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+				suite.Require().NoError(err)
+				enableRegistration(false)
+			},
+			false,
+			false,
+			"must hold a positive balance",
+		},
+		{
+			"fail - fee-on-transfer token",
+			func() {
+				var err error
+				contractAddr, err = suite.DeployContractDirectBalanceManipulation()
+				suite.Require().NoError(err)
+				enableRegistration(false)
+			},
+			false,
+			false,
+			"fee-on-transfer behavior detected",
+		},
+		{
+			"pass - fee sent to the community pool",
+			func() {
+				var err error
+				contractAddr, err = suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+				suite.Require().NoError(err)
+				_, err = suite.MintERC20Token(contractAddr, suite.keyring.GetAddr(0), big.NewInt(100))
+				suite.Require().NoError(err)
+				enableRegistration(false)
+			},
+			false,
+			true,
+			"",
+		},
+		{
+			"pass - fee burned",
+			func() {
+				var err error
+				contractAddr, err = suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+				suite.Require().NoError(err)
+				_, err = suite.MintERC20Token(contractAddr, suite.keyring.GetAddr(0), big.NewInt(100))
+				suite.Require().NoError(err)
+				enableRegistration(true)
+			},
+			true,
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			sender = suite.keyring.GetAccAddr(0)
+
+			tc.malleate()
+
+			ctx := suite.network.GetContext().WithGasMeter(storetypes.NewInfiniteGasMeter())
+			feePoolBefore, err := suite.network.App.DistrKeeper.FeePool.Get(ctx)
+			suite.Require().NoError(err)
+			supplyBefore := suite.network.App.BankKeeper.GetSupply(ctx, aizeltypes.BaseDenom)
+			senderBalanceBefore := suite.network.App.BankKeeper.GetBalance(ctx, sender, aizeltypes.BaseDenom)
+
+			res, err := suite.network.App.Erc20Keeper.RegisterERC20ByOwner(ctx, &types.MsgRegisterERC20ByOwner{
+				Sender:          sender.String(),
+				ContractAddress: contractAddr.Hex(),
+			})
+
+			if !tc.expPass {
+				suite.Require().ErrorContains(err, tc.errContains)
+				suite.Require().False(suite.network.App.Erc20Keeper.IsERC20Registered(ctx, contractAddr))
+				// the contract calls of the failed checks are charged to the sender
+				if !errors.Is(err, types.ErrRegistrationDisabled) {
+					suite.Require().Positive(ctx.GasMeter().GasConsumed())
+				}
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(types.CreateDenom(contractAddr.String()), res.Denom)
+			suite.Require().True(suite.network.App.Erc20Keeper.IsERC20Registered(ctx, contractAddr))
+
+			pair, found := suite.network.App.Erc20Keeper.GetTokenPair(ctx, suite.network.App.Erc20Keeper.GetERC20Map(ctx, contractAddr))
+			suite.Require().True(found)
+			suite.Require().Equal(types.OWNER_EXTERNAL, pair.ContractOwner)
+
+			// the dry-run transfer is not committed
+			balance := suite.network.App.Erc20Keeper.BalanceOf(ctx, contracts.ERC20MinterBurnerDecimalsContract.ABI, contractAddr, suite.keyring.GetAddr(0))
+			suite.Require().Equal(big.NewInt(100), balance)
+
+			senderBalanceAfter := suite.network.App.BankKeeper.GetBalance(ctx, sender, aizeltypes.BaseDenom)
+			suite.Require().Equal(senderBalanceBefore.Sub(fee[0]), senderBalanceAfter)
+
+			feePoolAfter, err := suite.network.App.DistrKeeper.FeePool.Get(ctx)
+			suite.Require().NoError(err)
+			supplyAfter := suite.network.App.BankKeeper.GetSupply(ctx, aizeltypes.BaseDenom)
+			if tc.burn {
+				suite.Require().Equal(supplyBefore.Sub(fee[0]), supplyAfter)
+				suite.Require().Equal(feePoolBefore.CommunityPool, feePoolAfter.CommunityPool)
+			} else {
+				suite.Require().Equal(supplyBefore, supplyAfter)
+				suite.Require().Equal(
+					feePoolBefore.CommunityPool.Add(sdk.NewDecCoinsFromCoins(fee...)...),
+					feePoolAfter.CommunityPool,
+				)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSyncTokenPairMetadata() {
+	var (
+		contractAddr common.Address
+		sender       sdk.AccAddress
+		token        string
+	)
+
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	// registerPair registers a token pair for a new ERC20 contract and replaces its
+	// denom metadata with a stale symbol
+	registerPair := func() {
+		var err error
+		contractAddr, err = suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+		suite.Require().NoError(err)
+
+		ctx := suite.network.GetContext()
+		_, err = suite.network.App.Erc20Keeper.RegisterERC20(ctx, &types.MsgRegisterERC20{
+			Authority:      govAddr.String(),
+			Erc20Addresses: []string{contractAddr.Hex()},
+		})
+		suite.Require().NoError(err)
+
+		denom := types.CreateDenom(contractAddr.String())
+		metadata, found := suite.network.App.BankKeeper.GetDenomMetaData(ctx, denom)
+		suite.Require().True(found)
+		metadata.Symbol = "OLD"
+		suite.network.App.BankKeeper.SetDenomMetaData(ctx, metadata)
+		token = contractAddr.Hex()
+	}
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		expPass     bool
+		errContains string
+	}{
+		{
+			"fail - token pair not registered",
+			func() {
+				token = utiltx.GenerateAddress().Hex()
+			},
+			false,
+			"not registered",
+		},
+		{
+			"fail - token pair owned by module",
+			func() {
+				pair := types.NewTokenPair(utiltx.GenerateAddress(), "coin", types.OWNER_MODULE)
+				suite.network.App.Erc20Keeper.SetToken(suite.network.GetContext(), pair)
+				token = pair.Denom
+			},
+			false,
+			"can only be updated through governance",
+		},
+		{
+			"fail - sender is not the contract owner",
+			func() {
+				registerPair()
+				sender = suite.keyring.GetAccAddr(1)
+			},
+			false,
+			"is not the owner of contract",
+		},
+		{
+			"pass - contract owner syncs by contract address",
+			func() {
+				registerPair()
+			},
+			true,
+			"",
+		},
+		{
+			"pass - contract owner syncs by denom",
+			func() {
+				registerPair()
+				token = types.CreateDenom(contractAddr.String())
+			},
+			true,
+			"",
+		},
+		{
+			"pass - governance authority syncs",
+			func() {
+				registerPair()
+				sender = govAddr
+			},
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			sender = suite.keyring.GetAccAddr(0)
+
+			tc.malleate()
+
+			ctx := suite.network.GetContext()
+			_, err := suite.network.App.Erc20Keeper.SyncTokenPairMetadata(ctx, &types.MsgSyncTokenPairMetadata{
+				Sender: sender.String(),
+				Token:  token,
+			})
+
+			if !tc.expPass {
+				suite.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			suite.Require().NoError(err)
+
+			metadata, found := suite.network.App.BankKeeper.GetDenomMetaData(ctx, types.CreateDenom(contractAddr.String()))
+			suite.Require().True(found)
+			suite.Require().Equal(erc20Symbol, metadata.Symbol)
+			suite.Require().Equal(types.SanitizeERC20Name(erc20Name), metadata.Display)
+
+			events := ctx.EventManager().Events()
+			suite.Require().Equal(types.EventTypeUpdateTokenMetadata, events[len(events)-1].Type)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateDenomMetadata() {
+	var metadata banktypes.Metadata
+
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	newMetadata := func(base string) banktypes.Metadata {
+		return banktypes.Metadata{
+			Description: "Coin with updated metadata",
+			Base:        base,
+			DenomUnits: []*banktypes.DenomUnit{
+				{Denom: base, Exponent: 0},
+				{Denom: "newcoin", Exponent: 18},
+			},
+			Name:    "New Coin",
+			Symbol:  "NEW",
+			Display: "newcoin",
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		authority   string
+		malleate    func()
+		expPass     bool
+		errContains string
+	}{
+		{
+			"fail - invalid authority",
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			func() {
+				metadata = newMetadata("coin")
+			},
+			false,
+			"invalid authority",
+		},
+		{
+			"fail - token pair not registered",
+			govAddr,
+			func() {
+				metadata = newMetadata("unregistered")
+			},
+			false,
+			"not registered",
+		},
+		{
+			"pass - governance updates the metadata of a registered pair",
+			govAddr,
+			func() {
+				pair := types.NewTokenPair(utiltx.GenerateAddress(), "coin", types.OWNER_MODULE)
+				suite.network.App.Erc20Keeper.SetToken(suite.network.GetContext(), pair)
+				metadata = newMetadata(pair.Denom)
+			},
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+
+			tc.malleate()
+
+			ctx := suite.network.GetContext()
+			_, err := suite.network.App.Erc20Keeper.UpdateDenomMetadata(ctx, &types.MsgUpdateDenomMetadata{
+				Authority: tc.authority,
+				Metadata:  metadata,
+			})
+
+			if !tc.expPass {
+				suite.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			suite.Require().NoError(err)
+
+			stored, found := suite.network.App.BankKeeper.GetDenomMetaData(ctx, metadata.Base)
+			suite.Require().True(found)
+			suite.Require().Equal(metadata, stored)
+		})
+	}
+}
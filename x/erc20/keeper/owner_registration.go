@@ -0,0 +1,211 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/AizelNetwork/CosmEvm/contracts"
+	"github.com/AizelNetwork/CosmEvm/x/erc20/types"
+	evmtypes "github.com/AizelNetwork/CosmEvm/x/evm/types"
+)
+
+// registrationCallGasLimit is the gas limit of each contract call performed to
+// validate a registration by the contract owner. The gas used by the calls is
+// consumed on the tx gas meter, so the sender pays for the checks even if they
+// fail.
+const registrationCallGasLimit uint64 = 100_000
+
+// defaultAdminRole is the DEFAULT_ADMIN_ROLE of the AccessControl contract standard.
+var defaultAdminRole [32]byte
+
+// validateContractOwner checks that the given account owns the ERC20 contract.
+// The account is considered the owner if it is returned by the owner() method
+// of Ownable contracts, or if it holds the DEFAULT_ADMIN_ROLE of AccessControl
+// contracts.
+func (k Keeper) validateContractOwner(ctx sdk.Context, contract, account common.Address) error {
+	// the calls are executed on a cached context to not commit their state changes
+	cacheCtx, _ := ctx.CacheContext()
+
+	res, err := k.registrationCall(cacheCtx, types.OwnableABI, types.ModuleAddress, contract, "owner")
+	if err == nil {
+		var ownerRes types.ERC20AddressResponse
+		if err := types.OwnableABI.UnpackIntoInterface(&ownerRes, "owner", res.Ret); err == nil && ownerRes.Value == account {
+			return nil
+		}
+	}
+
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	res, err = k.registrationCall(cacheCtx, erc20, types.ModuleAddress, contract, "hasRole", defaultAdminRole, account)
+	if err == nil {
+		var hasRoleRes types.ERC20BoolResponse
+		if err := erc20.UnpackIntoInterface(&hasRoleRes, "hasRole", res.Ret); err == nil && hasRoleRes.Value {
+			return nil
+		}
+	}
+
+	return errorsmod.Wrapf(types.ErrNotContractOwner, "account %s is not the owner of contract %s", account, contract)
+}
+
+// validateTransferBehavior performs a dry-run transfer of the owner token balance
+// to the module account on a cached context, that is discarded afterwards. It
+// returns an error if the balances of the sender or the recipient, or the total
+// supply do not change as expected, which is the case of tokens that take a fee
+// on transfer or that rebase the balances.
+func (k Keeper) validateTransferBehavior(ctx sdk.Context, contract, owner common.Address) error {
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+
+	// the dry-run is executed on a cached context to not commit the transfer
+	cacheCtx, _ := ctx.CacheContext()
+
+	amount := k.registrationBalanceOf(cacheCtx, erc20, contract, owner)
+	if amount == nil || amount.Sign() <= 0 {
+		return errorsmod.Wrapf(
+			types.ErrBalanceInvariance,
+			"contract owner %s must hold a positive balance to check the token transfer behavior", owner,
+		)
+	}
+
+	recipientBefore := k.registrationBalanceOf(cacheCtx, erc20, contract, types.ModuleAddress)
+	supplyBefore, err := k.totalSupply(cacheCtx, contract)
+	if err != nil {
+		return err
+	}
+
+	res, err := k.registrationCall(cacheCtx, erc20, owner, contract, "transfer", types.ModuleAddress, amount)
+	if err != nil {
+		return err
+	}
+
+	var transferRes types.ERC20BoolResponse
+	if err := erc20.UnpackIntoInterface(&transferRes, "transfer", res.Ret); err != nil {
+		return errorsmod.Wrapf(types.ErrABIUnpack, "failed to unpack transfer: %s", err.Error())
+	}
+
+	if !transferRes.Value {
+		return errorsmod.Wrap(types.ErrEVMCall, "dry-run transfer returned false")
+	}
+
+	ownerAfter := k.registrationBalanceOf(cacheCtx, erc20, contract, owner)
+	recipientAfter := k.registrationBalanceOf(cacheCtx, erc20, contract, types.ModuleAddress)
+	if recipientBefore == nil || ownerAfter == nil || recipientAfter == nil {
+		return errorsmod.Wrap(types.ErrEVMCall, "failed to retrieve balances")
+	}
+
+	if ownerAfter.Sign() != 0 {
+		return errorsmod.Wrapf(
+			types.ErrBalanceInvariance,
+			"invalid sender balance after transfer - expected: 0, actual: %v", ownerAfter,
+		)
+	}
+
+	if received := new(big.Int).Sub(recipientAfter, recipientBefore); received.Cmp(amount) != 0 {
+		return errorsmod.Wrapf(
+			types.ErrBalanceInvariance,
+			"fee-on-transfer behavior detected - expected received amount: %v, actual: %v", amount, received,
+		)
+	}
+
+	supplyAfter, err := k.totalSupply(cacheCtx, contract)
+	if err != nil {
+		return err
+	}
+
+	if supplyAfter.Cmp(supplyBefore) != 0 {
+		return errorsmod.Wrapf(
+			types.ErrBalanceInvariance,
+			"rebasing behavior detected - expected total supply: %v, actual: %v", supplyBefore, supplyAfter,
+		)
+	}
+
+	return nil
+}
+
+// chargeRegistrationFee deducts the registration fee from the sender. The fee is
+// burned or sent to the community pool depending on the module parameters.
+func (k Keeper) chargeRegistrationFee(ctx sdk.Context, sender sdk.AccAddress, params types.Params) error {
+	fee := params.RegistrationFee
+	if fee.IsZero() {
+		return nil
+	}
+
+	if !params.BurnRegistrationFee {
+		return k.distrKeeper.FundCommunityPool(ctx, fee, sender)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, fee); err != nil {
+		return err
+	}
+
+	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, fee)
+}
+
+// totalSupply queries the total supply of the given ERC20 contract with the
+// registration gas limit.
+func (k Keeper) totalSupply(ctx sdk.Context, contract common.Address) (*big.Int, error) {
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+
+	res, err := k.registrationCall(ctx, erc20, types.ModuleAddress, contract, "totalSupply")
+	if err != nil {
+		return nil, err
+	}
+
+	unpacked, err := erc20.Unpack("totalSupply", res.Ret)
+	if err != nil || len(unpacked) == 0 {
+		return nil, errorsmod.Wrap(types.ErrABIUnpack, "failed to unpack total supply")
+	}
+
+	supply, ok := unpacked[0].(*big.Int)
+	if !ok {
+		return nil, errorsmod.Wrap(types.ErrABIUnpack, "invalid total supply type")
+	}
+
+	return supply, nil
+}
+
+// registrationCall calls the given method of the contract with the registration
+// gas limit, and consumes the gas used on the gas meter of the context. Failed
+// calls consume their whole gas limit.
+func (k Keeper) registrationCall(
+	ctx sdk.Context,
+	abi abi.ABI,
+	from, contract common.Address,
+	method string,
+	args ...interface{},
+) (*evmtypes.MsgEthereumTxResponse, error) {
+	res, err := k.evmKeeper.CallEVMWithGasLimit(ctx, abi, from, contract, registrationCallGasLimit, method, args...)
+	if err != nil {
+		ctx.GasMeter().ConsumeGas(registrationCallGasLimit, "erc20 registration call")
+		return nil, err
+	}
+
+	ctx.GasMeter().ConsumeGas(res.GasUsed, "erc20 registration call")
+	return res, nil
+}
+
+// registrationBalanceOf queries the balance of the given account with the
+// registration gas limit. It returns nil if the balance cannot be retrieved.
+func (k Keeper) registrationBalanceOf(ctx sdk.Context, abi abi.ABI, contract, account common.Address) *big.Int {
+	res, err := k.registrationCall(ctx, abi, types.ModuleAddress, contract, "balanceOf", account)
+	if err != nil {
+		return nil
+	}
+
+	unpacked, err := abi.Unpack("balanceOf", res.Ret)
+	if err != nil || len(unpacked) == 0 {
+		return nil
+	}
+
+	balance, ok := unpacked[0].(*big.Int)
+	if !ok {
+		return nil
+	}
+
+	return balance
+}
@@ -20,7 +20,12 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	enableErc20 := k.IsERC20Enabled(ctx)
 	dynamicPrecompiles := k.getDynamicPrecompiles(ctx)
 	nativePrecompiles := k.getNativePrecompiles(ctx)
-	return types.NewParams(enableErc20, nativePrecompiles, dynamicPrecompiles)
+	params = types.NewParams(enableErc20, nativePrecompiles, dynamicPrecompiles)
+	params.EnablePermissionlessRegistration = k.IsPermissionlessRegistrationEnabled(ctx)
+	params.RegistrationFee = k.getRegistrationFee(ctx)
+	params.BurnRegistrationFee = k.isBurnRegistrationFee(ctx)
+	params.RateLimitGuardian = k.getRateLimitGuardian(ctx)
+	return params
 }
 
 // UpdateCodeHash takes in the updated parameters and
@@ -88,6 +93,10 @@ func (k Keeper) SetParams(ctx sdk.Context, newParams types.Params) error {
 	k.setERC20Enabled(ctx, newParams.EnableErc20)
 	k.setDynamicPrecompiles(ctx, newParams.DynamicPrecompiles)
 	k.setNativePrecompiles(ctx, newParams.NativePrecompiles)
+	k.setFlag(ctx, types.ParamStoreKeyEnablePermissionlessRegistration, newParams.EnablePermissionlessRegistration)
+	k.setRegistrationFee(ctx, newParams.RegistrationFee)
+	k.setFlag(ctx, types.ParamStoreKeyBurnRegistrationFee, newParams.BurnRegistrationFee)
+	k.setRateLimitGuardian(ctx, newParams.RateLimitGuardian)
 	return nil
 }
 
@@ -147,3 +156,68 @@ func (k Keeper) getNativePrecompiles(ctx sdk.Context) (nativePrecompiles []strin
 	}
 	return nativePrecompiles
 }
+
+// IsPermissionlessRegistrationEnabled returns true if the ERC20 contract owners can
+// register token pairs without a governance proposal
+func (k Keeper) IsPermissionlessRegistrationEnabled(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.ParamStoreKeyEnablePermissionlessRegistration)
+}
+
+// isBurnRegistrationFee returns true if the registration fee is burned
+func (k Keeper) isBurnRegistrationFee(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.ParamStoreKeyBurnRegistrationFee)
+}
+
+// setFlag sets a boolean param in the store
+func (k Keeper) setFlag(ctx sdk.Context, key []byte, enable bool) {
+	store := ctx.KVStore(k.storeKey)
+	if enable {
+		store.Set(key, isTrue)
+		return
+	}
+	store.Delete(key)
+}
+
+// setRegistrationFee sets the RegistrationFee param in the store
+func (k Keeper) setRegistrationFee(ctx sdk.Context, fee sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	if fee.Empty() {
+		store.Delete(types.ParamStoreKeyRegistrationFee)
+		return
+	}
+	store.Set(types.ParamStoreKeyRegistrationFee, []byte(fee.String()))
+}
+
+// getRegistrationFee returns the RegistrationFee param from the store
+func (k Keeper) getRegistrationFee(ctx sdk.Context) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamStoreKeyRegistrationFee)
+	if len(bz) == 0 {
+		return nil
+	}
+
+	fee, err := sdk.ParseCoinsNormalized(string(bz))
+	if err != nil {
+		// the fee is validated before being stored
+		panic(err)
+	}
+	return fee
+}
+
+// setRateLimitGuardian sets the RateLimitGuardian param in the store
+func (k Keeper) setRateLimitGuardian(ctx sdk.Context, guardian string) {
+	store := ctx.KVStore(k.storeKey)
+	if guardian == "" {
+		store.Delete(types.ParamStoreKeyRateLimitGuardian)
+		return
+	}
+	store.Set(types.ParamStoreKeyRateLimitGuardian, []byte(guardian))
+}
+
+// getRateLimitGuardian returns the RateLimitGuardian param from the store
+func (k Keeper) getRateLimitGuardian(ctx sdk.Context) string {
+	store := ctx.KVStore(k.storeKey)
+	return string(store.Get(types.ParamStoreKeyRateLimitGuardian))
+}
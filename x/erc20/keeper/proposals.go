@@ -64,10 +64,22 @@ func (k Keeper) CreateCoinMetadata(
 		)
 	}
 
+	metadata, err := newCoinMetadata(strContract, erc20Data)
+	if err != nil {
+		return nil, err
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+
+	return &metadata, nil
+}
+
+// newCoinMetadata creates a bank denom metadata based on the ERC20 token ABI
+// details of the given contract.
+func newCoinMetadata(strContract string, erc20Data types.ERC20Data) (banktypes.Metadata, error) {
 	// base denomination
 	base := types.CreateDenom(strContract)
 
-	// create a bank denom metadata based on the ERC20 token ABI details
 	// metadata name is should always be the contract since it's the key
 	// to the bank store
 	metadata := banktypes.Metadata{
@@ -99,14 +111,12 @@ func (k Keeper) CreateCoinMetadata(
 	}
 
 	if err := metadata.Validate(); err != nil {
-		return nil, errorsmod.Wrapf(
+		return banktypes.Metadata{}, errorsmod.Wrapf(
 			err, "ERC20 token data is invalid for contract %s", strContract,
 		)
 	}
 
-	k.bankKeeper.SetDenomMetaData(ctx, metadata)
-
-	return &metadata, nil
+	return metadata, nil
 }
 
 // toggleConversion toggles conversion for a given token pair
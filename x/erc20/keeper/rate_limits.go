@@ -0,0 +1,244 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/AizelNetwork/CosmEvm/x/erc20/types"
+)
+
+// GetRateLimit returns the conversion rate limit of the given token pair denomination.
+func (k Keeper) GetRateLimit(ctx sdk.Context, denom string) (types.RateLimit, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRateLimit)
+	bz := store.Get([]byte(denom))
+	if len(bz) == 0 {
+		return types.RateLimit{}, false
+	}
+
+	var rateLimit types.RateLimit
+	k.cdc.MustUnmarshal(bz, &rateLimit)
+	return rateLimit, true
+}
+
+// SetRateLimit stores the conversion rate limit of a token pair.
+func (k Keeper) SetRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRateLimit)
+	bz := k.cdc.MustMarshal(&rateLimit)
+	store.Set([]byte(rateLimit.Denom), bz)
+}
+
+// DeleteRateLimit removes the conversion rate limit of the given token pair denomination.
+func (k Keeper) DeleteRateLimit(ctx sdk.Context, denom string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRateLimit)
+	store.Delete([]byte(denom))
+}
+
+// GetRateLimits returns the conversion rate limits of all token pairs.
+func (k Keeper) GetRateLimits(ctx sdk.Context) []types.RateLimit {
+	rateLimits := []types.RateLimit{}
+
+	k.IterateRateLimits(ctx, func(rateLimit types.RateLimit) (stop bool) {
+		rateLimits = append(rateLimits, rateLimit)
+		return false
+	})
+
+	return rateLimits
+}
+
+// IterateRateLimits iterates over all the stored rate limits.
+func (k Keeper) IterateRateLimits(ctx sdk.Context, cb func(rateLimit types.RateLimit) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixRateLimit)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var rateLimit types.RateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &rateLimit)
+
+		if cb(rateLimit) {
+			break
+		}
+	}
+}
+
+// setRateLimit sets the quotas of the rate limit of the given token. The flows and
+// the paused status of an existing rate limit are kept. Setting both quotas to
+// zero removes the rate limit.
+func (k Keeper) setRateLimit(
+	ctx sdk.Context,
+	token, epochIdentifier string,
+	maxInflow, maxOutflow math.Int,
+) (types.RateLimit, error) {
+	pair, err := k.getTokenPairByToken(ctx, token)
+	if err != nil {
+		return types.RateLimit{}, err
+	}
+
+	rateLimit, found := k.GetRateLimit(ctx, pair.Denom)
+	if !found {
+		rateLimit = types.NewRateLimit(pair.Denom, epochIdentifier, maxInflow, maxOutflow)
+	}
+
+	rateLimit.EpochIdentifier = epochIdentifier
+	rateLimit.MaxInflow = maxInflow
+	rateLimit.MaxOutflow = maxOutflow
+
+	if rateLimit.IsUnlimited() {
+		k.DeleteRateLimit(ctx, pair.Denom)
+		return rateLimit, nil
+	}
+
+	if err := rateLimit.Validate(); err != nil {
+		return types.RateLimit{}, errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+
+	k.SetRateLimit(ctx, rateLimit)
+	return rateLimit, nil
+}
+
+// resumeConversion lifts the pause of the rate limit of the given token and
+// resets its flows for the current epoch. Unless the sender is the governance
+// authority, it must be the rate limit guardian.
+func (k Keeper) resumeConversion(ctx sdk.Context, sender sdk.AccAddress, token string) (types.RateLimit, error) {
+	if !sender.Equals(k.authority) {
+		guardian := k.getRateLimitGuardian(ctx)
+		if guardian == "" || guardian != sender.String() {
+			return types.RateLimit{}, errorsmod.Wrapf(
+				errortypes.ErrUnauthorized, "%s is neither the governance authority nor the rate limit guardian", sender,
+			)
+		}
+	}
+
+	pair, err := k.getTokenPairByToken(ctx, token)
+	if err != nil {
+		return types.RateLimit{}, err
+	}
+
+	rateLimit, found := k.GetRateLimit(ctx, pair.Denom)
+	if !found || !rateLimit.Paused {
+		return types.RateLimit{}, errorsmod.Wrapf(
+			errortypes.ErrInvalidRequest, "conversions of token pair %s are not paused", pair.Denom,
+		)
+	}
+
+	rateLimit.Paused = false
+	rateLimit.ResetFlows()
+	k.SetRateLimit(ctx, rateLimit)
+	return rateLimit, nil
+}
+
+// checkAndUpdateInflow checks that converting the given amount of ERC20 tokens
+// into Cosmos coins does not exceed the inflow quota of the token pair and
+// records it.
+func (k Keeper) checkAndUpdateInflow(ctx sdk.Context, denom string, amount math.Int) error {
+	return k.checkAndUpdateFlow(ctx, denom, amount, true)
+}
+
+// checkAndUpdateOutflow checks that converting the given amount of Cosmos coins
+// into ERC20 tokens does not exceed the outflow quota of the token pair and
+// records it.
+func (k Keeper) checkAndUpdateOutflow(ctx sdk.Context, denom string, amount math.Int) error {
+	return k.checkAndUpdateFlow(ctx, denom, amount, false)
+}
+
+// checkAndUpdateFlow returns an error if the conversions of the token pair are
+// paused or if the amount exceeds the remaining quota of the current epoch.
+// Otherwise, the amount is added to the flow. The conversions are paused when a
+// conversion reaches or exceeds the quota, which is the expected outcome of an
+// exploit draining the token pair, and stay paused until governance or the
+// guardian resume them. The conversion exceeding the quota is rejected, which
+// reverts its state changes, so the pause is applied at the end of the block
+// along with the unchanged flows.
+func (k Keeper) checkAndUpdateFlow(ctx sdk.Context, denom string, amount math.Int, inflow bool) error {
+	rateLimit, found := k.GetRateLimit(ctx, denom)
+	if !found {
+		return nil
+	}
+
+	if rateLimit.Paused {
+		return errorsmod.Wrapf(types.ErrConversionPaused, "denom %s", denom)
+	}
+
+	flow, quota := rateLimit.Outflow, rateLimit.MaxOutflow
+	if inflow {
+		flow, quota = rateLimit.Inflow, rateLimit.MaxInflow
+	}
+
+	newFlow := flow.Add(amount)
+	if quota.IsPositive() && newFlow.GT(quota) {
+		k.tripRateLimit(ctx, denom)
+		return errorsmod.Wrapf(
+			types.ErrRateLimitExceeded,
+			"denom %s, amount %s, remaining quota %s", denom, amount, quota.Sub(flow),
+		)
+	}
+
+	if inflow {
+		rateLimit.Inflow = newFlow
+	} else {
+		rateLimit.Outflow = newFlow
+	}
+
+	if quota.IsPositive() && newFlow.Equal(quota) {
+		k.pauseConversion(ctx, rateLimit)
+		return nil
+	}
+
+	k.SetRateLimit(ctx, rateLimit)
+	return nil
+}
+
+// tripRateLimit records the token pair whose conversions are paused at the end
+// of the block. The trip is kept in memory, since the state changes of the
+// rejected conversion are reverted. It is only recorded while finalizing a
+// block, so that CheckTx and simulations don't pause the conversions.
+func (k Keeper) tripRateLimit(ctx sdk.Context, denom string) {
+	if ctx.ExecMode() != sdk.ExecModeFinalize {
+		return
+	}
+	k.trippedRateLimits[denom] = struct{}{}
+}
+
+// PauseTrippedConversions pauses the conversions of the token pairs whose
+// quotas were exceeded during the block.
+func (k Keeper) PauseTrippedConversions(ctx sdk.Context) {
+	denoms := make([]string, 0, len(k.trippedRateLimits))
+	for denom := range k.trippedRateLimits {
+		denoms = append(denoms, denom)
+		delete(k.trippedRateLimits, denom)
+	}
+	sort.Strings(denoms)
+
+	for _, denom := range denoms {
+		rateLimit, found := k.GetRateLimit(ctx, denom)
+		if !found || rateLimit.Paused {
+			continue
+		}
+		k.pauseConversion(ctx, rateLimit)
+	}
+}
+
+// pauseConversion pauses the conversions of the token pair of the given rate
+// limit and stores it.
+func (k Keeper) pauseConversion(ctx sdk.Context, rateLimit types.RateLimit) {
+	rateLimit.Paused = true
+	k.SetRateLimit(ctx, rateLimit)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePauseConversion,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, rateLimit.Denom),
+			sdk.NewAttribute(types.AttributeKeyInflow, rateLimit.Inflow.String()),
+			sdk.NewAttribute(types.AttributeKeyOutflow, rateLimit.Outflow.String()),
+		),
+	)
+}
@@ -0,0 +1,357 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	utiltx "github.com/AizelNetwork/CosmEvm/testutil/tx"
+	epochstypes "github.com/AizelNetwork/CosmEvm/x/epochs/types"
+	"github.com/AizelNetwork/CosmEvm/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestUpdateRateLimit() {
+	var token string
+
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name        string
+		authority   string
+		maxInflow   math.Int
+		maxOutflow  math.Int
+		malleate    func()
+		expPass     bool
+		expFound    bool
+		errContains string
+	}{
+		{
+			"fail - invalid authority",
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			math.NewInt(100),
+			math.ZeroInt(),
+			func() {},
+			false,
+			false,
+			"invalid authority",
+		},
+		{
+			"fail - token pair not registered",
+			govAddr,
+			math.NewInt(100),
+			math.ZeroInt(),
+			func() {
+				token = "unregistered"
+			},
+			false,
+			false,
+			"not registered",
+		},
+		{
+			"pass - set rate limit",
+			govAddr,
+			math.NewInt(100),
+			math.NewInt(50),
+			func() {},
+			true,
+			true,
+			"",
+		},
+		{
+			"pass - zero quotas remove the rate limit",
+			govAddr,
+			math.ZeroInt(),
+			math.ZeroInt(),
+			func() {
+				suite.network.App.Erc20Keeper.SetRateLimit(
+					suite.network.GetContext(),
+					types.NewRateLimit("coin", epochstypes.DayEpochID, math.NewInt(100), math.ZeroInt()),
+				)
+			},
+			true,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+
+			pair := types.NewTokenPair(utiltx.GenerateAddress(), "coin", types.OWNER_MODULE)
+			suite.network.App.Erc20Keeper.SetToken(suite.network.GetContext(), pair)
+			token = pair.Erc20Address
+
+			tc.malleate()
+
+			ctx := suite.network.GetContext()
+			_, err := suite.network.App.Erc20Keeper.UpdateRateLimit(ctx, &types.MsgUpdateRateLimit{
+				Authority:       tc.authority,
+				Token:           token,
+				EpochIdentifier: epochstypes.DayEpochID,
+				MaxInflow:       tc.maxInflow,
+				MaxOutflow:      tc.maxOutflow,
+			})
+
+			if !tc.expPass {
+				suite.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			suite.Require().NoError(err)
+
+			rateLimit, found := suite.network.App.Erc20Keeper.GetRateLimit(ctx, pair.Denom)
+			suite.Require().Equal(tc.expFound, found)
+			if found {
+				suite.Require().Equal(tc.maxInflow, rateLimit.MaxInflow)
+				suite.Require().Equal(tc.maxOutflow, rateLimit.MaxOutflow)
+				suite.Require().Equal(epochstypes.DayEpochID, rateLimit.EpochIdentifier)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestResumeConversion() {
+	var (
+		sender sdk.AccAddress
+		paused bool
+	)
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		expPass     bool
+		errContains string
+	}{
+		{
+			"fail - sender is not the guardian",
+			func() {
+				sender = suite.keyring.GetAccAddr(1)
+			},
+			false,
+			"neither the governance authority nor the rate limit guardian",
+		},
+		{
+			"fail - conversions are not paused",
+			func() {
+				sender = authtypes.NewModuleAddress(govtypes.ModuleName)
+				paused = false
+			},
+			false,
+			"are not paused",
+		},
+		{
+			"pass - governance resumes the conversions",
+			func() {
+				sender = authtypes.NewModuleAddress(govtypes.ModuleName)
+			},
+			true,
+			"",
+		},
+		{
+			"pass - guardian resumes the conversions",
+			func() {
+				sender = suite.keyring.GetAccAddr(1)
+
+				ctx := suite.network.GetContext()
+				params := suite.network.App.Erc20Keeper.GetParams(ctx)
+				params.RateLimitGuardian = sender.String()
+				err := suite.network.App.Erc20Keeper.SetParams(ctx, params)
+				suite.Require().NoError(err)
+			},
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			paused = true
+
+			tc.malleate()
+
+			ctx := suite.network.GetContext()
+			pair := types.NewTokenPair(utiltx.GenerateAddress(), "coin", types.OWNER_MODULE)
+			suite.network.App.Erc20Keeper.SetToken(ctx, pair)
+
+			rateLimit := types.NewRateLimit(pair.Denom, epochstypes.DayEpochID, math.NewInt(100), math.ZeroInt())
+			rateLimit.Inflow = math.NewInt(100)
+			rateLimit.Paused = paused
+			suite.network.App.Erc20Keeper.SetRateLimit(ctx, rateLimit)
+
+			_, err := suite.network.App.Erc20Keeper.ResumeConversion(ctx, &types.MsgResumeConversion{
+				Sender: sender.String(),
+				Token:  pair.Denom,
+			})
+
+			if !tc.expPass {
+				suite.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			suite.Require().NoError(err)
+
+			rateLimit, found := suite.network.App.Erc20Keeper.GetRateLimit(ctx, pair.Denom)
+			suite.Require().True(found)
+			suite.Require().False(rateLimit.Paused)
+			suite.Require().True(rateLimit.Inflow.IsZero())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConversionRateLimit() {
+	suite.SetupTest()
+
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	sender := suite.keyring.GetAddr(0)
+	receiver := suite.keyring.GetAccAddr(0)
+
+	contractAddr, err := suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+	suite.Require().NoError(err)
+	_, err = suite.MintERC20Token(contractAddr, sender, big.NewInt(100))
+	suite.Require().NoError(err)
+
+	ctx := suite.network.GetContext()
+	_, err = suite.network.App.Erc20Keeper.RegisterERC20(ctx, &types.MsgRegisterERC20{
+		Authority:      govAddr,
+		Erc20Addresses: []string{contractAddr.Hex()},
+	})
+	suite.Require().NoError(err)
+
+	pair, found := suite.network.App.Erc20Keeper.GetTokenPair(ctx, suite.network.App.Erc20Keeper.GetERC20Map(ctx, contractAddr))
+	suite.Require().True(found)
+
+	_, err = suite.network.App.Erc20Keeper.UpdateRateLimit(ctx, &types.MsgUpdateRateLimit{
+		Authority:       govAddr,
+		Token:           contractAddr.Hex(),
+		EpochIdentifier: epochstypes.DayEpochID,
+		MaxInflow:       math.NewInt(50),
+		MaxOutflow:      math.NewInt(5),
+	})
+	suite.Require().NoError(err)
+
+	convertERC20 := func(amount int64) error {
+		_, err := suite.network.App.Erc20Keeper.ConvertERC20(ctx, types.NewMsgConvertERC20(
+			math.NewInt(amount), receiver, contractAddr, sender,
+		))
+		return err
+	}
+
+	rateLimit := func() types.RateLimit {
+		rl, found := suite.network.App.Erc20Keeper.GetRateLimit(ctx, pair.Denom)
+		suite.Require().True(found)
+		return rl
+	}
+
+	// conversions within the inflow quota succeed
+	suite.Require().NoError(convertERC20(30))
+	suite.Require().Equal(math.NewInt(30), rateLimit().Inflow)
+
+	// conversions exceeding the remaining quota are rejected
+	suite.Require().ErrorIs(convertERC20(30), types.ErrRateLimitExceeded)
+	suite.Require().Equal(math.NewInt(30), rateLimit().Inflow)
+
+	// conversions from Cosmos coins exceeding the outflow quota are rejected
+	err = suite.network.App.Erc20Keeper.ConvertCoinNativeERC20(ctx, pair, math.NewInt(6), sender, receiver)
+	suite.Require().ErrorIs(err, types.ErrRateLimitExceeded)
+	suite.Require().NoError(suite.network.App.Erc20Keeper.ConvertCoinNativeERC20(ctx, pair, math.NewInt(4), sender, receiver))
+	suite.Require().Equal(math.NewInt(4), rateLimit().Outflow)
+
+	// the rejected conversions only pause the conversions at the end of a
+	// finalized block
+	suite.Require().False(rateLimit().Paused)
+
+	// exhausting the quota pauses the conversions
+	suite.Require().NoError(convertERC20(20))
+	suite.Require().True(rateLimit().Paused)
+	suite.Require().ErrorIs(convertERC20(1), types.ErrConversionPaused)
+	err = suite.network.App.Erc20Keeper.ConvertCoinNativeERC20(ctx, pair, math.NewInt(1), sender, receiver)
+	suite.Require().ErrorIs(err, types.ErrConversionPaused)
+
+	// the end of the epoch does not lift the pause
+	suite.network.App.Erc20Keeper.AfterEpochEnd(ctx, epochstypes.DayEpochID, 1)
+	suite.Require().True(rateLimit().Paused)
+	suite.Require().Equal(math.NewInt(50), rateLimit().Inflow)
+
+	// governance resumes the conversions and resets the flows
+	_, err = suite.network.App.Erc20Keeper.ResumeConversion(ctx, &types.MsgResumeConversion{
+		Sender: govAddr,
+		Token:  pair.Denom,
+	})
+	suite.Require().NoError(err)
+	suite.Require().False(rateLimit().Paused)
+	suite.Require().NoError(convertERC20(10))
+	suite.Require().Equal(math.NewInt(10), rateLimit().Inflow)
+}
+
+func (suite *KeeperTestSuite) TestConversionRateLimitExceeded() {
+	suite.SetupTest()
+
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	sender := suite.keyring.GetAddr(0)
+	receiver := suite.keyring.GetAccAddr(0)
+
+	contractAddr, err := suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+	suite.Require().NoError(err)
+	_, err = suite.MintERC20Token(contractAddr, sender, big.NewInt(100))
+	suite.Require().NoError(err)
+
+	ctx := suite.network.GetContext()
+	_, err = suite.network.App.Erc20Keeper.RegisterERC20(ctx, &types.MsgRegisterERC20{
+		Authority:      govAddr,
+		Erc20Addresses: []string{contractAddr.Hex()},
+	})
+	suite.Require().NoError(err)
+
+	pair, found := suite.network.App.Erc20Keeper.GetTokenPair(ctx, suite.network.App.Erc20Keeper.GetERC20Map(ctx, contractAddr))
+	suite.Require().True(found)
+
+	_, err = suite.network.App.Erc20Keeper.UpdateRateLimit(ctx, &types.MsgUpdateRateLimit{
+		Authority:       govAddr,
+		Token:           contractAddr.Hex(),
+		EpochIdentifier: epochstypes.DayEpochID,
+		MaxInflow:       math.NewInt(50),
+		MaxOutflow:      math.NewInt(5),
+	})
+	suite.Require().NoError(err)
+
+	// the conversions are delivered as in a finalized block, where the state
+	// changes of a failed message are discarded
+	ctx = ctx.WithExecMode(sdk.ExecModeFinalize)
+	convertERC20 := func(amount int64) error {
+		cacheCtx, writeFn := ctx.CacheContext()
+		_, err := suite.network.App.Erc20Keeper.ConvertERC20(cacheCtx, types.NewMsgConvertERC20(
+			math.NewInt(amount), receiver, contractAddr, sender,
+		))
+		if err == nil {
+			writeFn()
+		}
+		return err
+	}
+
+	// a single conversion exceeding the quota is rejected and trips the
+	// circuit breaker at the end of the block
+	suite.Require().ErrorIs(convertERC20(60), types.ErrRateLimitExceeded)
+
+	rateLimit, found := suite.network.App.Erc20Keeper.GetRateLimit(ctx, pair.Denom)
+	suite.Require().True(found)
+	suite.Require().False(rateLimit.Paused)
+
+	_, err = suite.network.App.EndBlocker(ctx)
+	suite.Require().NoError(err)
+
+	rateLimit, found = suite.network.App.Erc20Keeper.GetRateLimit(ctx, pair.Denom)
+	suite.Require().True(found)
+	suite.Require().True(rateLimit.Paused)
+	suite.Require().True(rateLimit.Inflow.IsZero())
+
+	// the conversions within the quota are paused as well, in both directions
+	suite.Require().ErrorIs(convertERC20(1), types.ErrConversionPaused)
+	err = suite.network.App.Erc20Keeper.ConvertCoinNativeERC20(ctx, pair, math.NewInt(1), sender, receiver)
+	suite.Require().ErrorIs(err, types.ErrConversionPaused)
+}
@@ -35,8 +35,9 @@ var (
 	_ module.AppModuleBasic      = AppModuleBasic{}
 	_ module.AppModuleSimulation = AppModule{}
 
-	_ appmodule.AppModule   = AppModule{}
-	_ module.HasABCIGenesis = AppModule{}
+	_ appmodule.AppModule     = AppModule{}
+	_ appmodule.HasEndBlocker = AppModule{}
+	_ module.HasABCIGenesis   = AppModule{}
 )
 
 // app module Basics object
@@ -143,6 +144,14 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	}
 }
 
+// EndBlock pauses the conversions of the token pairs whose rate limits were
+// exceeded during the block.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	c := sdk.UnwrapSDKContext(ctx)
+	am.keeper.PauseTrippedConversions(c)
+	return nil
+}
+
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
 	var genesisState types.GenesisState
 
@@ -27,11 +27,16 @@ var (
 
 const (
 	// Amino names
-	convertERC20Name = "aizel/MsgConvertERC20"
-	convertCoinName  = "aizel/MsgConvertCoin" // keep it for backwards compatibility when querying txs
-	updateParams     = "aizel/erc20/MsgUpdateParams"
-	registerERC20    = "aizel/erc20/MsgRegisterERC20"
-	toggleConversion = "aizel/erc20/MsgToggleConversion"
+	convertERC20Name     = "aizel/MsgConvertERC20"
+	convertCoinName      = "aizel/MsgConvertCoin" // keep it for backwards compatibility when querying txs
+	updateParams         = "aizel/erc20/MsgUpdateParams"
+	registerERC20        = "aizel/erc20/MsgRegisterERC20"
+	toggleConversion     = "aizel/erc20/MsgToggleConversion"
+	registerERC20ByOwner = "aizel/erc20/MsgRegisterERC20ByOwner"
+	syncTokenPairMeta    = "aizel/erc20/MsgSyncTokenPairMetadata"
+	updateDenomMetadata  = "aizel/erc20/MsgUpdateDenomMetadata"
+	updateRateLimit      = "aizel/erc20/MsgUpdateRateLimit"
+	resumeConversion     = "aizel/erc20/MsgResumeConversion"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -49,6 +54,11 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgUpdateParams{},
 		&MsgRegisterERC20{},
 		&MsgToggleConversion{},
+		&MsgRegisterERC20ByOwner{},
+		&MsgSyncTokenPairMetadata{},
+		&MsgUpdateDenomMetadata{},
+		&MsgUpdateRateLimit{},
+		&MsgResumeConversion{},
 	)
 	registry.RegisterImplementations(
 		(*govv1beta1.Content)(nil),
@@ -69,4 +79,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgConvertCoin{}, convertCoinName, nil)
 	cdc.RegisterConcrete(&MsgRegisterERC20{}, registerERC20, nil)
 	cdc.RegisterConcrete(&MsgToggleConversion{}, toggleConversion, nil)
+	cdc.RegisterConcrete(&MsgRegisterERC20ByOwner{}, registerERC20ByOwner, nil)
+	cdc.RegisterConcrete(&MsgSyncTokenPairMetadata{}, syncTokenPairMeta, nil)
+	cdc.RegisterConcrete(&MsgUpdateDenomMetadata{}, updateDenomMetadata, nil)
+	cdc.RegisterConcrete(&MsgUpdateRateLimit{}, updateRateLimit, nil)
+	cdc.RegisterConcrete(&MsgResumeConversion{}, resumeConversion, nil)
 }
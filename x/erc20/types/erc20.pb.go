@@ -4,7 +4,10 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	types "github.com/cosmos/cosmos-sdk/x/bank/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -130,6 +133,82 @@ func (m *TokenPair) GetContractOwner() Owner {
 	return OWNER_UNSPECIFIED
 }
 
+// RateLimit defines the conversion quotas of a token pair, which are tracked over
+// the windows of an epoch. Inflow is the amount of ERC20 tokens converted into Cosmos
+// coins and outflow the amount of Cosmos coins converted into ERC20 tokens.
+type RateLimit struct {
+	// denom is the Cosmos coin denomination of the token pair
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// epoch_identifier is the identifier of the x/epochs epoch that resets the flows
+	EpochIdentifier string `protobuf:"bytes,2,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// max_inflow is the maximum inflow per epoch. A zero value disables the inflow quota.
+	MaxInflow cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=max_inflow,json=maxInflow,proto3,customtype=cosmossdk.io/math.Int" json:"max_inflow"`
+	// max_outflow is the maximum outflow per epoch. A zero value disables the outflow quota.
+	MaxOutflow cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=max_outflow,json=maxOutflow,proto3,customtype=cosmossdk.io/math.Int" json:"max_outflow"`
+	// inflow is the amount converted into Cosmos coins during the current epoch
+	Inflow cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=inflow,proto3,customtype=cosmossdk.io/math.Int" json:"inflow"`
+	// outflow is the amount converted into ERC20 tokens during the current epoch
+	Outflow cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=outflow,proto3,customtype=cosmossdk.io/math.Int" json:"outflow"`
+	// paused is set when a conversion reaches or exceeds a quota. It blocks the
+	// conversions of the token pair until governance or the rate limit guardian
+	// resumes them.
+	Paused bool `protobuf:"varint,7,opt,name=paused,proto3" json:"paused,omitempty"`
+}
+
+func (m *RateLimit) Reset()         { *m = RateLimit{} }
+func (m *RateLimit) String() string { return proto.CompactTextString(m) }
+func (*RateLimit) ProtoMessage()    {}
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{1}
+}
+func (m *RateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimit.Merge(m, src)
+}
+func (m *RateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimit proto.InternalMessageInfo
+
+func (m *RateLimit) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *RateLimit) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+func (m *RateLimit) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
 // Deprecated: RegisterCoinProposal is a gov Content type to register a token pair for a
 // native Cosmos coin. We're keeping it to remove the existing proposals from
 // store. After that, remove this message.
@@ -147,7 +226,7 @@ func (m *RegisterCoinProposal) Reset()         { *m = RegisterCoinProposal{} }
 func (m *RegisterCoinProposal) String() string { return proto.CompactTextString(m) }
 func (*RegisterCoinProposal) ProtoMessage()    {}
 func (*RegisterCoinProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_668d5dc537f45142, []int{1}
+	return fileDescriptor_668d5dc537f45142, []int{2}
 }
 func (m *RegisterCoinProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -209,7 +288,7 @@ func (m *ProposalMetadata) Reset()         { *m = ProposalMetadata{} }
 func (m *ProposalMetadata) String() string { return proto.CompactTextString(m) }
 func (*ProposalMetadata) ProtoMessage()    {}
 func (*ProposalMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_668d5dc537f45142, []int{2}
+	return fileDescriptor_668d5dc537f45142, []int{3}
 }
 func (m *ProposalMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -261,7 +340,7 @@ func (m *RegisterERC20Proposal) Reset()         { *m = RegisterERC20Proposal{} }
 func (m *RegisterERC20Proposal) String() string { return proto.CompactTextString(m) }
 func (*RegisterERC20Proposal) ProtoMessage()    {}
 func (*RegisterERC20Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_668d5dc537f45142, []int{3}
+	return fileDescriptor_668d5dc537f45142, []int{4}
 }
 func (m *RegisterERC20Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -328,7 +407,7 @@ func (m *ToggleTokenConversionProposal) Reset()         { *m = ToggleTokenConver
 func (m *ToggleTokenConversionProposal) String() string { return proto.CompactTextString(m) }
 func (*ToggleTokenConversionProposal) ProtoMessage()    {}
 func (*ToggleTokenConversionProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_668d5dc537f45142, []int{4}
+	return fileDescriptor_668d5dc537f45142, []int{5}
 }
 func (m *ToggleTokenConversionProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -381,6 +460,7 @@ func (m *ToggleTokenConversionProposal) GetToken() string {
 func init() {
 	proto.RegisterEnum("evmos.erc20.v1.Owner", Owner_name, Owner_value)
 	proto.RegisterType((*TokenPair)(nil), "evmos.erc20.v1.TokenPair")
+	proto.RegisterType((*RateLimit)(nil), "evmos.erc20.v1.RateLimit")
 	proto.RegisterType((*RegisterCoinProposal)(nil), "evmos.erc20.v1.RegisterCoinProposal")
 	proto.RegisterType((*ProposalMetadata)(nil), "evmos.erc20.v1.ProposalMetadata")
 	proto.RegisterType((*RegisterERC20Proposal)(nil), "evmos.erc20.v1.RegisterERC20Proposal")
@@ -390,39 +470,50 @@ func init() {
 func init() { proto.RegisterFile("evmos/erc20/v1/erc20.proto", fileDescriptor_668d5dc537f45142) }
 
 var fileDescriptor_668d5dc537f45142 = []byte{
-	// 504 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xcf, 0x8b, 0xd3, 0x40,
-	0x14, 0xce, 0x6c, 0xbb, 0xba, 0x9d, 0xdd, 0x0d, 0x71, 0x68, 0x21, 0x14, 0x36, 0x1b, 0x2a, 0x48,
-	0xf0, 0x90, 0xb4, 0xf1, 0x26, 0x82, 0x6c, 0xbb, 0x11, 0x56, 0x76, 0xdb, 0x92, 0xed, 0xa2, 0x78,
-	0x29, 0x93, 0x64, 0x88, 0x61, 0xdb, 0x4c, 0x99, 0x19, 0xa3, 0x1e, 0xbc, 0x7b, 0xf4, 0xe2, 0x5d,
-	0xd0, 0x3f, 0x66, 0x8f, 0x7b, 0xf4, 0x24, 0xd2, 0x5e, 0xfc, 0x33, 0x24, 0x33, 0x89, 0xb8, 0x1e,
-	0xdd, 0xcb, 0xf0, 0xbe, 0xef, 0xfd, 0xe0, 0xbd, 0xef, 0xcd, 0x83, 0x5d, 0x52, 0x2c, 0x29, 0xf7,
-	0x08, 0x8b, 0xfd, 0xbe, 0x57, 0x0c, 0x94, 0xe1, 0xae, 0x18, 0x15, 0x14, 0xe9, 0xd2, 0xe7, 0x2a,
-	0xaa, 0x18, 0x74, 0xad, 0x98, 0xf2, 0x32, 0x38, 0xc2, 0xf9, 0xa5, 0x57, 0x0c, 0x22, 0x22, 0xf0,
-	0x40, 0x02, 0x15, 0xdf, 0x6d, 0xa7, 0x34, 0xa5, 0xd2, 0xf4, 0x4a, 0x4b, 0xb1, 0xbd, 0x6f, 0x00,
-	0xb6, 0x66, 0xf4, 0x92, 0xe4, 0x53, 0x9c, 0x31, 0x74, 0x1f, 0xee, 0xcb, 0x7a, 0x73, 0x9c, 0x24,
-	0x8c, 0x70, 0x6e, 0x02, 0x1b, 0x38, 0xad, 0x70, 0x4f, 0x92, 0x47, 0x8a, 0x43, 0x6d, 0xb8, 0x9d,
-	0x90, 0x9c, 0x2e, 0xcd, 0x2d, 0xe9, 0x54, 0x00, 0x99, 0xf0, 0x2e, 0xc9, 0x71, 0xb4, 0x20, 0x89,
-	0xd9, 0xb0, 0x81, 0xb3, 0x13, 0xd6, 0x10, 0x3d, 0x81, 0x7a, 0x4c, 0x73, 0xc1, 0x70, 0x2c, 0xe6,
-	0xf4, 0x6d, 0x4e, 0x98, 0xd9, 0xb4, 0x81, 0xa3, 0xfb, 0x1d, 0xf7, 0xe6, 0x04, 0xee, 0xa4, 0x74,
-	0x86, 0xfb, 0x75, 0xb0, 0x84, 0x8f, 0x9b, 0xbf, 0xbe, 0x1c, 0x82, 0xde, 0x67, 0x00, 0xdb, 0x21,
-	0x49, 0x33, 0x2e, 0x08, 0x1b, 0xd1, 0x2c, 0x9f, 0x32, 0xba, 0xa2, 0x1c, 0x2f, 0xca, 0x66, 0x44,
-	0x26, 0x16, 0xa4, 0xea, 0x54, 0x01, 0x64, 0xc3, 0xdd, 0x84, 0xf0, 0x98, 0x65, 0x2b, 0x91, 0xd1,
-	0xbc, 0x6a, 0xf4, 0x6f, 0x0a, 0x3d, 0x85, 0x3b, 0x4b, 0x22, 0x70, 0x82, 0x05, 0x36, 0x1b, 0x76,
-	0xc3, 0xd9, 0xf5, 0x0f, 0x5c, 0x25, 0xa0, 0x2b, 0x35, 0xab, 0x04, 0x74, 0xcf, 0xaa, 0xa0, 0x61,
-	0xf3, 0xea, 0xc7, 0xa1, 0x16, 0xfe, 0x49, 0x92, 0x7d, 0x69, 0xbd, 0x73, 0x68, 0xd4, 0xad, 0xd4,
-	0x91, 0x37, 0x4a, 0x83, 0xff, 0x28, 0xdd, 0xfb, 0x00, 0x3b, 0xf5, 0xac, 0x41, 0x38, 0xf2, 0xfb,
-	0xb7, 0x1e, 0xf6, 0x01, 0xd4, 0xa5, 0xc8, 0xd5, 0x56, 0x09, 0x97, 0x23, 0xb7, 0xc2, 0x7f, 0xd8,
-	0x6a, 0x26, 0x0e, 0x0f, 0x66, 0x34, 0x4d, 0x17, 0x44, 0xfe, 0x8b, 0x11, 0xcd, 0x0b, 0xc2, 0x78,
-	0x46, 0x6f, 0xaf, 0x79, 0x99, 0x57, 0x96, 0x94, 0x1f, 0xa4, 0xcc, 0x2b, 0x81, 0x5a, 0xf0, 0xc3,
-	0xe7, 0x70, 0x5b, 0xee, 0x1b, 0x75, 0xe0, 0xbd, 0xc9, 0x8b, 0x71, 0x10, 0xce, 0x2f, 0xc6, 0xe7,
-	0xd3, 0x60, 0x74, 0xf2, 0xec, 0x24, 0x38, 0x36, 0x34, 0x64, 0xc0, 0x3d, 0x45, 0x9f, 0x4d, 0x8e,
-	0x2f, 0x4e, 0x03, 0x03, 0x20, 0x04, 0x75, 0xc5, 0x04, 0x2f, 0x67, 0x41, 0x38, 0x3e, 0x3a, 0x35,
-	0xb6, 0xba, 0xcd, 0x8f, 0x5f, 0x2d, 0x6d, 0x38, 0xbc, 0x5a, 0x5b, 0xe0, 0x7a, 0x6d, 0x81, 0x9f,
-	0x6b, 0x0b, 0x7c, 0xda, 0x58, 0xda, 0xf5, 0xc6, 0xd2, 0xbe, 0x6f, 0x2c, 0xed, 0x95, 0x93, 0x66,
-	0xe2, 0xf5, 0x9b, 0xc8, 0x8d, 0xe9, 0xd2, 0xab, 0x4e, 0x4b, 0xbe, 0x85, 0xdf, 0xf7, 0xde, 0x55,
-	0x67, 0x26, 0xde, 0xaf, 0x08, 0x8f, 0xee, 0xc8, 0xf3, 0x78, 0xf4, 0x3b, 0x00, 0x00, 0xff, 0xff,
-	0x17, 0xfb, 0x5c, 0x69, 0x82, 0x03, 0x00, 0x00,
+	// 684 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x41, 0x4f, 0x53, 0x4b,
+	0x14, 0xee, 0xd0, 0x52, 0xe8, 0x00, 0x7d, 0x65, 0x42, 0x5f, 0xfa, 0x9a, 0x70, 0x69, 0xfa, 0x92,
+	0x97, 0x3e, 0x12, 0xee, 0xa5, 0x75, 0x67, 0x4c, 0x0c, 0x94, 0xab, 0x96, 0x40, 0x8b, 0x17, 0x88,
+	0xc6, 0x4d, 0x33, 0xed, 0x1d, 0xca, 0xa4, 0xbd, 0x33, 0xcd, 0x9d, 0xa1, 0xa0, 0x89, 0x7b, 0x97,
+	0x6e, 0xdc, 0x1a, 0x13, 0x5d, 0xb8, 0x74, 0xe1, 0x8f, 0x60, 0x49, 0x5c, 0x19, 0x17, 0xc4, 0xc0,
+	0x42, 0x7f, 0x86, 0xb9, 0x33, 0x73, 0x11, 0x5c, 0x96, 0x4d, 0x73, 0xbf, 0xef, 0x9c, 0xf3, 0xf5,
+	0x3b, 0x73, 0xe6, 0x0c, 0x2c, 0x92, 0x51, 0xc0, 0x85, 0x43, 0xc2, 0x6e, 0x6d, 0xd5, 0x19, 0x55,
+	0xf5, 0x87, 0x3d, 0x0c, 0xb9, 0xe4, 0x28, 0xab, 0x62, 0xb6, 0xa6, 0x46, 0xd5, 0xe2, 0x3c, 0x0e,
+	0x28, 0xe3, 0x8e, 0xfa, 0xd5, 0x29, 0x45, 0xab, 0xcb, 0x45, 0x54, 0xdf, 0xc1, 0xac, 0xef, 0x8c,
+	0xaa, 0x1d, 0x22, 0x71, 0x55, 0x01, 0x13, 0xff, 0x47, 0xc7, 0xdb, 0x0a, 0x39, 0x1a, 0x98, 0xd0,
+	0x42, 0x8f, 0xf7, 0xb8, 0xe6, 0xa3, 0x2f, 0xcd, 0x96, 0x3f, 0x00, 0x98, 0xd9, 0xe3, 0x7d, 0xc2,
+	0x76, 0x30, 0x0d, 0xd1, 0xbf, 0x70, 0x4e, 0xfd, 0x7b, 0x1b, 0xfb, 0x7e, 0x48, 0x84, 0x28, 0x80,
+	0x12, 0xa8, 0x64, 0xbc, 0x59, 0x45, 0xae, 0x69, 0x0e, 0x2d, 0xc0, 0x49, 0x9f, 0x30, 0x1e, 0x14,
+	0x26, 0x54, 0x50, 0x03, 0x54, 0x80, 0x53, 0x84, 0xe1, 0xce, 0x80, 0xf8, 0x85, 0x64, 0x09, 0x54,
+	0xa6, 0xbd, 0x18, 0xa2, 0x7b, 0x30, 0xdb, 0xe5, 0x4c, 0x86, 0xb8, 0x2b, 0xdb, 0xfc, 0x98, 0x91,
+	0xb0, 0x90, 0x2a, 0x81, 0x4a, 0xb6, 0x96, 0xb7, 0x6f, 0xf6, 0x6b, 0xb7, 0xa2, 0xa0, 0x37, 0x17,
+	0x27, 0x2b, 0x78, 0x37, 0xf5, 0xf3, 0xdd, 0x12, 0x28, 0xbf, 0x4d, 0xc2, 0x8c, 0x87, 0x25, 0xd9,
+	0xa2, 0x01, 0x95, 0xbf, 0x1d, 0x80, 0xeb, 0x0e, 0xfe, 0x87, 0x39, 0x32, 0xe4, 0xdd, 0xc3, 0x36,
+	0xf5, 0x09, 0x93, 0xf4, 0x80, 0x92, 0xd0, 0x58, 0xfc, 0x4b, 0xf1, 0x8d, 0x2b, 0x1a, 0xb5, 0x20,
+	0x0c, 0xf0, 0x49, 0x9b, 0xb2, 0x83, 0x01, 0x3f, 0x56, 0x7e, 0x33, 0xeb, 0xab, 0xa7, 0xe7, 0x4b,
+	0x89, 0x6f, 0xe7, 0x4b, 0x79, 0x7d, 0x6a, 0xc2, 0xef, 0xdb, 0x94, 0x3b, 0x01, 0x96, 0x87, 0x76,
+	0x83, 0xc9, 0x2f, 0x9f, 0x57, 0xa0, 0x39, 0xce, 0x06, 0x93, 0x1f, 0x7f, 0x7c, 0x5a, 0x06, 0x5e,
+	0x26, 0xc0, 0x27, 0x0d, 0x25, 0x81, 0x1e, 0xc3, 0x99, 0x48, 0x90, 0x1f, 0x49, 0xa5, 0x98, 0x1a,
+	0x53, 0x31, 0x72, 0xd5, 0xd2, 0x1a, 0xe8, 0x11, 0x4c, 0x1b, 0x7f, 0x93, 0x63, 0xaa, 0x99, 0x7a,
+	0xb4, 0x09, 0xa7, 0x62, 0x63, 0xe9, 0x31, 0xa5, 0x62, 0x01, 0xf4, 0x37, 0x4c, 0x0f, 0xf1, 0x91,
+	0x20, 0x7e, 0x61, 0x4a, 0x4d, 0xd9, 0xa0, 0xf2, 0x1b, 0x00, 0x17, 0x3c, 0xd2, 0xa3, 0x42, 0x92,
+	0xb0, 0xce, 0x29, 0xdb, 0x09, 0xf9, 0x90, 0x0b, 0x3c, 0x88, 0x66, 0x25, 0xa9, 0x1c, 0x90, 0x78,
+	0x56, 0x0a, 0xa0, 0x12, 0x9c, 0xf1, 0x89, 0xe8, 0x86, 0x74, 0x28, 0x29, 0x67, 0x66, 0x4c, 0xd7,
+	0x29, 0x74, 0x1f, 0x4e, 0x07, 0x44, 0x62, 0x1f, 0x4b, 0x5c, 0x48, 0x96, 0x92, 0x95, 0x99, 0xda,
+	0xa2, 0x6d, 0x5c, 0xa9, 0xfb, 0x6e, 0x2e, 0xbf, 0xbd, 0x6d, 0x92, 0xd6, 0x53, 0x51, 0x53, 0xde,
+	0x55, 0x91, 0xba, 0x38, 0x89, 0xf2, 0x2e, 0xcc, 0xc5, 0x56, 0xe2, 0xcc, 0x1b, 0xd2, 0x60, 0x0c,
+	0xe9, 0xf2, 0x4b, 0x98, 0x8f, 0x7b, 0x75, 0xbd, 0x7a, 0x6d, 0xf5, 0xd6, 0xcd, 0xfe, 0x07, 0xb3,
+	0x6a, 0x0b, 0xcc, 0xda, 0x11, 0xa1, 0x5a, 0xce, 0x78, 0x7f, 0xb0, 0xa6, 0x27, 0x01, 0x17, 0xf7,
+	0x78, 0xaf, 0x37, 0x20, 0x6a, 0x71, 0xeb, 0x9c, 0x8d, 0x48, 0x28, 0x28, 0xbf, 0xfd, 0x99, 0x47,
+	0x75, 0x91, 0xa4, 0xde, 0x08, 0x4f, 0x03, 0xbd, 0x81, 0xcb, 0x9b, 0x70, 0x52, 0x2d, 0x24, 0xca,
+	0xc3, 0xf9, 0xd6, 0x93, 0xa6, 0xeb, 0xb5, 0xf7, 0x9b, 0xbb, 0x3b, 0x6e, 0xbd, 0xf1, 0xa0, 0xe1,
+	0x6e, 0xe4, 0x12, 0x28, 0x07, 0x67, 0x35, 0xbd, 0xdd, 0xda, 0xd8, 0xdf, 0x72, 0x73, 0x00, 0x21,
+	0x98, 0xd5, 0x8c, 0xfb, 0x74, 0xcf, 0xf5, 0x9a, 0x6b, 0x5b, 0xb9, 0x89, 0x62, 0xea, 0xd5, 0x7b,
+	0x2b, 0xb1, 0xfe, 0xf0, 0xf4, 0xc2, 0x02, 0x67, 0x17, 0x16, 0xf8, 0x7e, 0x61, 0x81, 0xd7, 0x97,
+	0x56, 0xe2, 0xec, 0xd2, 0x4a, 0x7c, 0xbd, 0xb4, 0x12, 0xcf, 0x56, 0x7a, 0x54, 0x1e, 0x1e, 0x75,
+	0xec, 0x2e, 0x0f, 0x9c, 0x35, 0xfa, 0x82, 0x0c, 0x9a, 0x44, 0x1e, 0xf3, 0xb0, 0xef, 0xd4, 0xb9,
+	0x08, 0xdc, 0x51, 0xe0, 0x9c, 0x98, 0xa7, 0x53, 0x3e, 0x1f, 0x12, 0xd1, 0x49, 0xab, 0x47, 0xec,
+	0xce, 0xaf, 0x01, 0x00, 0x9a, 0xd5, 0xb7, 0xca, 0x56, 0x05, 0x00, 0x00,
 }
 
 func (this *TokenPair) Equal(that interface{}) bool {
@@ -540,6 +631,93 @@ func (m *TokenPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.Outflow.Size()
+		i -= size
+		if _, err := m.Outflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Inflow.Size()
+		i -= size
+		if _, err := m.Inflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxOutflow.Size()
+		i -= size
+		if _, err := m.MaxOutflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxInflow.Size()
+		i -= size
+		if _, err := m.MaxInflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RegisterCoinProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -752,6 +930,34 @@ func (m *TokenPair) Size() (n int) {
 	return n
 }
 
+func (m *RateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = m.MaxInflow.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	l = m.MaxOutflow.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	l = m.Inflow.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	l = m.Outflow.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
 func (m *RegisterCoinProposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -993,6 +1199,276 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxInflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOutflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxOutflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Outflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *RegisterCoinProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
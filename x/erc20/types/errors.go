@@ -24,4 +24,8 @@ var (
 	ErrInvalidIBC               = errorsmod.Register(ModuleName, 14, "invalid IBC transaction")
 	ErrTokenPairOwnedByModule   = errorsmod.Register(ModuleName, 15, "token pair owned by module")
 	ErrNativeConversionDisabled = errorsmod.Register(ModuleName, 16, "native coins manual conversion is disabled")
+	ErrRegistrationDisabled     = errorsmod.Register(ModuleName, 17, "permissionless token pair registration is disabled")
+	ErrNotContractOwner         = errorsmod.Register(ModuleName, 18, "sender is not the owner of the contract")
+	ErrRateLimitExceeded        = errorsmod.Register(ModuleName, 19, "token pair conversion rate limit exceeded")
+	ErrConversionPaused         = errorsmod.Register(ModuleName, 20, "token pair conversion is paused by its rate limit")
 )
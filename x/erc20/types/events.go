@@ -15,11 +15,25 @@ const (
 	EventTypeRegisterERC20          = "register_erc20"
 	EventTypeToggleTokenConversion  = "toggle_token_conversion" // #nosec
 	EventTypeRegisterERC20Extension = "register_erc20_extension"
+	EventTypeUpdateTokenMetadata    = "update_token_metadata"
+	EventTypeUpdateRateLimit        = "update_rate_limit"
+	EventTypePauseConversion        = "pause_conversion"
+	EventTypeResumeConversion       = "resume_conversion"
 
 	AttributeCoinSourceChannel = "source_channel"
 	AttributeKeyCosmosCoin     = "cosmos_coin"
 	AttributeKeyERC20Token     = "erc20_token" // #nosec
 	AttributeKeyReceiver       = "receiver"
+	AttributeKeySender         = "sender"
+	AttributeKeyFee            = "fee"
+	AttributeKeyName           = "name"
+	AttributeKeySymbol         = "symbol"
+	AttributeKeyDecimals       = "decimals"
+	AttributeKeyEpochID        = "epoch_identifier"
+	AttributeKeyMaxInflow      = "max_inflow"
+	AttributeKeyMaxOutflow     = "max_outflow"
+	AttributeKeyInflow         = "inflow"
+	AttributeKeyOutflow        = "outflow"
 )
 
 // LogTransfer Event type for Transfer(address from, address to, uint256 value)
@@ -3,6 +3,26 @@
 
 package types
 
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ownableABIJSON is the ABI of the owner getter of the Ownable contract standard.
+const ownableABIJSON = `[{"inputs":[],"name":"owner","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"}]`
+
+// OwnableABI is the ABI used to query the owner of an Ownable contract.
+var OwnableABI abi.ABI
+
+func init() {
+	var err error
+	if OwnableABI, err = abi.JSON(strings.NewReader(ownableABIJSON)); err != nil {
+		panic(err)
+	}
+}
+
 // ERC20Data represents the ERC20 token details used to map
 // the token to a Cosmos Coin
 type ERC20Data struct {
@@ -21,6 +41,11 @@ type ERC20Uint8Response struct {
 	Value uint8
 }
 
+// ERC20AddressResponse defines the address value from the call response
+type ERC20AddressResponse struct {
+	Value common.Address
+}
+
 // ERC20BoolResponse defines the bool value from the call response
 type ERC20BoolResponse struct {
 	Value bool
@@ -58,6 +58,22 @@ func (gs GenesisState) Validate() error {
 		seenDenom[b.Denom] = true
 	}
 
+	seenRateLimit := make(map[string]bool)
+	for _, rl := range gs.RateLimits {
+		if seenRateLimit[rl.Denom] {
+			return fmt.Errorf("rate limit duplicated on genesis: '%s'", rl.Denom)
+		}
+		if !seenDenom[rl.Denom] {
+			return fmt.Errorf("rate limit denomination '%s' not found in token pairs", rl.Denom)
+		}
+
+		if err := rl.Validate(); err != nil {
+			return fmt.Errorf("invalid rate limit on genesis: %w", err)
+		}
+
+		seenRateLimit[rl.Denom] = true
+	}
+
 	// Check if params are valid
 	if err := gs.Params.Validate(); err != nil {
 		return fmt.Errorf("invalid params on genesis: %w", err)
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -30,6 +32,8 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// token_pairs is a slice of the registered token pairs at genesis
 	TokenPairs []TokenPair `protobuf:"bytes,2,rep,name=token_pairs,json=tokenPairs,proto3" json:"token_pairs"`
+	// rate_limits is a slice of the conversion rate limits of the token pairs at genesis
+	RateLimits []RateLimit `protobuf:"bytes,3,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +83,13 @@ func (m *GenesisState) GetTokenPairs() []TokenPair {
 	return nil
 }
 
+func (m *GenesisState) GetRateLimits() []RateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
 // Params defines the erc20 module params
 type Params struct {
 	// enable_erc20 is the parameter to enable the conversion of Cosmos coins <--> ERC20 tokens.
@@ -89,6 +100,18 @@ type Params struct {
 	// dynamic_precompiles defines the slice of hex addresses of the
 	// active precompiles that are used to interact with Bank coins as ERC20s
 	DynamicPrecompiles []string `protobuf:"bytes,4,rep,name=dynamic_precompiles,json=dynamicPrecompiles,proto3" json:"dynamic_precompiles,omitempty"`
+	// enable_permissionless_registration is the parameter to allow ERC20 contract owners
+	// to register a token pair without a governance proposal.
+	EnablePermissionlessRegistration bool `protobuf:"varint,5,opt,name=enable_permissionless_registration,json=enablePermissionlessRegistration,proto3" json:"enable_permissionless_registration,omitempty"`
+	// registration_fee defines the fee paid by the contract owner on a permissionless
+	// token pair registration.
+	RegistrationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=registration_fee,json=registrationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"registration_fee"`
+	// burn_registration_fee defines if the registration fee is burned. Otherwise, the fee
+	// is sent to the community pool.
+	BurnRegistrationFee bool `protobuf:"varint,7,opt,name=burn_registration_fee,json=burnRegistrationFee,proto3" json:"burn_registration_fee,omitempty"`
+	// rate_limit_guardian is the bech32 address allowed to resume the conversions of a
+	// token pair paused by its rate limit, besides governance. Empty if unset.
+	RateLimitGuardian string `protobuf:"bytes,8,opt,name=rate_limit_guardian,json=rateLimitGuardian,proto3" json:"rate_limit_guardian,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -145,6 +168,34 @@ func (m *Params) GetDynamicPrecompiles() []string {
 	return nil
 }
 
+func (m *Params) GetEnablePermissionlessRegistration() bool {
+	if m != nil {
+		return m.EnablePermissionlessRegistration
+	}
+	return false
+}
+
+func (m *Params) GetRegistrationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RegistrationFee
+	}
+	return nil
+}
+
+func (m *Params) GetBurnRegistrationFee() bool {
+	if m != nil {
+		return m.BurnRegistrationFee
+	}
+	return false
+}
+
+func (m *Params) GetRateLimitGuardian() string {
+	if m != nil {
+		return m.RateLimitGuardian
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "evmos.erc20.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "evmos.erc20.v1.Params")
@@ -153,29 +204,40 @@ func init() {
 func init() { proto.RegisterFile("evmos/erc20/v1/genesis.proto", fileDescriptor_2f4674601b0d6987) }
 
 var fileDescriptor_2f4674601b0d6987 = []byte{
-	// 339 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xc1, 0x4e, 0xfa, 0x40,
-	0x10, 0xc6, 0xbb, 0x94, 0x10, 0xd8, 0x92, 0x7f, 0xfe, 0xac, 0xc6, 0x20, 0x31, 0x15, 0x39, 0x35,
-	0x26, 0x76, 0xa1, 0x9e, 0xbc, 0x92, 0x10, 0x13, 0x4f, 0x04, 0x3d, 0x79, 0x21, 0x4b, 0x9d, 0xd4,
-	0x8d, 0xb4, 0xdb, 0x74, 0xd7, 0x46, 0xde, 0x02, 0xdf, 0xc2, 0xa3, 0x8f, 0xc1, 0x91, 0xa3, 0x27,
-	0x63, 0xe0, 0xe0, 0x6b, 0x18, 0x76, 0x6b, 0x04, 0x2e, 0x93, 0xc9, 0xf7, 0xfb, 0xbe, 0x99, 0xcd,
-	0x2c, 0x3e, 0x81, 0x3c, 0x16, 0x92, 0x42, 0x16, 0x06, 0x5d, 0x9a, 0xf7, 0x68, 0x04, 0x09, 0x48,
-	0x2e, 0xfd, 0x34, 0x13, 0x4a, 0x90, 0x7f, 0x9a, 0xfa, 0x9a, 0xfa, 0x79, 0xaf, 0xd5, 0x60, 0x31,
-	0x4f, 0x04, 0xd5, 0xd5, 0x58, 0x5a, 0xad, 0xbd, 0x01, 0xc6, 0x6b, 0xd8, 0x61, 0x24, 0x22, 0xa1,
-	0x5b, 0xba, 0xe9, 0x8c, 0xda, 0x99, 0x23, 0x5c, 0xbf, 0x36, 0x6b, 0x6e, 0x15, 0x53, 0x40, 0xae,
-	0x70, 0x25, 0x65, 0x19, 0x8b, 0x65, 0x13, 0xb5, 0x91, 0xe7, 0x04, 0x47, 0xfe, 0xee, 0x5a, 0x7f,
-	0xa8, 0x69, 0xbf, 0xb6, 0xf8, 0x3c, 0xb5, 0xde, 0xbe, 0xdf, 0xcf, 0xd1, 0xa8, 0x08, 0x90, 0x01,
-	0x76, 0x94, 0x78, 0x82, 0x64, 0x9c, 0x32, 0x9e, 0xc9, 0x66, 0xa9, 0x6d, 0x7b, 0x4e, 0x70, 0xbc,
-	0x9f, 0xbf, 0xdb, 0x58, 0x86, 0x8c, 0x67, 0xdb, 0x23, 0xb0, 0xfa, 0x55, 0x65, 0xe7, 0x15, 0xe1,
-	0x8a, 0x59, 0x42, 0xce, 0x70, 0x1d, 0x12, 0x36, 0x99, 0xc2, 0x58, 0xc7, 0xf5, 0x93, 0xaa, 0x23,
-	0xc7, 0x68, 0x83, 0x8d, 0x44, 0x2e, 0x30, 0x49, 0x98, 0xe2, 0x39, 0x8c, 0xd3, 0x0c, 0x42, 0x11,
-	0xa7, 0x7c, 0x0a, 0xb2, 0x69, 0xb7, 0x6d, 0xaf, 0x36, 0x6a, 0x18, 0x32, 0xfc, 0x03, 0x84, 0xe2,
-	0x83, 0x87, 0x59, 0xc2, 0x62, 0x1e, 0xee, 0xf8, 0xcb, 0xda, 0x4f, 0x0a, 0xb4, 0x15, 0xb8, 0x29,
-	0x57, 0x4b, 0xff, 0xed, 0x7e, 0x7f, 0xb1, 0x72, 0xd1, 0x72, 0xe5, 0xa2, 0xaf, 0x95, 0x8b, 0xe6,
-	0x6b, 0xd7, 0x5a, 0xae, 0x5d, 0xeb, 0x63, 0xed, 0x5a, 0xf7, 0x5e, 0xc4, 0xd5, 0xe3, 0xf3, 0xc4,
-	0x0f, 0x45, 0x4c, 0x8b, 0xeb, 0xeb, 0x9a, 0x07, 0x5d, 0xfa, 0x52, 0xfc, 0x84, 0x9a, 0xa5, 0x20,
-	0x27, 0x15, 0x7d, 0xf1, 0xcb, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x34, 0x6f, 0x0a, 0xc1, 0xe6,
-	0x01, 0x00, 0x00,
+	// 520 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0x4f, 0x6f, 0xd3, 0x30,
+	0x14, 0x6f, 0xd6, 0x51, 0x3a, 0x77, 0x82, 0x2d, 0x05, 0x14, 0x2a, 0x94, 0x95, 0x9e, 0x2a, 0xa4,
+	0xc6, 0xb4, 0x88, 0x03, 0x47, 0x3a, 0x95, 0x4a, 0x68, 0x42, 0x55, 0xe0, 0xc4, 0x25, 0x72, 0xd2,
+	0x47, 0xb0, 0x9a, 0xd8, 0x91, 0xed, 0x06, 0x06, 0x5f, 0x82, 0x8f, 0x81, 0x38, 0xf1, 0x11, 0x38,
+	0xee, 0xb8, 0x1b, 0x9c, 0x00, 0xb5, 0x07, 0xbe, 0x06, 0x8a, 0x9d, 0xb1, 0xb4, 0xec, 0xd2, 0x5a,
+	0xef, 0xf7, 0xc7, 0xbf, 0xf7, 0xfc, 0x82, 0xee, 0x41, 0x9e, 0x72, 0x89, 0x41, 0x44, 0xa3, 0x87,
+	0x38, 0x1f, 0xe2, 0x18, 0x18, 0x48, 0x2a, 0xbd, 0x4c, 0x70, 0xc5, 0xed, 0x1b, 0x1a, 0xf5, 0x34,
+	0xea, 0xe5, 0xc3, 0xce, 0x21, 0x49, 0x29, 0xe3, 0x58, 0xff, 0x1a, 0x4a, 0xc7, 0x8d, 0xb8, 0x2c,
+	0x1c, 0x42, 0x22, 0x01, 0xe7, 0xc3, 0x10, 0x14, 0x19, 0xe2, 0x88, 0x53, 0x56, 0xe2, 0x9d, 0xad,
+	0x0b, 0x8c, 0x97, 0xc1, 0x6e, 0xc5, 0x3c, 0xe6, 0xfa, 0x88, 0x8b, 0x93, 0xa9, 0xf6, 0xbe, 0x5b,
+	0x68, 0x7f, 0x6a, 0x62, 0xbc, 0x54, 0x44, 0x81, 0xfd, 0x04, 0x35, 0x32, 0x22, 0x48, 0x2a, 0x1d,
+	0xab, 0x6b, 0xf5, 0x5b, 0xa3, 0x3b, 0xde, 0x66, 0x2c, 0x6f, 0xa6, 0xd1, 0xf1, 0xde, 0xd9, 0xcf,
+	0xa3, 0xda, 0xe7, 0x3f, 0x5f, 0x1f, 0x58, 0x7e, 0x29, 0xb0, 0x27, 0xa8, 0xa5, 0xf8, 0x02, 0x58,
+	0x90, 0x11, 0x2a, 0xa4, 0xb3, 0xd3, 0xad, 0xf7, 0x5b, 0xa3, 0xbb, 0xdb, 0xfa, 0x57, 0x05, 0x65,
+	0x46, 0xa8, 0xa8, 0x5a, 0x20, 0x75, 0x51, 0xd5, 0x36, 0x82, 0x28, 0x08, 0x12, 0x9a, 0x52, 0x25,
+	0x9d, 0xfa, 0xd5, 0x36, 0x3e, 0x51, 0x70, 0x52, 0x30, 0x36, 0x6c, 0xc4, 0x45, 0x55, 0xf6, 0xbe,
+	0xd5, 0x51, 0xc3, 0x64, 0xb5, 0xef, 0xa3, 0x7d, 0x60, 0x24, 0x4c, 0x20, 0xd0, 0x72, 0xdd, 0x59,
+	0xd3, 0x6f, 0x99, 0xda, 0xa4, 0x28, 0xd9, 0x03, 0x64, 0x33, 0xa2, 0x68, 0x0e, 0x41, 0x26, 0x20,
+	0xe2, 0x69, 0x46, 0x13, 0x30, 0x77, 0xef, 0xf9, 0x87, 0x06, 0x99, 0x5d, 0x02, 0x36, 0x46, 0xed,
+	0xf9, 0x29, 0x23, 0x29, 0x8d, 0x36, 0xf8, 0xbb, 0x9a, 0x6f, 0x97, 0x50, 0x55, 0x70, 0x82, 0x7a,
+	0x65, 0x84, 0x0c, 0x44, 0x4a, 0xa5, 0xa4, 0x9c, 0x25, 0x20, 0x65, 0x20, 0x20, 0xa6, 0x52, 0x09,
+	0xa2, 0x28, 0x67, 0xce, 0x35, 0x1d, 0xac, 0x6b, 0x98, 0xb3, 0x0d, 0xa2, 0x5f, 0xe1, 0xd9, 0x1f,
+	0xd1, 0x41, 0x55, 0x17, 0xbc, 0x01, 0x70, 0x1a, 0xe5, 0x9c, 0xcc, 0x8a, 0x78, 0xc5, 0x8a, 0x78,
+	0xe5, 0x8a, 0x78, 0xc7, 0x9c, 0xb2, 0xf1, 0xe3, 0x62, 0x4e, 0x5f, 0x7e, 0x1d, 0xf5, 0x63, 0xaa,
+	0xde, 0x2e, 0x43, 0x2f, 0xe2, 0x29, 0x2e, 0xf7, 0xc9, 0xfc, 0x0d, 0xe4, 0x7c, 0x81, 0xd5, 0x69,
+	0x06, 0x52, 0x0b, 0xa4, 0x99, 0xe9, 0xcd, 0xea, 0x4d, 0xcf, 0x00, 0xec, 0x11, 0xba, 0x1d, 0x2e,
+	0x05, 0x0b, 0xfe, 0x4b, 0x70, 0x5d, 0xa7, 0x6f, 0x17, 0xa0, 0xbf, 0xa5, 0xf1, 0x50, 0xfb, 0xf2,
+	0x4d, 0x83, 0x78, 0x49, 0xc4, 0x9c, 0x12, 0xe6, 0x34, 0xbb, 0x56, 0x31, 0xdf, 0x7f, 0xaf, 0x36,
+	0x2d, 0x81, 0xe7, 0xbb, 0xcd, 0x9d, 0x83, 0xfa, 0x78, 0x7a, 0xb6, 0x72, 0xad, 0xf3, 0x95, 0x6b,
+	0xfd, 0x5e, 0xb9, 0xd6, 0xa7, 0xb5, 0x5b, 0x3b, 0x5f, 0xbb, 0xb5, 0x1f, 0x6b, 0xb7, 0xf6, 0x7a,
+	0x50, 0xe9, 0xe1, 0x29, 0xfd, 0x00, 0xc9, 0x0b, 0x50, 0xef, 0xb8, 0x58, 0xe0, 0x63, 0x2e, 0xd3,
+	0x49, 0x9e, 0xe2, 0xf7, 0xe5, 0x47, 0xa0, 0xdb, 0x09, 0x1b, 0x7a, 0xd9, 0x1f, 0xfd, 0x1d, 0x00,
+	0xec, 0x17, 0x7f, 0x39, 0x81, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -198,6 +260,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.TokenPairs) > 0 {
 		for iNdEx := len(m.TokenPairs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -245,6 +321,47 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RateLimitGuardian) > 0 {
+		i -= len(m.RateLimitGuardian)
+		copy(dAtA[i:], m.RateLimitGuardian)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.RateLimitGuardian)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.BurnRegistrationFee {
+		i--
+		if m.BurnRegistrationFee {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.RegistrationFee) > 0 {
+		for iNdEx := len(m.RegistrationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RegistrationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.EnablePermissionlessRegistration {
+		i--
+		if m.EnablePermissionlessRegistration {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.DynamicPrecompiles) > 0 {
 		for iNdEx := len(m.DynamicPrecompiles) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.DynamicPrecompiles[iNdEx])
@@ -301,6 +418,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -325,6 +448,22 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.EnablePermissionlessRegistration {
+		n += 2
+	}
+	if len(m.RegistrationFee) > 0 {
+		for _, e := range m.RegistrationFee {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.BurnRegistrationFee {
+		n += 2
+	}
+	l = len(m.RateLimitGuardian)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -430,6 +569,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -564,6 +737,112 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.DynamicPrecompiles = append(m.DynamicPrecompiles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnablePermissionlessRegistration", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnablePermissionlessRegistration = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegistrationFee = append(m.RegistrationFee, types.Coin{})
+			if err := m.RegistrationFee[len(m.RegistrationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRegistrationFee", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnRegistrationFee = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitGuardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimitGuardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
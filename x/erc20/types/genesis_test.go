@@ -3,7 +3,9 @@ package types_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	aizeltypes "github.com/AizelNetwork/CosmEvm/types"
+	epochstypes "github.com/AizelNetwork/CosmEvm/x/epochs/types"
 	"github.com/AizelNetwork/CosmEvm/x/erc20/types"
 	"github.com/stretchr/testify/suite"
 )
@@ -169,6 +171,51 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid genesis - with rate limit",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: types.DefaultTokenPairs,
+				RateLimits: []types.RateLimit{
+					types.NewRateLimit(aizeltypes.BaseDenom, epochstypes.DayEpochID, math.NewInt(100), math.ZeroInt()),
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated rate limit",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: types.DefaultTokenPairs,
+				RateLimits: []types.RateLimit{
+					types.NewRateLimit(aizeltypes.BaseDenom, epochstypes.DayEpochID, math.NewInt(100), math.ZeroInt()),
+					types.NewRateLimit(aizeltypes.BaseDenom, epochstypes.WeekEpochID, math.NewInt(100), math.ZeroInt()),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - rate limit without token pair",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: types.DefaultTokenPairs,
+				RateLimits: []types.RateLimit{
+					types.NewRateLimit("usdt", epochstypes.DayEpochID, math.NewInt(100), math.ZeroInt()),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid rate limit epoch identifier",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: types.DefaultTokenPairs,
+				RateLimits: []types.RateLimit{
+					types.NewRateLimit(aizeltypes.BaseDenom, "", math.NewInt(100), math.ZeroInt()),
+				},
+			},
+			expPass: false,
+		},
 		{
 			// Voting period cant be zero
 			name:     "empty genesis",
@@ -33,6 +33,7 @@ const (
 	prefixTokenPairByERC20
 	prefixTokenPairByDenom
 	prefixSTRv2Addresses
+	prefixRateLimit
 )
 
 // KVStore key prefixes
@@ -41,4 +42,5 @@ var (
 	KeyPrefixTokenPairByERC20 = []byte{prefixTokenPairByERC20}
 	KeyPrefixTokenPairByDenom = []byte{prefixTokenPairByDenom}
 	KeyPrefixSTRv2Addresses   = []byte{prefixSTRv2Addresses}
+	KeyPrefixRateLimit        = []byte{prefixRateLimit}
 )
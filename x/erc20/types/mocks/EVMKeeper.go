@@ -119,6 +119,39 @@ func (_m *EVMKeeper) CallEVMWithData(ctx types.Context, from common.Address, con
 	return r0, r1
 }
 
+// CallEVMWithGasLimit provides a mock function with given fields: ctx, _a1, from, contract, gasLimit, method, args
+func (_m *EVMKeeper) CallEVMWithGasLimit(ctx types.Context, _a1 abi.ABI, from common.Address, contract common.Address, gasLimit uint64, method string, args ...interface{}) (*evmtypes.MsgEthereumTxResponse, error) {
+	var _ca []interface{}
+	_ca = append(_ca, ctx, _a1, from, contract, gasLimit, method)
+	_ca = append(_ca, args...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CallEVMWithGasLimit")
+	}
+
+	var r0 *evmtypes.MsgEthereumTxResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(types.Context, abi.ABI, common.Address, common.Address, uint64, string, ...interface{}) (*evmtypes.MsgEthereumTxResponse, error)); ok {
+		return rf(ctx, _a1, from, contract, gasLimit, method, args...)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, abi.ABI, common.Address, common.Address, uint64, string, ...interface{}) *evmtypes.MsgEthereumTxResponse); ok {
+		r0 = rf(ctx, _a1, from, contract, gasLimit, method, args...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*evmtypes.MsgEthereumTxResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, abi.ABI, common.Address, common.Address, uint64, string, ...interface{}) error); ok {
+		r1 = rf(ctx, _a1, from, contract, gasLimit, method, args...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteAccount provides a mock function with given fields: ctx, addr
 func (_m *EVMKeeper) DeleteAccount(ctx types.Context, addr common.Address) error {
 	ret := _m.Called(ctx, addr)
@@ -4,6 +4,8 @@
 package types
 
 import (
+	"strings"
+
 	protov2 "google.golang.org/protobuf/proto"
 
 	errorsmod "cosmossdk.io/errors"
@@ -13,6 +15,7 @@ import (
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 
 	erc20api "github.com/AizelNetwork/CosmEvm/api/aizel/erc20/v1"
+	epochstypes "github.com/AizelNetwork/CosmEvm/x/epochs/types"
 
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -22,10 +25,20 @@ var (
 	_ sdk.Msg              = &MsgUpdateParams{}
 	_ sdk.Msg              = &MsgRegisterERC20{}
 	_ sdk.Msg              = &MsgToggleConversion{}
+	_ sdk.Msg              = &MsgRegisterERC20ByOwner{}
+	_ sdk.Msg              = &MsgSyncTokenPairMetadata{}
+	_ sdk.Msg              = &MsgUpdateDenomMetadata{}
+	_ sdk.Msg              = &MsgUpdateRateLimit{}
+	_ sdk.Msg              = &MsgResumeConversion{}
 	_ sdk.HasValidateBasic = &MsgConvertERC20{}
 	_ sdk.HasValidateBasic = &MsgUpdateParams{}
 	_ sdk.HasValidateBasic = &MsgRegisterERC20{}
 	_ sdk.HasValidateBasic = &MsgToggleConversion{}
+	_ sdk.HasValidateBasic = &MsgRegisterERC20ByOwner{}
+	_ sdk.HasValidateBasic = &MsgSyncTokenPairMetadata{}
+	_ sdk.HasValidateBasic = &MsgUpdateDenomMetadata{}
+	_ sdk.HasValidateBasic = &MsgUpdateRateLimit{}
+	_ sdk.HasValidateBasic = &MsgResumeConversion{}
 )
 
 const (
@@ -108,3 +121,102 @@ func (m *MsgToggleConversion) ValidateBasic() error {
 
 	return nil
 }
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRegisterERC20ByOwner) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if !common.IsHexAddress(m.ContractAddress) {
+		return errortypes.ErrInvalidAddress.Wrapf("invalid ERC20 contract address: %s", m.ContractAddress)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRegisterERC20ByOwner) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgSyncTokenPairMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if strings.TrimSpace(m.Token) == "" {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "token identifier cannot be empty")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSyncTokenPairMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateDenomMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	if err := m.Metadata.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid denom metadata")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateDenomMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateRateLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	if strings.TrimSpace(m.Token) == "" {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "token identifier cannot be empty")
+	}
+
+	if err := validateQuota(m.MaxInflow, m.MaxOutflow); err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+
+	// the epoch identifier is not required when removing the rate limit
+	if m.MaxInflow.IsZero() && m.MaxOutflow.IsZero() {
+		return nil
+	}
+
+	return epochstypes.ValidateEpochIdentifierString(m.EpochIdentifier)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateRateLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgResumeConversion) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if strings.TrimSpace(m.Token) == "" {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "token identifier cannot be empty")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgResumeConversion) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/AizelNetwork/CosmEvm/types"
 	"github.com/AizelNetwork/CosmEvm/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -41,9 +42,13 @@ func GetWEVMOSContractHex(chainID string) string {
 
 // Parameter store key
 var (
-	ParamStoreKeyEnableErc20        = []byte("EnableErc20")
-	ParamStoreKeyDynamicPrecompiles = []byte("DynamicPrecompiles")
-	ParamStoreKeyNativePrecompiles  = []byte("NativePrecompiles")
+	ParamStoreKeyEnableErc20                      = []byte("EnableErc20")
+	ParamStoreKeyDynamicPrecompiles               = []byte("DynamicPrecompiles")
+	ParamStoreKeyNativePrecompiles                = []byte("NativePrecompiles")
+	ParamStoreKeyEnablePermissionlessRegistration = []byte("EnablePermissionlessRegistration")
+	ParamStoreKeyRegistrationFee                  = []byte("RegistrationFee")
+	ParamStoreKeyBurnRegistrationFee              = []byte("BurnRegistrationFee")
+	ParamStoreKeyRateLimitGuardian                = []byte("RateLimitGuardian")
 	// DefaultNativePrecompiles defines the default precompiles for the wrapped native coin
 	// NOTE: If you modify this, make sure you modify it on the local_node genesis script as well
 	DefaultNativePrecompiles = []string{WEVMOSContractMainnet}
@@ -88,6 +93,24 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := ValidateBool(p.EnablePermissionlessRegistration); err != nil {
+		return err
+	}
+
+	if err := p.RegistrationFee.Validate(); err != nil {
+		return fmt.Errorf("invalid registration fee: %w", err)
+	}
+
+	if err := ValidateBool(p.BurnRegistrationFee); err != nil {
+		return err
+	}
+
+	if p.RateLimitGuardian != "" {
+		if _, err := sdk.AccAddressFromBech32(p.RateLimitGuardian); err != nil {
+			return fmt.Errorf("invalid rate limit guardian address: %w", err)
+		}
+	}
+
 	npAddrs, err := ValidatePrecompiles(p.NativePrecompiles)
 	if err != nil {
 		return err
@@ -150,6 +173,12 @@ func (p Params) IsNativePrecompile(addr common.Address) bool {
 	return isAddrIncluded(addr, p.NativePrecompiles)
 }
 
+// IsPermissionlessRegistrationEnabled checks if ERC20 contract owners can register
+// token pairs without a governance proposal.
+func (p Params) IsPermissionlessRegistrationEnabled() bool {
+	return p.EnableErc20 && p.EnablePermissionlessRegistration
+}
+
 // IsDynamicPrecompile checks if the provided address is within the dynamic precompiles
 func (p Params) IsDynamicPrecompile(addr common.Address) bool {
 	return isAddrIncluded(addr, p.DynamicPrecompiles)
@@ -4,7 +4,10 @@ import (
 	"slices"
 	"testing"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	utiltx "github.com/AizelNetwork/CosmEvm/testutil/tx"
 	"github.com/AizelNetwork/CosmEvm/x/erc20/types"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -73,6 +76,47 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			false,
 			"",
 		},
+		{
+			"valid registration fee",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.EnablePermissionlessRegistration = true
+				params.RegistrationFee = sdk.NewCoins(sdk.NewInt64Coin("aaizel", 100))
+				return params
+			},
+			false,
+			"",
+		},
+		{
+			"invalid registration fee",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.RegistrationFee = sdk.Coins{{Denom: "aaizel", Amount: math.NewInt(-1)}}
+				return params
+			},
+			true,
+			"invalid registration fee",
+		},
+		{
+			"valid rate limit guardian",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.RateLimitGuardian = sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String()
+				return params
+			},
+			false,
+			"",
+		},
+		{
+			"invalid rate limit guardian",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.RateLimitGuardian = "guardian"
+				return params
+			},
+			true,
+			"invalid rate limit guardian address",
+		},
 		{
 			"invalid address - native precompile",
 			func() types.Params {
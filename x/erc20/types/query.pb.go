@@ -228,6 +228,203 @@ func (m *QueryTokenPairResponse) GetTokenPair() TokenPair {
 	return TokenPair{}
 }
 
+// QueryRateLimitsRequest is the request type for the Query/RateLimits RPC
+// method.
+type QueryRateLimitsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRateLimitsRequest) Reset()         { *m = QueryRateLimitsRequest{} }
+func (m *QueryRateLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitsRequest) ProtoMessage()    {}
+func (*QueryRateLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fba814bce17cabdf, []int{4}
+}
+func (m *QueryRateLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRateLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitsRequest.Merge(m, src)
+}
+func (m *QueryRateLimitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRateLimitsRequest proto.InternalMessageInfo
+
+func (m *QueryRateLimitsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryRateLimitsResponse is the response type for the Query/RateLimits RPC
+// method.
+type QueryRateLimitsResponse struct {
+	// rate_limits is a slice of the conversion rate limits of the token pairs
+	RateLimits []RateLimit `protobuf:"bytes,1,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRateLimitsResponse) Reset()         { *m = QueryRateLimitsResponse{} }
+func (m *QueryRateLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitsResponse) ProtoMessage()    {}
+func (*QueryRateLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fba814bce17cabdf, []int{5}
+}
+func (m *QueryRateLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRateLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitsResponse.Merge(m, src)
+}
+func (m *QueryRateLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRateLimitsResponse proto.InternalMessageInfo
+
+func (m *QueryRateLimitsResponse) GetRateLimits() []RateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
+func (m *QueryRateLimitsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryRateLimitRequest is the request type for the Query/RateLimit RPC method.
+type QueryRateLimitRequest struct {
+	// token identifier can be either the hex contract address of the ERC20 or the
+	// Cosmos base denomination
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (m *QueryRateLimitRequest) Reset()         { *m = QueryRateLimitRequest{} }
+func (m *QueryRateLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitRequest) ProtoMessage()    {}
+func (*QueryRateLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fba814bce17cabdf, []int{6}
+}
+func (m *QueryRateLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRateLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitRequest.Merge(m, src)
+}
+func (m *QueryRateLimitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRateLimitRequest proto.InternalMessageInfo
+
+func (m *QueryRateLimitRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+// QueryRateLimitResponse is the response type for the Query/RateLimit RPC
+// method.
+type QueryRateLimitResponse struct {
+	// rate_limit is the conversion rate limit of the token pair
+	RateLimit RateLimit `protobuf:"bytes,1,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit"`
+}
+
+func (m *QueryRateLimitResponse) Reset()         { *m = QueryRateLimitResponse{} }
+func (m *QueryRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitResponse) ProtoMessage()    {}
+func (*QueryRateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fba814bce17cabdf, []int{7}
+}
+func (m *QueryRateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitResponse.Merge(m, src)
+}
+func (m *QueryRateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRateLimitResponse proto.InternalMessageInfo
+
+func (m *QueryRateLimitResponse) GetRateLimit() RateLimit {
+	if m != nil {
+		return m.RateLimit
+	}
+	return RateLimit{}
+}
+
 // QueryParamsRequest is the request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -236,7 +433,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fba814bce17cabdf, []int{4}
+	return fileDescriptor_fba814bce17cabdf, []int{8}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -276,7 +473,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fba814bce17cabdf, []int{5}
+	return fileDescriptor_fba814bce17cabdf, []int{9}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -317,6 +514,10 @@ func init() {
 	proto.RegisterType((*QueryTokenPairsResponse)(nil), "evmos.erc20.v1.QueryTokenPairsResponse")
 	proto.RegisterType((*QueryTokenPairRequest)(nil), "evmos.erc20.v1.QueryTokenPairRequest")
 	proto.RegisterType((*QueryTokenPairResponse)(nil), "evmos.erc20.v1.QueryTokenPairResponse")
+	proto.RegisterType((*QueryRateLimitsRequest)(nil), "evmos.erc20.v1.QueryRateLimitsRequest")
+	proto.RegisterType((*QueryRateLimitsResponse)(nil), "evmos.erc20.v1.QueryRateLimitsResponse")
+	proto.RegisterType((*QueryRateLimitRequest)(nil), "evmos.erc20.v1.QueryRateLimitRequest")
+	proto.RegisterType((*QueryRateLimitResponse)(nil), "evmos.erc20.v1.QueryRateLimitResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "evmos.erc20.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "evmos.erc20.v1.QueryParamsResponse")
 }
@@ -324,40 +525,47 @@ func init() {
 func init() { proto.RegisterFile("evmos/erc20/v1/query.proto", fileDescriptor_fba814bce17cabdf) }
 
 var fileDescriptor_fba814bce17cabdf = []byte{
-	// 518 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4f, 0x8b, 0xd3, 0x40,
-	0x14, 0xef, 0xec, 0xb2, 0x85, 0xbe, 0x82, 0xe0, 0x58, 0x6b, 0xad, 0x1a, 0x97, 0x94, 0xed, 0x96,
-	0x15, 0x67, 0xb6, 0xf1, 0xe4, 0xb5, 0xa2, 0x5e, 0x6b, 0xf1, 0x24, 0x88, 0x4e, 0xcb, 0x10, 0x83,
-	0x36, 0x93, 0x66, 0xa6, 0xc1, 0x45, 0xbc, 0xec, 0x45, 0xbc, 0x09, 0x7e, 0x09, 0xbd, 0xf9, 0x31,
-	0xf6, 0xb8, 0xe0, 0xc5, 0x93, 0x48, 0x2b, 0xf8, 0x35, 0x24, 0x33, 0x93, 0xb4, 0x89, 0x4b, 0xeb,
-	0x25, 0xcc, 0xbc, 0xf7, 0x7e, 0x7f, 0xde, 0x7b, 0x13, 0x68, 0xf3, 0x64, 0x2a, 0x24, 0xe5, 0xf1,
-	0xc4, 0x3b, 0xa6, 0x49, 0x9f, 0xce, 0xe6, 0x3c, 0x3e, 0x21, 0x51, 0x2c, 0x94, 0xc0, 0x97, 0x74,
-	0x8e, 0xe8, 0x1c, 0x49, 0xfa, 0xed, 0xcb, 0x6c, 0x1a, 0x84, 0x82, 0xea, 0xaf, 0x29, 0x69, 0x1f,
-	0x4d, 0x84, 0x4c, 0xf1, 0x63, 0x26, 0xb9, 0xc1, 0xd2, 0xa4, 0x3f, 0xe6, 0x8a, 0xf5, 0x69, 0xc4,
-	0xfc, 0x20, 0x64, 0x2a, 0x10, 0xa1, 0xad, 0x2d, 0x4b, 0x19, 0x5e, 0x93, 0xbb, 0x59, 0xca, 0xf9,
-	0x3c, 0xe4, 0x32, 0x90, 0x36, 0xdb, 0xf0, 0x85, 0x2f, 0xf4, 0x91, 0xa6, 0xa7, 0x0c, 0xe3, 0x0b,
-	0xe1, 0xbf, 0xe1, 0x94, 0x45, 0x01, 0x65, 0x61, 0x28, 0x94, 0x16, 0xb3, 0x18, 0xf7, 0x25, 0x34,
-	0x9f, 0xa4, 0x7e, 0x9e, 0x8a, 0xd7, 0x3c, 0x1c, 0xb2, 0x20, 0x96, 0x23, 0x3e, 0x9b, 0x73, 0xa9,
-	0xf0, 0x23, 0x80, 0x95, 0xb7, 0x16, 0xda, 0x47, 0xbd, 0xba, 0xd7, 0x25, 0xa6, 0x11, 0x92, 0x36,
-	0x42, 0xcc, 0x10, 0x6c, 0x23, 0x64, 0xc8, 0x7c, 0x6e, 0xb1, 0xa3, 0x35, 0xa4, 0xfb, 0x15, 0xc1,
-	0xb5, 0x7f, 0x24, 0x64, 0x24, 0x42, 0xc9, 0xf1, 0x43, 0xa8, 0xab, 0x34, 0xfa, 0x22, 0x4a, 0xc3,
-	0x2d, 0xb4, 0xbf, 0xdb, 0xab, 0x7b, 0xd7, 0x49, 0x71, 0xa0, 0x24, 0x07, 0x0e, 0x6a, 0x67, 0x3f,
-	0x6f, 0x57, 0xbe, 0xfc, 0xf9, 0x76, 0x84, 0x46, 0xa0, 0x72, 0x3a, 0xfc, 0xb8, 0x60, 0x75, 0x47,
-	0x5b, 0x3d, 0xdc, 0x6a, 0xd5, 0x78, 0x28, 0x78, 0xbd, 0x0b, 0x57, 0x8b, 0x56, 0xb3, 0x61, 0x34,
-	0x60, 0x4f, 0xeb, 0xe9, 0x39, 0xd4, 0x46, 0xe6, 0xe2, 0x3e, 0x2f, 0x0f, 0x2f, 0x6f, 0xec, 0x01,
-	0xc0, 0xaa, 0x31, 0x3b, 0xbc, 0xff, 0xeb, 0xab, 0x96, 0xf7, 0xe5, 0x36, 0x00, 0x6b, 0xfa, 0x21,
-	0x8b, 0xd9, 0x34, 0xdb, 0x8b, 0x3b, 0x84, 0x2b, 0x85, 0xa8, 0x55, 0xbc, 0x0f, 0xd5, 0x48, 0x47,
-	0xac, 0x5a, 0xb3, 0xac, 0x66, 0xea, 0xd7, 0xa5, 0x2c, 0xc0, 0xfb, 0xb8, 0x0b, 0x7b, 0x9a, 0x12,
-	0x9f, 0x22, 0x80, 0xd5, 0x9a, 0x70, 0xb7, 0xcc, 0x71, 0xf1, 0x53, 0x69, 0x1f, 0x6e, 0xad, 0x33,
-	0x26, 0xdd, 0xce, 0xe9, 0xf7, 0xdf, 0x9f, 0x77, 0x6e, 0xe1, 0x1b, 0xb4, 0xf4, 0x90, 0xd7, 0x5e,
-	0x01, 0xfe, 0x80, 0xa0, 0x96, 0x63, 0xf1, 0xc1, 0x66, 0xee, 0xcc, 0x42, 0x77, 0x5b, 0x99, 0x75,
-	0x70, 0x47, 0x3b, 0x38, 0xc0, 0x9d, 0x0d, 0x0e, 0xe8, 0x3b, 0x7d, 0x79, 0x8f, 0x67, 0x50, 0x35,
-	0x53, 0xc3, 0xee, 0x85, 0xf4, 0x85, 0xc5, 0xb4, 0x3b, 0x1b, 0x6b, 0xac, 0xbe, 0xa3, 0xf5, 0x5b,
-	0xb8, 0x59, 0xd6, 0x37, 0xbb, 0x18, 0x0c, 0xce, 0x16, 0x0e, 0x3a, 0x5f, 0x38, 0xe8, 0xd7, 0xc2,
-	0x41, 0x9f, 0x96, 0x4e, 0xe5, 0x7c, 0xe9, 0x54, 0x7e, 0x2c, 0x9d, 0xca, 0xb3, 0x9e, 0x1f, 0xa8,
-	0x57, 0xf3, 0x31, 0x99, 0x88, 0x69, 0x86, 0xd5, 0xdf, 0xc4, 0x3b, 0xa6, 0x6f, 0x2d, 0x8f, 0x3a,
-	0x89, 0xb8, 0x1c, 0x57, 0xf5, 0xaf, 0x7d, 0xef, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x55, 0x70,
-	0x8a, 0x8d, 0xb5, 0x04, 0x00, 0x00,
+	// 625 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4f, 0x6f, 0xd3, 0x30,
+	0x1c, 0x6d, 0x86, 0x36, 0xa9, 0x9e, 0x84, 0x84, 0x29, 0xa5, 0x04, 0x08, 0x53, 0xaa, 0xb5, 0xd3,
+	0xd0, 0x62, 0x5a, 0x4e, 0x1c, 0xd9, 0x34, 0x76, 0x41, 0xa8, 0x54, 0x9c, 0x90, 0xd0, 0x70, 0x2b,
+	0x2b, 0x44, 0x6b, 0xe2, 0x34, 0x76, 0x0b, 0x03, 0x71, 0xd9, 0x85, 0x2b, 0x12, 0x17, 0x3e, 0x02,
+	0xdc, 0xf8, 0x18, 0x3b, 0x4e, 0xe2, 0xc2, 0x09, 0xa1, 0x16, 0x89, 0xaf, 0x81, 0x62, 0xbb, 0xce,
+	0x9f, 0xb5, 0xcd, 0x0e, 0x70, 0xa9, 0x12, 0xfb, 0xf7, 0x7e, 0xef, 0xfd, 0xde, 0xb3, 0x1b, 0x60,
+	0x92, 0xb1, 0x4f, 0x19, 0x22, 0x51, 0xbf, 0x7d, 0x0f, 0x8d, 0x5b, 0x68, 0x38, 0x22, 0xd1, 0xb1,
+	0x13, 0x46, 0x94, 0x53, 0x78, 0x59, 0xec, 0x39, 0x62, 0xcf, 0x19, 0xb7, 0xcc, 0x2b, 0xd8, 0xf7,
+	0x02, 0x8a, 0xc4, 0xaf, 0x2c, 0x31, 0xb7, 0xfb, 0x94, 0xc5, 0xf8, 0x1e, 0x66, 0x44, 0x62, 0xd1,
+	0xb8, 0xd5, 0x23, 0x1c, 0xb7, 0x50, 0x88, 0x5d, 0x2f, 0xc0, 0xdc, 0xa3, 0x81, 0xaa, 0xcd, 0x53,
+	0xc9, 0xbe, 0x72, 0xef, 0x56, 0x6e, 0xcf, 0x25, 0x01, 0x61, 0x1e, 0x53, 0xbb, 0x15, 0x97, 0xba,
+	0x54, 0x3c, 0xa2, 0xf8, 0x69, 0x86, 0x71, 0x29, 0x75, 0x07, 0x04, 0xe1, 0xd0, 0x43, 0x38, 0x08,
+	0x28, 0x17, 0x64, 0x0a, 0x63, 0xbf, 0x04, 0xd5, 0xa7, 0xb1, 0x9e, 0x67, 0xf4, 0x88, 0x04, 0x1d,
+	0xec, 0x45, 0xac, 0x4b, 0x86, 0x23, 0xc2, 0x38, 0x7c, 0x04, 0x40, 0xa2, 0xad, 0x66, 0x6c, 0x18,
+	0x5b, 0xeb, 0xed, 0x86, 0x23, 0x07, 0x71, 0xe2, 0x41, 0x1c, 0x69, 0x82, 0x1a, 0xc4, 0xe9, 0x60,
+	0x97, 0x28, 0x6c, 0x37, 0x85, 0xb4, 0xbf, 0x1a, 0xe0, 0xfa, 0x39, 0x0a, 0x16, 0xd2, 0x80, 0x11,
+	0xb8, 0x0f, 0xd6, 0x79, 0xbc, 0x7a, 0x18, 0xc6, 0xcb, 0x35, 0x63, 0xe3, 0xd2, 0xd6, 0x7a, 0xfb,
+	0x86, 0x93, 0x35, 0xd4, 0xd1, 0xc0, 0xdd, 0xf2, 0xe9, 0xcf, 0x3b, 0xa5, 0x2f, 0x7f, 0xbe, 0x6d,
+	0x1b, 0x5d, 0xc0, 0x75, 0x3b, 0x78, 0x90, 0x91, 0xba, 0x22, 0xa4, 0x36, 0x0b, 0xa5, 0x4a, 0x0d,
+	0x19, 0xad, 0x3b, 0xe0, 0x5a, 0x56, 0xea, 0xcc, 0x8c, 0x0a, 0x58, 0x15, 0x7c, 0xc2, 0x87, 0x72,
+	0x57, 0xbe, 0xd8, 0x2f, 0xf2, 0xe6, 0xe9, 0xc1, 0xf6, 0x00, 0x48, 0x06, 0x53, 0xe6, 0x5d, 0x6c,
+	0xae, 0xb2, 0x9e, 0x4b, 0x67, 0xd3, 0xc5, 0x9c, 0x3c, 0xf6, 0x7c, 0x8f, 0xff, 0xbf, 0x6c, 0xd2,
+	0x14, 0x49, 0x36, 0x11, 0xe6, 0xe4, 0x70, 0x20, 0x96, 0x17, 0x65, 0xa3, 0x81, 0x99, 0x6c, 0x22,
+	0xdd, 0xee, 0xdf, 0x67, 0xa3, 0x19, 0x2f, 0x96, 0x4d, 0xaa, 0x3c, 0xc9, 0x26, 0x19, 0x6c, 0x51,
+	0x36, 0x73, 0xe7, 0x2a, 0xeb, 0xb9, 0xec, 0x0a, 0x80, 0xa2, 0x7d, 0x07, 0x47, 0xd8, 0x9f, 0xe5,
+	0x62, 0x77, 0xc0, 0xd5, 0xcc, 0xaa, 0x62, 0x7c, 0x00, 0xd6, 0x42, 0xb1, 0xa2, 0xd8, 0xaa, 0x79,
+	0x36, 0x59, 0x9f, 0xa6, 0x52, 0x80, 0xf6, 0xe7, 0x55, 0xb0, 0x2a, 0x5a, 0xc2, 0x13, 0x03, 0x80,
+	0xe4, 0x0a, 0xc1, 0x46, 0xbe, 0xc7, 0xfc, 0x6b, 0x6c, 0x36, 0x0b, 0xeb, 0xa4, 0x48, 0xbb, 0x7e,
+	0xf2, 0xfd, 0xf7, 0xa7, 0x95, 0xdb, 0xf0, 0x26, 0xca, 0xfd, 0xc9, 0xa4, 0x6e, 0x28, 0xfc, 0x60,
+	0x80, 0xb2, 0xc6, 0xc2, 0xcd, 0xe5, 0xbd, 0x67, 0x12, 0x1a, 0x45, 0x65, 0x4a, 0xc1, 0x5d, 0xa1,
+	0x60, 0x13, 0xd6, 0x97, 0x28, 0x40, 0xef, 0xc4, 0xcb, 0x7b, 0x61, 0x47, 0x72, 0x6a, 0x17, 0xd8,
+	0x71, 0xee, 0xe6, 0x98, 0xcd, 0xc2, 0xba, 0x22, 0x3b, 0x52, 0x97, 0x42, 0xd8, 0xa1, 0xb1, 0x0b,
+	0xec, 0xc8, 0x9f, 0x57, 0xb3, 0x51, 0x54, 0x56, 0x64, 0x47, 0x4a, 0x81, 0xb6, 0x63, 0x08, 0xd6,
+	0xe4, 0x21, 0x82, 0xf6, 0xdc, 0xf6, 0x99, 0x73, 0x6a, 0xd6, 0x97, 0xd6, 0x28, 0x7e, 0x4b, 0xf0,
+	0xd7, 0x60, 0x35, 0xcf, 0x2f, 0x8f, 0xe6, 0xee, 0xc1, 0xe9, 0xc4, 0x32, 0xce, 0x26, 0x96, 0xf1,
+	0x6b, 0x62, 0x19, 0x1f, 0xa7, 0x56, 0xe9, 0x6c, 0x6a, 0x95, 0x7e, 0x4c, 0xad, 0xd2, 0xf3, 0x1d,
+	0xd7, 0xe3, 0xaf, 0x46, 0x3d, 0xa7, 0x4f, 0x7d, 0xf4, 0xd0, 0x7b, 0x4b, 0x06, 0x4f, 0x08, 0x7f,
+	0x4d, 0xa3, 0x23, 0xb4, 0x47, 0x99, 0xbf, 0x3f, 0xf6, 0xd1, 0x1b, 0xd5, 0x8c, 0x1f, 0x87, 0x84,
+	0xf5, 0xd6, 0xc4, 0xa7, 0xe8, 0xfe, 0xdf, 0x01, 0x00, 0xd9, 0xdf, 0x28, 0x52, 0x65, 0x07, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -376,6 +584,10 @@ type QueryClient interface {
 	TokenPairs(ctx context.Context, in *QueryTokenPairsRequest, opts ...grpc.CallOption) (*QueryTokenPairsResponse, error)
 	// TokenPair retrieves a registered token pair
 	TokenPair(ctx context.Context, in *QueryTokenPairRequest, opts ...grpc.CallOption) (*QueryTokenPairResponse, error)
+	// RateLimits retrieves the conversion rate limits of the token pairs
+	RateLimits(ctx context.Context, in *QueryRateLimitsRequest, opts ...grpc.CallOption) (*QueryRateLimitsResponse, error)
+	// RateLimit retrieves the conversion rate limit of a token pair
+	RateLimit(ctx context.Context, in *QueryRateLimitRequest, opts ...grpc.CallOption) (*QueryRateLimitResponse, error)
 	// Params retrieves the erc20 module params
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -406,6 +618,24 @@ func (c *queryClient) TokenPair(ctx context.Context, in *QueryTokenPairRequest,
 	return out, nil
 }
 
+func (c *queryClient) RateLimits(ctx context.Context, in *QueryRateLimitsRequest, opts ...grpc.CallOption) (*QueryRateLimitsResponse, error) {
+	out := new(QueryRateLimitsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Query/RateLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RateLimit(ctx context.Context, in *QueryRateLimitRequest, opts ...grpc.CallOption) (*QueryRateLimitResponse, error) {
+	out := new(QueryRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Query/RateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Query/Params", in, out, opts...)
@@ -421,6 +651,10 @@ type QueryServer interface {
 	TokenPairs(context.Context, *QueryTokenPairsRequest) (*QueryTokenPairsResponse, error)
 	// TokenPair retrieves a registered token pair
 	TokenPair(context.Context, *QueryTokenPairRequest) (*QueryTokenPairResponse, error)
+	// RateLimits retrieves the conversion rate limits of the token pairs
+	RateLimits(context.Context, *QueryRateLimitsRequest) (*QueryRateLimitsResponse, error)
+	// RateLimit retrieves the conversion rate limit of a token pair
+	RateLimit(context.Context, *QueryRateLimitRequest) (*QueryRateLimitResponse, error)
 	// Params retrieves the erc20 module params
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -435,6 +669,12 @@ func (*UnimplementedQueryServer) TokenPairs(ctx context.Context, req *QueryToken
 func (*UnimplementedQueryServer) TokenPair(ctx context.Context, req *QueryTokenPairRequest) (*QueryTokenPairResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TokenPair not implemented")
 }
+func (*UnimplementedQueryServer) RateLimits(ctx context.Context, req *QueryRateLimitsRequest) (*QueryRateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimits not implemented")
+}
+func (*UnimplementedQueryServer) RateLimit(ctx context.Context, req *QueryRateLimitRequest) (*QueryRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimit not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -479,6 +719,42 @@ func _Query_TokenPair_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Query/RateLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimits(ctx, req.(*QueryRateLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Query/RateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimit(ctx, req.(*QueryRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -497,6 +773,7 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "evmos.erc20.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -509,6 +786,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TokenPair",
 			Handler:    _Query_TokenPair_Handler,
 		},
+		{
+			MethodName: "RateLimits",
+			Handler:    _Query_RateLimits_Handler,
+		},
+		{
+			MethodName: "RateLimit",
+			Handler:    _Query_RateLimit_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -665,7 +950,7 @@ func (m *QueryTokenPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryRateLimitsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -675,20 +960,32 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryRateLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryRateLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryRateLimitsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -698,56 +995,191 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryRateLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryRateLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryRateLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryTokenPairsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryRateLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTokenPairsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryTokenPairsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenPairsResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -788,6 +1220,62 @@ func (m *QueryTokenPairResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryRateLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RateLimit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1185,6 +1673,377 @@ func (m *QueryTokenPairResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryRateLimitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,74 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochstypes "github.com/AizelNetwork/CosmEvm/x/epochs/types"
+)
+
+// NewRateLimit returns an instance of RateLimit with zero flows
+func NewRateLimit(denom, epochIdentifier string, maxInflow, maxOutflow math.Int) RateLimit {
+	return RateLimit{
+		Denom:           denom,
+		EpochIdentifier: epochIdentifier,
+		MaxInflow:       maxInflow,
+		MaxOutflow:      maxOutflow,
+		Inflow:          math.ZeroInt(),
+		Outflow:         math.ZeroInt(),
+	}
+}
+
+// Validate performs a stateless validation of a RateLimit
+func (rl RateLimit) Validate() error {
+	if err := sdk.ValidateDenom(rl.Denom); err != nil {
+		return err
+	}
+
+	if err := epochstypes.ValidateEpochIdentifierString(rl.EpochIdentifier); err != nil {
+		return err
+	}
+
+	if err := validateQuota(rl.MaxInflow, rl.MaxOutflow); err != nil {
+		return err
+	}
+
+	if rl.Inflow.IsNil() || rl.Inflow.IsNegative() {
+		return fmt.Errorf("inflow cannot be nil or negative: %s", rl.Inflow)
+	}
+
+	if rl.Outflow.IsNil() || rl.Outflow.IsNegative() {
+		return fmt.Errorf("outflow cannot be nil or negative: %s", rl.Outflow)
+	}
+
+	return nil
+}
+
+// validateQuota checks that the inflow and outflow quotas are not negative
+func validateQuota(maxInflow, maxOutflow math.Int) error {
+	if maxInflow.IsNil() || maxInflow.IsNegative() {
+		return fmt.Errorf("max inflow cannot be nil or negative: %s", maxInflow)
+	}
+
+	if maxOutflow.IsNil() || maxOutflow.IsNegative() {
+		return fmt.Errorf("max outflow cannot be nil or negative: %s", maxOutflow)
+	}
+
+	return nil
+}
+
+// IsUnlimited returns true if neither the inflow nor the outflow quotas are set
+func (rl RateLimit) IsUnlimited() bool {
+	return rl.MaxInflow.IsZero() && rl.MaxOutflow.IsZero()
+}
+
+// ResetFlows sets the inflow and outflow of the current epoch to zero
+func (rl *RateLimit) ResetFlows() {
+	rl.Inflow = math.ZeroInt()
+	rl.Outflow = math.ZeroInt()
+}
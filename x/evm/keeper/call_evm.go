@@ -75,12 +75,54 @@ func (k Keeper) CallEVMWithData(
 		gasCap = gasRes.Gas
 	}
 
+	return k.applyCall(ctx, from, contract, data, nonce, gasCap, commit)
+}
+
+// CallEVMWithGasLimit performs a smart contract method call using given args
+// and a fixed gas limit instead of an estimated one. The state changes are committed.
+func (k Keeper) CallEVMWithGasLimit(
+	ctx sdk.Context,
+	abi abi.ABI,
+	from, contract common.Address,
+	gasLimit uint64,
+	method string,
+	args ...interface{},
+) (*types.MsgEthereumTxResponse, error) {
+	data, err := abi.Pack(method, args...)
+	if err != nil {
+		return nil, errorsmod.Wrap(
+			types.ErrABIPack,
+			errorsmod.Wrap(err, "failed to create transaction data").Error(),
+		)
+	}
+
+	nonce, err := k.accountKeeper.GetSequence(ctx, from.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := k.applyCall(ctx, from, &contract, data, nonce, gasLimit, true)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "contract call failed: method '%s', contract '%s'", method, contract)
+	}
+	return resp, nil
+}
+
+// applyCall applies the message of a smart contract call with the given gas limit.
+func (k Keeper) applyCall(
+	ctx sdk.Context,
+	from common.Address,
+	contract *common.Address,
+	data []byte,
+	nonce, gasLimit uint64,
+	commit bool,
+) (*types.MsgEthereumTxResponse, error) {
 	msg := ethtypes.NewMessage(
 		from,
 		contract,
 		nonce,
 		big.NewInt(0), // amount
-		gasCap,        // gasLimit
+		gasLimit,      // gasLimit
 		big.NewInt(0), // gasFeeCap
 		big.NewInt(0), // gasTipCap
 		big.NewInt(0), // gasPrice
@@ -146,3 +146,47 @@ func (suite *KeeperTestSuite) TestCallEVMWithData() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestCallEVMWithGasLimit() {
+	waizelContract := common.HexToAddress(types.WEVMOSContractMainnet)
+	testCases := []struct {
+		name     string
+		method   string
+		gasLimit uint64
+		expPass  bool
+	}{
+		{
+			"unknown method",
+			"",
+			100_000,
+			false,
+		},
+		{
+			"fail - gas limit below intrinsic gas",
+			"balanceOf",
+			1_000,
+			false,
+		},
+		{
+			"pass",
+			"balanceOf",
+			100_000,
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+			account := utiltx.GenerateAddress()
+			res, err := suite.network.App.EvmKeeper.CallEVMWithGasLimit(suite.network.GetContext(), erc20, types.ModuleAddress, waizelContract, tc.gasLimit, tc.method, account)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().LessOrEqual(res.GasUsed, tc.gasLimit)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
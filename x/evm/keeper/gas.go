@@ -30,6 +30,9 @@ func (k *Keeper) GetEthIntrinsicGas(ctx sdk.Context, msg core.Message, cfg *para
 // consumed in the transaction. Additionally, the function sets the total gas consumed to the value
 // returned by the EVM execution, thus ignoring the previous intrinsic gas consumed during in the
 // AnteHandler.
+//
+// NOTE: the leftover gas of a sponsored transaction is refunded to the sponsor that paid the fees,
+// and the leftover gas of a transaction paid in a fee denom is refunded in the fee denom.
 func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64, denom string) error {
 	// Return EVM tokens for remaining gas, exchanged at the original rate.
 	remaining := new(big.Int).Mul(new(big.Int).SetUint64(leftoverGas), msg.GasPrice())
@@ -42,8 +45,23 @@ func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64
 		// positive amount refund
 		refundedCoins := sdk.Coins{sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(remaining))}
 
+		// refund in the fee denom at the price used to deduct the fees, rounded
+		// down in favor of the fee collector
+		if price, ok := types.FeeDenomFromContext(ctx); ok {
+			amount := price.FromEVMDenom(types.ConvertBigIntFrom18DecimalsToLegacyDec(remaining)).TruncateInt()
+			if !amount.IsPositive() {
+				return nil
+			}
+			refundedCoins = sdk.Coins{sdk.NewCoin(price.Denom, amount)}
+		}
+
+		refundee := sdk.AccAddress(msg.From().Bytes())
+		if sponsor, ok := types.SponsorFromContext(ctx); ok {
+			refundee = sponsor
+		}
+
 		// refund to sender from the fee collector module account, which is the escrow account in charge of collecting tx fees
-		err := k.bankWrapper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, msg.From().Bytes(), refundedCoins)
+		err := k.bankWrapper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, refundee, refundedCoins)
 		if err != nil {
 			err = errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "fee collector account failed to refund fees: %s", err.Error())
 			return errorsmod.Wrapf(err, "failed to refund %d leftover gas (%s)", leftoverGas, refundedCoins.String())
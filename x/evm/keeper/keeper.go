@@ -320,3 +320,21 @@ func (k Keeper) AddTransientGasUsed(ctx sdk.Context, gasUsed uint64) (uint64, er
 	k.SetTransientGasUsed(ctx, result)
 	return result, nil
 }
+
+// SetTxGasUsedTransient sets the gas used by the Ethereum tx with the given hash
+// on the current block.
+func (k Keeper) SetTxGasUsedTransient(ctx sdk.Context, txHash common.Hash, gasUsed uint64) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientTxGasUsed)
+	store.Set(txHash.Bytes(), sdk.Uint64ToBigEndian(gasUsed))
+}
+
+// GetTxGasUsedTransient returns the gas used by the Ethereum tx with the given
+// hash on the current block. It returns false if the tx has not been executed.
+func (k Keeper) GetTxGasUsedTransient(ctx sdk.Context, txHash common.Hash) (uint64, bool) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientTxGasUsed)
+	bz := store.Get(txHash.Bytes())
+	if len(bz) == 0 {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(bz), true
+}
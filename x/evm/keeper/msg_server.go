@@ -49,6 +49,12 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 		return nil, errorsmod.Wrap(err, "failed to apply transaction")
 	}
 
+	// a failed tx of a bundle reverts the state changes of all the txs of the
+	// bundle, including their gas refunds
+	if response.Failed() && types.IsBundleContext(ctx) {
+		return nil, errorsmod.Wrapf(types.ErrBundleReverted, "tx %s failed: %s", response.Hash, response.VmError)
+	}
+
 	defer func() {
 		telemetry.IncrCounterWithLabels(
 			[]string{"tx", "msg", "ethereum_tx", "total"},
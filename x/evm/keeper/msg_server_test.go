@@ -81,6 +81,96 @@ func (suite *KeeperTestSuite) TestEthereumTx() {
 	suite.enableFeemarket = false
 }
 
+func (suite *KeeperTestSuite) TestEthereumTxBundle() {
+	suite.enableFeemarket = true
+	defer func() { suite.enableFeemarket = false }()
+	suite.SetupTest()
+	testCases := []struct {
+		name      string
+		isBundle  bool
+		expFailed bool
+		expErr    error
+	}{
+		{"failed tx - not in a bundle", false, true, nil},
+		{"failed tx - reverts the bundle", true, false, types.ErrBundleReverted},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// contract creation with an invalid opcode
+			args := types.EvmTxArgs{
+				GasLimit: 100000,
+				Input:    []byte{0xfe},
+			}
+			tx, err := suite.factory.GenerateSignedEthTx(suite.keyring.GetPrivKey(0), args)
+			suite.Require().NoError(err)
+			msg := tx.GetMsgs()[0].(*types.MsgEthereumTx)
+
+			ctx := suite.network.GetContext()
+			if tc.isBundle {
+				ctx = types.ContextWithBundle(ctx)
+			}
+
+			res, err := suite.network.App.EvmKeeper.EthereumTx(ctx, msg)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expFailed, res.Failed())
+			}
+
+			err = suite.network.NextBlock()
+			suite.Require().NoError(err)
+		})
+	}
+}
+
+// TestFailedBundleCost checks that a failed bundle consumes the nonces and
+// charges the full gas limit of all its txs, as the gas refund is reverted
+// together with the state changes of the bundle.
+func (suite *KeeperTestSuite) TestFailedBundleCost() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+	sender := suite.keyring.GetKey(0)
+	recipient := suite.keyring.GetAddr(1)
+
+	nonce := suite.network.App.EvmKeeper.GetNonce(ctx, sender.Addr)
+	senderBalance := suite.network.App.EvmKeeper.GetBalance(ctx, sender.Addr)
+	recipientBalance := suite.network.App.EvmKeeper.GetBalance(ctx, recipient)
+	gasPrice := new(big.Int).Mul(suite.network.App.EvmKeeper.GetBaseFee(ctx), big.NewInt(2))
+
+	txArgs := []types.EvmTxArgs{
+		// successful transfer
+		{Nonce: nonce, To: &recipient, Amount: big.NewInt(1000), GasLimit: 50000, GasPrice: gasPrice},
+		// contract creation with an invalid opcode
+		{Nonce: nonce + 1, Input: []byte{0xfe}, GasLimit: 100000, GasPrice: gasPrice},
+	}
+
+	msgs := make([]*types.MsgEthereumTx, len(txArgs))
+	expFee := new(big.Int)
+	for i, args := range txArgs {
+		msg, err := suite.factory.GenerateSignedMsgEthereumTx(sender.Priv, args)
+		suite.Require().NoError(err)
+		msgs[i] = &msg
+		expFee.Add(expFee, msg.GetFee())
+	}
+
+	tx, err := types.BuildBundleTx(suite.network.App.GetTxConfig().NewTxBuilder(), msgs, suite.network.GetBaseDenom(), 0)
+	suite.Require().NoError(err)
+	txBytes, err := suite.network.App.GetTxConfig().TxEncoder()(tx)
+	suite.Require().NoError(err)
+
+	res, err := suite.network.NextBlockWithTxs(txBytes)
+	suite.Require().NoError(err)
+	suite.Require().Len(res.TxResults, 1)
+	suite.Require().Equal(types.ErrBundleReverted.ABCICode(), res.TxResults[0].Code)
+
+	ctx = suite.network.GetContext()
+	suite.Require().Equal(nonce+2, suite.network.App.EvmKeeper.GetNonce(ctx, sender.Addr))
+	suite.Require().Equal(new(big.Int).Sub(senderBalance, expFee), suite.network.App.EvmKeeper.GetBalance(ctx, sender.Addr))
+	suite.Require().Equal(recipientBalance, suite.network.App.EvmKeeper.GetBalance(ctx, recipient))
+}
+
 func (suite *KeeperTestSuite) TestUpdateParams() {
 	suite.SetupTest()
 	testCases := []struct {
@@ -11,19 +11,24 @@ import (
 	evidencekeeper "cosmossdk.io/x/evidence/keeper"
 
 	bankprecompile "github.com/AizelNetwork/CosmEvm/precompiles/bank"
+	batchprecompile "github.com/AizelNetwork/CosmEvm/precompiles/batch"
 	"github.com/AizelNetwork/CosmEvm/precompiles/bech32"
 	distprecompile "github.com/AizelNetwork/CosmEvm/precompiles/distribution"
 	evidenceprecompile "github.com/AizelNetwork/CosmEvm/precompiles/evidence"
 	govprecompile "github.com/AizelNetwork/CosmEvm/precompiles/gov"
+	icaprecompile "github.com/AizelNetwork/CosmEvm/precompiles/ica"
 	ics20precompile "github.com/AizelNetwork/CosmEvm/precompiles/ics20"
+	inflationprecompile "github.com/AizelNetwork/CosmEvm/precompiles/inflation"
 	"github.com/AizelNetwork/CosmEvm/precompiles/p256"
 	slashingprecompile "github.com/AizelNetwork/CosmEvm/precompiles/slashing"
 	stakingprecompile "github.com/AizelNetwork/CosmEvm/precompiles/staking"
 	vestingprecompile "github.com/AizelNetwork/CosmEvm/precompiles/vesting"
+	epochskeeper "github.com/AizelNetwork/CosmEvm/x/epochs/keeper"
 	erc20Keeper "github.com/AizelNetwork/CosmEvm/x/erc20/keeper"
 	"github.com/AizelNetwork/CosmEvm/x/evm/core/vm"
 	"github.com/AizelNetwork/CosmEvm/x/evm/types"
 	transferkeeper "github.com/AizelNetwork/CosmEvm/x/ibc/transfer/keeper"
+	inflationkeeper "github.com/AizelNetwork/CosmEvm/x/inflation/v1/keeper"
 	stakingkeeper "github.com/AizelNetwork/CosmEvm/x/staking/keeper"
 	vestingkeeper "github.com/AizelNetwork/CosmEvm/x/vesting/keeper"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
@@ -31,6 +36,7 @@ import (
 	distributionkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/keeper"
 	channelkeeper "github.com/cosmos/ibc-go/v8/modules/core/04-channel/keeper"
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -40,6 +46,7 @@ const bech32PrecompileBaseGas = 6_000
 // AvailableStaticPrecompiles returns the list of all available static precompiled contracts.
 // NOTE: this should only be used during initialization of the Keeper.
 func NewAvailableStaticPrecompiles(
+	evmKeeper *Keeper,
 	stakingKeeper stakingkeeper.Keeper,
 	distributionKeeper distributionkeeper.Keeper,
 	bankKeeper bankkeeper.Keeper,
@@ -51,6 +58,9 @@ func NewAvailableStaticPrecompiles(
 	govKeeper govkeeper.Keeper,
 	slashingKeeper slashingkeeper.Keeper,
 	evidenceKeeper evidencekeeper.Keeper,
+	inflationKeeper inflationkeeper.Keeper,
+	epochsKeeper epochskeeper.Keeper,
+	icaControllerKeeper icacontrollerkeeper.Keeper,
 ) map[common.Address]vm.PrecompiledContract {
 	// Clone the mapping from the latest EVM fork.
 	precompiles := maps.Clone(vm.PrecompiledContractsBerlin)
@@ -112,6 +122,27 @@ func NewAvailableStaticPrecompiles(
 		panic(fmt.Errorf("failed to instantiate evidence precompile: %w", err))
 	}
 
+	inflationPrecompile, err := inflationprecompile.NewPrecompile(inflationKeeper, epochsKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate inflation precompile: %w", err))
+	}
+
+	icaPrecompile, err := icaprecompile.NewPrecompile(icaControllerKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate ica precompile: %w", err))
+	}
+
+	batchPrecompile, err := batchprecompile.NewPrecompile(
+		evmKeeper,
+		stakingPrecompile,
+		distributionPrecompile,
+		ibcTransferPrecompile,
+		govPrecompile,
+	)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate batch precompile: %w", err))
+	}
+
 	// Stateless precompiles
 	precompiles[bech32Precompile.Address()] = bech32Precompile
 	precompiles[p256Precompile.Address()] = p256Precompile
@@ -125,6 +156,9 @@ func NewAvailableStaticPrecompiles(
 	precompiles[govPrecompile.Address()] = govPrecompile
 	precompiles[slashingPrecompile.Address()] = slashingPrecompile
 	precompiles[evidencePrecompile.Address()] = evidencePrecompile
+	precompiles[inflationPrecompile.Address()] = inflationPrecompile
+	precompiles[icaPrecompile.Address()] = icaPrecompile
+	precompiles[batchPrecompile.Address()] = batchPrecompile
 
 	return precompiles
 }
@@ -0,0 +1,126 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// MaxBundleTxs is the maximum number of Ethereum txs in a bundle.
+const MaxBundleTxs = 16
+
+// bundleContextKey is the context key used to mark the execution of a bundle.
+type bundleContextKey struct{}
+
+// ContextWithBundle returns a context that marks the execution of the messages
+// of an Ethereum tx bundle. A failed Ethereum tx executed with this context
+// reverts the whole bundle.
+//
+// NOTE: only the execution of the bundle is reverted. The fees and nonces of
+// its txs are consumed by the ante handler, and the gas refunds are reverted
+// with the execution, so a failed bundle charges the full gas limit of all
+// its txs, like any other failed Cosmos tx.
+func ContextWithBundle(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(bundleContextKey{}, true)
+}
+
+// IsBundleContext returns true if the context is the execution context of an
+// Ethereum tx bundle.
+func IsBundleContext(ctx sdk.Context) bool {
+	isBundle, _ := ctx.Value(bundleContextKey{}).(bool)
+	return isBundle
+}
+
+// IsBundle returns true if the messages are the Ethereum txs of a bundle, i.e.
+// a Cosmos tx containing more than one Ethereum tx.
+func IsBundle(msgs []sdk.Msg) bool {
+	if len(msgs) < 2 {
+		return false
+	}
+	_, ok := msgs[0].(*MsgEthereumTx)
+	return ok
+}
+
+// ValidateBundleSize returns an error if the number of Ethereum txs of a bundle
+// is outside of the allowed range.
+func ValidateBundleSize(size int) error {
+	if size < 2 || size > MaxBundleTxs {
+		return errorsmod.Wrapf(ErrInvalidBundle, "a bundle must contain between 2 and %d txs, got %d", MaxBundleTxs, size)
+	}
+	return nil
+}
+
+// BundleHash returns the hash of an Ethereum tx bundle, computed as the
+// keccak256 hash of the concatenated hashes of its txs.
+func BundleHash(msgs []*MsgEthereumTx) common.Hash {
+	hashes := make([]byte, 0, len(msgs)*common.HashLength)
+	for _, msg := range msgs {
+		hashes = append(hashes, msg.AsTransaction().Hash().Bytes()...)
+	}
+	return crypto.Keccak256Hash(hashes)
+}
+
+// BuildBundleTx builds a Cosmos tx that executes the given Ethereum txs
+// contiguously and atomically. The bundle is not valid after the max block
+// number, unless it's zero.
+func BuildBundleTx(b client.TxBuilder, msgs []*MsgEthereumTx, evmDenom string, maxBlockNumber uint64) (signing.Tx, error) {
+	if err := ValidateBundleSize(len(msgs)); err != nil {
+		return nil, err
+	}
+
+	builder, ok := b.(authtx.ExtensionOptionsTxBuilder)
+	if !ok {
+		return nil, errors.New("unsupported builder")
+	}
+
+	option, err := codectypes.NewAnyWithValue(&ExtensionOptionsEthereumTx{})
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		sdkMsgs  = make([]sdk.Msg, len(msgs))
+		feeAmt   = sdkmath.ZeroInt()
+		gasLimit uint64
+	)
+
+	for i, msg := range msgs {
+		txData, err := UnpackTxData(msg.Data)
+		if err != nil {
+			return nil, err
+		}
+
+		feeAmt = feeAmt.Add(sdkmath.NewIntFromBigInt(txData.Fee()))
+		gasLimit += msg.GetGas()
+
+		// A valid msg should have empty `From`
+		msg.From = ""
+		sdkMsgs[i] = msg
+	}
+
+	fees := make(sdk.Coins, 0, 1)
+	if feeAmt.IsPositive() {
+		fees = append(fees, sdk.NewCoin(evmDenom, feeAmt))
+		fees = ConvertCoinsFrom18Decimals(fees)
+	}
+
+	builder.SetExtensionOptions(option)
+	if err := builder.SetMsgs(sdkMsgs...); err != nil {
+		return nil, err
+	}
+	builder.SetFeeAmount(fees)
+	builder.SetGasLimit(gasLimit)
+	builder.SetTimeoutHeight(maxBlockNumber)
+
+	return builder.GetTx(), nil
+}
@@ -39,6 +39,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*tx.TxExtensionOptionI)(nil),
 		&ExtensionOptionsEthereumTx{},
+		&ExtensionOptionSponsor{},
+		&ExtensionOptionFeeDenom{},
+		&ExtensionOptionMultisig{},
 	)
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
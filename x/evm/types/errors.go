@@ -32,6 +32,11 @@ const (
 	codeErrInactivePrecompile
 	codeErrABIPack
 	codeErrABIUnpack
+	codeErrInvalidBundle
+	codeErrBundleReverted
+	codeErrInvalidSponsor
+	codeErrInvalidFeeDenom
+	codeErrInvalidMultisig
 )
 
 var (
@@ -88,6 +93,21 @@ var (
 
 	// ErrABIUnpack returns an error if the contract ABI unpacking fails
 	ErrABIUnpack = errorsmod.Register(ModuleName, codeErrABIUnpack, "contract ABI unpack failed")
+
+	// ErrInvalidBundle returns an error if an Ethereum tx bundle is invalid
+	ErrInvalidBundle = errorsmod.Register(ModuleName, codeErrInvalidBundle, "invalid ethereum tx bundle")
+
+	// ErrBundleReverted returns an error if a tx of an Ethereum tx bundle fails
+	ErrBundleReverted = errorsmod.Register(ModuleName, codeErrBundleReverted, "ethereum tx bundle reverted")
+
+	// ErrInvalidSponsor returns an error if the sponsor of an Ethereum tx is invalid
+	ErrInvalidSponsor = errorsmod.Register(ModuleName, codeErrInvalidSponsor, "invalid ethereum tx sponsor")
+
+	// ErrInvalidFeeDenom returns an error if the denom used to pay the fees of an Ethereum tx is invalid
+	ErrInvalidFeeDenom = errorsmod.Register(ModuleName, codeErrInvalidFeeDenom, "invalid ethereum tx fee denom")
+
+	// ErrInvalidMultisig returns an error if the multisig signature of an Ethereum tx is invalid
+	ErrInvalidMultisig = errorsmod.Register(ModuleName, codeErrInvalidMultisig, "invalid ethereum tx multisig")
 )
 
 // NewExecErrorWithReason unpacks the revert return bytes and returns a wrapped error
@@ -0,0 +1,110 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	feemarkettypes "github.com/AizelNetwork/CosmEvm/x/feemarket/types"
+)
+
+// FeeDenomExtensionOptionTypeURL is the type URL of the fee denom extension
+// option.
+const FeeDenomExtensionOptionTypeURL = "/ethermint.evm.v1.ExtensionOptionFeeDenom"
+
+// feeDenomContextKey is the context key used to store the price of the fee
+// denom used to pay the fees of an Ethereum tx.
+type feeDenomContextKey struct{}
+
+// ContextWithFeeDenom returns a context that marks the execution of an
+// Ethereum tx whose fees are paid in the fee denom with the given price. The
+// leftover gas of the tx is refunded in the fee denom at the same price.
+func ContextWithFeeDenom(ctx sdk.Context, price feemarkettypes.FeeDenomPrice) sdk.Context {
+	return ctx.WithValue(feeDenomContextKey{}, price)
+}
+
+// FeeDenomFromContext returns the price of the fee denom used to pay the fees
+// of the Ethereum tx executed with the context, if any.
+func FeeDenomFromContext(ctx sdk.Context) (feemarkettypes.FeeDenomPrice, bool) {
+	price, ok := ctx.Value(feeDenomContextKey{}).(feemarkettypes.FeeDenomPrice)
+	return price, ok && price.Denom != ""
+}
+
+// FeeDenomSignBytes returns the bytes signed by the fee payer of an Ethereum
+// tx paid in a fee denom, i.e. the keccak256 hash of the Ethereum tx hash and
+// the fee denom.
+func FeeDenomSignBytes(txHash common.Hash, denom string) []byte {
+	return crypto.Keccak256(txHash.Bytes(), []byte(denom))
+}
+
+// ValidateBasic performs a stateless validation of the fee denom extension
+// option.
+func (o ExtensionOptionFeeDenom) ValidateBasic() error {
+	if err := sdk.ValidateDenom(o.Denom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidFeeDenom, "invalid fee denom: %s", err)
+	}
+
+	if o.Denom == GetEVMCoinDenom() {
+		return errorsmod.Wrapf(ErrInvalidFeeDenom, "fee denom cannot be the evm denom %s", o.Denom)
+	}
+
+	if len(o.Signature) > 0 && len(o.Signature) != crypto.SignatureLength {
+		return errorsmod.Wrapf(
+			ErrInvalidFeeDenom,
+			"invalid signature length, expected %d, got %d", crypto.SignatureLength, len(o.Signature),
+		)
+	}
+
+	return nil
+}
+
+// VerifySignature verifies that the signature of the extension option was
+// produced by the given fee payer for the Ethereum tx with the given hash.
+func (o ExtensionOptionFeeDenom) VerifySignature(txHash common.Hash, payer common.Address) error {
+	if len(o.Signature) == 0 {
+		return errorsmod.Wrapf(ErrInvalidFeeDenom, "fee denom %s not signed by the fee payer %s", o.Denom, payer)
+	}
+
+	pubKey, err := crypto.SigToPub(FeeDenomSignBytes(txHash, o.Denom), o.Signature)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidFeeDenom, "failed to recover fee payer public key: %s", err)
+	}
+
+	if signer := crypto.PubkeyToAddress(*pubKey); signer != payer {
+		return errorsmod.Wrapf(
+			ErrInvalidFeeDenom,
+			"signature signer %s doesn't match fee payer %s", signer, payer,
+		)
+	}
+
+	return nil
+}
+
+// GetFeeDenomOption returns the fee denom extension option of an Ethereum tx,
+// or nil if the fees of the tx are paid in the evm denom.
+func GetFeeDenomOption(tx sdk.Tx) (*ExtensionOptionFeeDenom, error) {
+	option := &ExtensionOptionFeeDenom{}
+	found, err := getExtensionOption(tx, FeeDenomExtensionOptionTypeURL, option)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidFeeDenom, "fee denom extension option: %s", err)
+	}
+	if !found {
+		return nil, nil
+	}
+
+	return option, nil
+}
+
+// GetFeeDenom returns the fee denom of an Ethereum tx, or an empty string if
+// the fees of the tx are paid in the evm denom.
+func GetFeeDenom(tx sdk.Tx) (string, error) {
+	option, err := GetFeeDenomOption(tx)
+	if err != nil || option == nil {
+		return "", err
+	}
+
+	return option.Denom, nil
+}
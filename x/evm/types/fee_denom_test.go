@@ -0,0 +1,69 @@
+package types
+
+import (
+	"crypto/ecdsa"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtensionOptionFeeDenomSignature(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	payer := crypto.PubkeyToAddress(key.PublicKey)
+
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	txHash := common.HexToHash("0x01")
+	denom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+	sign := func(t *testing.T, signKey *ecdsa.PrivateKey, hash common.Hash, denom string) []byte {
+		sig, err := crypto.Sign(FeeDenomSignBytes(hash, denom), signKey)
+		require.NoError(t, err)
+		return sig
+	}
+
+	testCases := []struct {
+		name    string
+		option  ExtensionOptionFeeDenom
+		expPass bool
+	}{
+		{
+			"valid signature",
+			ExtensionOptionFeeDenom{Denom: denom, Signature: sign(t, key, txHash, denom)},
+			true,
+		},
+		{
+			"no signature",
+			ExtensionOptionFeeDenom{Denom: denom},
+			false,
+		},
+		{
+			"signature of another account",
+			ExtensionOptionFeeDenom{Denom: denom, Signature: sign(t, otherKey, txHash, denom)},
+			false,
+		},
+		{
+			"signature over another denom",
+			ExtensionOptionFeeDenom{Denom: denom, Signature: sign(t, key, txHash, "uatom")},
+			false,
+		},
+		{
+			"signature over another tx",
+			ExtensionOptionFeeDenom{Denom: denom, Signature: sign(t, key, common.HexToHash("0x02"), denom)},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.option.VerifySignature(txHash, payer)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, ErrInvalidFeeDenom, tc.name)
+		}
+	}
+}
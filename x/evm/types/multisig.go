@@ -0,0 +1,284 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	multisigtypes "github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// MultisigExtensionOptionTypeURL is the type URL of the multisig extension
+// option.
+const MultisigExtensionOptionTypeURL = "/ethermint.evm.v1.ExtensionOptionMultisig"
+
+var _ codectypes.UnpackInterfacesMessage = ExtensionOptionMultisig{}
+
+// multisigContextKey is the context key used to store the multisig sender of
+// an Ethereum tx.
+type multisigContextKey struct{}
+
+// ContextWithMultisigSender returns a context that marks the execution of an
+// Ethereum tx whose sender is the given multisig account.
+func ContextWithMultisigSender(ctx sdk.Context, sender common.Address) sdk.Context {
+	return ctx.WithValue(multisigContextKey{}, sender)
+}
+
+// MultisigSenderFromContext returns the multisig sender of the Ethereum tx
+// executed with the context, if any.
+func MultisigSenderFromContext(ctx sdk.Context) (common.Address, bool) {
+	sender, ok := ctx.Value(multisigContextKey{}).(common.Address)
+	return sender, ok && sender != (common.Address{})
+}
+
+// multisigSignBytesPrefix is prepended to the signing hash of the Ethereum tx
+// signed by the keys of a multisig sender, so that their signatures can't be
+// replayed as the Ethereum signatures of the same tx by their own accounts.
+var multisigSignBytesPrefix = []byte("ethermint multisig tx:")
+
+// MultisigSignBytes returns the bytes signed by the keys of a multisig sender,
+// i.e. the prefixed signing hash of the Ethereum tx, which commits to the
+// chain ID, followed by the fee denom of the tx if its fees aren't paid in the
+// evm denom.
+func MultisigSignBytes(signer ethtypes.Signer, tx *ethtypes.Transaction, feeDenom string) []byte {
+	signBytes := append(append([]byte{}, multisigSignBytesPrefix...), signer.Hash(tx).Bytes()...)
+	return append(signBytes, feeDenom...)
+}
+
+// MultisigSigner is an Ethereum signer that returns the multisig sender of an
+// Ethereum tx, whose signature was verified with the multisig extension option.
+type MultisigSigner struct {
+	ethtypes.Signer
+	sender common.Address
+}
+
+// NewMultisigSigner returns a signer that wraps the given signer and returns
+// the given multisig sender.
+func NewMultisigSigner(signer ethtypes.Signer, sender common.Address) MultisigSigner {
+	return MultisigSigner{
+		Signer: signer,
+		sender: sender,
+	}
+}
+
+// Sender returns the multisig sender of the tx.
+func (s MultisigSigner) Sender(_ *ethtypes.Transaction) (common.Address, error) {
+	return s.sender, nil
+}
+
+// Equal returns true if the given signer is a multisig signer of the same
+// sender.
+func (s MultisigSigner) Equal(other ethtypes.Signer) bool {
+	o, ok := other.(MultisigSigner)
+	return ok && o.sender == s.sender && s.Signer.Equal(o.Signer)
+}
+
+// MultisigSignature returns the signature carried by the Ethereum txs of a
+// multisig sender in place of an ECDSA signature. Its R value is the sender
+// address, so that the hash of the tx commits to its sender, and its S value is
+// zero, so that it can't be recovered as the signature of any account.
+func MultisigSignature(sender common.Address) []byte {
+	sig := make([]byte, crypto.SignatureLength)
+	copy(sig[common.HashLength-common.AddressLength:common.HashLength], sender.Bytes())
+	return sig
+}
+
+// HasMultisigSignature returns true if the Ethereum tx carries the multisig
+// signature of the given sender.
+func HasMultisigSignature(signer ethtypes.Signer, tx *ethtypes.Transaction, sender common.Address) bool {
+	multisigTx, err := tx.WithSignature(signer, MultisigSignature(sender))
+	if err != nil {
+		return false
+	}
+
+	return multisigTx.Hash() == tx.Hash()
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces.
+func (o ExtensionOptionMultisig) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(o.PubKey, &pubKey)
+}
+
+// GetMultisigPubKey returns the multisig public key of the sender.
+func (o ExtensionOptionMultisig) GetMultisigPubKey() (*kmultisig.LegacyAminoPubKey, error) {
+	if o.PubKey == nil {
+		return nil, errorsmod.Wrap(ErrInvalidMultisig, "empty public key")
+	}
+
+	pubKey, ok := o.PubKey.GetCachedValue().(*kmultisig.LegacyAminoPubKey)
+	if !ok {
+		return nil, errorsmod.Wrapf(ErrInvalidMultisig, "invalid public key type %s", o.PubKey.TypeUrl)
+	}
+
+	return pubKey, nil
+}
+
+// GetSender returns the address of the multisig sender, derived from its
+// public key.
+func (o ExtensionOptionMultisig) GetSender() (common.Address, error) {
+	pubKey, err := o.GetMultisigPubKey()
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return common.BytesToAddress(pubKey.Address()), nil
+}
+
+// ValidateBasic performs a stateless validation of the multisig extension
+// option.
+func (o ExtensionOptionMultisig) ValidateBasic() error {
+	pubKey, err := o.GetMultisigPubKey()
+	if err != nil {
+		return err
+	}
+
+	pubKeys := pubKey.GetPubKeys()
+	for i, pk := range pubKeys {
+		// the keys sign with single signatures, so nested multisigs aren't
+		// supported
+		if _, ok := pk.(multisigtypes.PubKey); ok {
+			return errorsmod.Wrapf(ErrInvalidMultisig, "nested multisig public key at index %d", i)
+		}
+	}
+
+	if o.Bitarray == nil || o.Bitarray.Count() != len(pubKeys) {
+		return errorsmod.Wrapf(ErrInvalidMultisig, "bit array size must be the number of keys %d", len(pubKeys))
+	}
+
+	signers := o.Bitarray.NumTrueBitsBefore(len(pubKeys))
+	if len(o.Signatures) != signers {
+		return errorsmod.Wrapf(
+			ErrInvalidMultisig,
+			"invalid number of signatures, expected %d, got %d", signers, len(o.Signatures),
+		)
+	}
+
+	if signers < int(pubKey.Threshold) {
+		return errorsmod.Wrapf(
+			ErrInvalidMultisig,
+			"not enough signatures, expected %d, got %d", pubKey.Threshold, signers,
+		)
+	}
+
+	return nil
+}
+
+// VerifySignature verifies that the signatures of the extension option were
+// produced by the threshold of the multisig keys over the given sign bytes.
+func (o ExtensionOptionMultisig) VerifySignature(signBytes []byte) error {
+	pubKey, err := o.GetMultisigPubKey()
+	if err != nil {
+		return err
+	}
+
+	sigs := make([]signing.SignatureData, len(o.Signatures))
+	for i, sig := range o.Signatures {
+		sigs[i] = &signing.SingleSignatureData{Signature: sig}
+	}
+
+	getSignBytes := func(signing.SignMode) ([]byte, error) {
+		return signBytes, nil
+	}
+
+	if err := pubKey.VerifyMultisignature(getSignBytes, &signing.MultiSignatureData{
+		BitArray:   o.Bitarray,
+		Signatures: sigs,
+	}); err != nil {
+		return errorsmod.Wrap(ErrInvalidMultisig, err.Error())
+	}
+
+	return nil
+}
+
+// VerifySender checks that the Ethereum tx carries the multisig signature of
+// the sender and that the extension option holds the signatures of the
+// threshold of the multisig keys over its sign bytes with the given fee denom.
+// It returns the multisig sender of the tx.
+func (o ExtensionOptionMultisig) VerifySender(
+	signer ethtypes.Signer,
+	tx *ethtypes.Transaction,
+	feeDenom string,
+) (common.Address, error) {
+	sender, err := o.GetSender()
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	if !HasMultisigSignature(signer, tx, sender) {
+		return common.Address{}, errorsmod.Wrapf(
+			ErrInvalidMultisig,
+			"the ethereum transaction of a multisig sender must carry the multisig signature of %s", sender,
+		)
+	}
+
+	if err := o.VerifySignature(MultisigSignBytes(signer, tx, feeDenom)); err != nil {
+		return common.Address{}, err
+	}
+
+	return sender, nil
+}
+
+// GetTxSender returns the sender of an Ethereum message of the given tx. The
+// sender of the tx of a multisig account is verified with the multisig
+// extension option of the tx, while the sender of any other tx is recovered
+// from its signature. The from address of the message is never trusted.
+func GetTxSender(tx sdk.Tx, msg *MsgEthereumTx, chainID *big.Int) (common.Address, error) {
+	option, err := GetMultisigOption(tx)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	if option == nil {
+		return msg.GetSender(chainID)
+	}
+
+	if err := option.ValidateBasic(); err != nil {
+		return common.Address{}, err
+	}
+
+	feeDenom, err := GetFeeDenom(tx)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return option.VerifySender(ethtypes.LatestSignerForChainID(chainID), msg.AsTransaction(), feeDenom)
+}
+
+// GetMultisigOption returns the multisig extension option of an Ethereum tx,
+// or nil if the tx is signed by its sender. The option, with the multisig
+// public key, is unpacked when the tx is decoded.
+func GetMultisigOption(tx sdk.Tx) (*ExtensionOptionMultisig, error) {
+	extTx, ok := tx.(interface {
+		GetExtensionOptions() []*codectypes.Any
+	})
+	if !ok {
+		return nil, nil
+	}
+
+	var option *ExtensionOptionMultisig
+	for _, any := range extTx.GetExtensionOptions() {
+		if any.GetTypeUrl() != MultisigExtensionOptionTypeURL {
+			continue
+		}
+		if option != nil {
+			return nil, errorsmod.Wrapf(ErrInvalidMultisig, "multiple %s extension options", MultisigExtensionOptionTypeURL)
+		}
+
+		option, ok = any.GetCachedValue().(*ExtensionOptionMultisig)
+		if !ok {
+			return nil, errorsmod.Wrap(ErrInvalidMultisig, "multisig extension option is not unpacked")
+		}
+	}
+
+	return option, nil
+}
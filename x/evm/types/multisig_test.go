@@ -0,0 +1,294 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AizelNetwork/CosmEvm/crypto/ethsecp256k1"
+)
+
+func TestExtensionOptionMultisig(t *testing.T) {
+	privKeys := make([]*ethsecp256k1.PrivKey, 3)
+	pubKeys := make([]cryptotypes.PubKey, 3)
+	for i := range privKeys {
+		privKey, err := ethsecp256k1.GenerateKey()
+		require.NoError(t, err)
+		privKeys[i] = privKey
+		pubKeys[i] = privKey.PubKey()
+	}
+
+	multisigKey := kmultisig.NewLegacyAminoPubKey(2, pubKeys)
+	pubKeyAny, err := codectypes.NewAnyWithValue(multisigKey)
+	require.NoError(t, err)
+
+	nestedKey := kmultisig.NewLegacyAminoPubKey(1, []cryptotypes.PubKey{pubKeys[0], multisigKey})
+	nestedAny, err := codectypes.NewAnyWithValue(nestedKey)
+	require.NoError(t, err)
+
+	singleAny, err := codectypes.NewAnyWithValue(pubKeys[0])
+	require.NoError(t, err)
+
+	signer := ethtypes.LatestSignerForChainID(big.NewInt(9000))
+	tx := ethtypes.NewTransaction(1, common.HexToAddress("0x01"), big.NewInt(1), 21000, big.NewInt(1), nil)
+	signBytes := MultisigSignBytes(signer, tx, "")
+
+	sign := func(t *testing.T, signBytes []byte, signers ...int) *ExtensionOptionMultisig {
+		bitarray := cryptotypes.NewCompactBitArray(len(pubKeys))
+		sigs := make([][]byte, 0, len(signers))
+		for _, i := range signers {
+			sig, err := privKeys[i].Sign(signBytes)
+			require.NoError(t, err)
+			bitarray.SetIndex(i, true)
+			sigs = append(sigs, sig)
+		}
+		return &ExtensionOptionMultisig{PubKey: pubKeyAny, Bitarray: bitarray, Signatures: sigs}
+	}
+
+	testCases := []struct {
+		name         string
+		option       *ExtensionOptionMultisig
+		expValidate  bool
+		expSignature bool
+	}{
+		{
+			"valid signatures of the threshold",
+			sign(t, signBytes, 0, 2),
+			true,
+			true,
+		},
+		{
+			"valid signatures of all keys",
+			sign(t, signBytes, 0, 1, 2),
+			true,
+			true,
+		},
+		{
+			"signatures over another tx",
+			sign(t, MultisigSignBytes(signer, ethtypes.NewTransaction(2, common.Address{}, nil, 0, nil, nil), ""), 0, 1),
+			true,
+			false,
+		},
+		{
+			"signatures below the threshold",
+			sign(t, signBytes, 1),
+			false,
+			false,
+		},
+		{
+			"signatures not matching the bit array",
+			func() *ExtensionOptionMultisig {
+				option := sign(t, signBytes, 0, 1)
+				option.Signatures = option.Signatures[:1]
+				return option
+			}(),
+			false,
+			false,
+		},
+		{
+			"bit array of another size",
+			func() *ExtensionOptionMultisig {
+				option := sign(t, signBytes, 0, 1)
+				option.Bitarray = cryptotypes.NewCompactBitArray(2)
+				return option
+			}(),
+			false,
+			false,
+		},
+		{
+			"nested multisig public key",
+			&ExtensionOptionMultisig{PubKey: nestedAny, Bitarray: cryptotypes.NewCompactBitArray(2)},
+			false,
+			false,
+		},
+		{
+			"single public key",
+			&ExtensionOptionMultisig{PubKey: singleAny},
+			false,
+			false,
+		},
+		{
+			"empty public key",
+			&ExtensionOptionMultisig{},
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.option.ValidateBasic()
+		if !tc.expValidate {
+			require.ErrorIs(t, err, ErrInvalidMultisig, tc.name)
+			continue
+		}
+		require.NoError(t, err, tc.name)
+
+		sender, err := tc.option.GetSender()
+		require.NoError(t, err, tc.name)
+		require.Equal(t, common.BytesToAddress(multisigKey.Address()), sender, tc.name)
+
+		err = tc.option.VerifySignature(signBytes)
+		if tc.expSignature {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, ErrInvalidMultisig, tc.name)
+		}
+	}
+}
+
+func TestMultisigSigner(t *testing.T) {
+	sender := common.HexToAddress("0x01")
+	signer := ethtypes.LatestSignerForChainID(big.NewInt(9000))
+	tx, err := ethtypes.NewTransaction(1, common.HexToAddress("0x02"), big.NewInt(1), 21000, big.NewInt(1), nil).
+		WithSignature(signer, MultisigSignature(sender))
+	require.NoError(t, err)
+	require.True(t, HasMultisigSignature(signer, tx, sender))
+	require.False(t, HasMultisigSignature(signer, tx, common.HexToAddress("0x03")))
+
+	multisigSigner := NewMultisigSigner(signer, sender)
+	from, err := multisigSigner.Sender(tx)
+	require.NoError(t, err)
+	require.Equal(t, sender, from)
+	require.Equal(t, signer.Hash(tx), multisigSigner.Hash(tx))
+
+	require.True(t, multisigSigner.Equal(NewMultisigSigner(signer, sender)))
+	require.False(t, multisigSigner.Equal(NewMultisigSigner(signer, common.HexToAddress("0x03"))))
+	require.False(t, multisigSigner.Equal(signer))
+}
+
+// extensionOptionsTx is a tx with the given extension options.
+type extensionOptionsTx struct {
+	sdk.Tx
+	options []*codectypes.Any
+}
+
+func (tx extensionOptionsTx) GetExtensionOptions() []*codectypes.Any { return tx.options }
+
+func TestGetTxSender(t *testing.T) {
+	privKeys := make([]*ethsecp256k1.PrivKey, 2)
+	pubKeys := make([]cryptotypes.PubKey, 2)
+	for i := range privKeys {
+		privKey, err := ethsecp256k1.GenerateKey()
+		require.NoError(t, err)
+		privKeys[i] = privKey
+		pubKeys[i] = privKey.PubKey()
+	}
+
+	multisigKey := kmultisig.NewLegacyAminoPubKey(2, pubKeys)
+	pubKeyAny, err := codectypes.NewAnyWithValue(multisigKey)
+	require.NoError(t, err)
+	sender := common.BytesToAddress(multisigKey.Address())
+
+	chainID := big.NewInt(9000)
+	unsignedTx := ethtypes.NewTransaction(1, common.HexToAddress("0x01"), big.NewInt(1), 21000, big.NewInt(1), nil)
+	tx, err := unsignedTx.WithSignature(ethtypes.LatestSignerForChainID(chainID), MultisigSignature(sender))
+	require.NoError(t, err)
+	msg := &MsgEthereumTx{}
+	require.NoError(t, msg.FromEthereumTx(tx))
+	// the from address of the message is never trusted
+	msg.From = common.HexToAddress("0x02").Hex()
+
+	newTx := func(t *testing.T, feeDenom string, signers ...int) sdk.Tx {
+		signBytes := MultisigSignBytes(ethtypes.LatestSignerForChainID(chainID), tx, feeDenom)
+		bitarray := cryptotypes.NewCompactBitArray(len(pubKeys))
+		sigs := make([][]byte, 0, len(signers))
+		for _, i := range signers {
+			sig, err := privKeys[i].Sign(signBytes)
+			require.NoError(t, err)
+			bitarray.SetIndex(i, true)
+			sigs = append(sigs, sig)
+		}
+
+		option, err := codectypes.NewAnyWithValue(&ExtensionOptionMultisig{PubKey: pubKeyAny, Bitarray: bitarray, Signatures: sigs})
+		require.NoError(t, err)
+		return extensionOptionsTx{options: []*codectypes.Any{option}}
+	}
+
+	from, err := GetTxSender(newTx(t, "", 0, 1), msg, chainID)
+	require.NoError(t, err)
+	require.Equal(t, sender, from)
+
+	_, err = GetTxSender(newTx(t, "", 0), msg, chainID)
+	require.ErrorIs(t, err, ErrInvalidMultisig)
+
+	// the fee denom is signed by the multisig keys, so it can't be added to
+	// the tx after signing
+	withFeeDenom := func(t *testing.T, tx sdk.Tx, feeDenom string) sdk.Tx {
+		option, err := codectypes.NewAnyWithValue(&ExtensionOptionFeeDenom{Denom: feeDenom})
+		require.NoError(t, err)
+		extTx := tx.(extensionOptionsTx)
+		return extensionOptionsTx{options: append(extTx.options, option)}
+	}
+
+	from, err = GetTxSender(withFeeDenom(t, newTx(t, "uatom", 0, 1), "uatom"), msg, chainID)
+	require.NoError(t, err)
+	require.Equal(t, sender, from)
+
+	_, err = GetTxSender(withFeeDenom(t, newTx(t, "", 0, 1), "uatom"), msg, chainID)
+	require.ErrorIs(t, err, ErrInvalidMultisig)
+
+	// the tx must carry the multisig signature of the sender
+	unsignedMsg := &MsgEthereumTx{}
+	require.NoError(t, unsignedMsg.FromEthereumTx(unsignedTx))
+	_, err = GetTxSender(newTx(t, "", 0, 1), unsignedMsg, chainID)
+	require.ErrorIs(t, err, ErrInvalidMultisig)
+
+	// without the multisig extension option, the multisig tx has no sender
+	_, err = GetTxSender(extensionOptionsTx{}, msg, chainID)
+	require.Error(t, err)
+}
+
+func TestMultisigTxHash(t *testing.T) {
+	privKeys := make([]*ethsecp256k1.PrivKey, 2)
+	pubKeys := make([]cryptotypes.PubKey, 2)
+	for i := range privKeys {
+		privKey, err := ethsecp256k1.GenerateKey()
+		require.NoError(t, err)
+		privKeys[i] = privKey
+		pubKeys[i] = privKey.PubKey()
+	}
+
+	signer := ethtypes.LatestSignerForChainID(big.NewInt(9000))
+	body := ethtypes.NewTransaction(1, common.HexToAddress("0x01"), big.NewInt(1), 21000, big.NewInt(1), nil)
+
+	// two multisig senders of the same keys send txs with the same body
+	newSenderTx := func(t *testing.T, threshold int) (*ethtypes.Transaction, *ExtensionOptionMultisig) {
+		multisigKey := kmultisig.NewLegacyAminoPubKey(threshold, pubKeys)
+		pubKeyAny, err := codectypes.NewAnyWithValue(multisigKey)
+		require.NoError(t, err)
+
+		tx, err := body.WithSignature(signer, MultisigSignature(common.BytesToAddress(multisigKey.Address())))
+		require.NoError(t, err)
+
+		bitarray := cryptotypes.NewCompactBitArray(len(pubKeys))
+		sigs := make([][]byte, 0, len(privKeys))
+		for i, privKey := range privKeys {
+			sig, err := privKey.Sign(MultisigSignBytes(signer, tx, ""))
+			require.NoError(t, err)
+			bitarray.SetIndex(i, true)
+			sigs = append(sigs, sig)
+		}
+		return tx, &ExtensionOptionMultisig{PubKey: pubKeyAny, Bitarray: bitarray, Signatures: sigs}
+	}
+
+	txA, optionA := newSenderTx(t, 1)
+	txB, optionB := newSenderTx(t, 2)
+	require.NotEqual(t, txA.Hash(), txB.Hash())
+
+	senderA, err := optionA.VerifySender(signer, txA, "")
+	require.NoError(t, err)
+	senderB, err := optionB.VerifySender(signer, txB, "")
+	require.NoError(t, err)
+	require.NotEqual(t, senderA, senderB)
+
+	// the tx of a sender can't be sent by the other one
+	_, err = optionB.VerifySender(signer, txA, "")
+	require.ErrorIs(t, err, ErrInvalidMultisig)
+}
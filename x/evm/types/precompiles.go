@@ -17,6 +17,9 @@ const (
 	GovPrecompileAddress          = "0x0000000000000000000000000000000000000805"
 	SlashingPrecompileAddress     = "0x0000000000000000000000000000000000000806"
 	EvidencePrecompileAddress     = "0x0000000000000000000000000000000000000807"
+	InflationPrecompileAddress    = "0x0000000000000000000000000000000000000808"
+	ICAPrecompileAddress          = "0x0000000000000000000000000000000000000809"
+	BatchPrecompileAddress        = "0x000000000000000000000000000000000000080a"
 )
 
 // AvailableStaticPrecompiles defines the full list of all available EVM extension addresses.
@@ -34,4 +37,7 @@ var AvailableStaticPrecompiles = []string{
 	GovPrecompileAddress,
 	SlashingPrecompileAddress,
 	EvidencePrecompileAddress,
+	InflationPrecompileAddress,
+	ICAPrecompileAddress,
+	BatchPrecompileAddress,
 }
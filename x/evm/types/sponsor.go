@@ -0,0 +1,131 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"bytes"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// SponsorExtensionOptionTypeURL is the type URL of the sponsor extension option.
+const SponsorExtensionOptionTypeURL = "/ethermint.evm.v1.ExtensionOptionSponsor"
+
+// sponsorContextKey is the context key used to store the sponsor of an
+// Ethereum tx.
+type sponsorContextKey struct{}
+
+// ContextWithSponsor returns a context that marks the execution of an Ethereum
+// tx whose fees are paid by the given sponsor. The leftover gas of the tx is
+// refunded to the sponsor.
+func ContextWithSponsor(ctx sdk.Context, sponsor sdk.AccAddress) sdk.Context {
+	return ctx.WithValue(sponsorContextKey{}, sponsor)
+}
+
+// SponsorFromContext returns the sponsor of the Ethereum tx executed with the
+// context, if any.
+func SponsorFromContext(ctx sdk.Context) (sdk.AccAddress, bool) {
+	sponsor, ok := ctx.Value(sponsorContextKey{}).(sdk.AccAddress)
+	return sponsor, ok && !sponsor.Empty()
+}
+
+// SponsorSignBytes returns the bytes signed by the sponsor of an Ethereum tx,
+// i.e. the keccak256 hash of the Ethereum tx hash and the fee limit.
+func SponsorSignBytes(txHash common.Hash, feeLimit sdkmath.Int) []byte {
+	return crypto.Keccak256(txHash.Bytes(), common.BigToHash(feeLimit.BigInt()).Bytes())
+}
+
+// ValidateBasic performs a stateless validation of the sponsor extension
+// option.
+func (o ExtensionOptionSponsor) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(o.Sponsor); err != nil {
+		return errorsmod.Wrapf(ErrInvalidSponsor, "invalid sponsor address: %s", err)
+	}
+
+	if o.FeeLimit.IsNil() || !o.FeeLimit.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidSponsor, "fee limit must be positive")
+	}
+
+	if len(o.Signature) > 0 && len(o.Signature) != crypto.SignatureLength {
+		return errorsmod.Wrapf(
+			ErrInvalidSponsor,
+			"invalid signature length, expected %d, got %d", crypto.SignatureLength, len(o.Signature),
+		)
+	}
+
+	return nil
+}
+
+// VerifySignature verifies that the signature of the extension option was
+// produced by the sponsor for the Ethereum tx with the given hash.
+func (o ExtensionOptionSponsor) VerifySignature(txHash common.Hash) error {
+	sponsor, err := sdk.AccAddressFromBech32(o.Sponsor)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidSponsor, "invalid sponsor address: %s", err)
+	}
+
+	pubKey, err := crypto.SigToPub(SponsorSignBytes(txHash, o.FeeLimit), o.Signature)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidSponsor, "failed to recover sponsor public key: %s", err)
+	}
+
+	if signer := crypto.PubkeyToAddress(*pubKey); !bytes.Equal(signer.Bytes(), sponsor) {
+		return errorsmod.Wrapf(
+			ErrInvalidSponsor,
+			"signature signer %s doesn't match sponsor %s", signer, common.BytesToAddress(sponsor),
+		)
+	}
+
+	return nil
+}
+
+// GetSponsorOption returns the sponsor extension option of an Ethereum tx, or
+// nil if the tx isn't sponsored.
+func GetSponsorOption(tx sdk.Tx) (*ExtensionOptionSponsor, error) {
+	option := &ExtensionOptionSponsor{}
+	found, err := getExtensionOption(tx, SponsorExtensionOptionTypeURL, option)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidSponsor, "sponsor extension option: %s", err)
+	}
+	if !found {
+		return nil, nil
+	}
+
+	return option, nil
+}
+
+// getExtensionOption unmarshals the extension option of the tx with the given
+// type URL into the option. It returns false if the tx doesn't have the
+// extension option and fails if the tx has it multiple times.
+func getExtensionOption(tx sdk.Tx, typeURL string, option proto.Message) (bool, error) {
+	extTx, ok := tx.(interface {
+		GetExtensionOptions() []*codectypes.Any
+	})
+	if !ok {
+		return false, nil
+	}
+
+	found := false
+	for _, any := range extTx.GetExtensionOptions() {
+		if any.GetTypeUrl() != typeURL {
+			continue
+		}
+		if found {
+			return false, fmt.Errorf("multiple %s extension options", typeURL)
+		}
+
+		if err := proto.Unmarshal(any.Value, option); err != nil {
+			return false, fmt.Errorf("failed to unmarshal %s: %w", typeURL, err)
+		}
+		found = true
+	}
+
+	return found, nil
+}
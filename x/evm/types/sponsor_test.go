@@ -0,0 +1,90 @@
+package types
+
+import (
+	"crypto/ecdsa"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtensionOptionSponsor(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	sponsor := sdk.AccAddress(crypto.PubkeyToAddress(key.PublicKey).Bytes())
+
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	txHash := common.HexToHash("0x01")
+	feeLimit := sdkmath.NewInt(1e18)
+
+	sign := func(t *testing.T, signKey *ecdsa.PrivateKey) []byte {
+		sig, err := crypto.Sign(SponsorSignBytes(txHash, feeLimit), signKey)
+		require.NoError(t, err)
+		return sig
+	}
+
+	testCases := []struct {
+		name         string
+		option       ExtensionOptionSponsor
+		expValidate  bool
+		expSignature bool
+	}{
+		{
+			"valid signature",
+			ExtensionOptionSponsor{Sponsor: sponsor.String(), FeeLimit: feeLimit, Signature: sign(t, key)},
+			true,
+			true,
+		},
+		{
+			"signature of another account",
+			ExtensionOptionSponsor{Sponsor: sponsor.String(), FeeLimit: feeLimit, Signature: sign(t, otherKey)},
+			true,
+			false,
+		},
+		{
+			"signature over another fee limit",
+			ExtensionOptionSponsor{Sponsor: sponsor.String(), FeeLimit: feeLimit.AddRaw(1), Signature: sign(t, key)},
+			true,
+			false,
+		},
+		{
+			"invalid sponsor address",
+			ExtensionOptionSponsor{Sponsor: "invalid", FeeLimit: feeLimit},
+			false,
+			false,
+		},
+		{
+			"zero fee limit",
+			ExtensionOptionSponsor{Sponsor: sponsor.String(), FeeLimit: sdkmath.ZeroInt()},
+			false,
+			false,
+		},
+		{
+			"invalid signature length",
+			ExtensionOptionSponsor{Sponsor: sponsor.String(), FeeLimit: feeLimit, Signature: []byte{1}},
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.option.ValidateBasic()
+		if !tc.expValidate {
+			require.ErrorIs(t, err, ErrInvalidSponsor, tc.name)
+			continue
+		}
+		require.NoError(t, err, tc.name)
+
+		err = tc.option.VerifySignature(txHash)
+		if tc.expSignature {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, ErrInvalidSponsor, tc.name)
+		}
+	}
+}
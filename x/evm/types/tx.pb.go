@@ -10,6 +10,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	types1 "github.com/cosmos/cosmos-sdk/crypto/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -296,6 +297,146 @@ func (m *ExtensionOptionsEthereumTx) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ExtensionOptionsEthereumTx proto.InternalMessageInfo
 
+// ExtensionOptionSponsor is an extension option for ethereum transactions whose
+// fees are paid by a sponsor instead of the sender
+type ExtensionOptionSponsor struct {
+	// sponsor is the bech32 address of the account that pays the fees
+	Sponsor string `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// fee_limit is the max fee paid by the sponsor, in the evm denom with 18
+	// decimals
+	FeeLimit cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=fee_limit,json=feeLimit,proto3,customtype=cosmossdk.io/math.Int" json:"fee_limit"`
+	// signature is the ethereum signature of the sponsor over the sponsor sign
+	// bytes. It's empty when the fees are paid with a fee allowance granted by
+	// the sponsor to the sender.
+	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *ExtensionOptionSponsor) Reset()         { *m = ExtensionOptionSponsor{} }
+func (m *ExtensionOptionSponsor) String() string { return proto.CompactTextString(m) }
+func (*ExtensionOptionSponsor) ProtoMessage()    {}
+func (*ExtensionOptionSponsor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{5}
+}
+func (m *ExtensionOptionSponsor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtensionOptionSponsor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtensionOptionSponsor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtensionOptionSponsor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtensionOptionSponsor.Merge(m, src)
+}
+func (m *ExtensionOptionSponsor) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtensionOptionSponsor) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtensionOptionSponsor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtensionOptionSponsor proto.InternalMessageInfo
+
+// ExtensionOptionFeeDenom is an extension option for ethereum transactions
+// whose fees are paid in one of the fee denoms accepted by the fee market
+// instead of the evm denom
+type ExtensionOptionFeeDenom struct {
+	// denom is the denomination used to pay the fees
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// signature is the Ethereum signature of the fee payer over the keccak256
+	// hash of the Ethereum tx hash and the denom. It is produced by the sponsor
+	// if it signs the tx, or else by the sender, unless the sender is a multisig
+	// account whose keys sign the denom with the tx.
+	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *ExtensionOptionFeeDenom) Reset()         { *m = ExtensionOptionFeeDenom{} }
+func (m *ExtensionOptionFeeDenom) String() string { return proto.CompactTextString(m) }
+func (*ExtensionOptionFeeDenom) ProtoMessage()    {}
+func (*ExtensionOptionFeeDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{6}
+}
+func (m *ExtensionOptionFeeDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtensionOptionFeeDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtensionOptionFeeDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtensionOptionFeeDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtensionOptionFeeDenom.Merge(m, src)
+}
+func (m *ExtensionOptionFeeDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtensionOptionFeeDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtensionOptionFeeDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtensionOptionFeeDenom proto.InternalMessageInfo
+
+// ExtensionOptionMultisig is an extension option for ethereum transactions
+// whose sender is a multisig account, signed by the threshold of its keys
+// instead. The ethereum transaction carries a placeholder signature whose R
+// value is the sender address and whose S value is zero
+type ExtensionOptionMultisig struct {
+	// pub_key is the LegacyAminoPubKey multisig public key of the sender
+	PubKey *types.Any `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// bitarray marks the keys of the multisig that signed the transaction
+	Bitarray *types1.CompactBitArray `protobuf:"bytes,2,opt,name=bitarray,proto3" json:"bitarray,omitempty"`
+	// signatures are the signatures of the marked keys over the signing hash of
+	// the ethereum transaction, in the order of the keys
+	Signatures [][]byte `protobuf:"bytes,3,rep,name=signatures,proto3" json:"signatures,omitempty"`
+}
+
+func (m *ExtensionOptionMultisig) Reset()         { *m = ExtensionOptionMultisig{} }
+func (m *ExtensionOptionMultisig) String() string { return proto.CompactTextString(m) }
+func (*ExtensionOptionMultisig) ProtoMessage()    {}
+func (*ExtensionOptionMultisig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{7}
+}
+func (m *ExtensionOptionMultisig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtensionOptionMultisig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtensionOptionMultisig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtensionOptionMultisig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtensionOptionMultisig.Merge(m, src)
+}
+func (m *ExtensionOptionMultisig) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtensionOptionMultisig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtensionOptionMultisig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtensionOptionMultisig proto.InternalMessageInfo
+
 // MsgEthereumTxResponse defines the Msg/EthereumTx response type.
 type MsgEthereumTxResponse struct {
 	// hash of the ethereum transaction in hex format. This hash differs from the
@@ -318,7 +459,7 @@ func (m *MsgEthereumTxResponse) Reset()         { *m = MsgEthereumTxResponse{} }
 func (m *MsgEthereumTxResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgEthereumTxResponse) ProtoMessage()    {}
 func (*MsgEthereumTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f75ac0a12d075f21, []int{5}
+	return fileDescriptor_f75ac0a12d075f21, []int{8}
 }
 func (m *MsgEthereumTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -360,7 +501,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f75ac0a12d075f21, []int{6}
+	return fileDescriptor_f75ac0a12d075f21, []int{9}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -412,7 +553,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f75ac0a12d075f21, []int{7}
+	return fileDescriptor_f75ac0a12d075f21, []int{10}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -447,6 +588,9 @@ func init() {
 	proto.RegisterType((*AccessListTx)(nil), "ethermint.evm.v1.AccessListTx")
 	proto.RegisterType((*DynamicFeeTx)(nil), "ethermint.evm.v1.DynamicFeeTx")
 	proto.RegisterType((*ExtensionOptionsEthereumTx)(nil), "ethermint.evm.v1.ExtensionOptionsEthereumTx")
+	proto.RegisterType((*ExtensionOptionSponsor)(nil), "ethermint.evm.v1.ExtensionOptionSponsor")
+	proto.RegisterType((*ExtensionOptionFeeDenom)(nil), "ethermint.evm.v1.ExtensionOptionFeeDenom")
+	proto.RegisterType((*ExtensionOptionMultisig)(nil), "ethermint.evm.v1.ExtensionOptionMultisig")
 	proto.RegisterType((*MsgEthereumTxResponse)(nil), "ethermint.evm.v1.MsgEthereumTxResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "ethermint.evm.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ethermint.evm.v1.MsgUpdateParamsResponse")
@@ -455,72 +599,85 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/tx.proto", fileDescriptor_f75ac0a12d075f21) }
 
 var fileDescriptor_f75ac0a12d075f21 = []byte{
-	// 1038 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x96, 0x4f, 0x6f, 0xe3, 0xc4,
-	0x1b, 0xc7, 0xeb, 0xc4, 0xf9, 0x37, 0xc9, 0xef, 0x47, 0xb1, 0x5a, 0xea, 0x64, 0x21, 0xce, 0x1a,
-	0x16, 0xd2, 0x4a, 0xb5, 0xd9, 0x22, 0x21, 0x6d, 0xb8, 0xd0, 0x6c, 0xbb, 0x68, 0x51, 0x2b, 0x56,
-	0x26, 0x7b, 0x41, 0x48, 0x61, 0xea, 0x4c, 0x9d, 0x11, 0xb1, 0xc7, 0xf2, 0x4c, 0xac, 0x84, 0x13,
-	0xda, 0x13, 0xe2, 0x84, 0xc4, 0x95, 0x03, 0x07, 0x0e, 0x2b, 0x4e, 0x3d, 0x2c, 0xbc, 0x86, 0x15,
-	0xa7, 0x15, 0x5c, 0x10, 0x87, 0x80, 0x52, 0x50, 0xa5, 0x1e, 0x79, 0x05, 0x68, 0x66, 0x9c, 0x26,
-	0x69, 0x68, 0xbb, 0xac, 0x04, 0x97, 0x6a, 0x9e, 0x79, 0xfe, 0xcc, 0x93, 0xcf, 0xf3, 0xed, 0x8c,
-	0x41, 0x19, 0xb1, 0x2e, 0x8a, 0x7c, 0x1c, 0x30, 0x1b, 0xc5, 0xbe, 0x1d, 0xdf, 0xb4, 0xd9, 0xc0,
-	0x0a, 0x23, 0xc2, 0x88, 0xb6, 0x7c, 0xe6, 0xb2, 0x50, 0xec, 0x5b, 0xf1, 0xcd, 0xca, 0xf3, 0xd0,
-	0xc7, 0x01, 0xb1, 0xc5, 0x5f, 0x19, 0x54, 0x59, 0x73, 0x09, 0xf5, 0x09, 0xb5, 0x7d, 0xea, 0xf1,
-	0x64, 0x9f, 0x7a, 0x89, 0xa3, 0x2c, 0x1d, 0x6d, 0x61, 0xd9, 0xd2, 0x48, 0x5c, 0x95, 0x85, 0x33,
-	0x79, 0x7d, 0xe9, 0x5b, 0xf1, 0x88, 0x47, 0x64, 0x0e, 0x5f, 0x25, 0xbb, 0x2f, 0x7a, 0x84, 0x78,
-	0x3d, 0x64, 0xc3, 0x10, 0xdb, 0x30, 0x08, 0x08, 0x83, 0x0c, 0x93, 0x60, 0x52, 0xaf, 0x9c, 0x78,
-	0x85, 0x75, 0xd0, 0x3f, 0xb4, 0x61, 0x30, 0x94, 0x2e, 0xf3, 0x3b, 0x05, 0xfc, 0x6f, 0x9f, 0x7a,
-	0xbb, 0xfc, 0x40, 0xd4, 0xf7, 0x5b, 0x03, 0xad, 0x0e, 0xd4, 0x0e, 0x64, 0x50, 0x57, 0x6a, 0x4a,
-	0xbd, 0xb8, 0xb5, 0x62, 0xc9, 0x5c, 0x6b, 0x92, 0x6b, 0x6d, 0x07, 0x43, 0x47, 0x44, 0x68, 0x55,
-	0xa0, 0x52, 0xfc, 0x09, 0xd2, 0x53, 0x35, 0xa5, 0xae, 0x34, 0xc1, 0xe9, 0xc8, 0x50, 0x36, 0x1f,
-	0x9e, 0x1c, 0x6d, 0x28, 0x8e, 0xd8, 0xd7, 0x5e, 0x01, 0x6a, 0x17, 0xd2, 0xae, 0x9e, 0xae, 0x29,
-	0xf5, 0x42, 0x73, 0xf9, 0xcf, 0x91, 0x91, 0x8b, 0x7a, 0x61, 0xc3, 0xdc, 0x34, 0x93, 0x28, 0xee,
-	0xd5, 0x34, 0xa0, 0x1e, 0x46, 0xc4, 0xd7, 0x55, 0x1e, 0xe5, 0x88, 0x75, 0xa3, 0xf6, 0xd9, 0xd7,
-	0xc6, 0xd2, 0xe7, 0x27, 0x47, 0x1b, 0x6b, 0x53, 0x12, 0x73, 0x5d, 0x9a, 0x0f, 0x53, 0x20, 0xbf,
-	0x87, 0x3c, 0xe8, 0x0e, 0x5b, 0x03, 0x6d, 0x05, 0x64, 0x02, 0x12, 0xb8, 0x48, 0xf4, 0xac, 0x3a,
-	0xd2, 0xd0, 0xde, 0x04, 0x05, 0x0f, 0x72, 0xbe, 0xd8, 0x95, 0x3d, 0x16, 0x9a, 0xe5, 0x5f, 0x46,
-	0xc6, 0xaa, 0x44, 0x4d, 0x3b, 0x1f, 0x5b, 0x98, 0xd8, 0x3e, 0x64, 0x5d, 0xeb, 0x6e, 0xc0, 0x9c,
-	0xbc, 0x07, 0xe9, 0x3d, 0x1e, 0xaa, 0x55, 0x41, 0xda, 0x83, 0x54, 0x74, 0xad, 0x36, 0x4b, 0xe3,
-	0x91, 0x91, 0x7f, 0x07, 0xd2, 0x3d, 0xec, 0x63, 0xe6, 0x70, 0x87, 0xf6, 0x7f, 0x90, 0x62, 0x24,
-	0x69, 0x37, 0xc5, 0x88, 0x76, 0x0b, 0x64, 0x62, 0xd8, 0xeb, 0x23, 0x3d, 0x23, 0xce, 0x78, 0xf9,
-	0xc2, 0x33, 0xc6, 0x23, 0x23, 0xbb, 0xed, 0x93, 0x7e, 0xc0, 0x1c, 0x99, 0xc1, 0x7f, 0xbb, 0x60,
-	0x9d, 0xad, 0x29, 0xf5, 0x52, 0x42, 0xb5, 0x04, 0x94, 0x58, 0xcf, 0x89, 0x0d, 0x25, 0xe6, 0x56,
-	0xa4, 0xe7, 0xa5, 0x15, 0x71, 0x8b, 0xea, 0x05, 0x69, 0xd1, 0xc6, 0x0d, 0x4e, 0xe9, 0x87, 0x47,
-	0x9b, 0xd9, 0xd6, 0x60, 0x07, 0x32, 0xc8, 0x79, 0x69, 0x53, 0x5e, 0x13, 0x3a, 0xe6, 0x28, 0x0d,
-	0x4a, 0xdb, 0xae, 0x8b, 0x28, 0xdd, 0xc3, 0x94, 0xb5, 0x06, 0xda, 0xbb, 0x20, 0xef, 0x76, 0x21,
-	0x0e, 0xda, 0xb8, 0x23, 0x88, 0x15, 0x9a, 0xf6, 0x65, 0x3d, 0xe7, 0x6e, 0xf3, 0xe0, 0xbb, 0x3b,
-	0xa7, 0x23, 0x23, 0xe7, 0xca, 0xa5, 0x93, 0x2c, 0x3a, 0x53, 0xf4, 0xa9, 0x0b, 0xd1, 0xa7, 0xff,
-	0x31, 0x7a, 0xf5, 0x72, 0xf4, 0x99, 0x45, 0xf4, 0xd9, 0x67, 0x46, 0x9f, 0x9b, 0x41, 0xff, 0x11,
-	0xc8, 0x43, 0x01, 0x0a, 0x51, 0x3d, 0x5f, 0x4b, 0xd7, 0x8b, 0x5b, 0x2f, 0x59, 0xe7, 0xff, 0xc7,
-	0x2d, 0x89, 0xb2, 0xd5, 0x0f, 0x7b, 0xa8, 0x79, 0xe3, 0xf1, 0xc8, 0x58, 0x3a, 0x1d, 0x19, 0x00,
-	0x9e, 0xf1, 0xfd, 0xf6, 0x57, 0x03, 0x4c, 0x69, 0x4b, 0xa1, 0x9f, 0x55, 0x95, 0xc3, 0x2d, 0xcc,
-	0x0d, 0x17, 0xcc, 0x0d, 0xb7, 0x38, 0x19, 0xee, 0xfa, 0xe2, 0x70, 0x5f, 0x98, 0x0e, 0x77, 0x76,
-	0x9e, 0xe6, 0x57, 0x2a, 0x28, 0xed, 0x0c, 0x03, 0xe8, 0x63, 0xf7, 0x0e, 0x42, 0xff, 0xc9, 0x80,
-	0x6f, 0x81, 0x22, 0x1f, 0x30, 0xc3, 0x61, 0xdb, 0x85, 0xe1, 0xd5, 0x23, 0xe6, 0x72, 0x68, 0xe1,
-	0xf0, 0x36, 0x0c, 0x27, 0xa9, 0x87, 0x08, 0x89, 0x54, 0xf5, 0x69, 0x52, 0xef, 0x20, 0xc4, 0x53,
-	0x13, 0x79, 0x64, 0x2e, 0x97, 0x47, 0x76, 0x51, 0x1e, 0xb9, 0x67, 0x96, 0x47, 0xfe, 0x02, 0x79,
-	0x14, 0xfe, 0x3d, 0x79, 0x80, 0x39, 0x79, 0x14, 0xe7, 0xe4, 0x51, 0x7a, 0x3a, 0x79, 0xcc, 0xaa,
-	0xc1, 0x34, 0x41, 0x65, 0x77, 0xc0, 0x50, 0x40, 0x31, 0x09, 0xde, 0x0b, 0xc5, 0xbb, 0x30, 0xbd,
-	0x48, 0x1b, 0x2a, 0x2f, 0x64, 0x7e, 0xa3, 0x80, 0xd5, 0xb9, 0x0b, 0xd6, 0x41, 0x34, 0x24, 0x01,
-	0x15, 0x20, 0xc4, 0x25, 0xae, 0xc8, 0xeb, 0x59, 0x5c, 0xd9, 0xeb, 0x40, 0xed, 0x11, 0x8f, 0xea,
-	0x29, 0x01, 0x61, 0x75, 0x11, 0xc2, 0x1e, 0xf1, 0x1c, 0x11, 0xa2, 0x2d, 0x83, 0x74, 0x84, 0x98,
-	0x10, 0x48, 0xc9, 0xe1, 0x4b, 0xad, 0x0c, 0xf2, 0xb1, 0xdf, 0x46, 0x51, 0x44, 0xa2, 0xe4, 0x12,
-	0xcd, 0xc5, 0xfe, 0x2e, 0x37, 0xb9, 0x8b, 0x4b, 0xa3, 0x4f, 0x51, 0x47, 0x0e, 0xd9, 0xc9, 0x79,
-	0x90, 0xde, 0xa7, 0xa8, 0x93, 0xb4, 0xf9, 0xbd, 0x02, 0x9e, 0xdb, 0xa7, 0xde, 0xfd, 0xb0, 0x03,
-	0x19, 0xba, 0x07, 0x23, 0xe8, 0x53, 0x7e, 0xd7, 0xc0, 0x3e, 0xeb, 0x92, 0x08, 0xb3, 0x61, 0xa2,
-	0x76, 0xfd, 0xc7, 0x47, 0x9b, 0x2b, 0xc9, 0x8b, 0xba, 0xdd, 0xe9, 0x44, 0x88, 0xd2, 0xf7, 0x59,
-	0x84, 0x03, 0xcf, 0x99, 0x86, 0x6a, 0x6f, 0x81, 0x6c, 0x28, 0x2a, 0x08, 0x65, 0x17, 0xb7, 0xf4,
-	0xc5, 0x9f, 0x21, 0x4f, 0x68, 0x16, 0xf8, 0x18, 0xe5, 0xa8, 0x92, 0x94, 0x86, 0xf5, 0xe0, 0xe4,
-	0x68, 0x63, 0x5a, 0x8c, 0xe3, 0xbf, 0x86, 0x62, 0xfe, 0xce, 0x0f, 0xc4, 0x93, 0x7d, 0xae, 0x49,
-	0xb3, 0x0c, 0xd6, 0xce, 0x6d, 0x4d, 0x00, 0x6f, 0xfd, 0xa1, 0x80, 0xf4, 0x3e, 0xf5, 0xb4, 0x21,
-	0x00, 0x33, 0xaf, 0xb0, 0xb1, 0xd8, 0xcd, 0xdc, 0x7c, 0x2a, 0xaf, 0x5d, 0x11, 0x30, 0xa9, 0x6f,
-	0x5e, 0x7f, 0xf0, 0xd3, 0xef, 0x5f, 0xa6, 0xae, 0x99, 0x65, 0x5b, 0x36, 0x38, 0xf9, 0xa2, 0x48,
-	0x22, 0xdb, 0x6c, 0xa0, 0x7d, 0x08, 0x4a, 0x73, 0x48, 0xaf, 0xff, 0x6d, 0xed, 0xd9, 0x90, 0xca,
-	0xfa, 0x95, 0x21, 0x93, 0x06, 0x2a, 0x99, 0x4f, 0x39, 0xba, 0xe6, 0xdb, 0x8f, 0xc7, 0x55, 0xe5,
-	0xc9, 0xb8, 0xaa, 0xfc, 0x36, 0xae, 0x2a, 0x5f, 0x1c, 0x57, 0x97, 0x9e, 0x1c, 0x57, 0x97, 0x7e,
-	0x3e, 0xae, 0x2e, 0x7d, 0xf0, 0xaa, 0x87, 0x59, 0xb7, 0x7f, 0x60, 0xb9, 0xc4, 0x9f, 0xf6, 0x48,
-	0xa8, 0x1d, 0x6f, 0xbd, 0x9e, 0xe0, 0x64, 0xc3, 0x10, 0xd1, 0x83, 0xac, 0xf8, 0x06, 0x79, 0xe3,
-	0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe7, 0x94, 0x7d, 0xc7, 0x93, 0x09, 0x00, 0x00,
+	// 1237 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xce, 0xda, 0x8e, 0x3f, 0x26, 0x06, 0xca, 0x28, 0x6d, 0xd6, 0x6e, 0xb1, 0xd3, 0x85, 0x0a,
+	0xb7, 0x28, 0xbb, 0x4a, 0x90, 0x90, 0x1a, 0x0e, 0x28, 0x4e, 0xd2, 0xaa, 0x34, 0x81, 0x6a, 0x9b,
+	0x5e, 0x10, 0x52, 0x18, 0xaf, 0x27, 0xeb, 0x51, 0xbd, 0x3b, 0xab, 0x9d, 0xf1, 0x62, 0xf7, 0x84,
+	0x7a, 0x42, 0x9c, 0x90, 0xb8, 0x72, 0xe0, 0xc0, 0xa1, 0xe2, 0x54, 0xa4, 0xc2, 0x6f, 0xa8, 0x7a,
+	0xaa, 0x40, 0x48, 0x88, 0x83, 0x41, 0x29, 0xa8, 0x52, 0x8e, 0xfc, 0x02, 0x34, 0x1f, 0xfe, 0x8a,
+	0x9b, 0xa4, 0x54, 0x82, 0x8b, 0x3d, 0xef, 0xbc, 0x1f, 0xfb, 0xee, 0xf3, 0x3c, 0x3b, 0xef, 0x80,
+	0x12, 0xe6, 0x2d, 0x1c, 0x07, 0x24, 0xe4, 0x0e, 0x4e, 0x02, 0x27, 0x59, 0x76, 0x78, 0xd7, 0x8e,
+	0x62, 0xca, 0x29, 0x3c, 0x35, 0x74, 0xd9, 0x38, 0x09, 0xec, 0x64, 0xb9, 0xfc, 0x2a, 0x0a, 0x48,
+	0x48, 0x1d, 0xf9, 0xab, 0x82, 0xca, 0x4b, 0x1e, 0x65, 0x01, 0x65, 0x8e, 0x17, 0xf7, 0x22, 0x4e,
+	0x9d, 0xa0, 0xd3, 0xe6, 0x84, 0x11, 0xdf, 0x49, 0x96, 0x1b, 0x98, 0xa3, 0xe5, 0xe1, 0x86, 0x0e,
+	0x5f, 0xd0, 0xe1, 0x01, 0x13, 0x21, 0xe2, 0x4f, 0x3b, 0x4a, 0xca, 0xb1, 0x2b, 0x2d, 0x47, 0x19,
+	0xda, 0x55, 0x9e, 0x6a, 0x51, 0xb4, 0xa3, 0x7c, 0xf3, 0x3e, 0xf5, 0xa9, 0xca, 0x11, 0x2b, 0xbd,
+	0x7b, 0xce, 0xa7, 0xd4, 0x6f, 0x63, 0x07, 0x45, 0xc4, 0x41, 0x61, 0x48, 0x39, 0xe2, 0x84, 0x86,
+	0x83, 0x7a, 0x25, 0xed, 0x95, 0x56, 0xa3, 0xb3, 0xe7, 0xa0, 0xb0, 0xa7, 0x5c, 0xd6, 0x0f, 0x06,
+	0x78, 0x69, 0x9b, 0xf9, 0x9b, 0xe2, 0x81, 0xb8, 0x13, 0xec, 0x74, 0x61, 0x0d, 0x64, 0x9a, 0x88,
+	0x23, 0xd3, 0x58, 0x34, 0x6a, 0x73, 0x2b, 0xf3, 0xb6, 0xca, 0xb5, 0x07, 0xb9, 0xf6, 0x5a, 0xd8,
+	0x73, 0x65, 0x04, 0xac, 0x80, 0x0c, 0x23, 0x77, 0xb0, 0x99, 0x5a, 0x34, 0x6a, 0x46, 0x1d, 0x1c,
+	0xf4, 0xab, 0xc6, 0xd2, 0xbd, 0xa7, 0xf7, 0x2f, 0x19, 0xae, 0xdc, 0x87, 0x6f, 0x80, 0x4c, 0x0b,
+	0xb1, 0x96, 0x99, 0x5e, 0x34, 0x6a, 0x85, 0xfa, 0xa9, 0xbf, 0xfb, 0xd5, 0x5c, 0xdc, 0x8e, 0x56,
+	0xad, 0x25, 0x4b, 0x47, 0x09, 0x2f, 0x84, 0x20, 0xb3, 0x17, 0xd3, 0xc0, 0xcc, 0x88, 0x28, 0x57,
+	0xae, 0x57, 0x17, 0x3f, 0xff, 0xa6, 0x3a, 0xf3, 0xc5, 0xd3, 0xfb, 0x97, 0x16, 0x46, 0x48, 0x4c,
+	0x74, 0x69, 0xdd, 0x4b, 0x81, 0xfc, 0x16, 0xf6, 0x91, 0xd7, 0xdb, 0xe9, 0xc2, 0x79, 0x30, 0x1b,
+	0xd2, 0xd0, 0xc3, 0xb2, 0xe7, 0x8c, 0xab, 0x0c, 0xf8, 0x0e, 0x28, 0xf8, 0x48, 0xe0, 0x4b, 0x3c,
+	0xd5, 0x63, 0xa1, 0x5e, 0xfa, 0xad, 0x5f, 0x3d, 0xad, 0xa0, 0x66, 0xcd, 0xdb, 0x36, 0xa1, 0x4e,
+	0x80, 0x78, 0xcb, 0xbe, 0x16, 0x72, 0x37, 0xef, 0x23, 0x76, 0x43, 0x84, 0xc2, 0x0a, 0x48, 0xfb,
+	0x88, 0xc9, 0xae, 0x33, 0xf5, 0xe2, 0x7e, 0xbf, 0x9a, 0xbf, 0x8a, 0xd8, 0x16, 0x09, 0x08, 0x77,
+	0x85, 0x03, 0xbe, 0x0c, 0x52, 0x9c, 0xea, 0x76, 0x53, 0x9c, 0xc2, 0xcb, 0x60, 0x36, 0x41, 0xed,
+	0x0e, 0x36, 0x67, 0xe5, 0x33, 0x5e, 0x3f, 0xf2, 0x19, 0xfb, 0xfd, 0x6a, 0x76, 0x2d, 0xa0, 0x9d,
+	0x90, 0xbb, 0x2a, 0x43, 0xbc, 0xbb, 0xc4, 0x3a, 0xbb, 0x68, 0xd4, 0x8a, 0x1a, 0xd5, 0x22, 0x30,
+	0x12, 0x33, 0x27, 0x37, 0x8c, 0x44, 0x58, 0xb1, 0x99, 0x57, 0x56, 0x2c, 0x2c, 0x66, 0x16, 0x94,
+	0xc5, 0x56, 0x2f, 0x08, 0x94, 0x1e, 0x3d, 0x58, 0xca, 0xee, 0x74, 0x37, 0x10, 0x47, 0x02, 0x2f,
+	0x38, 0xc2, 0x6b, 0x80, 0x8e, 0xd5, 0x4f, 0x83, 0xe2, 0x9a, 0xe7, 0x61, 0xc6, 0xb6, 0x08, 0xe3,
+	0x3b, 0x5d, 0xf8, 0x3e, 0xc8, 0x7b, 0x2d, 0x44, 0xc2, 0x5d, 0xd2, 0x94, 0x88, 0x15, 0xea, 0xce,
+	0x71, 0x3d, 0xe7, 0xd6, 0x45, 0xf0, 0xb5, 0x8d, 0x83, 0x7e, 0x35, 0xe7, 0xa9, 0xa5, 0xab, 0x17,
+	0xcd, 0x11, 0xf4, 0xa9, 0x23, 0xa1, 0x4f, 0xff, 0x6b, 0xe8, 0x33, 0xc7, 0x43, 0x3f, 0x3b, 0x0d,
+	0x7d, 0xf6, 0x85, 0xa1, 0xcf, 0x8d, 0x41, 0xff, 0x09, 0xc8, 0x23, 0x09, 0x14, 0x66, 0x66, 0x7e,
+	0x31, 0x5d, 0x9b, 0x5b, 0x79, 0xcd, 0x3e, 0x7c, 0x24, 0xd8, 0x0a, 0xca, 0x9d, 0x4e, 0xd4, 0xc6,
+	0xf5, 0x0b, 0x0f, 0xfb, 0xd5, 0x99, 0x83, 0x7e, 0x15, 0xa0, 0x21, 0xbe, 0xdf, 0xfd, 0x5e, 0x05,
+	0x23, 0xb4, 0x95, 0xd0, 0x87, 0x55, 0x15, 0xb9, 0x85, 0x09, 0x72, 0xc1, 0x04, 0xb9, 0x73, 0x03,
+	0x72, 0x2f, 0x4e, 0x93, 0x7b, 0x66, 0x44, 0xee, 0x38, 0x9f, 0xd6, 0xd7, 0x19, 0x50, 0xdc, 0xe8,
+	0x85, 0x28, 0x20, 0xde, 0x15, 0x8c, 0xff, 0x17, 0x82, 0x2f, 0x83, 0x39, 0x41, 0x30, 0x27, 0xd1,
+	0xae, 0x87, 0xa2, 0x93, 0x29, 0x16, 0x72, 0xd8, 0x21, 0xd1, 0x3a, 0x8a, 0x06, 0xa9, 0x7b, 0x18,
+	0xcb, 0xd4, 0xcc, 0xf3, 0xa4, 0x5e, 0xc1, 0x58, 0xa4, 0x6a, 0x79, 0xcc, 0x1e, 0x2f, 0x8f, 0xec,
+	0xb4, 0x3c, 0x72, 0x2f, 0x2c, 0x8f, 0xfc, 0x11, 0xf2, 0x28, 0xfc, 0x77, 0xf2, 0x00, 0x13, 0xf2,
+	0x98, 0x9b, 0x90, 0x47, 0xf1, 0xf9, 0xe4, 0x31, 0xae, 0x06, 0xcb, 0x02, 0xe5, 0xcd, 0x2e, 0xc7,
+	0x21, 0x23, 0x34, 0xfc, 0x30, 0x92, 0x73, 0x61, 0x74, 0x90, 0xae, 0x66, 0x44, 0x21, 0xeb, 0x7b,
+	0x03, 0x9c, 0x39, 0x14, 0x74, 0x33, 0xa2, 0x21, 0xa3, 0x31, 0x5c, 0x01, 0x39, 0xa6, 0x96, 0x5a,
+	0x4b, 0xe6, 0x4f, 0x0f, 0x96, 0xe6, 0xf5, 0xbc, 0x5a, 0x6b, 0x36, 0x63, 0xcc, 0xd8, 0x4d, 0x1e,
+	0x93, 0xd0, 0x77, 0x07, 0x81, 0xf0, 0x3d, 0x50, 0x10, 0xfc, 0xb6, 0x05, 0x35, 0xfa, 0xe8, 0xb5,
+	0x04, 0x16, 0x47, 0x12, 0xa0, 0x81, 0xd8, 0xc3, 0x58, 0xd2, 0x09, 0xcf, 0x81, 0x02, 0x23, 0x7e,
+	0x88, 0x78, 0x27, 0x56, 0x07, 0x48, 0xd1, 0x1d, 0x6d, 0xe8, 0x9e, 0x6f, 0x82, 0x85, 0x43, 0x2d,
+	0x5f, 0xc1, 0x78, 0x03, 0x87, 0x34, 0x10, 0xa2, 0x6d, 0x8a, 0x85, 0xea, 0xd8, 0x55, 0xc6, 0x64,
+	0xd1, 0xd4, 0xb3, 0x8b, 0xfe, 0x62, 0x4c, 0x55, 0xdd, 0xd6, 0x03, 0x1d, 0x5e, 0x05, 0xb9, 0xa8,
+	0xd3, 0xd8, 0xbd, 0x8d, 0x7b, 0xc7, 0x0d, 0xc7, 0xba, 0xf9, 0x68, 0x84, 0x8f, 0xba, 0x24, 0xd8,
+	0x37, 0x3a, 0x8d, 0xeb, 0xb8, 0xe7, 0x66, 0x23, 0xf9, 0x0f, 0xaf, 0x83, 0x7c, 0x83, 0x70, 0x14,
+	0xc7, 0xa8, 0x27, 0xfb, 0x98, 0x5b, 0x71, 0xec, 0xc9, 0x84, 0xe1, 0x25, 0x42, 0xdf, 0x2a, 0xec,
+	0x75, 0x1a, 0x44, 0xc8, 0xe3, 0x75, 0xc2, 0xd7, 0x44, 0x9a, 0x3b, 0x2c, 0x00, 0x2b, 0x00, 0x0c,
+	0x5f, 0x42, 0x4c, 0xad, 0x74, 0xad, 0xe8, 0x8e, 0xed, 0xe8, 0xf7, 0xfa, 0xd6, 0x00, 0xa7, 0x27,
+	0x26, 0xa8, 0x8b, 0x25, 0x59, 0x52, 0xe9, 0x72, 0x4a, 0x2b, 0xa8, 0xe4, 0x1a, 0x5e, 0x04, 0x99,
+	0x36, 0xf5, 0x99, 0x99, 0x92, 0x2a, 0x3f, 0x3d, 0xad, 0xf2, 0x2d, 0xea, 0xbb, 0x32, 0x04, 0x9e,
+	0x02, 0xe9, 0x18, 0x73, 0xcd, 0x91, 0x58, 0xc2, 0x12, 0xc8, 0x27, 0xc1, 0x2e, 0x8e, 0x63, 0x1a,
+	0xeb, 0x29, 0x99, 0x4b, 0x82, 0x4d, 0x61, 0x0a, 0x97, 0xf8, 0xf6, 0x3b, 0x0c, 0x37, 0xd5, 0x57,
+	0xec, 0xe6, 0x7c, 0xc4, 0x6e, 0x31, 0xdc, 0xd4, 0x6d, 0xfe, 0x68, 0x80, 0x57, 0xb6, 0x99, 0x7f,
+	0x2b, 0x6a, 0x22, 0x8e, 0x6f, 0xa0, 0x18, 0x05, 0x4c, 0x0c, 0x13, 0xd4, 0xe1, 0x2d, 0x1a, 0x13,
+	0xde, 0x3b, 0x51, 0x82, 0xa3, 0x50, 0xf8, 0x2e, 0xc8, 0x46, 0xb2, 0x82, 0xc6, 0xd8, 0x9c, 0x7e,
+	0x0d, 0xf5, 0x84, 0x7a, 0x41, 0x68, 0x53, 0x49, 0x50, 0xa7, 0xac, 0xda, 0x77, 0x9f, 0xde, 0xbf,
+	0x34, 0x2a, 0x26, 0xbe, 0xaf, 0xb3, 0x38, 0x11, 0x17, 0xb9, 0xae, 0xbc, 0x93, 0x1d, 0x6a, 0xd2,
+	0x2a, 0x81, 0x85, 0x43, 0x5b, 0x03, 0x80, 0x57, 0xfe, 0x32, 0x40, 0x7a, 0x9b, 0xf9, 0xb0, 0x07,
+	0xc0, 0xd8, 0x35, 0xab, 0x3a, 0xdd, 0xcd, 0x04, 0x3f, 0xe5, 0x37, 0x4f, 0x08, 0x18, 0xd4, 0xb7,
+	0xce, 0xdf, 0xfd, 0xf9, 0xcf, 0xaf, 0x52, 0x67, 0xad, 0x92, 0xa3, 0x1a, 0x1c, 0x5c, 0x19, 0x75,
+	0xe4, 0x2e, 0xef, 0xc2, 0x8f, 0x41, 0x71, 0x02, 0xd2, 0xf3, 0xcf, 0xac, 0x3d, 0x1e, 0x52, 0xbe,
+	0x78, 0x62, 0xc8, 0xa0, 0x81, 0xf2, 0xec, 0x67, 0x02, 0xba, 0xfa, 0xe6, 0xc3, 0xfd, 0x8a, 0xf1,
+	0x78, 0xbf, 0x62, 0xfc, 0xb1, 0x5f, 0x31, 0xbe, 0x7c, 0x52, 0x99, 0x79, 0xfc, 0xa4, 0x32, 0xf3,
+	0xeb, 0x93, 0xca, 0xcc, 0x47, 0x6f, 0xf9, 0x84, 0xb7, 0x3a, 0x0d, 0xdb, 0xa3, 0x81, 0xb3, 0x46,
+	0xee, 0xe0, 0xf6, 0x07, 0x98, 0x7f, 0x4a, 0xe3, 0xdb, 0xce, 0x3a, 0x65, 0xc1, 0x66, 0x12, 0x68,
+	0x4c, 0x79, 0x2f, 0xc2, 0xac, 0x91, 0x95, 0x1f, 0xd3, 0xdb, 0xff, 0x0c, 0x00, 0x23, 0x55, 0xc1,
+	0xff, 0xa8, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -628,6 +785,7 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethermint.evm.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1063,6 +1221,146 @@ func (m *ExtensionOptionsEthereumTx) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *ExtensionOptionSponsor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtensionOptionSponsor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtensionOptionSponsor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.FeeLimit.Size()
+		i -= size
+		if _, err := m.FeeLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExtensionOptionFeeDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtensionOptionFeeDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtensionOptionFeeDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExtensionOptionMultisig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtensionOptionMultisig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtensionOptionMultisig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signatures[iNdEx])
+			copy(dAtA[i:], m.Signatures[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signatures[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Bitarray != nil {
+		{
+			size, err := m.Bitarray.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PubKey != nil {
+		{
+			size, err := m.PubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgEthereumTxResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1386,6 +1684,65 @@ func (m *ExtensionOptionsEthereumTx) Size() (n int) {
 	return n
 }
 
+func (m *ExtensionOptionSponsor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.FeeLimit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *ExtensionOptionFeeDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *ExtensionOptionMultisig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PubKey != nil {
+		l = m.PubKey.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Bitarray != nil {
+		l = m.Bitarray.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Signatures) > 0 {
+		for _, b := range m.Signatures {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *MsgEthereumTxResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2817,6 +3174,426 @@ func (m *ExtensionOptionsEthereumTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ExtensionOptionSponsor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtensionOptionSponsor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtensionOptionSponsor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExtensionOptionFeeDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtensionOptionFeeDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtensionOptionFeeDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExtensionOptionMultisig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtensionOptionMultisig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtensionOptionMultisig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubKey == nil {
+				m.PubKey = &types.Any{}
+			}
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bitarray", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Bitarray == nil {
+				m.Bitarray = &types1.CompactBitArray{}
+			}
+			if err := m.Bitarray.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, make([]byte, postIndex-iNdEx))
+			copy(m.Signatures[len(m.Signatures)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -24,13 +24,23 @@ func InitGenesis(
 
 	k.SetBlockGasWanted(ctx, data.BlockGas)
 
+	if !data.LearningRate.IsNil() && data.LearningRate.IsPositive() {
+		k.SetLearningRate(ctx, data.LearningRate)
+	}
+
+	for _, price := range data.FeeDenomPrices {
+		k.SetFeeDenomPriceRecord(ctx, price)
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
 // ExportGenesis exports genesis state of the fee market module
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:   k.GetParams(ctx),
-		BlockGas: k.GetBlockGasWanted(ctx),
+		Params:         k.GetParams(ctx),
+		BlockGas:       k.GetBlockGasWanted(ctx),
+		LearningRate:   k.GetLearningRate(ctx),
+		FeeDenomPrices: k.GetFeeDenomPrices(ctx),
 	}
 }
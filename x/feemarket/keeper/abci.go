@@ -74,11 +74,29 @@ func (k *Keeper) EndBlock(ctx sdk.Context) error {
 	// gasWanted = max(gasWanted * MinGasMultiplier, gasUsed)
 	// this will be keep BaseFee protected from un-penalized manipulation
 	// more info here https://github.com/aizel/ethermint/pull/1105#discussion_r888798925
-	minGasMultiplier := k.GetParams(ctx).MinGasMultiplier
-	limitedGasWanted := math.LegacyNewDec(gasWanted.Int64()).Mul(minGasMultiplier)
+	params := k.GetParams(ctx)
+	limitedGasWanted := math.LegacyNewDec(gasWanted.Int64()).Mul(params.MinGasMultiplier)
 	updatedGasWanted := math.LegacyMaxDec(limitedGasWanted, math.LegacyNewDec(gasUsed.Int64())).TruncateInt().Uint64()
 	k.SetBlockGasWanted(ctx, updatedGasWanted)
 
+	// keep track of the base fee and gas wanted of the latest blocks for the
+	// moving window and AIMD base fee algorithms and the base fee history query
+	baseFee := params.BaseFee
+	if baseFee.IsNil() || params.NoBaseFee {
+		baseFee = math.LegacyZeroDec()
+	}
+	maxGas := int64(-1)
+	if consParams := ctx.ConsensusParams(); consParams.Block != nil {
+		maxGas = consParams.Block.MaxGas
+	}
+	k.SetBaseFeeRecord(ctx, types.BaseFeeRecord{
+		Height:    ctx.BlockHeight(),
+		BaseFee:   baseFee,
+		GasWanted: updatedGasWanted,
+		MaxGas:    maxGas,
+	})
+	k.pruneBaseFeeHistory(ctx, params.HistorySize)
+
 	defer func() {
 		telemetry.SetGauge(float32(updatedGasWanted), "feemarket", "block_gas")
 	}()
@@ -0,0 +1,131 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AizelNetwork/CosmEvm/x/feemarket/types"
+)
+
+// SetBaseFeeRecord stores the base fee and gas wanted of a block.
+func (k Keeper) SetBaseFeeRecord(ctx sdk.Context, record types.BaseFeeRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBaseFeeHistory)
+	bz := k.cdc.MustMarshal(&record)
+	store.Set(sdk.Uint64ToBigEndian(uint64(record.Height)), bz) //nolint:gosec // G115 -- block height is positive
+}
+
+// GetBaseFeeRecord returns the base fee and gas wanted of the block with the
+// given height.
+func (k Keeper) GetBaseFeeRecord(ctx sdk.Context, height int64) (types.BaseFeeRecord, bool) {
+	var record types.BaseFeeRecord
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBaseFeeHistory)
+	bz := store.Get(sdk.Uint64ToBigEndian(uint64(height))) //nolint:gosec // G115 -- block height is positive
+	if len(bz) == 0 {
+		return record, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// GetBaseFeeHistory returns the records of the latest count blocks in
+// ascending height order. A zero count returns the whole stored history.
+func (k Keeper) GetBaseFeeHistory(ctx sdk.Context, count uint64) []types.BaseFeeRecord {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBaseFeeHistory)
+	iterator := storetypes.KVStoreReversePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	history := []types.BaseFeeRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		if count != 0 && uint64(len(history)) >= count {
+			break
+		}
+
+		var record types.BaseFeeRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		history = append(history, record)
+	}
+
+	// reverse to ascending height order
+	for i, j := 0, len(history)-1; i < j; i, j = i+1, j-1 {
+		history[i], history[j] = history[j], history[i]
+	}
+
+	return history
+}
+
+// GetWindowGasWanted returns the average gas wanted of the latest window
+// blocks. If fewer blocks are stored, the average of the stored blocks is
+// returned.
+func (k Keeper) GetWindowGasWanted(ctx sdk.Context, window uint64) uint64 {
+	history := k.GetBaseFeeHistory(ctx, window)
+	if len(history) == 0 {
+		return k.GetBlockGasWanted(ctx)
+	}
+
+	total := math.ZeroInt()
+	for _, record := range history {
+		total = total.Add(math.NewIntFromUint64(record.GasWanted))
+	}
+
+	return total.QuoRaw(int64(len(history))).Uint64()
+}
+
+// pruneBaseFeeHistory removes the records of the blocks that are older than
+// the history size.
+func (k Keeper) pruneBaseFeeHistory(ctx sdk.Context, historySize uint64) {
+	height := ctx.BlockHeight()
+	if height <= 0 || uint64(height) <= historySize {
+		return
+	}
+	cutoff := uint64(height) - historySize
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBaseFeeHistory)
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		if sdk.BigEndianToUint64(iterator.Key()) > cutoff {
+			break
+		}
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetLearningRate returns the current learning rate of the AIMD base fee
+// algorithm. It defaults to the min learning rate parameter if it is not set.
+func (k Keeper) GetLearningRate(ctx sdk.Context) math.LegacyDec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPrefixLearningRate)
+	if len(bz) == 0 {
+		minLearningRate := k.GetParams(ctx).Aimd.MinLearningRate
+		if minLearningRate.IsNil() {
+			return math.LegacyZeroDec()
+		}
+		return minLearningRate
+	}
+
+	var learningRate math.LegacyDec
+	if err := learningRate.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return learningRate
+}
+
+// SetLearningRate stores the learning rate of the AIMD base fee algorithm.
+func (k Keeper) SetLearningRate(ctx sdk.Context, learningRate math.LegacyDec) {
+	bz, err := learningRate.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.KeyPrefixLearningRate, bz)
+}
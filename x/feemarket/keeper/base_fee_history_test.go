@@ -0,0 +1,168 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/AizelNetwork/CosmEvm/testutil/integration/aizel/network"
+	"github.com/AizelNetwork/CosmEvm/x/feemarket/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalculateBaseFeeAlgorithms(t *testing.T) {
+	var (
+		nw             *network.UnitTestNetwork
+		ctx            sdk.Context
+		initialBaseFee math.LegacyDec
+	)
+
+	testCases := []struct {
+		name                 string
+		malleate             func(params *types.Params)
+		parentBlockGasWanted uint64
+		expFee               func() math.LegacyDec
+		expLearningRate      math.LegacyDec
+	}{
+		{
+			"AIMD - full parent block increases the learning rate",
+			func(params *types.Params) {
+				params.BaseFeeAlgorithm = types.BASE_FEE_ALGORITHM_AIMD
+				params.WindowSize = 1
+				nw.App.FeeMarketKeeper.SetBaseFeeRecord(ctx, types.BaseFeeRecord{Height: ctx.BlockHeight() + 2, BaseFee: params.BaseFee, GasWanted: 100})
+			},
+			100,
+			func() math.LegacyDec { return initialBaseFee.Mul(math.LegacyNewDecWithPrec(1035, 3)) },
+			math.LegacyNewDecWithPrec(35, 3),
+		},
+		{
+			"AIMD - stable gas usage decreases the learning rate",
+			func(params *types.Params) {
+				params.BaseFeeAlgorithm = types.BASE_FEE_ALGORITHM_AIMD
+				params.WindowSize = 1
+				nw.App.FeeMarketKeeper.SetBaseFeeRecord(ctx, types.BaseFeeRecord{Height: ctx.BlockHeight() + 2, BaseFee: params.BaseFee, GasWanted: 60})
+				nw.App.FeeMarketKeeper.SetLearningRate(ctx, math.LegacyNewDecWithPrec(2, 1))
+			},
+			60,
+			func() math.LegacyDec { return initialBaseFee.Mul(math.LegacyNewDecWithPrec(1038, 3)) },
+			math.LegacyNewDecWithPrec(19, 2),
+		},
+		{
+			"AIMD - zero parent base fee increases by at least 1",
+			func(params *types.Params) {
+				params.BaseFeeAlgorithm = types.BASE_FEE_ALGORITHM_AIMD
+				params.WindowSize = 1
+				params.BaseFee = math.LegacyZeroDec()
+				params.MinGasPrice = math.LegacyZeroDec()
+				nw.App.FeeMarketKeeper.SetBaseFeeRecord(ctx, types.BaseFeeRecord{Height: ctx.BlockHeight() + 2, BaseFee: params.BaseFee, GasWanted: 100})
+			},
+			100,
+			func() math.LegacyDec { return math.LegacyOneDec() },
+			math.LegacyNewDecWithPrec(35, 3),
+		},
+		{
+			"moving window - average gas of the window equals the target",
+			func(params *types.Params) {
+				params.BaseFeeAlgorithm = types.BASE_FEE_ALGORITHM_MOVING_WINDOW
+				params.WindowSize = 2
+				nw.App.FeeMarketKeeper.SetBaseFeeRecord(ctx, types.BaseFeeRecord{Height: ctx.BlockHeight() + 1, BaseFee: params.BaseFee, GasWanted: 0})
+				nw.App.FeeMarketKeeper.SetBaseFeeRecord(ctx, types.BaseFeeRecord{Height: ctx.BlockHeight() + 2, BaseFee: params.BaseFee, GasWanted: 100})
+			},
+			100,
+			func() math.LegacyDec { return initialBaseFee },
+			math.LegacyDec{},
+		},
+		{
+			"max base fee bound",
+			func(params *types.Params) {
+				params.MaxBaseFee = params.BaseFee
+			},
+			100,
+			func() math.LegacyDec { return initialBaseFee },
+			math.LegacyDec{},
+		},
+		{
+			"min base fee bound",
+			func(params *types.Params) {
+				params.MinBaseFee = params.BaseFee.MulInt64(2)
+			},
+			50,
+			func() math.LegacyDec { return initialBaseFee.MulInt64(2) },
+			math.LegacyDec{},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// reset network and context
+			nw = network.NewUnitTestNetwork()
+			ctx = nw.GetContext()
+
+			params := nw.App.FeeMarketKeeper.GetParams(ctx)
+			tc.malleate(&params)
+			err := nw.App.FeeMarketKeeper.SetParams(ctx, params)
+			require.NoError(t, err)
+
+			initialBaseFee = params.BaseFee
+
+			ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 3)
+			nw.App.FeeMarketKeeper.SetBlockGasWanted(ctx, tc.parentBlockGasWanted)
+
+			// Set next block target/gasLimit through Consensus Param MaxGas
+			ctx = ctx.WithConsensusParams(tmproto.ConsensusParams{
+				Block: &tmproto.BlockParams{MaxGas: 100, MaxBytes: 10},
+			})
+
+			fee := nw.App.FeeMarketKeeper.CalculateBaseFee(ctx)
+			require.Equal(t, tc.expFee(), fee)
+			if !tc.expLearningRate.IsNil() {
+				require.Equal(t, tc.expLearningRate, nw.App.FeeMarketKeeper.GetLearningRate(ctx))
+			}
+		})
+	}
+}
+
+func TestBaseFeeHistory(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+	k := nw.App.FeeMarketKeeper
+
+	params := k.GetParams(ctx)
+	params.HistorySize = 2
+	params.WindowSize = 2
+	require.NoError(t, k.SetParams(ctx, params))
+
+	startHeight := ctx.BlockHeight() + 1
+	for i := int64(0); i < 3; i++ {
+		ctx = ctx.WithBlockHeight(startHeight + i).
+			WithBlockGasMeter(storetypes.NewGasMeter(uint64(1000000000))).
+			WithConsensusParams(tmproto.ConsensusParams{Block: &tmproto.BlockParams{MaxGas: 100}})
+		k.SetTransientBlockGasWanted(ctx, uint64(10*(i+1)))
+		require.NoError(t, k.EndBlock(ctx))
+	}
+
+	// the oldest record is pruned
+	_, found := k.GetBaseFeeRecord(ctx, startHeight)
+	require.False(t, found)
+
+	history := k.GetBaseFeeHistory(ctx, 0)
+	require.Len(t, history, 2)
+	require.Equal(t, startHeight+1, history[0].Height)
+	require.Equal(t, uint64(10), history[0].GasWanted)
+	require.Equal(t, startHeight+2, history[1].Height)
+	require.Equal(t, uint64(15), history[1].GasWanted)
+	require.Equal(t, int64(100), history[1].MaxGas)
+	require.Equal(t, params.BaseFee, history[1].BaseFee)
+
+	require.Equal(t, uint64(12), k.GetWindowGasWanted(ctx, params.WindowSize))
+
+	_, err := k.BaseFeeHistory(ctx, nil)
+	require.Error(t, err)
+
+	res, err := k.BaseFeeHistory(ctx, &types.QueryBaseFeeHistoryRequest{Count: 1})
+	require.NoError(t, err)
+	require.Equal(t, history[1:], res.History)
+	require.NotNil(t, res.NextBaseFee)
+	require.Equal(t, k.CalculateBaseFee(ctx.WithBlockHeight(ctx.BlockHeight()+1)), *res.NextBaseFee)
+}
@@ -7,10 +7,14 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/ethereum/go-ethereum/common/math"
+
+	"github.com/AizelNetwork/CosmEvm/x/feemarket/types"
 )
 
 // CalculateBaseFee calculates the base fee for the current block. This is only calculated once per
 // block during BeginBlock. If the NoBaseFee parameter is enabled or below activation height, this function returns nil.
+// The adjustment algorithm is selected by the BaseFeeAlgorithm parameter and the result is bounded by the
+// MinBaseFee and MaxBaseFee parameters. When the AIMD algorithm is selected, the updated learning rate is stored.
 // NOTE: This code is inspired from the go-ethereum EIP1559 implementation and adapted to Cosmos SDK-based
 // chains. For the canonical code refer to: https://github.com/ethereum/go-ethereum/blob/master/consensus/misc/eip1559.go
 func (k Keeper) CalculateBaseFee(ctx sdk.Context) sdkmath.LegacyDec {
@@ -39,8 +43,6 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) sdkmath.LegacyDec {
 		return sdkmath.LegacyDec{}
 	}
 
-	parentGasUsed := k.GetBlockGasWanted(ctx)
-
 	gasLimit := sdkmath.NewIntFromUint64(math.MaxUint64)
 
 	// NOTE: a MaxGas equal to -1 means that block gas is unlimited
@@ -55,6 +57,32 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) sdkmath.LegacyDec {
 		return sdkmath.LegacyDec{}
 	}
 
+	var baseFee sdkmath.LegacyDec
+	switch params.BaseFeeAlgorithm {
+	case types.BASE_FEE_ALGORITHM_AIMD:
+		var learningRate sdkmath.LegacyDec
+		baseFee, learningRate = k.calculateAIMDBaseFee(ctx, params, parentBaseFee, gasLimit, parentGasTargetInt)
+		k.SetLearningRate(ctx, learningRate)
+	case types.BASE_FEE_ALGORITHM_MOVING_WINDOW:
+		// smooth bursty block gas by using the average gas of the latest blocks
+		// instead of the gas of the parent block
+		windowGasUsed := k.GetWindowGasWanted(ctx, params.WindowSize)
+		baseFee = calculateEIP1559BaseFee(params, parentBaseFee, windowGasUsed, parentGasTargetInt)
+	default:
+		baseFee = calculateEIP1559BaseFee(params, parentBaseFee, k.GetBlockGasWanted(ctx), parentGasTargetInt)
+	}
+
+	return params.BoundBaseFee(baseFee)
+}
+
+// calculateEIP1559BaseFee adjusts the parent base fee following EIP-1559,
+// given the gas used and the gas target.
+func calculateEIP1559BaseFee(
+	params types.Params,
+	parentBaseFee sdkmath.LegacyDec,
+	parentGasUsed uint64,
+	parentGasTargetInt sdkmath.Int,
+) sdkmath.LegacyDec {
 	parentGasTarget := parentGasTargetInt.Uint64()
 	baseFeeChangeDenominator := sdkmath.NewIntFromUint64(uint64(params.BaseFeeChangeDenominator))
 
@@ -93,3 +121,60 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) sdkmath.LegacyDec {
 	// the min gas price don't even reach the mempool.
 	return sdkmath.LegacyMaxDec(parentBaseFee.Sub(baseFeeDelta), params.MinGasPrice)
 }
+
+// calculateAIMDBaseFee adjusts the parent base fee exponentially with an
+// adaptive learning rate, following the additive increase, multiplicative
+// decrease (AIMD) EIP-1559 variant:
+//
+//	utilization  = windowGasUsed / gasLimit
+//	learningRate = min(maxLR, learningRate + alpha)  if utilization <= gamma or utilization >= 1 - gamma
+//	learningRate = max(minLR, learningRate * beta)   otherwise
+//	baseFee      = parentBaseFee * (1 + learningRate * (parentGasUsed - gasTarget) / gasTarget)
+//	baseFee      = max(baseFee, parentBaseFee + 1)  if parentGasUsed > gasTarget
+//
+// The learning rate grows while blocks are consistently empty or full, so that
+// the base fee converges faster, and shrinks when the gas usage is stable to
+// dampen the oscillations caused by bursty blocks.
+func (k Keeper) calculateAIMDBaseFee(
+	ctx sdk.Context,
+	params types.Params,
+	parentBaseFee sdkmath.LegacyDec,
+	gasLimit sdkmath.Int,
+	parentGasTargetInt sdkmath.Int,
+) (sdkmath.LegacyDec, sdkmath.LegacyDec) {
+	aimd := params.Aimd
+	learningRate := k.GetLearningRate(ctx)
+
+	if gasLimit.IsPositive() {
+		utilization := sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(k.GetWindowGasWanted(ctx, params.WindowSize))).
+			QuoInt(gasLimit)
+
+		if utilization.LTE(aimd.Gamma) || utilization.GTE(sdkmath.LegacyOneDec().Sub(aimd.Gamma)) {
+			learningRate = sdkmath.LegacyMinDec(aimd.MaxLearningRate, learningRate.Add(aimd.Alpha))
+		} else {
+			learningRate = sdkmath.LegacyMaxDec(aimd.MinLearningRate, learningRate.Mul(aimd.Beta))
+		}
+	}
+
+	if parentGasTargetInt.IsZero() {
+		return sdkmath.LegacyZeroDec(), learningRate
+	}
+
+	parentGasUsed := sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(k.GetBlockGasWanted(ctx)))
+	parentGasTarget := sdkmath.LegacyNewDecFromInt(parentGasTargetInt)
+
+	// the adjustment is in the [-learningRate, learningRate * (elasticity - 1)] range
+	adjustment := learningRate.Mul(parentGasUsed.Sub(parentGasTarget)).Quo(parentGasTarget)
+	baseFee := parentBaseFee.Mul(sdkmath.LegacyOneDec().Add(adjustment))
+
+	// As in the EIP-1559 algorithm, the base fee increases by at least 1 when
+	// the parent block used more gas than its target, so that it can recover
+	// from zero.
+	if parentGasUsed.GT(parentGasTarget) {
+		baseFee = sdkmath.LegacyMaxDec(baseFee, parentBaseFee.Add(sdkmath.LegacyOneDec()))
+	}
+
+	// Set global min gas price as lower bound of the base fee, transactions below
+	// the min gas price don't even reach the mempool.
+	return sdkmath.LegacyMaxDec(baseFee, params.MinGasPrice), learningRate
+}
@@ -0,0 +1,91 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AizelNetwork/CosmEvm/x/feemarket/types"
+)
+
+// UpdateFeeDenomPrice updates the price of a fee denom with the time of the
+// current block. It is the entry point of the price feed, which is updated
+// through MsgSetFeeDenomPrice.
+func (k Keeper) UpdateFeeDenomPrice(ctx sdk.Context, denom string, price math.LegacyDec) error {
+	if !k.GetParams(ctx).IsFeeDenom(denom) {
+		return errorsmod.Wrapf(types.ErrInvalidFeeDenom, "%s is not a fee denom", denom)
+	}
+
+	feeDenomPrice := types.NewFeeDenomPrice(denom, price, ctx.BlockTime().Unix())
+	if err := feeDenomPrice.Validate(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidFeeDenom, err.Error())
+	}
+
+	k.SetFeeDenomPriceRecord(ctx, feeDenomPrice)
+	return nil
+}
+
+// SetFeeDenomPriceRecord stores the price of a fee denom.
+func (k Keeper) SetFeeDenomPriceRecord(ctx sdk.Context, price types.FeeDenomPrice) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeDenomPrice)
+	bz := k.cdc.MustMarshal(&price)
+	store.Set([]byte(price.Denom), bz)
+}
+
+// GetFeeDenomPrice returns the latest price of a fee denom.
+func (k Keeper) GetFeeDenomPrice(ctx sdk.Context, denom string) (types.FeeDenomPrice, bool) {
+	var price types.FeeDenomPrice
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeDenomPrice)
+	bz := store.Get([]byte(denom))
+	if len(bz) == 0 {
+		return price, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &price)
+	return price, true
+}
+
+// GetFeeDenomPrices returns the latest prices of all the fee denoms.
+func (k Keeper) GetFeeDenomPrices(ctx sdk.Context) []types.FeeDenomPrice {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeDenomPrice)
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	prices := []types.FeeDenomPrice{}
+	for ; iterator.Valid(); iterator.Next() {
+		var price types.FeeDenomPrice
+		k.cdc.MustUnmarshal(iterator.Value(), &price)
+		prices = append(prices, price)
+	}
+
+	return prices
+}
+
+// GetValidFeeDenomPrice returns the price of a denom accepted to pay fees. It
+// fails if the denom is not a fee denom or if its price is missing or older
+// than the max price age.
+func (k Keeper) GetValidFeeDenomPrice(ctx sdk.Context, denom string) (types.FeeDenomPrice, error) {
+	params := k.GetParams(ctx)
+	if !params.IsFeeDenom(denom) {
+		return types.FeeDenomPrice{}, errorsmod.Wrapf(types.ErrInvalidFeeDenom, "%s is not accepted to pay fees", denom)
+	}
+
+	price, found := k.GetFeeDenomPrice(ctx, denom)
+	if !found {
+		return types.FeeDenomPrice{}, errorsmod.Wrapf(types.ErrInvalidFeeDenom, "no price found for %s", denom)
+	}
+
+	age := ctx.BlockTime().Unix() - price.UpdateTime
+	if age < 0 || uint64(age) > params.MaxPriceAge {
+		return types.FeeDenomPrice{}, errorsmod.Wrapf(
+			types.ErrFeeDenomPriceStale,
+			"price of %s was updated %ds ago, max age %ds", denom, age, params.MaxPriceAge,
+		)
+	}
+
+	return price, nil
+}
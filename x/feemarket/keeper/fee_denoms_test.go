@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/AizelNetwork/CosmEvm/testutil/integration/aizel/network"
+	"github.com/AizelNetwork/CosmEvm/x/feemarket/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeDenomPrices(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+	k := nw.App.FeeMarketKeeper
+
+	// the price of a denom not accepted to pay fees cannot be set
+	err := k.UpdateFeeDenomPrice(ctx, "uusdc", math.LegacyNewDec(2))
+	require.ErrorIs(t, err, types.ErrInvalidFeeDenom)
+
+	params := k.GetParams(ctx)
+	params.FeeDenoms = []string{"uusdc"}
+	params.MaxPriceAge = 60
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// no price has been set yet
+	_, err = k.GetValidFeeDenomPrice(ctx, "uusdc")
+	require.ErrorIs(t, err, types.ErrInvalidFeeDenom)
+
+	// the price must be positive
+	err = k.UpdateFeeDenomPrice(ctx, "uusdc", math.LegacyZeroDec())
+	require.ErrorIs(t, err, types.ErrInvalidFeeDenom)
+
+	require.NoError(t, k.UpdateFeeDenomPrice(ctx, "uusdc", math.LegacyNewDec(2)))
+	require.Equal(t, []types.FeeDenomPrice{
+		types.NewFeeDenomPrice("uusdc", math.LegacyNewDec(2), ctx.BlockTime().Unix()),
+	}, k.GetFeeDenomPrices(ctx))
+
+	price, err := k.GetValidFeeDenomPrice(ctx, "uusdc")
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(2), price.Price)
+
+	// the price is valid up to the max price age
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(60 * time.Second))
+	_, err = k.GetValidFeeDenomPrice(ctx, "uusdc")
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second))
+	_, err = k.GetValidFeeDenomPrice(ctx, "uusdc")
+	require.ErrorIs(t, err, types.ErrFeeDenomPriceStale)
+
+	// a removed fee denom is rejected even with a stored price
+	params.FeeDenoms = nil
+	require.NoError(t, k.SetParams(ctx, params))
+	_, err = k.GetValidFeeDenomPrice(ctx, "uusdc")
+	require.ErrorIs(t, err, types.ErrInvalidFeeDenom)
+}
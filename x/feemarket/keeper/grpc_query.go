@@ -7,7 +7,10 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/AizelNetwork/CosmEvm/x/feemarket/types"
 )
@@ -48,3 +51,41 @@ func (k Keeper) BlockGas(c context.Context, _ *types.QueryBlockGasRequest) (*typ
 		Gas: gas.Int64(),
 	}, nil
 }
+
+// BaseFeeHistory implements the Query/BaseFeeHistory gRPC method
+func (k Keeper) BaseFeeHistory(c context.Context, req *types.QueryBaseFeeHistoryRequest) (*types.QueryBaseFeeHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	history := k.GetBaseFeeHistory(ctx, req.Count)
+
+	// query contexts don't include the consensus params, so the block max gas
+	// of the latest block is used to calculate the base fee of the next block
+	if consParams := ctx.ConsensusParams(); consParams.Block == nil && len(history) > 0 {
+		consParams.Block = &cmtproto.BlockParams{MaxGas: history[len(history)-1].MaxGas}
+		ctx = ctx.WithConsensusParams(consParams)
+	}
+
+	res := &types.QueryBaseFeeHistoryResponse{History: history}
+
+	// calculate the base fee of the next block on a cached context, so that the
+	// state changes of the calculation are discarded
+	cacheCtx, _ := ctx.CacheContext()
+	nextBaseFee := k.CalculateBaseFee(cacheCtx.WithBlockHeight(ctx.BlockHeight() + 1))
+	if !nextBaseFee.IsNil() {
+		res.NextBaseFee = &nextBaseFee
+	}
+
+	return res, nil
+}
+
+// FeeDenomPrices implements the Query/FeeDenomPrices gRPC method
+func (k Keeper) FeeDenomPrices(c context.Context, _ *types.QueryFeeDenomPricesRequest) (*types.QueryFeeDenomPricesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryFeeDenomPricesResponse{
+		Prices: k.GetFeeDenomPrices(ctx),
+	}, nil
+}
@@ -27,3 +27,20 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// SetFeeDenomPrice implements the gRPC MsgServer interface. It updates the
+// price of a fee denom with the time of the current block. The update can only
+// be performed if the requested authority is the Cosmos SDK governance module
+// account.
+func (k *Keeper) SetFeeDenomPrice(goCtx context.Context, req *types.MsgSetFeeDenomPrice) (*types.MsgSetFeeDenomPriceResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.UpdateFeeDenomPrice(ctx, req.Denom, req.Price); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetFeeDenomPriceResponse{}, nil
+}
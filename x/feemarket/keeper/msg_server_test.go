@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/AizelNetwork/CosmEvm/testutil/integration/aizel/network"
 	"github.com/AizelNetwork/CosmEvm/x/feemarket/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -52,3 +53,56 @@ func TestUpdateParams(t *testing.T) {
 		})
 	}
 }
+
+func TestSetFeeDenomPrice(t *testing.T) {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name      string
+		request   *types.MsgSetFeeDenomPrice
+		expectErr error
+	}{
+		{
+			name:      "fail - invalid authority",
+			request:   &types.MsgSetFeeDenomPrice{Authority: "foobar", Denom: "uusdc", Price: math.LegacyNewDec(2)},
+			expectErr: govtypes.ErrInvalidSigner,
+		},
+		{
+			name:      "fail - not a fee denom",
+			request:   &types.MsgSetFeeDenomPrice{Authority: authority, Denom: "uatom", Price: math.LegacyNewDec(2)},
+			expectErr: types.ErrInvalidFeeDenom,
+		},
+		{
+			name:      "fail - non-positive price",
+			request:   &types.MsgSetFeeDenomPrice{Authority: authority, Denom: "uusdc", Price: math.LegacyZeroDec()},
+			expectErr: types.ErrInvalidFeeDenom,
+		},
+		{
+			name:    "pass - valid price update",
+			request: &types.MsgSetFeeDenomPrice{Authority: authority, Denom: "uusdc", Price: math.LegacyNewDec(2)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			nw := network.NewUnitTestNetwork()
+			ctx := nw.GetContext()
+			k := nw.App.FeeMarketKeeper
+
+			params := k.GetParams(ctx)
+			params.FeeDenoms = []string{"uusdc"}
+			require.NoError(t, k.SetParams(ctx, params))
+
+			_, err := k.SetFeeDenomPrice(ctx, tc.request)
+			if tc.expectErr != nil {
+				require.ErrorIs(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+
+			price, found := k.GetFeeDenomPrice(ctx, tc.request.Denom)
+			require.True(t, found)
+			require.Equal(t, types.NewFeeDenomPrice(tc.request.Denom, tc.request.Price, ctx.BlockTime().Unix()), price)
+		})
+	}
+}
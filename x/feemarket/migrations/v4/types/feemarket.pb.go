@@ -1,25 +1,25 @@
 // Code generated by protoc-gen-gogo. DO NOT EDIT.
-// source: ethermint/feemarket/v1/feemarket.proto
+// source: ethermint/feemarket/migrations/v4/feemarket.proto
+
+// NOTE: the legacy types use their own package so that they don't conflict
+// with the current x/feemarket types in the proto registry.
 
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
 	math_bits "math/bits"
-
-	cosmossdk_io_math "cosmossdk.io/math"
-	_ "github.com/cosmos/gogoproto/gogoproto"
-	proto "github.com/cosmos/gogoproto/proto"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
-var (
-	_ = proto.Marshal
-	_ = fmt.Errorf
-	_ = math.Inf
-)
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -40,25 +40,23 @@ type Params struct {
 	// enable_height defines at which block height the base fee calculation is enabled.
 	EnableHeight int64 `protobuf:"varint,5,opt,name=enable_height,json=enableHeight,proto3" json:"enable_height,omitempty"`
 	// base_fee for EIP-1559 blocks.
-	BaseFee cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=base_fee,json=baseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"base_fee"`
+	BaseFee cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.Int" json:"base_fee"`
 	// min_gas_price defines the minimum gas price value for cosmos and eth transactions
-	MinGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=min_gas_price,json=minGasPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_gas_price"`
+	MinGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=min_gas_price,json=minGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_gas_price"`
 	// min_gas_multiplier bounds the minimum gas used to be charged
 	// to senders based on gas limit
-	MinGasMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=min_gas_multiplier,json=minGasMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_gas_multiplier"`
+	MinGasMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=min_gas_multiplier,json=minGasMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_gas_multiplier"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4feb8b20cf98e6e1, []int{0}
+	return fileDescriptor_f8c7116149ce51cc, []int{0}
 }
-
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-
 func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
 		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
@@ -71,15 +69,12 @@ func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-
 func (m *Params) XXX_Merge(src proto.Message) {
 	xxx_messageInfo_Params.Merge(m, src)
 }
-
 func (m *Params) XXX_Size() int {
 	return m.Size()
 }
-
 func (m *Params) XXX_DiscardUnknown() {
 	xxx_messageInfo_Params.DiscardUnknown(m)
 }
@@ -115,40 +110,42 @@ func (m *Params) GetEnableHeight() int64 {
 }
 
 func init() {
-	proto.RegisterType((*Params)(nil), "ethermint.feemarket.v1.Params")
+	proto.RegisterType((*Params)(nil), "ethermint.feemarket.migrations.v4.Params")
 }
 
 func init() {
-	proto.RegisterFile("ethermint/feemarket/v1/feemarket.proto", fileDescriptor_4feb8b20cf98e6e1)
+	proto.RegisterFile("ethermint/feemarket/migrations/v4/feemarket.proto", fileDescriptor_f8c7116149ce51cc)
 }
 
-var fileDescriptor_4feb8b20cf98e6e1 = []byte{
-	// 388 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0xc1, 0x8a, 0xdb, 0x30,
-	0x14, 0xb4, 0x9a, 0xdd, 0xac, 0x57, 0xdb, 0x40, 0x10, 0xdb, 0x62, 0x5a, 0xf0, 0x9a, 0x16, 0x16,
-	0x1f, 0x5a, 0x9b, 0x65, 0xcf, 0xbd, 0xa4, 0x21, 0x6d, 0x0a, 0x85, 0xe0, 0x63, 0x29, 0x08, 0xd9,
-	0x79, 0xb1, 0x45, 0x2c, 0xc9, 0x58, 0x4a, 0x68, 0xfe, 0xa2, 0x9f, 0x95, 0x63, 0x4e, 0xa5, 0xf4,
-	0x10, 0x4a, 0xf2, 0x23, 0x25, 0x76, 0x62, 0xe7, 0xda, 0x3d, 0x49, 0x7a, 0x33, 0x9a, 0x79, 0xd2,
-	0x1b, 0x7c, 0x0f, 0x26, 0x83, 0x52, 0x70, 0x69, 0xc2, 0x19, 0x80, 0x60, 0xe5, 0x1c, 0x4c, 0xb8,
-	0x7c, 0x68, 0x0f, 0x41, 0x51, 0x2a, 0xa3, 0xc8, 0xcb, 0x86, 0x17, 0xb4, 0xd0, 0xf2, 0xe1, 0xd5,
-	0x6d, 0xaa, 0x52, 0x55, 0x51, 0xc2, 0xc3, 0xae, 0x66, 0xbf, 0xf9, 0xd5, 0xc1, 0xdd, 0x09, 0x2b,
-	0x99, 0xd0, 0xc4, 0xc5, 0x37, 0x52, 0xd1, 0x98, 0x69, 0xa0, 0x33, 0x00, 0x07, 0x79, 0xc8, 0xb7,
-	0xa3, 0x6b, 0xa9, 0x06, 0x4c, 0xc3, 0x08, 0x80, 0x7c, 0xc0, 0xaf, 0x4f, 0x20, 0x4d, 0x32, 0x26,
-	0x53, 0xa0, 0x53, 0x90, 0x4a, 0x70, 0xc9, 0x8c, 0x2a, 0x9d, 0x67, 0x1e, 0xf2, 0x7b, 0x91, 0x13,
-	0xd7, 0xec, 0x8f, 0x15, 0x61, 0xd8, 0xe2, 0xe4, 0x11, 0xbf, 0x80, 0x9c, 0x69, 0xc3, 0x13, 0x6e,
-	0x56, 0x54, 0x2c, 0x72, 0xc3, 0x8b, 0x9c, 0x43, 0xe9, 0x74, 0xaa, 0x8b, 0xb7, 0x2d, 0xf8, 0xb5,
-	0xc1, 0xc8, 0x5b, 0xdc, 0x03, 0xc9, 0xe2, 0x1c, 0x68, 0x06, 0x3c, 0xcd, 0x8c, 0x73, 0xe9, 0x21,
-	0xbf, 0x13, 0x3d, 0xaf, 0x8b, 0x9f, 0xab, 0x1a, 0x19, 0x63, 0xbb, 0xe9, 0xba, 0xeb, 0x21, 0xff,
-	0x7a, 0x10, 0xac, 0xb7, 0x77, 0xd6, 0x9f, 0xed, 0xdd, 0x7d, 0xca, 0x4d, 0xb6, 0x88, 0x83, 0x44,
-	0x89, 0x30, 0x51, 0x5a, 0x28, 0x7d, 0x5c, 0xde, 0xeb, 0xe9, 0x3c, 0x34, 0xab, 0x02, 0x74, 0x30,
-	0x96, 0x26, 0xba, 0x3a, 0x76, 0x4d, 0x22, 0xdc, 0x13, 0x5c, 0xd2, 0x94, 0x69, 0x5a, 0x94, 0x3c,
-	0x01, 0xe7, 0xea, 0xbf, 0xf5, 0x86, 0x90, 0x44, 0x37, 0x82, 0xcb, 0x4f, 0x4c, 0x4f, 0x0e, 0x12,
-	0xe4, 0x3b, 0x26, 0x27, 0xcd, 0xb3, 0x57, 0xdb, 0x4f, 0x12, 0xee, 0xd7, 0xc2, 0xed, 0x0f, 0x7d,
-	0xb9, 0xb0, 0x2f, 0xfa, 0x97, 0x51, 0x9f, 0x4b, 0x6e, 0x38, 0xcb, 0x9b, 0xf1, 0x0d, 0x46, 0xeb,
-	0x9d, 0x8b, 0x36, 0x3b, 0x17, 0xfd, 0xdd, 0xb9, 0xe8, 0xe7, 0xde, 0xb5, 0x36, 0x7b, 0xd7, 0xfa,
-	0xbd, 0x77, 0xad, 0x6f, 0xef, 0xce, 0xbc, 0x60, 0x79, 0xb0, 0x6a, 0x93, 0xf5, 0xe3, 0x2c, 0x5b,
-	0x95, 0x6b, 0xdc, 0xad, 0x72, 0xf2, 0xf8, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xa5, 0xce, 0xeb, 0x97,
-	0x7f, 0x02, 0x00, 0x00,
+var fileDescriptor_f8c7116149ce51cc = []byte{
+	// 419 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0xb3, 0xa6, 0x4d, 0xd3, 0xa9, 0x81, 0x30, 0xb4, 0xb0, 0x58, 0xdc, 0x46, 0x7b, 0xc9,
+	0x69, 0x17, 0x69, 0x0f, 0x5e, 0x44, 0x4c, 0xab, 0x55, 0x51, 0xa9, 0x7b, 0xd3, 0xcb, 0x30, 0xbb,
+	0x7d, 0x9d, 0x7d, 0x64, 0x67, 0x26, 0xcc, 0xbc, 0x46, 0xe3, 0xa7, 0xf0, 0x9b, 0xf8, 0x35, 0x7a,
+	0xec, 0x51, 0x3c, 0x14, 0x49, 0xbe, 0x88, 0x74, 0xd3, 0xec, 0x0a, 0x22, 0xf4, 0x36, 0xfc, 0xff,
+	0xff, 0xdf, 0x9f, 0xf7, 0x98, 0xc7, 0x9e, 0x00, 0x15, 0xe0, 0x34, 0x1a, 0x4a, 0xce, 0x01, 0xb4,
+	0x74, 0x63, 0xa0, 0x44, 0xa3, 0x72, 0x92, 0xd0, 0x1a, 0x9f, 0x4c, 0x0f, 0x1b, 0x3d, 0x9e, 0x38,
+	0x4b, 0x96, 0x3f, 0xaa, 0x91, 0xb8, 0xb1, 0x1a, 0x24, 0x9e, 0x1e, 0x3e, 0xd8, 0x56, 0x56, 0xd9,
+	0x2a, 0x9d, 0xdc, 0xbc, 0x96, 0xe0, 0xe3, 0x1f, 0x6d, 0xd6, 0x39, 0x95, 0x4e, 0x6a, 0xcf, 0x23,
+	0xb6, 0x65, 0xac, 0xc8, 0xa4, 0x07, 0x71, 0x0e, 0x10, 0x06, 0x83, 0x60, 0xd8, 0x4d, 0x37, 0x8d,
+	0x1d, 0x49, 0x0f, 0xaf, 0x00, 0xf8, 0x33, 0xb6, 0xbb, 0x32, 0x45, 0x5e, 0x48, 0xa3, 0x40, 0x9c,
+	0x81, 0xb1, 0x1a, 0x8d, 0x24, 0xeb, 0xc2, 0x7b, 0x83, 0x60, 0xd8, 0x4b, 0xc3, 0x6c, 0x99, 0x3e,
+	0xaa, 0x02, 0xc7, 0x8d, 0xcf, 0x0f, 0xd8, 0x0e, 0x94, 0xd2, 0x13, 0xe6, 0x48, 0x33, 0xa1, 0x2f,
+	0x4a, 0xc2, 0x49, 0x89, 0xe0, 0xc2, 0x76, 0x05, 0x6e, 0x37, 0xe6, 0xfb, 0xda, 0xe3, 0xfb, 0xac,
+	0x07, 0x46, 0x66, 0x25, 0x88, 0x02, 0x50, 0x15, 0x14, 0xae, 0x0f, 0x82, 0x61, 0x3b, 0xbd, 0xbf,
+	0x14, 0x5f, 0x57, 0x1a, 0x7f, 0xca, 0xba, 0xf5, 0xd4, 0x9d, 0x41, 0x30, 0xdc, 0x1c, 0x3d, 0xbc,
+	0xbc, 0xde, 0x6b, 0xfd, 0xba, 0xde, 0xdb, 0xc9, 0xad, 0xd7, 0xd6, 0xfb, 0xb3, 0x71, 0x8c, 0x36,
+	0xd1, 0x92, 0x8a, 0xf8, 0x8d, 0xa1, 0x74, 0xe3, 0x76, 0x48, 0x7e, 0xc2, 0x7a, 0x1a, 0x8d, 0x50,
+	0xd2, 0x8b, 0x89, 0xc3, 0x1c, 0xc2, 0x8d, 0x0a, 0xdf, 0xbf, 0xc5, 0x77, 0xff, 0xc5, 0xdf, 0x81,
+	0x92, 0xf9, 0xec, 0x18, 0xf2, 0x74, 0x4b, 0xa3, 0x39, 0x91, 0xfe, 0xf4, 0x86, 0xe3, 0x1f, 0x19,
+	0x5f, 0x15, 0xfd, 0xb5, 0x59, 0xf7, 0xee, 0x6d, 0xfd, 0x65, 0x5b, 0xb3, 0xfa, 0xdb, 0xb5, 0xee,
+	0x5a, 0x7f, 0x3d, 0xed, 0xa3, 0x41, 0x42, 0x59, 0xd6, 0xff, 0x32, 0xfa, 0x74, 0x39, 0x8f, 0x82,
+	0xab, 0x79, 0x14, 0xfc, 0x9e, 0x47, 0xc1, 0xf7, 0x45, 0xd4, 0xba, 0x5a, 0x44, 0xad, 0x9f, 0x8b,
+	0xa8, 0xf5, 0xf9, 0xb9, 0x42, 0x2a, 0x2e, 0xb2, 0x38, 0xb7, 0x3a, 0x79, 0x81, 0xdf, 0xa0, 0xfc,
+	0x00, 0xf4, 0xc5, 0xba, 0x71, 0x72, 0x64, 0xbd, 0x7e, 0x39, 0xd5, 0xc9, 0xd7, 0xff, 0xde, 0x13,
+	0xcd, 0x26, 0xe0, 0xb3, 0x4e, 0x75, 0x13, 0x07, 0x7f, 0x06, 0x00, 0x4b, 0xbb, 0x70, 0x6d, 0x81,
+	0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -240,7 +237,6 @@ func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
-
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
@@ -271,11 +267,9 @@ func (m *Params) Size() (n int) {
 func sovFeemarket(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
-
 func sozFeemarket(x uint64) (n int) {
 	return sovFeemarket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-
 func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -505,7 +499,6 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-
 func skipFeemarket(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
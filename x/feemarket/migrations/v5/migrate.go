@@ -3,6 +3,8 @@
 package v5
 
 import (
+	"fmt"
+
 	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	typesV4 "github.com/AizelNetwork/CosmEvm/x/feemarket/migrations/v5/types"
@@ -35,7 +37,9 @@ func MigrateStore(
 	params.MinGasPrice = paramsV4.MinGasPrice
 	params.MinGasMultiplier = paramsV4.MinGasMultiplier
 
-	if err := params.Validate(); err != nil {
+	// NOTE: the current params validation also covers the fields added by later
+	// consensus versions, which are set by their own migrations.
+	if err := validateParamsV5(params); err != nil {
 		return err
 	}
 
@@ -48,3 +52,28 @@ func MigrateStore(
 
 	return nil
 }
+
+// validateParamsV5 validates the fields of the params of the consensus version 5.
+func validateParamsV5(params types.Params) error {
+	if params.BaseFeeChangeDenominator == 0 {
+		return fmt.Errorf("base fee change denominator cannot be 0")
+	}
+
+	if params.BaseFee.IsNil() || params.BaseFee.IsNegative() {
+		return fmt.Errorf("invalid base fee: %s", params.BaseFee)
+	}
+
+	if params.EnableHeight < 0 {
+		return fmt.Errorf("enable height cannot be negative: %d", params.EnableHeight)
+	}
+
+	if params.MinGasPrice.IsNil() || params.MinGasPrice.IsNegative() {
+		return fmt.Errorf("invalid min gas price: %s", params.MinGasPrice)
+	}
+
+	if params.MinGasMultiplier.IsNil() || params.MinGasMultiplier.IsNegative() || params.MinGasMultiplier.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("invalid min gas multiplier: %s", params.MinGasMultiplier)
+	}
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/AizelNetwork/CosmEvm/encoding"
 	v5 "github.com/AizelNetwork/CosmEvm/x/feemarket/migrations/v5"
 	typesV4 "github.com/AizelNetwork/CosmEvm/x/feemarket/migrations/v5/types"
+	v6 "github.com/AizelNetwork/CosmEvm/x/feemarket/migrations/v6"
 	"github.com/AizelNetwork/CosmEvm/x/feemarket/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
 
@@ -53,4 +54,10 @@ func TestMigrate(t *testing.T) {
 	require.Equal(t, v4Params.BaseFee, migratedParams.BaseFee.TruncateInt())
 	require.Equal(t, v4Params.MinGasPrice, migratedParams.MinGasPrice)
 	require.Equal(t, v4Params.MinGasMultiplier, migratedParams.MinGasMultiplier)
+
+	// the defaults of the fields added by later consensus versions are set by
+	// their migrations
+	require.NoError(t, v6.MigrateStore(ctx, storeKey, cdc))
+	cdc.MustUnmarshal(kvStore.Get(types.ParamsKey), &migratedParams)
+	require.NoError(t, migratedParams.Validate())
 }
@@ -1,5 +1,8 @@
 // Code generated by protoc-gen-gogo. DO NOT EDIT.
-// source: ethermint/feemarket/v1/feemarket.proto
+// source: ethermint/feemarket/migrations/v5/feemarket.proto
+
+// NOTE: the legacy types use their own package so that they don't conflict
+// with the current x/feemarket types in the proto registry.
 
 package types
 
@@ -24,7 +27,7 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// ParamsV4 defines the EVM module parameters
+// ParamsV4 defines the EVM module parameters of the consensus version 4
 type ParamsV4 struct {
 	// no_base_fee forces the EIP-1559 base fee to 0 (needed for 0 price calls)
 	NoBaseFee bool `protobuf:"varint,1,opt,name=no_base_fee,json=noBaseFee,proto3" json:"no_base_fee,omitempty"`
@@ -49,14 +52,14 @@ func (m *ParamsV4) Reset()         { *m = ParamsV4{} }
 func (m *ParamsV4) String() string { return proto.CompactTextString(m) }
 func (*ParamsV4) ProtoMessage()    {}
 func (*ParamsV4) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4feb8b20cf98e6e1, []int{0}
+	return fileDescriptor_024024515bc9f3c5, []int{0}
 }
 func (m *ParamsV4) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 func (m *ParamsV4) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ParamsV4.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -67,16 +70,16 @@ func (m *ParamsV4) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	}
 }
 func (m *ParamsV4) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Params.Merge(m, src)
+	xxx_messageInfo_ParamsV4.Merge(m, src)
 }
 func (m *ParamsV4) XXX_Size() int {
 	return m.Size()
 }
 func (m *ParamsV4) XXX_DiscardUnknown() {
-	xxx_messageInfo_Params.DiscardUnknown(m)
+	xxx_messageInfo_ParamsV4.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Params proto.InternalMessageInfo
+var xxx_messageInfo_ParamsV4 proto.InternalMessageInfo
 
 func (m *ParamsV4) GetNoBaseFee() bool {
 	if m != nil {
@@ -107,41 +110,42 @@ func (m *ParamsV4) GetEnableHeight() int64 {
 }
 
 func init() {
-	proto.RegisterType((*ParamsV4)(nil), "ethermint.feemarket.v1.ParamsV4")
+	proto.RegisterType((*ParamsV4)(nil), "ethermint.feemarket.migrations.v5.ParamsV4")
 }
 
 func init() {
-	proto.RegisterFile("ethermint/feemarket/v1/feemarket.proto", fileDescriptor_4feb8b20cf98e6e1)
+	proto.RegisterFile("ethermint/feemarket/migrations/v5/feemarket.proto", fileDescriptor_024024515bc9f3c5)
 }
 
-var fileDescriptor_4feb8b20cf98e6e1 = []byte{
-	// 404 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x6b, 0xdb, 0x30,
-	0x14, 0xc7, 0xad, 0xa5, 0x4d, 0x5d, 0x75, 0x81, 0x20, 0xda, 0x61, 0x56, 0xe6, 0x9a, 0x15, 0x86,
-	0x0f, 0xc3, 0x26, 0xf4, 0xb2, 0x1d, 0x76, 0xc9, 0x4a, 0xbb, 0x8d, 0x0d, 0x3a, 0x1f, 0x77, 0x11,
-	0xb2, 0xfb, 0x62, 0x8b, 0x58, 0x92, 0xb1, 0x14, 0xb3, 0x7c, 0x8b, 0x7d, 0x93, 0x7d, 0x8d, 0x1c,
-	0x73, 0x1c, 0x3b, 0x84, 0x91, 0x7c, 0x91, 0x11, 0x3b, 0xb1, 0x03, 0xbb, 0xec, 0x22, 0xa4, 0xf7,
-	0xff, 0xff, 0xfe, 0xbc, 0x87, 0x1e, 0x7e, 0x05, 0x26, 0x83, 0x52, 0x70, 0x69, 0xc2, 0x09, 0x80,
-	0x60, 0xe5, 0x14, 0x4c, 0x58, 0x8d, 0xba, 0x47, 0x50, 0x94, 0xca, 0x28, 0xf2, 0xac, 0xf5, 0x05,
-	0x9d, 0x54, 0x8d, 0x9e, 0x9f, 0xa7, 0x2a, 0x55, 0xb5, 0x25, 0xdc, 0xde, 0x1a, 0xf7, 0xcb, 0x9f,
-	0x3d, 0xdc, 0x7f, 0x60, 0x25, 0x13, 0x9a, 0xb8, 0xf8, 0x4c, 0x2a, 0x1a, 0x33, 0x0d, 0x74, 0x02,
-	0xe0, 0x20, 0x0f, 0xf9, 0x76, 0x74, 0x2a, 0xd5, 0x98, 0x69, 0xb8, 0x03, 0x20, 0xef, 0xf0, 0xe5,
-	0x5e, 0xa4, 0x49, 0xc6, 0x64, 0x0a, 0xf4, 0x11, 0xa4, 0x12, 0x5c, 0x32, 0xa3, 0x4a, 0xe7, 0x89,
-	0x87, 0xfc, 0x41, 0xe4, 0xc4, 0x8d, 0xfb, 0x7d, 0x6d, 0xb8, 0xed, 0x74, 0x72, 0x83, 0x2f, 0x20,
-	0x67, 0xda, 0xf0, 0x84, 0x9b, 0x39, 0x15, 0xb3, 0xdc, 0xf0, 0x22, 0xe7, 0x50, 0x3a, 0xbd, 0x1a,
-	0x3c, 0xef, 0xc4, 0x2f, 0xad, 0x46, 0xae, 0xf1, 0x00, 0x24, 0x8b, 0x73, 0xa0, 0x19, 0xf0, 0x34,
-	0x33, 0xce, 0xb1, 0x87, 0xfc, 0x5e, 0xf4, 0xb4, 0x29, 0x7e, 0xa8, 0x6b, 0xe4, 0x0d, 0xb6, 0xdb,
-	0xae, 0xfb, 0x1e, 0xf2, 0x4f, 0xc7, 0x2f, 0x16, 0xab, 0x2b, 0xeb, 0xf7, 0xea, 0xea, 0x22, 0x51,
-	0x5a, 0x28, 0xad, 0x1f, 0xa7, 0x01, 0x57, 0xa1, 0x60, 0x26, 0x0b, 0x3e, 0x4a, 0x13, 0x9d, 0xec,
-	0x9a, 0x24, 0xf7, 0x78, 0x20, 0xb8, 0xa4, 0x29, 0xd3, 0xb4, 0x28, 0x79, 0x02, 0xce, 0x49, 0x8d,
-	0x5f, 0xef, 0xf0, 0xcb, 0x7f, 0xf1, 0xcf, 0x90, 0xb2, 0x64, 0x7e, 0x0b, 0x49, 0x74, 0x26, 0xb8,
-	0xbc, 0x67, 0xfa, 0x61, 0xcb, 0x91, 0xaf, 0x98, 0xec, 0x83, 0x0e, 0x26, 0xb3, 0xff, 0x3f, 0x6d,
-	0xd8, 0xa4, 0x75, 0xa3, 0x7f, 0x3a, 0xb2, 0x8f, 0x86, 0xc7, 0xd1, 0x90, 0x4b, 0x6e, 0x38, 0xcb,
-	0xdb, 0x7f, 0x19, 0xdf, 0x2d, 0xd6, 0x2e, 0x5a, 0xae, 0x5d, 0xf4, 0x67, 0xed, 0xa2, 0x1f, 0x1b,
-	0xd7, 0x5a, 0x6e, 0x5c, 0xeb, 0xd7, 0xc6, 0xb5, 0xbe, 0xbd, 0x4e, 0xb9, 0xc9, 0x66, 0x71, 0x90,
-	0x28, 0x11, 0x42, 0x25, 0x94, 0xde, 0x9d, 0xd5, 0xe8, 0x6d, 0xf8, 0xfd, 0x60, 0x69, 0xcc, 0xbc,
-	0x00, 0x1d, 0xf7, 0xeb, 0x05, 0xb8, 0xf9, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xba, 0x26, 0x40, 0xe2,
-	0x58, 0x02, 0x00, 0x00,
+var fileDescriptor_024024515bc9f3c5 = []byte{
+	// 422 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xdf, 0x6a, 0x13, 0x41,
+	0x14, 0xc6, 0xb3, 0xa6, 0x7f, 0xb6, 0x53, 0x03, 0x61, 0x68, 0x61, 0xb1, 0xb8, 0x8d, 0xf6, 0x26,
+	0x57, 0xbb, 0x48, 0x2d, 0x78, 0x23, 0x62, 0x5a, 0xad, 0x8a, 0x4a, 0xdd, 0x0b, 0x41, 0x6f, 0x86,
+	0xd9, 0xed, 0xe9, 0xec, 0x21, 0x3b, 0x33, 0x61, 0xe6, 0x34, 0x1a, 0x9f, 0xc2, 0x57, 0xf1, 0x2d,
+	0x7a, 0xd9, 0x4b, 0xf1, 0xa2, 0x48, 0xf2, 0x22, 0xd2, 0x4d, 0x93, 0x15, 0x44, 0xe8, 0xdd, 0xf0,
+	0x7d, 0xdf, 0xef, 0xe3, 0x1c, 0xe6, 0xb0, 0x47, 0x40, 0x25, 0x38, 0x8d, 0x86, 0xd2, 0x33, 0x00,
+	0x2d, 0xdd, 0x10, 0x28, 0xd5, 0xa8, 0x9c, 0x24, 0xb4, 0xc6, 0xa7, 0xe3, 0x83, 0x46, 0x4f, 0x46,
+	0xce, 0x92, 0xe5, 0x0f, 0x96, 0x48, 0xd2, 0x58, 0x0d, 0x92, 0x8c, 0x0f, 0xee, 0x6d, 0x29, 0xab,
+	0x6c, 0x9d, 0x4e, 0xaf, 0x5f, 0x73, 0xf0, 0xe1, 0x8f, 0x36, 0x0b, 0x4f, 0xa4, 0x93, 0xda, 0x7f,
+	0x7c, 0xcc, 0x63, 0xb6, 0x69, 0xac, 0xc8, 0xa5, 0x07, 0x71, 0x06, 0x10, 0x05, 0xbd, 0xa0, 0x1f,
+	0x66, 0x1b, 0xc6, 0x0e, 0xa4, 0x87, 0x97, 0x00, 0xfc, 0x29, 0xdb, 0x59, 0x98, 0xa2, 0x28, 0xa5,
+	0x51, 0x20, 0x4e, 0xc1, 0x58, 0x8d, 0x46, 0x92, 0x75, 0xd1, 0x9d, 0x5e, 0xd0, 0xef, 0x64, 0x51,
+	0x3e, 0x4f, 0x1f, 0xd6, 0x81, 0xa3, 0xc6, 0xe7, 0xfb, 0x6c, 0x1b, 0x2a, 0xe9, 0x09, 0x0b, 0xa4,
+	0x89, 0xd0, 0xe7, 0x15, 0xe1, 0xa8, 0x42, 0x70, 0x51, 0xbb, 0x06, 0xb7, 0x1a, 0xf3, 0xdd, 0xd2,
+	0xe3, 0x7b, 0xac, 0x03, 0x46, 0xe6, 0x15, 0x88, 0x12, 0x50, 0x95, 0x14, 0xad, 0xf6, 0x82, 0x7e,
+	0x3b, 0xbb, 0x3b, 0x17, 0x5f, 0xd5, 0x1a, 0x7f, 0xc2, 0xc2, 0xe5, 0xd4, 0x6b, 0xbd, 0xa0, 0xbf,
+	0x31, 0xb8, 0x7f, 0x71, 0xb5, 0xdb, 0xfa, 0x75, 0xb5, 0xbb, 0x5d, 0x58, 0xaf, 0xad, 0xf7, 0xa7,
+	0xc3, 0x04, 0x6d, 0xaa, 0x25, 0x95, 0xc9, 0x6b, 0x43, 0xd9, 0xfa, 0xcd, 0x90, 0xfc, 0x98, 0x75,
+	0x34, 0x1a, 0xa1, 0xa4, 0x17, 0x23, 0x87, 0x05, 0x44, 0xeb, 0x35, 0xbe, 0x77, 0x83, 0xef, 0xfc,
+	0x8b, 0xbf, 0x05, 0x25, 0x8b, 0xc9, 0x11, 0x14, 0xd9, 0xa6, 0x46, 0x73, 0x2c, 0xfd, 0xc9, 0x35,
+	0xc7, 0x3f, 0x30, 0xbe, 0x28, 0xfa, 0x6b, 0xb3, 0xf0, 0xf6, 0x6d, 0xdd, 0x79, 0x5b, 0xb3, 0xfa,
+	0x9b, 0x95, 0x70, 0xa5, 0xbb, 0x9a, 0x75, 0xd1, 0x20, 0xa1, 0xac, 0x96, 0xff, 0x32, 0xf8, 0x74,
+	0x31, 0x8d, 0x83, 0xcb, 0x69, 0x1c, 0xfc, 0x9e, 0xc6, 0xc1, 0xf7, 0x59, 0xdc, 0xba, 0x9c, 0xc5,
+	0xad, 0x9f, 0xb3, 0xb8, 0xf5, 0xf9, 0x99, 0x42, 0x2a, 0xcf, 0xf3, 0xa4, 0xb0, 0x3a, 0x7d, 0x8e,
+	0xdf, 0xa0, 0x7a, 0x0f, 0xf4, 0xc5, 0xba, 0x61, 0x7a, 0x68, 0xbd, 0x7e, 0x31, 0xd6, 0xe9, 0xd7,
+	0xff, 0x5e, 0x14, 0x4d, 0x46, 0xe0, 0xf3, 0xb5, 0xfa, 0x2a, 0xf6, 0xff, 0x0c, 0x00, 0x98, 0x92,
+	0x1c, 0x10, 0x83, 0x02, 0x00, 0x00,
 }
 
 func (m *ParamsV4) Marshal() (dAtA []byte, err error) {
@@ -289,10 +293,10 @@ func (m *ParamsV4) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParamsV4: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParamsV4: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
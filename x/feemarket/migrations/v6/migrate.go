@@ -0,0 +1,49 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+package v6
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"github.com/AizelNetwork/CosmEvm/x/feemarket/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateStore migrates the x/feemarket module state from the consensus version 5 to
+// version 6. Specifically, it sets the default values of the base fee algorithm
+// parameters, keeping the EIP-1559 algorithm, and of the share of the block gas
+// reserved for the Cosmos transactions.
+func MigrateStore(
+	ctx sdk.Context,
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+) error {
+	var (
+		store  = ctx.KVStore(storeKey)
+		params types.Params
+	)
+
+	paramsBz := store.Get(types.ParamsKey)
+	cdc.MustUnmarshal(paramsBz, &params)
+
+	params.BaseFeeAlgorithm = types.BASE_FEE_ALGORITHM_EIP1559
+	params.WindowSize = types.DefaultWindowSize
+	params.MinBaseFee = types.DefaultMinBaseFee
+	params.MaxBaseFee = types.DefaultMaxBaseFee
+	params.Aimd = types.DefaultAIMDParams
+	params.HistorySize = types.DefaultHistorySize
+	params.CosmosBlockGasShare = types.DefaultCosmosBlockGasShare
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package v6_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/AizelNetwork/CosmEvm/encoding"
+	v6 "github.com/AizelNetwork/CosmEvm/x/feemarket/migrations/v6"
+	"github.com/AizelNetwork/CosmEvm/x/feemarket/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := encoding.MakeConfig()
+	cdc := encCfg.Codec
+
+	storeKey := storetypes.NewKVStoreKey(types.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+
+	kvStore := ctx.KVStore(storeKey)
+
+	// params of consensus version 5 don't include the base fee algorithm fields
+	v5Params := types.Params{
+		NoBaseFee:                false,
+		BaseFeeChangeDenominator: 8,
+		ElasticityMultiplier:     2,
+		EnableHeight:             10,
+		BaseFee:                  math.LegacyNewDec(1000000),
+		MinGasPrice:              math.LegacyNewDec(10),
+		MinGasMultiplier:         math.LegacyNewDecWithPrec(5, 1),
+		MinBaseFee:               math.LegacyZeroDec(),
+		MaxBaseFee:               math.LegacyZeroDec(),
+	}
+
+	v5ParamsBz, err := cdc.Marshal(&v5Params)
+	require.NoError(t, err)
+
+	kvStore.Set(types.ParamsKey, v5ParamsBz)
+
+	require.NoError(t, v6.MigrateStore(ctx, storeKey, cdc))
+
+	paramsBz := kvStore.Get(types.ParamsKey)
+	var migratedParams types.Params
+	cdc.MustUnmarshal(paramsBz, &migratedParams)
+
+	require.Equal(t, v5Params.BaseFeeChangeDenominator, migratedParams.BaseFeeChangeDenominator)
+	require.Equal(t, v5Params.ElasticityMultiplier, migratedParams.ElasticityMultiplier)
+	require.Equal(t, v5Params.EnableHeight, migratedParams.EnableHeight)
+	require.Equal(t, v5Params.BaseFee, migratedParams.BaseFee)
+	require.Equal(t, v5Params.MinGasPrice, migratedParams.MinGasPrice)
+	require.Equal(t, v5Params.MinGasMultiplier, migratedParams.MinGasMultiplier)
+	require.Equal(t, types.BASE_FEE_ALGORITHM_EIP1559, migratedParams.BaseFeeAlgorithm)
+	require.Equal(t, types.DefaultWindowSize, migratedParams.WindowSize)
+	require.Equal(t, types.DefaultHistorySize, migratedParams.HistorySize)
+	require.Equal(t, types.DefaultAIMDParams, migratedParams.Aimd)
+	require.Equal(t, types.DefaultCosmosBlockGasShare, migratedParams.CosmosBlockGasShare)
+	require.NoError(t, migratedParams.Validate())
+}
@@ -22,7 +22,8 @@ var (
 
 const (
 	// Amino names
-	updateParamsName = "ethermint/feemarket/MsgUpdateParams"
+	updateParamsName     = "ethermint/feemarket/MsgUpdateParams"
+	setFeeDenomPriceName = "ethermint/feemarket/MsgSetFeeDenomPrice"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -36,6 +37,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgSetFeeDenomPrice{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -43,4 +45,5 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 // RegisterLegacyAminoCodec required for EIP-712
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgSetFeeDenomPrice{}, setFeeDenomPriceName, nil)
 }
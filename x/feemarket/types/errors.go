@@ -0,0 +1,14 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrInvalidFeeDenom    = errorsmod.Register(ModuleName, 2, "invalid fee denom")
+	ErrFeeDenomPriceStale = errorsmod.Register(ModuleName, 3, "fee denom price is stale")
+)
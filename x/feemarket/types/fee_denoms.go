@@ -0,0 +1,68 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"fmt"
+	"slices"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IsFeeDenom returns true if the denom is accepted to pay the fees of
+// transactions besides the evm denom.
+func (p Params) IsFeeDenom(denom string) bool {
+	return slices.Contains(p.FeeDenoms, denom)
+}
+
+// NewFeeDenomPrice creates a new FeeDenomPrice instance.
+func NewFeeDenomPrice(denom string, price math.LegacyDec, updateTime int64) FeeDenomPrice {
+	return FeeDenomPrice{
+		Denom:      denom,
+		Price:      price,
+		UpdateTime: updateTime,
+	}
+}
+
+// Validate performs a stateless validation of the fee denom price.
+func (p FeeDenomPrice) Validate() error {
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return err
+	}
+
+	if p.Price.IsNil() || !p.Price.IsPositive() {
+		return fmt.Errorf("price of fee denom %s must be positive: %s", p.Denom, p.Price)
+	}
+
+	if p.UpdateTime < 0 {
+		return fmt.Errorf("update time of fee denom %s cannot be negative: %d", p.Denom, p.UpdateTime)
+	}
+
+	return nil
+}
+
+// ToEVMDenom converts an amount of the fee denom to the evm denom.
+func (p FeeDenomPrice) ToEVMDenom(amount math.LegacyDec) math.LegacyDec {
+	return amount.Mul(p.Price)
+}
+
+// FromEVMDenom converts an amount of the evm denom to the fee denom.
+func (p FeeDenomPrice) FromEVMDenom(amount math.LegacyDec) math.LegacyDec {
+	return amount.Quo(p.Price)
+}
+
+func validateFeeDenoms(denoms []string) error {
+	seen := make(map[string]bool, len(denoms))
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid fee denom: %w", err)
+		}
+		if seen[denom] {
+			return fmt.Errorf("duplicated fee denom: %s", denom)
+		}
+		seen[denom] = true
+	}
+
+	return nil
+}
@@ -25,6 +25,42 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// BaseFeeAlgorithm defines the algorithm used to adjust the base fee.
+type BaseFeeAlgorithm int32
+
+const (
+	// BASE_FEE_ALGORITHM_EIP1559 adjusts the base fee following EIP-1559 using
+	// the gas of the parent block.
+	BASE_FEE_ALGORITHM_EIP1559 BaseFeeAlgorithm = 0
+	// BASE_FEE_ALGORITHM_AIMD adjusts the base fee exponentially with a learning
+	// rate that increases additively when the block gas is far from the target
+	// and decreases multiplicatively otherwise.
+	BASE_FEE_ALGORITHM_AIMD BaseFeeAlgorithm = 1
+	// BASE_FEE_ALGORITHM_MOVING_WINDOW adjusts the base fee following EIP-1559
+	// using the average gas of the last window_size blocks.
+	BASE_FEE_ALGORITHM_MOVING_WINDOW BaseFeeAlgorithm = 2
+)
+
+var BaseFeeAlgorithm_name = map[int32]string{
+	0: "BASE_FEE_ALGORITHM_EIP1559",
+	1: "BASE_FEE_ALGORITHM_AIMD",
+	2: "BASE_FEE_ALGORITHM_MOVING_WINDOW",
+}
+
+var BaseFeeAlgorithm_value = map[string]int32{
+	"BASE_FEE_ALGORITHM_EIP1559":       0,
+	"BASE_FEE_ALGORITHM_AIMD":          1,
+	"BASE_FEE_ALGORITHM_MOVING_WINDOW": 2,
+}
+
+func (x BaseFeeAlgorithm) String() string {
+	return proto.EnumName(BaseFeeAlgorithm_name, int32(x))
+}
+
+func (BaseFeeAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{0}
+}
+
 // Params defines the feemarket module parameters
 type Params struct {
 	// no_base_fee forces the EIP-1559 base fee to 0 (needed for 0 price calls)
@@ -44,6 +80,36 @@ type Params struct {
 	// min_gas_multiplier bounds the minimum gas used to be charged
 	// to senders based on gas limit
 	MinGasMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=min_gas_multiplier,json=minGasMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_gas_multiplier"`
+	// base_fee_algorithm defines the algorithm used to adjust the base fee
+	// between blocks.
+	BaseFeeAlgorithm BaseFeeAlgorithm `protobuf:"varint,9,opt,name=base_fee_algorithm,json=baseFeeAlgorithm,proto3,enum=ethermint.feemarket.v1.BaseFeeAlgorithm" json:"base_fee_algorithm,omitempty"`
+	// window_size is the number of blocks used by the moving window and AIMD
+	// algorithms to compute the gas usage of the chain.
+	WindowSize uint64 `protobuf:"varint,10,opt,name=window_size,json=windowSize,proto3" json:"window_size,omitempty"`
+	// min_base_fee is the lower bound of the base fee. Zero disables the bound.
+	MinBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=min_base_fee,json=minBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_base_fee"`
+	// max_base_fee is the upper bound of the base fee. Zero disables the bound.
+	MaxBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,12,opt,name=max_base_fee,json=maxBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_base_fee"`
+	// aimd defines the parameters of the AIMD base fee algorithm.
+	Aimd AIMDParams `protobuf:"bytes,13,opt,name=aimd,proto3" json:"aimd"`
+	// history_size is the number of blocks for which the base fee and the block
+	// gas are kept in the store. It must be greater or equal than window_size.
+	HistorySize uint64 `protobuf:"varint,14,opt,name=history_size,json=historySize,proto3" json:"history_size,omitempty"`
+	// cosmos_block_gas_share is the share of the block gas reserved for the
+	// Cosmos transactions. The Ethereum transactions of a block cannot use more
+	// than the remaining share of the block gas.
+	CosmosBlockGasShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,15,opt,name=cosmos_block_gas_share,json=cosmosBlockGasShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"cosmos_block_gas_share"`
+	// fee_denoms are the denominations, besides the evm denom, accepted to pay
+	// the fees of transactions. The fees are converted with the price of the
+	// denomination provided by the fee denom price feed.
+	// NOTE: the fees paid in a fee denom are not swapped to the evm denom. They
+	// are forwarded to the fee collector as they are and distributed to the
+	// stakers in the fee denom. They are never burned.
+	FeeDenoms []string `protobuf:"bytes,16,rep,name=fee_denoms,json=feeDenoms,proto3" json:"fee_denoms,omitempty"`
+	// max_price_age is the max number of seconds since the last update of the
+	// price of a fee denom for the price to be used. Older prices are stale and
+	// the fee denom is rejected until the price is updated.
+	MaxPriceAge uint64 `protobuf:"varint,17,opt,name=max_price_age,json=maxPriceAge,proto3" json:"max_price_age,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -107,8 +173,229 @@ func (m *Params) GetEnableHeight() int64 {
 	return 0
 }
 
+func (m *Params) GetBaseFeeAlgorithm() BaseFeeAlgorithm {
+	if m != nil {
+		return m.BaseFeeAlgorithm
+	}
+	return BASE_FEE_ALGORITHM_EIP1559
+}
+
+func (m *Params) GetWindowSize() uint64 {
+	if m != nil {
+		return m.WindowSize
+	}
+	return 0
+}
+
+func (m *Params) GetAimd() AIMDParams {
+	if m != nil {
+		return m.Aimd
+	}
+	return AIMDParams{}
+}
+
+func (m *Params) GetHistorySize() uint64 {
+	if m != nil {
+		return m.HistorySize
+	}
+	return 0
+}
+
+func (m *Params) GetFeeDenoms() []string {
+	if m != nil {
+		return m.FeeDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetMaxPriceAge() uint64 {
+	if m != nil {
+		return m.MaxPriceAge
+	}
+	return 0
+}
+
+// AIMDParams defines the parameters of the additive increase, multiplicative
+// decrease (AIMD) base fee algorithm.
+type AIMDParams struct {
+	// alpha is the amount added to the learning rate when the window utilization
+	// is outside of the [gamma, 1 - gamma] range.
+	Alpha cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=alpha,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"alpha"`
+	// beta is the factor the learning rate is multiplied by when the window
+	// utilization is within the [gamma, 1 - gamma] range.
+	Beta cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=beta,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"beta"`
+	// gamma is the utilization threshold used to adjust the learning rate.
+	Gamma cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=gamma,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"gamma"`
+	// min_learning_rate is the lower bound of the learning rate.
+	MinLearningRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=min_learning_rate,json=minLearningRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_learning_rate"`
+	// max_learning_rate is the upper bound of the learning rate.
+	MaxLearningRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=max_learning_rate,json=maxLearningRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_learning_rate"`
+}
+
+func (m *AIMDParams) Reset()         { *m = AIMDParams{} }
+func (m *AIMDParams) String() string { return proto.CompactTextString(m) }
+func (*AIMDParams) ProtoMessage()    {}
+func (*AIMDParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{1}
+}
+func (m *AIMDParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AIMDParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AIMDParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AIMDParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AIMDParams.Merge(m, src)
+}
+func (m *AIMDParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *AIMDParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_AIMDParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AIMDParams proto.InternalMessageInfo
+
+// BaseFeeRecord defines the base fee and the gas wanted of a block.
+type BaseFeeRecord struct {
+	// height of the block
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// base_fee of the block
+	BaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_fee"`
+	// gas_wanted is the gas wanted of the block used for the base fee calculation
+	GasWanted uint64 `protobuf:"varint,3,opt,name=gas_wanted,json=gasWanted,proto3" json:"gas_wanted,omitempty"`
+	// max_gas is the maximum gas of the block. -1 means that the block gas is
+	// unlimited.
+	MaxGas int64 `protobuf:"varint,4,opt,name=max_gas,json=maxGas,proto3" json:"max_gas,omitempty"`
+}
+
+func (m *BaseFeeRecord) Reset()         { *m = BaseFeeRecord{} }
+func (m *BaseFeeRecord) String() string { return proto.CompactTextString(m) }
+func (*BaseFeeRecord) ProtoMessage()    {}
+func (*BaseFeeRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{2}
+}
+func (m *BaseFeeRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BaseFeeRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BaseFeeRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BaseFeeRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BaseFeeRecord.Merge(m, src)
+}
+func (m *BaseFeeRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *BaseFeeRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_BaseFeeRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BaseFeeRecord proto.InternalMessageInfo
+
+func (m *BaseFeeRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BaseFeeRecord) GetGasWanted() uint64 {
+	if m != nil {
+		return m.GasWanted
+	}
+	return 0
+}
+
+func (m *BaseFeeRecord) GetMaxGas() int64 {
+	if m != nil {
+		return m.MaxGas
+	}
+	return 0
+}
+
+// FeeDenomPrice defines the price of a fee denom in the evm denom.
+type FeeDenomPrice struct {
+	// denom is the fee denom
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// price is the amount of the evm denom worth one unit of the fee denom
+	Price cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price"`
+	// update_time is the unix time, in seconds, of the block in which the price
+	// was updated
+	UpdateTime int64 `protobuf:"varint,3,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+}
+
+func (m *FeeDenomPrice) Reset()         { *m = FeeDenomPrice{} }
+func (m *FeeDenomPrice) String() string { return proto.CompactTextString(m) }
+func (*FeeDenomPrice) ProtoMessage()    {}
+func (*FeeDenomPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{3}
+}
+func (m *FeeDenomPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDenomPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDenomPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDenomPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDenomPrice.Merge(m, src)
+}
+func (m *FeeDenomPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDenomPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDenomPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDenomPrice proto.InternalMessageInfo
+
+func (m *FeeDenomPrice) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FeeDenomPrice) GetUpdateTime() int64 {
+	if m != nil {
+		return m.UpdateTime
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("ethermint.feemarket.v1.BaseFeeAlgorithm", BaseFeeAlgorithm_name, BaseFeeAlgorithm_value)
 	proto.RegisterType((*Params)(nil), "ethermint.feemarket.v1.Params")
+	proto.RegisterType((*AIMDParams)(nil), "ethermint.feemarket.v1.AIMDParams")
+	proto.RegisterType((*BaseFeeRecord)(nil), "ethermint.feemarket.v1.BaseFeeRecord")
+	proto.RegisterType((*FeeDenomPrice)(nil), "ethermint.feemarket.v1.FeeDenomPrice")
 }
 
 func init() {
@@ -116,34 +403,64 @@ func init() {
 }
 
 var fileDescriptor_4feb8b20cf98e6e1 = []byte{
-	// 427 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xc1, 0x6e, 0xd4, 0x30,
-	0x10, 0x5d, 0xb3, 0xed, 0x76, 0xeb, 0xb2, 0xd2, 0x62, 0x15, 0x14, 0xb5, 0x22, 0x5d, 0x81, 0x84,
-	0xa2, 0x0a, 0x25, 0x94, 0xde, 0x90, 0xb8, 0x6c, 0xab, 0x82, 0x50, 0x91, 0xaa, 0x1c, 0x38, 0x70,
-	0xb1, 0x9c, 0x74, 0x9a, 0x8c, 0x1a, 0xdb, 0xab, 0xd8, 0x8d, 0xd8, 0x5f, 0xe0, 0xc4, 0x67, 0x70,
-	0xec, 0x67, 0xf4, 0xd8, 0x23, 0xe2, 0x50, 0xa1, 0xcd, 0xa1, 0xbf, 0x81, 0x1a, 0xd3, 0xa4, 0x1c,
-	0xf7, 0x32, 0x1a, 0xbf, 0xf7, 0xe6, 0x79, 0xec, 0x19, 0xfa, 0x0a, 0x6c, 0x0e, 0xa5, 0x44, 0x65,
-	0xa3, 0x33, 0x00, 0x29, 0xca, 0x73, 0xb0, 0x51, 0xb5, 0xd7, 0x1d, 0xc2, 0x59, 0xa9, 0xad, 0x66,
-	0xcf, 0x5a, 0x5d, 0xd8, 0x51, 0xd5, 0xde, 0xd6, 0x13, 0x21, 0x51, 0xe9, 0xa8, 0x89, 0x4e, 0xba,
-	0xb5, 0x99, 0xe9, 0x4c, 0x37, 0x69, 0x74, 0x97, 0x39, 0xf4, 0x45, 0xdd, 0xa7, 0x83, 0x13, 0x51,
-	0x0a, 0x69, 0x98, 0x4f, 0x37, 0x94, 0xe6, 0x89, 0x30, 0xc0, 0xcf, 0x00, 0x3c, 0x32, 0x21, 0xc1,
-	0x30, 0x5e, 0x57, 0x7a, 0x2a, 0x0c, 0x1c, 0x01, 0xb0, 0xf7, 0x74, 0xfb, 0x9e, 0xe4, 0x69, 0x2e,
-	0x54, 0x06, 0xfc, 0x14, 0x94, 0x96, 0xa8, 0x84, 0xd5, 0xa5, 0xf7, 0x68, 0x42, 0x82, 0x51, 0xec,
-	0x25, 0x4e, 0x7d, 0xd0, 0x08, 0x0e, 0x3b, 0x9e, 0xed, 0xd3, 0xa7, 0x50, 0x08, 0x63, 0x31, 0x45,
-	0x3b, 0xe7, 0xf2, 0xa2, 0xb0, 0x38, 0x2b, 0x10, 0x4a, 0xaf, 0xdf, 0x14, 0x6e, 0x76, 0xe4, 0xe7,
-	0x96, 0x63, 0x2f, 0xe9, 0x08, 0x94, 0x48, 0x0a, 0xe0, 0x39, 0x60, 0x96, 0x5b, 0x6f, 0x75, 0x42,
-	0x82, 0x7e, 0xfc, 0xd8, 0x81, 0x1f, 0x1b, 0x8c, 0x1d, 0xd0, 0x61, 0xdb, 0xf5, 0x60, 0x42, 0x82,
-	0xf5, 0x69, 0x70, 0x75, 0xb3, 0xd3, 0xfb, 0x7d, 0xb3, 0xb3, 0x9d, 0x6a, 0x23, 0xb5, 0x31, 0xa7,
-	0xe7, 0x21, 0xea, 0x48, 0x0a, 0x9b, 0x87, 0xc7, 0x90, 0x89, 0x74, 0x7e, 0x08, 0xe9, 0xcf, 0xdb,
-	0xcb, 0x5d, 0x12, 0xaf, 0xfd, 0xeb, 0x97, 0x1d, 0xd3, 0x91, 0x44, 0xc5, 0x33, 0x61, 0xf8, 0xac,
-	0xc4, 0x14, 0xbc, 0xb5, 0x25, 0x9d, 0x36, 0x24, 0xaa, 0x0f, 0xc2, 0x9c, 0xdc, 0x15, 0xb3, 0x2f,
-	0x94, 0xdd, 0xbb, 0x3d, 0x78, 0xe9, 0x70, 0x49, 0xcb, 0xb1, 0xb3, 0xec, 0xfe, 0xe3, 0xdd, 0xf3,
-	0xef, 0xb7, 0x97, 0xbb, 0x1e, 0x54, 0x52, 0x9b, 0xe8, 0xdb, 0x83, 0xd5, 0x70, 0x23, 0xfc, 0xb4,
-	0x32, 0x5c, 0x19, 0xaf, 0xc6, 0x63, 0x54, 0x68, 0x51, 0x14, 0xed, 0x2c, 0xa7, 0x47, 0x57, 0x0b,
-	0x9f, 0x5c, 0x2f, 0x7c, 0xf2, 0x67, 0xe1, 0x93, 0x1f, 0xb5, 0xdf, 0xbb, 0xae, 0xfd, 0xde, 0xaf,
-	0xda, 0xef, 0x7d, 0x7d, 0x9d, 0xa1, 0xcd, 0x2f, 0x92, 0x30, 0xd5, 0x32, 0x72, 0xb6, 0x2e, 0x56,
-	0x6f, 0xdf, 0xfc, 0x77, 0x81, 0x9d, 0xcf, 0xc0, 0x24, 0x83, 0x66, 0x69, 0xf6, 0xff, 0x06, 0x00,
-	0x00, 0xff, 0xff, 0x0f, 0xe9, 0x83, 0xfe, 0x9f, 0x02, 0x00, 0x00,
+	// 911 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcb, 0x6e, 0x23, 0x45,
+	0x14, 0x75, 0xc7, 0x8e, 0x13, 0x97, 0xe3, 0x19, 0x4f, 0x11, 0x32, 0xad, 0x44, 0x71, 0x8c, 0x41,
+	0xa8, 0x95, 0x85, 0xad, 0xcc, 0x68, 0x16, 0x20, 0x40, 0xb2, 0xf3, 0x1a, 0x0f, 0x79, 0xa9, 0x13,
+	0x25, 0x12, 0x12, 0x6a, 0x5d, 0xdb, 0x37, 0xdd, 0xa5, 0x74, 0x75, 0x59, 0x5d, 0x95, 0xc4, 0x09,
+	0x7b, 0x84, 0x58, 0xf1, 0x0f, 0xb0, 0x60, 0x39, 0x9f, 0x31, 0xcb, 0x59, 0x22, 0x16, 0x23, 0x94,
+	0x2c, 0xe6, 0x0f, 0x58, 0xb1, 0x40, 0x5d, 0xe5, 0x47, 0x08, 0x61, 0xd1, 0x6c, 0xac, 0xee, 0xfb,
+	0x38, 0x7d, 0xcf, 0xad, 0x53, 0xc7, 0xe4, 0x53, 0x54, 0x01, 0xc6, 0x9c, 0x45, 0xaa, 0x71, 0x8a,
+	0xc8, 0x21, 0x3e, 0x43, 0xd5, 0xb8, 0x58, 0x9b, 0xbc, 0xd4, 0xfb, 0xb1, 0x50, 0x82, 0x2e, 0x8c,
+	0xeb, 0xea, 0x93, 0xd4, 0xc5, 0xda, 0xe2, 0x13, 0xe0, 0x2c, 0x12, 0x0d, 0xfd, 0x6b, 0x4a, 0x17,
+	0xe7, 0x7d, 0xe1, 0x0b, 0xfd, 0xd8, 0x48, 0x9e, 0x4c, 0xb4, 0xf6, 0xd7, 0x0c, 0xc9, 0x1f, 0x40,
+	0x0c, 0x5c, 0xd2, 0x0a, 0x29, 0x46, 0xc2, 0xeb, 0x80, 0x44, 0xef, 0x14, 0xd1, 0xb6, 0xaa, 0x96,
+	0x33, 0xeb, 0x16, 0x22, 0xd1, 0x02, 0x89, 0x5b, 0x88, 0xf4, 0x4b, 0xb2, 0x34, 0x4a, 0x7a, 0xdd,
+	0x00, 0x22, 0x1f, 0xbd, 0x1e, 0x46, 0x82, 0xb3, 0x08, 0x94, 0x88, 0xed, 0xa9, 0xaa, 0xe5, 0x94,
+	0x5c, 0xbb, 0x63, 0xaa, 0xd7, 0x75, 0xc1, 0xc6, 0x24, 0x4f, 0x9f, 0x93, 0x0f, 0x31, 0x04, 0xa9,
+	0x58, 0x97, 0xa9, 0x2b, 0x8f, 0x9f, 0x87, 0x8a, 0xf5, 0x43, 0x86, 0xb1, 0x9d, 0xd5, 0x8d, 0xf3,
+	0x93, 0xe4, 0xee, 0x38, 0x47, 0x3f, 0x26, 0x25, 0x8c, 0xa0, 0x13, 0xa2, 0x17, 0x20, 0xf3, 0x03,
+	0x65, 0x4f, 0x57, 0x2d, 0x27, 0xeb, 0xce, 0x99, 0xe0, 0x4b, 0x1d, 0xa3, 0xeb, 0x64, 0x76, 0x3c,
+	0x75, 0xbe, 0x6a, 0x39, 0x85, 0x96, 0xf3, 0xe6, 0xdd, 0x4a, 0xe6, 0xf7, 0x77, 0x2b, 0x4b, 0x5d,
+	0x21, 0xb9, 0x90, 0xb2, 0x77, 0x56, 0x67, 0xa2, 0xc1, 0x41, 0x05, 0xf5, 0x1d, 0xf4, 0xa1, 0x7b,
+	0xb5, 0x81, 0xdd, 0x5f, 0xdf, 0xbf, 0x5e, 0xb5, 0xdc, 0x99, 0xe1, 0xbc, 0x74, 0x87, 0x94, 0x38,
+	0x8b, 0x3c, 0x1f, 0xa4, 0xd7, 0x8f, 0x59, 0x17, 0xed, 0x99, 0x94, 0x48, 0x45, 0xce, 0xa2, 0x6d,
+	0x90, 0x07, 0x49, 0x33, 0x3d, 0x26, 0x74, 0x84, 0x76, 0x87, 0xe9, 0x6c, 0x4a, 0xc8, 0xb2, 0x81,
+	0xbc, 0xb3, 0x8f, 0x63, 0x42, 0xc7, 0x67, 0x00, 0xa1, 0x2f, 0x62, 0xa6, 0x02, 0x6e, 0x17, 0xaa,
+	0x96, 0xf3, 0xe8, 0x99, 0x53, 0x7f, 0x58, 0x0c, 0xf5, 0xe1, 0x01, 0x36, 0x47, 0xf5, 0x6e, 0xb9,
+	0x73, 0x2f, 0x42, 0x57, 0x48, 0xf1, 0x92, 0x45, 0x3d, 0x71, 0xe9, 0x49, 0x76, 0x8d, 0x36, 0xa9,
+	0x5a, 0x4e, 0xce, 0x25, 0x26, 0x74, 0xc8, 0xae, 0x91, 0xbe, 0x22, 0x73, 0x09, 0xa1, 0xf1, 0x9e,
+	0x8b, 0x29, 0xa9, 0x10, 0xce, 0xa2, 0x91, 0x90, 0x12, 0x2c, 0x18, 0x4c, 0xb0, 0xe6, 0x52, 0x63,
+	0xc1, 0x60, 0x84, 0xd5, 0x24, 0x39, 0x60, 0xbc, 0x67, 0x97, 0xaa, 0x96, 0x53, 0x7c, 0x56, 0xfb,
+	0xaf, 0x15, 0x34, 0xdb, 0xbb, 0x1b, 0x46, 0xe6, 0xad, 0x42, 0xf2, 0x1d, 0x03, 0xa4, 0x5b, 0xe9,
+	0x47, 0x64, 0x2e, 0x60, 0x52, 0x89, 0xf8, 0xca, 0x90, 0x7f, 0xa4, 0xc9, 0x17, 0x87, 0x31, 0xcd,
+	0xfe, 0x5b, 0xb2, 0x60, 0xa6, 0xf2, 0x3a, 0xa1, 0xe8, 0x9e, 0xe9, 0x73, 0x95, 0x01, 0xc4, 0x68,
+	0x3f, 0x4e, 0x39, 0xfb, 0x07, 0xa6, 0xa2, 0x95, 0xc0, 0x6c, 0x83, 0x3c, 0x4c, 0x40, 0xe8, 0x32,
+	0x21, 0xa7, 0x38, 0xbc, 0x4d, 0xd2, 0x2e, 0x57, 0xb3, 0x4e, 0xc1, 0x2d, 0x9c, 0xa2, 0xb9, 0x3e,
+	0x92, 0xd6, 0x48, 0x29, 0xd9, 0x97, 0x96, 0xa5, 0x07, 0x3e, 0xda, 0x4f, 0xcc, 0x84, 0x1c, 0x06,
+	0x5a, 0x6d, 0x4d, 0x1f, 0x3f, 0x5f, 0xfe, 0xf1, 0xfd, 0xeb, 0x55, 0x1b, 0x2f, 0xb8, 0x90, 0x8d,
+	0xc1, 0x1d, 0xcf, 0x30, 0xa4, 0x5f, 0xe5, 0x66, 0x73, 0xe5, 0x69, 0xb7, 0xcc, 0x22, 0xa6, 0x18,
+	0x84, 0xe3, 0xd5, 0xd7, 0xfe, 0x9c, 0x22, 0x64, 0xb2, 0x1b, 0xfa, 0x15, 0x99, 0x86, 0xb0, 0x1f,
+	0x80, 0x6d, 0xa5, 0xa4, 0x65, 0xda, 0xe8, 0x17, 0x24, 0xd7, 0x41, 0x05, 0xf6, 0x54, 0xca, 0x76,
+	0xdd, 0x95, 0x7c, 0xdd, 0x07, 0xce, 0xc1, 0xce, 0xa6, 0x6c, 0x37, 0x6d, 0xf4, 0x88, 0x3c, 0x49,
+	0x34, 0x1a, 0x22, 0xc4, 0x11, 0x8b, 0x7c, 0x2f, 0x06, 0x85, 0x76, 0x2e, 0x25, 0xd6, 0x63, 0xce,
+	0xa2, 0x9d, 0x21, 0x82, 0x0b, 0x0a, 0x35, 0x2a, 0x0c, 0xee, 0xa1, 0x4e, 0xa7, 0x46, 0x85, 0xc1,
+	0x5d, 0xd4, 0xda, 0x2f, 0x16, 0x29, 0x0d, 0x35, 0xec, 0x62, 0x57, 0xc4, 0x3d, 0xba, 0x40, 0xf2,
+	0x43, 0x8f, 0xb3, 0xb4, 0xc7, 0xe5, 0x83, 0x7f, 0xbb, 0xdb, 0xd4, 0xff, 0x75, 0xb7, 0x65, 0x42,
+	0x12, 0xcd, 0x5e, 0x42, 0xa4, 0xb0, 0xa7, 0xf7, 0x9b, 0x73, 0x0b, 0x3e, 0xc8, 0x13, 0x1d, 0xa0,
+	0x4f, 0xc9, 0x4c, 0xc2, 0xd1, 0x07, 0xa9, 0xf7, 0x95, 0x75, 0xf3, 0x1c, 0x06, 0xdb, 0x20, 0x6b,
+	0xdf, 0x5b, 0xa4, 0xb4, 0x35, 0x14, 0xa2, 0x71, 0xb6, 0x79, 0x32, 0xad, 0x75, 0x6a, 0x24, 0xe2,
+	0x9a, 0x97, 0xe4, 0xe8, 0x8c, 0x6b, 0xa6, 0x9d, 0xd0, 0xb4, 0x25, 0xfe, 0x73, 0xde, 0xef, 0x81,
+	0x42, 0x4f, 0x31, 0x8e, 0x7a, 0xc0, 0xac, 0x4b, 0x4c, 0xe8, 0x88, 0x71, 0x5c, 0xfd, 0x8e, 0x94,
+	0xef, 0xdb, 0x18, 0xad, 0x90, 0xc5, 0x56, 0xf3, 0x70, 0xd3, 0xdb, 0xda, 0xdc, 0xf4, 0x9a, 0x3b,
+	0xdb, 0xfb, 0x6e, 0xfb, 0xe8, 0xe5, 0xae, 0xb7, 0xd9, 0x3e, 0x58, 0x7b, 0xf1, 0xe2, 0xb3, 0x72,
+	0x86, 0x2e, 0x91, 0xa7, 0x0f, 0xe4, 0x13, 0xb9, 0x97, 0x2d, 0xfa, 0x09, 0xa9, 0x3e, 0x90, 0xdc,
+	0xdd, 0x3f, 0x6e, 0xef, 0x6d, 0x7b, 0x27, 0xed, 0xbd, 0x8d, 0xfd, 0x93, 0xf2, 0xd4, 0x62, 0xee,
+	0x87, 0x9f, 0x2b, 0x99, 0xd6, 0xd7, 0x6f, 0x6e, 0x2a, 0xd6, 0xdb, 0x9b, 0x8a, 0xf5, 0xc7, 0x4d,
+	0xc5, 0xfa, 0xe9, 0xb6, 0x92, 0x79, 0x7b, 0x5b, 0xc9, 0xfc, 0x76, 0x5b, 0xc9, 0x7c, 0xb3, 0xe6,
+	0x33, 0x15, 0x9c, 0x77, 0xea, 0x5d, 0xc1, 0x1b, 0x4d, 0x76, 0x8d, 0xe1, 0x1e, 0xaa, 0x4b, 0x11,
+	0x9f, 0x35, 0xd6, 0x85, 0xe4, 0x9b, 0x17, 0xfc, 0x1f, 0x77, 0x51, 0x5d, 0xf5, 0x51, 0x76, 0xf2,
+	0xfa, 0x8f, 0xf7, 0xf9, 0xdf, 0x03, 0x00, 0xbc, 0x21, 0x10, 0xd8, 0xe3, 0x07, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -166,6 +483,79 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxPriceAge != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.MaxPriceAge))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.FeeDenoms) > 0 {
+		for iNdEx := len(m.FeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FeeDenoms[iNdEx])
+			copy(dAtA[i:], m.FeeDenoms[iNdEx])
+			i = encodeVarintFeemarket(dAtA, i, uint64(len(m.FeeDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	{
+		size := m.CosmosBlockGasShare.Size()
+		i -= size
+		if _, err := m.CosmosBlockGasShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if m.HistorySize != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.HistorySize))
+		i--
+		dAtA[i] = 0x70
+	}
+	{
+		size, err := m.Aimd.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.MaxBaseFee.Size()
+		i -= size
+		if _, err := m.MaxBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.MinBaseFee.Size()
+		i -= size
+		if _, err := m.MinBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.WindowSize != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.WindowSize))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.BaseFeeAlgorithm != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.BaseFeeAlgorithm))
+		i--
+		dAtA[i] = 0x48
+	}
 	{
 		size := m.MinGasMultiplier.Size()
 		i -= size
@@ -224,51 +614,300 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
-	offset -= sovFeemarket(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *AIMDParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *AIMDParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AIMDParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.NoBaseFee {
-		n += 2
+	{
+		size := m.MaxLearningRate.Size()
+		i -= size
+		if _, err := m.MaxLearningRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
 	}
-	if m.BaseFeeChangeDenominator != 0 {
-		n += 1 + sovFeemarket(uint64(m.BaseFeeChangeDenominator))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MinLearningRate.Size()
+		i -= size
+		if _, err := m.MinLearningRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
 	}
-	if m.ElasticityMultiplier != 0 {
-		n += 1 + sovFeemarket(uint64(m.ElasticityMultiplier))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Gamma.Size()
+		i -= size
+		if _, err := m.Gamma.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
 	}
-	if m.EnableHeight != 0 {
-		n += 1 + sovFeemarket(uint64(m.EnableHeight))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Beta.Size()
+		i -= size
+		if _, err := m.Beta.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
 	}
-	l = m.BaseFee.Size()
-	n += 1 + l + sovFeemarket(uint64(l))
-	l = m.MinGasPrice.Size()
-	n += 1 + l + sovFeemarket(uint64(l))
-	l = m.MinGasMultiplier.Size()
-	n += 1 + l + sovFeemarket(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Alpha.Size()
+		i -= size
+		if _, err := m.Alpha.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func sovFeemarket(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *BaseFeeRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozFeemarket(x uint64) (n int) {
-	return sovFeemarket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *BaseFeeRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+
+func (m *BaseFeeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxGas != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.MaxGas))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GasWanted != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasWanted))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeDenomPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDenomPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDenomPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpdateTime != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.UpdateTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFeemarket(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeemarket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NoBaseFee {
+		n += 2
+	}
+	if m.BaseFeeChangeDenominator != 0 {
+		n += 1 + sovFeemarket(uint64(m.BaseFeeChangeDenominator))
+	}
+	if m.ElasticityMultiplier != 0 {
+		n += 1 + sovFeemarket(uint64(m.ElasticityMultiplier))
+	}
+	if m.EnableHeight != 0 {
+		n += 1 + sovFeemarket(uint64(m.EnableHeight))
+	}
+	l = m.BaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.MinGasMultiplier.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.BaseFeeAlgorithm != 0 {
+		n += 1 + sovFeemarket(uint64(m.BaseFeeAlgorithm))
+	}
+	if m.WindowSize != 0 {
+		n += 1 + sovFeemarket(uint64(m.WindowSize))
+	}
+	l = m.MinBaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.MaxBaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.Aimd.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.HistorySize != 0 {
+		n += 1 + sovFeemarket(uint64(m.HistorySize))
+	}
+	l = m.CosmosBlockGasShare.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if len(m.FeeDenoms) > 0 {
+		for _, s := range m.FeeDenoms {
+			l = len(s)
+			n += 2 + l + sovFeemarket(uint64(l))
+		}
+	}
+	if m.MaxPriceAge != 0 {
+		n += 2 + sovFeemarket(uint64(m.MaxPriceAge))
+	}
+	return n
+}
+
+func (m *AIMDParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Alpha.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.Beta.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.Gamma.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.MinLearningRate.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.MaxLearningRate.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	return n
+}
+
+func (m *BaseFeeRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovFeemarket(uint64(m.Height))
+	}
+	l = m.BaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.GasWanted != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasWanted))
+	}
+	if m.MaxGas != 0 {
+		n += 1 + sovFeemarket(uint64(m.MaxGas))
+	}
+	return n
+}
+
+func (m *FeeDenomPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.UpdateTime != 0 {
+		n += 1 + sovFeemarket(uint64(m.UpdateTime))
+	}
+	return n
+}
+
+func sovFeemarket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeemarket(x uint64) (n int) {
+	return sovFeemarket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -476,6 +1115,745 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeAlgorithm", wireType)
+			}
+			m.BaseFeeAlgorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeAlgorithm |= BaseFeeAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowSize", wireType)
+			}
+			m.WindowSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aimd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Aimd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistorySize", wireType)
+			}
+			m.HistorySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistorySize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosBlockGasShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CosmosBlockGasShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenoms = append(m.FeeDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAge", wireType)
+			}
+			m.MaxPriceAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPriceAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AIMDParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AIMDParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AIMDParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Alpha", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Alpha.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Beta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gamma", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Gamma.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLearningRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinLearningRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLearningRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxLearningRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BaseFeeRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BaseFeeRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BaseFeeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasWanted", wireType)
+			}
+			m.GasWanted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasWanted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGas", wireType)
+			}
+			m.MaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDenomPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDenomPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDenomPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
+			}
+			m.UpdateTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeemarket(dAtA[iNdEx:])
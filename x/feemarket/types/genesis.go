@@ -2,11 +2,14 @@
 // SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
 package types
 
+import "fmt"
+
 // DefaultGenesisState sets default fee market genesis state.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:   DefaultParams(),
-		BlockGas: 0,
+		Params:       DefaultParams(),
+		BlockGas:     0,
+		LearningRate: DefaultAIMDParams.MinLearningRate,
 	}
 }
 
@@ -21,5 +24,27 @@ func NewGenesisState(params Params, blockGas uint64) *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if !gs.LearningRate.IsNil() && gs.LearningRate.IsNegative() {
+		return fmt.Errorf("learning rate cannot be negative: %s", gs.LearningRate)
+	}
+
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(gs.FeeDenomPrices))
+	for _, price := range gs.FeeDenomPrices {
+		if err := price.Validate(); err != nil {
+			return err
+		}
+		if seen[price.Denom] {
+			return fmt.Errorf("duplicated fee denom price: %s", price.Denom)
+		}
+		if !gs.Params.IsFeeDenom(price.Denom) {
+			return fmt.Errorf("price of %s is not a fee denom", price.Denom)
+		}
+		seen[price.Denom] = true
+	}
+
+	return nil
 }
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -31,6 +32,10 @@ type GenesisState struct {
 	// block_gas is the amount of gas wanted on the last block before the upgrade.
 	// Zero by default.
 	BlockGas uint64 `protobuf:"varint,3,opt,name=block_gas,json=blockGas,proto3" json:"block_gas,omitempty"`
+	// learning_rate is the current learning rate of the AIMD base fee algorithm.
+	LearningRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=learning_rate,json=learningRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"learning_rate"`
+	// fee_denom_prices are the latest prices of the fee denoms.
+	FeeDenomPrices []FeeDenomPrice `protobuf:"bytes,5,rep,name=fee_denom_prices,json=feeDenomPrices,proto3" json:"fee_denom_prices"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -80,6 +85,13 @@ func (m *GenesisState) GetBlockGas() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetFeeDenomPrices() []FeeDenomPrice {
+	if m != nil {
+		return m.FeeDenomPrices
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ethermint.feemarket.v1.GenesisState")
 }
@@ -89,24 +101,31 @@ func init() {
 }
 
 var fileDescriptor_6241c21661288629 = []byte{
-	// 262 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x49, 0x2d, 0xc9, 0x48,
-	0x2d, 0xca, 0xcd, 0xcc, 0x2b, 0xd1, 0x4f, 0x4b, 0x4d, 0xcd, 0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0xd1,
-	0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x83, 0xab, 0xd2, 0x83, 0xab, 0xd2, 0x2b, 0x33, 0x94, 0x12, 0x4c, 0xcc, 0xcd, 0xcc,
-	0xcb, 0xd7, 0x07, 0x93, 0x10, 0xa5, 0x52, 0x6a, 0x38, 0x0c, 0x44, 0xe8, 0x83, 0xa8, 0x13, 0x49,
-	0xcf, 0x4f, 0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0x88, 0xa8, 0x52, 0x1d, 0x17, 0x8f, 0x3b, 0xc4,
-	0xe6, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x47, 0x2e, 0xb6, 0x82, 0xc4, 0xa2, 0xc4, 0xdc, 0x62,
-	0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x39, 0x3d, 0xec, 0x2e, 0xd1, 0x0b, 0x00, 0xab, 0x72,
-	0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x50, 0x8d, 0x42, 0xd2,
-	0x5c, 0x9c, 0x49, 0x39, 0xf9, 0xc9, 0xd9, 0xf1, 0xe9, 0x89, 0xc5, 0x12, 0xcc, 0x0a, 0x8c, 0x1a,
-	0x2c, 0x41, 0x1c, 0x60, 0x01, 0xf7, 0xc4, 0x62, 0x2f, 0x16, 0x0e, 0x26, 0x01, 0xe6, 0x20, 0x8e,
-	0xa4, 0xc4, 0xe2, 0xd4, 0xf8, 0xb4, 0xd4, 0x54, 0x27, 0xb7, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c,
-	0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e,
-	0x3c, 0x96, 0x63, 0x88, 0xd2, 0x49, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5,
-	0x4f, 0x2d, 0xcb, 0xcd, 0x2f, 0x86, 0x92, 0x65, 0x46, 0x06, 0xfa, 0x15, 0x48, 0x5e, 0x2d, 0xa9,
-	0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x7b, 0xc7, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x2a, 0x4a,
-	0xda, 0x75, 0x5f, 0x01, 0x00, 0x00,
+	// 373 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x4f, 0xee, 0xd2, 0x40,
+	0x14, 0xc7, 0x3b, 0x3f, 0x90, 0x40, 0x41, 0x83, 0x8d, 0x31, 0x0d, 0x24, 0xa5, 0x31, 0x6a, 0x1a,
+	0x17, 0x33, 0x01, 0x4f, 0x00, 0xa2, 0x24, 0xfe, 0x0b, 0xa9, 0x3b, 0x36, 0xcd, 0xb4, 0xbc, 0x96,
+	0x49, 0x99, 0x4e, 0x33, 0x33, 0xa2, 0x78, 0x0a, 0x8f, 0xa1, 0x3b, 0x8f, 0xc1, 0x92, 0xa5, 0x71,
+	0x41, 0x0c, 0x2c, 0xbc, 0x86, 0x69, 0xab, 0xc8, 0x42, 0x36, 0x93, 0x97, 0x37, 0x9f, 0xef, 0x7b,
+	0x9f, 0xcc, 0x98, 0x0f, 0x41, 0xaf, 0x40, 0x72, 0x96, 0x69, 0x12, 0x03, 0x70, 0x2a, 0x53, 0xd0,
+	0x64, 0x33, 0x24, 0x09, 0x64, 0xa0, 0x98, 0xc2, 0xb9, 0x14, 0x5a, 0x58, 0xf7, 0xcf, 0x14, 0x3e,
+	0x53, 0x78, 0x33, 0xec, 0xdd, 0xa5, 0x9c, 0x65, 0x82, 0x94, 0x67, 0x85, 0xf6, 0x1e, 0x5f, 0x19,
+	0xf8, 0x2f, 0x57, 0x71, 0xf7, 0x12, 0x91, 0x88, 0xb2, 0x24, 0x45, 0x55, 0x75, 0x1f, 0x7c, 0xbd,
+	0x31, 0x3b, 0xb3, 0x6a, 0xf5, 0x3b, 0x4d, 0x35, 0x58, 0x63, 0xb3, 0x91, 0x53, 0x49, 0xb9, 0xb2,
+	0x91, 0x8b, 0xbc, 0xf6, 0xc8, 0xc1, 0xff, 0x57, 0xc1, 0xf3, 0x92, 0x9a, 0xb4, 0x76, 0x87, 0x81,
+	0xf1, 0xe5, 0xd7, 0xb7, 0x27, 0xc8, 0xff, 0x13, 0xb4, 0xfa, 0x66, 0x2b, 0x5c, 0x8b, 0x28, 0x0d,
+	0x12, 0xaa, 0xec, 0x9a, 0x8b, 0xbc, 0xba, 0xdf, 0x2c, 0x1b, 0x33, 0xaa, 0xac, 0x37, 0xe6, 0xed,
+	0x35, 0x50, 0x99, 0xb1, 0x2c, 0x09, 0x24, 0xd5, 0x60, 0xd7, 0x5d, 0xe4, 0xb5, 0x26, 0x5e, 0x31,
+	0xe6, 0xc7, 0x61, 0xd0, 0x8f, 0x84, 0xe2, 0x42, 0xa9, 0x65, 0x8a, 0x99, 0x20, 0x9c, 0xea, 0x15,
+	0x7e, 0x0d, 0x09, 0x8d, 0xb6, 0x53, 0x88, 0xaa, 0x2d, 0x9d, 0xbf, 0x71, 0xbf, 0xd0, 0x5d, 0x98,
+	0xdd, 0x18, 0x20, 0x58, 0x42, 0x26, 0x78, 0x90, 0x4b, 0x16, 0x81, 0xb2, 0x6f, 0xb9, 0x35, 0xaf,
+	0x3d, 0x7a, 0x74, 0x4d, 0xfc, 0x05, 0xc0, 0xb4, 0xc0, 0xe7, 0x05, 0x7d, 0xe9, 0x7f, 0x27, 0xbe,
+	0xbc, 0x51, 0x2f, 0xeb, 0xcd, 0x9b, 0x6e, 0xcd, 0x6f, 0x86, 0x54, 0x41, 0x10, 0x03, 0x4c, 0x5e,
+	0xed, 0x8e, 0x0e, 0xda, 0x1f, 0x1d, 0xf4, 0xf3, 0xe8, 0xa0, 0xcf, 0x27, 0xc7, 0xd8, 0x9f, 0x1c,
+	0xe3, 0xfb, 0xc9, 0x31, 0x16, 0xc3, 0x84, 0xe9, 0xd5, 0xfb, 0x10, 0x47, 0x82, 0x93, 0x31, 0xfb,
+	0x04, 0xeb, 0xb7, 0xa0, 0x3f, 0x08, 0x99, 0x92, 0x67, 0x42, 0xf1, 0xe7, 0x1b, 0x4e, 0x3e, 0x5e,
+	0xfc, 0x8d, 0xde, 0xe6, 0xa0, 0xc2, 0x46, 0xf9, 0xfe, 0x4f, 0x7f, 0x0f, 0x00, 0x00, 0x84, 0xfc,
+	0xd5, 0x10, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -129,6 +148,30 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeeDenomPrices) > 0 {
+		for iNdEx := len(m.FeeDenomPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeDenomPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.LearningRate.Size()
+		i -= size
+		if _, err := m.LearningRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if m.BlockGas != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockGas))
 		i--
@@ -169,6 +212,14 @@ func (m *GenesisState) Size() (n int) {
 	if m.BlockGas != 0 {
 		n += 1 + sovGenesis(uint64(m.BlockGas))
 	}
+	l = m.LearningRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.FeeDenomPrices) > 0 {
+		for _, e := range m.FeeDenomPrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -259,6 +310,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LearningRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LearningRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenomPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenomPrices = append(m.FeeDenomPrices, FeeDenomPrice{})
+			if err := m.FeeDenomPrices[len(m.FeeDenomPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,8 @@ package types
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+
 	"github.com/stretchr/testify/suite"
 )
 
@@ -15,6 +17,10 @@ func TestGenesisTestSuite(t *testing.T) {
 }
 
 func (suite *GenesisTestSuite) TestValidateGenesis() {
+	feeDenomParams := DefaultParams()
+	feeDenomParams.FeeDenoms = []string{"uusdc"}
+	feeDenomParams.MaxPriceAge = 60
+
 	testCases := []struct {
 		name     string
 		genState *GenesisState
@@ -30,6 +36,8 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			&GenesisState{
 				DefaultParams(),
 				uint64(1),
+				math.LegacyNewDecWithPrec(1, 1),
+				nil,
 			},
 			true,
 		},
@@ -49,6 +57,49 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			false,
 		},
+		{
+			"negative learning rate",
+			&GenesisState{
+				Params:       DefaultParams(),
+				LearningRate: math.LegacyNewDec(-1),
+			},
+			false,
+		},
+		{
+			"valid genesis with fee denom prices",
+			&GenesisState{
+				Params:         feeDenomParams,
+				FeeDenomPrices: []FeeDenomPrice{NewFeeDenomPrice("uusdc", math.LegacyNewDec(2), 10)},
+			},
+			true,
+		},
+		{
+			"invalid genesis - price of a denom not accepted",
+			&GenesisState{
+				Params:         DefaultParams(),
+				FeeDenomPrices: []FeeDenomPrice{NewFeeDenomPrice("uusdc", math.LegacyNewDec(2), 10)},
+			},
+			false,
+		},
+		{
+			"invalid genesis - duplicated fee denom price",
+			&GenesisState{
+				Params: feeDenomParams,
+				FeeDenomPrices: []FeeDenomPrice{
+					NewFeeDenomPrice("uusdc", math.LegacyNewDec(2), 10),
+					NewFeeDenomPrice("uusdc", math.LegacyNewDec(3), 20),
+				},
+			},
+			false,
+		},
+		{
+			"invalid genesis - zero fee denom price",
+			&GenesisState{
+				Params:         feeDenomParams,
+				FeeDenomPrices: []FeeDenomPrice{NewFeeDenomPrice("uusdc", math.LegacyZeroDec(), 10)},
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -22,6 +22,9 @@ const (
 const (
 	prefixBlockGasWanted    = iota + 1
 	deprecatedPrefixBaseFee // unused
+	prefixBaseFeeHistory
+	prefixLearningRate
+	prefixFeeDenomPrice
 )
 
 const (
@@ -31,6 +34,9 @@ const (
 // KVStore key prefixes
 var (
 	KeyPrefixBlockGasWanted = []byte{prefixBlockGasWanted}
+	KeyPrefixBaseFeeHistory = []byte{prefixBaseFeeHistory}
+	KeyPrefixLearningRate   = []byte{prefixLearningRate}
+	KeyPrefixFeeDenomPrice  = []byte{prefixFeeDenomPrice}
 )
 
 // Transient Store key prefixes
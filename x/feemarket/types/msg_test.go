@@ -3,6 +3,7 @@ package types
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/suite"
@@ -51,3 +52,50 @@ func (suite *MsgsTestSuite) TestMsgUpdateValidateBasic() {
 		})
 	}
 }
+
+func (suite *MsgsTestSuite) TestMsgSetFeeDenomPriceValidateBasic() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name    string
+		msg     *MsgSetFeeDenomPrice
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			&MsgSetFeeDenomPrice{Authority: "invalid", Denom: "uusdc", Price: math.LegacyNewDec(2)},
+			false,
+		},
+		{
+			"fail - invalid denom",
+			&MsgSetFeeDenomPrice{Authority: authority, Denom: "", Price: math.LegacyNewDec(2)},
+			false,
+		},
+		{
+			"fail - nil price",
+			&MsgSetFeeDenomPrice{Authority: authority, Denom: "uusdc"},
+			false,
+		},
+		{
+			"fail - negative price",
+			&MsgSetFeeDenomPrice{Authority: authority, Denom: "uusdc", Price: math.LegacyNewDec(-1)},
+			false,
+		},
+		{
+			"pass - valid msg",
+			&MsgSetFeeDenomPrice{Authority: authority, Denom: "uusdc", Price: math.LegacyNewDec(2)},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
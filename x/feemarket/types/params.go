@@ -21,8 +21,32 @@ var (
 	DefaultEnableHeight = int64(0)
 	// DefaultNoBaseFee is false
 	DefaultNoBaseFee = false
+	// DefaultBaseFeeAlgorithm is the EIP-1559 base fee algorithm
+	DefaultBaseFeeAlgorithm = BASE_FEE_ALGORITHM_EIP1559
+	// DefaultWindowSize is 10 blocks
+	DefaultWindowSize = uint64(10)
+	// DefaultHistorySize is 100 blocks
+	DefaultHistorySize = uint64(100)
+	// DefaultMinBaseFee is 0 (i.e disabled)
+	DefaultMinBaseFee = math.LegacyZeroDec()
+	// DefaultMaxBaseFee is 0 (i.e disabled)
+	DefaultMaxBaseFee = math.LegacyZeroDec()
+	// DefaultAIMDParams are the default parameters of the AIMD base fee algorithm
+	DefaultAIMDParams = AIMDParams{
+		Alpha:           math.LegacyNewDecWithPrec(25, 3), // 0.025
+		Beta:            math.LegacyNewDecWithPrec(95, 2), // 0.95
+		Gamma:           math.LegacyNewDecWithPrec(25, 2), // 0.25
+		MinLearningRate: math.LegacyNewDecWithPrec(1, 2),  // 0.01
+		MaxLearningRate: math.LegacyNewDecWithPrec(50, 2), // 0.50
+	}
+	// DefaultCosmosBlockGasShare is 0.1 or 10%
+	DefaultCosmosBlockGasShare = math.LegacyNewDecWithPrec(10, 2)
 )
 
+// MaxHistorySize is the maximum number of blocks for which the base fee
+// history can be kept in the store.
+const MaxHistorySize = uint64(10_000)
+
 // Parameter keys
 var (
 	ParamsKey                             = []byte("Params")
@@ -71,6 +95,13 @@ func NewParams(
 		EnableHeight:             enableHeight,
 		MinGasPrice:              minGasPrice,
 		MinGasMultiplier:         minGasPriceMultiplier,
+		BaseFeeAlgorithm:         DefaultBaseFeeAlgorithm,
+		WindowSize:               DefaultWindowSize,
+		MinBaseFee:               DefaultMinBaseFee,
+		MaxBaseFee:               DefaultMaxBaseFee,
+		Aimd:                     DefaultAIMDParams,
+		HistorySize:              DefaultHistorySize,
+		CosmosBlockGasShare:      DefaultCosmosBlockGasShare,
 	}
 }
 
@@ -84,6 +115,13 @@ func DefaultParams() Params {
 		EnableHeight:             DefaultEnableHeight,
 		MinGasPrice:              DefaultMinGasPrice,
 		MinGasMultiplier:         DefaultMinGasMultiplier,
+		BaseFeeAlgorithm:         DefaultBaseFeeAlgorithm,
+		WindowSize:               DefaultWindowSize,
+		MinBaseFee:               DefaultMinBaseFee,
+		MaxBaseFee:               DefaultMaxBaseFee,
+		Aimd:                     DefaultAIMDParams,
+		HistorySize:              DefaultHistorySize,
+		CosmosBlockGasShare:      DefaultCosmosBlockGasShare,
 	}
 }
 
@@ -105,7 +143,84 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateMinGasPrice(p.MinGasPrice)
+	if err := validateMinGasPrice(p.MinGasPrice); err != nil {
+		return err
+	}
+
+	if err := validateBaseFeeAlgorithm(p.BaseFeeAlgorithm); err != nil {
+		return err
+	}
+
+	if err := validateBaseFeeBounds(p.MinBaseFee, p.MaxBaseFee); err != nil {
+		return err
+	}
+
+	if p.HistorySize == 0 || p.HistorySize > MaxHistorySize {
+		return fmt.Errorf("history size must be between 1 and %d: %d", MaxHistorySize, p.HistorySize)
+	}
+
+	if p.WindowSize == 0 || p.WindowSize > p.HistorySize {
+		return fmt.Errorf("window size must be between 1 and the history size %d: %d", p.HistorySize, p.WindowSize)
+	}
+
+	if err := validateCosmosBlockGasShare(p.CosmosBlockGasShare); err != nil {
+		return err
+	}
+
+	if err := validateFeeDenoms(p.FeeDenoms); err != nil {
+		return err
+	}
+
+	if len(p.FeeDenoms) > 0 && p.MaxPriceAge == 0 {
+		return fmt.Errorf("max price age cannot be 0 when fee denoms are accepted")
+	}
+
+	return p.Aimd.Validate()
+}
+
+// Validate performs basic validation on the AIMD base fee algorithm parameters.
+func (p AIMDParams) Validate() error {
+	for _, v := range []math.LegacyDec{p.Alpha, p.Beta, p.Gamma, p.MinLearningRate, p.MaxLearningRate} {
+		if v.IsNil() {
+			return fmt.Errorf("invalid AIMD parameter: nil")
+		}
+		if v.IsNegative() {
+			return fmt.Errorf("AIMD parameter cannot be negative: %s", v)
+		}
+	}
+
+	if p.Beta.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("AIMD beta cannot be greater than 1: %s", p.Beta)
+	}
+
+	if p.Gamma.GT(math.LegacyNewDecWithPrec(5, 1)) {
+		return fmt.Errorf("AIMD gamma cannot be greater than 0.5: %s", p.Gamma)
+	}
+
+	if p.MaxLearningRate.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("AIMD max learning rate cannot be greater than 1: %s", p.MaxLearningRate)
+	}
+
+	if p.MinLearningRate.GT(p.MaxLearningRate) {
+		return fmt.Errorf(
+			"AIMD min learning rate %s cannot be greater than the max learning rate %s",
+			p.MinLearningRate, p.MaxLearningRate,
+		)
+	}
+
+	return nil
+}
+
+// BoundBaseFee returns the base fee bounded by the min and max base fee
+// parameters. A zero bound is disabled.
+func (p Params) BoundBaseFee(baseFee math.LegacyDec) math.LegacyDec {
+	if !p.MinBaseFee.IsNil() && p.MinBaseFee.IsPositive() {
+		baseFee = math.LegacyMaxDec(baseFee, p.MinBaseFee)
+	}
+	if !p.MaxBaseFee.IsNil() && p.MaxBaseFee.IsPositive() {
+		baseFee = math.LegacyMinDec(baseFee, p.MaxBaseFee)
+	}
+	return baseFee
 }
 
 func validateBool(i interface{}) error {
@@ -185,6 +300,45 @@ func validateEnableHeight(i interface{}) error {
 	return nil
 }
 
+func validateBaseFeeAlgorithm(algorithm BaseFeeAlgorithm) error {
+	if _, ok := BaseFeeAlgorithm_name[int32(algorithm)]; !ok {
+		return fmt.Errorf("invalid base fee algorithm: %d", algorithm)
+	}
+	return nil
+}
+
+func validateBaseFeeBounds(minBaseFee, maxBaseFee math.LegacyDec) error {
+	if minBaseFee.IsNil() || maxBaseFee.IsNil() {
+		return fmt.Errorf("invalid base fee bounds: nil")
+	}
+
+	if minBaseFee.IsNegative() {
+		return fmt.Errorf("min base fee cannot be negative: %s", minBaseFee)
+	}
+
+	if maxBaseFee.IsNegative() {
+		return fmt.Errorf("max base fee cannot be negative: %s", maxBaseFee)
+	}
+
+	if maxBaseFee.IsPositive() && minBaseFee.GT(maxBaseFee) {
+		return fmt.Errorf("min base fee %s cannot be greater than the max base fee %s", minBaseFee, maxBaseFee)
+	}
+
+	return nil
+}
+
+func validateCosmosBlockGasShare(share math.LegacyDec) error {
+	if share.IsNil() {
+		return fmt.Errorf("invalid cosmos block gas share: nil")
+	}
+
+	if share.IsNegative() || share.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("cosmos block gas share must be between 0 and 1: %s", share)
+	}
+
+	return nil
+}
+
 func validateMinGasMultiplier(i interface{}) error {
 	v, ok := i.(math.LegacyDec)
 
@@ -57,6 +57,129 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			NewParams(true, 7, 3, math.LegacyNewDec(2000000000), int64(544435345345435345), math.LegacyNewDecWithPrec(20, 4), math.LegacyNewDec(2)),
 			true,
 		},
+		{
+			"invalid: unknown base fee algorithm",
+			func() Params {
+				params := DefaultParams()
+				params.BaseFeeAlgorithm = BaseFeeAlgorithm(3)
+				return params
+			}(),
+			true,
+		},
+		{
+			"invalid: window size zero",
+			func() Params {
+				params := DefaultParams()
+				params.WindowSize = 0
+				return params
+			}(),
+			true,
+		},
+		{
+			"invalid: window size bigger than history size",
+			func() Params {
+				params := DefaultParams()
+				params.WindowSize = params.HistorySize + 1
+				return params
+			}(),
+			true,
+		},
+		{
+			"invalid: history size bigger than max",
+			func() Params {
+				params := DefaultParams()
+				params.HistorySize = MaxHistorySize + 1
+				return params
+			}(),
+			true,
+		},
+		{
+			"invalid: min base fee bigger than max base fee",
+			func() Params {
+				params := DefaultParams()
+				params.MinBaseFee = math.LegacyNewDec(2)
+				params.MaxBaseFee = math.LegacyNewDec(1)
+				return params
+			}(),
+			true,
+		},
+		{
+			"valid: AIMD with base fee bounds",
+			func() Params {
+				params := DefaultParams()
+				params.BaseFeeAlgorithm = BASE_FEE_ALGORITHM_AIMD
+				params.MinBaseFee = math.LegacyNewDec(1)
+				params.MaxBaseFee = math.LegacyNewDec(2)
+				return params
+			}(),
+			false,
+		},
+		{
+			"invalid: AIMD min learning rate bigger than max learning rate",
+			func() Params {
+				params := DefaultParams()
+				params.Aimd.MinLearningRate = params.Aimd.MaxLearningRate.Add(math.LegacyOneDec())
+				return params
+			}(),
+			true,
+		},
+		{
+			"invalid: cosmos block gas share bigger than 1",
+			func() Params {
+				params := DefaultParams()
+				params.CosmosBlockGasShare = math.LegacyNewDecWithPrec(11, 1)
+				return params
+			}(),
+			true,
+		},
+		{
+			"valid: fee denoms with max price age",
+			func() Params {
+				params := DefaultParams()
+				params.FeeDenoms = []string{"uusdc", "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"}
+				params.MaxPriceAge = 60
+				return params
+			}(),
+			false,
+		},
+		{
+			"invalid: fee denoms without max price age",
+			func() Params {
+				params := DefaultParams()
+				params.FeeDenoms = []string{"uusdc"}
+				return params
+			}(),
+			true,
+		},
+		{
+			"invalid: duplicated fee denom",
+			func() Params {
+				params := DefaultParams()
+				params.FeeDenoms = []string{"uusdc", "uusdc"}
+				params.MaxPriceAge = 60
+				return params
+			}(),
+			true,
+		},
+		{
+			"invalid: fee denom",
+			func() Params {
+				params := DefaultParams()
+				params.FeeDenoms = []string{"1"}
+				params.MaxPriceAge = 60
+				return params
+			}(),
+			true,
+		},
+		{
+			"invalid: AIMD beta bigger than 1",
+			func() Params {
+				params := DefaultParams()
+				params.Aimd.Beta = math.LegacyNewDec(2)
+				return params
+			}(),
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
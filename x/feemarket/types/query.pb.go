@@ -275,6 +275,189 @@ func (m *QueryBlockGasResponse) GetGas() int64 {
 	return 0
 }
 
+// QueryBaseFeeHistoryRequest defines the request type for querying the base
+// fee history.
+type QueryBaseFeeHistoryRequest struct {
+	// count is the number of latest blocks to return. Zero returns the whole
+	// stored history.
+	Count uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *QueryBaseFeeHistoryRequest) Reset()         { *m = QueryBaseFeeHistoryRequest{} }
+func (m *QueryBaseFeeHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeHistoryRequest) ProtoMessage()    {}
+func (*QueryBaseFeeHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{6}
+}
+func (m *QueryBaseFeeHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeHistoryRequest.Merge(m, src)
+}
+func (m *QueryBaseFeeHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryBaseFeeHistoryRequest) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// QueryBaseFeeHistoryResponse defines the response type for querying the base
+// fee history.
+type QueryBaseFeeHistoryResponse struct {
+	// history is the base fee history of the latest blocks in ascending height
+	// order
+	History []BaseFeeRecord `protobuf:"bytes,1,rep,name=history,proto3" json:"history"`
+	// next_base_fee is the base fee of the next block
+	NextBaseFee *cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=next_base_fee,json=nextBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"next_base_fee,omitempty"`
+}
+
+func (m *QueryBaseFeeHistoryResponse) Reset()         { *m = QueryBaseFeeHistoryResponse{} }
+func (m *QueryBaseFeeHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeHistoryResponse) ProtoMessage()    {}
+func (*QueryBaseFeeHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{7}
+}
+func (m *QueryBaseFeeHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeHistoryResponse.Merge(m, src)
+}
+func (m *QueryBaseFeeHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryBaseFeeHistoryResponse) GetHistory() []BaseFeeRecord {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+// QueryFeeDenomPricesRequest defines the request type for querying the prices
+// of the fee denoms.
+type QueryFeeDenomPricesRequest struct {
+}
+
+func (m *QueryFeeDenomPricesRequest) Reset()         { *m = QueryFeeDenomPricesRequest{} }
+func (m *QueryFeeDenomPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeDenomPricesRequest) ProtoMessage()    {}
+func (*QueryFeeDenomPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{8}
+}
+func (m *QueryFeeDenomPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeDenomPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeDenomPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeDenomPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeDenomPricesRequest.Merge(m, src)
+}
+func (m *QueryFeeDenomPricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeDenomPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeDenomPricesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeDenomPricesRequest proto.InternalMessageInfo
+
+// QueryFeeDenomPricesResponse defines the response type for querying the
+// prices of the fee denoms.
+type QueryFeeDenomPricesResponse struct {
+	// prices are the latest prices of the fee denoms
+	Prices []FeeDenomPrice `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices"`
+}
+
+func (m *QueryFeeDenomPricesResponse) Reset()         { *m = QueryFeeDenomPricesResponse{} }
+func (m *QueryFeeDenomPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeDenomPricesResponse) ProtoMessage()    {}
+func (*QueryFeeDenomPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{9}
+}
+func (m *QueryFeeDenomPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeDenomPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeDenomPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeDenomPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeDenomPricesResponse.Merge(m, src)
+}
+func (m *QueryFeeDenomPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeDenomPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeDenomPricesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeDenomPricesResponse proto.InternalMessageInfo
+
+func (m *QueryFeeDenomPricesResponse) GetPrices() []FeeDenomPrice {
+	if m != nil {
+		return m.Prices
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "ethermint.feemarket.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "ethermint.feemarket.v1.QueryParamsResponse")
@@ -282,6 +465,10 @@ func init() {
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "ethermint.feemarket.v1.QueryBaseFeeResponse")
 	proto.RegisterType((*QueryBlockGasRequest)(nil), "ethermint.feemarket.v1.QueryBlockGasRequest")
 	proto.RegisterType((*QueryBlockGasResponse)(nil), "ethermint.feemarket.v1.QueryBlockGasResponse")
+	proto.RegisterType((*QueryBaseFeeHistoryRequest)(nil), "ethermint.feemarket.v1.QueryBaseFeeHistoryRequest")
+	proto.RegisterType((*QueryBaseFeeHistoryResponse)(nil), "ethermint.feemarket.v1.QueryBaseFeeHistoryResponse")
+	proto.RegisterType((*QueryFeeDenomPricesRequest)(nil), "ethermint.feemarket.v1.QueryFeeDenomPricesRequest")
+	proto.RegisterType((*QueryFeeDenomPricesResponse)(nil), "ethermint.feemarket.v1.QueryFeeDenomPricesResponse")
 }
 
 func init() {
@@ -289,37 +476,48 @@ func init() {
 }
 
 var fileDescriptor_71a07c1ffd85fde2 = []byte{
-	// 466 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xce, 0x18, 0x4c, 0xdb, 0xf1, 0xa2, 0x63, 0x5a, 0x64, 0x8d, 0x1b, 0x59, 0x54, 0xb4, 0xd6,
-	0x1d, 0x1b, 0x6f, 0xde, 0x0c, 0x52, 0x2f, 0x1e, 0x74, 0x4f, 0xe2, 0xa5, 0x4c, 0xd6, 0xd7, 0xcd,
-	0x92, 0xce, 0xbe, 0xed, 0xce, 0x24, 0x98, 0xab, 0xe0, 0xc5, 0x83, 0x08, 0xfe, 0x09, 0x2f, 0x82,
-	0x3f, 0xa3, 0xc7, 0x82, 0x17, 0xf1, 0x50, 0x24, 0x11, 0xfc, 0x1b, 0x92, 0x99, 0xd9, 0xe8, 0x6a,
-	0xa2, 0xb9, 0x0c, 0x8f, 0xb7, 0xdf, 0xfb, 0xbe, 0xef, 0x7d, 0x8f, 0xa5, 0x01, 0xe8, 0x3e, 0x14,
-	0x32, 0xcd, 0x34, 0x3f, 0x00, 0x90, 0xa2, 0x18, 0x80, 0xe6, 0xa3, 0x5d, 0x7e, 0x34, 0x84, 0x62,
-	0x1c, 0xe6, 0x05, 0x6a, 0x64, 0x5b, 0x73, 0x4c, 0x38, 0xc7, 0x84, 0xa3, 0x5d, 0xef, 0x82, 0x90,
-	0x69, 0x86, 0xdc, 0xbc, 0x16, 0xea, 0xdd, 0x58, 0x42, 0xf7, 0x6b, 0xce, 0xe2, 0x9a, 0x09, 0x26,
-	0x68, 0x4a, 0x3e, 0xab, 0x5c, 0xb7, 0x95, 0x20, 0x26, 0x87, 0xc0, 0x45, 0x9e, 0x72, 0x91, 0x65,
-	0xa8, 0x85, 0x4e, 0x31, 0x53, 0xf6, 0x6b, 0xd0, 0xa4, 0xec, 0xe9, 0xcc, 0xd5, 0x13, 0x51, 0x08,
-	0xa9, 0x22, 0x38, 0x1a, 0x82, 0xd2, 0xc1, 0x33, 0x7a, 0xb1, 0xd2, 0x55, 0x39, 0x66, 0x0a, 0xd8,
-	0x03, 0xda, 0xc8, 0x4d, 0xe7, 0x12, 0xb9, 0x4a, 0x6e, 0x9e, 0xeb, 0xf8, 0xe1, 0xe2, 0x25, 0x42,
-	0x3b, 0xd7, 0xdd, 0x38, 0x3e, 0x6d, 0xd7, 0x3e, 0xfc, 0xf8, 0xb4, 0x4d, 0x22, 0x37, 0x18, 0x6c,
-	0x3a, 0xe6, 0xae, 0x50, 0xb0, 0x07, 0x50, 0x0a, 0x46, 0xb4, 0x59, 0x6d, 0x3b, 0xc5, 0xfb, 0x74,
-	0xbd, 0x27, 0x14, 0xec, 0x1f, 0x00, 0x18, 0xcd, 0x8d, 0x6e, 0xfb, 0xeb, 0x69, 0xfb, 0x72, 0x8c,
-	0x4a, 0xa2, 0x52, 0x2f, 0x06, 0x61, 0x8a, 0x5c, 0x0a, 0xdd, 0x0f, 0x1f, 0x43, 0x22, 0xe2, 0xf1,
-	0x43, 0x88, 0xa3, 0xb5, 0x9e, 0xe5, 0x08, 0xb6, 0x4a, 0xce, 0x43, 0x8c, 0x07, 0x8f, 0xc4, 0x7c,
-	0xb9, 0x5b, 0x74, 0xf3, 0x8f, 0xbe, 0x13, 0x3b, 0x4f, 0xeb, 0x89, 0xb0, 0xbb, 0xd5, 0xa3, 0x59,
-	0xd9, 0xf9, 0x58, 0xa7, 0x67, 0x0d, 0x96, 0xbd, 0x26, 0xb4, 0x61, 0xb7, 0x62, 0xdb, 0xcb, 0xb6,
-	0xfe, 0x3b, 0x48, 0xef, 0xf6, 0x4a, 0x58, 0xab, 0x1f, 0x04, 0xaf, 0x3e, 0x7f, 0x7f, 0x7f, 0xa6,
-	0xc5, 0x3c, 0x0e, 0x23, 0x89, 0xaa, 0x7a, 0x6c, 0x9b, 0x1f, 0x7b, 0x43, 0xe8, 0x9a, 0x0b, 0x89,
-	0xfd, 0x9b, 0xbc, 0x9a, 0xb0, 0xb7, 0xb3, 0x1a, 0xd8, 0x59, 0xb9, 0x66, 0xac, 0xf8, 0xac, 0xb5,
-	0xc8, 0x4a, 0x79, 0x11, 0xf6, 0x96, 0xd0, 0xf5, 0x32, 0x45, 0xf6, 0x1f, 0x81, 0xea, 0x11, 0xbc,
-	0x3b, 0x2b, 0xa2, 0x9d, 0x9f, 0xeb, 0xc6, 0x4f, 0x9b, 0x5d, 0x59, 0xe8, 0x67, 0x86, 0xde, 0x4f,
-	0x84, 0xea, 0xee, 0x1d, 0x4f, 0x7c, 0x72, 0x32, 0xf1, 0xc9, 0xb7, 0x89, 0x4f, 0xde, 0x4d, 0xfd,
-	0xda, 0xc9, 0xd4, 0xaf, 0x7d, 0x99, 0xfa, 0xb5, 0xe7, 0x3b, 0x49, 0xaa, 0xfb, 0xc3, 0x5e, 0x18,
-	0xa3, 0x74, 0x14, 0xf6, 0x1d, 0x75, 0xee, 0xf2, 0x97, 0xbf, 0xd1, 0xe9, 0x71, 0x0e, 0xaa, 0xd7,
-	0x30, 0x3f, 0xc7, 0xbd, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x44, 0x02, 0x20, 0xd0, 0xc9, 0x03,
-	0x00, 0x00,
+	// 645 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xc1, 0x4f, 0x13, 0x4f,
+	0x14, 0xee, 0xc0, 0x8f, 0x02, 0x43, 0x7e, 0x46, 0xc7, 0x42, 0xc8, 0x52, 0xb7, 0x64, 0x03, 0x04,
+	0x11, 0x77, 0x42, 0xb9, 0x79, 0xa3, 0x20, 0x12, 0x35, 0x06, 0x7b, 0x32, 0x5e, 0x9a, 0xe9, 0xf2,
+	0xd8, 0x6e, 0xca, 0xee, 0x94, 0x9d, 0x69, 0xa5, 0xde, 0x34, 0xf1, 0xe2, 0xc1, 0x98, 0xf8, 0x47,
+	0xe8, 0xc9, 0xf8, 0x67, 0x70, 0x24, 0xf1, 0x62, 0x3c, 0x10, 0x03, 0x26, 0xfe, 0x1b, 0x66, 0x67,
+	0xa6, 0x95, 0xd5, 0x02, 0xcb, 0x65, 0x33, 0xfb, 0xe6, 0x7d, 0xdf, 0xfb, 0xde, 0xdb, 0xef, 0x2d,
+	0x76, 0x40, 0x36, 0x20, 0x0e, 0x83, 0x48, 0xd2, 0x5d, 0x80, 0x90, 0xc5, 0x4d, 0x90, 0xb4, 0xb3,
+	0x42, 0xf7, 0xdb, 0x10, 0x77, 0xdd, 0x56, 0xcc, 0x25, 0x27, 0x53, 0xfd, 0x1c, 0xb7, 0x9f, 0xe3,
+	0x76, 0x56, 0xac, 0x1b, 0x2c, 0x0c, 0x22, 0x4e, 0xd5, 0x53, 0xa7, 0x5a, 0x0b, 0xe7, 0xd0, 0xfd,
+	0xc1, 0xe9, 0xbc, 0x82, 0xcf, 0x7d, 0xae, 0x8e, 0x34, 0x39, 0x99, 0x68, 0xd1, 0xe7, 0xdc, 0xdf,
+	0x03, 0xca, 0x5a, 0x01, 0x65, 0x51, 0xc4, 0x25, 0x93, 0x01, 0x8f, 0x84, 0xbe, 0x75, 0x0a, 0x98,
+	0x3c, 0x4d, 0x54, 0x6d, 0xb3, 0x98, 0x85, 0xa2, 0x0a, 0xfb, 0x6d, 0x10, 0xd2, 0x79, 0x86, 0x6f,
+	0xa6, 0xa2, 0xa2, 0xc5, 0x23, 0x01, 0x64, 0x0d, 0xe7, 0x5b, 0x2a, 0x32, 0x8d, 0x66, 0xd1, 0xe2,
+	0x44, 0xd9, 0x76, 0x07, 0x37, 0xe1, 0x6a, 0x5c, 0x65, 0xfc, 0xf0, 0xb8, 0x94, 0xfb, 0xf4, 0xeb,
+	0xcb, 0x12, 0xaa, 0x1a, 0xa0, 0x33, 0x69, 0x98, 0x2b, 0x4c, 0xc0, 0x26, 0x40, 0xaf, 0x60, 0x15,
+	0x17, 0xd2, 0x61, 0x53, 0xf1, 0x1e, 0x1e, 0xab, 0x33, 0x01, 0xb5, 0x5d, 0x00, 0x55, 0x73, 0xbc,
+	0x52, 0xfa, 0x7e, 0x5c, 0x9a, 0xf1, 0xb8, 0x08, 0xb9, 0x10, 0x3b, 0x4d, 0x37, 0xe0, 0x34, 0x64,
+	0xb2, 0xe1, 0x3e, 0x06, 0x9f, 0x79, 0xdd, 0x0d, 0xf0, 0xaa, 0xa3, 0x75, 0xcd, 0xe1, 0x4c, 0xf5,
+	0x38, 0xf7, 0xb8, 0xd7, 0x7c, 0xc0, 0xfa, 0xcd, 0xdd, 0xc6, 0x93, 0x7f, 0xc5, 0x4d, 0xb1, 0xeb,
+	0x78, 0xd8, 0x67, 0xba, 0xb7, 0xe1, 0x6a, 0x72, 0x74, 0xca, 0xd8, 0x3a, 0x2b, 0x6b, 0x2b, 0x10,
+	0x92, 0xc7, 0x5d, 0x43, 0x44, 0x0a, 0x78, 0xc4, 0xe3, 0xed, 0x48, 0x2a, 0xc4, 0x7f, 0x55, 0xfd,
+	0xe2, 0x7c, 0x46, 0x78, 0x66, 0x20, 0xc8, 0x54, 0x79, 0x88, 0x47, 0x1b, 0x3a, 0x34, 0x8d, 0x66,
+	0x87, 0x17, 0x27, 0xca, 0xf3, 0xe7, 0x4d, 0xb1, 0x3f, 0x0c, 0x8f, 0xc7, 0x3b, 0x67, 0x87, 0xd9,
+	0x23, 0x20, 0xeb, 0xf8, 0xff, 0x08, 0x0e, 0x64, 0xad, 0x3f, 0xa3, 0xa1, 0x6c, 0x33, 0x9a, 0x48,
+	0x50, 0x86, 0xde, 0x29, 0x9a, 0x26, 0x37, 0x01, 0x36, 0x20, 0xe2, 0xe1, 0x76, 0x1c, 0x78, 0xd0,
+	0x9f, 0x96, 0x8f, 0x67, 0x06, 0xde, 0x9a, 0x6e, 0xb6, 0x70, 0xbe, 0xa5, 0x22, 0x97, 0x35, 0x93,
+	0xc2, 0xa7, 0x9d, 0xa1, 0xf0, 0xe5, 0x57, 0x79, 0x3c, 0xa2, 0x2a, 0x91, 0x37, 0x08, 0xe7, 0xb5,
+	0x83, 0xc8, 0xd2, 0x79, 0x74, 0xff, 0x9a, 0xd6, 0xba, 0x93, 0x29, 0x57, 0xeb, 0x76, 0x9c, 0xd7,
+	0x5f, 0x7f, 0x7e, 0x18, 0x2a, 0x12, 0x8b, 0x42, 0x27, 0xe4, 0x22, 0xbd, 0x58, 0xda, 0xab, 0xe4,
+	0x2d, 0xc2, 0xa3, 0x66, 0x48, 0xe4, 0x62, 0xf2, 0xb4, 0x9b, 0xad, 0xe5, 0x6c, 0xc9, 0x46, 0xca,
+	0x9c, 0x92, 0x62, 0x93, 0xe2, 0x20, 0x29, 0xbd, 0x2f, 0x4b, 0xde, 0x21, 0x3c, 0xd6, 0x73, 0x2c,
+	0xb9, 0xa4, 0x40, 0xda, 0xf0, 0xd6, 0xdd, 0x8c, 0xd9, 0x46, 0xcf, 0xbc, 0xd2, 0x53, 0x22, 0xb7,
+	0x06, 0xea, 0x49, 0xb2, 0x6b, 0x3e, 0x13, 0xe4, 0x23, 0xc2, 0xd7, 0xd2, 0x16, 0x27, 0xe5, 0x2c,
+	0x7d, 0xa7, 0x97, 0xc8, 0x5a, 0xbd, 0x12, 0xc6, 0x48, 0x5c, 0x56, 0x12, 0x17, 0xc8, 0xdc, 0x45,
+	0x23, 0xab, 0xf5, 0xb6, 0x24, 0x51, 0x9a, 0xb6, 0xef, 0x25, 0x4a, 0x07, 0x6e, 0x82, 0xb5, 0x7a,
+	0x25, 0x4c, 0x16, 0xa5, 0x89, 0xc8, 0x9d, 0x04, 0x54, 0xd3, 0x3b, 0x50, 0x79, 0x74, 0x78, 0x62,
+	0xa3, 0xa3, 0x13, 0x1b, 0xfd, 0x38, 0xb1, 0xd1, 0xfb, 0x53, 0x3b, 0x77, 0x74, 0x6a, 0xe7, 0xbe,
+	0x9d, 0xda, 0xb9, 0xe7, 0x2b, 0x7e, 0x20, 0x1b, 0xed, 0xba, 0xeb, 0xf1, 0x90, 0xae, 0x05, 0x2f,
+	0x61, 0xef, 0x09, 0xc8, 0x17, 0x3c, 0x6e, 0xd2, 0x75, 0x2e, 0xc2, 0xfb, 0x9d, 0x90, 0x1e, 0x9c,
+	0xa1, 0x96, 0xdd, 0x16, 0x88, 0x7a, 0x5e, 0xfd, 0xe1, 0x57, 0x7f, 0x0f, 0x00, 0xbd, 0xbc, 0x51,
+	0xee, 0x8e, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -340,6 +538,11 @@ type QueryClient interface {
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error)
+	// BaseFeeHistory queries the base fee and gas wanted of the latest blocks
+	// and the base fee of the next block.
+	BaseFeeHistory(ctx context.Context, in *QueryBaseFeeHistoryRequest, opts ...grpc.CallOption) (*QueryBaseFeeHistoryResponse, error)
+	// FeeDenomPrices queries the latest prices of the fee denoms.
+	FeeDenomPrices(ctx context.Context, in *QueryFeeDenomPricesRequest, opts ...grpc.CallOption) (*QueryFeeDenomPricesResponse, error)
 }
 
 type queryClient struct {
@@ -377,6 +580,24 @@ func (c *queryClient) BlockGas(ctx context.Context, in *QueryBlockGasRequest, op
 	return out, nil
 }
 
+func (c *queryClient) BaseFeeHistory(ctx context.Context, in *QueryBaseFeeHistoryRequest, opts ...grpc.CallOption) (*QueryBaseFeeHistoryResponse, error) {
+	out := new(QueryBaseFeeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.feemarket.v1.Query/BaseFeeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeDenomPrices(ctx context.Context, in *QueryFeeDenomPricesRequest, opts ...grpc.CallOption) (*QueryFeeDenomPricesResponse, error) {
+	out := new(QueryFeeDenomPricesResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.feemarket.v1.Query/FeeDenomPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of x/feemarket module.
@@ -385,6 +606,11 @@ type QueryServer interface {
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error)
+	// BaseFeeHistory queries the base fee and gas wanted of the latest blocks
+	// and the base fee of the next block.
+	BaseFeeHistory(context.Context, *QueryBaseFeeHistoryRequest) (*QueryBaseFeeHistoryResponse, error)
+	// FeeDenomPrices queries the latest prices of the fee denoms.
+	FeeDenomPrices(context.Context, *QueryFeeDenomPricesRequest) (*QueryFeeDenomPricesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -400,6 +626,12 @@ func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeR
 func (*UnimplementedQueryServer) BlockGas(ctx context.Context, req *QueryBlockGasRequest) (*QueryBlockGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockGas not implemented")
 }
+func (*UnimplementedQueryServer) BaseFeeHistory(ctx context.Context, req *QueryBaseFeeHistoryRequest) (*QueryBaseFeeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BaseFeeHistory not implemented")
+}
+func (*UnimplementedQueryServer) FeeDenomPrices(ctx context.Context, req *QueryFeeDenomPricesRequest) (*QueryFeeDenomPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeDenomPrices not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -459,6 +691,43 @@ func _Query_BlockGas_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BaseFeeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBaseFeeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BaseFeeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.feemarket.v1.Query/BaseFeeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseFeeHistory(ctx, req.(*QueryBaseFeeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeDenomPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeDenomPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeDenomPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.feemarket.v1.Query/FeeDenomPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeDenomPrices(ctx, req.(*QueryFeeDenomPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethermint.feemarket.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -475,6 +744,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BlockGas",
 			Handler:    _Query_BlockGas_Handler,
 		},
+		{
+			MethodName: "BaseFeeHistory",
+			Handler:    _Query_BaseFeeHistory_Handler,
+		},
+		{
+			MethodName: "FeeDenomPrices",
+			Handler:    _Query_FeeDenomPrices_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/feemarket/v1/query.proto",
@@ -645,6 +922,143 @@ func (m *QueryBlockGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryBaseFeeHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextBaseFee != nil {
+		{
+			size := m.NextBaseFee.Size()
+			i -= size
+			if _, err := m.NextBaseFee.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeDenomPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeDenomPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeDenomPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeDenomPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeDenomPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeDenomPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -719,14 +1133,69 @@ func (m *QueryBlockGasResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryBaseFeeHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	return n
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
+
+func (m *QueryBaseFeeHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.NextBaseFee != nil {
+		l = m.NextBaseFee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeDenomPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeDenomPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
@@ -1113,6 +1582,329 @@ func (m *QueryBlockGasResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBaseFeeHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseFeeHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, BaseFeeRecord{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.NextBaseFee = &v
+			if err := m.NextBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeDenomPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeDenomPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeDenomPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeDenomPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeDenomPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeDenomPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, FeeDenomPrice{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
@@ -125,38 +126,143 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgSetFeeDenomPrice defines a Msg for updating the price of a fee denom.
+type MsgSetFeeDenomPrice struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// denom is the fee denom
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// price is the amount of the evm denom worth one unit of the fee denom
+	Price cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price"`
+}
+
+func (m *MsgSetFeeDenomPrice) Reset()         { *m = MsgSetFeeDenomPrice{} }
+func (m *MsgSetFeeDenomPrice) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeDenomPrice) ProtoMessage()    {}
+func (*MsgSetFeeDenomPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78aff2584dbf2838, []int{2}
+}
+func (m *MsgSetFeeDenomPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeeDenomPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeeDenomPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetFeeDenomPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeDenomPrice.Merge(m, src)
+}
+func (m *MsgSetFeeDenomPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeeDenomPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeDenomPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFeeDenomPrice proto.InternalMessageInfo
+
+func (m *MsgSetFeeDenomPrice) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetFeeDenomPrice) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// MsgSetFeeDenomPriceResponse defines the response structure for executing a
+// MsgSetFeeDenomPrice message.
+type MsgSetFeeDenomPriceResponse struct {
+}
+
+func (m *MsgSetFeeDenomPriceResponse) Reset()         { *m = MsgSetFeeDenomPriceResponse{} }
+func (m *MsgSetFeeDenomPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeDenomPriceResponse) ProtoMessage()    {}
+func (*MsgSetFeeDenomPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78aff2584dbf2838, []int{3}
+}
+func (m *MsgSetFeeDenomPriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeeDenomPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeeDenomPriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetFeeDenomPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeDenomPriceResponse.Merge(m, src)
+}
+func (m *MsgSetFeeDenomPriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeeDenomPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeDenomPriceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFeeDenomPriceResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "ethermint.feemarket.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ethermint.feemarket.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetFeeDenomPrice)(nil), "ethermint.feemarket.v1.MsgSetFeeDenomPrice")
+	proto.RegisterType((*MsgSetFeeDenomPriceResponse)(nil), "ethermint.feemarket.v1.MsgSetFeeDenomPriceResponse")
 }
 
 func init() { proto.RegisterFile("ethermint/feemarket/v1/tx.proto", fileDescriptor_78aff2584dbf2838) }
 
 var fileDescriptor_78aff2584dbf2838 = []byte{
-	// 355 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0x2d, 0xc9, 0x48,
-	0x2d, 0xca, 0xcd, 0xcc, 0x2b, 0xd1, 0x4f, 0x4b, 0x4d, 0xcd, 0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0xd1,
-	0x2f, 0x33, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x83, 0x2b, 0xd0,
-	0x83, 0x2b, 0xd0, 0x2b, 0x33, 0x94, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07, 0x93, 0x10,
-	0xa5, 0x52, 0xe2, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0xfa, 0xb9, 0xc5, 0xe9, 0x20, 0x23, 0x72,
-	0x8b, 0xd3, 0xa1, 0x12, 0x92, 0x10, 0x89, 0x78, 0x30, 0x4f, 0x1f, 0xc2, 0x81, 0x4a, 0xa9, 0xe1,
-	0xb0, 0x1f, 0x61, 0x17, 0x44, 0x9d, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x44, 0x3f, 0x88, 0x05, 0x11,
-	0x55, 0x3a, 0xc4, 0xc8, 0xc5, 0xef, 0x5b, 0x9c, 0x1e, 0x5a, 0x90, 0x92, 0x58, 0x92, 0x1a, 0x90,
-	0x58, 0x94, 0x98, 0x5b, 0x2c, 0x64, 0xc6, 0xc5, 0x99, 0x58, 0x5a, 0x92, 0x91, 0x5f, 0x94, 0x59,
-	0x52, 0x29, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xe9, 0x24, 0x71, 0x69, 0x8b, 0xae, 0x08, 0xd4, 0x5a,
-	0xc7, 0x94, 0x94, 0xa2, 0xd4, 0xe2, 0xe2, 0xe0, 0x92, 0xa2, 0xcc, 0xbc, 0xf4, 0x20, 0x84, 0x52,
-	0x21, 0x47, 0x2e, 0xb6, 0x02, 0xb0, 0x09, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0x72, 0x7a,
-	0xd8, 0x7d, 0xae, 0x07, 0xb1, 0xc7, 0x89, 0xf3, 0xc4, 0x3d, 0x79, 0x86, 0x15, 0xcf, 0x37, 0x68,
-	0x31, 0x06, 0x41, 0x35, 0x5a, 0x99, 0x34, 0x3d, 0xdf, 0xa0, 0x85, 0x30, 0xb2, 0xeb, 0xf9, 0x06,
-	0x2d, 0xc5, 0xd4, 0x32, 0x50, 0x90, 0x54, 0x20, 0xf9, 0x0e, 0xcd, 0xc1, 0x4a, 0x92, 0x5c, 0xe2,
-	0x68, 0x42, 0x41, 0xa9, 0xc5, 0x05, 0xf9, 0x79, 0xc5, 0xa9, 0x46, 0x65, 0x5c, 0xcc, 0xbe, 0xc5,
-	0xe9, 0x42, 0x19, 0x5c, 0x3c, 0x28, 0x5e, 0x54, 0xc7, 0xe5, 0x34, 0x34, 0x73, 0xa4, 0xf4, 0x89,
-	0x54, 0x08, 0xb3, 0x50, 0x8a, 0xb5, 0x01, 0xe4, 0x21, 0x27, 0xb7, 0x13, 0x8f, 0xe4, 0x18, 0x2f,
-	0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18,
-	0x6e, 0x3c, 0x96, 0x63, 0x88, 0xd2, 0x49, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf,
-	0xd5, 0x87, 0x78, 0x0d, 0x42, 0x96, 0x19, 0x19, 0xa0, 0x78, 0xb2, 0xa4, 0xb2, 0x20, 0xb5, 0x38,
-	0x89, 0x0d, 0x1c, 0x4d, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x58, 0x97, 0xd3, 0x06, 0x66,
-	0x02, 0x00, 0x00,
+	// 487 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0x33, 0x96, 0x14, 0x32, 0x0a, 0xea, 0x1a, 0x6c, 0xba, 0xc5, 0x4d, 0x0d, 0xa8, 0x21,
+	0xe2, 0x0e, 0x69, 0x45, 0xa4, 0x07, 0x21, 0xb1, 0x7a, 0xd1, 0x48, 0x49, 0xf1, 0xe2, 0x45, 0xb6,
+	0xbb, 0xcf, 0xd9, 0x21, 0xce, 0xce, 0x32, 0x33, 0x8d, 0x8d, 0x27, 0xf1, 0xe8, 0xc9, 0x8f, 0xe1,
+	0x31, 0x07, 0x3f, 0x81, 0xa7, 0x1e, 0x8b, 0x27, 0x11, 0x2c, 0x92, 0x1c, 0x72, 0xf1, 0x43, 0xc8,
+	0xee, 0x6c, 0x1a, 0xbb, 0x4d, 0x45, 0x7b, 0x59, 0x76, 0xde, 0xfb, 0xbf, 0xff, 0x7f, 0x7f, 0x6f,
+	0x58, 0x5c, 0x05, 0x1d, 0x82, 0xe4, 0x2c, 0xd2, 0xe4, 0x15, 0x00, 0xf7, 0x64, 0x0f, 0x34, 0xe9,
+	0x37, 0x89, 0xde, 0x73, 0x63, 0x29, 0xb4, 0xb0, 0xae, 0x1e, 0x09, 0xdc, 0x23, 0x81, 0xdb, 0x6f,
+	0xda, 0x97, 0x3d, 0xce, 0x22, 0x41, 0xd2, 0xa7, 0x91, 0xda, 0x4b, 0xbe, 0x50, 0x5c, 0x28, 0xc2,
+	0x15, 0x4d, 0x2c, 0xb8, 0xa2, 0x59, 0x63, 0xd9, 0x34, 0x5e, 0xa6, 0x27, 0x62, 0x0e, 0x59, 0xeb,
+	0xe6, 0x29, 0xf9, 0xb3, 0x2c, 0xa3, 0x2b, 0x53, 0x41, 0x85, 0x99, 0x4f, 0xde, 0x4c, 0xb5, 0xf6,
+	0x05, 0xe1, 0x8b, 0x1d, 0x45, 0x9f, 0xc7, 0x81, 0xa7, 0x61, 0xcb, 0x93, 0x1e, 0x57, 0xd6, 0x3d,
+	0x5c, 0xf2, 0x76, 0x75, 0x28, 0x24, 0xd3, 0x83, 0x0a, 0x5a, 0x45, 0xf5, 0x52, 0xbb, 0xf2, 0xf5,
+	0xf3, 0x9d, 0x72, 0x16, 0xdb, 0x0a, 0x02, 0x09, 0x4a, 0x6d, 0x6b, 0xc9, 0x22, 0xda, 0x9d, 0x49,
+	0xad, 0x16, 0x5e, 0x8c, 0x53, 0x87, 0xca, 0xb9, 0x55, 0x54, 0x3f, 0xbf, 0xe6, 0xb8, 0xf3, 0xc9,
+	0x5d, 0x93, 0xd3, 0x2e, 0xed, 0x1f, 0x56, 0x0b, 0x9f, 0x26, 0xc3, 0x06, 0xea, 0x66, 0x83, 0x1b,
+	0x77, 0xdf, 0x4f, 0x86, 0x8d, 0x99, 0xe5, 0x87, 0xc9, 0xb0, 0x71, 0x1d, 0xfa, 0xc9, 0x4a, 0xf6,
+	0xfe, 0xa0, 0xcb, 0x7d, 0x70, 0x6d, 0x19, 0x2f, 0xe5, 0x4a, 0x5d, 0x50, 0xb1, 0x88, 0x14, 0xd4,
+	0x7e, 0x20, 0x7c, 0xa5, 0xa3, 0xe8, 0x36, 0xe8, 0xc7, 0x00, 0x9b, 0x10, 0x09, 0xbe, 0x25, 0x99,
+	0x0f, 0x67, 0x66, 0x2c, 0xe3, 0x62, 0x90, 0xb8, 0xa4, 0x88, 0xa5, 0xae, 0x39, 0x58, 0x0f, 0x70,
+	0x31, 0x4e, 0x6c, 0x2b, 0x0b, 0xa9, 0x53, 0x3d, 0x01, 0xfb, 0x7e, 0x58, 0x5d, 0x31, 0x6e, 0x2a,
+	0xe8, 0xb9, 0x4c, 0x10, 0xee, 0xe9, 0xd0, 0x7d, 0x0a, 0xd4, 0xf3, 0x07, 0x9b, 0xe0, 0x1b, 0x6e,
+	0x33, 0xb6, 0x71, 0xff, 0x24, 0xf6, 0x8d, 0xb9, 0xd8, 0x79, 0x8e, 0xda, 0x35, 0xbc, 0x32, 0xa7,
+	0x3c, 0xc5, 0x5f, 0xfb, 0x85, 0xf0, 0x42, 0x47, 0x51, 0x2b, 0xc4, 0x17, 0x8e, 0x5d, 0xf1, 0xad,
+	0xd3, 0xae, 0x26, 0xb7, 0x47, 0x9b, 0xfc, 0xa3, 0x70, 0x9a, 0x68, 0x69, 0x7c, 0xe9, 0xc4, 0xb2,
+	0x6f, 0xff, 0xc5, 0x24, 0x2f, 0xb6, 0xd7, 0xff, 0x43, 0x3c, 0x4d, 0xb5, 0x8b, 0xef, 0x92, 0x75,
+	0xb6, 0x9f, 0xec, 0x8f, 0x1c, 0x74, 0x30, 0x72, 0xd0, 0xcf, 0x91, 0x83, 0x3e, 0x8e, 0x9d, 0xc2,
+	0xc1, 0xd8, 0x29, 0x7c, 0x1b, 0x3b, 0x85, 0x17, 0x4d, 0xca, 0x74, 0xb8, 0xbb, 0xe3, 0xfa, 0x82,
+	0x93, 0x16, 0x7b, 0x0b, 0xaf, 0x9f, 0x81, 0x7e, 0x23, 0x64, 0x8f, 0x3c, 0x14, 0x8a, 0x3f, 0xea,
+	0xf3, 0x63, 0x8b, 0xd6, 0x83, 0x18, 0xd4, 0xce, 0x62, 0xfa, 0x87, 0xac, 0xff, 0x1e, 0x00, 0x38,
+	0x4a, 0xd8, 0x8d, 0xe1, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -174,6 +280,10 @@ type MsgClient interface {
 	// UpdateParams defined a governance operation for updating the x/feemarket module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetFeeDenomPrice defines a governance operation for updating the price of
+	// a fee denom in the evm denom.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	SetFeeDenomPrice(ctx context.Context, in *MsgSetFeeDenomPrice, opts ...grpc.CallOption) (*MsgSetFeeDenomPriceResponse, error)
 }
 
 type msgClient struct {
@@ -193,11 +303,24 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SetFeeDenomPrice(ctx context.Context, in *MsgSetFeeDenomPrice, opts ...grpc.CallOption) (*MsgSetFeeDenomPriceResponse, error) {
+	out := new(MsgSetFeeDenomPriceResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.feemarket.v1.Msg/SetFeeDenomPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defined a governance operation for updating the x/feemarket module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetFeeDenomPrice defines a governance operation for updating the price of
+	// a fee denom in the evm denom.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	SetFeeDenomPrice(context.Context, *MsgSetFeeDenomPrice) (*MsgSetFeeDenomPriceResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -207,6 +330,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) SetFeeDenomPrice(ctx context.Context, req *MsgSetFeeDenomPrice) (*MsgSetFeeDenomPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeeDenomPrice not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -230,6 +356,25 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetFeeDenomPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFeeDenomPrice)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFeeDenomPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.feemarket.v1.Msg/SetFeeDenomPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFeeDenomPrice(ctx, req.(*MsgSetFeeDenomPrice))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethermint.feemarket.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -238,6 +383,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetFeeDenomPrice",
+			Handler:    _Msg_SetFeeDenomPrice_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/feemarket/v1/tx.proto",
@@ -306,6 +455,76 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetFeeDenomPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeeDenomPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeDenomPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeeDenomPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeeDenomPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeDenomPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -341,6 +560,34 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetFeeDenomPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetFeeDenomPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -512,6 +759,204 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetFeeDenomPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFeeDenomPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFeeDenomPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetFeeDenomPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFeeDenomPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFeeDenomPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,264 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package callbacks
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/AizelNetwork/CosmEvm/ibc"
+	callbacksabi "github.com/AizelNetwork/CosmEvm/precompiles/callbacks"
+	"github.com/AizelNetwork/CosmEvm/utils"
+	"github.com/AizelNetwork/CosmEvm/x/evm/statedb"
+	evmtypes "github.com/AizelNetwork/CosmEvm/x/evm/types"
+	"github.com/AizelNetwork/CosmEvm/x/ibc/callbacks/keeper"
+	"github.com/AizelNetwork/CosmEvm/x/ibc/callbacks/types"
+)
+
+var _ porttypes.IBCModule = &IBCMiddleware{}
+
+// IBCMiddleware implements the ICS26 callbacks for the ADR-008 callbacks middleware
+// given the module keeper, the EVM keeper and the underlying application. It executes
+// the callback contracts designated in the memo of ICS20 packets:
+//   - the source callback on acknowledgement and timeout
+//   - the destination callback on a successful receive
+//
+// Callbacks follow the ADR-008 gas policy of types.ExecuteCallback: the relayer must
+// provide their gas limit, bounded by the MaxCallbackGas param, and only contract
+// reverts are tolerated, being logged and emitted as events. Invalid callback data is
+// handled the same way.
+type IBCMiddleware struct {
+	*ibc.Module
+	keeper       keeper.Keeper
+	evmKeeper    types.EVMKeeper
+	callbacksABI abi.ABI
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the module keeper, the EVM keeper
+// and the underlying application.
+func NewIBCMiddleware(k keeper.Keeper, evmKeeper types.EVMKeeper, app porttypes.IBCModule) IBCMiddleware {
+	callbacksABI, err := callbacksabi.LoadABI()
+	if err != nil {
+		panic(err)
+	}
+
+	return IBCMiddleware{
+		Module:       ibc.NewModule(app),
+		keeper:       k,
+		evmKeeper:    evmKeeper,
+		callbacksABI: callbacksABI,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface.
+// It receives the packet through the underlying application and then executes
+// the destination callback, if any, when the acknowledgement is successful.
+// An error acknowledgement is returned if the callback fails with an error other
+// than a contract revert.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) exported.Acknowledgement {
+	ack := im.Module.OnRecvPacket(ctx, packet, relayer)
+
+	// return if the acknowledgement is asynchronous or an error ACK
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return ack
+	}
+
+	maxCallbackGas := im.keeper.GetParams(ctx).MaxCallbackGas
+	callbackData, found, err := types.GetCallbackData(data.Memo, types.DestinationCallbackKey, maxCallbackGas)
+	if !found {
+		return ack
+	}
+
+	if err := im.processCallback(
+		ctx, types.AttributeValueReceive, packet.DestinationPort, packet.DestinationChannel, packet.Sequence,
+		callbackData, err, callbacksabi.PacketReceiveMethod,
+		packet.DestinationChannel, packet.DestinationPort, packet.Sequence, packet.GetData(),
+	); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	return ack
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+// It executes the acknowledgement logic of the underlying application and then
+// executes the source callback, if any.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.Module.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	callbackData, found, err := im.getSourceCallbackData(ctx, packet)
+	if !found {
+		return nil
+	}
+
+	return im.processCallback(
+		ctx, types.AttributeValueAcknowledgment, packet.SourcePort, packet.SourceChannel, packet.Sequence,
+		callbackData, err, callbacksabi.PacketAcknowledgementMethod,
+		packet.SourceChannel, packet.SourcePort, packet.Sequence, packet.GetData(), acknowledgement,
+	)
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+// It executes the timeout logic of the underlying application and then executes
+// the source callback, if any.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.Module.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	callbackData, found, err := im.getSourceCallbackData(ctx, packet)
+	if !found {
+		return nil
+	}
+
+	return im.processCallback(
+		ctx, types.AttributeValueTimeout, packet.SourcePort, packet.SourceChannel, packet.Sequence,
+		callbackData, err, callbacksabi.PacketTimeoutMethod,
+		packet.SourceChannel, packet.SourcePort, packet.Sequence, packet.GetData(),
+	)
+}
+
+// getSourceCallbackData returns the source callback designated in the packet memo.
+// The source callback contract must be the sender of the packet, so that contracts
+// cannot be called on behalf of packets they did not send.
+func (im IBCMiddleware) getSourceCallbackData(ctx sdk.Context, packet channeltypes.Packet) (types.CallbackData, bool, error) {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return types.CallbackData{}, false, nil
+	}
+
+	maxCallbackGas := im.keeper.GetParams(ctx).MaxCallbackGas
+	callbackData, found, err := types.GetCallbackData(data.Memo, types.SourceCallbackKey, maxCallbackGas)
+	if !found || err != nil {
+		return callbackData, found, err
+	}
+
+	sender, err := utils.GetEvmosAddressFromBech32(data.Sender)
+	if err != nil {
+		return callbackData, true, errorsmod.Wrap(types.ErrInvalidCallbackOwner, err.Error())
+	}
+
+	if common.BytesToAddress(sender) != callbackData.ContractAddress {
+		return callbackData, true, errorsmod.Wrapf(
+			types.ErrInvalidCallbackOwner,
+			"contract %s, sender %s", callbackData.ContractAddress, common.BytesToAddress(sender),
+		)
+	}
+
+	return callbackData, true, nil
+}
+
+// processCallback executes the callback method on the callback contract and emits
+// an event with the result. If the callback data is invalid (dataErr), the callback
+// address is not a contract or the contract reverts, the error is logged and the
+// state changes of the callback are discarded. Any other execution error is returned
+// so that the packet fails.
+func (im IBCMiddleware) processCallback(
+	ctx sdk.Context,
+	callbackType, portID, channelID string,
+	sequence uint64,
+	callbackData types.CallbackData,
+	dataErr error,
+	method string,
+	args ...interface{},
+) error {
+	event := sdk.NewEvent(
+		types.EventTypeIBCCallback,
+		sdk.NewAttribute(types.AttributeKeyCallbackType, callbackType),
+		sdk.NewAttribute(types.AttributeKeyPortID, portID),
+		sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+		sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(sequence, 10)),
+	)
+
+	err := dataErr
+	gasUsed := uint64(0)
+	if err == nil {
+		event = event.AppendAttributes(
+			sdk.NewAttribute(types.AttributeKeyContractAddress, callbackData.ContractAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyGasLimit, strconv.FormatUint(callbackData.GasLimit, 10)),
+		)
+
+		account := im.evmKeeper.GetAccountWithoutBalance(ctx, callbackData.ContractAddress)
+		if account == nil || !account.IsContract() {
+			err = errorsmod.Wrapf(types.ErrCallbackFailed, "%s is not a contract", callbackData.ContractAddress)
+		}
+	}
+
+	if err == nil {
+		var revertErr, execErr error
+		gasUsed, revertErr, execErr = types.ExecuteCallback(
+			ctx, callbackData.GasLimit, "ibc callback",
+			im.call(callbackData.ContractAddress, method, args...),
+		)
+		if execErr != nil {
+			return errorsmod.Wrapf(execErr, "failed to execute %s callback", callbackType)
+		}
+		if revertErr != nil {
+			err = errorsmod.Wrap(types.ErrCallbackFailed, revertErr.Error())
+		}
+	}
+
+	if err != nil {
+		ctx.Logger().With("module", types.ModuleName).Error(
+			"failed to execute IBC callback",
+			"callback", callbackType,
+			"port", portID,
+			"channel", channelID,
+			"sequence", sequence,
+			"error", err.Error(),
+		)
+		event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyCallbackError, err.Error()))
+	}
+
+	event = event.AppendAttributes(
+		sdk.NewAttribute(types.AttributeKeyGasUsed, strconv.FormatUint(gasUsed, 10)),
+		sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(err == nil)),
+	)
+	ctx.EventManager().EmitEvent(event)
+
+	return nil
+}
+
+// call returns the call of the given method on the callback contract, using the ICS20
+// precompile address as the sender so that contracts can authenticate the callbacks.
+func (im IBCMiddleware) call(contract common.Address, method string, args ...interface{}) types.ContractCall {
+	return func(ctx sdk.Context, gasLimit uint64) (*evmtypes.MsgEthereumTxResponse, error) {
+		sender := common.HexToAddress(evmtypes.ICS20PrecompileAddress)
+		if im.evmKeeper.GetAccountWithoutBalance(ctx, sender) == nil {
+			if err := im.evmKeeper.SetAccount(ctx, sender, *statedb.NewEmptyAccount()); err != nil {
+				return nil, err
+			}
+		}
+
+		return im.evmKeeper.CallEVMWithGasLimit(ctx, im.callbacksABI, sender, contract, gasLimit, method, args...)
+	}
+}
@@ -0,0 +1,284 @@
+package callbacks_test
+
+import (
+	"errors"
+	"testing"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	callbacksabi "github.com/AizelNetwork/CosmEvm/precompiles/callbacks"
+	"github.com/AizelNetwork/CosmEvm/x/evm/statedb"
+	evmtypes "github.com/AizelNetwork/CosmEvm/x/evm/types"
+	"github.com/AizelNetwork/CosmEvm/x/ibc/callbacks"
+	"github.com/AizelNetwork/CosmEvm/x/ibc/callbacks/keeper"
+	"github.com/AizelNetwork/CosmEvm/x/ibc/callbacks/types"
+)
+
+// mockApp defines an underlying IBC application that succeeds on every packet callback.
+type mockApp struct {
+	porttypes.IBCModule
+	ack exported.Acknowledgement
+}
+
+func (m mockApp) OnRecvPacket(sdk.Context, channeltypes.Packet, sdk.AccAddress) exported.Acknowledgement {
+	return m.ack
+}
+
+func (mockApp) OnAcknowledgementPacket(sdk.Context, channeltypes.Packet, []byte, sdk.AccAddress) error {
+	return nil
+}
+
+func (mockApp) OnTimeoutPacket(sdk.Context, channeltypes.Packet, sdk.AccAddress) error {
+	return nil
+}
+
+type evmCall struct {
+	contract common.Address
+	gasLimit uint64
+	method   string
+}
+
+// mockEVMKeeper records the executed callbacks.
+type mockEVMKeeper struct {
+	contracts map[common.Address]bool
+	calls     *[]evmCall
+	err       error
+}
+
+func (m mockEVMKeeper) GetAccountWithoutBalance(_ sdk.Context, addr common.Address) *statedb.Account {
+	account := statedb.NewEmptyAccount()
+	if m.contracts[addr] {
+		account.CodeHash = common.HexToHash("0x01").Bytes()
+	}
+	return account
+}
+
+func (mockEVMKeeper) SetAccount(sdk.Context, common.Address, statedb.Account) error {
+	return nil
+}
+
+func (m mockEVMKeeper) CallEVMWithGasLimit(
+	_ sdk.Context, _ abi.ABI, _, contract common.Address, gasLimit uint64, method string, _ ...interface{},
+) (*evmtypes.MsgEthereumTxResponse, error) {
+	*m.calls = append(*m.calls, evmCall{contract: contract, gasLimit: gasLimit, method: method})
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &evmtypes.MsgEthereumTxResponse{GasUsed: 21_000}, nil
+}
+
+func newPacket(sender common.Address, memo string) channeltypes.Packet {
+	data := transfertypes.NewFungibleTokenPacketData("aaizel", "1", sdk.AccAddress(sender.Bytes()).String(), "receiver", memo)
+	return channeltypes.Packet{
+		Sequence:           1,
+		SourcePort:         transfertypes.PortID,
+		SourceChannel:      "channel-0",
+		DestinationPort:    transfertypes.PortID,
+		DestinationChannel: "channel-1",
+		Data:               data.GetBytes(),
+	}
+}
+
+func callbackEvents(ctx sdk.Context) []sdk.Event {
+	var events []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeIBCCallback {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+func TestIBCMiddlewareCallbacks(t *testing.T) {
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	other := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	srcMemo := `{"src_callback": {"address": "` + contract.Hex() + `", "gas_limit": "50000"}}`
+	destMemo := `{"dest_callback": {"address": "` + contract.Hex() + `"}}`
+	maxCallbackGas := uint64(200_000)
+
+	acknowledge := func(im callbacks.IBCMiddleware, ctx sdk.Context, packet channeltypes.Packet) error {
+		return im.OnAcknowledgementPacket(ctx, packet, channeltypes.NewResultAcknowledgement([]byte{1}).Acknowledgement(), nil)
+	}
+	timeout := func(im callbacks.IBCMiddleware, ctx sdk.Context, packet channeltypes.Packet) error {
+		return im.OnTimeoutPacket(ctx, packet, nil)
+	}
+	receive := func(im callbacks.IBCMiddleware, ctx sdk.Context, packet channeltypes.Packet) error {
+		if ack := im.OnRecvPacket(ctx, packet, nil); !ack.Success() {
+			return errors.New("expected successful acknowledgement")
+		}
+		return nil
+	}
+
+	testCases := []struct {
+		name       string
+		sender     common.Address
+		memo       string
+		callErr    error
+		relayerGas uint64
+		exec       func(im callbacks.IBCMiddleware, ctx sdk.Context, packet channeltypes.Packet) error
+		expCalls   []evmCall
+		expEvents  int
+		expSuccess bool
+		expGasUsed string
+		expErr     bool
+		expPanic   bool
+	}{
+		{
+			name:   "no callback in memo",
+			sender: contract,
+			memo:   "hello",
+			exec:   acknowledge,
+		},
+		{
+			name:       "acknowledgement - source callback executed",
+			sender:     contract,
+			memo:       srcMemo,
+			exec:       acknowledge,
+			expCalls:   []evmCall{{contract, 50_000, callbacksabi.PacketAcknowledgementMethod}},
+			expEvents:  1,
+			expSuccess: true,
+			expGasUsed: "21000",
+		},
+		{
+			name:       "timeout - source callback executed",
+			sender:     contract,
+			memo:       srcMemo,
+			exec:       timeout,
+			expCalls:   []evmCall{{contract, 50_000, callbacksabi.PacketTimeoutMethod}},
+			expEvents:  1,
+			expSuccess: true,
+			expGasUsed: "21000",
+		},
+		{
+			name:       "timeout - source callback contract is not the sender",
+			sender:     other,
+			memo:       srcMemo,
+			exec:       timeout,
+			expEvents:  1,
+			expGasUsed: "0",
+		},
+		{
+			name:       "acknowledgement - reverted callback does not fail the packet",
+			sender:     contract,
+			memo:       srcMemo,
+			callErr:    errorsmod.Wrap(evmtypes.ErrVMExecution, "execution reverted"),
+			exec:       acknowledge,
+			expCalls:   []evmCall{{contract, 50_000, callbacksabi.PacketAcknowledgementMethod}},
+			expEvents:  1,
+			expGasUsed: "50000",
+		},
+		{
+			name:     "acknowledgement - callback error fails the packet",
+			sender:   contract,
+			memo:     srcMemo,
+			callErr:  errors.New("state error"),
+			exec:     acknowledge,
+			expCalls: []evmCall{{contract, 50_000, callbacksabi.PacketAcknowledgementMethod}},
+			expErr:   true,
+		},
+		{
+			name:       "timeout - relayed with less gas than the callback gas limit",
+			sender:     contract,
+			memo:       srcMemo,
+			relayerGas: 49_999,
+			exec:       timeout,
+			expPanic:   true,
+		},
+		{
+			name:       "receive - destination callback executed with the max gas",
+			sender:     other,
+			memo:       destMemo,
+			exec:       receive,
+			expCalls:   []evmCall{{contract, maxCallbackGas, callbacksabi.PacketReceiveMethod}},
+			expEvents:  1,
+			expSuccess: true,
+			expGasUsed: "21000",
+		},
+		{
+			name:     "receive - callback error returns an error acknowledgement",
+			sender:   other,
+			memo:     destMemo,
+			callErr:  errors.New("state error"),
+			exec:     receive,
+			expCalls: []evmCall{{contract, maxCallbackGas, callbacksabi.PacketReceiveMethod}},
+			expErr:   true,
+		},
+		{
+			name:   "receive - source callback is ignored",
+			sender: contract,
+			memo:   srcMemo,
+			exec: func(im callbacks.IBCMiddleware, ctx sdk.Context, packet channeltypes.Packet) error {
+				im.OnRecvPacket(ctx, packet, nil)
+				return nil
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			key := storetypes.NewKVStoreKey(types.StoreKey)
+			ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+			if tc.relayerGas != 0 {
+				ctx = ctx.WithGasMeter(storetypes.NewGasMeter(tc.relayerGas))
+			}
+
+			k := keeper.NewKeeper(key, types.ModuleCdc, authtypes.NewModuleAddress(govtypes.ModuleName))
+			require.NoError(t, k.SetParams(ctx, types.NewParams(maxCallbackGas)))
+
+			calls := []evmCall{}
+			evmKeeper := mockEVMKeeper{
+				contracts: map[common.Address]bool{contract: true},
+				calls:     &calls,
+				err:       tc.callErr,
+			}
+			app := mockApp{ack: channeltypes.NewResultAcknowledgement([]byte{1})}
+			im := callbacks.NewIBCMiddleware(k, evmKeeper, app)
+
+			if tc.expPanic {
+				defer func() {
+					_, ok := recover().(storetypes.ErrorOutOfGas)
+					require.True(t, ok, "expected an out of gas panic")
+					require.Empty(t, calls)
+				}()
+			}
+
+			err := tc.exec(im, ctx, newPacket(tc.sender, tc.memo))
+			require.False(t, tc.expPanic, "expected a panic")
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			if tc.expCalls == nil {
+				require.Empty(t, calls)
+			} else {
+				require.Equal(t, tc.expCalls, calls)
+			}
+
+			events := callbackEvents(ctx)
+			require.Len(t, events, tc.expEvents)
+			if tc.expEvents > 0 {
+				success, found := events[0].GetAttribute(types.AttributeKeySuccess)
+				require.True(t, found)
+				require.Equal(t, tc.expSuccess, success.Value == "true")
+
+				gasUsed, found := events[0].GetAttribute(types.AttributeKeyGasUsed)
+				require.True(t, found)
+				require.Equal(t, tc.expGasUsed, gasUsed.Value)
+			}
+		})
+	}
+}
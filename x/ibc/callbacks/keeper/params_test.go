@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AizelNetwork/CosmEvm/x/ibc/callbacks/keeper"
+	"github.com/AizelNetwork/CosmEvm/x/ibc/callbacks/types"
+)
+
+func TestUpdateParams(t *testing.T) {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	testCases := []struct {
+		name     string
+		msg      *types.MsgUpdateParams
+		expError bool
+	}{
+		{
+			"fail - invalid authority",
+			&types.MsgUpdateParams{Authority: "aizel1fl48vsnmsdzcv85q5d2q4z5ajdha8yu3kc7lxl", Params: types.NewParams(50_000)},
+			true,
+		},
+		{
+			"fail - invalid params",
+			&types.MsgUpdateParams{Authority: authority.String(), Params: types.NewParams(0)},
+			true,
+		},
+		{
+			"pass - valid update",
+			&types.MsgUpdateParams{Authority: authority.String(), Params: types.NewParams(50_000)},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			key := storetypes.NewKVStoreKey(types.StoreKey)
+			ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+			k := keeper.NewKeeper(key, types.ModuleCdc, authority)
+			require.NoError(t, k.SetParams(ctx, types.DefaultParams()))
+
+			_, err := k.UpdateParams(ctx, tc.msg)
+			if tc.expError {
+				require.Error(t, err)
+				require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.msg.Params, k.GetParams(ctx))
+			}
+		})
+	}
+}
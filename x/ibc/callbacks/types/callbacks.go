@@ -0,0 +1,87 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"encoding/json"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// SourceCallbackKey defines the memo key of the callback executed on the
+	// source chain when the packet is acknowledged or times out.
+	SourceCallbackKey = "src_callback"
+	// DestinationCallbackKey defines the memo key of the callback executed on
+	// the destination chain when the packet is received.
+	DestinationCallbackKey = "dest_callback"
+	// CallbackAddressKey defines the memo key of the callback contract address.
+	CallbackAddressKey = "address"
+	// CallbackGasLimitKey defines the memo key of the callback gas limit.
+	CallbackGasLimitKey = "gas_limit"
+)
+
+// CallbackData defines the callback contract and the gas limit of its execution.
+type CallbackData struct {
+	ContractAddress common.Address
+	GasLimit        uint64
+}
+
+// GetCallbackData parses the callback designated under the given key of the packet
+// memo, following ADR-008:
+//
+//	{"src_callback": {"address": "0x...", "gas_limit": "100000"}}
+//
+// It returns false if the memo does not designate a callback for the key. The gas
+// limit defaults to, and is capped by, the given maximum callback gas.
+func GetCallbackData(memo, callbackKey string, maxCallbackGas uint64) (CallbackData, bool, error) {
+	if memo == "" {
+		return CallbackData{}, false, nil
+	}
+
+	// memos that are not JSON objects do not designate callbacks
+	var memoMap map[string]interface{}
+	if err := json.Unmarshal([]byte(memo), &memoMap); err != nil {
+		return CallbackData{}, false, nil
+	}
+
+	callback, found := memoMap[callbackKey]
+	if !found {
+		return CallbackData{}, false, nil
+	}
+
+	callbackMap, ok := callback.(map[string]interface{})
+	if !ok {
+		return CallbackData{}, true, errorsmod.Wrapf(ErrInvalidCallbackData, "%s must be a JSON object", callbackKey)
+	}
+
+	address, ok := callbackMap[CallbackAddressKey].(string)
+	if !ok || !common.IsHexAddress(address) {
+		return CallbackData{}, true, errorsmod.Wrapf(ErrInvalidCallbackData, "invalid callback address: %v", callbackMap[CallbackAddressKey])
+	}
+
+	gasLimit := maxCallbackGas
+	if rawGasLimit, found := callbackMap[CallbackGasLimitKey]; found {
+		gasLimitStr, ok := rawGasLimit.(string)
+		if !ok {
+			return CallbackData{}, true, errorsmod.Wrapf(ErrInvalidCallbackData, "invalid callback gas limit: %v", rawGasLimit)
+		}
+
+		userGasLimit, err := strconv.ParseUint(gasLimitStr, 10, 64)
+		if err != nil {
+			return CallbackData{}, true, errorsmod.Wrapf(ErrInvalidCallbackData, "invalid callback gas limit: %s", gasLimitStr)
+		}
+
+		if userGasLimit != 0 && userGasLimit < maxCallbackGas {
+			gasLimit = userGasLimit
+		}
+	}
+
+	return CallbackData{
+		ContractAddress: common.HexToAddress(address),
+		GasLimit:        gasLimit,
+	}, true, nil
+}
@@ -0,0 +1,73 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AizelNetwork/CosmEvm/x/ibc/callbacks/types"
+)
+
+func TestGetCallbackData(t *testing.T) {
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	maxGas := uint64(500_000)
+
+	testCases := []struct {
+		name     string
+		memo     string
+		key      string
+		expFound bool
+		expError bool
+		expData  types.CallbackData
+	}{
+		{"empty memo", "", types.SourceCallbackKey, false, false, types.CallbackData{}},
+		{"non JSON memo", "hello", types.SourceCallbackKey, false, false, types.CallbackData{}},
+		{"no callback key", `{"wasm": {}}`, types.SourceCallbackKey, false, false, types.CallbackData{}},
+		{
+			"other callback key",
+			`{"dest_callback": {"address": "` + contract.Hex() + `"}}`,
+			types.SourceCallbackKey, false, false, types.CallbackData{},
+		},
+		{"callback is not an object", `{"src_callback": "0x1"}`, types.SourceCallbackKey, true, true, types.CallbackData{}},
+		{"invalid address", `{"src_callback": {"address": "aizel1"}}`, types.SourceCallbackKey, true, true, types.CallbackData{}},
+		{
+			"invalid gas limit type",
+			`{"src_callback": {"address": "` + contract.Hex() + `", "gas_limit": 100}}`,
+			types.SourceCallbackKey, true, true, types.CallbackData{},
+		},
+		{
+			"invalid gas limit",
+			`{"src_callback": {"address": "` + contract.Hex() + `", "gas_limit": "-1"}}`,
+			types.SourceCallbackKey, true, true, types.CallbackData{},
+		},
+		{
+			"default gas limit",
+			`{"src_callback": {"address": "` + contract.Hex() + `"}}`,
+			types.SourceCallbackKey, true, false, types.CallbackData{ContractAddress: contract, GasLimit: maxGas},
+		},
+		{
+			"user gas limit",
+			`{"dest_callback": {"address": "` + contract.Hex() + `", "gas_limit": "100000"}}`,
+			types.DestinationCallbackKey, true, false, types.CallbackData{ContractAddress: contract, GasLimit: 100_000},
+		},
+		{
+			"user gas limit capped",
+			`{"src_callback": {"address": "` + contract.Hex() + `", "gas_limit": "900000"}}`,
+			types.SourceCallbackKey, true, false, types.CallbackData{ContractAddress: contract, GasLimit: maxGas},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data, found, err := types.GetCallbackData(tc.memo, tc.key, maxGas)
+			require.Equal(t, tc.expFound, found)
+			if tc.expError {
+				require.ErrorIs(t, err, types.ErrInvalidCallbackData)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expData, data)
+		})
+	}
+}
@@ -0,0 +1,15 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrInvalidCallbackData  = errorsmod.Register(ModuleName, 2, "invalid callback data")
+	ErrInvalidCallbackOwner = errorsmod.Register(ModuleName, 3, "callback contract is not the packet sender")
+	ErrCallbackFailed       = errorsmod.Register(ModuleName, 4, "callback execution failed")
+)
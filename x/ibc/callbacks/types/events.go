@@ -0,0 +1,22 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package types
+
+// IBC callbacks events
+const (
+	EventTypeIBCCallback = "ibc_callback"
+
+	AttributeKeyCallbackType     = "callback_type"
+	AttributeKeyContractAddress  = "contract_address"
+	AttributeKeyGasLimit         = "gas_limit"
+	AttributeKeyGasUsed          = "gas_used"
+	AttributeKeyPortID           = "port_id"
+	AttributeKeyChannelID        = "channel_id"
+	AttributeKeySequence         = "sequence"
+	AttributeKeySuccess          = "success"
+	AttributeKeyCallbackError    = "error"
+	AttributeValueAcknowledgment = "acknowledgement"
+	AttributeValueTimeout        = "timeout"
+	AttributeValueReceive        = "receive"
+)
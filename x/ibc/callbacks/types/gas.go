@@ -0,0 +1,58 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmtypes "github.com/AizelNetwork/CosmEvm/x/evm/types"
+)
+
+// ContractCall executes a callback contract call with the given gas limit.
+type ContractCall func(ctx sdk.Context, gasLimit uint64) (*evmtypes.MsgEthereumTxResponse, error)
+
+// ExecuteCallback executes a callback contract call following the gas policy
+// of ADR-008:
+//   - the relayer must provide the gas limit of the callback: if the gas
+//     remaining on the context is lower, the packet fails with an out of gas
+//     panic, so that it can be relayed again with more gas
+//   - the call is executed on a cached context, whose state changes are only
+//     committed if the call succeeds
+//   - a call reverted by the contract, including one running out of its gas
+//     limit, doesn't fail the packet. It uses the full gas limit and its error
+//     is returned as the revert error
+//   - any other error fails the packet
+//
+// The gas used by the call is consumed on the context.
+func ExecuteCallback(
+	ctx sdk.Context,
+	gasLimit uint64,
+	descriptor string,
+	call ContractCall,
+) (gasUsed uint64, revertErr error, err error) {
+	if remaining := ctx.GasMeter().GasRemaining(); remaining < gasLimit {
+		panic(storetypes.ErrorOutOfGas{
+			Descriptor: fmt.Sprintf("%s: gas limit %d, remaining gas %d", descriptor, gasLimit, remaining),
+		})
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	res, err := call(cacheCtx, gasLimit)
+	switch {
+	case err == nil:
+		writeCache()
+		gasUsed = res.GasUsed
+	case errors.Is(err, evmtypes.ErrVMExecution):
+		gasUsed, revertErr = gasLimit, err
+	default:
+		return 0, nil, err
+	}
+
+	ctx.GasMeter().ConsumeGas(gasUsed, descriptor)
+	return gasUsed, revertErr, nil
+}
@@ -0,0 +1,20 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/AizelNetwork/CosmEvm/x/evm/statedb"
+	evmtypes "github.com/AizelNetwork/CosmEvm/x/evm/types"
+)
+
+// EVMKeeper defines the expected EVM keeper interface used to execute the callbacks
+type EVMKeeper interface {
+	GetAccountWithoutBalance(ctx sdk.Context, addr common.Address) *statedb.Account
+	SetAccount(ctx sdk.Context, address common.Address, account statedb.Account) error
+	CallEVMWithGasLimit(ctx sdk.Context, abi abi.ABI, from, contract common.Address, gasLimit uint64, method string, args ...interface{}) (*evmtypes.MsgEthereumTxResponse, error)
+}
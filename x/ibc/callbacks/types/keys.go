@@ -0,0 +1,27 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package types
+
+const (
+	// ModuleName defines the IBC callbacks middleware name
+	ModuleName = "ibccallbacks"
+
+	// StoreKey defines the primary module store key. It differs from the module
+	// name, which would collide with the prefix of the IBC store key.
+	StoreKey = "callbacks"
+
+	// RouterKey is the message route for the IBC callbacks middleware
+	RouterKey = ModuleName
+)
+
+// prefix bytes for the ibccallbacks persistent store
+const (
+	prefixParams = iota + 1
+)
+
+// KVStore key prefixes
+var (
+	// ParamsKey defines the key for storing the module params
+	ParamsKey = []byte{prefixParams}
+)
@@ -0,0 +1,32 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package types
+
+import "errors"
+
+// DefaultMaxCallbackGas defines the default maximum gas limit of a single callback execution
+var DefaultMaxCallbackGas uint64 = 1_000_000
+
+// NewParams creates a new Params object
+func NewParams(maxCallbackGas uint64) Params {
+	return Params{
+		MaxCallbackGas: maxCallbackGas,
+	}
+}
+
+// DefaultParams returns the default ibccallbacks module parameters
+func DefaultParams() Params {
+	return Params{
+		MaxCallbackGas: DefaultMaxCallbackGas,
+	}
+}
+
+// Validate performs a stateless validation of the ibccallbacks params
+func (p Params) Validate() error {
+	if p.MaxCallbackGas == 0 {
+		return errors.New("max callback gas cannot be zero")
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		params   Params
+		expError bool
+	}{
+		{"default", DefaultParams(), false},
+		{"valid", NewParams(50_000), false},
+		{"invalid - zero max callback gas", NewParams(0), true},
+		{"invalid - empty", Params{}, true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.params.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
@@ -6,6 +6,7 @@ package keeper
 import (
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	capabilitykeeper "github.com/cosmos/ibc-go/modules/capability/keeper"
 
@@ -13,6 +14,9 @@ import (
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 
+	"github.com/ethereum/go-ethereum/common"
+
+	erc20types "github.com/AizelNetwork/CosmEvm/x/erc20/types"
 	"github.com/AizelNetwork/CosmEvm/x/ibc/transfer/types"
 )
 
@@ -56,3 +60,13 @@ func NewKeeper(
 		accountKeeper: accountKeeper,
 	}
 }
+
+// GetERC20TokenPair returns the enabled token pair registered for the given ERC20
+// contract address, if any.
+func (k Keeper) GetERC20TokenPair(ctx sdk.Context, contract common.Address) (erc20types.TokenPair, bool) {
+	pair, found := k.erc20Keeper.GetTokenPair(ctx, k.erc20Keeper.GetTokenPairID(ctx, contract.Hex()))
+	if !found || !pair.Enabled {
+		return erc20types.TokenPair{}, false
+	}
+	return pair, true
+}
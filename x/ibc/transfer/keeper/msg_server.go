@@ -10,6 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/hashicorp/go-metrics"
 
+	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -26,6 +27,13 @@ var _ types.MsgServer = Keeper{}
 // registered through governance.
 // If user doesn't have enough balance of coin, it will attempt to convert
 // ERC20 tokens to the coin denomination, and continue with a regular transfer.
+// If the token denomination is the hex address of a registered ERC20 contract,
+// the full amount is transferred from the ERC20 token balance of the sender.
+// Hex addresses are not valid coin denominations and are rejected by the
+// MsgTransfer ValidateBasic, so this form is only available to the ICS20
+// precompile, which calls the keeper directly. Cosmos txs transfer the ERC20
+// tokens with the erc20/{contract address} denomination instead, which
+// converts the tokens missing from the Cosmos coin balance of the sender.
 func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.MsgTransferResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -44,6 +52,12 @@ func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.
 			WithTransientKVGasConfig(transientKVGasCfg)
 	}()
 
+	// the ERC20 tokens are transferred instead of their Cosmos coin representation
+	// when the token denomination is the hex address of the ERC20 contract
+	if common.IsHexAddress(msg.Token.Denom) {
+		return k.transferERC20(ctx, msg)
+	}
+
 	// use native denom or contract address
 	denom := strings.TrimPrefix(msg.Token.Denom, erc20types.ModuleName+"/")
 
@@ -117,3 +131,58 @@ func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.
 
 	return k.Keeper.Transfer(ctx, msg)
 }
+
+// transferERC20 transfers via IBC the ERC20 tokens of the registered token pair
+// of the contract address set as the token denomination. For externally owned
+// token pairs, the full amount is escrowed in the erc20 module account and
+// converted to the Cosmos coin representation before the transfer, so that the
+// conversion is reverted if the transfer fails. The tokens are unescrowed when
+// they are received back or when the transfer is refunded.
+func (k Keeper) transferERC20(ctx sdk.Context, msg *types.MsgTransfer) (*types.MsgTransferResponse, error) {
+	if !k.erc20Keeper.IsERC20Enabled(ctx) {
+		return nil, erc20types.ErrERC20Disabled
+	}
+
+	pairID := k.erc20Keeper.GetTokenPairID(ctx, msg.Token.Denom)
+	pair, found := k.erc20Keeper.GetTokenPair(ctx, pairID)
+	if !found {
+		return nil, errorsmod.Wrapf(erc20types.ErrTokenPairNotFound, "ERC20 contract %s is not registered", msg.Token.Denom)
+	}
+
+	if !pair.Enabled {
+		return nil, errorsmod.Wrapf(erc20types.ErrERC20TokenPairDisabled, "ERC20 contract %s", msg.Token.Denom)
+	}
+
+	// update the msg denom to the token pair denom
+	msg.Token.Denom = pair.Denom
+
+	// the ERC20 token balance of native coins is the balance of the coin itself
+	if !pair.IsNativeERC20() {
+		return k.Keeper.Transfer(ctx, msg)
+	}
+
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	msgConvertERC20 := erc20types.NewMsgConvertERC20(
+		msg.Token.Amount,
+		sender,
+		pair.GetERC20Contract(),
+		common.BytesToAddress(sender.Bytes()),
+	)
+
+	// Use MsgConvertERC20 to escrow the ERC20 and mint its Cosmos coin representation
+	if _, err := k.erc20Keeper.ConvertERC20(ctx, msgConvertERC20); err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		telemetry.IncrCounterWithLabels(
+			[]string{"erc20", "ibc", "transfer", "total"},
+			1,
+			[]metrics.Label{
+				telemetry.NewLabel("denom", pair.Denom),
+			},
+		)
+	}()
+
+	return k.Keeper.Transfer(ctx, msg)
+}
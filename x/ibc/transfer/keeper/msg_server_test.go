@@ -8,9 +8,13 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/AizelNetwork/CosmEvm/contracts"
 	"github.com/AizelNetwork/CosmEvm/testutil/integration/aizel/keyring"
 	testutils "github.com/AizelNetwork/CosmEvm/testutil/integration/aizel/utils"
 	aizeltypes "github.com/AizelNetwork/CosmEvm/types"
+	erc20types "github.com/AizelNetwork/CosmEvm/x/erc20/types"
 	"github.com/AizelNetwork/CosmEvm/x/ibc/transfer/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
@@ -309,3 +313,170 @@ func (suite *KeeperTestSuite) TestTransfer() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestTransferERC20() {
+	var (
+		ctx          sdk.Context
+		sender       keyring.Key
+		contractAddr common.Address
+	)
+	mockChannelKeeper := &MockChannelKeeper{}
+	mockChannelKeeper.On("GetNextSequenceSend", mock.Anything, mock.Anything, mock.Anything).Return(1, true)
+	mockChannelKeeper.On("GetChannel", mock.Anything, mock.Anything, mock.Anything).Return(channeltypes.Channel{Counterparty: channeltypes.NewCounterparty("transfer", "channel-1")}, true)
+	authAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	amt := math.NewInt(10)
+
+	registerPair := func() {
+		_, err := suite.network.App.Erc20Keeper.RegisterERC20(ctx, &erc20types.MsgRegisterERC20{
+			Authority:      authAddr,
+			Erc20Addresses: []string{contractAddr.Hex()},
+		})
+		suite.Require().NoError(err)
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"fail - ERC20 contract not registered",
+			func() {},
+			false,
+		},
+		{
+			"fail - token pair disabled",
+			func() {
+				registerPair()
+				_, err := suite.network.App.Erc20Keeper.ToggleConversion(ctx, &erc20types.MsgToggleConversion{
+					Authority: authAddr,
+					Token:     contractAddr.Hex(),
+				})
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"fail - ERC20 disabled by params",
+			func() {
+				registerPair()
+				params := suite.network.App.Erc20Keeper.GetParams(ctx)
+				params.EnableErc20 = false
+				err := suite.network.App.Erc20Keeper.SetParams(ctx, params)
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"fail - insufficient ERC20 balance",
+			func() {
+				registerPair()
+				amt = amt.MulRaw(2)
+			},
+			false,
+		},
+		{
+			"pass - ERC20 escrowed in module account",
+			func() {
+				registerPair()
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			sender = suite.keyring.GetKey(0)
+			amt = math.NewInt(10)
+
+			var err error
+			contractAddr, err = suite.DeployContract("coin", "token", uint8(6))
+			suite.Require().NoError(err)
+			_, err = suite.MintERC20Token(contractAddr, sender.Addr, amt.BigInt())
+			suite.Require().NoError(err)
+
+			ctx = suite.network.GetContext()
+
+			suite.network.App.TransferKeeper = keeper.NewKeeper(
+				suite.network.App.AppCodec(), suite.network.App.GetKey(types.StoreKey), suite.network.App.GetSubspace(types.ModuleName),
+				&MockICS4Wrapper{}, // ICS4 Wrapper
+				mockChannelKeeper, suite.network.App.IBCKeeper.PortKeeper,
+				suite.network.App.AccountKeeper, suite.network.App.BankKeeper, suite.network.App.ScopedTransferKeeper,
+				suite.network.App.Erc20Keeper, // Add ERC20 Keeper for ERC20 transfers
+				authAddr,
+			)
+			tc.malleate()
+
+			coin := sdk.Coin{Denom: contractAddr.Hex(), Amount: amt}
+			msg := types.NewMsgTransfer("transfer", "channel-0", coin, sender.AccAddr.String(), "", timeoutHeight, 0, "")
+
+			_, err = suite.network.App.TransferKeeper.Transfer(ctx, msg)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(erc20types.CreateDenom(contractAddr.Hex()), msg.Token.Denom)
+
+				erc20ABI := contracts.ERC20MinterBurnerDecimalsContract.ABI
+				balance := suite.network.App.Erc20Keeper.BalanceOf(ctx, erc20ABI, contractAddr, sender.Addr)
+				suite.Require().Equal(int64(0), balance.Int64())
+
+				escrowed := suite.network.App.Erc20Keeper.BalanceOf(ctx, erc20ABI, contractAddr, erc20types.ModuleAddress)
+				suite.Require().Equal(amt.BigInt(), escrowed)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTransferERC20ValidateBasic() {
+	mockChannelKeeper := &MockChannelKeeper{}
+	mockChannelKeeper.On("GetNextSequenceSend", mock.Anything, mock.Anything, mock.Anything).Return(1, true)
+	mockChannelKeeper.On("GetChannel", mock.Anything, mock.Anything, mock.Anything).Return(channeltypes.Channel{Counterparty: channeltypes.NewCounterparty("transfer", "channel-1")}, true)
+	authAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	amt := math.NewInt(10)
+
+	suite.SetupTest()
+	sender := suite.keyring.GetKey(0)
+	receiver := suite.keyring.GetAccAddr(1).String()
+
+	contractAddr, err := suite.DeployContract("coin", "token", uint8(6))
+	suite.Require().NoError(err)
+	_, err = suite.MintERC20Token(contractAddr, sender.Addr, amt.BigInt())
+	suite.Require().NoError(err)
+
+	ctx := suite.network.GetContext()
+	suite.network.App.TransferKeeper = keeper.NewKeeper(
+		suite.network.App.AppCodec(), suite.network.App.GetKey(types.StoreKey), suite.network.App.GetSubspace(types.ModuleName),
+		&MockICS4Wrapper{}, // ICS4 Wrapper
+		mockChannelKeeper, suite.network.App.IBCKeeper.PortKeeper,
+		suite.network.App.AccountKeeper, suite.network.App.BankKeeper, suite.network.App.ScopedTransferKeeper,
+		suite.network.App.Erc20Keeper, // Add ERC20 Keeper for ERC20 transfers
+		authAddr,
+	)
+	_, err = suite.network.App.Erc20Keeper.RegisterERC20(ctx, &erc20types.MsgRegisterERC20{
+		Authority:      authAddr,
+		Erc20Addresses: []string{contractAddr.Hex()},
+	})
+	suite.Require().NoError(err)
+
+	// the hex address of the contract is only accepted by the ICS20 precompile
+	coin := sdk.Coin{Denom: contractAddr.Hex(), Amount: amt}
+	msg := types.NewMsgTransfer("transfer", "channel-0", coin, sender.AccAddr.String(), receiver, timeoutHeight, 0, "")
+	suite.Require().Error(msg.ValidateBasic())
+
+	// Cosmos txs use the denomination of the Cosmos coin representation, and
+	// the ERC20 tokens are escrowed as the sender has no coin balance
+	coin = sdk.NewCoin(erc20types.CreateDenom(contractAddr.Hex()), amt)
+	msg = types.NewMsgTransfer("transfer", "channel-0", coin, sender.AccAddr.String(), receiver, timeoutHeight, 0, "")
+	suite.Require().NoError(msg.ValidateBasic())
+
+	_, err = suite.network.App.TransferKeeper.Transfer(ctx, msg)
+	suite.Require().NoError(err)
+
+	erc20ABI := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	balance := suite.network.App.Erc20Keeper.BalanceOf(ctx, erc20ABI, contractAddr, sender.Addr)
+	suite.Require().Equal(int64(0), balance.Int64())
+
+	escrowed := suite.network.App.Erc20Keeper.BalanceOf(ctx, erc20ABI, contractAddr, erc20types.ModuleAddress)
+	suite.Require().Equal(amt.BigInt(), escrowed)
+}
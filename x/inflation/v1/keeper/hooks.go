@@ -55,7 +55,8 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 		panic(err)
 	}
 
-	epochMintProvision := types.CalculateEpochMintProvision(
+	epochMintProvision := k.CalculateEpochMintProvision(
+		ctx,
 		params,
 		period,
 		epochsPerPeriod,
@@ -75,6 +76,11 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 		Amount: epochMintProvision.TruncateInt(),
 	}
 
+	// the inflation rate of the target bonded model is adjusted on every minting epoch
+	if params.InflationModel == types.INFLATION_MODEL_TARGET_BONDED {
+		k.SetTargetBondedInflationRate(ctx, k.NextTargetBondedInflationRate(ctx, params, epochsPerPeriod, bondedRatio))
+	}
+
 	staking, communityPool, err := k.MintAndAllocateInflation(ctx, mintedCoin, params)
 	if err != nil {
 		panic(err)
@@ -133,6 +139,12 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 	)
 }
 
+// IsEpochIdentifierInUse returns true if the inflation is minted at the end of
+// the epoch with the given identifier.
+func (k Keeper) IsEpochIdentifierInUse(ctx sdk.Context, epochIdentifier string) bool {
+	return k.GetEpochIdentifier(ctx) == epochIdentifier
+}
+
 // ___________________________________________________________________________________________________
 
 // Hooks wrapper struct for incentives keeper
@@ -140,7 +152,10 @@ type Hooks struct {
 	k Keeper
 }
 
-var _ epochstypes.EpochHooks = Hooks{}
+var (
+	_ epochstypes.EpochHooks          = Hooks{}
+	_ epochstypes.EpochIdentifierUser = Hooks{}
+)
 
 // Return the wrapper struct
 func (k Keeper) Hooks() Hooks {
@@ -155,3 +170,7 @@ func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNu
 func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
 	h.k.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
 }
+
+func (h Hooks) IsEpochIdentifierInUse(ctx sdk.Context, epochIdentifier string) bool {
+	return h.k.IsEpochIdentifierInUse(ctx, epochIdentifier)
+}
@@ -4,9 +4,13 @@
 package keeper
 
 import (
+	"fmt"
+
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 
+	aizeltypes "github.com/AizelNetwork/CosmEvm/types"
 	utils "github.com/AizelNetwork/CosmEvm/utils"
 	"github.com/AizelNetwork/CosmEvm/x/inflation/v1/types"
 )
@@ -30,8 +34,8 @@ func (k Keeper) MintAndAllocateInflation(
 		return nil, nil, err
 	}
 
-	// Allocate minted coins according to allocation proportions (staking, usage
-	// incentives, community pool)
+	// Allocate minted coins according to allocation proportions (staking,
+	// distribution targets, community pool)
 	return k.AllocateExponentialInflation(ctx, coin, params)
 }
 
@@ -45,7 +49,7 @@ func (k Keeper) MintCoins(ctx sdk.Context, coin sdk.Coin) error {
 // AllocateExponentialInflation allocates coins from the inflation to external
 // modules according to allocation proportions:
 //   - staking rewards -> sdk `auth` module fee collector
-//   - usage incentives -> `x/incentives` module
+//   - distribution targets -> module accounts, addresses or EVM contracts
 //   - community pool -> `sdk `distr` module community pool
 func (k Keeper) AllocateExponentialInflation(
 	ctx sdk.Context,
@@ -69,6 +73,28 @@ func (k Keeper) AllocateExponentialInflation(
 		return nil, nil, err
 	}
 
+	// Allocate the proportions of the distribution targets
+	for _, target := range distribution.Targets {
+		coin := k.GetProportions(ctx, mintedCoin, target.Weight)
+		if !coin.IsPositive() {
+			continue
+		}
+
+		// a failed allocation is reverted and its coins are allocated to the
+		// community pool, so that a faulty target cannot halt the chain
+		cacheCtx, writeFn := ctx.CacheContext()
+		if err := k.allocateToTarget(cacheCtx, target, coin); err != nil {
+			k.Logger(ctx).Error(
+				"failed to allocate inflation to distribution target",
+				"target_type", target.TargetType.String(),
+				"target", target.Address,
+				"error", err.Error(),
+			)
+			continue
+		}
+		writeFn()
+	}
+
 	// Allocate community pool amount (remaining module balance) to community
 	// pool address
 	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
@@ -86,6 +112,71 @@ func (k Keeper) AllocateExponentialInflation(
 	return staking, communityPool, nil
 }
 
+// allocateToTarget transfers the given coin to the distribution target. EVM
+// contract targets are notified through the inflation receiver interface, with
+// a gas limit of InflationReceiverGasLimit. It returns an error if the transfer
+// or the notification fails, including when the notification panics, in which
+// case the state changes must be discarded by the caller.
+func (k Keeper) allocateToTarget(ctx sdk.Context, target types.DistributionTarget, coin sdk.Coin) (err error) {
+	coins := sdk.Coins{coin}
+
+	// recover from any panic (e.g. out of gas) raised during the notification
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("inflation distribution target panicked: %v", r)
+		}
+	}()
+
+	switch target.TargetType {
+	case types.DISTRIBUTION_TARGET_TYPE_MODULE:
+		// check the module account exists to avoid a panic on the transfer
+		if k.accountKeeper.GetModuleAccount(ctx, target.Address) == nil {
+			return fmt.Errorf("module account %s does not exist", target.Address)
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, target.Address, coins); err != nil {
+			return err
+		}
+	case types.DISTRIBUTION_TARGET_TYPE_ADDRESS:
+		addr, err := sdk.AccAddressFromBech32(target.Address)
+		if err != nil {
+			return err
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins); err != nil {
+			return err
+		}
+	case types.DISTRIBUTION_TARGET_TYPE_CONTRACT:
+		contract := common.HexToAddress(target.Address)
+		moduleAddr := common.BytesToAddress(k.accountKeeper.GetModuleAddress(types.ModuleName).Bytes())
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, contract.Bytes(), coins); err != nil {
+			return err
+		}
+
+		// the call returns an error if the execution reverts or runs out of gas
+		if _, err := k.evmKeeper.CallEVMWithGasLimit(
+			ctx, types.InflationReceiverABI, moduleAddr, contract, types.InflationReceiverGasLimit,
+			types.InflationReceiverMethod, coin.Denom, coin.Amount.BigInt(),
+		); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("invalid distribution target type: %s", target.TargetType)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDistributionTarget,
+			sdk.NewAttribute(types.AttributeKeyTargetType, target.TargetType.String()),
+			sdk.NewAttribute(types.AttributeKeyTarget, target.Address),
+			sdk.NewAttribute(types.AttributeKeyAmount, coin.String()),
+		),
+	)
+
+	return nil
+}
+
 // GetProportions calculates the proportion of coins that is to be
 // allocated during inflation for a given distribution.
 func (k Keeper) GetProportions(
@@ -193,10 +284,74 @@ func (k Keeper) GetEpochMintProvision(ctx sdk.Context) math.LegacyDec {
 	if err != nil {
 		return math.LegacyZeroDec()
 	}
-	return types.CalculateEpochMintProvision(
-		k.GetParams(ctx),
+
+	params := k.GetParams(ctx)
+	epochMintProvision := k.CalculateEpochMintProvision(
+		ctx,
+		params,
 		k.GetPeriod(ctx),
 		k.GetEpochsPerPeriod(ctx),
 		bondedRadio,
-	).Quo(math.LegacyNewDec(types.ReductionFactor))
+	)
+
+	if params.InflationModel == types.INFLATION_MODEL_EXPONENTIAL {
+		return epochMintProvision.Quo(math.LegacyNewDec(types.ReductionFactor))
+	}
+
+	return epochMintProvision
+}
+
+// CalculateEpochMintProvision calculates the provision to be minted on an epoch
+// according to the inflation model of the given params:
+//   - exponential -> exponential decay with bonding incentive
+//   - halving -> halving provision capped to the max supply
+//   - target bonded -> dynamic inflation rate applied to the circulating supply
+func (k Keeper) CalculateEpochMintProvision(
+	ctx sdk.Context,
+	params types.Params,
+	period uint64,
+	epochsPerPeriod int64,
+	bondedRatio math.LegacyDec,
+) math.LegacyDec {
+	switch params.InflationModel {
+	case types.INFLATION_MODEL_HALVING:
+		epochMintProvision := types.CalculateHalvingEpochMintProvision(params, period, epochsPerPeriod)
+		return k.capToMaxSupply(ctx, params, epochMintProvision)
+	case types.INFLATION_MODEL_TARGET_BONDED:
+		rate := k.NextTargetBondedInflationRate(ctx, params, epochsPerPeriod, bondedRatio)
+		circulatingSupply := k.GetCirculatingSupply(ctx, params.MintDenom)
+		return types.CalculateTargetBondedEpochMintProvision(rate, circulatingSupply, epochsPerPeriod)
+	default:
+		return types.CalculateEpochMintProvision(params, period, epochsPerPeriod, bondedRatio)
+	}
+}
+
+// NextTargetBondedInflationRate returns the yearly inflation rate of the target
+// bonded inflation model for the next epoch.
+func (k Keeper) NextTargetBondedInflationRate(
+	ctx sdk.Context,
+	params types.Params,
+	epochsPerPeriod int64,
+	bondedRatio math.LegacyDec,
+) math.LegacyDec {
+	return types.CalculateTargetBondedInflationRate(
+		params,
+		k.GetTargetBondedInflationRate(ctx),
+		epochsPerPeriod,
+		bondedRatio,
+	)
+}
+
+// capToMaxSupply caps the epoch mint provision so that the total supply of the
+// mint denom does not exceed the max supply of the halving inflation model.
+func (k Keeper) capToMaxSupply(ctx sdk.Context, params types.Params, epochMintProvision math.LegacyDec) math.LegacyDec {
+	maxSupply := params.HalvingCalculation.MaxSupply.Mul(math.LegacyNewDecFromInt(aizeltypes.PowerReduction))
+	supply := math.LegacyNewDecFromInt(k.bankKeeper.GetSupply(ctx, params.MintDenom).Amount)
+
+	remaining := maxSupply.Sub(supply)
+	if !remaining.IsPositive() {
+		return math.LegacyZeroDec()
+	}
+
+	return math.LegacyMinDec(epochMintProvision, remaining)
 }
@@ -0,0 +1,41 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AizelNetwork/CosmEvm/x/inflation/v1/types"
+)
+
+// GetTargetBondedInflationRate gets the current yearly inflation rate of the
+// target bonded inflation model. It defaults to the minimum inflation rate of
+// the params if it is not set.
+func (k Keeper) GetTargetBondedInflationRate(ctx sdk.Context) math.LegacyDec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPrefixInflationRate)
+	if len(bz) == 0 {
+		return k.GetParams(ctx).TargetBondedCalculation.InflationMin
+	}
+
+	var rate math.LegacyDec
+	if err := rate.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return rate
+}
+
+// SetTargetBondedInflationRate stores the current yearly inflation rate of the
+// target bonded inflation model
+func (k Keeper) SetTargetBondedInflationRate(ctx sdk.Context, rate math.LegacyDec) {
+	bz, err := rate.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefixInflationRate, bz)
+}
@@ -9,12 +9,17 @@ import (
 
 	testkeyring "github.com/AizelNetwork/CosmEvm/testutil/integration/aizel/keyring"
 	"github.com/AizelNetwork/CosmEvm/testutil/integration/aizel/network"
+	utiltx "github.com/AizelNetwork/CosmEvm/testutil/tx"
 	aizeltypes "github.com/AizelNetwork/CosmEvm/types"
 	"github.com/AizelNetwork/CosmEvm/utils"
+	erc20types "github.com/AizelNetwork/CosmEvm/x/erc20/types"
 	evmtypes "github.com/AizelNetwork/CosmEvm/x/evm/types"
 	"github.com/AizelNetwork/CosmEvm/x/inflation/v1/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -97,6 +102,60 @@ func TestMintAndAllocateInflation(t *testing.T) {
 	}
 }
 
+func TestAllocateInflationToTargets(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+	denom := nw.GetBaseDenom()
+	addr := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+
+	// the ERC20 contract does not implement the inflation receiver interface,
+	// so the coins allocated to it are sent to the community pool instead
+	contract, err := nw.App.Erc20Keeper.DeployERC20Contract(ctx, banktypes.Metadata{
+		Description: "test token",
+		Base:        "acoin",
+		Display:     "coin",
+		Name:        "coin",
+		Symbol:      "COIN",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "acoin", Exponent: 0},
+			{Denom: "coin", Exponent: 18},
+		},
+	})
+	require.NoError(t, err)
+
+	// the coins allocated to a blocked address cannot be sent, so they are
+	// sent to the community pool as well
+	blockedAddr := nw.App.AccountKeeper.GetModuleAddress(distrtypes.ModuleName)
+
+	params := types.DefaultParams()
+	params.InflationDistribution = types.InflationDistribution{
+		StakingRewards: math.LegacyNewDecWithPrec(5, 1),
+		CommunityPool:  math.LegacyNewDecWithPrec(1, 1),
+		Targets: []types.DistributionTarget{
+			types.NewDistributionTarget(types.DISTRIBUTION_TARGET_TYPE_MODULE, erc20types.ModuleName, math.LegacyNewDecWithPrec(1, 1)),
+			types.NewDistributionTarget(types.DISTRIBUTION_TARGET_TYPE_ADDRESS, addr.String(), math.LegacyNewDecWithPrec(1, 1)),
+			types.NewDistributionTarget(types.DISTRIBUTION_TARGET_TYPE_CONTRACT, contract.Hex(), math.LegacyNewDecWithPrec(1, 1)),
+			types.NewDistributionTarget(types.DISTRIBUTION_TARGET_TYPE_ADDRESS, blockedAddr.String(), math.LegacyNewDecWithPrec(1, 1)),
+		},
+	}
+	require.NoError(t, params.Validate())
+
+	mintCoin := sdk.NewCoin(denom, math.NewInt(1_000_000))
+	staking, communityPool, err := nw.App.InflationKeeper.MintAndAllocateInflation(ctx, mintCoin, params)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(500_000))), staking)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(300_000))), communityPool)
+
+	erc20Balance := nw.App.BankKeeper.GetBalance(ctx, nw.App.AccountKeeper.GetModuleAddress(erc20types.ModuleName), denom)
+	require.Equal(t, math.NewInt(100_000), erc20Balance.Amount)
+
+	addrBalance := nw.App.BankKeeper.GetBalance(ctx, addr, denom)
+	require.Equal(t, math.NewInt(100_000), addrBalance.Amount)
+
+	contractBalance := nw.App.BankKeeper.GetBalance(ctx, contract.Bytes(), denom)
+	require.True(t, contractBalance.IsZero())
+}
+
 func TestGetCirculatingSupplyAndInflationRate(t *testing.T) {
 	var (
 		ctx sdk.Context
@@ -199,6 +258,95 @@ func TestGetCirculatingSupplyAndInflationRate(t *testing.T) {
 	}
 }
 
+func TestEpochMintProvisionInflationModels(t *testing.T) {
+	var (
+		ctx sdk.Context
+		nw  *network.UnitTestNetwork
+	)
+	powerReduction := math.LegacyNewDecFromInt(aizeltypes.PowerReduction)
+
+	testCases := []struct {
+		name   string
+		expRes func(params types.Params, bondedRatio math.LegacyDec) math.LegacyDec
+		params func() types.Params
+	}{
+		{
+			"halving - below max supply",
+			func(params types.Params, _ math.LegacyDec) math.LegacyDec {
+				return types.CalculateHalvingEpochMintProvision(params, nw.App.InflationKeeper.GetPeriod(ctx), nw.App.InflationKeeper.GetEpochsPerPeriod(ctx))
+			},
+			func() types.Params {
+				params := types.DefaultParams()
+				params.InflationModel = types.INFLATION_MODEL_HALVING
+				return params
+			},
+		},
+		{
+			"halving - max supply reached",
+			func(types.Params, math.LegacyDec) math.LegacyDec {
+				return math.LegacyZeroDec()
+			},
+			func() types.Params {
+				params := types.DefaultParams()
+				params.InflationModel = types.INFLATION_MODEL_HALVING
+				supply := nw.App.BankKeeper.GetSupply(ctx, params.MintDenom).Amount
+				params.HalvingCalculation.MaxSupply = math.LegacyNewDecFromInt(supply).Quo(powerReduction)
+				return params
+			},
+		},
+		{
+			"target bonded",
+			func(params types.Params, bondedRatio math.LegacyDec) math.LegacyDec {
+				epochsPerPeriod := nw.App.InflationKeeper.GetEpochsPerPeriod(ctx)
+				rate := types.CalculateTargetBondedInflationRate(params, math.LegacyNewDecWithPrec(10, 2), epochsPerPeriod, bondedRatio)
+				circulatingSupply := nw.App.InflationKeeper.GetCirculatingSupply(ctx, params.MintDenom)
+				return rate.Mul(circulatingSupply).Quo(math.LegacyNewDec(epochsPerPeriod))
+			},
+			func() types.Params {
+				params := types.DefaultParams()
+				params.InflationModel = types.INFLATION_MODEL_TARGET_BONDED
+				nw.App.InflationKeeper.SetTargetBondedInflationRate(ctx, math.LegacyNewDecWithPrec(10, 2))
+				return params
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("Case %s", tc.name), func(t *testing.T) {
+			// reset
+			nw = network.NewUnitTestNetwork()
+			ctx = nw.GetContext()
+
+			params := tc.params()
+			require.NoError(t, nw.App.InflationKeeper.SetParams(ctx, params))
+
+			bondedRatio, err := nw.App.InflationKeeper.BondedRatio(ctx)
+			require.NoError(t, err)
+
+			epochMintProvision := nw.App.InflationKeeper.GetEpochMintProvision(ctx)
+			require.Equal(t, tc.expRes(params, bondedRatio), epochMintProvision)
+		})
+	}
+}
+
+func TestUpdateParamsTargetBondedInflationRate(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+
+	params := types.DefaultParams()
+	params.InflationModel = types.INFLATION_MODEL_TARGET_BONDED
+
+	// the rate is initialized from the current inflation rate of the exponential model
+	currentRate := nw.App.InflationKeeper.GetInflationRate(ctx, params.MintDenom).QuoInt64(100)
+	expRate := params.TargetBondedCalculation.BoundInflationRate(currentRate)
+
+	_, err := nw.App.InflationKeeper.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Params:    params,
+	})
+	require.NoError(t, err)
+	require.Equal(t, expRate, nw.App.InflationKeeper.GetTargetBondedInflationRate(ctx))
+}
+
 func TestBondedRatio(t *testing.T) {
 	var (
 		ctx sdk.Context
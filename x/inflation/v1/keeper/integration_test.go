@@ -103,20 +103,12 @@ var _ = Describe("Inflation", Ordered, func() {
 					Expect(s.network.NextBlockAfter(time.Hour * 25)).To(BeNil()) // End Epoch
 				})
 
-				It("should not allocate funds to usage incentives (deprecated)", func() {
+				It("should not allocate funds to usage incentives (removed)", func() {
 					res, err := s.handler.GetBalanceFromBank(addr, denomMint)
 					Expect(err).To(BeNil())
 					actual := res.Balance
 
-					provisionRes, err := s.handler.GetEpochMintProvision()
-					Expect(err).To(BeNil(), "failed to get epoch mint provision")
-					paramsRes, err := s.handler.GetInflationParams()
-					Expect(err).To(BeNil(), "failed to get inflation params")
-					distribution := paramsRes.Params.InflationDistribution.UsageIncentives //nolint:staticcheck
-					expected := provisionRes.EpochMintProvision.Amount.Mul(distribution).TruncateInt()
-
 					Expect(actual.IsZero()).To(BeTrue())
-					Expect(actual.Amount).To(Equal(expected))
 				})
 
 				It("should allocate funds to the community pool", func() {
@@ -145,9 +137,8 @@ var _ = Describe("Inflation", Ordered, func() {
 				params := res.Params
 				params.EnableInflation = true
 				params.InflationDistribution = types.InflationDistribution{
-					StakingRewards:  math.LegacyNewDecWithPrec(333333333, 9),
-					CommunityPool:   math.LegacyNewDecWithPrec(666666667, 9),
-					UsageIncentives: math.LegacyZeroDec(), // Deprecated
+					StakingRewards: math.LegacyNewDecWithPrec(333333333, 9),
+					CommunityPool:  math.LegacyNewDecWithPrec(666666667, 9),
 				}
 				err = integrationutils.UpdateInflationParams(
 					integrationutils.UpdateParamsInput{
@@ -195,20 +186,12 @@ var _ = Describe("Inflation", Ordered, func() {
 					Expect(s.network.NextBlockAfter(time.Hour * 25)).To(BeNil()) // End Epoch
 				})
 
-				It("should not allocate funds to usage incentives (deprecated)", func() {
+				It("should not allocate funds to usage incentives (removed)", func() {
 					res, err := s.handler.GetBalanceFromBank(addr, denomMint)
 					Expect(err).To(BeNil())
 					actual := res.Balance
 
-					provisionRes, err := s.handler.GetEpochMintProvision()
-					Expect(err).To(BeNil(), "failed to get epoch mint provision")
-					paramsRes, err := s.handler.GetInflationParams()
-					Expect(err).To(BeNil(), "failed to get inflation params")
-					distribution := paramsRes.Params.InflationDistribution.UsageIncentives //nolint:staticcheck
-					expected := (provisionRes.EpochMintProvision.Amount.Mul(distribution)).TruncateInt()
-
 					Expect(actual.IsZero()).To(BeTrue())
-					Expect(actual.Amount).To(Equal(expected))
 				})
 
 				It("should allocate funds to the community pool", func() {
@@ -281,20 +264,12 @@ var _ = Describe("Inflation", Ordered, func() {
 					Expect(s.network.NextBlockAfter(time.Hour * 25)).To(BeNil()) // End Epoch
 				})
 
-				It("should not allocate funds to usage incentives (deprecated)", func() {
+				It("should not allocate funds to usage incentives (removed)", func() {
 					res, err := s.handler.GetBalanceFromBank(addr, denomMint)
 					Expect(err).To(BeNil())
 					actual := res.Balance
 
-					provisionRes, err := s.handler.GetEpochMintProvision()
-					Expect(err).To(BeNil(), "failed to get epoch mint provision")
-					paramsRes, err := s.handler.GetInflationParams()
-					Expect(err).To(BeNil(), "failed to get inflation params")
-					distribution := paramsRes.Params.InflationDistribution.UsageIncentives //nolint:staticcheck
-					expected := (provisionRes.EpochMintProvision.Amount.Mul(distribution)).TruncateInt()
-
 					Expect(actual.IsZero()).To(BeTrue())
-					Expect(actual.Amount).To(Equal(expected))
 				})
 				It("should allocate funds to the community pool", func() {
 					res, err := s.handler.GetCommunityPool()
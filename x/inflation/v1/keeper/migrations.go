@@ -5,6 +5,7 @@ package keeper
 
 import (
 	v3 "github.com/AizelNetwork/CosmEvm/x/inflation/v1/migrations/v3"
+	v4 "github.com/AizelNetwork/CosmEvm/x/inflation/v1/migrations/v4"
 	"github.com/AizelNetwork/CosmEvm/x/inflation/v1/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -27,3 +28,8 @@ func NewMigrator(keeper Keeper, legacySubspace types.Subspace) Migrator {
 func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 	return v3.MigrateStore(ctx.KVStore(m.keeper.storeKey))
 }
+
+// Migrate3to4 migrates the store from consensus version 3 to 4
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.MigrateStore(ctx.KVStore(m.keeper.storeKey), m.keeper.cdc)
+}
@@ -8,7 +8,10 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/AizelNetwork/CosmEvm/x/inflation/v1/types"
 )
 
@@ -21,9 +24,54 @@ func (k Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.validateDistributionTargets(ctx, req.Params.InflationDistribution.Targets); err != nil {
+		return nil, err
+	}
+
+	// initialize the target bonded inflation rate with the current inflation rate
+	// to avoid a sudden change of the inflation when switching the inflation model
+	oldParams := k.GetParams(ctx)
+	if req.Params.InflationModel == types.INFLATION_MODEL_TARGET_BONDED &&
+		oldParams.InflationModel != types.INFLATION_MODEL_TARGET_BONDED {
+		rate := k.GetInflationRate(ctx, oldParams.MintDenom).QuoInt64(100)
+		k.SetTargetBondedInflationRate(ctx, req.Params.TargetBondedCalculation.BoundInflationRate(rate))
+	}
+
 	if err := k.SetParams(ctx, req.Params); err != nil {
 		return nil, errorsmod.Wrapf(err, "error setting params")
 	}
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// validateDistributionTargets checks that the module accounts of the module
+// distribution targets exist, and that the address and contract distribution
+// targets are not blocked addresses, since sending coins to them would fail
+// when allocating the inflation.
+func (k Keeper) validateDistributionTargets(ctx sdk.Context, targets []types.DistributionTarget) error {
+	for _, target := range targets {
+		var addr sdk.AccAddress
+		switch target.TargetType {
+		case types.DISTRIBUTION_TARGET_TYPE_MODULE:
+			if k.accountKeeper.GetModuleAccount(ctx, target.Address) == nil {
+				return errorsmod.Wrapf(errortypes.ErrUnknownAddress, "module account %s does not exist", target.Address)
+			}
+			continue
+		case types.DISTRIBUTION_TARGET_TYPE_ADDRESS:
+			var err error
+			if addr, err = sdk.AccAddressFromBech32(target.Address); err != nil {
+				return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid distribution target address %s: %s", target.Address, err)
+			}
+		case types.DISTRIBUTION_TARGET_TYPE_CONTRACT:
+			addr = common.HexToAddress(target.Address).Bytes()
+		default:
+			continue
+		}
+
+		if k.bankKeeper.BlockedAddr(addr) {
+			return errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s is not allowed to receive funds", target.Address)
+		}
+	}
+
+	return nil
+}
@@ -3,10 +3,15 @@ package keeper_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/AizelNetwork/CosmEvm/testutil/integration/aizel/network"
+	utiltx "github.com/AizelNetwork/CosmEvm/testutil/tx"
+	erc20types "github.com/AizelNetwork/CosmEvm/x/erc20/types"
+	evmtypes "github.com/AizelNetwork/CosmEvm/x/evm/types"
 	"github.com/AizelNetwork/CosmEvm/x/inflation/v1/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/require"
 )
@@ -16,6 +21,20 @@ func TestUpdateParams(t *testing.T) {
 		ctx sdk.Context
 		nw  *network.UnitTestNetwork
 	)
+	withTarget := func(targetType types.DistributionTargetType, address string) types.Params {
+		params := types.DefaultParams()
+		params.InflationDistribution = types.InflationDistribution{
+			StakingRewards: math.LegacyNewDecWithPrec(5, 1),
+			CommunityPool:  math.LegacyNewDecWithPrec(4, 1),
+			Targets: []types.DistributionTarget{
+				types.NewDistributionTarget(targetType, address, math.LegacyNewDecWithPrec(1, 1)),
+			},
+		}
+		return params
+	}
+	withModuleTarget := func(module string) types.Params {
+		return withTarget(types.DISTRIBUTION_TARGET_TYPE_MODULE, module)
+	}
 	testCases := []struct {
 		name      string
 		request   *types.MsgUpdateParams
@@ -34,6 +53,46 @@ func TestUpdateParams(t *testing.T) {
 			},
 			expectErr: false,
 		},
+		{
+			name: "fail - module distribution target does not exist",
+			request: &types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:    withModuleTarget("unknown"),
+			},
+			expectErr: true,
+		},
+		{
+			name: "pass - module distribution target",
+			request: &types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:    withModuleTarget(erc20types.ModuleName),
+			},
+			expectErr: false,
+		},
+		{
+			name: "fail - address distribution target is a blocked module account",
+			request: &types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:    withTarget(types.DISTRIBUTION_TARGET_TYPE_ADDRESS, authtypes.NewModuleAddress(distrtypes.ModuleName).String()),
+			},
+			expectErr: true,
+		},
+		{
+			name: "fail - contract distribution target is a blocked precompile",
+			request: &types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:    withTarget(types.DISTRIBUTION_TARGET_TYPE_CONTRACT, evmtypes.StakingPrecompileAddress),
+			},
+			expectErr: true,
+		},
+		{
+			name: "pass - address distribution target",
+			request: &types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:    withTarget(types.DISTRIBUTION_TARGET_TYPE_ADDRESS, sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String()),
+			},
+			expectErr: false,
+		},
 	}
 
 	for _, tc := range testCases {
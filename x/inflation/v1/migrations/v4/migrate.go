@@ -0,0 +1,35 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package v4
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/AizelNetwork/CosmEvm/x/inflation/v1/types"
+)
+
+// MigrateStore migrates the x/inflation module state from the consensus version 3 to
+// version 4. Specifically, it sets the default halving and target bonded calculation
+// params and keeps the exponential inflation model as the active one.
+func MigrateStore(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	var params types.Params
+	if bz := store.Get(types.ParamsKey); len(bz) != 0 {
+		if err := cdc.Unmarshal(bz, &params); err != nil {
+			return err
+		}
+	}
+
+	params.InflationModel = types.INFLATION_MODEL_EXPONENTIAL
+	params.HalvingCalculation = types.DefaultHalvingCalculation
+	params.TargetBondedCalculation = types.DefaultTargetBondedCalculation
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, bz)
+	return nil
+}
@@ -0,0 +1,42 @@
+package v4_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	v4 "github.com/AizelNetwork/CosmEvm/x/inflation/v1/migrations/v4"
+	"github.com/AizelNetwork/CosmEvm/x/inflation/v1/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	// params stored before the inflation models were introduced
+	oldParams := types.Params{
+		MintDenom:              types.DefaultInflationDenom,
+		ExponentialCalculation: types.DefaultExponentialCalculation,
+		InflationDistribution:  types.DefaultInflationDistribution,
+		EnableInflation:        true,
+	}
+	oldParams.ExponentialCalculation.MaxVariance = math.LegacyNewDecWithPrec(1, 1)
+	store.Set(types.ParamsKey, cdc.MustMarshal(&oldParams))
+
+	require.NoError(t, v4.MigrateStore(store, cdc))
+
+	var params types.Params
+	cdc.MustUnmarshal(store.Get(types.ParamsKey), &params)
+	require.NoError(t, params.Validate())
+	require.Equal(t, types.INFLATION_MODEL_EXPONENTIAL, params.InflationModel)
+	require.Equal(t, oldParams.ExponentialCalculation, params.ExponentialCalculation)
+	require.Equal(t, types.DefaultHalvingCalculation, params.HalvingCalculation)
+	require.Equal(t, types.DefaultTargetBondedCalculation, params.TargetBondedCalculation)
+}
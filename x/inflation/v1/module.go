@@ -27,7 +27,7 @@ import (
 )
 
 // consensusVersion defines the current x/inflation module consensus version.
-const consensusVersion = 3
+const consensusVersion = 4
 
 // type check to ensure the interface is properly implemented
 var (
@@ -141,6 +141,11 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err != nil {
 		panic(err)
 	}
+
+	// Migrate to version 4 of store
+	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(err)
+	}
 }
 
 // InitGenesis performs genesis initialization for the inflation module. It returns
@@ -5,8 +5,12 @@ package types
 
 // Minting module event types
 const (
-	EventTypeMint = ModuleName
+	EventTypeMint               = ModuleName
+	EventTypeDistributionTarget = "inflation_distribution_target"
 
 	AttributeKeyEpochProvisions = "epoch_provisions"
 	AttributeEpochNumber        = "epoch_number"
+	AttributeKeyTargetType      = "target_type"
+	AttributeKeyTarget          = "target"
+	AttributeKeyAmount          = "amount"
 )
@@ -0,0 +1,34 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// InflationReceiverMethod is the method called on contract distribution targets
+// after the minted coins are transferred to them.
+const InflationReceiverMethod = "onInflationReceived"
+
+// InflationReceiverGasLimit is the gas limit of the notification of contract
+// distribution targets, which runs in the epoch hooks without a tx gas meter.
+const InflationReceiverGasLimit uint64 = 300_000
+
+// inflationReceiverABIJSON is the ABI of the inflation receiver interface that
+// contract distribution targets must implement:
+//
+//	function onInflationReceived(string denom, uint256 amount) external;
+const inflationReceiverABIJSON = `[{"inputs":[{"internalType":"string","name":"denom","type":"string"},{"internalType":"uint256","name":"amount","type":"uint256"}],"name":"onInflationReceived","outputs":[],"stateMutability":"nonpayable","type":"function"}]`
+
+// InflationReceiverABI is the ABI used to notify contract distribution targets.
+var InflationReceiverABI abi.ABI
+
+func init() {
+	var err error
+	if InflationReceiverABI, err = abi.JSON(strings.NewReader(inflationReceiverABIJSON)); err != nil {
+		panic(err)
+	}
+}
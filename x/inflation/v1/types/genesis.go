@@ -6,6 +6,8 @@ package types
 import (
 	fmt "fmt"
 
+	"cosmossdk.io/math"
+
 	epochstypes "github.com/AizelNetwork/CosmEvm/x/epochs/types"
 )
 
@@ -34,6 +36,7 @@ func DefaultGenesisState() *GenesisState {
 		EpochIdentifier: epochstypes.DayEpochID,
 		EpochsPerPeriod: 365,
 		SkippedEpochs:   0,
+		InflationRate:   math.LegacyZeroDec(),
 	}
 }
 
@@ -52,6 +55,10 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	if !gs.InflationRate.IsNil() && gs.InflationRate.IsNegative() {
+		return fmt.Errorf("inflation rate cannot be negative: %s", gs.InflationRate)
+	}
+
 	return gs.Params.Validate()
 }
 
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -36,6 +37,9 @@ type GenesisState struct {
 	EpochsPerPeriod int64 `protobuf:"varint,4,opt,name=epochs_per_period,json=epochsPerPeriod,proto3" json:"epochs_per_period,omitempty"`
 	// skipped_epochs is the number of epochs that have passed while inflation is disabled
 	SkippedEpochs uint64 `protobuf:"varint,5,opt,name=skipped_epochs,json=skippedEpochs,proto3" json:"skipped_epochs,omitempty"`
+	// inflation_rate is the current yearly inflation rate of the target bonded
+	// inflation model
+	InflationRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=inflation_rate,json=inflationRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation_rate"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -116,6 +120,13 @@ type Params struct {
 	InflationDistribution InflationDistribution `protobuf:"bytes,3,opt,name=inflation_distribution,json=inflationDistribution,proto3" json:"inflation_distribution"`
 	// enable_inflation is the parameter that enables inflation and halts increasing the skipped_epochs
 	EnableInflation bool `protobuf:"varint,4,opt,name=enable_inflation,json=enableInflation,proto3" json:"enable_inflation,omitempty"`
+	// inflation_model defines the curve used to calculate the inflation
+	InflationModel InflationModel `protobuf:"varint,5,opt,name=inflation_model,json=inflationModel,proto3,enum=evmos.inflation.v1.InflationModel" json:"inflation_model,omitempty"`
+	// halving_calculation takes in the variables to calculate the halving inflation
+	HalvingCalculation HalvingCalculation `protobuf:"bytes,6,opt,name=halving_calculation,json=halvingCalculation,proto3" json:"halving_calculation"`
+	// target_bonded_calculation takes in the variables to calculate the target
+	// bonded inflation
+	TargetBondedCalculation TargetBondedCalculation `protobuf:"bytes,7,opt,name=target_bonded_calculation,json=targetBondedCalculation,proto3" json:"target_bonded_calculation"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -179,6 +190,27 @@ func (m *Params) GetEnableInflation() bool {
 	return false
 }
 
+func (m *Params) GetInflationModel() InflationModel {
+	if m != nil {
+		return m.InflationModel
+	}
+	return INFLATION_MODEL_EXPONENTIAL
+}
+
+func (m *Params) GetHalvingCalculation() HalvingCalculation {
+	if m != nil {
+		return m.HalvingCalculation
+	}
+	return HalvingCalculation{}
+}
+
+func (m *Params) GetTargetBondedCalculation() TargetBondedCalculation {
+	if m != nil {
+		return m.TargetBondedCalculation
+	}
+	return TargetBondedCalculation{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "evmos.inflation.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "evmos.inflation.v1.Params")
@@ -187,34 +219,43 @@ func init() {
 func init() { proto.RegisterFile("evmos/inflation/v1/genesis.proto", fileDescriptor_1cb8eee530db1235) }
 
 var fileDescriptor_1cb8eee530db1235 = []byte{
-	// 431 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xc1, 0x6e, 0x13, 0x31,
-	0x10, 0x8d, 0x9b, 0x12, 0x11, 0x17, 0x28, 0xb5, 0x20, 0x44, 0x91, 0x58, 0x56, 0x91, 0x90, 0xd2,
-	0x1c, 0xd6, 0x34, 0x9c, 0xb9, 0x94, 0x56, 0x28, 0xb7, 0x68, 0xb9, 0x71, 0xb1, 0x9c, 0xec, 0x34,
-	0xb5, 0x9a, 0xb5, 0xad, 0xb5, 0x13, 0x95, 0xbf, 0xe0, 0x33, 0xb8, 0xc1, 0x67, 0xf4, 0xd8, 0x23,
-	0x27, 0x84, 0x92, 0x03, 0xbf, 0x81, 0x32, 0x5e, 0x36, 0x41, 0xec, 0xc5, 0xb2, 0xdf, 0xbc, 0x79,
-	0x6f, 0x66, 0x3c, 0x34, 0x86, 0x55, 0x6e, 0x1c, 0x57, 0xfa, 0x6a, 0x21, 0xbd, 0x32, 0x9a, 0xaf,
-	0xce, 0xf8, 0x1c, 0x34, 0x38, 0xe5, 0x12, 0x5b, 0x18, 0x6f, 0x18, 0x43, 0x46, 0x52, 0x31, 0x92,
-	0xd5, 0x59, 0xef, 0x44, 0xe6, 0x4a, 0x1b, 0x8e, 0x67, 0xa0, 0xf5, 0x9e, 0xcd, 0xcd, 0xdc, 0xe0,
-	0x95, 0x6f, 0x6f, 0x25, 0xda, 0xaf, 0x91, 0xdf, 0x29, 0x21, 0xa7, 0xbf, 0x21, 0xf4, 0xd1, 0x87,
-	0x60, 0xf9, 0xd1, 0x4b, 0x0f, 0xec, 0x1d, 0x6d, 0x59, 0x59, 0xc8, 0xdc, 0x75, 0x49, 0x4c, 0x06,
-	0x47, 0xa3, 0x5e, 0xf2, 0x7f, 0x09, 0xc9, 0x04, 0x19, 0xe7, 0xed, 0xbb, 0x9f, 0xaf, 0x1a, 0x5f,
-	0x7f, 0x7f, 0x1f, 0x92, 0xb4, 0x4c, 0x62, 0x1d, 0xda, 0xb2, 0x50, 0x28, 0x93, 0x75, 0x0f, 0x62,
-	0x32, 0x38, 0x4c, 0xcb, 0x17, 0x3b, 0xa5, 0x4f, 0xc1, 0x9a, 0xd9, 0xb5, 0x50, 0x19, 0x68, 0xaf,
-	0xae, 0x14, 0x14, 0xdd, 0x66, 0x4c, 0x06, 0xed, 0xf4, 0x18, 0xf1, 0x71, 0x05, 0xb3, 0x21, 0x3d,
-	0x41, 0xc8, 0x09, 0x0b, 0x85, 0x28, 0xd5, 0x0e, 0x63, 0x32, 0x68, 0x96, 0x5c, 0x37, 0x81, 0x62,
-	0x12, 0x64, 0x5f, 0xd3, 0x27, 0xee, 0x46, 0x59, 0x0b, 0x99, 0x08, 0xa1, 0xee, 0x03, 0xb4, 0x7d,
-	0x5c, 0xa2, 0x97, 0x08, 0xf6, 0xbf, 0x1d, 0xd0, 0x56, 0xa8, 0x99, 0xbd, 0xa4, 0x34, 0x57, 0xda,
-	0x8b, 0x0c, 0xb4, 0xc9, 0xb1, 0xc7, 0x76, 0xda, 0xde, 0x22, 0x17, 0x5b, 0x80, 0x69, 0xfa, 0x02,
-	0x6e, 0xad, 0xd1, 0xdb, 0x6a, 0xe4, 0x42, 0xcc, 0xe4, 0x62, 0xb6, 0x0c, 0x7d, 0x63, 0x43, 0x47,
-	0xa3, 0x61, 0xdd, 0x3c, 0x2e, 0x77, 0x29, 0xef, 0x77, 0x19, 0xfb, 0xf3, 0xe9, 0x40, 0x2d, 0x85,
-	0xdd, 0xd0, 0x4e, 0xa5, 0x24, 0x32, 0xe5, 0x7c, 0xa1, 0xa6, 0x4b, 0xb4, 0x6b, 0xa2, 0xdd, 0x69,
-	0x9d, 0xdd, 0xf8, 0xef, 0xe3, 0x62, 0x2f, 0x61, 0xdf, 0xed, 0xb9, 0xaa, 0x63, 0xe0, 0x27, 0x68,
-	0x39, 0x5d, 0x80, 0xa8, 0xe2, 0x38, 0xd8, 0x87, 0xe9, 0x71, 0xc0, 0x2b, 0xe1, 0xf3, 0xf1, 0xdd,
-	0x3a, 0x22, 0xf7, 0xeb, 0x88, 0xfc, 0x5a, 0x47, 0xe4, 0xcb, 0x26, 0x6a, 0xdc, 0x6f, 0xa2, 0xc6,
-	0x8f, 0x4d, 0xd4, 0xf8, 0xc4, 0xe7, 0xca, 0x5f, 0x2f, 0xa7, 0xc9, 0xcc, 0xe4, 0x3c, 0x2c, 0x58,
-	0x38, 0x57, 0xa3, 0x37, 0xfc, 0xf6, 0xdf, 0x65, 0xf3, 0x9f, 0x2d, 0xb8, 0x69, 0x0b, 0x37, 0xed,
-	0xed, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2e, 0x47, 0x3c, 0x80, 0xee, 0x02, 0x00, 0x00,
+	// 573 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x4f, 0x6f, 0xd3, 0x3c,
+	0x1c, 0x6e, 0xb6, 0xbd, 0x79, 0xa9, 0xc7, 0x3a, 0x66, 0x60, 0x2b, 0x43, 0x64, 0x55, 0x25, 0x50,
+	0x37, 0xa4, 0x44, 0x2b, 0x5c, 0x39, 0xd0, 0xb5, 0x82, 0x09, 0x18, 0x55, 0xe0, 0xc4, 0x25, 0x72,
+	0x92, 0xdf, 0x52, 0xab, 0x89, 0x1d, 0xc5, 0x6e, 0xd9, 0xf8, 0x14, 0x7c, 0x0c, 0x8e, 0x1c, 0xf8,
+	0x10, 0x3b, 0xee, 0xc0, 0x01, 0x71, 0x98, 0x50, 0x7b, 0xe0, 0x6b, 0xa0, 0x38, 0x21, 0x6d, 0xb5,
+	0x94, 0x4b, 0x14, 0x3f, 0x7e, 0xfe, 0xf8, 0xf7, 0x58, 0x46, 0x0d, 0x18, 0x47, 0x5c, 0x58, 0x94,
+	0x9d, 0x86, 0x44, 0x52, 0xce, 0xac, 0xf1, 0xa1, 0x15, 0x00, 0x03, 0x41, 0x85, 0x19, 0x27, 0x5c,
+	0x72, 0x8c, 0x15, 0xc3, 0x2c, 0x18, 0xe6, 0xf8, 0x70, 0x77, 0x8b, 0x44, 0x94, 0x71, 0x4b, 0x7d,
+	0x33, 0xda, 0xee, 0x9d, 0x80, 0x07, 0x5c, 0xfd, 0x5a, 0xe9, 0x5f, 0x8e, 0x36, 0x4b, 0xec, 0x67,
+	0x4e, 0x8a, 0xd3, 0xfc, 0xb6, 0x82, 0x6e, 0xbe, 0xc8, 0x22, 0xdf, 0x49, 0x22, 0x01, 0x3f, 0x43,
+	0x7a, 0x4c, 0x12, 0x12, 0x89, 0xba, 0xd6, 0xd0, 0x5a, 0xeb, 0xed, 0x5d, 0xf3, 0xfa, 0x11, 0xcc,
+	0xbe, 0x62, 0x74, 0xaa, 0x17, 0x57, 0x7b, 0x95, 0x2f, 0xbf, 0xbf, 0x1e, 0x68, 0x76, 0x2e, 0xc2,
+	0xdb, 0x48, 0x8f, 0x21, 0xa1, 0xdc, 0xaf, 0xaf, 0x34, 0xb4, 0xd6, 0x9a, 0x9d, 0xaf, 0xf0, 0x3e,
+	0xba, 0x05, 0x31, 0xf7, 0x06, 0x0e, 0xf5, 0x81, 0x49, 0x7a, 0x4a, 0x21, 0xa9, 0xaf, 0x36, 0xb4,
+	0x56, 0xd5, 0xde, 0x54, 0xf8, 0x71, 0x01, 0xe3, 0x03, 0xb4, 0xa5, 0x20, 0xe1, 0xc4, 0x90, 0x38,
+	0xb9, 0xdb, 0x5a, 0x43, 0x6b, 0xad, 0xe6, 0x5c, 0xd1, 0x87, 0xa4, 0x9f, 0xd9, 0x3e, 0x44, 0x35,
+	0x31, 0xa4, 0x71, 0x0c, 0xbe, 0x93, 0x6d, 0xd5, 0xff, 0x53, 0xb1, 0x1b, 0x39, 0xda, 0x53, 0x20,
+	0x7e, 0x8b, 0x6a, 0xc5, 0xf9, 0x9d, 0x84, 0x48, 0xa8, 0xeb, 0x69, 0x76, 0xa7, 0x95, 0x0e, 0xf0,
+	0xf3, 0x6a, 0xef, 0xbe, 0xc7, 0x45, 0xc4, 0x85, 0xf0, 0x87, 0x26, 0xe5, 0x56, 0x44, 0xe4, 0xc0,
+	0x7c, 0x0d, 0x01, 0xf1, 0xce, 0xbb, 0xe0, 0x65, 0xf3, 0x6d, 0x14, 0x7a, 0x9b, 0x48, 0x68, 0x7e,
+	0x5f, 0x43, 0x7a, 0x56, 0x02, 0x7e, 0x80, 0x50, 0x44, 0x99, 0x74, 0x7c, 0x60, 0x3c, 0x52, 0xa5,
+	0x55, 0xed, 0x6a, 0x8a, 0x74, 0x53, 0x00, 0x33, 0xb4, 0x03, 0x67, 0x31, 0x67, 0xe9, 0x78, 0x24,
+	0x74, 0x3c, 0x12, 0x7a, 0xa3, 0xcc, 0x48, 0x35, 0xb4, 0xde, 0x3e, 0x28, 0x2b, 0xb8, 0x37, 0x93,
+	0x1c, 0xcd, 0x14, 0xf3, 0x85, 0x6f, 0x43, 0x29, 0x05, 0x0f, 0xd1, 0xf6, 0x6c, 0x54, 0x9f, 0x0a,
+	0x99, 0x50, 0x77, 0xa4, 0xe2, 0x56, 0x55, 0xdc, 0x7e, 0x59, 0xdc, 0xf1, 0xdf, 0x45, 0x77, 0x4e,
+	0x30, 0x9f, 0x76, 0x97, 0x96, 0x31, 0xd4, 0xad, 0x32, 0xe2, 0x86, 0xe0, 0x14, 0xfb, 0xea, 0xa6,
+	0x6e, 0xd8, 0x9b, 0x19, 0x5e, 0x18, 0xe3, 0x57, 0x68, 0x73, 0x76, 0xae, 0x88, 0xfb, 0x10, 0xaa,
+	0xab, 0xaa, 0xb5, 0x9b, 0xff, 0x3c, 0xd0, 0x9b, 0x94, 0x69, 0xd7, 0xe8, 0xc2, 0x1a, 0xbb, 0xe8,
+	0xf6, 0x80, 0x84, 0x63, 0xca, 0x82, 0x85, 0x42, 0x75, 0x35, 0xe1, 0xa3, 0x32, 0xc3, 0x97, 0x19,
+	0x7d, 0x49, 0x99, 0x78, 0x70, 0x6d, 0x1b, 0x27, 0xe8, 0x9e, 0x24, 0x49, 0x00, 0xd2, 0x71, 0x39,
+	0xf3, 0xc1, 0x5f, 0x48, 0xfa, 0x5f, 0x25, 0x3d, 0x2e, 0x4b, 0x7a, 0xaf, 0x44, 0x1d, 0xa5, 0x59,
+	0x12, 0xb7, 0x23, 0x97, 0x70, 0x4e, 0x2e, 0x26, 0x86, 0x76, 0x39, 0x31, 0xb4, 0x5f, 0x13, 0x43,
+	0xfb, 0x3c, 0x35, 0x2a, 0x97, 0x53, 0xa3, 0xf2, 0x63, 0x6a, 0x54, 0x3e, 0x3c, 0x0d, 0xa8, 0x1c,
+	0x8c, 0x5c, 0xd3, 0xe3, 0x91, 0xf5, 0x9c, 0x7e, 0x82, 0xf0, 0x04, 0xe4, 0x47, 0x9e, 0x0c, 0xad,
+	0x23, 0x2e, 0xa2, 0xde, 0x38, 0xb2, 0xce, 0x16, 0xdf, 0xb9, 0x3c, 0x8f, 0x41, 0xb8, 0xba, 0x7a,
+	0xe4, 0x4f, 0xfe, 0x0c, 0x00, 0x2f, 0x6a, 0x1d, 0x1e, 0x69, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -237,6 +278,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.InflationRate.Size()
+		i -= size
+		if _, err := m.InflationRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	if m.SkippedEpochs != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.SkippedEpochs))
 		i--
@@ -292,6 +343,31 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.TargetBondedCalculation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.HalvingCalculation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.InflationModel != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.InflationModel))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.EnableInflation {
 		i--
 		if m.EnableInflation {
@@ -364,6 +440,8 @@ func (m *GenesisState) Size() (n int) {
 	if m.SkippedEpochs != 0 {
 		n += 1 + sovGenesis(uint64(m.SkippedEpochs))
 	}
+	l = m.InflationRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -384,6 +462,13 @@ func (m *Params) Size() (n int) {
 	if m.EnableInflation {
 		n += 2
 	}
+	if m.InflationModel != 0 {
+		n += 1 + sovGenesis(uint64(m.InflationModel))
+	}
+	l = m.HalvingCalculation.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.TargetBondedCalculation.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -544,6 +629,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -712,6 +831,91 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.EnableInflation = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationModel", wireType)
+			}
+			m.InflationModel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InflationModel |= InflationModel(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalvingCalculation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HalvingCalculation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBondedCalculation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetBondedCalculation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
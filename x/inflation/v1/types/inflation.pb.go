@@ -25,8 +25,85 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// DistributionTargetType defines the type of recipient of a distribution target
+type DistributionTargetType int32
+
+const (
+	// DISTRIBUTION_TARGET_TYPE_UNSPECIFIED defines an invalid target type
+	DISTRIBUTION_TARGET_TYPE_UNSPECIFIED DistributionTargetType = 0
+	// DISTRIBUTION_TARGET_TYPE_MODULE defines a module account target, identified
+	// by the module name
+	DISTRIBUTION_TARGET_TYPE_MODULE DistributionTargetType = 1
+	// DISTRIBUTION_TARGET_TYPE_ADDRESS defines an arbitrary account target,
+	// identified by its bech32 address
+	DISTRIBUTION_TARGET_TYPE_ADDRESS DistributionTargetType = 2
+	// DISTRIBUTION_TARGET_TYPE_CONTRACT defines an EVM contract target, identified
+	// by its hex address, that is notified after receiving the minted coins
+	DISTRIBUTION_TARGET_TYPE_CONTRACT DistributionTargetType = 3
+)
+
+var DistributionTargetType_name = map[int32]string{
+	0: "DISTRIBUTION_TARGET_TYPE_UNSPECIFIED",
+	1: "DISTRIBUTION_TARGET_TYPE_MODULE",
+	2: "DISTRIBUTION_TARGET_TYPE_ADDRESS",
+	3: "DISTRIBUTION_TARGET_TYPE_CONTRACT",
+}
+
+var DistributionTargetType_value = map[string]int32{
+	"DISTRIBUTION_TARGET_TYPE_UNSPECIFIED": 0,
+	"DISTRIBUTION_TARGET_TYPE_MODULE":      1,
+	"DISTRIBUTION_TARGET_TYPE_ADDRESS":     2,
+	"DISTRIBUTION_TARGET_TYPE_CONTRACT":    3,
+}
+
+func (x DistributionTargetType) String() string {
+	return proto.EnumName(DistributionTargetType_name, int32(x))
+}
+
+func (DistributionTargetType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d064cb35c3ff7df8, []int{0}
+}
+
+// InflationModel defines the curve used to calculate the inflation minted on
+// each epoch
+type InflationModel int32
+
+const (
+	// INFLATION_MODEL_EXPONENTIAL defines the exponential decay curve calculated
+	// with the ExponentialCalculation factors
+	INFLATION_MODEL_EXPONENTIAL InflationModel = 0
+	// INFLATION_MODEL_HALVING defines a curve that halves the provision after a
+	// fixed number of periods, up to a maximum supply, calculated with the
+	// HalvingCalculation factors
+	INFLATION_MODEL_HALVING InflationModel = 1
+	// INFLATION_MODEL_TARGET_BONDED defines a dynamic inflation rate that is
+	// adjusted on each epoch towards a target bonded ratio, calculated with the
+	// TargetBondedCalculation factors
+	INFLATION_MODEL_TARGET_BONDED InflationModel = 2
+)
+
+var InflationModel_name = map[int32]string{
+	0: "INFLATION_MODEL_EXPONENTIAL",
+	1: "INFLATION_MODEL_HALVING",
+	2: "INFLATION_MODEL_TARGET_BONDED",
+}
+
+var InflationModel_value = map[string]int32{
+	"INFLATION_MODEL_EXPONENTIAL":   0,
+	"INFLATION_MODEL_HALVING":       1,
+	"INFLATION_MODEL_TARGET_BONDED": 2,
+}
+
+func (x InflationModel) String() string {
+	return proto.EnumName(InflationModel_name, int32(x))
+}
+
+func (InflationModel) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d064cb35c3ff7df8, []int{1}
+}
+
 // InflationDistribution defines the distribution in which inflation is
-// allocated through minting on each epoch (staking, incentives, community). It
+// allocated through minting on each epoch (staking, community pool and targets). It
 // excludes the team vesting distribution, as this is minted once at genesis.
 // The initial InflationDistribution can be calculated from the Evmos Token
 // Model like this:
@@ -36,12 +113,12 @@ type InflationDistribution struct {
 	// staking_rewards defines the proportion of the minted minted_denom that is
 	// to be allocated as staking rewards
 	StakingRewards cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=staking_rewards,json=stakingRewards,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"staking_rewards"`
-	// Deprecated: usage_incentives defines the proportion of the minted minted_denom that is
-	// to be allocated to the incentives module address
-	UsageIncentives cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=usage_incentives,json=usageIncentives,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"usage_incentives"` // Deprecated: Do not use.
 	// community_pool defines the proportion of the minted minted_denom that is to
 	// be allocated to the community pool
 	CommunityPool cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=community_pool,json=communityPool,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"community_pool"`
+	// targets defines the additional recipients of the minted minted_denom and
+	// their proportions
+	Targets []DistributionTarget `protobuf:"bytes,4,rep,name=targets,proto3" json:"targets"`
 }
 
 func (m *InflationDistribution) Reset()         { *m = InflationDistribution{} }
@@ -77,6 +154,73 @@ func (m *InflationDistribution) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_InflationDistribution proto.InternalMessageInfo
 
+func (m *InflationDistribution) GetTargets() []DistributionTarget {
+	if m != nil {
+		return m.Targets
+	}
+	return nil
+}
+
+// DistributionTarget defines a recipient of a proportion of the inflation
+// minted on each epoch
+type DistributionTarget struct {
+	// target_type defines the type of the recipient
+	TargetType DistributionTargetType `protobuf:"varint,1,opt,name=target_type,json=targetType,proto3,enum=evmos.inflation.v1.DistributionTargetType" json:"target_type,omitempty"`
+	// address is the module name, bech32 address or hex contract address of the
+	// recipient, depending on the target type
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// weight defines the proportion of the minted minted_denom that is to be
+	// allocated to the recipient
+	Weight cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=weight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight"`
+}
+
+func (m *DistributionTarget) Reset()         { *m = DistributionTarget{} }
+func (m *DistributionTarget) String() string { return proto.CompactTextString(m) }
+func (*DistributionTarget) ProtoMessage()    {}
+func (*DistributionTarget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d064cb35c3ff7df8, []int{1}
+}
+func (m *DistributionTarget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionTarget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributionTarget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionTarget.Merge(m, src)
+}
+func (m *DistributionTarget) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionTarget) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionTarget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionTarget proto.InternalMessageInfo
+
+func (m *DistributionTarget) GetTargetType() DistributionTargetType {
+	if m != nil {
+		return m.TargetType
+	}
+	return DISTRIBUTION_TARGET_TYPE_UNSPECIFIED
+}
+
+func (m *DistributionTarget) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 // ExponentialCalculation holds factors to calculate exponential inflation on
 // each period. Calculation reference:
 // periodProvision = exponentialDecay       *  bondingIncentive
@@ -99,7 +243,7 @@ func (m *ExponentialCalculation) Reset()         { *m = ExponentialCalculation{}
 func (m *ExponentialCalculation) String() string { return proto.CompactTextString(m) }
 func (*ExponentialCalculation) ProtoMessage()    {}
 func (*ExponentialCalculation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d064cb35c3ff7df8, []int{1}
+	return fileDescriptor_d064cb35c3ff7df8, []int{2}
 }
 func (m *ExponentialCalculation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -128,9 +272,117 @@ func (m *ExponentialCalculation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ExponentialCalculation proto.InternalMessageInfo
 
+// HalvingCalculation holds factors to calculate the inflation with halvings on
+// each period. Calculation reference:
+// periodProvision = initial_provision / 2 ^ floor(period / halving_interval)
+// The minted amount is capped so that the supply never exceeds max_supply.
+type HalvingCalculation struct {
+	// initial_provision defines the provision minted on the first period
+	InitialProvision cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=initial_provision,json=initialProvision,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"initial_provision"`
+	// halving_interval defines the number of periods after which the provision
+	// is halved
+	HalvingInterval uint64 `protobuf:"varint,2,opt,name=halving_interval,json=halvingInterval,proto3" json:"halving_interval,omitempty"`
+	// max_supply defines the maximum supply of the mint denom
+	MaxSupply cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_supply"`
+}
+
+func (m *HalvingCalculation) Reset()         { *m = HalvingCalculation{} }
+func (m *HalvingCalculation) String() string { return proto.CompactTextString(m) }
+func (*HalvingCalculation) ProtoMessage()    {}
+func (*HalvingCalculation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d064cb35c3ff7df8, []int{3}
+}
+func (m *HalvingCalculation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HalvingCalculation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HalvingCalculation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HalvingCalculation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HalvingCalculation.Merge(m, src)
+}
+func (m *HalvingCalculation) XXX_Size() int {
+	return m.Size()
+}
+func (m *HalvingCalculation) XXX_DiscardUnknown() {
+	xxx_messageInfo_HalvingCalculation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HalvingCalculation proto.InternalMessageInfo
+
+func (m *HalvingCalculation) GetHalvingInterval() uint64 {
+	if m != nil {
+		return m.HalvingInterval
+	}
+	return 0
+}
+
+// TargetBondedCalculation holds factors to calculate a dynamic inflation rate
+// that is adjusted on each epoch proportionally to the deviation of the bonded
+// ratio from the bonding target. Calculation reference:
+// rateChange = (1 - bondedRatio / bonding_target) * adjustment_rate / epochsPerPeriod
+// rate       = min(max(rate + rateChange, inflation_min), inflation_max)
+type TargetBondedCalculation struct {
+	// inflation_min defines the minimum yearly inflation rate
+	InflationMin cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=inflation_min,json=inflationMin,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation_min"`
+	// inflation_max defines the maximum yearly inflation rate
+	InflationMax cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=inflation_max,json=inflationMax,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation_max"`
+	// bonding_target defines the targeted bonded ratio
+	BondingTarget cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=bonding_target,json=bondingTarget,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"bonding_target"`
+	// adjustment_rate defines the maximum yearly change of the inflation rate
+	AdjustmentRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=adjustment_rate,json=adjustmentRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"adjustment_rate"`
+}
+
+func (m *TargetBondedCalculation) Reset()         { *m = TargetBondedCalculation{} }
+func (m *TargetBondedCalculation) String() string { return proto.CompactTextString(m) }
+func (*TargetBondedCalculation) ProtoMessage()    {}
+func (*TargetBondedCalculation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d064cb35c3ff7df8, []int{4}
+}
+func (m *TargetBondedCalculation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TargetBondedCalculation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TargetBondedCalculation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TargetBondedCalculation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TargetBondedCalculation.Merge(m, src)
+}
+func (m *TargetBondedCalculation) XXX_Size() int {
+	return m.Size()
+}
+func (m *TargetBondedCalculation) XXX_DiscardUnknown() {
+	xxx_messageInfo_TargetBondedCalculation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TargetBondedCalculation proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("evmos.inflation.v1.DistributionTargetType", DistributionTargetType_name, DistributionTargetType_value)
+	proto.RegisterEnum("evmos.inflation.v1.InflationModel", InflationModel_name, InflationModel_value)
 	proto.RegisterType((*InflationDistribution)(nil), "evmos.inflation.v1.InflationDistribution")
+	proto.RegisterType((*DistributionTarget)(nil), "evmos.inflation.v1.DistributionTarget")
 	proto.RegisterType((*ExponentialCalculation)(nil), "evmos.inflation.v1.ExponentialCalculation")
+	proto.RegisterType((*HalvingCalculation)(nil), "evmos.inflation.v1.HalvingCalculation")
+	proto.RegisterType((*TargetBondedCalculation)(nil), "evmos.inflation.v1.TargetBondedCalculation")
 }
 
 func init() {
@@ -138,32 +390,57 @@ func init() {
 }
 
 var fileDescriptor_d064cb35c3ff7df8 = []byte{
-	// 396 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcd, 0x8e, 0xd3, 0x30,
-	0x10, 0xc7, 0xe3, 0xf2, 0x21, 0x61, 0x60, 0x17, 0x22, 0x40, 0xd1, 0x22, 0x65, 0x51, 0x4f, 0xab,
-	0x3d, 0xc4, 0x14, 0x24, 0x1e, 0xa0, 0x94, 0x43, 0x05, 0x12, 0x50, 0x01, 0x07, 0x2e, 0xd1, 0xc4,
-	0x35, 0xa9, 0xd5, 0xd8, 0x13, 0xd9, 0x4e, 0x48, 0xdf, 0x02, 0xf1, 0x14, 0x1c, 0x79, 0x8c, 0x1e,
-	0x7b, 0x44, 0x1c, 0x2a, 0xd4, 0x1e, 0x78, 0x0d, 0x94, 0x0f, 0x5a, 0x71, 0x23, 0x97, 0xd1, 0x64,
-	0x34, 0xbf, 0x7f, 0x3c, 0x7f, 0x8f, 0xe9, 0x50, 0x94, 0x0a, 0x2d, 0x93, 0xfa, 0x53, 0x06, 0x4e,
-	0xa2, 0x66, 0xe5, 0xe8, 0xf8, 0x11, 0xe5, 0x06, 0x1d, 0xfa, 0x7e, 0xd3, 0x13, 0x1d, 0xcb, 0xe5,
-	0xe8, 0xec, 0x2e, 0x28, 0xa9, 0x91, 0x35, 0xb1, 0x6d, 0x3b, 0xbb, 0x97, 0x62, 0x8a, 0x4d, 0xca,
-	0xea, 0xac, 0xad, 0x0e, 0xbf, 0x0e, 0xe8, 0xfd, 0xe9, 0x5f, 0x72, 0x22, 0xad, 0x33, 0x32, 0x29,
-	0xea, 0xdc, 0x7f, 0x4b, 0x4f, 0xad, 0x83, 0xa5, 0xd4, 0x69, 0x6c, 0xc4, 0x67, 0x30, 0x73, 0x1b,
-	0x90, 0x47, 0xe4, 0xe2, 0xc6, 0xf8, 0x62, 0xbd, 0x3d, 0xf7, 0x7e, 0x6e, 0xcf, 0x1f, 0x72, 0xb4,
-	0x0a, 0xad, 0x9d, 0x2f, 0x23, 0x89, 0x4c, 0x81, 0x5b, 0x44, 0xaf, 0x44, 0x0a, 0x7c, 0x35, 0x11,
-	0xfc, 0xdb, 0xef, 0xef, 0x97, 0x64, 0x76, 0xd2, 0x09, 0xcc, 0x5a, 0xde, 0x7f, 0x4f, 0xef, 0x14,
-	0x16, 0x52, 0x11, 0x4b, 0xcd, 0x85, 0x76, 0xb2, 0x14, 0x36, 0x18, 0x34, 0x9a, 0x97, 0xff, 0xab,
-	0x19, 0x90, 0xd9, 0x69, 0xa3, 0x31, 0x3d, 0x48, 0xf8, 0xaf, 0xe9, 0x09, 0x47, 0xa5, 0x0a, 0x2d,
-	0xdd, 0x2a, 0xce, 0x11, 0xb3, 0xe0, 0x4a, 0xcf, 0x83, 0xde, 0x3e, 0xf0, 0x6f, 0x10, 0xb3, 0xe1,
-	0x76, 0x40, 0x1f, 0xbc, 0xa8, 0x72, 0xd4, 0xf5, 0x1f, 0x20, 0x7b, 0x0e, 0x19, 0x2f, 0x5a, 0x87,
-	0xfc, 0x67, 0x94, 0x40, 0x6f, 0x1f, 0x08, 0xd4, 0x9c, 0xe9, 0x66, 0xed, 0xc1, 0x99, 0x9a, 0xe3,
-	0xbd, 0xc7, 0x21, 0xbc, 0xf6, 0x24, 0x41, 0x3d, 0xaf, 0x6f, 0xcf, 0x81, 0x49, 0x85, 0x0b, 0xae,
-	0xf6, 0xf5, 0xa4, 0xe3, 0xdf, 0x35, 0xb8, 0xff, 0x92, 0xde, 0x52, 0x50, 0xc5, 0x25, 0x18, 0x09,
-	0x9a, 0x8b, 0xe0, 0x5a, 0x4f, 0xb9, 0x9b, 0x0a, 0xaa, 0x0f, 0x1d, 0x3c, 0x9e, 0xae, 0x77, 0x21,
-	0xd9, 0xec, 0x42, 0xf2, 0x6b, 0x17, 0x92, 0x2f, 0xfb, 0xd0, 0xdb, 0xec, 0x43, 0xef, 0xc7, 0x3e,
-	0xf4, 0x3e, 0xb2, 0x54, 0xba, 0x45, 0x91, 0x44, 0x1c, 0x15, 0x6b, 0x77, 0xbf, 0x8d, 0xe5, 0x93,
-	0xc7, 0xac, 0xfa, 0xf7, 0x1d, 0xb8, 0x55, 0x2e, 0x6c, 0x72, 0xbd, 0xd9, 0xe3, 0xa7, 0x7f, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0xec, 0x57, 0xae, 0x03, 0x2a, 0x03, 0x00, 0x00,
+	// 790 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcf, 0x8f, 0xdb, 0x44,
+	0x14, 0x8e, 0x9d, 0xd0, 0xb2, 0xb3, 0x6d, 0xd6, 0x1d, 0x41, 0x6b, 0xed, 0x8a, 0xec, 0x36, 0x14,
+	0xb4, 0xec, 0x21, 0x56, 0x0b, 0xe2, 0x4c, 0x12, 0xbb, 0x5b, 0xd3, 0xc4, 0x49, 0x1d, 0x6f, 0x05,
+	0x5c, 0xac, 0x59, 0x7b, 0x70, 0x86, 0xb5, 0x67, 0x2c, 0xcf, 0xc4, 0x49, 0xf8, 0x0b, 0x38, 0xf2,
+	0x27, 0x20, 0x71, 0xe1, 0xc8, 0x19, 0x89, 0x7b, 0x8f, 0x3d, 0x22, 0x90, 0x16, 0xb4, 0x7b, 0xe0,
+	0xdf, 0x40, 0xfe, 0x91, 0xec, 0xb2, 0xed, 0x4a, 0x98, 0x5e, 0xac, 0x37, 0xcf, 0xef, 0xfb, 0x9e,
+	0xdf, 0x37, 0x9f, 0x67, 0x40, 0x1b, 0xa7, 0x11, 0xe3, 0x1a, 0xa1, 0x5f, 0x87, 0x48, 0x10, 0x46,
+	0xb5, 0xf4, 0xe1, 0xc5, 0xa2, 0x13, 0x27, 0x4c, 0x30, 0x08, 0xf3, 0x9a, 0xce, 0x45, 0x3a, 0x7d,
+	0xb8, 0x7d, 0x07, 0x45, 0x84, 0x32, 0x2d, 0x7f, 0x16, 0x65, 0xdb, 0xef, 0x04, 0x2c, 0x60, 0x79,
+	0xa8, 0x65, 0x51, 0x91, 0x6d, 0xff, 0x20, 0x83, 0x77, 0xcd, 0x15, 0x52, 0x27, 0x5c, 0x24, 0xe4,
+	0x78, 0x96, 0xc5, 0xf0, 0x19, 0xd8, 0xe2, 0x02, 0x9d, 0x10, 0x1a, 0xb8, 0x09, 0x9e, 0xa3, 0xc4,
+	0xe7, 0xaa, 0xb4, 0x27, 0xed, 0x6f, 0xf4, 0xf6, 0x5f, 0x9c, 0xee, 0xd6, 0x7e, 0x3f, 0xdd, 0xdd,
+	0xf1, 0x18, 0x8f, 0x18, 0xe7, 0xfe, 0x49, 0x87, 0x30, 0x2d, 0x42, 0x62, 0xda, 0x19, 0xe0, 0x00,
+	0x79, 0x4b, 0x1d, 0x7b, 0x3f, 0xfd, 0xfd, 0xf3, 0x81, 0x64, 0x37, 0x4b, 0x02, 0xbb, 0xc0, 0xc3,
+	0x11, 0x68, 0x7a, 0x2c, 0x8a, 0x66, 0x94, 0x88, 0xa5, 0x1b, 0x33, 0x16, 0xaa, 0xf5, 0x8a, 0x8c,
+	0xb7, 0xd7, 0xf8, 0x31, 0x63, 0x21, 0x7c, 0x0a, 0x6e, 0x0a, 0x94, 0x04, 0x58, 0x70, 0xb5, 0xb1,
+	0x57, 0xdf, 0xdf, 0x7c, 0xf4, 0x61, 0xe7, 0x55, 0x31, 0x3a, 0x97, 0xc7, 0x72, 0xf2, 0xf2, 0xde,
+	0x46, 0xd6, 0xb1, 0xa0, 0x5c, 0x31, 0x7c, 0xde, 0x78, 0x5b, 0x56, 0xea, 0xb6, 0x32, 0xe3, 0x28,
+	0xc0, 0x2e, 0xa1, 0x1e, 0xa6, 0x82, 0xa4, 0x98, 0xb7, 0x7f, 0x95, 0x00, 0x7c, 0x95, 0x02, 0x3e,
+	0x05, 0x9b, 0x05, 0xd2, 0x15, 0xcb, 0x18, 0xe7, 0xda, 0x34, 0x1f, 0x1d, 0xfc, 0xb7, 0xfe, 0xce,
+	0x32, 0xc6, 0x36, 0x10, 0xeb, 0x18, 0xaa, 0xe0, 0x26, 0xf2, 0xfd, 0x04, 0x73, 0xae, 0xca, 0x99,
+	0x24, 0xf6, 0x6a, 0x09, 0x3f, 0x03, 0x37, 0xe6, 0x98, 0x04, 0x53, 0x51, 0x59, 0xab, 0x12, 0xd7,
+	0x3e, 0x95, 0xc1, 0x5d, 0x63, 0x11, 0x33, 0x9a, 0x0d, 0x84, 0xc2, 0x3e, 0x0a, 0xbd, 0x59, 0xf1,
+	0x71, 0xf0, 0x53, 0x20, 0xa1, 0xca, 0xbb, 0x2a, 0xa1, 0x0c, 0x97, 0xa8, 0x72, 0x55, 0x5c, 0x92,
+	0xe1, 0xbc, 0xca, 0x73, 0x48, 0x5e, 0x66, 0x9c, 0x63, 0x46, 0xfd, 0xcc, 0x8b, 0x85, 0x68, 0x6a,
+	0xa3, 0xaa, 0x71, 0x4a, 0xfc, 0x7a, 0xf3, 0x6e, 0x45, 0x68, 0xe1, 0xa6, 0x28, 0x21, 0x88, 0x7a,
+	0x58, 0x7d, 0xab, 0x22, 0xdd, 0x66, 0x84, 0x16, 0xcf, 0x4b, 0x70, 0xfb, 0x4f, 0x09, 0xc0, 0x27,
+	0x28, 0x4c, 0x09, 0x0d, 0x2e, 0x8b, 0x7b, 0x04, 0xee, 0x10, 0x4a, 0x32, 0xc9, 0xdd, 0x38, 0x61,
+	0x29, 0xe1, 0x84, 0xd1, 0xca, 0x62, 0x2b, 0x25, 0xc5, 0x78, 0xc5, 0x00, 0x3f, 0x02, 0xca, 0xb4,
+	0x68, 0xe6, 0x12, 0x2a, 0x70, 0x92, 0xa2, 0x30, 0xdf, 0x8a, 0x86, 0xbd, 0x55, 0xe6, 0xcd, 0x32,
+	0x0d, 0x0f, 0x01, 0xc8, 0xa6, 0xe4, 0xb3, 0x38, 0x0e, 0x97, 0x95, 0x75, 0xdf, 0x88, 0xd0, 0x62,
+	0x92, 0x43, 0xdb, 0x7f, 0xc8, 0xe0, 0x5e, 0xf9, 0xe7, 0x30, 0xea, 0x63, 0xff, 0xf2, 0x98, 0x43,
+	0x70, 0x7b, 0xed, 0x76, 0x37, 0x22, 0xd5, 0x47, 0xbc, 0xb5, 0x86, 0x0f, 0xc9, 0x55, 0x3a, 0xb4,
+	0x50, 0xe5, 0xff, 0x4f, 0x87, 0x16, 0xaf, 0x71, 0x4e, 0xfd, 0xcd, 0x9c, 0xf3, 0x0c, 0x6c, 0x21,
+	0xff, 0x9b, 0x19, 0x17, 0x11, 0xa6, 0xc2, 0x4d, 0x90, 0xc0, 0x95, 0xbd, 0xd8, 0xbc, 0x20, 0xb0,
+	0x91, 0xc0, 0x07, 0xbf, 0x48, 0xe0, 0xee, 0xeb, 0xcf, 0x08, 0xb8, 0x0f, 0x1e, 0xe8, 0xe6, 0xc4,
+	0xb1, 0xcd, 0xde, 0x91, 0x63, 0x8e, 0x2c, 0xd7, 0xe9, 0xda, 0x87, 0x86, 0xe3, 0x3a, 0x5f, 0x8e,
+	0x0d, 0xf7, 0xc8, 0x9a, 0x8c, 0x8d, 0xbe, 0xf9, 0xd8, 0x34, 0x74, 0xa5, 0x06, 0xdf, 0x07, 0xbb,
+	0xd7, 0x56, 0x0e, 0x47, 0xfa, 0xd1, 0xc0, 0x50, 0x24, 0xf8, 0x00, 0xec, 0x5d, 0x5b, 0xd4, 0xd5,
+	0x75, 0xdb, 0x98, 0x4c, 0x14, 0x19, 0x7e, 0x00, 0xee, 0x5f, 0x5b, 0xd5, 0x1f, 0x59, 0x8e, 0xdd,
+	0xed, 0x3b, 0x4a, 0x7d, 0xbb, 0xf1, 0xdd, 0x8f, 0xad, 0xda, 0xc1, 0x1c, 0x34, 0xd7, 0xf7, 0xc7,
+	0x90, 0xf9, 0x38, 0x84, 0xbb, 0x60, 0xc7, 0xb4, 0x1e, 0x0f, 0xba, 0x39, 0x76, 0x38, 0xd2, 0x8d,
+	0x81, 0x6b, 0x7c, 0x31, 0x1e, 0x59, 0x86, 0xe5, 0x98, 0xdd, 0x81, 0x52, 0x83, 0x3b, 0xe0, 0xde,
+	0xd5, 0x82, 0x27, 0xdd, 0xc1, 0x73, 0xd3, 0x3a, 0x54, 0x24, 0x78, 0x1f, 0xbc, 0x77, 0xf5, 0x65,
+	0xd9, 0xbf, 0x37, 0xb2, 0x74, 0x43, 0x57, 0xe4, 0xa2, 0x71, 0xcf, 0x7a, 0x71, 0xd6, 0x92, 0x5e,
+	0x9e, 0xb5, 0xa4, 0xbf, 0xce, 0x5a, 0xd2, 0xf7, 0xe7, 0xad, 0xda, 0xcb, 0xf3, 0x56, 0xed, 0xb7,
+	0xf3, 0x56, 0xed, 0xab, 0x4f, 0x02, 0x22, 0xa6, 0xb3, 0xe3, 0x8e, 0xc7, 0x22, 0xad, 0x4b, 0xbe,
+	0xc5, 0xa1, 0x85, 0xc5, 0x9c, 0x25, 0x27, 0x5a, 0x9f, 0xf1, 0xc8, 0x48, 0x23, 0x6d, 0xf1, 0xef,
+	0x0b, 0x35, 0x3b, 0xbf, 0xf9, 0xf1, 0x8d, 0xfc, 0x42, 0xfc, 0xf8, 0x9f, 0x01, 0x00, 0xb1, 0x5f,
+	0x99, 0x88, 0x73, 0x07, 0x00, 0x00,
 }
 
 func (m *InflationDistribution) Marshal() (dAtA []byte, err error) {
@@ -186,6 +463,20 @@ func (m *InflationDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Targets) > 0 {
+		for iNdEx := len(m.Targets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Targets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInflation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size := m.CommunityPool.Size()
 		i -= size
@@ -197,25 +488,60 @@ func (m *InflationDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x1a
 	{
-		size := m.UsageIncentives.Size()
+		size := m.StakingRewards.Size()
 		i -= size
-		if _, err := m.UsageIncentives.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.StakingRewards.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintInflation(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionTarget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionTarget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionTarget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	{
-		size := m.StakingRewards.Size()
+		size := m.Weight.Size()
 		i -= size
-		if _, err := m.StakingRewards.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintInflation(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintInflation(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TargetType != 0 {
+		i = encodeVarintInflation(dAtA, i, uint64(m.TargetType))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -285,65 +611,518 @@ func (m *ExponentialCalculation) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		if _, err := m.A.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i = encodeVarintInflation(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintInflation(dAtA []byte, offset int, v uint64) int {
-	offset -= sovInflation(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *InflationDistribution) Size() (n int) {
-	if m == nil {
-		return 0
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *HalvingCalculation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HalvingCalculation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HalvingCalculation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.HalvingInterval != 0 {
+		i = encodeVarintInflation(dAtA, i, uint64(m.HalvingInterval))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.InitialProvision.Size()
+		i -= size
+		if _, err := m.InitialProvision.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TargetBondedCalculation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TargetBondedCalculation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TargetBondedCalculation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AdjustmentRate.Size()
+		i -= size
+		if _, err := m.AdjustmentRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.BondingTarget.Size()
+		i -= size
+		if _, err := m.BondingTarget.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.InflationMax.Size()
+		i -= size
+		if _, err := m.InflationMax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.InflationMin.Size()
+		i -= size
+		if _, err := m.InflationMin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintInflation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovInflation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InflationDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StakingRewards.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.CommunityPool.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	if len(m.Targets) > 0 {
+		for _, e := range m.Targets {
+			l = e.Size()
+			n += 1 + l + sovInflation(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DistributionTarget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TargetType != 0 {
+		n += 1 + sovInflation(uint64(m.TargetType))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovInflation(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	return n
+}
+
+func (m *ExponentialCalculation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.A.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.R.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.C.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.BondingTarget.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.MaxVariance.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	return n
+}
+
+func (m *HalvingCalculation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InitialProvision.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	if m.HalvingInterval != 0 {
+		n += 1 + sovInflation(uint64(m.HalvingInterval))
+	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	return n
+}
+
+func (m *TargetBondedCalculation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InflationMin.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.InflationMax.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.BondingTarget.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.AdjustmentRate.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	return n
+}
+
+func sovInflation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozInflation(x uint64) (n int) {
+	return sovInflation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InflationDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InflationDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InflationDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingRewards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakingRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Targets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Targets = append(m.Targets, DistributionTarget{})
+			if err := m.Targets[len(m.Targets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DistributionTarget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionTarget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionTarget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetType", wireType)
+			}
+			m.TargetType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetType |= DistributionTargetType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.StakingRewards.Size()
-	n += 1 + l + sovInflation(uint64(l))
-	l = m.UsageIncentives.Size()
-	n += 1 + l + sovInflation(uint64(l))
-	l = m.CommunityPool.Size()
-	n += 1 + l + sovInflation(uint64(l))
-	return n
-}
 
-func (m *ExponentialCalculation) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.A.Size()
-	n += 1 + l + sovInflation(uint64(l))
-	l = m.R.Size()
-	n += 1 + l + sovInflation(uint64(l))
-	l = m.C.Size()
-	n += 1 + l + sovInflation(uint64(l))
-	l = m.BondingTarget.Size()
-	n += 1 + l + sovInflation(uint64(l))
-	l = m.MaxVariance.Size()
-	n += 1 + l + sovInflation(uint64(l))
-	return n
-}
-
-func sovInflation(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozInflation(x uint64) (n int) {
-	return sovInflation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *InflationDistribution) Unmarshal(dAtA []byte) error {
+func (m *ExponentialCalculation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -366,15 +1145,15 @@ func (m *InflationDistribution) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InflationDistribution: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExponentialCalculation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InflationDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExponentialCalculation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field A", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -402,13 +1181,13 @@ func (m *InflationDistribution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StakingRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.A.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsageIncentives", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -436,13 +1215,13 @@ func (m *InflationDistribution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UsageIncentives.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.R.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field C", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -470,7 +1249,75 @@ func (m *InflationDistribution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.C.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondingTarget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondingTarget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVariance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxVariance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -495,7 +1342,7 @@ func (m *InflationDistribution) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExponentialCalculation) Unmarshal(dAtA []byte) error {
+func (m *HalvingCalculation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -518,15 +1365,15 @@ func (m *ExponentialCalculation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExponentialCalculation: wiretype end group for non-group")
+			return fmt.Errorf("proto: HalvingCalculation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExponentialCalculation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HalvingCalculation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field A", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialProvision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -554,13 +1401,32 @@ func (m *ExponentialCalculation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.A.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InitialProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalvingInterval", wireType)
+			}
+			m.HalvingInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HalvingInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -588,13 +1454,63 @@ func (m *ExponentialCalculation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.R.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TargetBondedCalculation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TargetBondedCalculation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TargetBondedCalculation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field C", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -622,11 +1538,45 @@ func (m *ExponentialCalculation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.C.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InflationMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BondingTarget", wireType)
 			}
@@ -660,9 +1610,9 @@ func (m *ExponentialCalculation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxVariance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdjustmentRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -690,7 +1640,7 @@ func (m *ExponentialCalculation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxVariance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AdjustmentRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -71,3 +71,86 @@ func CalculateEpochMintProvision(
 	epochProvision = epochProvision.Mul(math.LegacyNewDecFromInt(aizeltypes.PowerReduction))
 	return epochProvision
 }
+
+// CalculateHalvingEpochMintProvision returns mint provision per epoch for the
+// halving inflation model:
+//
+// f(x) = initialProvision / 2 ^ floor(x / halvingInterval)
+//
+// where x represents the period. The epoch emission is f(x) / numberOfEpochs.
+// NOTE: the max supply cap is not applied here, as it depends on the current supply.
+func CalculateHalvingEpochMintProvision(
+	params Params,
+	period uint64,
+	epochsPerPeriod int64,
+) math.LegacyDec {
+	if epochsPerPeriod == 0 || params.HalvingCalculation.HalvingInterval == 0 {
+		return math.LegacyZeroDec()
+	}
+
+	halvings := period / params.HalvingCalculation.HalvingInterval
+	// the provision is zero after 64 halvings for any realistic initial provision
+	if halvings >= 64 {
+		return math.LegacyZeroDec()
+	}
+
+	periodProvision := params.HalvingCalculation.InitialProvision.QuoInt64(int64(1) << halvings)
+	epochProvision := periodProvision.Quo(math.LegacyNewDec(epochsPerPeriod))
+
+	// Multiply epochMintProvision with power reduction (10^18 for aizel) as the
+	// calculation is based on `aizel` and the issued tokens need to be given in
+	// `aaizel`
+	return epochProvision.Mul(math.LegacyNewDecFromInt(aizeltypes.PowerReduction))
+}
+
+// CalculateTargetBondedInflationRate returns the yearly inflation rate of the
+// target bonded inflation model after an epoch. The rate is adjusted
+// proportionally to the deviation of the bonded ratio from the bonding target:
+//
+// rateChange = (1 - bondedRatio / bondingTarget) * adjustmentRate / epochsPerPeriod
+//
+// and bounded to the [inflationMin, inflationMax] range.
+func CalculateTargetBondedInflationRate(
+	params Params,
+	currentRate math.LegacyDec,
+	epochsPerPeriod int64,
+	bondedRatio math.LegacyDec,
+) math.LegacyDec {
+	calc := params.TargetBondedCalculation
+
+	rate := currentRate
+	if epochsPerPeriod != 0 && calc.BondingTarget.IsPositive() {
+		rateChangePerPeriod := math.LegacyOneDec().Sub(bondedRatio.Quo(calc.BondingTarget)).Mul(calc.AdjustmentRate)
+		rate = rate.Add(rateChangePerPeriod.Quo(math.LegacyNewDec(epochsPerPeriod)))
+	}
+
+	return calc.BoundInflationRate(rate)
+}
+
+// BoundInflationRate bounds the given yearly inflation rate to the
+// [inflationMin, inflationMax] range.
+func (calc TargetBondedCalculation) BoundInflationRate(rate math.LegacyDec) math.LegacyDec {
+	if rate.GT(calc.InflationMax) {
+		return calc.InflationMax
+	}
+	if rate.LT(calc.InflationMin) {
+		return calc.InflationMin
+	}
+
+	return rate
+}
+
+// CalculateTargetBondedEpochMintProvision returns mint provision per epoch for
+// the target bonded inflation model, given the yearly inflation rate and the
+// supply (in `aaizel`) the rate applies to.
+func CalculateTargetBondedEpochMintProvision(
+	rate math.LegacyDec,
+	supply math.LegacyDec,
+	epochsPerPeriod int64,
+) math.LegacyDec {
+	if epochsPerPeriod == 0 {
+		return math.LegacyZeroDec()
+	}
+
+	return rate.Mul(supply).Quo(math.LegacyNewDec(epochsPerPeriod))
+}
@@ -157,3 +157,122 @@ func (suite *InflationTestSuite) TestCalculateEpochMintProvision() {
 		})
 	}
 }
+
+func (suite *InflationTestSuite) TestCalculateHalvingEpochMintProvision() {
+	params := DefaultParams()
+	params.HalvingCalculation.InitialProvision = math.LegacyNewDec(365_000)
+	params.HalvingCalculation.HalvingInterval = 4
+	epochsPerPeriod := int64(365)
+
+	testCases := []struct {
+		name              string
+		period            uint64
+		epochsPerPeriod   int64
+		expEpochProvision math.LegacyDec
+	}{
+		{
+			"pass - first period",
+			uint64(0),
+			epochsPerPeriod,
+			// 365_000 / 365 * 10 ** 18
+			math.LegacyNewDec(1_000).MulInt(math.NewIntWithDecimal(1, 18)),
+		},
+		{
+			"pass - last period before halving",
+			uint64(3),
+			epochsPerPeriod,
+			math.LegacyNewDec(1_000).MulInt(math.NewIntWithDecimal(1, 18)),
+		},
+		{
+			"pass - second halving",
+			uint64(9),
+			epochsPerPeriod,
+			// 365_000 / 2 ** 2 / 365 * 10 ** 18
+			math.LegacyNewDec(250).MulInt(math.NewIntWithDecimal(1, 18)),
+		},
+		{
+			"pass - zero after 64 halvings",
+			uint64(256),
+			epochsPerPeriod,
+			math.LegacyZeroDec(),
+		},
+		{
+			"pass - zero epochs per period",
+			uint64(0),
+			0,
+			math.LegacyZeroDec(),
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			epochMintProvision := CalculateHalvingEpochMintProvision(params, tc.period, tc.epochsPerPeriod)
+			suite.Require().Equal(tc.expEpochProvision, epochMintProvision)
+		})
+	}
+}
+
+func (suite *InflationTestSuite) TestCalculateTargetBondedInflationRate() {
+	params := DefaultParams()
+	params.TargetBondedCalculation = TargetBondedCalculation{
+		InflationMin:   math.LegacyNewDecWithPrec(5, 2),
+		InflationMax:   math.LegacyNewDecWithPrec(20, 2),
+		BondingTarget:  math.LegacyNewDecWithPrec(50, 2),
+		AdjustmentRate: math.LegacyNewDecWithPrec(10, 2),
+	}
+	epochsPerPeriod := int64(10)
+
+	testCases := []struct {
+		name        string
+		currentRate math.LegacyDec
+		bondedRatio math.LegacyDec
+		expRate     math.LegacyDec
+	}{
+		{
+			"pass - increase below bonding target",
+			math.LegacyNewDecWithPrec(10, 2),
+			math.LegacyNewDecWithPrec(25, 2),
+			// 0.1 + (1 - 0.25 / 0.5) * 0.1 / 10
+			math.LegacyNewDecWithPrec(105, 3),
+		},
+		{
+			"pass - decrease above bonding target",
+			math.LegacyNewDecWithPrec(10, 2),
+			math.LegacyNewDecWithPrec(75, 2),
+			// 0.1 + (1 - 0.75 / 0.5) * 0.1 / 10
+			math.LegacyNewDecWithPrec(95, 3),
+		},
+		{
+			"pass - unchanged at bonding target",
+			math.LegacyNewDecWithPrec(10, 2),
+			math.LegacyNewDecWithPrec(50, 2),
+			math.LegacyNewDecWithPrec(10, 2),
+		},
+		{
+			"pass - bounded to max inflation",
+			math.LegacyNewDecWithPrec(20, 2),
+			math.LegacyZeroDec(),
+			math.LegacyNewDecWithPrec(20, 2),
+		},
+		{
+			"pass - bounded to min inflation",
+			math.LegacyNewDecWithPrec(5, 2),
+			math.LegacyOneDec(),
+			math.LegacyNewDecWithPrec(5, 2),
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			rate := CalculateTargetBondedInflationRate(params, tc.currentRate, epochsPerPeriod, tc.bondedRatio)
+			suite.Require().Equal(tc.expRate, rate)
+		})
+	}
+}
+
+func (suite *InflationTestSuite) TestCalculateTargetBondedEpochMintProvision() {
+	rate := math.LegacyNewDecWithPrec(10, 2)
+	supply := math.LegacyNewDec(3_650_000)
+
+	// 0.1 * 3_650_000 / 365
+	suite.Require().Equal(math.LegacyNewDec(1_000), CalculateTargetBondedEpochMintProvision(rate, supply, 365))
+	suite.Require().Equal(math.LegacyZeroDec(), CalculateTargetBondedEpochMintProvision(rate, supply, 0))
+}
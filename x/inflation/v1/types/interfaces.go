@@ -9,6 +9,10 @@ import (
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmtypes "github.com/AizelNetwork/CosmEvm/x/evm/types"
 )
 
 // AccountKeeper defines the contract required for account APIs.
@@ -30,6 +34,7 @@ type BankKeeper interface {
 	BurnCoins(ctx context.Context, name string, amt sdk.Coins) error
 	HasSupply(ctx context.Context, denom string) bool
 	GetSupply(ctx context.Context, denom string) sdk.Coin
+	BlockedAddr(addr sdk.AccAddress) bool
 }
 
 // DistrKeeper defines the contract needed to be fulfilled for distribution keeper
@@ -45,6 +50,12 @@ type StakingKeeper interface {
 	TotalBondedTokens(ctx context.Context) (math.Int, error)
 }
 
+// EVMKeeper defines the expected EVM keeper interface used to notify contract
+// distribution targets
+type EVMKeeper interface {
+	CallEVMWithGasLimit(ctx sdk.Context, abi abi.ABI, from, contract common.Address, gasLimit uint64, method string, args ...interface{}) (*evmtypes.MsgEthereumTxResponse, error)
+}
+
 type (
 	LegacyParams = paramtypes.ParamSet
 	// Subspace defines an interface that implements the legacy Cosmos SDK x/params Subspace type.
@@ -22,6 +22,7 @@ const (
 	prefixEpochIdentifier
 	prefixEpochsPerPeriod
 	prefixSkippedEpochs
+	prefixInflationRate
 )
 
 // KVStore key prefixes
@@ -30,4 +31,5 @@ var (
 	KeyPrefixEpochIdentifier = []byte{prefixEpochIdentifier}
 	KeyPrefixEpochsPerPeriod = []byte{prefixEpochsPerPeriod}
 	KeyPrefixSkippedEpochs   = []byte{prefixSkippedEpochs}
+	KeyPrefixInflationRate   = []byte{prefixInflationRate}
 )
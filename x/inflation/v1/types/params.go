@@ -35,10 +35,21 @@ var (
 		BondingTarget: math.LegacyNewDecWithPrec(66, 2), // 66%
 		MaxVariance:   math.LegacyZeroDec(),             // 0%
 	}
+	DefaultInflationModel     = INFLATION_MODEL_EXPONENTIAL
+	DefaultHalvingCalculation = HalvingCalculation{
+		InitialProvision: math.LegacyNewDec(int64(300_000_000)),
+		HalvingInterval:  4,
+		MaxSupply:        math.LegacyNewDec(int64(2_000_000_000)),
+	}
+	DefaultTargetBondedCalculation = TargetBondedCalculation{
+		InflationMin:   math.LegacyNewDecWithPrec(7, 2),  // 7%
+		InflationMax:   math.LegacyNewDecWithPrec(20, 2), // 20%
+		BondingTarget:  math.LegacyNewDecWithPrec(66, 2), // 66%
+		AdjustmentRate: math.LegacyNewDecWithPrec(13, 2), // 13%
+	}
 	DefaultInflationDistribution = InflationDistribution{
-		StakingRewards:  math.LegacyNewDecWithPrec(533333334, 9), // 0.53
-		CommunityPool:   math.LegacyNewDecWithPrec(466666666, 9), // 0.47
-		UsageIncentives: math.LegacyZeroDec(),                    // Deprecated
+		StakingRewards: math.LegacyNewDecWithPrec(533333334, 9), // 0.53
+		CommunityPool:  math.LegacyNewDecWithPrec(466666666, 9), // 0.47
 	}
 )
 
@@ -49,20 +60,26 @@ func NewParams(
 	enableInflation bool,
 ) Params {
 	return Params{
-		MintDenom:              mintDenom,
-		ExponentialCalculation: exponentialCalculation,
-		InflationDistribution:  inflationDistribution,
-		EnableInflation:        enableInflation,
+		MintDenom:               mintDenom,
+		ExponentialCalculation:  exponentialCalculation,
+		InflationDistribution:   inflationDistribution,
+		EnableInflation:         enableInflation,
+		InflationModel:          DefaultInflationModel,
+		HalvingCalculation:      DefaultHalvingCalculation,
+		TargetBondedCalculation: DefaultTargetBondedCalculation,
 	}
 }
 
 // default minting module parameters
 func DefaultParams() Params {
 	return Params{
-		MintDenom:              DefaultInflationDenom,
-		ExponentialCalculation: DefaultExponentialCalculation,
-		InflationDistribution:  DefaultInflationDistribution,
-		EnableInflation:        DefaultInflation,
+		MintDenom:               DefaultInflationDenom,
+		ExponentialCalculation:  DefaultExponentialCalculation,
+		InflationDistribution:   DefaultInflationDistribution,
+		EnableInflation:         DefaultInflation,
+		InflationModel:          DefaultInflationModel,
+		HalvingCalculation:      DefaultHalvingCalculation,
+		TargetBondedCalculation: DefaultTargetBondedCalculation,
 	}
 }
 
@@ -121,6 +138,77 @@ func validateExponentialCalculation(i interface{}) error {
 	return nil
 }
 
+func validateInflationModel(i interface{}) error {
+	v, ok := i.(InflationModel)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := InflationModel_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid inflation model: %d", v)
+	}
+
+	return nil
+}
+
+func validateHalvingCalculation(i interface{}) error {
+	v, ok := i.(HalvingCalculation)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.InitialProvision.IsNil() || v.InitialProvision.IsNegative() {
+		return fmt.Errorf("initial provision cannot be negative")
+	}
+
+	if v.HalvingInterval == 0 {
+		return fmt.Errorf("halving interval cannot be zero")
+	}
+
+	if v.MaxSupply.IsNil() || !v.MaxSupply.IsPositive() {
+		return fmt.Errorf("max supply must be positive")
+	}
+
+	return nil
+}
+
+func validateTargetBondedCalculation(i interface{}) error {
+	v, ok := i.(TargetBondedCalculation)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.InflationMin.IsNil() || v.InflationMin.IsNegative() {
+		return fmt.Errorf("min inflation cannot be negative")
+	}
+
+	if v.InflationMax.IsNil() || v.InflationMax.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("max inflation cannot be greater than 1")
+	}
+
+	if v.InflationMin.GT(v.InflationMax) {
+		return fmt.Errorf("min inflation cannot be greater than max inflation")
+	}
+
+	if v.BondingTarget.IsNil() || !v.BondingTarget.IsPositive() {
+		return fmt.Errorf("bonded target cannot be zero or negative")
+	}
+
+	if v.BondingTarget.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("bonded target cannot be greater than 1")
+	}
+
+	if v.AdjustmentRate.IsNil() || v.AdjustmentRate.IsNegative() {
+		return fmt.Errorf("adjustment rate cannot be negative")
+	}
+
+	if v.AdjustmentRate.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("adjustment rate cannot be greater than 1")
+	}
+
+	return nil
+}
+
 func validateInflationDistribution(i interface{}) error {
 	v, ok := i.(InflationDistribution)
 	if !ok {
@@ -131,15 +219,27 @@ func validateInflationDistribution(i interface{}) error {
 		return errors.New("staking distribution ratio must not be negative")
 	}
 
-	if !v.UsageIncentives.IsZero() {
-		return errors.New("incentives pool distribution is deprecated. UsageIncentives param should be zero")
-	}
-
 	if v.CommunityPool.IsNegative() {
 		return errors.New("community pool distribution ratio must not be negative")
 	}
 
-	totalProportions := v.StakingRewards.Add(v.UsageIncentives).Add(v.CommunityPool)
+	totalProportions := v.StakingRewards.Add(v.CommunityPool)
+
+	seenTargets := make(map[string]bool, len(v.Targets))
+	for _, target := range v.Targets {
+		if err := target.Validate(); err != nil {
+			return err
+		}
+
+		key := target.TargetType.String() + "/" + strings.ToLower(target.Address)
+		if seenTargets[key] {
+			return fmt.Errorf("duplicate distribution target: %s", target.Address)
+		}
+		seenTargets[key] = true
+
+		totalProportions = totalProportions.Add(target.Weight)
+	}
+
 	if !totalProportions.Equal(math.LegacyNewDec(1)) {
 		return errors.New("total distributions ratio should be 1")
 	}
@@ -147,6 +247,41 @@ func validateInflationDistribution(i interface{}) error {
 	return nil
 }
 
+// NewDistributionTarget creates a new DistributionTarget instance
+func NewDistributionTarget(targetType DistributionTargetType, address string, weight math.LegacyDec) DistributionTarget {
+	return DistributionTarget{
+		TargetType: targetType,
+		Address:    address,
+		Weight:     weight,
+	}
+}
+
+// Validate performs a stateless validation of the distribution target
+func (t DistributionTarget) Validate() error {
+	if t.Weight.IsNil() || !t.Weight.IsPositive() {
+		return fmt.Errorf("distribution target %s weight must be positive", t.Address)
+	}
+
+	switch t.TargetType {
+	case DISTRIBUTION_TARGET_TYPE_MODULE:
+		if strings.TrimSpace(t.Address) == "" {
+			return errors.New("distribution target module name cannot be blank")
+		}
+	case DISTRIBUTION_TARGET_TYPE_ADDRESS:
+		if _, err := sdk.AccAddressFromBech32(t.Address); err != nil {
+			return fmt.Errorf("invalid distribution target address %s: %w", t.Address, err)
+		}
+	case DISTRIBUTION_TARGET_TYPE_CONTRACT:
+		if err := aizeltypes.ValidateNonZeroAddress(t.Address); err != nil {
+			return fmt.Errorf("invalid distribution target contract %s: %w", t.Address, err)
+		}
+	default:
+		return fmt.Errorf("invalid distribution target type: %s", t.TargetType)
+	}
+
+	return nil
+}
+
 func validateBool(i interface{}) error {
 	_, ok := i.(bool)
 	if !ok {
@@ -166,6 +301,15 @@ func (p Params) Validate() error {
 	if err := validateInflationDistribution(p.InflationDistribution); err != nil {
 		return err
 	}
+	if err := validateInflationModel(p.InflationModel); err != nil {
+		return err
+	}
+	if err := validateHalvingCalculation(p.HalvingCalculation); err != nil {
+		return err
+	}
+	if err := validateTargetBondedCalculation(p.TargetBondedCalculation); err != nil {
+		return err
+	}
 
 	return validateBool(p.EnableInflation)
 }
@@ -25,9 +25,27 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 	}
 
 	validInflationDistribution := InflationDistribution{
-		StakingRewards:  math.LegacyNewDecWithPrec(533334, 6),
-		UsageIncentives: math.LegacyZeroDec(),
-		CommunityPool:   math.LegacyNewDecWithPrec(466666, 6),
+		StakingRewards: math.LegacyNewDecWithPrec(533334, 6),
+		CommunityPool:  math.LegacyNewDecWithPrec(466666, 6),
+	}
+
+	contract := "0x1D54EcB8583Ca25895c512A8308389fFD581F9c9"
+	withTargets := func(targets ...DistributionTarget) Params {
+		return NewParams(
+			DefaultInflationDenom,
+			validExponentialCalculation,
+			InflationDistribution{
+				StakingRewards: math.LegacyNewDecWithPrec(5, 1),
+				CommunityPool:  math.LegacyNewDecWithPrec(3, 1),
+				Targets:        targets,
+			},
+			true,
+		)
+	}
+	withModel := func(malleate func(p *Params)) Params {
+		params := DefaultParams()
+		malleate(&params)
+		return params
 	}
 
 	testCases := []struct {
@@ -53,13 +71,61 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 		{
 			"valid param literal",
 			Params{
-				MintDenom:              DefaultInflationDenom,
-				ExponentialCalculation: validExponentialCalculation,
-				InflationDistribution:  validInflationDistribution,
-				EnableInflation:        true,
+				MintDenom:               DefaultInflationDenom,
+				ExponentialCalculation:  validExponentialCalculation,
+				InflationDistribution:   validInflationDistribution,
+				EnableInflation:         true,
+				InflationModel:          INFLATION_MODEL_HALVING,
+				HalvingCalculation:      DefaultHalvingCalculation,
+				TargetBondedCalculation: DefaultTargetBondedCalculation,
 			},
 			false,
 		},
+		{
+			"valid - target bonded inflation model",
+			withModel(func(p *Params) { p.InflationModel = INFLATION_MODEL_TARGET_BONDED }),
+			false,
+		},
+		{
+			"invalid - inflation model",
+			withModel(func(p *Params) { p.InflationModel = InflationModel(3) }),
+			true,
+		},
+		{
+			"invalid - halving calculation - zero halving interval",
+			withModel(func(p *Params) { p.HalvingCalculation.HalvingInterval = 0 }),
+			true,
+		},
+		{
+			"invalid - halving calculation - negative initial provision",
+			withModel(func(p *Params) { p.HalvingCalculation.InitialProvision = math.LegacyOneDec().Neg() }),
+			true,
+		},
+		{
+			"invalid - halving calculation - zero max supply",
+			withModel(func(p *Params) { p.HalvingCalculation.MaxSupply = math.LegacyZeroDec() }),
+			true,
+		},
+		{
+			"invalid - target bonded calculation - min inflation greater than max inflation",
+			withModel(func(p *Params) { p.TargetBondedCalculation.InflationMin = math.LegacyNewDecWithPrec(21, 2) }),
+			true,
+		},
+		{
+			"invalid - target bonded calculation - max inflation greater than 1",
+			withModel(func(p *Params) { p.TargetBondedCalculation.InflationMax = math.LegacyNewDec(2) }),
+			true,
+		},
+		{
+			"invalid - target bonded calculation - zero bonding target",
+			withModel(func(p *Params) { p.TargetBondedCalculation.BondingTarget = math.LegacyZeroDec() }),
+			true,
+		},
+		{
+			"invalid - target bonded calculation - negative adjustment rate",
+			withModel(func(p *Params) { p.TargetBondedCalculation.AdjustmentRate = math.LegacyOneDec().Neg() }),
+			true,
+		},
 		{
 			"invalid - denom",
 			NewParams(
@@ -198,26 +264,63 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 				MintDenom:              DefaultInflationDenom,
 				ExponentialCalculation: validExponentialCalculation,
 				InflationDistribution: InflationDistribution{
-					StakingRewards:  math.LegacyOneDec().Neg(),
-					UsageIncentives: math.LegacyNewDecWithPrec(333333, 6),
-					CommunityPool:   math.LegacyNewDecWithPrec(133333, 6),
+					StakingRewards: math.LegacyOneDec().Neg(),
+					CommunityPool:  math.LegacyNewDecWithPrec(133333, 6),
 				},
 				EnableInflation: true,
 			},
 			true,
 		},
 		{
-			"invalid - inflation distribution - negative usage incentives",
-			Params{
-				MintDenom:              DefaultInflationDenom,
-				ExponentialCalculation: validExponentialCalculation,
-				InflationDistribution: InflationDistribution{
-					StakingRewards:  math.LegacyNewDecWithPrec(533334, 6),
-					UsageIncentives: math.LegacyOneDec().Neg(),
-					CommunityPool:   math.LegacyNewDecWithPrec(133333, 6),
-				},
-				EnableInflation: true,
-			},
+			"valid - inflation distribution - with targets",
+			withTargets(
+				NewDistributionTarget(DISTRIBUTION_TARGET_TYPE_MODULE, "erc20", math.LegacyNewDecWithPrec(1, 1)),
+				NewDistributionTarget(DISTRIBUTION_TARGET_TYPE_CONTRACT, contract, math.LegacyNewDecWithPrec(1, 1)),
+			),
+			false,
+		},
+		{
+			"invalid - inflation distribution - negative target weight",
+			withTargets(
+				NewDistributionTarget(DISTRIBUTION_TARGET_TYPE_MODULE, "erc20", math.LegacyNewDecWithPrec(1, 1).Neg()),
+				NewDistributionTarget(DISTRIBUTION_TARGET_TYPE_CONTRACT, contract, math.LegacyNewDecWithPrec(3, 1)),
+			),
+			true,
+		},
+		{
+			"invalid - inflation distribution - unspecified target type",
+			withTargets(
+				NewDistributionTarget(DISTRIBUTION_TARGET_TYPE_UNSPECIFIED, "erc20", math.LegacyNewDecWithPrec(2, 1)),
+			),
+			true,
+		},
+		{
+			"invalid - inflation distribution - invalid target address",
+			withTargets(
+				NewDistributionTarget(DISTRIBUTION_TARGET_TYPE_ADDRESS, contract, math.LegacyNewDecWithPrec(2, 1)),
+			),
+			true,
+		},
+		{
+			"invalid - inflation distribution - invalid target contract",
+			withTargets(
+				NewDistributionTarget(DISTRIBUTION_TARGET_TYPE_CONTRACT, "erc20", math.LegacyNewDecWithPrec(2, 1)),
+			),
+			true,
+		},
+		{
+			"invalid - inflation distribution - duplicate targets",
+			withTargets(
+				NewDistributionTarget(DISTRIBUTION_TARGET_TYPE_CONTRACT, contract, math.LegacyNewDecWithPrec(1, 1)),
+				NewDistributionTarget(DISTRIBUTION_TARGET_TYPE_CONTRACT, contract, math.LegacyNewDecWithPrec(1, 1)),
+			),
+			true,
+		},
+		{
+			"invalid - inflation distribution - targets total distribution ratio unequal 1",
+			withTargets(
+				NewDistributionTarget(DISTRIBUTION_TARGET_TYPE_MODULE, "erc20", math.LegacyNewDecWithPrec(1, 1)),
+			),
 			true,
 		},
 		{
@@ -226,9 +329,8 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 				MintDenom:              DefaultInflationDenom,
 				ExponentialCalculation: validExponentialCalculation,
 				InflationDistribution: InflationDistribution{
-					StakingRewards:  math.LegacyNewDecWithPrec(533334, 6),
-					UsageIncentives: math.LegacyNewDecWithPrec(333333, 6),
-					CommunityPool:   math.LegacyOneDec().Neg(),
+					StakingRewards: math.LegacyNewDecWithPrec(533334, 6),
+					CommunityPool:  math.LegacyOneDec().Neg(),
 				},
 				EnableInflation: true,
 			},
@@ -240,9 +342,8 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 				MintDenom:              DefaultInflationDenom,
 				ExponentialCalculation: validExponentialCalculation,
 				InflationDistribution: InflationDistribution{
-					StakingRewards:  math.LegacyNewDecWithPrec(533333, 6),
-					UsageIncentives: math.LegacyNewDecWithPrec(333333, 6),
-					CommunityPool:   math.LegacyNewDecWithPrec(133333, 6),
+					StakingRewards: math.LegacyNewDecWithPrec(533333, 6),
+					CommunityPool:  math.LegacyNewDecWithPrec(133333, 6),
 				},
 				EnableInflation: true,
 			},
@@ -0,0 +1,140 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AizelNetwork/CosmEvm/x/revenue/types"
+)
+
+// NewTxCmd returns a root CLI command handler for revenue transaction commands
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "revenue subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewRegisterRevenueCmd(),
+		NewUpdateRevenueCmd(),
+		NewCancelRevenueCmd(),
+	)
+	return txCmd
+}
+
+// NewRegisterRevenueCmd returns a CLI command handler for registering a
+// contract for fee distribution
+func NewRegisterRevenueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register CONTRACT_HEX NONCES [WITHDRAWER_BECH32]",
+		Short: "Register a contract for fee distribution. **NOTE** Please ensure, that the deployer of the contract (or the factory that deployed the contract) is an account that is owned by your project, to avoid that an individual deployer who leaves your project becomes malicious.",
+		Long:  "Register a contract for fee distribution.\nOnly the contract deployer can register a contract.\nProvide the account nonce(s) used to derive the contract address. E.g.: you have an account nonce of 4 when you send a deployment transaction for a contract A; you use this contract as a factory, to create another contract B. If you register A, the nonces value is \"4\". If you register B, the nonces value is \"4,1\" (B is the first contract created by A). \nThe withdrawer address defaults to the deployer address if not provided.",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var nonces []uint64
+			for _, nonce := range strings.Split(args[1], ",") {
+				n, err := strconv.ParseUint(strings.TrimSpace(nonce), 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid nonce %s: %w", nonce, err)
+				}
+				nonces = append(nonces, n)
+			}
+
+			var withdrawer sdk.AccAddress
+			if len(args) == 3 {
+				withdrawer, err = sdk.AccAddressFromBech32(args[2])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgRegisterRevenue(args[0], cliCtx.GetFromAddress(), withdrawer, nonces)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewUpdateRevenueCmd returns a CLI command handler for updating the
+// withdrawer address of a contract registered for fee distribution
+func NewUpdateRevenueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update CONTRACT_HEX WITHDRAWER_BECH32",
+		Short: "Update withdrawer address for a contract registered for fee distribution.",
+		Long:  "Update withdrawer address for a contract registered for fee distribution. \nOnly the contract deployer can update the withdrawer address.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			withdrawer, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateRevenue(args[0], cliCtx.GetFromAddress(), withdrawer)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCancelRevenueCmd returns a CLI command handler for canceling the fee
+// distribution of a contract
+func NewCancelRevenueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel CONTRACT_HEX",
+		Short: "Cancel a contract from fee distribution",
+		Long:  "Cancel a contract from fee distribution. The deployer will no longer receive fees from users interacting with the contract. \nOnly the contract deployer can cancel a contract.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelRevenue(args[0], cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,95 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmtypes "github.com/AizelNetwork/CosmEvm/x/evm/types"
+	"github.com/AizelNetwork/CosmEvm/x/revenue/types"
+)
+
+// DistributeFees splits the fees of an Ethereum tx held by the fee collector
+// according to the module params. The burn shares are burned and, if the called
+// contract is registered, the developer shares are sent to its withdrawer. The
+// remaining fees are left in the fee collector for the validators.
+//
+// Only the fees paid in the EVM denom are distributed. The fees paid in a fee
+// market fee denom, e.g. an IBC voucher, are left in the fee collector, as
+// burning them would destroy coins backed by the escrow of the counterparty
+// chain.
+//
+// The distributed amounts are capped to the fee collector balance. It returns
+// the burned and the developer coins.
+func (k Keeper) DistributeFees(ctx sdk.Context, txHash string, contract *common.Address, fees sdk.Coins) (burned, developer sdk.Coins, err error) {
+	params := k.GetParams(ctx)
+	if !params.EnableRevenue || !fees.IsAllPositive() {
+		return nil, nil, nil
+	}
+
+	var revenue types.Revenue
+	found := false
+	if contract != nil {
+		revenue, found = k.GetRevenue(ctx, *contract)
+	}
+
+	feeCollector := authtypes.NewModuleAddress(k.feeCollectorName)
+	for _, fee := range fees {
+		if fee.Denom != evmtypes.GetEVMCoinDenom() {
+			continue
+		}
+
+		balance := k.bankKeeper.GetBalance(ctx, feeCollector, fee.Denom)
+		available := sdkmath.MinInt(fee.Amount, balance.Amount)
+
+		burnAmt := sdkmath.LegacyNewDecFromInt(available).Mul(params.BurnShares).TruncateInt()
+		if burnAmt.IsPositive() {
+			burned = burned.Add(sdk.NewCoin(fee.Denom, burnAmt))
+		}
+
+		if !found {
+			continue
+		}
+
+		developerAmt := sdkmath.LegacyNewDecFromInt(available).Mul(params.DeveloperShares).TruncateInt()
+		if developerAmt.IsPositive() {
+			developer = developer.Add(sdk.NewCoin(fee.Denom, developerAmt))
+		}
+	}
+
+	if !burned.IsZero() {
+		if err := k.bankKeeper.BurnCoins(ctx, k.feeCollectorName, burned); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if !developer.IsZero() {
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, k.feeCollectorName, revenue.GetWithdrawerAddr(), developer)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if burned.IsZero() && developer.IsZero() {
+		return nil, nil, nil
+	}
+
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyTxHash, txHash),
+		sdk.NewAttribute(types.AttributeKeyBurned, burned.String()),
+		sdk.NewAttribute(types.AttributeKeyDeveloper, developer.String()),
+	}
+	if found {
+		attrs = append(attrs,
+			sdk.NewAttribute(types.AttributeKeyContract, revenue.ContractAddress),
+			sdk.NewAttribute(types.AttributeKeyWithdrawer, revenue.WithdrawerAddress),
+		)
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeDistributeRevenue, attrs...))
+
+	return burned, developer, nil
+}
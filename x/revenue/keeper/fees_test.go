@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	utiltx "github.com/AizelNetwork/CosmEvm/testutil/tx"
+	evmtypes "github.com/AizelNetwork/CosmEvm/x/evm/types"
+	inflationtypes "github.com/AizelNetwork/CosmEvm/x/inflation/v1/types"
+	"github.com/AizelNetwork/CosmEvm/x/revenue/types"
+)
+
+func TestDistributeFees(t *testing.T) {
+	params := types.NewParams(
+		true,
+		sdkmath.LegacyNewDecWithPrec(20, 2),
+		sdkmath.LegacyNewDecWithPrec(50, 2),
+		sdkmath.LegacyNewDecWithPrec(30, 2),
+		types.DefaultAddrDerivationCostCreate,
+	)
+	unregistered := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name         string
+		registered   bool
+		contract     bool
+		feeCollector sdkmath.Int
+		expBurned    sdkmath.Int
+		expDeveloper sdkmath.Int
+	}{
+		{
+			"pass - contract creation only burns",
+			false,
+			false,
+			sdkmath.NewInt(1000),
+			sdkmath.NewInt(200),
+			sdkmath.ZeroInt(),
+		},
+		{
+			"pass - unregistered contract only burns",
+			false,
+			true,
+			sdkmath.NewInt(1000),
+			sdkmath.NewInt(200),
+			sdkmath.ZeroInt(),
+		},
+		{
+			"pass - registered contract",
+			true,
+			true,
+			sdkmath.NewInt(1000),
+			sdkmath.NewInt(200),
+			sdkmath.NewInt(300),
+		},
+		{
+			"pass - capped to the fee collector balance",
+			true,
+			true,
+			sdkmath.NewInt(100),
+			sdkmath.NewInt(20),
+			sdkmath.NewInt(30),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite := SetupTest(params)
+		ctx := suite.network.GetContext()
+		k := suite.network.App.RevenueKeeper
+		bk := suite.network.App.BankKeeper
+		denom := evmtypes.GetEVMCoinDenom()
+		fees := sdk.Coins{sdk.NewCoin(denom, sdkmath.NewInt(1000))}
+
+		collected := sdk.Coins{sdk.NewCoin(denom, tc.feeCollector)}
+		require.NoError(t, bk.MintCoins(ctx, inflationtypes.ModuleName, collected))
+		require.NoError(t, bk.SendCoinsFromModuleToModule(ctx, inflationtypes.ModuleName, authtypes.FeeCollectorName, collected))
+
+		withdrawer := suite.keyring.GetAccAddr(1)
+		contract := suite.DeployContract(t, suite.keyring.GetAddr(0), 1)
+		if tc.registered {
+			k.SetRevenue(ctx, types.NewRevenue(contract, suite.keyring.GetAccAddr(0), withdrawer))
+		}
+
+		var to *common.Address
+		if tc.contract {
+			to = &unregistered
+			if tc.registered {
+				to = &contract
+			}
+		}
+
+		withdrawerBefore := bk.GetBalance(ctx, withdrawer, denom)
+
+		burned, developer, err := k.DistributeFees(ctx, "0x00", to, fees)
+		require.NoError(t, err, tc.name)
+		require.True(t, tc.expBurned.Equal(burned.AmountOf(denom)), tc.name)
+		require.True(t, tc.expDeveloper.Equal(developer.AmountOf(denom)), tc.name)
+
+		withdrawerAfter := bk.GetBalance(ctx, withdrawer, denom)
+		require.True(t, tc.expDeveloper.Equal(withdrawerAfter.Amount.Sub(withdrawerBefore.Amount)), tc.name)
+
+		feeCollectorBalance := bk.GetBalance(ctx, authtypes.NewModuleAddress(authtypes.FeeCollectorName), denom)
+		expBalance := tc.feeCollector.Sub(tc.expBurned).Sub(tc.expDeveloper)
+		require.True(t, expBalance.Equal(feeCollectorBalance.Amount), tc.name)
+	}
+}
+
+func TestDistributeFeesFeeDenom(t *testing.T) {
+	params := types.NewParams(
+		true,
+		sdkmath.LegacyNewDecWithPrec(20, 2),
+		sdkmath.LegacyNewDecWithPrec(50, 2),
+		sdkmath.LegacyNewDecWithPrec(30, 2),
+		types.DefaultAddrDerivationCostCreate,
+	)
+
+	suite := SetupTest(params)
+	ctx := suite.network.GetContext()
+	k := suite.network.App.RevenueKeeper
+	bk := suite.network.App.BankKeeper
+	feeDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	fees := sdk.Coins{sdk.NewCoin(feeDenom, sdkmath.NewInt(1000))}
+
+	require.NoError(t, bk.MintCoins(ctx, inflationtypes.ModuleName, fees))
+	require.NoError(t, bk.SendCoinsFromModuleToModule(ctx, inflationtypes.ModuleName, authtypes.FeeCollectorName, fees))
+
+	withdrawer := suite.keyring.GetAccAddr(1)
+	contract := suite.DeployContract(t, suite.keyring.GetAddr(0), 1)
+	k.SetRevenue(ctx, types.NewRevenue(contract, suite.keyring.GetAccAddr(0), withdrawer))
+
+	// the fees paid in a fee denom are left in the fee collector
+	burned, developer, err := k.DistributeFees(ctx, "0x00", &contract, fees)
+	require.NoError(t, err)
+	require.True(t, burned.IsZero())
+	require.True(t, developer.IsZero())
+
+	feeCollectorBalance := bk.GetBalance(ctx, authtypes.NewModuleAddress(authtypes.FeeCollectorName), feeDenom)
+	require.Equal(t, sdkmath.NewInt(1000), feeCollectorBalance.Amount)
+	require.True(t, bk.GetBalance(ctx, withdrawer, feeDenom).IsZero())
+}
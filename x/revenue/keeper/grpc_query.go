@@ -0,0 +1,120 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	aizeltypes "github.com/AizelNetwork/CosmEvm/types"
+	"github.com/AizelNetwork/CosmEvm/x/revenue/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Revenues returns all revenues that have been registered for fee distribution
+func (k Keeper) Revenues(
+	c context.Context,
+	req *types.QueryRevenuesRequest,
+) (*types.QueryRevenuesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var revenues []types.Revenue
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRevenue)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var revenue types.Revenue
+		if err := k.cdc.Unmarshal(value, &revenue); err != nil {
+			return err
+		}
+		revenues = append(revenues, revenue)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRevenuesResponse{
+		Revenues:   revenues,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Revenue returns the revenue that has been registered for fee distribution for
+// a given contract
+func (k Keeper) Revenue(
+	c context.Context,
+	req *types.QueryRevenueRequest,
+) (*types.QueryRevenueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := aizeltypes.ValidateNonZeroAddress(req.ContractAddress); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid contract address %s", req.ContractAddress)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	revenue, found := k.GetRevenue(ctx, common.HexToAddress(req.ContractAddress))
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "revenue registered contract '%s'", req.ContractAddress)
+	}
+
+	return &types.QueryRevenueResponse{Revenue: revenue}, nil
+}
+
+// Params returns the revenue module params
+func (k Keeper) Params(
+	c context.Context,
+	_ *types.QueryParamsRequest,
+) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// DeployerRevenues returns all contracts that have been registered for fee
+// distribution by a given deployer
+func (k Keeper) DeployerRevenues(
+	c context.Context,
+	req *types.QueryDeployerRevenuesRequest,
+) (*types.QueryDeployerRevenuesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	deployer, err := sdk.AccAddressFromBech32(req.DeployerAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid deployer address %s", req.DeployerAddress)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var contracts []string
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetKeyPrefixDeployer(deployer))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		contracts = append(contracts, common.BytesToAddress(key).Hex())
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDeployerRevenuesResponse{
+		ContractAddresses: contracts,
+		Pagination:        pageRes,
+	}, nil
+}
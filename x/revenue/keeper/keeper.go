@@ -0,0 +1,57 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AizelNetwork/CosmEvm/x/revenue/types"
+)
+
+// Keeper of this module maintains collections of revenues for contracts
+// registered to receive transaction fees.
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+	// the address capable of executing a MsgUpdateParams message. Typically, this should be the x/gov module account.
+	authority sdk.AccAddress
+
+	bankKeeper       types.BankKeeper
+	evmKeeper        types.EVMKeeper
+	feeCollectorName string
+}
+
+// NewKeeper creates new instances of the revenue Keeper
+func NewKeeper(
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+	authority sdk.AccAddress,
+	bk types.BankKeeper,
+	evmKeeper types.EVMKeeper,
+	feeCollector string,
+) Keeper {
+	// ensure gov module account is set and is not nil
+	if err := sdk.VerifyAddressFormat(authority); err != nil {
+		panic(err)
+	}
+
+	return Keeper{
+		storeKey:         storeKey,
+		cdc:              cdc,
+		authority:        authority,
+		bankKeeper:       bk,
+		evmKeeper:        evmKeeper,
+		feeCollectorName: feeCollector,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
@@ -0,0 +1,182 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/AizelNetwork/CosmEvm/x/revenue/types"
+)
+
+var _ types.MsgServer = &Keeper{}
+
+// RegisterRevenue registers a contract to receive transaction fees. The sender
+// proves to be the deployer of the contract with the nonces of the address
+// path from the sender to the contract.
+func (k Keeper) RegisterRevenue(goCtx context.Context, msg *types.MsgRegisterRevenue) (*types.MsgRegisterRevenueResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := k.GetParams(ctx)
+	if !params.EnableRevenue {
+		return nil, types.ErrRevenueDisabled
+	}
+
+	contract := common.HexToAddress(msg.ContractAddress)
+	if k.IsRevenueRegistered(ctx, contract) {
+		return nil, errorsmod.Wrapf(types.ErrRevenueAlreadyRegistered, "contract %s", contract)
+	}
+
+	deployer := sdk.MustAccAddressFromBech32(msg.DeployerAddress)
+	deployerAccount := k.evmKeeper.GetAccountWithoutBalance(ctx, common.BytesToAddress(deployer))
+	if deployerAccount == nil || deployerAccount.IsContract() {
+		return nil, errorsmod.Wrapf(types.ErrRevenueDeployerIsNotEOA, "deployer %s", deployer)
+	}
+
+	var withdrawer sdk.AccAddress
+	if msg.WithdrawerAddress != "" {
+		withdrawer = sdk.MustAccAddressFromBech32(msg.WithdrawerAddress)
+		if k.bankKeeper.BlockedAddr(withdrawer) {
+			return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s is not allowed to receive funds", withdrawer)
+		}
+	}
+
+	// the address derivation is charged per nonce as it would be on a contract
+	// creation
+	ctx.GasMeter().ConsumeGas(
+		params.AddrDerivationCostCreate*uint64(len(msg.Nonces)),
+		"revenue registration: address derivation CREATE opcode",
+	)
+
+	if derived := types.DeriveContractAddress(common.BytesToAddress(deployer), msg.Nonces); derived != contract {
+		return nil, errorsmod.Wrapf(
+			types.ErrRevenueContractNotMatch,
+			"expected %s, got %s", contract, derived,
+		)
+	}
+
+	contractAccount := k.evmKeeper.GetAccountWithoutBalance(ctx, contract)
+	if contractAccount == nil || !contractAccount.IsContract() {
+		return nil, errorsmod.Wrapf(types.ErrRevenueNoContractDeployed, "contract %s", contract)
+	}
+
+	revenue := types.NewRevenue(contract, deployer, withdrawer)
+	k.SetRevenue(ctx, revenue)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterRevenue,
+			sdk.NewAttribute(types.AttributeKeyContract, revenue.ContractAddress),
+			sdk.NewAttribute(types.AttributeKeyDeployer, revenue.DeployerAddress),
+			sdk.NewAttribute(types.AttributeKeyWithdrawer, revenue.WithdrawerAddress),
+		),
+	)
+
+	return &types.MsgRegisterRevenueResponse{}, nil
+}
+
+// UpdateRevenue updates the withdrawer address of the revenue of a contract
+// registered by the sender.
+func (k Keeper) UpdateRevenue(goCtx context.Context, msg *types.MsgUpdateRevenue) (*types.MsgUpdateRevenueResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.GetParams(ctx).EnableRevenue {
+		return nil, types.ErrRevenueDisabled
+	}
+
+	revenue, err := k.getDeployerRevenue(ctx, msg.ContractAddress, msg.DeployerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if revenue.WithdrawerAddress == msg.WithdrawerAddress {
+		return nil, errorsmod.Wrapf(types.ErrRevenueWithdrawerUnchanged, "withdrawer %s", msg.WithdrawerAddress)
+	}
+
+	withdrawer := sdk.MustAccAddressFromBech32(msg.WithdrawerAddress)
+	if k.bankKeeper.BlockedAddr(withdrawer) {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s is not allowed to receive funds", withdrawer)
+	}
+
+	revenue.WithdrawerAddress = withdrawer.String()
+	k.SetRevenue(ctx, revenue)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateRevenue,
+			sdk.NewAttribute(types.AttributeKeyContract, revenue.ContractAddress),
+			sdk.NewAttribute(types.AttributeKeyDeployer, revenue.DeployerAddress),
+			sdk.NewAttribute(types.AttributeKeyWithdrawer, revenue.WithdrawerAddress),
+		),
+	)
+
+	return &types.MsgUpdateRevenueResponse{}, nil
+}
+
+// CancelRevenue deletes the revenue of a contract registered by the sender, so
+// that the contract no longer receives transaction fees.
+func (k Keeper) CancelRevenue(goCtx context.Context, msg *types.MsgCancelRevenue) (*types.MsgCancelRevenueResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.GetParams(ctx).EnableRevenue {
+		return nil, types.ErrRevenueDisabled
+	}
+
+	revenue, err := k.getDeployerRevenue(ctx, msg.ContractAddress, msg.DeployerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	k.DeleteRevenue(ctx, revenue)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelRevenue,
+			sdk.NewAttribute(types.AttributeKeyContract, revenue.ContractAddress),
+			sdk.NewAttribute(types.AttributeKeyDeployer, revenue.DeployerAddress),
+		),
+	)
+
+	return &types.MsgCancelRevenueResponse{}, nil
+}
+
+// UpdateParams implements the gRPC MsgServer interface. After a successful governance vote
+// it updates the parameters in the keeper only if the requested authority
+// is the Cosmos SDK governance module account
+func (k Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// getDeployerRevenue returns the revenue of the given contract, checking that
+// it was registered by the given deployer.
+func (k Keeper) getDeployerRevenue(ctx sdk.Context, contractAddress, deployerAddress string) (types.Revenue, error) {
+	contract := common.HexToAddress(contractAddress)
+	revenue, found := k.GetRevenue(ctx, contract)
+	if !found {
+		return types.Revenue{}, errorsmod.Wrapf(types.ErrRevenueNotFound, "contract %s", contract)
+	}
+
+	if revenue.DeployerAddress != deployerAddress {
+		return types.Revenue{}, errorsmod.Wrapf(
+			types.ErrInvalidDeployer,
+			"expected %s, got %s", revenue.DeployerAddress, deployerAddress,
+		)
+	}
+
+	return revenue, nil
+}
@@ -0,0 +1,158 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	utiltx "github.com/AizelNetwork/CosmEvm/testutil/tx"
+	"github.com/AizelNetwork/CosmEvm/x/revenue/types"
+)
+
+func TestRegisterRevenue(t *testing.T) {
+	suite := SetupTest(types.DefaultParams())
+	ctx := suite.network.GetContext()
+	k := suite.network.App.RevenueKeeper
+
+	deployer := suite.keyring.GetAccAddr(0)
+	contract := suite.DeployContract(t, suite.keyring.GetAddr(0), 1)
+	// the contract is created by the contract deployed on the deployer nonce 0
+	factoryContract := suite.DeployContract(t, suite.DeployContract(t, suite.keyring.GetAddr(0), 0), 5)
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgRegisterRevenue
+		expErr error
+	}{
+		{
+			"fail - deployer is not an EOA",
+			types.NewMsgRegisterRevenue(contract.Hex(), sdk.AccAddress(contract.Bytes()), nil, []uint64{1}),
+			types.ErrRevenueDeployerIsNotEOA,
+		},
+		{
+			"fail - nonces do not derive the contract address",
+			types.NewMsgRegisterRevenue(contract.Hex(), deployer, nil, []uint64{2}),
+			types.ErrRevenueContractNotMatch,
+		},
+		{
+			"fail - no contract deployed",
+			types.NewMsgRegisterRevenue(utiltx.GenerateAddress().Hex(), deployer, nil, []uint64{1}),
+			types.ErrRevenueContractNotMatch,
+		},
+		{
+			"fail - blocked withdrawer",
+			types.NewMsgRegisterRevenue(contract.Hex(), deployer, authtypes.NewModuleAddress(authtypes.FeeCollectorName), []uint64{1}),
+			errortypes.ErrUnauthorized,
+		},
+		{
+			"pass - contract deployed by the deployer",
+			types.NewMsgRegisterRevenue(contract.Hex(), deployer, nil, []uint64{1}),
+			nil,
+		},
+		{
+			"pass - contract deployed by a factory",
+			types.NewMsgRegisterRevenue(factoryContract.Hex(), deployer, suite.keyring.GetAccAddr(1), []uint64{0, 5}),
+			nil,
+		},
+		{
+			"fail - already registered",
+			types.NewMsgRegisterRevenue(contract.Hex(), deployer, nil, []uint64{1}),
+			types.ErrRevenueAlreadyRegistered,
+		},
+	}
+
+	for _, tc := range testCases {
+		_, err := k.RegisterRevenue(ctx, tc.msg)
+
+		if tc.expErr != nil {
+			require.ErrorIs(t, err, tc.expErr, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+
+	revenue, found := k.GetRevenue(ctx, contract)
+	require.True(t, found)
+	require.Equal(t, deployer, revenue.GetWithdrawerAddr())
+
+	revenue, found = k.GetRevenue(ctx, factoryContract)
+	require.True(t, found)
+	require.Equal(t, suite.keyring.GetAccAddr(1), revenue.GetWithdrawerAddr())
+	require.Len(t, k.GetContractsByDeployer(ctx, deployer), 2)
+}
+
+func TestUpdateAndCancelRevenue(t *testing.T) {
+	suite := SetupTest(types.DefaultParams())
+	ctx := suite.network.GetContext()
+	k := suite.network.App.RevenueKeeper
+
+	deployer := suite.keyring.GetAccAddr(0)
+	other := suite.keyring.GetAccAddr(1)
+	contract := suite.DeployContract(t, suite.keyring.GetAddr(0), 1)
+
+	_, err := k.UpdateRevenue(ctx, types.NewMsgUpdateRevenue(contract.Hex(), deployer, other))
+	require.ErrorIs(t, err, types.ErrRevenueNotFound)
+
+	_, err = k.RegisterRevenue(ctx, types.NewMsgRegisterRevenue(contract.Hex(), deployer, nil, []uint64{1}))
+	require.NoError(t, err)
+
+	_, err = k.UpdateRevenue(ctx, types.NewMsgUpdateRevenue(contract.Hex(), other, other))
+	require.ErrorIs(t, err, types.ErrInvalidDeployer)
+
+	_, err = k.UpdateRevenue(ctx, types.NewMsgUpdateRevenue(contract.Hex(), deployer, deployer))
+	require.ErrorIs(t, err, types.ErrRevenueWithdrawerUnchanged)
+
+	_, err = k.UpdateRevenue(ctx, types.NewMsgUpdateRevenue(contract.Hex(), deployer, other))
+	require.NoError(t, err)
+
+	revenue, found := k.GetRevenue(ctx, contract)
+	require.True(t, found)
+	require.Equal(t, other, revenue.GetWithdrawerAddr())
+
+	_, err = k.CancelRevenue(ctx, types.NewMsgCancelRevenue(contract.Hex(), other))
+	require.ErrorIs(t, err, types.ErrInvalidDeployer)
+
+	_, err = k.CancelRevenue(ctx, types.NewMsgCancelRevenue(contract.Hex(), deployer))
+	require.NoError(t, err)
+
+	require.False(t, k.IsRevenueRegistered(ctx, contract))
+	require.Empty(t, k.GetContractsByDeployer(ctx, deployer))
+}
+
+func TestRevenueDisabled(t *testing.T) {
+	params := types.DefaultParams()
+	params.EnableRevenue = false
+
+	suite := SetupTest(params)
+	ctx := suite.network.GetContext()
+	k := suite.network.App.RevenueKeeper
+	contract := suite.DeployContract(t, suite.keyring.GetAddr(0), 1)
+
+	_, err := k.RegisterRevenue(ctx, types.NewMsgRegisterRevenue(contract.Hex(), suite.keyring.GetAccAddr(0), nil, []uint64{1}))
+	require.ErrorIs(t, err, types.ErrRevenueDisabled)
+}
+
+func TestUpdateParams(t *testing.T) {
+	suite := SetupTest(types.DefaultParams())
+	ctx := suite.network.GetContext()
+	k := suite.network.App.RevenueKeeper
+
+	params := types.DefaultParams()
+	params.BurnShares = params.ValidatorShares
+	params.ValidatorShares = params.ValidatorShares.Sub(params.BurnShares)
+
+	_, err := k.UpdateParams(ctx, &types.MsgUpdateParams{Authority: suite.keyring.GetAccAddr(0).String(), Params: params})
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	_, err = k.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority.String(), Params: params})
+	require.NoError(t, err)
+	updated := k.GetParams(ctx)
+	require.True(t, params.BurnShares.Equal(updated.BurnShares))
+	require.True(t, params.ValidatorShares.Equal(updated.ValidatorShares))
+	require.True(t, params.DeveloperShares.Equal(updated.DeveloperShares))
+}
@@ -0,0 +1,100 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/AizelNetwork/CosmEvm/x/revenue/types"
+)
+
+// GetRevenues returns all revenues stored
+func (k Keeper) GetRevenues(ctx sdk.Context) []types.Revenue {
+	revenues := []types.Revenue{}
+
+	k.IterateRevenues(ctx, func(revenue types.Revenue) (stop bool) {
+		revenues = append(revenues, revenue)
+		return false
+	})
+
+	return revenues
+}
+
+// IterateRevenues iterates over all registered contracts and performs a
+// callback with the corresponding revenue.
+func (k Keeper) IterateRevenues(ctx sdk.Context, handlerFn func(revenue types.Revenue) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixRevenue)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var revenue types.Revenue
+		k.cdc.MustUnmarshal(iterator.Value(), &revenue)
+
+		if handlerFn(revenue) {
+			break
+		}
+	}
+}
+
+// GetRevenue returns the revenue for a registered contract
+func (k Keeper) GetRevenue(ctx sdk.Context, contract common.Address) (types.Revenue, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRevenue)
+	bz := store.Get(contract.Bytes())
+	if len(bz) == 0 {
+		return types.Revenue{}, false
+	}
+
+	var revenue types.Revenue
+	k.cdc.MustUnmarshal(bz, &revenue)
+	return revenue, true
+}
+
+// SetRevenue stores the revenue for a registered contract and indexes the
+// contract by its deployer.
+func (k Keeper) SetRevenue(ctx sdk.Context, revenue types.Revenue) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRevenue)
+	store.Set(revenue.GetContractAddr().Bytes(), k.cdc.MustMarshal(&revenue))
+
+	ctx.KVStore(k.storeKey).Set(
+		types.GetKeyDeployerContract(revenue.GetDeployerAddr(), revenue.GetContractAddr()),
+		[]byte{1},
+	)
+}
+
+// DeleteRevenue deletes the revenue of a registered contract and its deployer
+// index.
+func (k Keeper) DeleteRevenue(ctx sdk.Context, revenue types.Revenue) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRevenue)
+	store.Delete(revenue.GetContractAddr().Bytes())
+
+	ctx.KVStore(k.storeKey).Delete(
+		types.GetKeyDeployerContract(revenue.GetDeployerAddr(), revenue.GetContractAddr()),
+	)
+}
+
+// IsRevenueRegistered checks if a contract was registered for receiving
+// transaction fees
+func (k Keeper) IsRevenueRegistered(ctx sdk.Context, contract common.Address) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRevenue)
+	return store.Has(contract.Bytes())
+}
+
+// GetContractsByDeployer returns the hex addresses of the contracts registered
+// by the given deployer
+func (k Keeper) GetContractsByDeployer(ctx sdk.Context, deployer sdk.AccAddress) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetKeyPrefixDeployer(deployer))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var contracts []string
+	for ; iterator.Valid(); iterator.Next() {
+		contracts = append(contracts, common.BytesToAddress(iterator.Key()).Hex())
+	}
+
+	return contracts
+}
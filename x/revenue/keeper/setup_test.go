@@ -0,0 +1,54 @@
+package keeper_test
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AizelNetwork/CosmEvm/testutil/integration/aizel/keyring"
+	"github.com/AizelNetwork/CosmEvm/testutil/integration/aizel/network"
+	"github.com/AizelNetwork/CosmEvm/x/evm/statedb"
+	"github.com/AizelNetwork/CosmEvm/x/revenue/types"
+)
+
+// KeeperTestSuite is the implementation of the test suite for the
+// Revenue module.
+type KeeperTestSuite struct {
+	network *network.UnitTestNetwork
+	keyring keyring.Keyring
+}
+
+// SetupTest is the setup function for revenue module tests with the given
+// module parameters.
+func SetupTest(params types.Params) *KeeperTestSuite {
+	keys := keyring.New(2)
+
+	customGenesis := network.CustomGenesisState{}
+	customGenesis[types.ModuleName] = &types.GenesisState{Params: params}
+
+	nw := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keys.GetAllAccAddrs()...),
+		network.WithCustomGenesis(customGenesis),
+	)
+
+	return &KeeperTestSuite{
+		network: nw,
+		keyring: keys,
+	}
+}
+
+// DeployContract sets a contract code on the address created by the given
+// deployer with the given nonce, as if the deployer had sent the create tx.
+func (s *KeeperTestSuite) DeployContract(t require.TestingT, deployer common.Address, nonce uint64) common.Address {
+	ctx := s.network.GetContext()
+	contract := crypto.CreateAddress(deployer, nonce)
+
+	code := []byte{0x60, 0x00}
+	codeHash := crypto.Keccak256(code)
+	s.network.App.EvmKeeper.SetCode(ctx, codeHash, code)
+
+	err := s.network.App.EvmKeeper.SetAccount(ctx, contract, statedb.Account{Balance: common.Big0, CodeHash: codeHash})
+	require.NoError(t, err)
+
+	return contract
+}
@@ -0,0 +1,20 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrRevenueDisabled            = errorsmod.Register(ModuleName, 2, "revenue module is disabled by governance")
+	ErrRevenueAlreadyRegistered   = errorsmod.Register(ModuleName, 3, "revenue already exists for given contract")
+	ErrRevenueNotFound            = errorsmod.Register(ModuleName, 4, "revenue not found for given contract")
+	ErrRevenueDeployerIsNotEOA    = errorsmod.Register(ModuleName, 5, "no account found or the deployer is not an EOA")
+	ErrRevenueNoContractDeployed  = errorsmod.Register(ModuleName, 6, "no contract deployed")
+	ErrRevenueContractNotMatch    = errorsmod.Register(ModuleName, 7, "contract address does not match the address derived from the deployer and nonces")
+	ErrRevenueWithdrawerUnchanged = errorsmod.Register(ModuleName, 8, "withdrawer address is unchanged")
+	ErrInvalidDeployer            = errorsmod.Register(ModuleName, 9, "sender is not the deployer of the contract")
+)
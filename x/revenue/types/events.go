@@ -0,0 +1,19 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package types
+
+// revenue events
+const (
+	EventTypeRegisterRevenue   = "register_revenue"
+	EventTypeUpdateRevenue     = "update_revenue"
+	EventTypeCancelRevenue     = "cancel_revenue"
+	EventTypeDistributeRevenue = "distribute_revenue"
+
+	AttributeKeyContract   = "contract"
+	AttributeKeyDeployer   = "deployer"
+	AttributeKeyWithdrawer = "withdrawer"
+	AttributeKeyTxHash     = "tx_hash"
+	AttributeKeyBurned     = "burned"
+	AttributeKeyDeveloper  = "developer"
+)
@@ -0,0 +1,45 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, revenues []Revenue) GenesisState {
+	return GenesisState{
+		Params:   params,
+		Revenues: revenues,
+	}
+}
+
+// DefaultGenesisState sets default revenue genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seenContract := make(map[string]bool)
+	for _, revenue := range gs.Revenues {
+		// only one revenue per contract
+		contract := strings.ToLower(revenue.ContractAddress)
+		if seenContract[contract] {
+			return fmt.Errorf("contract duplicated on genesis '%s'", revenue.ContractAddress)
+		}
+
+		if err := revenue.Validate(); err != nil {
+			return err
+		}
+
+		seenContract[contract] = true
+	}
+
+	return gs.Params.Validate()
+}
@@ -0,0 +1,748 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: evmos/revenue/v1/genesis.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the module's genesis state.
+type GenesisState struct {
+	// params are the revenue module parameters at genesis
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// revenues is a slice of active registered contracts for fee distribution
+	Revenues []Revenue `protobuf:"bytes,2,rep,name=revenues,proto3" json:"revenues"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_649d64d9c3438055, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetRevenues() []Revenue {
+	if m != nil {
+		return m.Revenues
+	}
+	return nil
+}
+
+// Params defines the revenue module params. The shares of the Ethereum tx fees
+// must add up to 1.
+type Params struct {
+	// enable_revenue defines a parameter to enable the fee distribution of the
+	// Ethereum tx fees
+	EnableRevenue bool `protobuf:"varint,1,opt,name=enable_revenue,json=enableRevenue,proto3" json:"enable_revenue,omitempty"`
+	// burn_shares defines the proportion of the Ethereum tx fees that is burned
+	BurnShares cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=burn_shares,json=burnShares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"burn_shares"`
+	// validator_shares defines the proportion of the Ethereum tx fees that is
+	// left to the validators in the fee collector
+	ValidatorShares cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=validator_shares,json=validatorShares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validator_shares"`
+	// developer_shares defines the proportion of the Ethereum tx fees that is
+	// sent to the withdrawer of the called contract. If the contract is not
+	// registered, this proportion is left to the validators.
+	DeveloperShares cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=developer_shares,json=developerShares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"developer_shares"`
+	// addr_derivation_cost_create defines the cost of address derivation for
+	// verifying the contract deployer at fee registration
+	AddrDerivationCostCreate uint64 `protobuf:"varint,5,opt,name=addr_derivation_cost_create,json=addrDerivationCostCreate,proto3" json:"addr_derivation_cost_create,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_649d64d9c3438055, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetEnableRevenue() bool {
+	if m != nil {
+		return m.EnableRevenue
+	}
+	return false
+}
+
+func (m *Params) GetAddrDerivationCostCreate() uint64 {
+	if m != nil {
+		return m.AddrDerivationCostCreate
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "evmos.revenue.v1.GenesisState")
+	proto.RegisterType((*Params)(nil), "evmos.revenue.v1.Params")
+}
+
+func init() { proto.RegisterFile("evmos/revenue/v1/genesis.proto", fileDescriptor_649d64d9c3438055) }
+
+var fileDescriptor_649d64d9c3438055 = []byte{
+	// 414 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x4f, 0x6b, 0x14, 0x31,
+	0x18, 0xc6, 0x37, 0x6d, 0x5d, 0xda, 0xac, 0x7f, 0xea, 0xe0, 0x61, 0x6c, 0x61, 0xba, 0x14, 0x84,
+	0xc1, 0x43, 0x42, 0xeb, 0x51, 0x04, 0xdd, 0xad, 0x48, 0x41, 0x44, 0x76, 0x6f, 0x5e, 0x86, 0xec,
+	0xcc, 0xcb, 0x6c, 0xe8, 0x66, 0xde, 0x25, 0xc9, 0x46, 0xeb, 0x77, 0x10, 0xfc, 0x18, 0x1e, 0xfd,
+	0x18, 0x3d, 0xf6, 0x28, 0x1e, 0x8a, 0xec, 0x1c, 0xfc, 0x1a, 0x32, 0xc9, 0x74, 0x14, 0x7b, 0xea,
+	0x25, 0xbc, 0xbc, 0xcf, 0xf3, 0xfc, 0x9e, 0x1c, 0x5e, 0x9a, 0x80, 0x53, 0x68, 0xb8, 0x06, 0x07,
+	0xd5, 0x0a, 0xb8, 0x3b, 0xe2, 0x25, 0x54, 0x60, 0xa4, 0x61, 0x4b, 0x8d, 0x16, 0xa3, 0x5d, 0xaf,
+	0xb3, 0x56, 0x67, 0xee, 0x68, 0xef, 0xa1, 0x50, 0xb2, 0x42, 0xee, 0xdf, 0x60, 0xda, 0xbb, 0x09,
+	0xb9, 0xf6, 0x07, 0xfd, 0x51, 0x89, 0x25, 0xfa, 0x91, 0x37, 0x53, 0xd8, 0x1e, 0x7e, 0x21, 0xf4,
+	0xee, 0x9b, 0x50, 0x36, 0xb5, 0xc2, 0x42, 0xf4, 0x9c, 0xf6, 0x97, 0x42, 0x0b, 0x65, 0x62, 0x32,
+	0x24, 0xe9, 0xe0, 0x38, 0x66, 0xff, 0x97, 0xb3, 0xf7, 0x5e, 0x1f, 0xed, 0x5c, 0x5c, 0x1d, 0xf4,
+	0xbe, 0xfd, 0xfe, 0xfe, 0x94, 0x4c, 0xda, 0x48, 0xf4, 0x92, 0x6e, 0xb7, 0x3e, 0x13, 0x6f, 0x0c,
+	0x37, 0xd3, 0xc1, 0xf1, 0xe3, 0x9b, 0xf1, 0x49, 0x18, 0xff, 0xcd, 0x77, 0xa9, 0xc3, 0x7a, 0x83,
+	0xf6, 0x03, 0x3f, 0x7a, 0x42, 0xef, 0x43, 0x25, 0x66, 0x0b, 0xc8, 0x5a, 0xd5, 0xff, 0x68, 0x7b,
+	0x72, 0x2f, 0x6c, 0x5b, 0x4c, 0x74, 0x4a, 0x07, 0xb3, 0x95, 0xae, 0x32, 0x33, 0x17, 0xda, 0xd7,
+	0x92, 0x74, 0x67, 0x94, 0x36, 0xec, 0x9f, 0x57, 0x07, 0xfb, 0x39, 0x1a, 0x85, 0xc6, 0x14, 0x67,
+	0x4c, 0x22, 0x57, 0xc2, 0xce, 0xd9, 0x5b, 0x28, 0x45, 0x7e, 0x7e, 0x02, 0x79, 0xa8, 0xa6, 0x4d,
+	0x78, 0xea, 0xb3, 0xd1, 0x94, 0xee, 0x3a, 0xb1, 0x90, 0x85, 0xb0, 0xa8, 0xaf, 0x79, 0x9b, 0xb7,
+	0xe4, 0x3d, 0xe8, 0x08, 0x7f, 0xa1, 0x05, 0x38, 0x58, 0xe0, 0x12, 0x3a, 0xe8, 0xd6, 0x6d, 0xa1,
+	0x1d, 0xa1, 0x85, 0xbe, 0xa0, 0xfb, 0xa2, 0x28, 0x74, 0x56, 0x80, 0x96, 0x4e, 0x58, 0x89, 0x55,
+	0x96, 0xa3, 0xb1, 0x59, 0xae, 0x41, 0x58, 0x88, 0xef, 0x0c, 0x49, 0xba, 0x35, 0x89, 0x1b, 0xcb,
+	0x49, 0xe7, 0x18, 0xa3, 0xb1, 0x63, 0xaf, 0x8f, 0x4e, 0x2f, 0xd6, 0x09, 0xb9, 0x5c, 0x27, 0xe4,
+	0xd7, 0x3a, 0x21, 0x5f, 0xeb, 0xa4, 0x77, 0x59, 0x27, 0xbd, 0x1f, 0x75, 0xd2, 0xfb, 0xc0, 0x4b,
+	0x69, 0xe7, 0xab, 0x19, 0xcb, 0x51, 0xf1, 0x57, 0xf2, 0x33, 0x2c, 0xde, 0x81, 0xfd, 0x88, 0xfa,
+	0x8c, 0x8f, 0xd1, 0xa8, 0xd7, 0x4e, 0xf1, 0x4f, 0xdd, 0x85, 0xd9, 0xf3, 0x25, 0x98, 0x59, 0xdf,
+	0xdf, 0xd1, 0xb3, 0x3f, 0x03, 0x00, 0xbc, 0x2d, 0x03, 0x6b, 0xc4, 0x02, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Revenues) > 0 {
+		for iNdEx := len(m.Revenues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Revenues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AddrDerivationCostCreate != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AddrDerivationCostCreate))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.DeveloperShares.Size()
+		i -= size
+		if _, err := m.DeveloperShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ValidatorShares.Size()
+		i -= size
+		if _, err := m.ValidatorShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BurnShares.Size()
+		i -= size
+		if _, err := m.BurnShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.EnableRevenue {
+		i--
+		if m.EnableRevenue {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Revenues) > 0 {
+		for _, e := range m.Revenues {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EnableRevenue {
+		n += 2
+	}
+	l = m.BurnShares.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.ValidatorShares.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.DeveloperShares.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.AddrDerivationCostCreate != 0 {
+		n += 1 + sovGenesis(uint64(m.AddrDerivationCostCreate))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revenues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revenues = append(m.Revenues, Revenue{})
+			if err := m.Revenues[len(m.Revenues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableRevenue", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableRevenue = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DeveloperShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddrDerivationCostCreate", wireType)
+			}
+			m.AddrDerivationCostCreate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddrDerivationCostCreate |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
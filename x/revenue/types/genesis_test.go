@@ -0,0 +1,38 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	deployer := sdk.AccAddress(common.HexToAddress("0x1111111111111111111111111111111111111111").Bytes())
+	revenue := NewRevenue(common.HexToAddress("0x3333333333333333333333333333333333333333"), deployer, nil)
+
+	invalidParams := DefaultParams()
+	invalidParams.BurnShares = DefaultDeveloperShares
+
+	testCases := []struct {
+		name     string
+		genState GenesisState
+		expPass  bool
+	}{
+		{"default", *DefaultGenesisState(), true},
+		{"valid - with revenues", NewGenesisState(DefaultParams(), []Revenue{revenue}), true},
+		{"invalid - duplicated revenues", NewGenesisState(DefaultParams(), []Revenue{revenue, revenue}), false},
+		{"invalid - revenue without deployer", NewGenesisState(DefaultParams(), []Revenue{{ContractAddress: revenue.ContractAddress}}), false},
+		{"invalid - params", NewGenesisState(invalidParams, []Revenue{}), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "revenue"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for revenue
+	RouterKey = ModuleName
+)
+
+// prefix bytes for the revenue persistent store
+const (
+	prefixRevenue = iota + 1
+	prefixDeployer
+	prefixParams
+)
+
+// KVStore key prefixes
+var (
+	// KeyPrefixRevenue defines prefix key for storing the revenues by contract
+	KeyPrefixRevenue = []byte{prefixRevenue}
+	// KeyPrefixDeployer defines prefix key for indexing the contracts by deployer
+	KeyPrefixDeployer = []byte{prefixDeployer}
+	// ParamsKey defines the key for storing the module params
+	ParamsKey = []byte{prefixParams}
+)
+
+// GetKeyPrefixDeployer returns the KVStore key prefix for the reverse mapping
+// of deployers and their contracts
+func GetKeyPrefixDeployer(deployerAddress sdk.AccAddress) []byte {
+	return append(KeyPrefixDeployer, deployerAddress.Bytes()...)
+}
+
+// GetKeyDeployerContract returns the KVStore key of the contract in the
+// reverse mapping of the given deployer
+func GetKeyDeployerContract(deployerAddress sdk.AccAddress, contract common.Address) []byte {
+	return append(GetKeyPrefixDeployer(deployerAddress), contract.Bytes()...)
+}
@@ -0,0 +1,142 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	aizeltypes "github.com/AizelNetwork/CosmEvm/types"
+)
+
+var (
+	_ sdk.Msg = &MsgRegisterRevenue{}
+	_ sdk.Msg = &MsgUpdateRevenue{}
+	_ sdk.Msg = &MsgCancelRevenue{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
+// MaxNonces is the maximum length of the address path that proves the deployer
+// of a contract
+const MaxNonces = 20
+
+// NewMsgRegisterRevenue creates new instance of MsgRegisterRevenue
+func NewMsgRegisterRevenue(contract string, deployer, withdrawer sdk.AccAddress, nonces []uint64) *MsgRegisterRevenue {
+	withdrawerAddress := ""
+	if !withdrawer.Empty() {
+		withdrawerAddress = withdrawer.String()
+	}
+
+	return &MsgRegisterRevenue{
+		ContractAddress:   contract,
+		DeployerAddress:   deployer.String(),
+		WithdrawerAddress: withdrawerAddress,
+		Nonces:            nonces,
+	}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRegisterRevenue) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.DeployerAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid deployer address")
+	}
+
+	if err := aizeltypes.ValidateNonZeroAddress(m.ContractAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid contract address")
+	}
+
+	if m.WithdrawerAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(m.WithdrawerAddress); err != nil {
+			return errorsmod.Wrap(err, "invalid withdrawer address")
+		}
+	}
+
+	if len(m.Nonces) < 1 {
+		return fmt.Errorf("invalid nonces - empty array")
+	}
+
+	if len(m.Nonces) > MaxNonces {
+		return fmt.Errorf("invalid nonces - array length must be at most %d, got %d", MaxNonces, len(m.Nonces))
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRegisterRevenue) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// NewMsgUpdateRevenue creates new instance of MsgUpdateRevenue
+func NewMsgUpdateRevenue(contract string, deployer, withdrawer sdk.AccAddress) *MsgUpdateRevenue {
+	return &MsgUpdateRevenue{
+		ContractAddress:   contract,
+		DeployerAddress:   deployer.String(),
+		WithdrawerAddress: withdrawer.String(),
+	}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateRevenue) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.DeployerAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid deployer address")
+	}
+
+	if err := aizeltypes.ValidateNonZeroAddress(m.ContractAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid contract address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.WithdrawerAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid withdrawer address")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateRevenue) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// NewMsgCancelRevenue creates new instance of MsgCancelRevenue.
+func NewMsgCancelRevenue(contract string, deployer sdk.AccAddress) *MsgCancelRevenue {
+	return &MsgCancelRevenue{
+		ContractAddress: contract,
+		DeployerAddress: deployer.String(),
+	}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgCancelRevenue) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.DeployerAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid deployer address")
+	}
+
+	if err := aizeltypes.ValidateNonZeroAddress(m.ContractAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid contract address")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgCancelRevenue) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	return m.Params.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
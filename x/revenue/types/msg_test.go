@@ -0,0 +1,65 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgRegisterRevenueValidateBasic(t *testing.T) {
+	deployer := sdk.AccAddress(common.HexToAddress("0x1111111111111111111111111111111111111111").Bytes())
+	withdrawer := sdk.AccAddress(common.HexToAddress("0x2222222222222222222222222222222222222222").Bytes())
+	contract := "0x3333333333333333333333333333333333333333"
+
+	testCases := []struct {
+		name    string
+		msg     *MsgRegisterRevenue
+		expPass bool
+	}{
+		{"pass - without withdrawer", NewMsgRegisterRevenue(contract, deployer, nil, []uint64{1}), true},
+		{"pass - with withdrawer", NewMsgRegisterRevenue(contract, deployer, withdrawer, []uint64{1, 2}), true},
+		{"fail - invalid deployer", &MsgRegisterRevenue{ContractAddress: contract, DeployerAddress: "invalid", Nonces: []uint64{1}}, false},
+		{"fail - zero contract", NewMsgRegisterRevenue("0x0000000000000000000000000000000000000000", deployer, nil, []uint64{1}), false},
+		{"fail - invalid withdrawer", &MsgRegisterRevenue{ContractAddress: contract, DeployerAddress: deployer.String(), WithdrawerAddress: "invalid", Nonces: []uint64{1}}, false},
+		{"fail - empty nonces", NewMsgRegisterRevenue(contract, deployer, nil, []uint64{}), false},
+		{"fail - too many nonces", NewMsgRegisterRevenue(contract, deployer, nil, make([]uint64, MaxNonces+1)), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgUpdateAndCancelRevenueValidateBasic(t *testing.T) {
+	deployer := sdk.AccAddress(common.HexToAddress("0x1111111111111111111111111111111111111111").Bytes())
+	withdrawer := sdk.AccAddress(common.HexToAddress("0x2222222222222222222222222222222222222222").Bytes())
+	contract := "0x3333333333333333333333333333333333333333"
+
+	testCases := []struct {
+		name    string
+		msg     sdk.HasValidateBasic
+		expPass bool
+	}{
+		{"pass - update", NewMsgUpdateRevenue(contract, deployer, withdrawer), true},
+		{"fail - update without withdrawer", NewMsgUpdateRevenue(contract, deployer, nil), false},
+		{"fail - update with invalid contract", NewMsgUpdateRevenue("invalid", deployer, withdrawer), false},
+		{"pass - cancel", NewMsgCancelRevenue(contract, deployer), true},
+		{"fail - cancel with invalid deployer", &MsgCancelRevenue{ContractAddress: contract, DeployerAddress: "invalid"}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
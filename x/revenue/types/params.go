@@ -0,0 +1,87 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+var (
+	DefaultEnableRevenue   = true
+	DefaultBurnShares      = math.LegacyZeroDec()
+	DefaultValidatorShares = math.LegacyNewDecWithPrec(50, 2) // 50%
+	DefaultDeveloperShares = math.LegacyNewDecWithPrec(50, 2) // 50%
+	// Cost for executing `crypto.CreateAddress` must be at least 36 gas for the
+	// contained keccak256(word) operation
+	DefaultAddrDerivationCostCreate = uint64(50)
+)
+
+// NewParams creates a new Params object
+func NewParams(
+	enableRevenue bool,
+	burnShares,
+	validatorShares,
+	developerShares math.LegacyDec,
+	addrDerivationCostCreate uint64,
+) Params {
+	return Params{
+		EnableRevenue:            enableRevenue,
+		BurnShares:               burnShares,
+		ValidatorShares:          validatorShares,
+		DeveloperShares:          developerShares,
+		AddrDerivationCostCreate: addrDerivationCostCreate,
+	}
+}
+
+// DefaultParams returns the default revenue module parameters
+func DefaultParams() Params {
+	return Params{
+		EnableRevenue:            DefaultEnableRevenue,
+		BurnShares:               DefaultBurnShares,
+		ValidatorShares:          DefaultValidatorShares,
+		DeveloperShares:          DefaultDeveloperShares,
+		AddrDerivationCostCreate: DefaultAddrDerivationCostCreate,
+	}
+}
+
+// Validate performs a stateless validation of the revenue params
+func (p Params) Validate() error {
+	if err := validateShares(p.BurnShares); err != nil {
+		return fmt.Errorf("invalid burn shares: %w", err)
+	}
+
+	if err := validateShares(p.ValidatorShares); err != nil {
+		return fmt.Errorf("invalid validator shares: %w", err)
+	}
+
+	if err := validateShares(p.DeveloperShares); err != nil {
+		return fmt.Errorf("invalid developer shares: %w", err)
+	}
+
+	totalShares := p.BurnShares.Add(p.ValidatorShares).Add(p.DeveloperShares)
+	if !totalShares.Equal(math.LegacyOneDec()) {
+		return errors.New("total shares ratio should be 1")
+	}
+
+	return nil
+}
+
+func validateShares(v math.LegacyDec) error {
+	if v.IsNil() {
+		return errors.New("shares cannot be nil")
+	}
+
+	if v.IsNegative() {
+		return errors.New("shares cannot be negative")
+	}
+
+	if v.GT(math.LegacyOneDec()) {
+		return errors.New("shares cannot be greater than 1")
+	}
+
+	return nil
+}
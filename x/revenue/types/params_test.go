@@ -0,0 +1,58 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		params   Params
+		expError bool
+	}{
+		{"default", DefaultParams(), false},
+		{
+			"valid - all shares to validators",
+			NewParams(true, math.LegacyZeroDec(), math.LegacyOneDec(), math.LegacyZeroDec(), 50),
+			false,
+		},
+		{
+			"valid - disabled",
+			NewParams(false, math.LegacyNewDecWithPrec(20, 2), math.LegacyNewDecWithPrec(30, 2), math.LegacyNewDecWithPrec(50, 2), 0),
+			false,
+		},
+		{
+			"invalid - nil shares",
+			Params{EnableRevenue: true, BurnShares: math.LegacyDec{}, ValidatorShares: math.LegacyOneDec(), DeveloperShares: math.LegacyZeroDec()},
+			true,
+		},
+		{
+			"invalid - negative shares",
+			NewParams(true, math.LegacyZeroDec(), math.LegacyNewDec(2), math.LegacyNewDec(-1), 50),
+			true,
+		},
+		{
+			"invalid - shares above one",
+			NewParams(true, math.LegacyZeroDec(), math.LegacyNewDecWithPrec(11, 1), math.LegacyZeroDec(), 50),
+			true,
+		},
+		{
+			"invalid - shares do not add up to one",
+			NewParams(true, math.LegacyZeroDec(), math.LegacyNewDecWithPrec(50, 2), math.LegacyNewDecWithPrec(40, 2), 50),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.params.Validate()
+
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
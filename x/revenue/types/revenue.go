@@ -0,0 +1,71 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	aizeltypes "github.com/AizelNetwork/CosmEvm/types"
+)
+
+// NewRevenue returns an instance of Revenue. If the provided withdrawer
+// address is empty, the fees are sent to the deployer.
+func NewRevenue(contract common.Address, deployer, withdrawer sdk.AccAddress) Revenue {
+	if withdrawer.Empty() {
+		withdrawer = deployer
+	}
+
+	return Revenue{
+		ContractAddress:   contract.String(),
+		DeployerAddress:   deployer.String(),
+		WithdrawerAddress: withdrawer.String(),
+	}
+}
+
+// GetContractAddr returns the contract address
+func (r Revenue) GetContractAddr() common.Address {
+	return common.HexToAddress(r.ContractAddress)
+}
+
+// GetDeployerAddr returns the contract deployer address
+func (r Revenue) GetDeployerAddr() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(r.DeployerAddress)
+}
+
+// GetWithdrawerAddr returns the account address to where the funds proceeding
+// from the fees will be received.
+func (r Revenue) GetWithdrawerAddr() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(r.WithdrawerAddress)
+}
+
+// Validate performs a stateless validation of a Revenue
+func (r Revenue) Validate() error {
+	if err := aizeltypes.ValidateNonZeroAddress(r.ContractAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid contract address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(r.DeployerAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid deployer address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(r.WithdrawerAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid withdrawer address")
+	}
+
+	return nil
+}
+
+// DeriveContractAddress returns the address of the contract deployed by
+// following the address path from the deployer, where each address creates
+// the next one with the given nonce.
+func DeriveContractAddress(deployer common.Address, nonces []uint64) common.Address {
+	addr := deployer
+	for _, nonce := range nonces {
+		addr = crypto.CreateAddress(addr, nonce)
+	}
+	return addr
+}
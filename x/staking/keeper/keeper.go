@@ -4,17 +4,27 @@
 package keeper
 
 import (
+	"context"
+
 	addresscodec "cosmossdk.io/core/address"
 	storetypes "cosmossdk.io/core/store"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
+// AccountKeeper extends the account keeper expected by the Cosmos SDK staking
+// module with the methods to update the vesting accounts of the delegators.
+type AccountKeeper interface {
+	types.AccountKeeper
+	SetAccount(ctx context.Context, acc sdk.AccountI)
+}
+
 // Keeper is a wrapper around the Cosmos SDK staking keeper.
 type Keeper struct {
 	*stakingkeeper.Keeper
-	ak types.AccountKeeper
+	ak AccountKeeper
 	bk types.BankKeeper
 }
 
@@ -22,7 +32,7 @@ type Keeper struct {
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeService storetypes.KVStoreService,
-	ak types.AccountKeeper,
+	ak AccountKeeper,
 	bk types.BankKeeper,
 	authority string,
 	validatorAddressCodec addresscodec.Codec,
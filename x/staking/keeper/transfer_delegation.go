@@ -0,0 +1,184 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	vestingtypes "github.com/AizelNetwork/CosmEvm/x/vesting/types"
+)
+
+const (
+	// EventTypeTransferDelegation defines the event type emitted when a delegation
+	// is transferred between two accounts.
+	EventTypeTransferDelegation = "transfer_delegation"
+
+	// AttributeKeyRecipient defines the event attribute key of the recipient of the delegation.
+	AttributeKeyRecipient = "recipient"
+)
+
+// TransferDelegation transfers the delegation of the given amount of tokens on a validator
+// from the delegator to the recipient. The bonded tokens never leave the staking pools:
+// the delegator shares are unbonded and the resulting tokens are delegated again on behalf
+// of the recipient on the same validator, without any unbonding period.
+// It returns the shares received by the recipient.
+//
+// Similarly to the delegation check performed in validateDelegationAmountNotUnvested,
+// a clawback vesting account cannot transfer the part of its delegations that
+// corresponds to locked vested coins. As no coins are moved, the delegation
+// trackers of a vesting delegator are updated as on an undelegation. Transfers
+// to vesting accounts are rejected, as the received delegation cannot be tracked
+// as delegated vesting nor delegated free coins without unlocking their coins.
+func (k Keeper) TransferDelegation(
+	goCtx context.Context,
+	delAddr, recipientAddr sdk.AccAddress,
+	valAddr sdk.ValAddress,
+	amount math.Int,
+) (math.LegacyDec, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !amount.IsPositive() {
+		return math.LegacyDec{}, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid delegation transfer amount: %s", amount)
+	}
+
+	if delAddr.Equals(recipientAddr) {
+		return math.LegacyDec{}, errorsmod.Wrap(errortypes.ErrInvalidRequest, "delegator and recipient cannot be the same")
+	}
+
+	if err := k.validateDelegationTransferNotLocked(ctx, delAddr, amount); err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	if _, isVesting := k.ak.GetAccount(ctx, recipientAddr).(vestexported.VestingAccount); isVesting {
+		return math.LegacyDec{}, errorsmod.Wrapf(
+			errortypes.ErrInvalidRequest,
+			"cannot transfer a delegation to the vesting account %s", recipientAddr,
+		)
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, amount)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	returnAmount, err := k.Unbond(ctx, delAddr, valAddr, shares)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	if !returnAmount.IsPositive() {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrTinyRedelegationAmount, "%s", amount)
+	}
+
+	if err := k.trackDelegationTransfer(ctx, delAddr, returnAmount); err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	// fetch the validator again to get the updated tokens and shares
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	// the tokens remain on the pool that corresponds to the validator status,
+	// so no coins are moved between the pools or the accounts
+	newShares, err := k.Delegate(ctx, recipientAddr, returnAmount, validator.GetStatus(), validator, false)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeTransferDelegation,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(AttributeKeyRecipient, recipientAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, returnAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyNewShares, newShares.String()),
+		),
+	)
+
+	return newShares, nil
+}
+
+// validateDelegationTransferNotLocked checks if the delegator is a clawback vesting account.
+// In such case, checks that the transferred amount does not include the delegated
+// coins that are still locked according to the current lockup schedule.
+func (k Keeper) validateDelegationTransferNotLocked(ctx sdk.Context, delAddr sdk.AccAddress, amount math.Int) error {
+	acc := k.ak.GetAccount(ctx, delAddr)
+	if acc == nil {
+		return errorsmod.Wrapf(errortypes.ErrUnknownAddress, "account %s does not exist", delAddr)
+	}
+
+	// check if delegator address is a clawback vesting account. If not, no check
+	// is required, unless it is another type of vesting account, whose delegated
+	// vesting coins cannot be told apart from the vested ones.
+	clawbackAccount, isClawback := acc.(*vestingtypes.ClawbackVestingAccount)
+	if !isClawback {
+		if _, isVesting := acc.(vestexported.VestingAccount); isVesting {
+			return errorsmod.Wrapf(
+				errortypes.ErrInvalidRequest,
+				"cannot transfer a delegation from the vesting account %s", delAddr,
+			)
+		}
+		return nil
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	delegated, err := k.GetDelegatorBonded(ctx, delAddr)
+	if err != nil {
+		return err
+	}
+
+	// As long as there are locked vested coins (or unvested coins, when unvested
+	// staking is enabled), the delegated coins are considered to be locked coins
+	// (see ClawbackVestingAccount.GetLockedDelegatedCoins), which cannot be transferred.
+	lockedDelegated := clawbackAccount.GetLockedDelegatedCoins(ctx.BlockTime()).AmountOf(bondDenom)
+
+	transferableAmt := delegated.Sub(lockedDelegated)
+	if transferableAmt.IsNegative() {
+		transferableAmt = math.ZeroInt()
+	}
+
+	if transferableAmt.LT(amount) {
+		return errorsmod.Wrapf(
+			vestingtypes.ErrInsufficientVestedCoins,
+			"cannot transfer delegation of locked coins. delegated coins available for transfer < transfer amount (%s < %s)",
+			transferableAmt, amount,
+		)
+	}
+	return nil
+}
+
+// trackDelegationTransfer updates the delegation trackers of the delegator if
+// it is a vesting account, in the same way the bank keeper does when the coins
+// of an undelegation are returned to the account. Otherwise, the delegated free
+// and vesting coins would still include the transferred delegation and reduce
+// the locked coins of the account.
+func (k Keeper) trackDelegationTransfer(ctx sdk.Context, delAddr sdk.AccAddress, amount math.Int) error {
+	vestingAcc, isVesting := k.ak.GetAccount(ctx, delAddr).(vestexported.VestingAccount)
+	if !isVesting {
+		return nil
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	vestingAcc.TrackUndelegation(sdk.NewCoins(sdk.NewCoin(bondDenom, amount)))
+	k.ak.SetAccount(ctx, vestingAcc)
+	return nil
+}
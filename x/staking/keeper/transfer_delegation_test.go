@@ -0,0 +1,219 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/AizelNetwork/CosmEvm/testutil"
+	"github.com/AizelNetwork/CosmEvm/testutil/integration/aizel/network"
+	utiltx "github.com/AizelNetwork/CosmEvm/testutil/tx"
+	aizeltypes "github.com/AizelNetwork/CosmEvm/types"
+	"github.com/AizelNetwork/CosmEvm/x/staking/keeper"
+	vestingtypes "github.com/AizelNetwork/CosmEvm/x/vesting/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransferDelegation(t *testing.T) {
+	var (
+		ctx              sdk.Context
+		nw               *network.UnitTestNetwork
+		defaultDelCoin   = sdk.NewCoin(aizeltypes.BaseDenom, math.NewInt(1e18))
+		delegatorAddr, _ = utiltx.NewAccAddressAndKey()
+		recipientAddr, _ = utiltx.NewAccAddressAndKey()
+		funderAddr, _    = utiltx.NewAccAddressAndKey()
+	)
+
+	// delegate delegates the given coin from the delegator to the first validator
+	delegate := func(coin sdk.Coin) {
+		srv := keeper.NewMsgServerImpl(&nw.App.StakingKeeper)
+		_, err := srv.Delegate(ctx, &types.MsgDelegate{
+			DelegatorAddress: delegatorAddr.String(),
+			ValidatorAddress: nw.GetValidators()[0].OperatorAddress,
+			Amount:           coin,
+		})
+		require.NoError(t, err)
+	}
+
+	testCases := []struct {
+		name      string
+		setup     func() math.Int
+		recipient func() sdk.AccAddress
+		expErr    bool
+		errMsg    string
+	}{
+		{
+			name: "can transfer a delegation from a common account",
+			setup: func() math.Int {
+				err := testutil.FundAccountWithBaseDenom(ctx, nw.App.BankKeeper, delegatorAddr, defaultDelCoin.Amount.Int64())
+				require.NoError(t, err)
+				delegate(defaultDelCoin)
+				return defaultDelCoin.Amount.QuoRaw(2)
+			},
+			recipient: func() sdk.AccAddress { return recipientAddr },
+			expErr:    false,
+		},
+		{
+			name: "cannot transfer a delegation with zero amount",
+			setup: func() math.Int {
+				err := testutil.FundAccountWithBaseDenom(ctx, nw.App.BankKeeper, delegatorAddr, defaultDelCoin.Amount.Int64())
+				require.NoError(t, err)
+				delegate(defaultDelCoin)
+				return math.ZeroInt()
+			},
+			recipient: func() sdk.AccAddress { return recipientAddr },
+			expErr:    true,
+			errMsg:    "invalid delegation transfer amount",
+		},
+		{
+			name: "cannot transfer a delegation to the delegator itself",
+			setup: func() math.Int {
+				err := testutil.FundAccountWithBaseDenom(ctx, nw.App.BankKeeper, delegatorAddr, defaultDelCoin.Amount.Int64())
+				require.NoError(t, err)
+				delegate(defaultDelCoin)
+				return defaultDelCoin.Amount
+			},
+			recipient: func() sdk.AccAddress { return delegatorAddr },
+			expErr:    true,
+			errMsg:    "delegator and recipient cannot be the same",
+		},
+		{
+			name: "cannot transfer more than the delegated amount",
+			setup: func() math.Int {
+				err := testutil.FundAccountWithBaseDenom(ctx, nw.App.BankKeeper, delegatorAddr, defaultDelCoin.Amount.Int64())
+				require.NoError(t, err)
+				delegate(defaultDelCoin)
+				return defaultDelCoin.Amount.MulRaw(2)
+			},
+			recipient: func() sdk.AccAddress { return recipientAddr },
+			expErr:    true,
+			errMsg:    "invalid shares amount",
+		},
+		{
+			name: "can transfer a delegation of free coins from a ClawbackVestingAccount",
+			setup: func() math.Int {
+				err := setupClawbackVestingAccount(ctx, nw, delegatorAddr, funderAddr, testutil.TestVestingSchedule.TotalVestingCoins.Add(defaultDelCoin))
+				require.NoError(t, err)
+				delegate(defaultDelCoin)
+				return defaultDelCoin.Amount
+			},
+			recipient: func() sdk.AccAddress { return recipientAddr },
+			expErr:    false,
+		},
+		{
+			name: "cannot transfer a delegation of locked vested coins from a ClawbackVestingAccount",
+			setup: func() math.Int {
+				err := setupClawbackVestingAccount(ctx, nw, delegatorAddr, funderAddr, testutil.TestVestingSchedule.TotalVestingCoins)
+				require.NoError(t, err)
+
+				// after first vesting period and before lockup
+				// some vested tokens, but still all locked
+				cliffDuration := time.Duration(testutil.TestVestingSchedule.CliffPeriodLength)
+				ctx = ctx.WithBlockTime(ctx.BlockTime().Add(cliffDuration * time.Second))
+
+				acc := nw.App.AccountKeeper.GetAccount(ctx, delegatorAddr)
+				vestAcc, ok := acc.(*vestingtypes.ClawbackVestingAccount)
+				require.True(t, ok)
+
+				// delegate the locked vested coins
+				lockedVested := vestAcc.GetLockedUpVestedCoins(ctx.BlockTime())
+				require.True(t, lockedVested.IsAllGT(sdk.NewCoins()))
+				delegate(lockedVested[0])
+				return lockedVested[0].Amount
+			},
+			recipient: func() sdk.AccAddress { return recipientAddr },
+			expErr:    true,
+			errMsg:    "cannot transfer delegation of locked coins",
+		},
+		{
+			name: "cannot transfer a delegation to a ClawbackVestingAccount",
+			setup: func() math.Int {
+				err := testutil.FundAccountWithBaseDenom(ctx, nw.App.BankKeeper, delegatorAddr, defaultDelCoin.Amount.Int64())
+				require.NoError(t, err)
+				delegate(defaultDelCoin)
+
+				err = setupClawbackVestingAccount(ctx, nw, recipientAddr, funderAddr, testutil.TestVestingSchedule.TotalVestingCoins)
+				require.NoError(t, err)
+				return defaultDelCoin.Amount
+			},
+			recipient: func() sdk.AccAddress { return recipientAddr },
+			expErr:    true,
+			errMsg:    "cannot transfer a delegation to the vesting account",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			nw = network.NewUnitTestNetwork()
+			ctx = nw.GetContext()
+			amount := tc.setup()
+			recipient := tc.recipient()
+
+			valAddr, err := sdk.ValAddressFromBech32(nw.GetValidators()[0].OperatorAddress)
+			require.NoError(t, err)
+
+			newShares, err := nw.App.StakingKeeper.TransferDelegation(ctx, delegatorAddr, recipient, valAddr, amount)
+
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.True(t, newShares.IsPositive())
+
+			delegation, err := nw.App.StakingKeeper.GetDelegation(ctx, recipient, valAddr)
+			require.NoError(t, err)
+			require.Equal(t, newShares, delegation.Shares)
+		})
+	}
+}
+
+func TestTransferDelegationVestingTrackers(t *testing.T) {
+	var (
+		delCoin          = sdk.NewCoin(aizeltypes.BaseDenom, math.NewInt(1e18))
+		delegatorAddr, _ = utiltx.NewAccAddressAndKey()
+		recipientAddr, _ = utiltx.NewAccAddressAndKey()
+		funderAddr, _    = utiltx.NewAccAddressAndKey()
+	)
+
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+
+	err := setupClawbackVestingAccount(ctx, nw, delegatorAddr, funderAddr, testutil.TestVestingSchedule.TotalVestingCoins.Add(delCoin))
+	require.NoError(t, err)
+
+	valAddr, err := sdk.ValAddressFromBech32(nw.GetValidators()[0].OperatorAddress)
+	require.NoError(t, err)
+
+	// delegate the free coins, so that only the locked vesting coins remain
+	srv := keeper.NewMsgServerImpl(&nw.App.StakingKeeper)
+	_, err = srv.Delegate(ctx, &types.MsgDelegate{
+		DelegatorAddress: delegatorAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Amount:           delCoin,
+	})
+	require.NoError(t, err)
+
+	spendable := nw.App.BankKeeper.SpendableCoins(ctx, delegatorAddr)
+	require.True(t, spendable.IsZero(), "expected no spendable coins before the transfer, got %s", spendable)
+
+	_, err = nw.App.StakingKeeper.TransferDelegation(ctx, delegatorAddr, recipientAddr, valAddr, delCoin.Amount)
+	require.NoError(t, err)
+
+	acc := nw.App.AccountKeeper.GetAccount(ctx, delegatorAddr)
+	vestAcc, ok := acc.(*vestingtypes.ClawbackVestingAccount)
+	require.True(t, ok)
+	require.True(t, vestAcc.DelegatedFree.IsZero(), "expected no delegated free coins, got %s", vestAcc.DelegatedFree)
+
+	// the locked vesting coins must not become spendable after the transfer
+	spendable = nw.App.BankKeeper.SpendableCoins(ctx, delegatorAddr)
+	require.True(t, spendable.IsZero(), "expected no spendable coins after the transfer, got %s", spendable)
+}
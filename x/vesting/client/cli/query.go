@@ -26,6 +26,10 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetBalancesCmd(),
+		GetParamsCmd(),
+		GetUnlockScheduleCmd(),
+		GetNextUnlockCmd(),
+		GetUnvestedSupplyCmd(),
 	)
 	return cmd
 }
@@ -62,3 +66,129 @@ func GetBalancesCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetParamsCmd queries the vesting module params
+func GetParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Gets the vesting params, including the vesting templates",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetUnlockScheduleCmd queries the vesting and unlocking timeline of a vesting account.
+func GetUnlockScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unlock-schedule ADDRESS",
+		Short: "Gets the vesting and unlocking events of a vesting account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryUnlockScheduleRequest{
+				Address: args[0],
+			}
+
+			res, err := queryClient.UnlockSchedule(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetNextUnlockCmd queries the next time at which tokens of a vesting account become spendable.
+func GetNextUnlockCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "next-unlock ADDRESS",
+		Short: "Gets the next time at which tokens of a vesting account become spendable",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryNextUnlockRequest{
+				Address: args[0],
+			}
+
+			res, err := queryClient.NextUnlock(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetUnvestedSupplyCmd queries the aggregate unvested and locked tokens of a page of vesting accounts.
+func GetUnvestedSupplyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unvested-supply",
+		Short: "Gets the aggregate unvested and locked tokens of a page of vesting accounts",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryUnvestedSupplyRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.UnvestedSupply(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "unvested supply")
+	return cmd
+}
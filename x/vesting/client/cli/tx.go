@@ -14,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 
 	"github.com/AizelNetwork/CosmEvm/x/vesting/types"
@@ -26,6 +27,7 @@ const (
 	FlagVesting  = "vesting"
 	FlagClawback = "clawback"
 	FlagFunder   = "funder"
+	FlagStart    = "start-time"
 )
 
 // NewTxCmd returns a root CLI command handler for vesting
@@ -42,9 +44,11 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		NewMsgCreateClawbackVestingAccountCmd(),
 		NewMsgFundVestingAccountCmd(),
+		NewMsgFundVestingAccountWithTemplateCmd(),
 		NewMsgClawbackCmd(),
 		NewMsgUpdateVestingFunderCmd(),
 		NewMsgConvertVestingAccountCmd(),
+		NewMsgSetUnvestedStakingCmd(),
 	)
 
 	return txCmd
@@ -163,6 +167,50 @@ with a start time and an array of coins strings and durations relative to the st
 	return cmd
 }
 
+// NewMsgFundVestingAccountWithTemplateCmd returns a CLI command handler for funding a
+// clawback vesting account using a vesting template.
+func NewMsgFundVestingAccountWithTemplateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fund-vesting-account-with-template TO_ADDRESS TEMPLATE AMOUNT",
+		Short: "Fund a vesting account with an allocation of tokens using a vesting template.",
+		Long: `Fund a vesting account according to the schedule of one of the vesting templates defined
+in the module params. The amount is split evenly across the vesting periods of the template.
+The vesting starts at the time given by --start-time (unix timestamp) or at the current time if omitted.`,
+		Example: fmt.Sprintf("%s tx %s fund-vesting-account-with-template <address> four-year-one-year-cliff 1000aaizel --from=<key_or_address>", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			toAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			startTime := time.Now().UTC()
+			start, _ := cmd.Flags().GetInt64(FlagStart)
+			if start != 0 {
+				startTime = time.Unix(start, 0).UTC()
+			}
+
+			msg := types.NewMsgFundVestingAccountWithTemplate(clientCtx.GetFromAddress(), toAddr, startTime, args[1], amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Int64(FlagStart, 0, "unix timestamp at which the vesting starts")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // NewMsgClawbackCmd returns a CLI command handler for clawing back unvested funds.
 func NewMsgClawbackCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -238,6 +286,42 @@ func NewMsgUpdateVestingFunderCmd() *cobra.Command {
 	return cmd
 }
 
+// NewMsgSetUnvestedStakingCmd returns a CLI command handler for enabling or
+// disabling the delegation of unvested coins of a clawback vesting account.
+func NewMsgSetUnvestedStakingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-unvested-staking VESTING_ACCOUNT_ADDRESS ENABLED",
+		Short: "Enable or disable the delegation of unvested coins of a ClawbackVestingAccount.",
+		Long: `Must be requested by the original funder address (--from).
+		Delegated unvested coins remain subject to clawback. Unvested staking cannot be disabled
+		while unvested coins are delegated.`,
+		Example: fmt.Sprintf("%s tx %s set-unvested-staking <vesting_address> true --from=<funder>", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			vestingAcc, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			enabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetUnvestedStaking(clientCtx.GetFromAddress(), vestingAcc, enabled)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // NewMsgConvertVestingAccountCmd returns a CLI command handler for converting
 // a clawback vesting account into a non-vesting account.
 func NewMsgConvertVestingAccountCmd() *cobra.Command {
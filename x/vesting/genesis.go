@@ -0,0 +1,32 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package vesting
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/AizelNetwork/CosmEvm/x/vesting/keeper"
+	"github.com/AizelNetwork/CosmEvm/x/vesting/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis import module genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	data types.GenesisState,
+) {
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(errorsmod.Wrapf(err, "error setting params"))
+	}
+
+	// index the clawback vesting accounts of the auth genesis
+	k.IndexClawbackVestingAccounts(ctx)
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Params: k.GetParams(ctx),
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AizelNetwork/CosmEvm/x/vesting/types"
+)
+
+// SetClawbackVestingAccount indexes the given address as a clawback vesting
+// account.
+func (k Keeper) SetClawbackVestingAccount(ctx sdk.Context, addr sdk.AccAddress) {
+	k.clawbackVestingAccountStore(ctx).Set(addr.Bytes(), []byte{})
+}
+
+// DeleteClawbackVestingAccount removes the given address from the index of
+// clawback vesting accounts.
+func (k Keeper) DeleteClawbackVestingAccount(ctx sdk.Context, addr sdk.AccAddress) {
+	k.clawbackVestingAccountStore(ctx).Delete(addr.Bytes())
+}
+
+// IndexClawbackVestingAccounts iterates over all the accounts and indexes the
+// clawback vesting accounts. It is only meant to be used on genesis and store
+// migrations, since it walks the whole account store.
+func (k Keeper) IndexClawbackVestingAccounts(ctx sdk.Context) {
+	k.accountKeeper.IterateAccounts(ctx, func(account sdk.AccountI) bool {
+		if _, ok := account.(*types.ClawbackVestingAccount); ok {
+			k.SetClawbackVestingAccount(ctx, account.GetAddress())
+		}
+
+		return false
+	})
+}
+
+// clawbackVestingAccountStore returns the prefix store of the index of clawback
+// vesting accounts.
+func (k Keeper) clawbackVestingAccountStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixClawbackVestingAccount)
+}
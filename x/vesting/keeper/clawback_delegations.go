@@ -0,0 +1,197 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"math"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/AizelNetwork/CosmEvm/x/vesting/types"
+)
+
+// clawbackDelegatedUnvested claws back the portion of the bond denom clawback
+// amount that is not held in the account balance, because it has been
+// delegated, from the delegations of the account. It returns the coins that
+// still need to be transferred from the account balance.
+//
+// NOTE: the unvested coins held in the balance are the unvested coins that are
+// not tracked as delegated vesting coins. The rest of the balance consists of
+// vested coins, which must not be clawed back.
+func (k Keeper) clawbackDelegatedUnvested(
+	ctx sdk.Context,
+	vestingAccount types.ClawbackVestingAccount,
+	destinationAddr sdk.AccAddress,
+	toClawBack sdk.Coins,
+	toCommunityPool bool,
+) (sdk.Coins, error) {
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	address := vestingAccount.GetAddress()
+	unvested := toClawBack.AmountOf(bondDenom)
+	unvestedInBalance := sdkmath.MaxInt(unvested.Sub(vestingAccount.DelegatedVesting.AmountOf(bondDenom)), sdkmath.ZeroInt())
+	unvestedInBalance = sdkmath.MinInt(unvestedInBalance, k.bankKeeper.GetBalance(ctx, address, bondDenom).Amount)
+
+	shortfall := unvested.Sub(unvestedInBalance)
+	if !shortfall.IsPositive() {
+		return toClawBack, nil
+	}
+
+	clawedBack, err := k.clawbackDelegations(ctx, address, destinationAddr, shortfall, toCommunityPool)
+	if err != nil {
+		return nil, err
+	}
+
+	return toClawBack.Sub(sdk.NewCoin(bondDenom, clawedBack)), nil
+}
+
+// clawbackDelegations claws back up to the given amount of bond denom tokens
+// from the delegations of the given address. Bonded delegations are unbonded
+// instantly and, if the amount is not covered by them, the balances of the
+// unbonding delegation entries are reduced, removing the entries that are fully
+// clawed back. The tokens are transferred
+// directly from the staking pools to the destination address. It returns the
+// amount that was clawed back.
+//
+// NOTE: this is only required for accounts that have unvested staking enabled,
+// since otherwise unvested coins cannot be delegated and the clawback amount is
+// always covered by the account balance.
+func (k Keeper) clawbackDelegations(
+	ctx sdk.Context,
+	delegator, destinationAddr sdk.AccAddress,
+	amount sdkmath.Int,
+	toCommunityPool bool,
+) (sdkmath.Int, error) {
+	clawedBack := sdkmath.ZeroInt()
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return clawedBack, err
+	}
+
+	delegations, err := k.stakingKeeper.GetDelegatorDelegations(ctx, delegator, math.MaxUint16)
+	if err != nil {
+		return clawedBack, err
+	}
+
+	for _, delegation := range delegations {
+		remaining := amount.Sub(clawedBack)
+		if !remaining.IsPositive() {
+			return clawedBack, nil
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(delegation.ValidatorAddress)
+		if err != nil {
+			return clawedBack, err
+		}
+
+		validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+		if err != nil {
+			return clawedBack, err
+		}
+
+		delegated := validator.TokensFromShares(delegation.Shares).TruncateInt()
+		toUnbond := sdkmath.MinInt(delegated, remaining)
+		if !toUnbond.IsPositive() {
+			continue
+		}
+
+		shares, err := k.stakingKeeper.ValidateUnbondAmount(ctx, delegator, valAddr, toUnbond)
+		if err != nil {
+			return clawedBack, err
+		}
+
+		unbonded, err := k.stakingKeeper.Unbond(ctx, delegator, valAddr, shares)
+		if err != nil {
+			return clawedBack, err
+		}
+
+		pool := stakingtypes.NotBondedPoolName
+		if validator.IsBonded() {
+			pool = stakingtypes.BondedPoolName
+		}
+
+		coins := sdk.NewCoins(sdk.NewCoin(bondDenom, unbonded))
+		if err := k.sendFromPool(ctx, pool, destinationAddr, coins, toCommunityPool); err != nil {
+			return clawedBack, err
+		}
+
+		clawedBack = clawedBack.Add(unbonded)
+	}
+
+	unbondingDelegations, err := k.stakingKeeper.GetUnbondingDelegations(ctx, delegator, math.MaxUint16)
+	if err != nil {
+		return clawedBack, err
+	}
+
+	for _, ubd := range unbondingDelegations {
+		taken := sdkmath.ZeroInt()
+		for i := 0; i < len(ubd.Entries); i++ {
+			remaining := amount.Sub(clawedBack).Sub(taken)
+			if !remaining.IsPositive() {
+				break
+			}
+
+			entry := ubd.Entries[i]
+			toTake := sdkmath.MinInt(entry.Balance, remaining)
+			taken = taken.Add(toTake)
+
+			if toTake.LT(entry.Balance) {
+				ubd.Entries[i].Balance = entry.Balance.Sub(toTake)
+				ubd.Entries[i].InitialBalance = sdkmath.MaxInt(entry.InitialBalance.Sub(toTake), sdkmath.ZeroInt())
+				continue
+			}
+
+			// remove the entries that are fully clawed back
+			ubd.RemoveEntry(int64(i))
+			i--
+			if err := k.stakingKeeper.DeleteUnbondingIndex(ctx, entry.UnbondingId); err != nil {
+				return clawedBack, err
+			}
+		}
+
+		if !taken.IsPositive() {
+			continue
+		}
+
+		if len(ubd.Entries) == 0 {
+			err = k.stakingKeeper.RemoveUnbondingDelegation(ctx, ubd)
+		} else {
+			err = k.stakingKeeper.SetUnbondingDelegation(ctx, ubd)
+		}
+		if err != nil {
+			return clawedBack, err
+		}
+
+		coins := sdk.NewCoins(sdk.NewCoin(bondDenom, taken))
+		if err := k.sendFromPool(ctx, stakingtypes.NotBondedPoolName, destinationAddr, coins, toCommunityPool); err != nil {
+			return clawedBack, err
+		}
+
+		clawedBack = clawedBack.Add(taken)
+	}
+
+	return clawedBack, nil
+}
+
+// sendFromPool transfers the given coins from the staking pool to the
+// destination address or, if specified, to the community pool.
+func (k Keeper) sendFromPool(
+	ctx sdk.Context,
+	pool string,
+	destinationAddr sdk.AccAddress,
+	coins sdk.Coins,
+	toCommunityPool bool,
+) error {
+	if toCommunityPool {
+		return k.distributionKeeper.FundCommunityPool(ctx, coins, k.accountKeeper.GetModuleAddress(pool))
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, pool, destinationAddr, coins)
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -25,17 +26,9 @@ func (k Keeper) Balances(
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	addr, err := sdk.AccAddressFromBech32(req.Address)
+	clawbackAccount, err := k.getClawbackAccountByAddress(goCtx, req.Address)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
-	}
-
-	clawbackAccount, err := k.GetClawbackVestingAccount(goCtx, addr)
-	if err != nil {
-		return nil, status.Errorf(
-			codes.InvalidArgument,
-			"account at address '%s' either does not exist or is not a vesting account ", addr.String(),
-		)
+		return nil, err
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -49,3 +42,119 @@ func (k Keeper) Balances(
 		Vested:   vested,
 	}, nil
 }
+
+// Params returns the vesting module params
+func (k Keeper) Params(
+	goCtx context.Context,
+	_ *types.QueryParamsRequest,
+) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// UnlockSchedule returns the vesting and unlocking events of a clawback
+// vesting account
+func (k Keeper) UnlockSchedule(
+	goCtx context.Context,
+	req *types.QueryUnlockScheduleRequest,
+) (*types.QueryUnlockScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	clawbackAccount, err := k.getClawbackAccountByAddress(goCtx, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryUnlockScheduleResponse{
+		Events: clawbackAccount.GetScheduleEvents(),
+	}, nil
+}
+
+// NextUnlock returns the next time at which tokens of a clawback vesting
+// account become spendable and the amount of tokens that become spendable
+func (k Keeper) NextUnlock(
+	goCtx context.Context,
+	req *types.QueryNextUnlockRequest,
+) (*types.QueryNextUnlockResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	clawbackAccount, err := k.getClawbackAccountByAddress(goCtx, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, event := range clawbackAccount.GetScheduleEvents() {
+		if !event.Time.After(ctx.BlockTime()) || event.Spendable.IsZero() {
+			continue
+		}
+
+		return &types.QueryNextUnlockResponse{
+			Time:   event.Time,
+			Amount: event.Spendable,
+		}, nil
+	}
+
+	return nil, status.Errorf(
+		codes.NotFound,
+		"no pending unlocks for vesting account '%s'", req.Address,
+	)
+}
+
+// UnvestedSupply returns the aggregate amount of unvested and locked tokens of
+// a page of the clawback vesting accounts
+func (k Keeper) UnvestedSupply(
+	goCtx context.Context,
+	req *types.QueryUnvestedSupplyRequest,
+) (*types.QueryUnvestedSupplyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	unvested, locked := sdk.NewCoins(), sdk.NewCoins()
+	pageRes, err := query.Paginate(k.clawbackVestingAccountStore(ctx), req.Pagination, func(key, _ []byte) error {
+		vestingAcc, err := k.GetClawbackVestingAccount(ctx, sdk.AccAddress(key))
+		if err != nil {
+			return err
+		}
+
+		unvested = unvested.Add(vestingAcc.GetVestingCoins(ctx.BlockTime())...)
+		locked = locked.Add(vestingAcc.GetLockedUpCoins(ctx.BlockTime())...)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryUnvestedSupplyResponse{
+		Unvested:   unvested,
+		Locked:     locked,
+		Pagination: pageRes,
+	}, nil
+}
+
+// getClawbackAccountByAddress returns the clawback vesting account for the
+// given bech32 address or a gRPC error if it does not exist.
+func (k Keeper) getClawbackAccountByAddress(goCtx context.Context, address string) (*types.ClawbackVestingAccount, error) {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	clawbackAccount, err := k.GetClawbackVestingAccount(goCtx, addr)
+	if err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"account at address '%s' either does not exist or is not a vesting account ", addr.String(),
+		)
+	}
+
+	return clawbackAccount, nil
+}
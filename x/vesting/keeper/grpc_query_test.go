@@ -3,13 +3,16 @@ package keeper_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/AizelNetwork/CosmEvm/testutil"
 	"github.com/AizelNetwork/CosmEvm/testutil/integration/aizel/network"
+	utiltx "github.com/AizelNetwork/CosmEvm/testutil/tx"
 	"github.com/AizelNetwork/CosmEvm/x/vesting/types"
 )
 
@@ -140,3 +143,131 @@ func TestBalances(t *testing.T) {
 		})
 	}
 }
+
+// setupFundedVestingAccount creates a clawback vesting account at the vesting
+// address and funds it with the default lockup and vesting schedules, starting
+// at the given time.
+func setupFundedVestingAccount(t *testing.T, nw *network.UnitTestNetwork, startTime time.Time) {
+	ctx := nw.GetContext()
+
+	// fund the vesting account with coins to initialize it and
+	// then send all balances to the funding account
+	err := testutil.FundAccount(ctx, nw.App.BankKeeper, vestingAddr, balances)
+	require.NoError(t, err, "error while funding the target account")
+	err = nw.App.BankKeeper.SendCoins(ctx, vestingAddr, funder, balances)
+	require.NoError(t, err, "error while sending coins to the funder account")
+
+	msg := types.NewMsgCreateClawbackVestingAccount(funder, vestingAddr, false)
+	_, err = nw.App.VestingKeeper.CreateClawbackVestingAccount(ctx, msg)
+	require.NoError(t, err, "error while creating the vesting account")
+
+	msgFund := types.NewMsgFundVestingAccount(funder, vestingAddr, startTime, lockupPeriods, vestingPeriods)
+	_, err = nw.App.VestingKeeper.FundVestingAccount(ctx, msgFund)
+	require.NoError(t, err, "error while funding the vesting account")
+}
+
+func TestParams(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	qc := nw.GetVestingClient()
+
+	res, err := qc.Params(nw.GetContext(), &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams(), res.Params)
+}
+
+func TestUnlockSchedule(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+
+	_, err := nw.App.VestingKeeper.UnlockSchedule(nw.GetContext(), &types.QueryUnlockScheduleRequest{Address: vestingAddr.String()})
+	require.ErrorContains(t, err, "either does not exist or is not a vesting account")
+
+	startTime := nw.GetContext().BlockTime().Truncate(time.Second).UTC()
+	setupFundedVestingAccount(t, nw, startTime)
+
+	qc := nw.GetVestingClient()
+	res, err := qc.UnlockSchedule(nw.GetContext(), &types.QueryUnlockScheduleRequest{Address: vestingAddr.String()})
+	require.NoError(t, err)
+
+	// vesting events at 2000, 4000, 6000 and 8000 seconds and the unlock event at 5000 seconds
+	expTimes := []int64{2000, 4000, 5000, 6000, 8000}
+	expSpendable := []sdk.Coins{
+		sdk.NewCoins(),
+		sdk.NewCoins(),
+		quarter.Add(quarter...),
+		quarter,
+		quarter,
+	}
+	require.Len(t, res.Events, len(expTimes))
+	for i, event := range res.Events {
+		require.Equal(t, startTime.Add(time.Duration(expTimes[i])*time.Second), event.Time)
+		require.True(t, expSpendable[i].Equal(event.Spendable), "event %d: expected %s, got %s", i, expSpendable[i], event.Spendable)
+	}
+}
+
+func TestNextUnlock(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+
+	startTime := nw.GetContext().BlockTime().Truncate(time.Second).UTC()
+	setupFundedVestingAccount(t, nw, startTime)
+
+	qc := nw.GetVestingClient()
+
+	res, err := qc.NextUnlock(nw.GetContext(), &types.QueryNextUnlockRequest{Address: vestingAddr.String()})
+	require.NoError(t, err)
+	require.Equal(t, startTime.Add(5000*time.Second), res.Time)
+	require.Equal(t, quarter.Add(quarter...), res.Amount)
+
+	// after the last unlock there are no pending unlocks
+	ctx := nw.GetContext().WithBlockTime(startTime.Add(8000 * time.Second))
+	_, err = nw.App.VestingKeeper.NextUnlock(ctx, &types.QueryNextUnlockRequest{Address: vestingAddr.String()})
+	require.ErrorContains(t, err, "no pending unlocks")
+}
+
+func TestUnvestedSupply(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+
+	res, err := nw.App.VestingKeeper.UnvestedSupply(nw.GetContext(), &types.QueryUnvestedSupplyRequest{})
+	require.NoError(t, err)
+	require.True(t, res.Unvested.IsZero())
+	require.True(t, res.Locked.IsZero())
+
+	startTime := nw.GetContext().BlockTime()
+	setupFundedVestingAccount(t, nw, startTime)
+
+	qc := nw.GetVestingClient()
+	res, err = qc.UnvestedSupply(nw.GetContext(), &types.QueryUnvestedSupplyRequest{})
+	require.NoError(t, err)
+	require.Equal(t, balances, res.Unvested)
+	require.Equal(t, balances, res.Locked)
+
+	// the supply is paginated over the clawback vesting accounts
+	firstVestingAddr := vestingAddr
+	vestingAddr = sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	setupFundedVestingAccount(t, nw, startTime)
+
+	pageReq := &query.PageRequest{Limit: 1, CountTotal: true}
+	res, err = qc.UnvestedSupply(nw.GetContext(), &types.QueryUnvestedSupplyRequest{Pagination: pageReq})
+	require.NoError(t, err)
+	require.Equal(t, balances, res.Unvested)
+	require.Equal(t, uint64(2), res.Pagination.Total)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	res, err = qc.UnvestedSupply(nw.GetContext(), &types.QueryUnvestedSupplyRequest{})
+	require.NoError(t, err)
+	require.Equal(t, balances.Add(balances...), res.Unvested)
+
+	// the converted accounts are removed from the index
+	ctx := nw.GetContext().WithBlockTime(startTime.Add(8000 * time.Second))
+	_, err = nw.App.VestingKeeper.ConvertVestingAccount(ctx, types.NewMsgConvertVestingAccount(firstVestingAddr))
+	require.NoError(t, err)
+
+	res, err = nw.App.VestingKeeper.UnvestedSupply(ctx, &types.QueryUnvestedSupplyRequest{Pagination: pageReq})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Pagination.Total)
+
+	// after the vesting period has concluded, nothing is unvested or locked
+	res, err = nw.App.VestingKeeper.UnvestedSupply(ctx, &types.QueryUnvestedSupplyRequest{})
+	require.NoError(t, err)
+	require.True(t, res.Unvested.IsZero())
+	require.True(t, res.Locked.IsZero())
+}
@@ -5,6 +5,7 @@ package keeper
 import (
 	v2 "github.com/AizelNetwork/CosmEvm/x/vesting/migrations/v2"
 	v3 "github.com/AizelNetwork/CosmEvm/x/vesting/migrations/v3"
+	v4 "github.com/AizelNetwork/CosmEvm/x/vesting/migrations/v4"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 )
@@ -12,6 +13,7 @@ import (
 var (
 	_ module.MigrationHandler = Migrator{}.Migrate1to2
 	_ module.MigrationHandler = Migrator{}.Migrate2to3
+	_ module.MigrationHandler = Migrator{}.Migrate3to4
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -35,3 +37,8 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 	return v3.MigrateStore(ctx, m.keeper.accountKeeper)
 }
+
+// Migrate3to4 migrates the store from consensus version 3 to 4
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.MigrateStore(ctx, m.keeper)
+}
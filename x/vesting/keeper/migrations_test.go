@@ -139,3 +139,28 @@ func TestMigrate2to3(t *testing.T) {
 		})
 	}
 }
+
+func TestMigrate3to4(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+
+	// clear the params set on genesis
+	err := nw.App.VestingKeeper.SetParams(ctx, vestingtypes.Params{})
+	require.NoError(t, err)
+
+	// clawback vesting account created before the accounts were indexed
+	vestingAddr = types.AccAddress(testutiltx.GenerateAddress().Bytes())
+	setupFundedVestingAccount(t, nw, ctx.BlockTime())
+	nw.App.VestingKeeper.DeleteClawbackVestingAccount(ctx, vestingAddr)
+
+	// migrate
+	migrator := keeper.NewMigrator(nw.App.VestingKeeper)
+	err = migrator.Migrate3to4(ctx)
+	require.NoError(t, err, "migration failed")
+
+	require.Equal(t, vestingtypes.DefaultParams(), nw.App.VestingKeeper.GetParams(ctx))
+
+	res, err := nw.App.VestingKeeper.UnvestedSupply(ctx, &vestingtypes.QueryUnvestedSupplyRequest{})
+	require.NoError(t, err)
+	require.Equal(t, balances, res.Unvested)
+}
@@ -5,6 +5,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/AizelNetwork/CosmEvm/utils"
@@ -15,6 +16,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 var _ types.MsgServer = &Keeper{}
@@ -79,6 +81,7 @@ func (k Keeper) CreateClawbackVestingAccount(
 		FunderAddress:      funderAddress.String(),
 	}
 	ak.SetAccount(ctx, vestingAcc)
+	k.SetClawbackVestingAccount(ctx, vestingAcc.GetAddress())
 
 	if !msg.EnableGovClawback {
 		k.SetGovClawbackDisabled(ctx, vestingAcc.GetAddress())
@@ -377,10 +380,104 @@ func (k Keeper) ConvertVestingAccount(
 
 	baseAcc := vestingAcc.BaseAccount
 	k.accountKeeper.SetAccount(ctx, baseAcc)
+	k.DeleteClawbackVestingAccount(ctx, address)
 
 	return &types.MsgConvertVestingAccountResponse{}, nil
 }
 
+// FundVestingAccountWithTemplate funds a ClawbackVestingAccount with the
+// provided amount according to the schedule of a vesting template. The lockup
+// and vesting periods are derived from the template and the account is funded
+// as with FundVestingAccount.
+func (k Keeper) FundVestingAccountWithTemplate(
+	goCtx context.Context,
+	msg *types.MsgFundVestingAccountWithTemplate,
+) (*types.MsgFundVestingAccountWithTemplateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	template, found := k.GetParams(ctx).GetTemplate(msg.Template)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrVestingTemplateNotFound, "template %q", msg.Template)
+	}
+
+	lockupPeriods, vestingPeriods := template.Schedule(msg.Amount)
+	fundMsg := &types.MsgFundVestingAccount{
+		FunderAddress:  msg.FunderAddress,
+		VestingAddress: msg.VestingAddress,
+		StartTime:      msg.StartTime,
+		LockupPeriods:  lockupPeriods,
+		VestingPeriods: vestingPeriods,
+	}
+
+	if _, err := k.FundVestingAccount(ctx, fundMsg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFundVestingAccountWithTemplateResponse{}, nil
+}
+
+// UpdateParams defines a method for updating vesting params
+func (k Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, errorsmod.Wrapf(err, "error setting params")
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// SetUnvestedStaking enables or disables the delegation of unvested coins for a
+// ClawbackVestingAccount. Only the funder of the account can update this setting.
+// The delegated unvested coins remain subject to clawback.
+//
+// Checks performed on the ValidateBasic include:
+//   - funder and vesting addresses are correct bech32 format
+func (k Keeper) SetUnvestedStaking(
+	goCtx context.Context,
+	msg *types.MsgSetUnvestedStaking,
+) (*types.MsgSetUnvestedStakingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// NOTE: errors checked during msg validation
+	vestingAccAddr := sdk.MustAccAddressFromBech32(msg.VestingAddress)
+
+	va, err := k.GetClawbackVestingAccount(ctx, vestingAccAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if va.FunderAddress != msg.FunderAddress {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized, "unvested staking can only be updated by original funder: %s", va.FunderAddress)
+	}
+
+	// unvested staking cannot be disabled while unvested coins are delegated
+	if !msg.Enabled && !va.DelegatedVesting.IsZero() {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest,
+			"cannot disable unvested staking while unvested coins are delegated: %s", va.DelegatedVesting,
+		)
+	}
+
+	va.UnvestedStakingEnabled = msg.Enabled
+	k.accountKeeper.SetAccount(ctx, va)
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeSetUnvestedStaking,
+				sdk.NewAttribute(types.AttributeKeyFunder, msg.FunderAddress),
+				sdk.NewAttribute(types.AttributeKeyAccount, msg.VestingAddress),
+				sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(msg.Enabled)),
+			),
+		},
+	)
+
+	return &types.MsgSetUnvestedStakingResponse{}, nil
+}
+
 // transferClawback transfers unvested tokens in a ClawbackVestingAccount to
 // the destination address. Then, it updates the lockup schedule, removes future
 // vesting events and deletes the store entry for governance clawback if it exists.
@@ -407,11 +504,30 @@ func (k Keeper) transferClawback(
 	// if gov clawback is disabled, remove the entry from the store.
 	// if no entry is found for the address, this will no-op
 	k.DeleteGovClawbackDisabled(ctx, address)
+	k.DeleteClawbackVestingAccount(ctx, address)
+
+	toCommunityPool := destinationAddr.String() == authtypes.NewModuleAddress(distributiontypes.ModuleName).String()
+
+	// If unvested staking is enabled, part of the unvested coins can be delegated.
+	// In that case, the portion that is not held in the account balance is
+	// clawed back from the delegations.
+	toClawBackFromBalance := toClawBack
+	if vestingAccount.UnvestedStakingEnabled {
+		var err error
+		toClawBackFromBalance, err = k.clawbackDelegatedUnvested(ctx, vestingAccount, destinationAddr, toClawBack, toCommunityPool)
+		if err != nil {
+			return nil, err
+		}
+
+		if toClawBackFromBalance.IsZero() {
+			return toClawBack, nil
+		}
+	}
 
 	// In case destination is community pool (e.g. Gov Clawback)
 	// call the corresponding function
-	if destinationAddr.String() == authtypes.NewModuleAddress(distributiontypes.ModuleName).String() {
-		return toClawBack, k.distributionKeeper.FundCommunityPool(ctx, toClawBack, address)
+	if toCommunityPool {
+		return toClawBack, k.distributionKeeper.FundCommunityPool(ctx, toClawBackFromBalance, address)
 	}
 
 	// NOTE: don't use `SpendableCoins` to get the minimum value to clawback since
@@ -420,5 +536,5 @@ func (k Keeper) transferClawback(
 	// different denoms (because of store iteration).
 
 	// Transfer clawback to the destination (funder)
-	return toClawBack, k.bankKeeper.SendCoins(ctx, address, destinationAddr, toClawBack)
+	return toClawBack, k.bankKeeper.SendCoins(ctx, address, destinationAddr, toClawBackFromBalance)
 }
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
@@ -17,10 +18,12 @@ import (
 	aizeltypes "github.com/AizelNetwork/CosmEvm/types"
 	"github.com/AizelNetwork/CosmEvm/utils"
 	evmtypes "github.com/AizelNetwork/CosmEvm/x/evm/types"
+	aizelstakingkeeper "github.com/AizelNetwork/CosmEvm/x/staking/keeper"
 	"github.com/AizelNetwork/CosmEvm/x/vesting/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -289,6 +292,90 @@ func TestMsgFundVestingAccountSpecialCases(t *testing.T) {
 	})
 }
 
+func TestMsgFundVestingAccountWithTemplate(t *testing.T) {
+	oneYearMonthly := types.DefaultParams().Templates[1]
+
+	testCases := []struct {
+		name        string
+		template    string
+		expPass     bool
+		errContains string
+	}{
+		{
+			name:     "pass - default template",
+			template: oneYearMonthly.Name,
+			expPass:  true,
+		},
+		{
+			name:        "fail - template not found",
+			template:    "unknown",
+			expPass:     false,
+			errContains: "vesting template not found",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("Case %s", tc.name), func(t *testing.T) {
+			nw := network.NewUnitTestNetwork()
+			ctx := nw.GetContext()
+
+			// fund the recipient account to set the account and then
+			// send funds over to the funder account so balance is empty
+			err := testutil.FundAccount(ctx, nw.App.BankKeeper, vestingAddr, balances)
+			require.NoError(t, err, "failed to fund target account")
+			err = nw.App.BankKeeper.SendCoins(ctx, vestingAddr, funder, balances)
+			require.NoError(t, err, "failed to send tokens to funder account")
+
+			msgCreate := types.NewMsgCreateClawbackVestingAccount(funder, vestingAddr, false)
+			_, err = nw.App.VestingKeeper.CreateClawbackVestingAccount(ctx, msgCreate)
+			require.NoError(t, err, "failed to create clawback vesting account")
+
+			startTime := time.Now()
+			msg := types.NewMsgFundVestingAccountWithTemplate(funder, vestingAddr, startTime, tc.template, balances)
+			res, err := nw.App.VestingKeeper.FundVestingAccountWithTemplate(ctx, msg)
+
+			if tc.expPass {
+				require.NoError(t, err, tc.name)
+				require.Equal(t, &types.MsgFundVestingAccountWithTemplateResponse{}, res)
+
+				vestAcc, err := nw.App.VestingKeeper.GetClawbackVestingAccount(ctx, vestingAddr)
+				require.NoError(t, err)
+
+				expLockup, expVesting := oneYearMonthly.Schedule(balances)
+				require.Equal(t, expVesting, vestAcc.VestingPeriods)
+				require.Len(t, vestAcc.VestingPeriods, int(oneYearMonthly.NumPeriods))
+				// no lockup in the template defaults to an instant unlock schedule
+				require.Empty(t, expLockup)
+				require.Equal(t, sdkvesting.Periods{{Length: 0, Amount: balances}}, vestAcc.LockupPeriods)
+				require.Equal(t, startTime.Unix(), vestAcc.GetStartTime())
+				require.Equal(t, balances, vestAcc.OriginalVesting)
+			} else {
+				require.Error(t, err, tc.name)
+				require.ErrorContains(t, err, tc.errContains)
+			}
+		})
+	}
+}
+
+func TestMsgUpdateParams(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	params := types.NewParams(types.NewVestingTemplate("two-year-quarterly", 0, 7889400, 8, 0))
+
+	_, err := nw.App.VestingKeeper.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: funder.String(),
+		Params:    params,
+	})
+	require.ErrorContains(t, err, "invalid authority")
+
+	_, err = nw.App.VestingKeeper.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	})
+	require.NoError(t, err)
+	require.Equal(t, params, nw.App.VestingKeeper.GetParams(ctx))
+}
+
 func TestMsgCreateClawbackVestingAccount(t *testing.T) {
 	var (
 		ctx     sdk.Context
@@ -619,6 +706,208 @@ func TestMsgClawback(t *testing.T) {
 	}
 }
 
+// setupUnvestedStakingAccount creates and funds a clawback vesting account whose
+// coins are all unvested at the current block time.
+func setupUnvestedStakingAccount(t *testing.T, nw *network.UnitTestNetwork) {
+	ctx := nw.GetContext()
+
+	err := testutil.FundAccount(ctx, nw.App.BankKeeper, vestingAddr, balances)
+	require.NoError(t, err, "failed to fund target account")
+	err = nw.App.BankKeeper.SendCoins(ctx, vestingAddr, funder, balances)
+	require.NoError(t, err, "failed to send coins to funder account")
+
+	createMsg := types.NewMsgCreateClawbackVestingAccount(funder, vestingAddr, false)
+	_, err = nw.App.VestingKeeper.CreateClawbackVestingAccount(ctx, createMsg)
+	require.NoError(t, err, "failed to create clawback vesting account")
+
+	fundMsg := types.NewMsgFundVestingAccount(funder, vestingAddr, ctx.BlockTime(), lockupPeriods, vestingPeriods)
+	_, err = nw.App.VestingKeeper.FundVestingAccount(ctx, fundMsg)
+	require.NoError(t, err, "failed to fund clawback vesting account")
+}
+
+func TestMsgSetUnvestedStaking(t *testing.T) {
+	delegateAmt := sdk.NewInt64Coin(baseDenom, 600)
+
+	testCases := []struct {
+		name        string
+		malleate    func(nw *network.UnitTestNetwork)
+		funder      sdk.AccAddress
+		enabled     bool
+		expPass     bool
+		errContains string
+	}{
+		{
+			name:        "fail - wrong funder",
+			malleate:    func(*network.UnitTestNetwork) {},
+			funder:      addr3,
+			enabled:     true,
+			expPass:     false,
+			errContains: "unvested staking can only be updated by original funder",
+		},
+		{
+			name: "fail - disable while unvested coins are delegated",
+			malleate: func(nw *network.UnitTestNetwork) {
+				ctx := nw.GetContext()
+				_, err := nw.App.VestingKeeper.SetUnvestedStaking(ctx, types.NewMsgSetUnvestedStaking(funder, vestingAddr, true))
+				require.NoError(t, err)
+
+				msgDelegate := stakingtypes.NewMsgDelegate(vestingAddr.String(), nw.GetValidators()[0].OperatorAddress, delegateAmt)
+				_, err = aizelstakingkeeper.NewMsgServerImpl(&nw.App.StakingKeeper).Delegate(ctx, msgDelegate)
+				require.NoError(t, err)
+			},
+			funder:      funder,
+			enabled:     false,
+			expPass:     false,
+			errContains: "cannot disable unvested staking while unvested coins are delegated",
+		},
+		{
+			name:     "pass - enable",
+			malleate: func(*network.UnitTestNetwork) {},
+			funder:   funder,
+			enabled:  true,
+			expPass:  true,
+		},
+		{
+			name: "pass - disable without delegated unvested coins",
+			malleate: func(nw *network.UnitTestNetwork) {
+				_, err := nw.App.VestingKeeper.SetUnvestedStaking(nw.GetContext(), types.NewMsgSetUnvestedStaking(funder, vestingAddr, true))
+				require.NoError(t, err)
+			},
+			funder:  funder,
+			enabled: false,
+			expPass: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("Case %s", tc.name), func(t *testing.T) {
+			nw := network.NewUnitTestNetwork()
+			vestingAddr = sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+			setupUnvestedStakingAccount(t, nw)
+
+			tc.malleate(nw)
+
+			ctx := nw.GetContext()
+			msg := types.NewMsgSetUnvestedStaking(tc.funder, vestingAddr, tc.enabled)
+			res, err := nw.App.VestingKeeper.SetUnvestedStaking(ctx, msg)
+
+			if tc.expPass {
+				require.NoError(t, err)
+				require.Equal(t, &types.MsgSetUnvestedStakingResponse{}, res)
+
+				vestAcc, err := nw.App.VestingKeeper.GetClawbackVestingAccount(ctx, vestingAddr)
+				require.NoError(t, err)
+				require.Equal(t, tc.enabled, vestAcc.UnvestedStakingEnabled)
+
+				// unvested coins can only be delegated if enabled
+				msgDelegate := stakingtypes.NewMsgDelegate(vestingAddr.String(), nw.GetValidators()[0].OperatorAddress, delegateAmt)
+				_, err = aizelstakingkeeper.NewMsgServerImpl(&nw.App.StakingKeeper).Delegate(ctx, msgDelegate)
+				if tc.enabled {
+					require.NoError(t, err)
+
+					vestAcc, err = nw.App.VestingKeeper.GetClawbackVestingAccount(ctx, vestingAddr)
+					require.NoError(t, err)
+					require.Equal(t, sdk.NewCoins(delegateAmt), vestAcc.DelegatedVesting)
+					// delegated unvested coins are still locked
+					require.Equal(t, balances.Sub(delegateAmt), vestAcc.LockedCoins(ctx.BlockTime()))
+				} else {
+					require.ErrorContains(t, err, "cannot delegate unvested coins")
+				}
+			} else {
+				require.Error(t, err)
+				require.ErrorContains(t, err, tc.errContains)
+				require.Nil(t, res)
+			}
+		})
+	}
+}
+
+func TestMsgClawbackUnvestedStaking(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+	vestingAddr = sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	setupUnvestedStakingAccount(t, nw)
+
+	_, err := nw.App.VestingKeeper.SetUnvestedStaking(ctx, types.NewMsgSetUnvestedStaking(funder, vestingAddr, true))
+	require.NoError(t, err)
+
+	// delegate part of the unvested coins
+	delegateAmt := sdk.NewInt64Coin(baseDenom, 600)
+	valAddr := nw.GetValidators()[0].OperatorAddress
+	msgDelegate := stakingtypes.NewMsgDelegate(vestingAddr.String(), valAddr, delegateAmt)
+	_, err = aizelstakingkeeper.NewMsgServerImpl(&nw.App.StakingKeeper).Delegate(ctx, msgDelegate)
+	require.NoError(t, err)
+
+	// undelegate part of the delegation to have an unbonding delegation
+	undelegateAmt := sdk.NewInt64Coin(baseDenom, 100)
+	msgUndelegate := stakingtypes.NewMsgUndelegate(vestingAddr.String(), valAddr, undelegateAmt)
+	_, err = aizelstakingkeeper.NewMsgServerImpl(&nw.App.StakingKeeper).Undelegate(ctx, msgUndelegate)
+	require.NoError(t, err)
+
+	res, err := nw.App.VestingKeeper.Clawback(ctx, types.NewMsgClawback(funder, vestingAddr, nil))
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgClawbackResponse{Coins: balances}, res, "expected full balances to be clawed back")
+
+	require.Equal(t, sdk.NewInt64Coin(baseDenom, 0), nw.App.BankKeeper.GetBalance(ctx, vestingAddr, baseDenom))
+	require.Equal(t, balances[0], nw.App.BankKeeper.GetBalance(ctx, funder, baseDenom))
+
+	delegated, err := nw.App.StakingKeeper.GetDelegatorBonded(ctx, vestingAddr)
+	require.NoError(t, err)
+	require.True(t, delegated.IsZero(), "expected delegations to be clawed back")
+
+	unbonding, err := nw.App.StakingKeeper.GetDelegatorUnbonding(ctx, vestingAddr)
+	require.NoError(t, err)
+	require.True(t, unbonding.IsZero(), "expected unbonding delegations to be clawed back")
+}
+
+func TestMsgClawbackUnvestedStakingKeepsVested(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	vestingAddr = sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	setupUnvestedStakingAccount(t, nw)
+
+	// the first vesting period has passed
+	ctx := nw.GetContext()
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(2000 * time.Second))
+	_, err := nw.App.VestingKeeper.SetUnvestedStaking(ctx, types.NewMsgSetUnvestedStaking(funder, vestingAddr, true))
+	require.NoError(t, err)
+
+	// delegate all the unvested coins and part of the vested coins, so that
+	// only vested coins are left in the balance
+	valAddr := nw.GetValidators()[0].OperatorAddress
+	msgServer := aizelstakingkeeper.NewMsgServerImpl(&nw.App.StakingKeeper)
+	_, err = msgServer.Delegate(ctx, stakingtypes.NewMsgDelegate(vestingAddr.String(), valAddr, sdk.NewInt64Coin(baseDenom, 850)))
+	require.NoError(t, err)
+
+	vestAcc, err := nw.App.VestingKeeper.GetClawbackVestingAccount(ctx, vestingAddr)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 750)), vestAcc.DelegatedVesting)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 100)), vestAcc.DelegatedFree)
+
+	undelegateAmt := sdk.NewInt64Coin(baseDenom, 300)
+	_, err = msgServer.Undelegate(ctx, stakingtypes.NewMsgUndelegate(vestingAddr.String(), valAddr, undelegateAmt))
+	require.NoError(t, err)
+
+	unvested := sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 750))
+	res, err := nw.App.VestingKeeper.Clawback(ctx, types.NewMsgClawback(funder, vestingAddr, nil))
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgClawbackResponse{Coins: unvested}, res)
+
+	// the vested coins in the balance are not clawed back
+	require.Equal(t, sdk.NewInt64Coin(baseDenom, 150), nw.App.BankKeeper.GetBalance(ctx, vestingAddr, baseDenom))
+	require.Equal(t, unvested[0], nw.App.BankKeeper.GetBalance(ctx, funder, baseDenom))
+
+	delegated, err := nw.App.StakingKeeper.GetDelegatorBonded(ctx, vestingAddr)
+	require.NoError(t, err)
+	require.True(t, delegated.IsZero(), "expected delegations to be clawed back")
+
+	// the rest of the vested coins are kept in the unbonding delegation
+	ubds, err := nw.App.StakingKeeper.GetUnbondingDelegations(ctx, vestingAddr, 10)
+	require.NoError(t, err)
+	require.Len(t, ubds, 1)
+	require.Len(t, ubds[0].Entries, 1)
+	require.Equal(t, math.NewInt(100), ubds[0].Entries[0].Balance)
+	require.Equal(t, math.NewInt(100), ubds[0].Entries[0].InitialBalance)
+}
+
 func TestMsgUpdateVestingFunder(t *testing.T) {
 	var (
 		ctx sdk.Context
@@ -0,0 +1,31 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+package v4
+
+import (
+	vestingtypes "github.com/AizelNetwork/CosmEvm/x/vesting/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VestingKeeper defines the expected keeper methods to store the module params
+// and to index the clawback vesting accounts.
+type VestingKeeper interface {
+	SetParams(ctx sdk.Context, params vestingtypes.Params) error
+	IndexClawbackVestingAccounts(ctx sdk.Context)
+}
+
+// MigrateStore migrates the x/vesting module state from the consensus version 3 to
+// version 4.
+// Specifically, it sets the default vesting params, which contain the default
+// vesting schedule templates, and indexes the existing clawback vesting accounts.
+func MigrateStore(
+	ctx sdk.Context,
+	k VestingKeeper,
+) error {
+	if err := k.SetParams(ctx, vestingtypes.DefaultParams()); err != nil {
+		return err
+	}
+
+	k.IndexClawbackVestingAccounts(ctx)
+	return nil
+}
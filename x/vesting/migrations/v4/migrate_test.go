@@ -0,0 +1,3 @@
+package v4
+
+// NOTE: Migrations are tested in keeper package.
@@ -5,6 +5,7 @@ package types
 
 import (
 	"errors"
+	"sort"
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
@@ -82,16 +83,12 @@ func (va ClawbackVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins
 //
 // lockedCoins = totalAmt - unlockedVested
 func (va ClawbackVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
-	// Can delegate lockedUpVested coins and this will reduce the bank balance
-	// of the account. As long as there're lockedUpVested coins, we'll consider
-	// the delegated tokens as lockedUpVested tokens
-	// min(lockedUpVested, DelegatedFree)
-	//
-	// Consider that the "DelegatedFree" coins tracked on delegations refer to vested tokens.
-	// These "free" (vested) tokens can be locked up or unlocked
-	lockedUpVestedDelegatedCoins := va.DelegatedFree.Min(va.GetLockedUpVestedCoins(blockTime))
+	// Can delegate lockedUpVested coins (and unvested coins if enabled) and this
+	// will reduce the bank balance of the account. These delegated coins are not
+	// part of the bank balance, so they are subtracted from the locked coins.
+	lockedDelegatedCoins := va.GetLockedDelegatedCoins(blockTime)
 
-	res, isNeg := va.OriginalVesting.SafeSub(va.GetUnlockedVestedCoins(blockTime).Add(lockedUpVestedDelegatedCoins...)...)
+	res, isNeg := va.OriginalVesting.SafeSub(va.GetUnlockedVestedCoins(blockTime).Add(lockedDelegatedCoins...)...)
 
 	// safety check
 	if isNeg {
@@ -101,12 +98,38 @@ func (va ClawbackVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
 	return res
 }
 
+// GetLockedDelegatedCoins returns the delegated coins that are considered to be
+// locked up vested coins or, if unvested staking is enabled, unvested coins.
+func (va ClawbackVestingAccount) GetLockedDelegatedCoins(blockTime time.Time) sdk.Coins {
+	// As long as there're lockedUpVested coins, we'll consider
+	// the delegated tokens as lockedUpVested tokens
+	// min(lockedUpVested, DelegatedFree)
+	//
+	// Consider that the "DelegatedFree" coins tracked on delegations refer to vested tokens.
+	// These "free" (vested) tokens can be locked up or unlocked
+	if !va.UnvestedStakingEnabled {
+		return va.DelegatedFree.Min(va.GetLockedUpVestedCoins(blockTime))
+	}
+
+	// When unvested staking is enabled, the delegated tokens are considered
+	// unvested or lockedUpVested tokens first
+	// min(lockedUpVested + unvested, DelegatedFree + DelegatedVesting)
+	delegated := va.DelegatedFree.Add(va.DelegatedVesting...)
+	return delegated.Min(va.GetLockedUpVestedCoins(blockTime).Add(va.GetVestingCoins(blockTime)...))
+}
+
 // TrackDelegation tracks a desired delegation amount by setting the appropriate
 // values for the amount of delegated free coins.
 // The 'balance' input parameter is the delegator account balance.
 // The 'amount' input parameter are the delegated coins
-// Note that unvested coins cannot be delegated
-func (va *ClawbackVestingAccount) TrackDelegation(_ time.Time, balance, amount sdk.Coins) {
+// Note that unvested coins can only be delegated if unvested staking is enabled,
+// in which case they are tracked as delegated vesting coins.
+func (va *ClawbackVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
+	if va.UnvestedStakingEnabled {
+		va.BaseVestingAccount.TrackDelegation(balance, va.GetVestingCoins(blockTime), amount)
+		return
+	}
+
 	// Can only delegate vested (free) coins
 	for _, coin := range amount {
 		baseAmt := balance.AmountOf(coin.Denom)
@@ -277,3 +300,53 @@ func (va *ClawbackVestingAccount) AddGrant(
 
 	return nil
 }
+
+// GetScheduleEvents returns the vesting and unlocking events of the account
+// ordered by time. Each event contains the coins that vest, unlock and become
+// spendable (i.e. vested and unlocked) at the time of the event.
+func (va ClawbackVestingAccount) GetScheduleEvents() []ScheduleEvent {
+	vestingSteps := scheduleSteps(va.GetStartTime(), va.VestingPeriods)
+	lockupSteps := scheduleSteps(va.GetStartTime(), va.LockupPeriods)
+
+	times := make([]int64, 0, len(vestingSteps)+len(lockupSteps))
+	for t := range vestingSteps {
+		times = append(times, t)
+	}
+	for t := range lockupSteps {
+		if _, ok := vestingSteps[t]; !ok {
+			times = append(times, t)
+		}
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i] < times[j] })
+
+	vested, unlocked, spendable := sdk.NewCoins(), sdk.NewCoins(), sdk.NewCoins()
+	events := make([]ScheduleEvent, 0, len(times))
+	for _, t := range times {
+		vested = vested.Add(vestingSteps[t]...)
+		unlocked = unlocked.Add(lockupSteps[t]...)
+		newSpendable := vested.Min(unlocked)
+
+		events = append(events, ScheduleEvent{
+			Time:      time.Unix(t, 0).UTC(),
+			Vested:    sdk.NewCoins(vestingSteps[t]...),
+			Unlocked:  sdk.NewCoins(lockupSteps[t]...),
+			Spendable: newSpendable.Sub(spendable...),
+		})
+		spendable = newSpendable
+	}
+
+	return events
+}
+
+// scheduleSteps returns the coins of each step of a schedule indexed by the
+// absolute time of the step.
+func scheduleSteps(startTime int64, periods sdkvesting.Periods) map[int64]sdk.Coins {
+	steps := make(map[int64]sdk.Coins, len(periods))
+	elapsedTime := startTime
+	for _, period := range periods {
+		elapsedTime += period.Length
+		steps[elapsedTime] = steps[elapsedTime].Add(period.Amount...)
+	}
+
+	return steps
+}
@@ -465,6 +465,27 @@ func (suite *VestingAccountTestSuite) TestTrackDelegationUndelegation() {
 	}
 }
 
+func (suite *VestingAccountTestSuite) TestTrackDelegationUnvestedStaking() {
+	now := cmttime.Now()
+	delegation := sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 50))
+
+	addr := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	va := types.NewClawbackVestingAccount(bacc, sdk.AccAddress([]byte("funder")), origCoins, now, lockupPeriods, vestingPeriods)
+
+	va.UnvestedStakingEnabled = true
+	va.TrackDelegation(now, origCoins, delegation)
+	suite.Require().Equal(delegation, va.DelegatedVesting)
+	suite.Require().Empty(va.DelegatedFree)
+	// delegated unvested coins are considered locked
+	suite.Require().Equal(delegation, va.GetLockedDelegatedCoins(now))
+	suite.Require().Equal(origCoins.Sub(delegation...), va.LockedCoins(now))
+
+	va.TrackUndelegation(delegation)
+	suite.Require().Empty(va.DelegatedVesting)
+	suite.Require().Empty(va.GetLockedDelegatedCoins(now))
+}
+
 func (suite *VestingAccountTestSuite) TestComputeClawback() {
 	fee := func(x int64) sdk.Coin { return sdk.NewInt64Coin(feeDenom, x) }
 	stake := func(x int64) sdk.Coin { return sdk.NewInt64Coin(stakeDenom, x) }
@@ -538,6 +559,44 @@ func (suite *VestingAccountTestSuite) TestComputeClawback() {
 	}
 }
 
+func (suite *VestingAccountTestSuite) TestGetScheduleEvents() {
+	fee := func(x int64) sdk.Coin { return sdk.NewInt64Coin(feeDenom, x) }
+	stake := func(x int64) sdk.Coin { return sdk.NewInt64Coin(stakeDenom, x) }
+	now := time.Unix(cmttime.Now().Unix(), 0).UTC()
+	lockupPeriods := sdkvesting.Periods{
+		{Length: int64(12 * 3600), Amount: sdk.NewCoins(fee(1000), stake(100))}, // noon
+	}
+	vestingPeriods := sdkvesting.Periods{
+		{Length: int64(8 * 3600), Amount: sdk.NewCoins(fee(200))},            // 8am
+		{Length: int64(1 * 3600), Amount: sdk.NewCoins(fee(200), stake(50))}, // 9am
+		{Length: int64(6 * 3600), Amount: sdk.NewCoins(fee(200), stake(50))}, // 3pm
+		{Length: int64(2 * 3600), Amount: sdk.NewCoins(fee(200))},            // 5pm
+		{Length: int64(1 * 3600), Amount: sdk.NewCoins(fee(200))},            // 6pm
+	}
+
+	addr := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	va := types.NewClawbackVestingAccount(bacc, sdk.AccAddress([]byte("funder")), origCoins, now, lockupPeriods, vestingPeriods)
+
+	expEvents := []types.ScheduleEvent{
+		{Time: now.Add(8 * time.Hour), Vested: sdk.NewCoins(fee(200)), Unlocked: sdk.NewCoins(), Spendable: sdk.NewCoins()},
+		{Time: now.Add(9 * time.Hour), Vested: sdk.NewCoins(fee(200), stake(50)), Unlocked: sdk.NewCoins(), Spendable: sdk.NewCoins()},
+		{Time: now.Add(12 * time.Hour), Vested: sdk.NewCoins(), Unlocked: sdk.NewCoins(fee(1000), stake(100)), Spendable: sdk.NewCoins(fee(400), stake(50))},
+		{Time: now.Add(15 * time.Hour), Vested: sdk.NewCoins(fee(200), stake(50)), Unlocked: sdk.NewCoins(), Spendable: sdk.NewCoins(fee(200), stake(50))},
+		{Time: now.Add(17 * time.Hour), Vested: sdk.NewCoins(fee(200)), Unlocked: sdk.NewCoins(), Spendable: sdk.NewCoins(fee(200))},
+		{Time: now.Add(18 * time.Hour), Vested: sdk.NewCoins(fee(200)), Unlocked: sdk.NewCoins(), Spendable: sdk.NewCoins(fee(200))},
+	}
+
+	events := va.GetScheduleEvents()
+	suite.Require().Len(events, len(expEvents))
+	for i, expEvent := range expEvents {
+		suite.Require().Equal(expEvent.Time, events[i].Time, "event %d", i)
+		suite.Require().True(expEvent.Vested.Equal(events[i].Vested), "event %d: expected vested %s, got %s", i, expEvent.Vested, events[i].Vested)
+		suite.Require().True(expEvent.Unlocked.Equal(events[i].Unlocked), "event %d: expected unlocked %s, got %s", i, expEvent.Unlocked, events[i].Unlocked)
+		suite.Require().True(expEvent.Spendable.Equal(events[i].Spendable), "event %d: expected spendable %s, got %s", i, expEvent.Spendable, events[i].Spendable)
+	}
+}
+
 // getPercentOfVestingCoins is a helper function to calculate
 // the specified percentage of the coins in the vesting schedule
 func getPercentOfVestingCoins(percentage int64) sdk.Coins {
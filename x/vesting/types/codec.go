@@ -26,11 +26,14 @@ var (
 
 const (
 	// Amino names
-	clawback                     = "aizel/MsgClawback"
-	createClawbackVestingAccount = "aizel/MsgCreateClawbackVestingAccount"
-	updateVestingFunder          = "aizel/MsgUpdateVestingFunder"
-	convertVestingAccount        = "aizel/MsgConvertVestingAccount"
-	fundVestingAccount           = "aizel/MsgFundVestingAccount"
+	clawback                       = "aizel/MsgClawback"
+	createClawbackVestingAccount   = "aizel/MsgCreateClawbackVestingAccount"
+	updateVestingFunder            = "aizel/MsgUpdateVestingFunder"
+	convertVestingAccount          = "aizel/MsgConvertVestingAccount"
+	fundVestingAccount             = "aizel/MsgFundVestingAccount"
+	fundVestingAccountWithTemplate = "aizel/MsgFundVestingAccountWithTemplate"
+	updateParams                   = "aizel/x/vesting/MsgUpdateParams"
+	setUnvestedStaking             = "aizel/MsgSetUnvestedStaking"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -71,6 +74,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgUpdateVestingFunder{},
 		&MsgFundVestingAccount{},
 		&MsgConvertVestingAccount{},
+		&MsgFundVestingAccountWithTemplate{},
+		&MsgUpdateParams{},
+		&MsgSetUnvestedStaking{},
 	)
 
 	registry.RegisterImplementations(
@@ -90,4 +96,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateVestingFunder{}, updateVestingFunder, nil)
 	cdc.RegisterConcrete(&MsgConvertVestingAccount{}, convertVestingAccount, nil)
 	cdc.RegisterConcrete(&MsgFundVestingAccount{}, fundVestingAccount, nil)
+	cdc.RegisterConcrete(&MsgFundVestingAccountWithTemplate{}, fundVestingAccountWithTemplate, nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParams, nil)
+	cdc.RegisterConcrete(&MsgSetUnvestedStaking{}, setUnvestedStaking, nil)
 }
@@ -9,6 +9,7 @@ const (
 	EventTypeFundVestingAccount           = "fund_vesting_account"
 	EventTypeClawback                     = "clawback"
 	EventTypeUpdateVestingFunder          = "update_vesting_funder"
+	EventTypeSetUnvestedStaking           = "set_unvested_staking"
 
 	AttributeKeyCoins       = "coins"
 	AttributeKeyStartTime   = "start_time"
@@ -16,4 +17,5 @@ const (
 	AttributeKeyFunder      = "funder"
 	AttributeKeyNewFunder   = "new_funder"
 	AttributeKeyDestination = "destination"
+	AttributeKeyEnabled     = "enabled"
 )
@@ -10,6 +10,11 @@ const (
 	// prefixGovClawbackProposalKey to be used in the KVStore to track vesting accounts that are subject
 	// to active governance clawback proposals.
 	prefixGovClawbackProposalKey
+	// prefixParamsKey to be used in the KVStore to store the module params.
+	prefixParamsKey
+	// prefixClawbackVestingAccountKey to be used in the KVStore to index the
+	// addresses of the clawback vesting accounts.
+	prefixClawbackVestingAccountKey
 )
 
 var (
@@ -18,6 +23,11 @@ var (
 	// KeyPrefixGovClawbackProposalKey is the slice of prefix bytes for storing the vesting account
 	// of governance clawback proposals.
 	KeyPrefixGovClawbackProposalKey = []byte{prefixGovClawbackProposalKey}
+	// ParamsKey is the key for storing the vesting module params.
+	ParamsKey = []byte{prefixParamsKey}
+	// KeyPrefixClawbackVestingAccount is the slice of prefix bytes for indexing the
+	// addresses of the clawback vesting accounts.
+	KeyPrefixClawbackVestingAccount = []byte{prefixClawbackVestingAccountKey}
 )
 
 const (
@@ -5,6 +5,7 @@ package types
 
 import (
 	"bytes"
+	"strings"
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
@@ -20,14 +21,19 @@ var (
 	_ sdk.Msg = &MsgClawback{}
 	_ sdk.Msg = &MsgConvertVestingAccount{}
 	_ sdk.Msg = &MsgUpdateVestingFunder{}
+	_ sdk.Msg = &MsgFundVestingAccountWithTemplate{}
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgSetUnvestedStaking{}
 )
 
 const (
-	TypeMsgCreateClawbackVestingAccount = "create_clawback_vesting_account"
-	TypeMsgFundVestingAccount           = "fund_vesting_account"
-	TypeMsgClawback                     = "clawback"
-	TypeMsgUpdateVestingFunder          = "update_vesting_funder"
-	TypeMsgConvertVestingAccount        = "convert_vesting_account"
+	TypeMsgCreateClawbackVestingAccount   = "create_clawback_vesting_account"
+	TypeMsgFundVestingAccount             = "fund_vesting_account"
+	TypeMsgClawback                       = "clawback"
+	TypeMsgUpdateVestingFunder            = "update_vesting_funder"
+	TypeMsgConvertVestingAccount          = "convert_vesting_account"
+	TypeMsgFundVestingAccountWithTemplate = "fund_vesting_account_with_template"
+	TypeMsgSetUnvestedStaking             = "set_unvested_staking"
 )
 
 // NewMsgCreateClawbackVestingAccount creates new instance of MsgCreateClawbackVestingAccount
@@ -273,3 +279,110 @@ func (msg MsgConvertVestingAccount) ValidateBasic() error {
 func (msg *MsgConvertVestingAccount) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
 }
+
+// NewMsgFundVestingAccountWithTemplate creates new instance of MsgFundVestingAccountWithTemplate
+func NewMsgFundVestingAccountWithTemplate(
+	funder sdk.AccAddress,
+	vestingAddr sdk.AccAddress,
+	startTime time.Time,
+	template string,
+	amount sdk.Coins,
+) *MsgFundVestingAccountWithTemplate {
+	return &MsgFundVestingAccountWithTemplate{
+		FunderAddress:  funder.String(),
+		VestingAddress: vestingAddr.String(),
+		StartTime:      startTime,
+		Template:       template,
+		Amount:         amount,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgFundVestingAccountWithTemplate) Route() string { return RouterKey }
+
+// Type returns the action
+func (msg MsgFundVestingAccountWithTemplate) Type() string {
+	return TypeMsgFundVestingAccountWithTemplate
+}
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgFundVestingAccountWithTemplate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FunderAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid funder address")
+	}
+
+	vestingAddr, err := sdk.AccAddressFromBech32(msg.VestingAddress)
+	if err != nil {
+		return errorsmod.Wrapf(err, "invalid vesting address")
+	}
+
+	if equal := bytes.Compare(vestingAddr.Bytes(), common.Address{}.Bytes()); equal == 0 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "vesting address cannot be the zero address")
+	}
+
+	if strings.TrimSpace(msg.Template) == "" {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "vesting template cannot be blank")
+	}
+
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return errortypes.ErrInvalidCoins.Wrap(msg.Amount.String())
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgFundVestingAccountWithTemplate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// NewMsgSetUnvestedStaking creates new instance of MsgSetUnvestedStaking
+func NewMsgSetUnvestedStaking(
+	funder sdk.AccAddress,
+	vestingAddr sdk.AccAddress,
+	enabled bool,
+) *MsgSetUnvestedStaking {
+	return &MsgSetUnvestedStaking{
+		FunderAddress:  funder.String(),
+		VestingAddress: vestingAddr.String(),
+		Enabled:        enabled,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgSetUnvestedStaking) Route() string { return RouterKey }
+
+// Type returns the action
+func (msg MsgSetUnvestedStaking) Type() string { return TypeMsgSetUnvestedStaking }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetUnvestedStaking) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FunderAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid funder address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.VestingAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid vesting address")
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgSetUnvestedStaking) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	return m.Params.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
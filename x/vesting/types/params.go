@@ -0,0 +1,158 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/AizelNetwork/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+const (
+	// month is the average duration of a month in seconds (365.25 days / 12)
+	month = int64(2629800)
+	// year is the average duration of a year in seconds (365.25 days)
+	year = 12 * month
+)
+
+// DefaultTemplates returns the default vesting schedule templates
+func DefaultTemplates() []VestingTemplate {
+	return []VestingTemplate{
+		NewVestingTemplate("four-year-one-year-cliff", year, month, 48, 0),
+		NewVestingTemplate("one-year-monthly", 0, month, 12, 0),
+	}
+}
+
+// NewParams creates a new Params instance
+func NewParams(templates ...VestingTemplate) Params {
+	return Params{
+		Templates: templates,
+	}
+}
+
+// DefaultParams returns the default vesting module parameters
+func DefaultParams() Params {
+	return NewParams(DefaultTemplates()...)
+}
+
+// Validate performs a stateless validation of the params
+func (p Params) Validate() error {
+	seenNames := make(map[string]bool, len(p.Templates))
+	for _, template := range p.Templates {
+		if seenNames[template.Name] {
+			return fmt.Errorf("duplicate vesting template %q", template.Name)
+		}
+
+		if err := template.Validate(); err != nil {
+			return err
+		}
+
+		seenNames[template.Name] = true
+	}
+
+	return nil
+}
+
+// GetTemplate returns the vesting template with the given name
+func (p Params) GetTemplate(name string) (VestingTemplate, bool) {
+	for _, template := range p.Templates {
+		if template.Name == name {
+			return template, true
+		}
+	}
+
+	return VestingTemplate{}, false
+}
+
+// NewVestingTemplate creates a new VestingTemplate instance
+func NewVestingTemplate(name string, cliff, periodLength int64, numPeriods uint32, lockup int64) VestingTemplate {
+	return VestingTemplate{
+		Name:         name,
+		Cliff:        cliff,
+		PeriodLength: periodLength,
+		NumPeriods:   numPeriods,
+		Lockup:       lockup,
+	}
+}
+
+// Validate performs a stateless validation of the vesting template
+func (t VestingTemplate) Validate() error {
+	if strings.TrimSpace(t.Name) == "" {
+		return fmt.Errorf("vesting template name cannot be blank")
+	}
+
+	if t.PeriodLength <= 0 {
+		return fmt.Errorf("period length of vesting template %q must be positive: %d", t.Name, t.PeriodLength)
+	}
+
+	if t.NumPeriods == 0 {
+		return fmt.Errorf("vesting template %q must have at least one period", t.Name)
+	}
+
+	if t.PeriodLength > math.MaxInt64/int64(t.NumPeriods) {
+		return fmt.Errorf("duration of vesting template %q overflows: %d periods of %d seconds", t.Name, t.NumPeriods, t.PeriodLength)
+	}
+
+	if t.Cliff < 0 {
+		return fmt.Errorf("cliff of vesting template %q cannot be negative: %d", t.Name, t.Cliff)
+	}
+
+	if t.Cliff > t.Duration() {
+		return fmt.Errorf("cliff of vesting template %q cannot exceed its duration: %d > %d", t.Name, t.Cliff, t.Duration())
+	}
+
+	if t.Lockup < 0 {
+		return fmt.Errorf("lockup of vesting template %q cannot be negative: %d", t.Name, t.Lockup)
+	}
+
+	return nil
+}
+
+// Duration returns the total vesting duration of the template in seconds.
+//
+// NOTE: the duration of a valid template never overflows.
+func (t VestingTemplate) Duration() int64 {
+	return t.PeriodLength * int64(t.NumPeriods)
+}
+
+// Schedule returns the lockup and vesting periods that distribute the given
+// amount according to the template. The amount is split evenly across the
+// vesting periods, with the remainder vesting in the last period. The lockup
+// periods are empty when the template has no lockup, which defaults to an
+// instant unlock schedule when funding the account.
+func (t VestingTemplate) Schedule(amount sdk.Coins) (lockupPeriods, vestingPeriods sdkvesting.Periods) {
+	numPeriods := int64(t.NumPeriods)
+
+	elapsed := int64(0)
+	pending := sdk.NewCoins()
+	for i := int64(1); i <= numPeriods; i++ {
+		for _, coin := range amount {
+			periodAmount := coin.Amount.QuoRaw(numPeriods)
+			if i == numPeriods {
+				periodAmount = coin.Amount.Sub(periodAmount.MulRaw(numPeriods - 1))
+			}
+			pending = pending.Add(sdk.NewCoin(coin.Denom, periodAmount))
+		}
+
+		end := i * t.PeriodLength
+		// the amount vesting before the cliff or the amount of periods
+		// too small to be split is added to the next period
+		if end < t.Cliff || pending.IsZero() {
+			continue
+		}
+
+		vestingPeriods = append(vestingPeriods, sdkvesting.Period{Length: end - elapsed, Amount: pending})
+		elapsed = end
+		pending = sdk.NewCoins()
+	}
+
+	if t.Lockup > 0 {
+		lockupPeriods = sdkvesting.Periods{{Length: t.Lockup, Amount: amount}}
+	}
+
+	return lockupPeriods, vestingPeriods
+}
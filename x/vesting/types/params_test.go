@@ -0,0 +1,164 @@
+package types
+
+import (
+	"math"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type ParamsTestSuite struct {
+	suite.Suite
+}
+
+func TestParamsTestSuite(t *testing.T) {
+	suite.Run(t, new(ParamsTestSuite))
+}
+
+func (suite *ParamsTestSuite) TestParamsValidate() {
+	testCases := []struct {
+		name     string
+		params   Params
+		expError bool
+	}{
+		{"default", DefaultParams(), false},
+		{"empty", NewParams(), false},
+		{
+			"valid - cliff equal to duration",
+			NewParams(NewVestingTemplate("cliff-only", 100, 10, 10, 0)),
+			false,
+		},
+		{
+			"invalid - duplicate template",
+			NewParams(
+				NewVestingTemplate("monthly", 0, month, 12, 0),
+				NewVestingTemplate("monthly", 0, month, 24, 0),
+			),
+			true,
+		},
+		{
+			"invalid - blank name",
+			NewParams(NewVestingTemplate(" ", 0, month, 12, 0)),
+			true,
+		},
+		{
+			"invalid - zero period length",
+			NewParams(NewVestingTemplate("monthly", 0, 0, 12, 0)),
+			true,
+		},
+		{
+			"invalid - zero periods",
+			NewParams(NewVestingTemplate("monthly", 0, month, 0, 0)),
+			true,
+		},
+		{
+			"invalid - duration overflow",
+			NewParams(NewVestingTemplate("monthly", 0, math.MaxInt64/12+1, 12, 0)),
+			true,
+		},
+		{
+			"invalid - negative cliff",
+			NewParams(NewVestingTemplate("monthly", -1, month, 12, 0)),
+			true,
+		},
+		{
+			"invalid - cliff exceeds duration",
+			NewParams(NewVestingTemplate("monthly", 12*month+1, month, 12, 0)),
+			true,
+		},
+		{
+			"invalid - negative lockup",
+			NewParams(NewVestingTemplate("monthly", 0, month, 12, -1)),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.params.Validate()
+
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+		} else {
+			suite.Require().NoError(err, tc.name)
+		}
+	}
+}
+
+func (suite *ParamsTestSuite) TestGetTemplate() {
+	params := DefaultParams()
+
+	template, found := params.GetTemplate("one-year-monthly")
+	suite.Require().True(found)
+	suite.Require().Equal(uint32(12), template.NumPeriods)
+
+	_, found = params.GetTemplate("unknown")
+	suite.Require().False(found)
+}
+
+func (suite *ParamsTestSuite) TestTemplateSchedule() {
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+
+	testCases := []struct {
+		name       string
+		template   VestingTemplate
+		amount     sdk.Coins
+		expLockup  sdkvesting.Periods
+		expVesting sdkvesting.Periods
+	}{
+		{
+			"even split without cliff",
+			NewVestingTemplate("t", 0, 10, 4, 0),
+			coins(100),
+			nil,
+			sdkvesting.Periods{period(10, 25), period(10, 25), period(10, 25), period(10, 25)},
+		},
+		{
+			"remainder vests in the last period",
+			NewVestingTemplate("t", 0, 10, 3, 0),
+			coins(100),
+			nil,
+			sdkvesting.Periods{period(10, 33), period(10, 33), period(10, 34)},
+		},
+		{
+			"periods before the cliff vest at the cliff",
+			NewVestingTemplate("t", 20, 10, 4, 0),
+			coins(100),
+			nil,
+			sdkvesting.Periods{period(20, 50), period(10, 25), period(10, 25)},
+		},
+		{
+			"unaligned cliff vests with the next period",
+			NewVestingTemplate("t", 15, 10, 4, 0),
+			coins(100),
+			nil,
+			sdkvesting.Periods{period(20, 50), period(10, 25), period(10, 25)},
+		},
+		{
+			"amount smaller than the number of periods",
+			NewVestingTemplate("t", 0, 10, 4, 0),
+			coins(2),
+			nil,
+			sdkvesting.Periods{period(40, 2)},
+		},
+		{
+			"with lockup",
+			NewVestingTemplate("t", 0, 10, 2, 100),
+			coins(100),
+			sdkvesting.Periods{period(100, 100)},
+			sdkvesting.Periods{period(10, 50), period(10, 50)},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			lockup, vesting := tc.template.Schedule(tc.amount)
+			suite.Require().Equal(tc.expLockup, lockup)
+			suite.Require().Equal(tc.expVesting, vesting)
+			suite.Require().Equal(tc.amount, vesting.TotalAmount())
+		})
+	}
+}
@@ -8,23 +8,28 @@ import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -143,40 +148,467 @@ func (m *QueryBalancesResponse) GetVested() github_com_cosmos_cosmos_sdk_types.C
 	return nil
 }
 
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e31744b0ce27e85a, []int{2}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC
+// method.
+type QueryParamsResponse struct {
+	// params are the vesting module parameters
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e31744b0ce27e85a, []int{3}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// QueryUnlockScheduleRequest is the request type for the Query/UnlockSchedule
+// RPC method.
+type QueryUnlockScheduleRequest struct {
+	// address of the clawback vesting account
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryUnlockScheduleRequest) Reset()         { *m = QueryUnlockScheduleRequest{} }
+func (m *QueryUnlockScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnlockScheduleRequest) ProtoMessage()    {}
+func (*QueryUnlockScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e31744b0ce27e85a, []int{4}
+}
+func (m *QueryUnlockScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnlockScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnlockScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnlockScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnlockScheduleRequest.Merge(m, src)
+}
+func (m *QueryUnlockScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnlockScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnlockScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnlockScheduleRequest proto.InternalMessageInfo
+
+func (m *QueryUnlockScheduleRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryUnlockScheduleResponse is the response type for the
+// Query/UnlockSchedule RPC method.
+type QueryUnlockScheduleResponse struct {
+	// events are the vesting and unlocking events of the account, ordered by time
+	Events []ScheduleEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events"`
+}
+
+func (m *QueryUnlockScheduleResponse) Reset()         { *m = QueryUnlockScheduleResponse{} }
+func (m *QueryUnlockScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnlockScheduleResponse) ProtoMessage()    {}
+func (*QueryUnlockScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e31744b0ce27e85a, []int{5}
+}
+func (m *QueryUnlockScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnlockScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnlockScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnlockScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnlockScheduleResponse.Merge(m, src)
+}
+func (m *QueryUnlockScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnlockScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnlockScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnlockScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryUnlockScheduleResponse) GetEvents() []ScheduleEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+// QueryNextUnlockRequest is the request type for the Query/NextUnlock RPC
+// method.
+type QueryNextUnlockRequest struct {
+	// address of the clawback vesting account
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryNextUnlockRequest) Reset()         { *m = QueryNextUnlockRequest{} }
+func (m *QueryNextUnlockRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNextUnlockRequest) ProtoMessage()    {}
+func (*QueryNextUnlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e31744b0ce27e85a, []int{6}
+}
+func (m *QueryNextUnlockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextUnlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextUnlockRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextUnlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextUnlockRequest.Merge(m, src)
+}
+func (m *QueryNextUnlockRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextUnlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextUnlockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextUnlockRequest proto.InternalMessageInfo
+
+func (m *QueryNextUnlockRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryNextUnlockResponse is the response type for the Query/NextUnlock RPC
+// method.
+type QueryNextUnlockResponse struct {
+	// time is the next time at which tokens become spendable
+	Time time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+	// amount defines the coins that become spendable at the next unlock time
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *QueryNextUnlockResponse) Reset()         { *m = QueryNextUnlockResponse{} }
+func (m *QueryNextUnlockResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNextUnlockResponse) ProtoMessage()    {}
+func (*QueryNextUnlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e31744b0ce27e85a, []int{7}
+}
+func (m *QueryNextUnlockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextUnlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextUnlockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextUnlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextUnlockResponse.Merge(m, src)
+}
+func (m *QueryNextUnlockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextUnlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextUnlockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextUnlockResponse proto.InternalMessageInfo
+
+func (m *QueryNextUnlockResponse) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *QueryNextUnlockResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// QueryUnvestedSupplyRequest is the request type for the Query/UnvestedSupply
+// RPC method.
+type QueryUnvestedSupplyRequest struct {
+	// pagination defines an optional pagination for the request. The supply of
+	// all the accounts is the sum of the supply of every page.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryUnvestedSupplyRequest) Reset()         { *m = QueryUnvestedSupplyRequest{} }
+func (m *QueryUnvestedSupplyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnvestedSupplyRequest) ProtoMessage()    {}
+func (*QueryUnvestedSupplyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e31744b0ce27e85a, []int{8}
+}
+func (m *QueryUnvestedSupplyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnvestedSupplyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnvestedSupplyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnvestedSupplyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnvestedSupplyRequest.Merge(m, src)
+}
+func (m *QueryUnvestedSupplyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnvestedSupplyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnvestedSupplyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnvestedSupplyRequest proto.InternalMessageInfo
+
+func (m *QueryUnvestedSupplyRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryUnvestedSupplyResponse is the response type for the
+// Query/UnvestedSupply RPC method.
+type QueryUnvestedSupplyResponse struct {
+	// unvested defines the aggregate amount of unvested tokens of the page
+	Unvested github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=unvested,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unvested"`
+	// locked defines the aggregate amount of locked tokens of the page
+	Locked github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=locked,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"locked"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryUnvestedSupplyResponse) Reset()         { *m = QueryUnvestedSupplyResponse{} }
+func (m *QueryUnvestedSupplyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnvestedSupplyResponse) ProtoMessage()    {}
+func (*QueryUnvestedSupplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e31744b0ce27e85a, []int{9}
+}
+func (m *QueryUnvestedSupplyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnvestedSupplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnvestedSupplyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnvestedSupplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnvestedSupplyResponse.Merge(m, src)
+}
+func (m *QueryUnvestedSupplyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnvestedSupplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnvestedSupplyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnvestedSupplyResponse proto.InternalMessageInfo
+
+func (m *QueryUnvestedSupplyResponse) GetUnvested() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Unvested
+	}
+	return nil
+}
+
+func (m *QueryUnvestedSupplyResponse) GetLocked() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Locked
+	}
+	return nil
+}
+
+func (m *QueryUnvestedSupplyResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryBalancesRequest)(nil), "evmos.vesting.v2.QueryBalancesRequest")
 	proto.RegisterType((*QueryBalancesResponse)(nil), "evmos.vesting.v2.QueryBalancesResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "evmos.vesting.v2.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "evmos.vesting.v2.QueryParamsResponse")
+	proto.RegisterType((*QueryUnlockScheduleRequest)(nil), "evmos.vesting.v2.QueryUnlockScheduleRequest")
+	proto.RegisterType((*QueryUnlockScheduleResponse)(nil), "evmos.vesting.v2.QueryUnlockScheduleResponse")
+	proto.RegisterType((*QueryNextUnlockRequest)(nil), "evmos.vesting.v2.QueryNextUnlockRequest")
+	proto.RegisterType((*QueryNextUnlockResponse)(nil), "evmos.vesting.v2.QueryNextUnlockResponse")
+	proto.RegisterType((*QueryUnvestedSupplyRequest)(nil), "evmos.vesting.v2.QueryUnvestedSupplyRequest")
+	proto.RegisterType((*QueryUnvestedSupplyResponse)(nil), "evmos.vesting.v2.QueryUnvestedSupplyResponse")
 }
 
 func init() { proto.RegisterFile("evmos/vesting/v2/query.proto", fileDescriptor_e31744b0ce27e85a) }
 
 var fileDescriptor_e31744b0ce27e85a = []byte{
-	// 400 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x52, 0xc1, 0x6e, 0xda, 0x40,
-	0x10, 0xf5, 0x82, 0x4a, 0xe9, 0xf6, 0xd2, 0x5a, 0x54, 0x72, 0x11, 0x32, 0x08, 0x55, 0x14, 0xa1,
-	0x76, 0x17, 0x5c, 0xf5, 0x07, 0x68, 0x7f, 0xa0, 0x1c, 0x7b, 0x5b, 0xdb, 0x2b, 0x63, 0x61, 0x76,
-	0x0c, 0xbb, 0xb6, 0x8a, 0xaa, 0x5e, 0x7a, 0xeb, 0xad, 0x12, 0x3f, 0x11, 0xe5, 0x94, 0xcf, 0xe0,
-	0x88, 0x94, 0x4b, 0x72, 0x49, 0x22, 0x88, 0x94, 0xdf, 0x88, 0x6c, 0x2f, 0x28, 0x22, 0x91, 0x72,
-	0xe2, 0x62, 0x8f, 0xfd, 0x66, 0xde, 0xbc, 0x37, 0x33, 0xb8, 0xc1, 0xd3, 0x29, 0x48, 0x9a, 0x72,
-	0xa9, 0x42, 0x11, 0xd0, 0xd4, 0xa1, 0xb3, 0x84, 0xcf, 0x17, 0x24, 0x9e, 0x83, 0x02, 0xf3, 0x4d,
-	0x8e, 0x12, 0x8d, 0x92, 0xd4, 0xa9, 0xbf, 0x65, 0xd3, 0x50, 0x00, 0xcd, 0x9f, 0x45, 0x52, 0xdd,
-	0xf6, 0x40, 0x66, 0x1c, 0x2e, 0x93, 0x9c, 0xa6, 0x03, 0x97, 0x2b, 0x36, 0xa0, 0x1e, 0x84, 0x42,
-	0xe3, 0xb5, 0x00, 0x02, 0xc8, 0x43, 0x9a, 0x45, 0xfa, 0x6f, 0x23, 0x00, 0x08, 0x22, 0x4e, 0x59,
-	0x1c, 0x52, 0x26, 0x04, 0x28, 0xa6, 0x42, 0x10, 0xb2, 0x40, 0xdb, 0x7d, 0x5c, 0xfb, 0x91, 0xe9,
-	0x18, 0xb2, 0x88, 0x09, 0x8f, 0xcb, 0x11, 0x9f, 0x25, 0x5c, 0x2a, 0xd3, 0xc2, 0x2f, 0x99, 0xef,
-	0xcf, 0xb9, 0x94, 0x16, 0x6a, 0xa1, 0xee, 0xab, 0xd1, 0xee, 0xb3, 0x7d, 0x59, 0xc2, 0xef, 0x0e,
-	0x4a, 0x64, 0x0c, 0x42, 0x72, 0x73, 0x8c, 0x2b, 0x11, 0x78, 0x13, 0xee, 0x5b, 0xa8, 0x55, 0xee,
-	0xbe, 0x76, 0xde, 0x93, 0x42, 0x30, 0xc9, 0x04, 0x13, 0x2d, 0x98, 0x7c, 0x83, 0x50, 0x0c, 0xbf,
-	0xae, 0xae, 0x9a, 0xc6, 0xe9, 0x75, 0xb3, 0x1b, 0x84, 0x6a, 0x9c, 0xb8, 0xc4, 0x83, 0x29, 0xd5,
-	0xee, 0x8a, 0xd7, 0x67, 0xe9, 0x4f, 0xa8, 0x5a, 0xc4, 0x5c, 0xe6, 0x05, 0xf2, 0xe4, 0xee, 0xac,
-	0x87, 0x46, 0x9a, 0xdf, 0x8c, 0x70, 0x35, 0x11, 0xd9, 0xb0, 0xb8, 0x6f, 0x95, 0x8e, 0xd4, 0x6b,
-	0xdf, 0x21, 0xf3, 0xa5, 0x7b, 0x95, 0x8f, 0xe5, 0xab, 0xe0, 0x77, 0x96, 0x08, 0xbf, 0xc8, 0x67,
-	0x6b, 0xfe, 0x43, 0xb8, 0xba, 0x1b, 0xb0, 0xd9, 0x21, 0x87, 0xe7, 0x41, 0x9e, 0x5a, 0x5a, 0xfd,
-	0xe3, 0xb3, 0x79, 0xc5, 0xa6, 0xda, 0x9f, 0xfe, 0x9e, 0xdf, 0x2e, 0x4b, 0x1d, 0xf3, 0x03, 0x7d,
-	0x74, 0x95, 0xae, 0xce, 0xa5, 0xbf, 0xf5, 0xc2, 0xff, 0x0c, 0xbf, 0xaf, 0x36, 0x36, 0x5a, 0x6f,
-	0x6c, 0x74, 0xb3, 0xb1, 0xd1, 0xff, 0xad, 0x6d, 0xac, 0xb7, 0xb6, 0x71, 0xb1, 0xb5, 0x8d, 0x9f,
-	0xbd, 0x07, 0x36, 0x0b, 0x26, 0xcd, 0xe7, 0xf4, 0xe9, 0xaf, 0x3d, 0x6b, 0x6e, 0xd7, 0xad, 0xe4,
-	0x07, 0xf7, 0xe5, 0x3e, 0x00, 0x00, 0xff, 0xff, 0x04, 0xf4, 0x26, 0xc1, 0x09, 0x03, 0x00, 0x00,
+	// 799 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x31, 0x53, 0x13, 0x41,
+	0x14, 0xce, 0x05, 0x89, 0xb0, 0x8c, 0x8e, 0xae, 0xa8, 0xf1, 0x60, 0x12, 0x3c, 0x11, 0x02, 0x92,
+	0x5b, 0x09, 0xa3, 0x8d, 0x63, 0x61, 0x18, 0x74, 0x6c, 0x18, 0x0c, 0xda, 0xd8, 0x30, 0x9b, 0x64,
+	0x3d, 0x6e, 0xc8, 0xdd, 0x1e, 0xd9, 0xbd, 0x03, 0x74, 0x6c, 0xec, 0xec, 0x18, 0x6d, 0xac, 0xad,
+	0x1c, 0x2b, 0x7f, 0x81, 0x85, 0x15, 0x25, 0x33, 0x36, 0xda, 0x88, 0x82, 0x33, 0xfe, 0x0d, 0xe7,
+	0x76, 0xf7, 0xc2, 0x85, 0x0b, 0x86, 0x26, 0x36, 0x70, 0x77, 0xfb, 0xbe, 0xf7, 0xbe, 0xf7, 0xed,
+	0x7b, 0x5f, 0xc0, 0x28, 0x09, 0x1c, 0xca, 0x50, 0x40, 0x18, 0xb7, 0x5d, 0x0b, 0x05, 0x25, 0xb4,
+	0xee, 0x93, 0xe6, 0x96, 0xe9, 0x35, 0x29, 0xa7, 0xf0, 0x9c, 0x38, 0x35, 0xd5, 0xa9, 0x19, 0x94,
+	0xf4, 0xf3, 0xd8, 0xb1, 0x5d, 0x8a, 0xc4, 0x5f, 0x19, 0xa4, 0x4f, 0xd7, 0x28, 0x0b, 0x73, 0x54,
+	0x31, 0x23, 0x12, 0x8d, 0x82, 0xd9, 0x2a, 0xe1, 0x78, 0x16, 0x79, 0xd8, 0xb2, 0x5d, 0xcc, 0x6d,
+	0xea, 0xaa, 0xd8, 0x5c, 0x3c, 0x36, 0x8a, 0xaa, 0x51, 0xbb, 0x75, 0x9e, 0xa0, 0x13, 0xd5, 0x96,
+	0xe7, 0xc3, 0x16, 0xb5, 0xa8, 0x78, 0x44, 0xe1, 0x93, 0xfa, 0x3a, 0x6a, 0x51, 0x6a, 0x35, 0x08,
+	0xc2, 0x9e, 0x8d, 0xb0, 0xeb, 0x52, 0x2e, 0x4a, 0x32, 0x75, 0x9a, 0x57, 0xa7, 0xe2, 0xad, 0xea,
+	0x3f, 0x43, 0xdc, 0x76, 0x08, 0xe3, 0xd8, 0xf1, 0x64, 0x80, 0x71, 0x13, 0x0c, 0x3f, 0x0a, 0x69,
+	0x97, 0x71, 0x03, 0xbb, 0x35, 0xc2, 0x2a, 0x64, 0xdd, 0x27, 0x8c, 0xc3, 0x2c, 0x38, 0x8d, 0xeb,
+	0xf5, 0x26, 0x61, 0x2c, 0xab, 0x8d, 0x69, 0x85, 0xc1, 0x4a, 0xf4, 0x6a, 0x7c, 0x4f, 0x83, 0x8b,
+	0x47, 0x20, 0xcc, 0xa3, 0x2e, 0x23, 0x70, 0x15, 0x64, 0x1a, 0xb4, 0xb6, 0x46, 0xea, 0x59, 0x6d,
+	0xac, 0xaf, 0x30, 0x54, 0xba, 0x62, 0xca, 0x8e, 0xcd, 0xb0, 0x63, 0x53, 0x75, 0x6c, 0xce, 0x53,
+	0xdb, 0x2d, 0xdf, 0xda, 0xf9, 0x91, 0x4f, 0x7d, 0xdc, 0xcb, 0x17, 0x2c, 0x9b, 0xaf, 0xfa, 0x55,
+	0xb3, 0x46, 0x1d, 0xa4, 0xe4, 0x91, 0xff, 0x8a, 0xac, 0xbe, 0x86, 0xf8, 0x96, 0x47, 0x98, 0x00,
+	0xb0, 0x0f, 0x7f, 0x3e, 0x4d, 0x6b, 0x15, 0x95, 0x1f, 0x36, 0xc0, 0x80, 0xef, 0x86, 0xea, 0x90,
+	0x7a, 0x36, 0xdd, 0xa3, 0x5a, 0xad, 0x0a, 0x61, 0x5f, 0xaa, 0x56, 0x5f, 0xaf, 0xfa, 0x92, 0xf9,
+	0x8d, 0x61, 0x00, 0x85, 0xb4, 0x4b, 0xb8, 0x89, 0x9d, 0xe8, 0x2e, 0x8c, 0x0a, 0xb8, 0xd0, 0xf6,
+	0x55, 0xc9, 0x7d, 0x07, 0x64, 0x3c, 0xf1, 0x45, 0xdc, 0xd0, 0x50, 0x29, 0x6b, 0x1e, 0x9d, 0x58,
+	0x53, 0x22, 0xca, 0x83, 0x21, 0x2b, 0x55, 0x49, 0x42, 0x8c, 0xdb, 0x40, 0x17, 0x39, 0x9f, 0xb8,
+	0xa1, 0xa4, 0xcb, 0xb5, 0x55, 0x52, 0xf7, 0x1b, 0xa4, 0xfb, 0xed, 0x63, 0x30, 0xd2, 0x11, 0xa7,
+	0x38, 0x95, 0x41, 0x86, 0x04, 0xc4, 0xe5, 0x4c, 0x8d, 0x40, 0x3e, 0xc9, 0x29, 0xc2, 0x2c, 0x84,
+	0x71, 0x6d, 0xd4, 0x24, 0xd2, 0x28, 0x81, 0x4b, 0xa2, 0xc4, 0x22, 0xd9, 0xe4, 0xb2, 0x4c, 0x77,
+	0x5a, 0x5f, 0x34, 0x70, 0x39, 0x01, 0x52, 0x9c, 0xee, 0x82, 0x53, 0xe1, 0xd4, 0x2b, 0x95, 0x74,
+	0x53, 0xae, 0x84, 0x19, 0xad, 0x84, 0xf9, 0x38, 0x5a, 0x89, 0xf2, 0x99, 0x90, 0xcc, 0xf6, 0x5e,
+	0x5e, 0x93, 0x84, 0x04, 0x2c, 0xbc, 0x7d, 0xec, 0x50, 0xdf, 0xe5, 0x3d, 0x9b, 0x34, 0x95, 0xdf,
+	0xa8, 0xb7, 0xee, 0x44, 0x8e, 0xc3, 0xb2, 0xef, 0x79, 0x8d, 0xad, 0xa8, 0xf9, 0xfb, 0x00, 0x1c,
+	0x5a, 0x8a, 0x6a, 0x66, 0xa2, 0x8d, 0x8b, 0x74, 0xaf, 0x88, 0xd1, 0x12, 0xb6, 0xa2, 0xfb, 0xac,
+	0xc4, 0x90, 0xc6, 0xe7, 0x34, 0x18, 0xe9, 0x58, 0x46, 0xc9, 0x15, 0xdf, 0x2d, 0xed, 0x7f, 0xec,
+	0x96, 0xf2, 0x8c, 0x74, 0x8f, 0x3d, 0xe3, 0x41, 0x9b, 0x7e, 0x7d, 0x42, 0xbf, 0xc9, 0xae, 0xfa,
+	0x49, 0x51, 0xe2, 0x02, 0x96, 0x7e, 0xf5, 0x83, 0x7e, 0x21, 0x20, 0x7c, 0xad, 0x81, 0x81, 0xc8,
+	0x05, 0xe1, 0x44, 0x72, 0xd4, 0x3b, 0x39, 0xab, 0x3e, 0xd9, 0x35, 0x4e, 0xd6, 0x34, 0x66, 0x5e,
+	0x7d, 0xfd, 0xfd, 0x36, 0x3d, 0x01, 0xc7, 0x51, 0xe2, 0x87, 0xa1, 0xaa, 0x62, 0xd1, 0x0b, 0xb5,
+	0x00, 0x2f, 0xe1, 0x06, 0xc8, 0xc8, 0x6d, 0x87, 0xe3, 0xc7, 0x14, 0x68, 0x33, 0x15, 0xfd, 0x7a,
+	0x97, 0x28, 0x45, 0x62, 0x4c, 0x90, 0xd0, 0x61, 0x36, 0x49, 0x42, 0x3a, 0x09, 0x7c, 0xaf, 0x81,
+	0xb3, 0xed, 0x6e, 0x00, 0x67, 0x8e, 0xc9, 0xdd, 0xd1, 0x6c, 0xf4, 0xe2, 0x09, 0xa3, 0x15, 0xa3,
+	0x39, 0xc1, 0xa8, 0x08, 0x6f, 0x24, 0x19, 0xf9, 0x02, 0xb1, 0xc2, 0x14, 0x24, 0xa6, 0xce, 0x1b,
+	0x0d, 0x80, 0x43, 0x6b, 0x80, 0x85, 0x63, 0x4a, 0x26, 0x2c, 0x47, 0x9f, 0x3a, 0x41, 0xa4, 0x22,
+	0x86, 0x04, 0xb1, 0x29, 0x38, 0x99, 0x24, 0xe6, 0x92, 0x4d, 0xbe, 0x22, 0xd9, 0xc5, 0x48, 0xbd,
+	0x13, 0xca, 0xc5, 0x97, 0xf0, 0x1f, 0xca, 0x75, 0xb0, 0x04, 0xbd, 0x78, 0xc2, 0x68, 0x45, 0x70,
+	0x4a, 0x10, 0xbc, 0x06, 0xaf, 0x76, 0x52, 0x4e, 0x22, 0x56, 0x98, 0x80, 0x94, 0x1f, 0xee, 0xec,
+	0xe7, 0xb4, 0xdd, 0xfd, 0x9c, 0xf6, 0x73, 0x3f, 0xa7, 0x6d, 0x1f, 0xe4, 0x52, 0xbb, 0x07, 0xb9,
+	0xd4, 0xb7, 0x83, 0x5c, 0xea, 0x29, 0x8a, 0x6d, 0xdf, 0x3d, 0xfb, 0x39, 0x69, 0x2c, 0x12, 0xbe,
+	0x41, 0x9b, 0x6b, 0x68, 0x9e, 0x32, 0x67, 0x21, 0x70, 0xd0, 0x66, 0x2b, 0xaf, 0x58, 0xc5, 0x6a,
+	0x46, 0x18, 0xed, 0xdc, 0xdf, 0x01, 0x00, 0xd6, 0x17, 0x80, 0xf2, 0x6e, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -193,6 +625,17 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Balances retrieves the unvested, vested and locked tokens for a vesting account
 	Balances(ctx context.Context, in *QueryBalancesRequest, opts ...grpc.CallOption) (*QueryBalancesResponse, error)
+	// Params retrieves the vesting module params
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// UnlockSchedule retrieves the full vesting and unlocking timeline of a
+	// vesting account
+	UnlockSchedule(ctx context.Context, in *QueryUnlockScheduleRequest, opts ...grpc.CallOption) (*QueryUnlockScheduleResponse, error)
+	// NextUnlock retrieves the next time at which tokens of a vesting account
+	// become spendable
+	NextUnlock(ctx context.Context, in *QueryNextUnlockRequest, opts ...grpc.CallOption) (*QueryNextUnlockResponse, error)
+	// UnvestedSupply retrieves the aggregate amount of unvested and locked tokens
+	// of a page of clawback vesting accounts
+	UnvestedSupply(ctx context.Context, in *QueryUnvestedSupplyRequest, opts ...grpc.CallOption) (*QueryUnvestedSupplyResponse, error)
 }
 
 type queryClient struct {
@@ -212,10 +655,57 @@ func (c *queryClient) Balances(ctx context.Context, in *QueryBalancesRequest, op
 	return out, nil
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.vesting.v2.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnlockSchedule(ctx context.Context, in *QueryUnlockScheduleRequest, opts ...grpc.CallOption) (*QueryUnlockScheduleResponse, error) {
+	out := new(QueryUnlockScheduleResponse)
+	err := c.cc.Invoke(ctx, "/evmos.vesting.v2.Query/UnlockSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NextUnlock(ctx context.Context, in *QueryNextUnlockRequest, opts ...grpc.CallOption) (*QueryNextUnlockResponse, error) {
+	out := new(QueryNextUnlockResponse)
+	err := c.cc.Invoke(ctx, "/evmos.vesting.v2.Query/NextUnlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnvestedSupply(ctx context.Context, in *QueryUnvestedSupplyRequest, opts ...grpc.CallOption) (*QueryUnvestedSupplyResponse, error) {
+	out := new(QueryUnvestedSupplyResponse)
+	err := c.cc.Invoke(ctx, "/evmos.vesting.v2.Query/UnvestedSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Balances retrieves the unvested, vested and locked tokens for a vesting account
 	Balances(context.Context, *QueryBalancesRequest) (*QueryBalancesResponse, error)
+	// Params retrieves the vesting module params
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// UnlockSchedule retrieves the full vesting and unlocking timeline of a
+	// vesting account
+	UnlockSchedule(context.Context, *QueryUnlockScheduleRequest) (*QueryUnlockScheduleResponse, error)
+	// NextUnlock retrieves the next time at which tokens of a vesting account
+	// become spendable
+	NextUnlock(context.Context, *QueryNextUnlockRequest) (*QueryNextUnlockResponse, error)
+	// UnvestedSupply retrieves the aggregate amount of unvested and locked tokens
+	// of a page of clawback vesting accounts
+	UnvestedSupply(context.Context, *QueryUnvestedSupplyRequest) (*QueryUnvestedSupplyResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -225,6 +715,18 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Balances(ctx context.Context, req *QueryBalancesRequest) (*QueryBalancesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Balances not implemented")
 }
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) UnlockSchedule(ctx context.Context, req *QueryUnlockScheduleRequest) (*QueryUnlockScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockSchedule not implemented")
+}
+func (*UnimplementedQueryServer) NextUnlock(ctx context.Context, req *QueryNextUnlockRequest) (*QueryNextUnlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextUnlock not implemented")
+}
+func (*UnimplementedQueryServer) UnvestedSupply(ctx context.Context, req *QueryUnvestedSupplyRequest) (*QueryUnvestedSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnvestedSupply not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -248,7 +750,80 @@ func _Query_Balances_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.vesting.v2.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnlockSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnlockScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnlockSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.vesting.v2.Query/UnlockSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnlockSchedule(ctx, req.(*QueryUnlockScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NextUnlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextUnlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NextUnlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.vesting.v2.Query/NextUnlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NextUnlock(ctx, req.(*QueryNextUnlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnvestedSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnvestedSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnvestedSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.vesting.v2.Query/UnvestedSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnvestedSupply(ctx, req.(*QueryUnvestedSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "evmos.vesting.v2.Query",
 	HandlerType: (*QueryServer)(nil),
 	Methods: []grpc.MethodDesc{
@@ -256,6 +831,22 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Balances",
 			Handler:    _Query_Balances_Handler,
 		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "UnlockSchedule",
+			Handler:    _Query_UnlockSchedule_Handler,
+		},
+		{
+			MethodName: "NextUnlock",
+			Handler:    _Query_NextUnlock_Handler,
+		},
+		{
+			MethodName: "UnvestedSupply",
+			Handler:    _Query_UnvestedSupply_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/vesting/v2/query.proto",
@@ -335,85 +926,1229 @@ func (m *QueryBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i -= size
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x12
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Locked) > 0 {
+		for iNdEx := len(m.Locked) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnlockScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnlockScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnlockScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnlockScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnlockScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnlockScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextUnlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextUnlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextUnlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextUnlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextUnlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextUnlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintQuery(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnvestedSupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnvestedSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnvestedSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnvestedSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnvestedSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnvestedSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Locked) > 0 {
+		for iNdEx := len(m.Locked) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Unvested) > 0 {
+		for iNdEx := len(m.Unvested) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unvested[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryBalancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBalancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Locked) > 0 {
+		for _, e := range m.Locked {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Unvested) > 0 {
+		for _, e := range m.Unvested {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Vested) > 0 {
+		for _, e := range m.Vested {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryUnlockScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnlockScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryNextUnlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNextUnlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryUnvestedSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnvestedSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Unvested) > 0 {
+		for _, e := range m.Unvested {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Locked) > 0 {
+		for _, e := range m.Locked {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryBalancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBalancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locked = append(m.Locked, types.Coin{})
+			if err := m.Locked[len(m.Locked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unvested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unvested = append(m.Unvested, types.Coin{})
+			if err := m.Unvested[len(m.Unvested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vested = append(m.Vested, types.Coin{})
+			if err := m.Vested[len(m.Vested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnlockScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnlockScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnlockScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnlockScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnlockScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnlockScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, ScheduleEvent{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextUnlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	if len(m.Locked) > 0 {
-		for iNdEx := len(m.Locked) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Locked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextUnlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextUnlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0xa
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryBalancesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryBalancesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Locked) > 0 {
-		for _, e := range m.Locked {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryNextUnlockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	if len(m.Unvested) > 0 {
-		for _, e := range m.Unvested {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextUnlockResponse: wiretype end group for non-group")
 		}
-	}
-	if len(m.Vested) > 0 {
-		for _, e := range m.Vested {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextUnlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryBalancesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryUnvestedSupplyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -436,17 +2171,17 @@ func (m *QueryBalancesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBalancesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnvestedSupplyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnvestedSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -456,23 +2191,27 @@ func (m *QueryBalancesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -495,7 +2234,7 @@ func (m *QueryBalancesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBalancesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUnvestedSupplyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -518,15 +2257,15 @@ func (m *QueryBalancesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBalancesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnvestedSupplyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnvestedSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unvested", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -553,14 +2292,14 @@ func (m *QueryBalancesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Locked = append(m.Locked, types.Coin{})
-			if err := m.Locked[len(m.Locked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Unvested = append(m.Unvested, types.Coin{})
+			if err := m.Unvested[len(m.Unvested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unvested", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -587,14 +2326,14 @@ func (m *QueryBalancesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Unvested = append(m.Unvested, types.Coin{})
-			if err := m.Unvested[len(m.Unvested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Locked = append(m.Locked, types.Coin{})
+			if err := m.Locked[len(m.Locked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vested", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -621,8 +2360,10 @@ func (m *QueryBalancesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Vested = append(m.Vested, types.Coin{})
-			if err := m.Vested[len(m.Vested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
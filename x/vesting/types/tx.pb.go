@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
@@ -565,6 +566,327 @@ func (m *MsgConvertVestingAccountResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgConvertVestingAccountResponse proto.InternalMessageInfo
 
+// MsgFundVestingAccountWithTemplate defines a message that enables funding an
+// existing clawback vesting account using the schedule of a vesting template.
+type MsgFundVestingAccountWithTemplate struct {
+	// funder_address specifies the account that funds the vesting account
+	FunderAddress string `protobuf:"bytes,1,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// vesting_address specifies the account that receives the funds
+	VestingAddress string `protobuf:"bytes,2,opt,name=vesting_address,json=vestingAddress,proto3" json:"vesting_address,omitempty"`
+	// start_time defines the time at which the vesting period begins
+	StartTime time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// template is the name of the vesting template that defines the schedule
+	Template string `protobuf:"bytes,4,opt,name=template,proto3" json:"template,omitempty"`
+	// amount defines the coins to be vested
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgFundVestingAccountWithTemplate) Reset()         { *m = MsgFundVestingAccountWithTemplate{} }
+func (m *MsgFundVestingAccountWithTemplate) String() string { return proto.CompactTextString(m) }
+func (*MsgFundVestingAccountWithTemplate) ProtoMessage()    {}
+func (*MsgFundVestingAccountWithTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a372bb0b868e4c86, []int{10}
+}
+func (m *MsgFundVestingAccountWithTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundVestingAccountWithTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundVestingAccountWithTemplate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundVestingAccountWithTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundVestingAccountWithTemplate.Merge(m, src)
+}
+func (m *MsgFundVestingAccountWithTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundVestingAccountWithTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundVestingAccountWithTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundVestingAccountWithTemplate proto.InternalMessageInfo
+
+func (m *MsgFundVestingAccountWithTemplate) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
+	}
+	return ""
+}
+
+func (m *MsgFundVestingAccountWithTemplate) GetVestingAddress() string {
+	if m != nil {
+		return m.VestingAddress
+	}
+	return ""
+}
+
+func (m *MsgFundVestingAccountWithTemplate) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *MsgFundVestingAccountWithTemplate) GetTemplate() string {
+	if m != nil {
+		return m.Template
+	}
+	return ""
+}
+
+func (m *MsgFundVestingAccountWithTemplate) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgFundVestingAccountWithTemplateResponse defines the
+// MsgFundVestingAccountWithTemplate response type.
+type MsgFundVestingAccountWithTemplateResponse struct {
+}
+
+func (m *MsgFundVestingAccountWithTemplateResponse) Reset() {
+	*m = MsgFundVestingAccountWithTemplateResponse{}
+}
+func (m *MsgFundVestingAccountWithTemplateResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgFundVestingAccountWithTemplateResponse) ProtoMessage() {}
+func (*MsgFundVestingAccountWithTemplateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a372bb0b868e4c86, []int{11}
+}
+func (m *MsgFundVestingAccountWithTemplateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundVestingAccountWithTemplateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundVestingAccountWithTemplateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundVestingAccountWithTemplateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundVestingAccountWithTemplateResponse.Merge(m, src)
+}
+func (m *MsgFundVestingAccountWithTemplateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundVestingAccountWithTemplateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundVestingAccountWithTemplateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundVestingAccountWithTemplateResponse proto.InternalMessageInfo
+
+// MsgSetUnvestedStaking defines a message that enables or disables the
+// delegation of unvested coins of a ClawbackVestingAccount.
+type MsgSetUnvestedStaking struct {
+	// funder_address is the address which funded the account
+	FunderAddress string `protobuf:"bytes,1,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// vesting_address is the address of the ClawbackVestingAccount being updated
+	VestingAddress string `protobuf:"bytes,2,opt,name=vesting_address,json=vestingAddress,proto3" json:"vesting_address,omitempty"`
+	// enabled specifies whether the unvested coins of the account can be delegated
+	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *MsgSetUnvestedStaking) Reset()         { *m = MsgSetUnvestedStaking{} }
+func (m *MsgSetUnvestedStaking) String() string { return proto.CompactTextString(m) }
+func (*MsgSetUnvestedStaking) ProtoMessage()    {}
+func (*MsgSetUnvestedStaking) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a372bb0b868e4c86, []int{12}
+}
+func (m *MsgSetUnvestedStaking) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetUnvestedStaking) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetUnvestedStaking.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetUnvestedStaking) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetUnvestedStaking.Merge(m, src)
+}
+func (m *MsgSetUnvestedStaking) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetUnvestedStaking) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetUnvestedStaking.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetUnvestedStaking proto.InternalMessageInfo
+
+func (m *MsgSetUnvestedStaking) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
+	}
+	return ""
+}
+
+func (m *MsgSetUnvestedStaking) GetVestingAddress() string {
+	if m != nil {
+		return m.VestingAddress
+	}
+	return ""
+}
+
+func (m *MsgSetUnvestedStaking) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+// MsgSetUnvestedStakingResponse defines the MsgSetUnvestedStaking response type.
+type MsgSetUnvestedStakingResponse struct {
+}
+
+func (m *MsgSetUnvestedStakingResponse) Reset()         { *m = MsgSetUnvestedStakingResponse{} }
+func (m *MsgSetUnvestedStakingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetUnvestedStakingResponse) ProtoMessage()    {}
+func (*MsgSetUnvestedStakingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a372bb0b868e4c86, []int{13}
+}
+func (m *MsgSetUnvestedStakingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetUnvestedStakingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetUnvestedStakingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetUnvestedStakingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetUnvestedStakingResponse.Merge(m, src)
+}
+func (m *MsgSetUnvestedStakingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetUnvestedStakingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetUnvestedStakingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetUnvestedStakingResponse proto.InternalMessageInfo
+
+// MsgUpdateParams defines a Msg for updating the x/vesting module parameters.
+type MsgUpdateParams struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/vesting parameters to update.
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a372bb0b868e4c86, []int{14}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a372bb0b868e4c86, []int{15}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateClawbackVestingAccount)(nil), "evmos.vesting.v2.MsgCreateClawbackVestingAccount")
 	proto.RegisterType((*MsgCreateClawbackVestingAccountResponse)(nil), "evmos.vesting.v2.MsgCreateClawbackVestingAccountResponse")
@@ -576,70 +898,94 @@ func init() {
 	proto.RegisterType((*MsgUpdateVestingFunderResponse)(nil), "evmos.vesting.v2.MsgUpdateVestingFunderResponse")
 	proto.RegisterType((*MsgConvertVestingAccount)(nil), "evmos.vesting.v2.MsgConvertVestingAccount")
 	proto.RegisterType((*MsgConvertVestingAccountResponse)(nil), "evmos.vesting.v2.MsgConvertVestingAccountResponse")
+	proto.RegisterType((*MsgFundVestingAccountWithTemplate)(nil), "evmos.vesting.v2.MsgFundVestingAccountWithTemplate")
+	proto.RegisterType((*MsgFundVestingAccountWithTemplateResponse)(nil), "evmos.vesting.v2.MsgFundVestingAccountWithTemplateResponse")
+	proto.RegisterType((*MsgSetUnvestedStaking)(nil), "evmos.vesting.v2.MsgSetUnvestedStaking")
+	proto.RegisterType((*MsgSetUnvestedStakingResponse)(nil), "evmos.vesting.v2.MsgSetUnvestedStakingResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "evmos.vesting.v2.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "evmos.vesting.v2.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("evmos/vesting/v2/tx.proto", fileDescriptor_a372bb0b868e4c86) }
 
 var fileDescriptor_a372bb0b868e4c86 = []byte{
-	// 918 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x4d, 0x6b, 0x24, 0x45,
-	0x18, 0x9e, 0xda, 0xd9, 0x2c, 0xbb, 0x15, 0x13, 0x37, 0x1d, 0x57, 0xb3, 0x6d, 0xd2, 0x33, 0x36,
-	0x86, 0x4c, 0xc6, 0xd8, 0xb5, 0xd3, 0x1b, 0x95, 0x0d, 0x5e, 0x76, 0x22, 0xd1, 0x4b, 0x40, 0x82,
-	0x7a, 0xf0, 0x32, 0xd4, 0x74, 0x57, 0x7a, 0x9b, 0x64, 0xba, 0x9a, 0xa9, 0xea, 0x4e, 0x04, 0x05,
-	0xd9, 0x93, 0x08, 0xe2, 0x82, 0x7f, 0x40, 0x0f, 0x82, 0x28, 0x42, 0x7e, 0xc6, 0x22, 0x22, 0x0b,
-	0x5e, 0x3c, 0xb9, 0x92, 0x28, 0xf1, 0x67, 0x48, 0x7d, 0x74, 0xcd, 0x3a, 0xa9, 0x7c, 0xec, 0x41,
-	0x2f, 0xf3, 0x51, 0xef, 0x53, 0xef, 0xfb, 0xd4, 0xf3, 0xbc, 0xf5, 0x16, 0xbc, 0x49, 0xca, 0x01,
-	0x65, 0xa8, 0x24, 0x8c, 0xa7, 0x59, 0x82, 0xca, 0x10, 0xf1, 0xfd, 0x20, 0x1f, 0x52, 0x4e, 0x9d,
-	0xeb, 0x32, 0x14, 0xe8, 0x50, 0x50, 0x86, 0xee, 0x0c, 0x1e, 0xa4, 0x19, 0x45, 0xf2, 0x53, 0x81,
-	0x5c, 0x2f, 0xa2, 0x4c, 0x24, 0xe8, 0x63, 0x46, 0x50, 0xd9, 0xe9, 0x13, 0x8e, 0x3b, 0x28, 0xa2,
-	0x69, 0xa6, 0xe3, 0x2f, 0xe8, 0xf8, 0x80, 0x25, 0xa8, 0xec, 0x88, 0x2f, 0x1d, 0x78, 0x59, 0x07,
-	0x4c, 0x65, 0xbd, 0xb7, 0x2a, 0xa7, 0x50, 0xcf, 0x25, 0x34, 0xa1, 0xf2, 0x27, 0x12, 0xbf, 0xf4,
-	0xea, 0x7c, 0x42, 0x69, 0xb2, 0x4b, 0x10, 0xce, 0x53, 0x84, 0xb3, 0x8c, 0x72, 0xcc, 0x53, 0x9a,
-	0x31, 0x1d, 0x6d, 0xe8, 0xa8, 0xfc, 0xd7, 0x2f, 0xb6, 0x11, 0x4f, 0x07, 0x84, 0x71, 0x3c, 0xc8,
-	0x15, 0xc0, 0xff, 0x0b, 0xc0, 0xc6, 0x26, 0x4b, 0xd6, 0x87, 0x04, 0x73, 0xb2, 0xbe, 0x8b, 0xf7,
-	0xfa, 0x38, 0xda, 0xf9, 0x40, 0xd5, 0xbd, 0x1b, 0x45, 0xb4, 0xc8, 0xb8, 0xb3, 0x08, 0xa7, 0xb7,
-	0x8b, 0x2c, 0x26, 0xc3, 0x1e, 0x8e, 0xe3, 0x21, 0x61, 0x6c, 0x0e, 0x34, 0x41, 0xeb, 0xda, 0xd6,
-	0x94, 0x5a, 0xbd, 0xab, 0x16, 0x9d, 0x25, 0xf8, 0xac, 0x26, 0x6c, 0x70, 0x97, 0x24, 0x6e, 0x5a,
-	0x2f, 0x57, 0xc0, 0x00, 0xce, 0x92, 0x0c, 0xf7, 0x77, 0x49, 0x2f, 0xa1, 0x65, 0x2f, 0xd2, 0x45,
-	0xe7, 0xea, 0x4d, 0xd0, 0xba, 0xba, 0x35, 0xa3, 0x42, 0x6f, 0xd3, 0xb2, 0x62, 0xb3, 0xd6, 0xfd,
-	0xfb, 0xeb, 0x46, 0xed, 0xfe, 0xf1, 0x41, 0x7b, 0x3c, 0xff, 0xe7, 0xc7, 0x07, 0xed, 0x45, 0x65,
-	0xda, 0x39, 0x67, 0xf0, 0x97, 0xe1, 0xd2, 0x39, 0x90, 0x2d, 0xc2, 0x72, 0x9a, 0x31, 0xe2, 0x7f,
-	0x76, 0x19, 0xde, 0xd8, 0x64, 0xc9, 0x46, 0x91, 0xc5, 0xff, 0xb1, 0x10, 0xef, 0x40, 0xc8, 0x38,
-	0x1e, 0xf2, 0x9e, 0x70, 0x45, 0x9e, 0x7f, 0x32, 0x74, 0x03, 0x65, 0x59, 0x50, 0x59, 0x16, 0xbc,
-	0x57, 0x59, 0xd6, 0x9d, 0x7a, 0xf8, 0x7b, 0xa3, 0xf6, 0xe0, 0x71, 0x03, 0x7c, 0x77, 0x7c, 0xd0,
-	0x06, 0x5b, 0xd7, 0xe4, 0x66, 0x11, 0x76, 0xbe, 0x00, 0x70, 0x7a, 0x97, 0x46, 0x3b, 0x45, 0xde,
-	0xcb, 0xc9, 0x30, 0xa5, 0x31, 0x9b, 0xbb, 0xdc, 0xac, 0xb7, 0x26, 0x43, 0x2f, 0x50, 0xbd, 0x35,
-	0x6a, 0x5d, 0xd5, 0x5b, 0xc1, 0xbb, 0x12, 0xd6, 0xdd, 0x10, 0x29, 0xbf, 0x7f, 0xdc, 0xb8, 0x93,
-	0xa4, 0xfc, 0x5e, 0xd1, 0x0f, 0x22, 0x3a, 0x40, 0xba, 0x1b, 0xd5, 0xd7, 0xab, 0x2c, 0xde, 0x41,
-	0xfb, 0x08, 0x17, 0xfc, 0x9e, 0xe9, 0x4f, 0xfe, 0x51, 0x4e, 0x98, 0xce, 0xc0, 0x14, 0x97, 0x29,
-	0x55, 0x5d, 0xaf, 0x39, 0x5f, 0x82, 0x91, 0x06, 0x15, 0xa1, 0x89, 0xff, 0x95, 0x50, 0xa5, 0xb5,
-	0x5e, 0x5c, 0xbb, 0x2d, 0x1a, 0x68, 0xcc, 0x3e, 0xd1, 0x3f, 0x2f, 0x9a, 0xfe, 0x39, 0x69, 0xb8,
-	0xdf, 0x80, 0x0b, 0xd6, 0x80, 0xe9, 0x95, 0x1f, 0x00, 0x9c, 0x14, 0x7d, 0xa5, 0x3b, 0xea, 0x29,
-	0x3a, 0x04, 0xab, 0x4c, 0xe3, 0x1d, 0xa2, 0x97, 0x2b, 0xe0, 0x4b, 0xf0, 0x99, 0x98, 0xb0, 0x11,
-	0xaa, 0x2e, 0x51, 0x93, 0x62, 0x4d, 0x43, 0xd6, 0x96, 0x4f, 0x39, 0xd8, 0xcc, 0xe8, 0x62, 0x68,
-	0x76, 0xfe, 0x27, 0x70, 0xf6, 0x89, 0xbf, 0xd5, 0x21, 0x9c, 0x6d, 0x38, 0x21, 0xa6, 0x94, 0xe0,
-	0x2a, 0x1c, 0xba, 0x59, 0x39, 0x24, 0xe6, 0x98, 0xb1, 0x67, 0x9d, 0xa6, 0x59, 0xf7, 0x35, 0x6d,
-	0x4e, 0xeb, 0x4c, 0x73, 0x94, 0x1b, 0x62, 0x83, 0xf6, 0x42, 0xa5, 0xf7, 0x7f, 0x06, 0xf0, 0xf9,
-	0x4d, 0x96, 0xbc, 0x9f, 0xc7, 0x98, 0x13, 0x2d, 0xe8, 0x86, 0xe4, 0x7d, 0x51, 0xdd, 0x56, 0xa0,
-	0x93, 0x91, 0xbd, 0xde, 0x18, 0x54, 0x49, 0x77, 0x3d, 0x23, 0x7b, 0x1b, 0xe7, 0xdd, 0xc3, 0xba,
-	0xed, 0x1e, 0xae, 0xad, 0x9e, 0x22, 0xe1, 0xbc, 0x91, 0xd0, 0xc2, 0xd9, 0x6f, 0x42, 0xcf, 0x1e,
-	0x31, 0xdd, 0xf1, 0x31, 0x9c, 0x13, 0x7a, 0xd3, 0xac, 0x24, 0x43, 0x3e, 0x36, 0x4b, 0x2c, 0xe4,
-	0x80, 0x95, 0xdc, 0x1b, 0xa7, 0x4d, 0x3e, 0x6f, 0x64, 0xb0, 0xad, 0x82, 0xef, 0xc3, 0xe6, 0x69,
-	0xb1, 0x8a, 0x61, 0xf8, 0xcb, 0x15, 0x58, 0xdf, 0x64, 0x89, 0xf3, 0x13, 0x80, 0xf3, 0x67, 0xbe,
-	0x01, 0x9d, 0x60, 0xfc, 0x05, 0x0c, 0xce, 0x99, 0xa7, 0xee, 0x9d, 0xa7, 0xde, 0x62, 0x84, 0x7b,
-	0xf3, 0xfe, 0xaf, 0x7f, 0x7e, 0x75, 0xe9, 0x75, 0x67, 0x15, 0x59, 0x9e, 0x64, 0x14, 0xc9, 0x14,
-	0xe6, 0xe1, 0xe8, 0x19, 0x6d, 0x34, 0xd7, 0x6f, 0x00, 0x74, 0x2c, 0xd3, 0x7b, 0xc9, 0xca, 0xe7,
-	0x24, 0xd0, 0x45, 0x17, 0x04, 0x1a, 0xba, 0x1d, 0x49, 0xf7, 0x15, 0x67, 0xd9, 0x4a, 0x57, 0xf4,
-	0xd5, 0x09, 0x8e, 0x7b, 0xf0, 0xaa, 0x19, 0x1a, 0x0b, 0x76, 0xa1, 0x74, 0xd8, 0x5d, 0x3c, 0x33,
-	0x6c, 0x48, 0x2c, 0x4a, 0x12, 0x0d, 0x67, 0xc1, 0xae, 0x59, 0x55, 0xec, 0x5b, 0x00, 0x67, 0x6d,
-	0x37, 0xb0, 0x65, 0xad, 0x62, 0x41, 0xba, 0xb7, 0x2e, 0x8a, 0x34, 0xd4, 0x42, 0x49, 0x6d, 0xc5,
-	0x69, 0x5b, 0xa9, 0x15, 0x72, 0xa7, 0x51, 0x48, 0x5d, 0x43, 0xe7, 0x47, 0x00, 0x6f, 0xd8, 0x6f,
-	0x4e, 0xdb, 0xae, 0x87, 0x0d, 0xeb, 0x86, 0x17, 0xc7, 0x1a, 0xb6, 0xab, 0x92, 0x6d, 0xe0, 0xac,
-	0xd8, 0x85, 0x54, 0x7b, 0xc7, 0x0d, 0x75, 0x27, 0x3e, 0x15, 0xa3, 0xae, 0xfb, 0xd6, 0xc3, 0x43,
-	0x0f, 0x3c, 0x3a, 0xf4, 0xc0, 0x1f, 0x87, 0x1e, 0x78, 0x70, 0xe4, 0xd5, 0x1e, 0x1d, 0x79, 0xb5,
-	0xdf, 0x8e, 0xbc, 0xda, 0x87, 0xed, 0x27, 0x66, 0xa6, 0x4a, 0xac, 0xd3, 0x87, 0xb7, 0xd0, 0xfe,
-	0xbf, 0x5f, 0xb2, 0xfe, 0x15, 0xf9, 0xf8, 0xdf, 0xfe, 0x27, 0x00, 0x00, 0xff, 0xff, 0x56, 0xd4,
-	0x08, 0x47, 0x92, 0x0a, 0x00, 0x00,
+	// 1215 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xd6, 0x49, 0x48, 0x26, 0x4d, 0xda, 0x6c, 0x5a, 0xea, 0x2c, 0xc9, 0x3a, 0x59, 0x11,
+	0xe5, 0x4f, 0xd3, 0x5d, 0xe2, 0x84, 0x56, 0x71, 0x91, 0xaa, 0x38, 0x22, 0xc0, 0x21, 0xa8, 0x4a,
+	0x5a, 0x90, 0x10, 0xd2, 0x6a, 0x6d, 0x4f, 0x36, 0xab, 0x78, 0x77, 0xac, 0x9d, 0xb1, 0x9d, 0x22,
+	0x90, 0x50, 0xc5, 0x01, 0x21, 0x21, 0x2a, 0xf1, 0x05, 0xe0, 0x00, 0x42, 0x20, 0xa4, 0x1c, 0x10,
+	0x07, 0x3e, 0x41, 0x85, 0x38, 0x54, 0xf4, 0xc2, 0x89, 0xa2, 0x04, 0x14, 0x3e, 0x06, 0x9a, 0x3f,
+	0x3b, 0x4e, 0xed, 0x89, 0xed, 0x22, 0x8a, 0x7a, 0x89, 0xb3, 0xf3, 0x7e, 0xef, 0xcd, 0xef, 0xbd,
+	0xf7, 0x9b, 0x37, 0x03, 0xc6, 0x61, 0x2d, 0x44, 0xd8, 0xa9, 0x41, 0x4c, 0x82, 0xc8, 0x77, 0x6a,
+	0x59, 0x87, 0xec, 0xdb, 0x95, 0x18, 0x11, 0xa4, 0x9f, 0x67, 0x26, 0x5b, 0x98, 0xec, 0x5a, 0xd6,
+	0x18, 0xf5, 0xc2, 0x20, 0x42, 0x0e, 0xfb, 0xcb, 0x41, 0x86, 0x59, 0x44, 0x98, 0x06, 0x28, 0x78,
+	0x18, 0x3a, 0xb5, 0xa5, 0x02, 0x24, 0xde, 0x92, 0x53, 0x44, 0x41, 0x24, 0xec, 0x97, 0x84, 0x3d,
+	0xc4, 0xbe, 0x53, 0x5b, 0xa2, 0x3f, 0xc2, 0xf0, 0xa2, 0x30, 0xc8, 0x9d, 0x85, 0x6f, 0xb2, 0x1d,
+	0x47, 0x8d, 0x73, 0x94, 0xcb, 0xbe, 0x1c, 0xfe, 0x91, 0xec, 0xdc, 0xc2, 0xfc, 0x71, 0xd7, 0x0b,
+	0x3e, 0xf2, 0x11, 0xf7, 0xa3, 0xff, 0x89, 0xd5, 0x09, 0x1f, 0x21, 0xbf, 0x0c, 0x1d, 0xaf, 0x12,
+	0x38, 0x5e, 0x14, 0x21, 0xe2, 0x91, 0x00, 0x45, 0x49, 0xcc, 0x8c, 0xb0, 0xb2, 0xaf, 0x42, 0x75,
+	0xc7, 0x21, 0x41, 0x08, 0x31, 0xf1, 0xc2, 0x0a, 0x07, 0x58, 0x7f, 0x69, 0x20, 0xb3, 0x89, 0xfd,
+	0xf5, 0x18, 0x7a, 0x04, 0xae, 0x97, 0xbd, 0x7a, 0xc1, 0x2b, 0xee, 0xbd, 0xc5, 0xf7, 0x5d, 0x2b,
+	0x16, 0x51, 0x35, 0x22, 0xfa, 0x0c, 0x18, 0xd9, 0xa9, 0x46, 0x25, 0x18, 0xbb, 0x5e, 0xa9, 0x14,
+	0x43, 0x8c, 0xd3, 0xda, 0x94, 0x36, 0x37, 0xb8, 0x35, 0xcc, 0x57, 0xd7, 0xf8, 0xa2, 0x3e, 0x0b,
+	0xce, 0x09, 0xc2, 0x12, 0x77, 0x86, 0xe1, 0x46, 0xc4, 0x72, 0x02, 0xb4, 0xc1, 0x18, 0x8c, 0xbc,
+	0x42, 0x19, 0xba, 0x3e, 0xaa, 0xb9, 0x45, 0xb1, 0x69, 0x3a, 0x35, 0xa5, 0xcd, 0x0d, 0x6c, 0x8d,
+	0x72, 0xd3, 0x6b, 0xa8, 0x96, 0xb0, 0xc9, 0xe5, 0xff, 0xfe, 0x22, 0xd3, 0x73, 0xf7, 0xf8, 0x60,
+	0xa1, 0x39, 0xfe, 0x27, 0xc7, 0x07, 0x0b, 0x33, 0xbc, 0x6a, 0x1d, 0x72, 0xb0, 0xe6, 0xc1, 0x6c,
+	0x07, 0xc8, 0x16, 0xc4, 0x15, 0x14, 0x61, 0x68, 0x7d, 0xdc, 0x0b, 0x2e, 0x6e, 0x62, 0x7f, 0xa3,
+	0x1a, 0x95, 0x9e, 0x72, 0x21, 0x5e, 0x07, 0x00, 0x13, 0x2f, 0x26, 0x2e, 0xed, 0x0a, 0xcb, 0x7f,
+	0x28, 0x6b, 0xd8, 0xbc, 0x65, 0x76, 0xd2, 0x32, 0xfb, 0x56, 0xd2, 0xb2, 0xfc, 0xf0, 0xfd, 0xdf,
+	0x33, 0x3d, 0xf7, 0x1e, 0x65, 0xb4, 0x6f, 0x8e, 0x0f, 0x16, 0xb4, 0xad, 0x41, 0xe6, 0x4c, 0xcd,
+	0xfa, 0xa7, 0x1a, 0x18, 0x29, 0xa3, 0xe2, 0x5e, 0xb5, 0xe2, 0x56, 0x60, 0x1c, 0xa0, 0x12, 0x4e,
+	0xf7, 0x4e, 0xa5, 0xe6, 0x86, 0xb2, 0xa6, 0x2d, 0x34, 0x26, 0x55, 0xcf, 0x65, 0x69, 0xdf, 0x64,
+	0xb0, 0xfc, 0x06, 0x0d, 0xf9, 0xed, 0xa3, 0xcc, 0xaa, 0x1f, 0x90, 0xdd, 0x6a, 0xc1, 0x2e, 0xa2,
+	0x50, 0xa8, 0x52, 0xfc, 0x5c, 0xc1, 0xa5, 0x3d, 0x67, 0xdf, 0xf1, 0xaa, 0x64, 0x57, 0x4a, 0x93,
+	0xdc, 0xa9, 0x40, 0x2c, 0x22, 0x60, 0xce, 0x65, 0x98, 0xef, 0x2e, 0xd6, 0xf4, 0xcf, 0xb4, 0x46,
+	0x0d, 0x12, 0x42, 0x7d, 0xff, 0x2b, 0xa1, 0xa4, 0xd6, 0x62, 0x31, 0xb7, 0x4c, 0x05, 0xd4, 0xd4,
+	0x3e, 0xaa, 0x9f, 0x17, 0xa4, 0x7e, 0x5a, 0x1b, 0x6e, 0x65, 0xc0, 0xa4, 0xd2, 0x20, 0xb5, 0xf2,
+	0x9d, 0x06, 0x86, 0xa8, 0xae, 0x84, 0xa2, 0x9e, 0x40, 0x21, 0x1e, 0x8f, 0xd4, 0xac, 0x10, 0xb1,
+	0x9c, 0x00, 0xa7, 0xc1, 0xd9, 0x12, 0xc4, 0x0d, 0x54, 0x8a, 0xa1, 0x86, 0xe8, 0x9a, 0x80, 0xe4,
+	0xe6, 0x4f, 0x49, 0x6c, 0xb4, 0x71, 0x30, 0x04, 0x3b, 0xeb, 0x03, 0x30, 0x76, 0xe2, 0x33, 0x49,
+	0x42, 0xdf, 0x01, 0x7d, 0x74, 0xc0, 0x51, 0xae, 0xb4, 0x43, 0xe3, 0x49, 0x87, 0xe8, 0x08, 0x94,
+	0xed, 0x59, 0x47, 0x41, 0x94, 0x7f, 0x59, 0x34, 0x67, 0xae, 0x6d, 0x73, 0x78, 0x37, 0xa8, 0x83,
+	0xe8, 0x05, 0x0f, 0x6f, 0xfd, 0xa2, 0x81, 0xe7, 0x37, 0xb1, 0x7f, 0xbb, 0x52, 0xf2, 0x08, 0x14,
+	0x05, 0xdd, 0x60, 0xbc, 0xbb, 0xad, 0xdb, 0x22, 0xd0, 0x23, 0x58, 0x77, 0x9b, 0xa0, 0xbc, 0x74,
+	0xe7, 0x23, 0x58, 0xdf, 0xe8, 0x74, 0x0e, 0x53, 0xaa, 0x73, 0x98, 0x5b, 0x39, 0xa5, 0x84, 0x13,
+	0xb2, 0x84, 0x0a, 0xce, 0xd6, 0x14, 0x30, 0xd5, 0x16, 0xa9, 0x8e, 0xf7, 0x41, 0x9a, 0xd6, 0x1b,
+	0x45, 0x35, 0x18, 0x93, 0xa6, 0x59, 0xa2, 0x20, 0xa7, 0x29, 0xc9, 0x5d, 0x3b, 0x6d, 0xf2, 0x99,
+	0x8d, 0x06, 0xab, 0x76, 0xb0, 0x2c, 0x30, 0x75, 0x9a, 0x4d, 0x32, 0xfc, 0x28, 0x05, 0xa6, 0x95,
+	0x0a, 0x7f, 0x3b, 0x20, 0xbb, 0xb7, 0x60, 0x58, 0x29, 0x7b, 0x04, 0x3e, 0xc3, 0x73, 0xcf, 0x00,
+	0x03, 0x44, 0xb0, 0x4c, 0xf7, 0xb2, 0xbd, 0xe4, 0xb7, 0xbe, 0x0b, 0xfa, 0xbd, 0x90, 0xe6, 0x92,
+	0xee, 0x7b, 0x4a, 0xba, 0x16, 0xf1, 0x73, 0x37, 0x4e, 0xd1, 0xcf, 0x6c, 0x9b, 0xd9, 0x72, 0xb2,
+	0xc0, 0xd6, 0x65, 0x30, 0xdf, 0x11, 0x24, 0x7b, 0xf6, 0xa3, 0xc6, 0xee, 0xa7, 0x6d, 0x48, 0x6e,
+	0x47, 0xb4, 0xb0, 0xb0, 0xb4, 0x4d, 0xbc, 0xbd, 0x20, 0xf2, 0xff, 0xf3, 0x3e, 0xa5, 0xc1, 0x73,
+	0xfc, 0x36, 0x2e, 0x89, 0xcb, 0x39, 0xf9, 0xec, 0x62, 0x9a, 0xb6, 0xd2, 0x13, 0xd3, 0xb4, 0xd5,
+	0x20, 0x33, 0xfb, 0x49, 0x03, 0xe7, 0xe4, 0x91, 0xba, 0xe9, 0xc5, 0x5e, 0x88, 0xf5, 0xab, 0x60,
+	0x90, 0x8e, 0x7a, 0x14, 0x07, 0xe4, 0x0e, 0x4f, 0x27, 0x9f, 0xfe, 0xf5, 0x87, 0x2b, 0x17, 0x44,
+	0x2f, 0x05, 0xd5, 0x6d, 0x12, 0xd3, 0x40, 0x0d, 0xa8, 0x7e, 0x1d, 0xf4, 0x57, 0x58, 0x04, 0x96,
+	0xdb, 0x50, 0x36, 0x6d, 0x37, 0xbf, 0xfe, 0x6c, 0xbe, 0x43, 0x7e, 0x90, 0x36, 0x5f, 0x34, 0x94,
+	0xbb, 0xe4, 0xb2, 0x34, 0xbd, 0x46, 0x30, 0x9a, 0x59, 0x86, 0x67, 0xb6, 0x2f, 0xef, 0x9c, 0x26,
+	0xa2, 0xd6, 0x38, 0xb8, 0xd4, 0xb4, 0x94, 0xe4, 0x95, 0xfd, 0x1a, 0x80, 0xd4, 0x26, 0xf6, 0xf5,
+	0x9f, 0x35, 0x30, 0xd1, 0xf6, 0xa5, 0xb5, 0xd4, 0x4a, 0xb2, 0xc3, 0xab, 0xc5, 0x58, 0x7d, 0x62,
+	0x17, 0x59, 0xee, 0x57, 0xee, 0x3e, 0xfc, 0xf3, 0xf3, 0x33, 0x57, 0xf5, 0x15, 0x47, 0xf1, 0x66,
+	0x76, 0x8a, 0x2c, 0x84, 0x7c, 0x9e, 0xb9, 0x52, 0x32, 0x82, 0xeb, 0x97, 0x1a, 0xd0, 0x15, 0x6f,
+	0xa4, 0x59, 0x25, 0x9f, 0x56, 0xa0, 0xe1, 0x74, 0x09, 0x94, 0x74, 0x97, 0x18, 0xdd, 0xcb, 0xfa,
+	0xbc, 0x92, 0x2e, 0xd5, 0x62, 0x0b, 0xc7, 0x3a, 0x18, 0x90, 0x57, 0xf3, 0xa4, 0xba, 0x50, 0xc2,
+	0x6c, 0xcc, 0xb4, 0x35, 0x4b, 0x12, 0x33, 0x8c, 0x44, 0x46, 0x9f, 0x54, 0xd7, 0x2c, 0xd9, 0xec,
+	0x2b, 0x0d, 0x8c, 0xa9, 0xee, 0xb9, 0x39, 0xe5, 0x2e, 0x0a, 0xa4, 0xf1, 0x52, 0xb7, 0x48, 0x49,
+	0x2d, 0xcb, 0xa8, 0x2d, 0xea, 0x0b, 0x4a, 0x6a, 0x55, 0xe6, 0x29, 0x2b, 0xc4, 0x8f, 0xae, 0xfe,
+	0xbd, 0x06, 0x2e, 0xaa, 0xef, 0xa7, 0x05, 0x75, 0x3d, 0x54, 0x58, 0x23, 0xdb, 0x3d, 0x56, 0xb2,
+	0x5d, 0x61, 0x6c, 0x6d, 0x7d, 0x51, 0x5d, 0x48, 0xee, 0xdb, 0xd2, 0xd0, 0x87, 0x1a, 0x30, 0x3b,
+	0x5c, 0x56, 0xcb, 0x5d, 0xea, 0xea, 0xa4, 0x93, 0x71, 0xfd, 0x5f, 0x38, 0xc9, 0x54, 0x6e, 0xb0,
+	0x54, 0x56, 0xf5, 0x6b, 0x5d, 0x0b, 0xd3, 0xad, 0x07, 0x64, 0xd7, 0x95, 0x37, 0x15, 0x3d, 0x4a,
+	0x8a, 0x71, 0xae, 0x3e, 0x4a, 0xad, 0x40, 0xc3, 0xe9, 0x12, 0xd8, 0xe5, 0x51, 0xc2, 0x90, 0xb8,
+	0x55, 0xe1, 0xe9, 0x62, 0x41, 0xe6, 0x5d, 0x70, 0xf6, 0xb1, 0xb9, 0x3c, 0xdd, 0x46, 0x9f, 0x1c,
+	0x62, 0xcc, 0x77, 0x84, 0x24, 0x84, 0x8c, 0xbe, 0x0f, 0xe9, 0xfc, 0xcd, 0xbf, 0x71, 0xff, 0xd0,
+	0xd4, 0x1e, 0x1c, 0x9a, 0xda, 0x1f, 0x87, 0xa6, 0x76, 0xef, 0xc8, 0xec, 0x79, 0x70, 0x64, 0xf6,
+	0xfc, 0x76, 0x64, 0xf6, 0xbc, 0xe3, 0x9c, 0xb8, 0x99, 0xd7, 0x82, 0xf7, 0x60, 0xf9, 0x4d, 0x48,
+	0xea, 0x28, 0xde, 0x73, 0xd6, 0x11, 0x0e, 0x5f, 0xad, 0x85, 0x27, 0x06, 0x33, 0xbb, 0xa6, 0x0b,
+	0xfd, 0xec, 0x1d, 0xb1, 0xfc, 0xcf, 0x00, 0xc4, 0xcf, 0x98, 0xc3, 0x10, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -666,6 +1012,15 @@ type MsgClient interface {
 	UpdateVestingFunder(ctx context.Context, in *MsgUpdateVestingFunder, opts ...grpc.CallOption) (*MsgUpdateVestingFunderResponse, error)
 	// ConvertVestingAccount converts a ClawbackVestingAccount to an Eth account
 	ConvertVestingAccount(ctx context.Context, in *MsgConvertVestingAccount, opts ...grpc.CallOption) (*MsgConvertVestingAccountResponse, error)
+	// FundVestingAccountWithTemplate funds an existing ClawbackVestingAccount with
+	// tokens according to the schedule of a vesting template.
+	FundVestingAccountWithTemplate(ctx context.Context, in *MsgFundVestingAccountWithTemplate, opts ...grpc.CallOption) (*MsgFundVestingAccountWithTemplateResponse, error)
+	// SetUnvestedStaking enables or disables the delegation of unvested coins of
+	// an existing ClawbackVestingAccount.
+	SetUnvestedStaking(ctx context.Context, in *MsgSetUnvestedStaking, opts ...grpc.CallOption) (*MsgSetUnvestedStakingResponse, error)
+	// UpdateParams defines a governance operation for updating the x/vesting module parameters.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -721,6 +1076,33 @@ func (c *msgClient) ConvertVestingAccount(ctx context.Context, in *MsgConvertVes
 	return out, nil
 }
 
+func (c *msgClient) FundVestingAccountWithTemplate(ctx context.Context, in *MsgFundVestingAccountWithTemplate, opts ...grpc.CallOption) (*MsgFundVestingAccountWithTemplateResponse, error) {
+	out := new(MsgFundVestingAccountWithTemplateResponse)
+	err := c.cc.Invoke(ctx, "/evmos.vesting.v2.Msg/FundVestingAccountWithTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetUnvestedStaking(ctx context.Context, in *MsgSetUnvestedStaking, opts ...grpc.CallOption) (*MsgSetUnvestedStakingResponse, error) {
+	out := new(MsgSetUnvestedStakingResponse)
+	err := c.cc.Invoke(ctx, "/evmos.vesting.v2.Msg/SetUnvestedStaking", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.vesting.v2.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateClawbackVestingAccount creats a vesting account that is subject to clawback.
@@ -735,6 +1117,15 @@ type MsgServer interface {
 	UpdateVestingFunder(context.Context, *MsgUpdateVestingFunder) (*MsgUpdateVestingFunderResponse, error)
 	// ConvertVestingAccount converts a ClawbackVestingAccount to an Eth account
 	ConvertVestingAccount(context.Context, *MsgConvertVestingAccount) (*MsgConvertVestingAccountResponse, error)
+	// FundVestingAccountWithTemplate funds an existing ClawbackVestingAccount with
+	// tokens according to the schedule of a vesting template.
+	FundVestingAccountWithTemplate(context.Context, *MsgFundVestingAccountWithTemplate) (*MsgFundVestingAccountWithTemplateResponse, error)
+	// SetUnvestedStaking enables or disables the delegation of unvested coins of
+	// an existing ClawbackVestingAccount.
+	SetUnvestedStaking(context.Context, *MsgSetUnvestedStaking) (*MsgSetUnvestedStakingResponse, error)
+	// UpdateParams defines a governance operation for updating the x/vesting module parameters.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -756,6 +1147,15 @@ func (*UnimplementedMsgServer) UpdateVestingFunder(ctx context.Context, req *Msg
 func (*UnimplementedMsgServer) ConvertVestingAccount(ctx context.Context, req *MsgConvertVestingAccount) (*MsgConvertVestingAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConvertVestingAccount not implemented")
 }
+func (*UnimplementedMsgServer) FundVestingAccountWithTemplate(ctx context.Context, req *MsgFundVestingAccountWithTemplate) (*MsgFundVestingAccountWithTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundVestingAccountWithTemplate not implemented")
+}
+func (*UnimplementedMsgServer) SetUnvestedStaking(ctx context.Context, req *MsgSetUnvestedStaking) (*MsgSetUnvestedStakingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUnvestedStaking not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -851,6 +1251,61 @@ func _Msg_ConvertVestingAccount_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_FundVestingAccountWithTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundVestingAccountWithTemplate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundVestingAccountWithTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.vesting.v2.Msg/FundVestingAccountWithTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundVestingAccountWithTemplate(ctx, req.(*MsgFundVestingAccountWithTemplate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetUnvestedStaking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetUnvestedStaking)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetUnvestedStaking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.vesting.v2.Msg/SetUnvestedStaking",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetUnvestedStaking(ctx, req.(*MsgSetUnvestedStaking))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.vesting.v2.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "evmos.vesting.v2.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -875,10 +1330,22 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ConvertVestingAccount",
 			Handler:    _Msg_ConvertVestingAccount_Handler,
 		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "evmos/vesting/v2/tx.proto",
-}
+		{
+			MethodName: "FundVestingAccountWithTemplate",
+			Handler:    _Msg_FundVestingAccountWithTemplate_Handler,
+		},
+		{
+			MethodName: "SetUnvestedStaking",
+			Handler:    _Msg_SetUnvestedStaking_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "evmos/vesting/v2/tx.proto",
+}
 
 func (m *MsgCreateClawbackVestingAccount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -1247,6 +1714,228 @@ func (m *MsgConvertVestingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgFundVestingAccountWithTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundVestingAccountWithTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundVestingAccountWithTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Template) > 0 {
+		i -= len(m.Template)
+		copy(dAtA[i:], m.Template)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Template)))
+		i--
+		dAtA[i] = 0x22
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.VestingAddress) > 0 {
+		i -= len(m.VestingAddress)
+		copy(dAtA[i:], m.VestingAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VestingAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundVestingAccountWithTemplateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundVestingAccountWithTemplateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundVestingAccountWithTemplateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetUnvestedStaking) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetUnvestedStaking) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetUnvestedStaking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.VestingAddress) > 0 {
+		i -= len(m.VestingAddress)
+		copy(dAtA[i:], m.VestingAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VestingAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetUnvestedStakingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetUnvestedStakingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetUnvestedStakingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1381,47 +2070,587 @@ func (m *MsgUpdateVestingFunder) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgUpdateVestingFunderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConvertVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VestingAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertVestingAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFundVestingAccountWithTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VestingAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Template)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFundVestingAccountWithTemplateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetUnvestedStaking) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VestingAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetUnvestedStakingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateClawbackVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateClawbackVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateClawbackVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableGovClawback", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableGovClawback = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateClawbackVestingAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateClawbackVestingAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateClawbackVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockupPeriods = append(m.LockupPeriods, types.Period{})
+			if err := m.LockupPeriods[len(m.LockupPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, types.Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgUpdateVestingFunderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgConvertVestingAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.VestingAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgFundVestingAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundVestingAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgConvertVestingAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateClawbackVestingAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1444,10 +2673,10 @@ func (m *MsgCreateClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateClawbackVestingAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClawback: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateClawbackVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClawback: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1484,7 +2713,7 @@ func (m *MsgCreateClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1512,13 +2741,13 @@ func (m *MsgCreateClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VestingAddress = string(dAtA[iNdEx:postIndex])
+			m.AccountAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableGovClawback", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestAddress", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1528,12 +2757,24 @@ func (m *MsgCreateClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableGovClawback = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1555,7 +2796,7 @@ func (m *MsgCreateClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateClawbackVestingAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClawbackResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1578,12 +2819,46 @@ func (m *MsgCreateClawbackVestingAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateClawbackVestingAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClawbackResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateClawbackVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClawbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types1.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1605,7 +2880,7 @@ func (m *MsgCreateClawbackVestingAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFundVestingAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateVestingFunder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1628,10 +2903,10 @@ func (m *MsgFundVestingAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundVestingAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateVestingFunder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateVestingFunder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1668,7 +2943,7 @@ func (m *MsgFundVestingAccount) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFunderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1696,13 +2971,13 @@ func (m *MsgFundVestingAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VestingAddress = string(dAtA[iNdEx:postIndex])
+			m.NewFunderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1712,64 +2987,129 @@ func (m *MsgFundVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.VestingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockupPeriods", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateVestingFunderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateVestingFunderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateVestingFunderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LockupPeriods = append(m.LockupPeriods, types.Period{})
-			if err := m.LockupPeriods[len(m.LockupPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1779,25 +3119,23 @@ func (m *MsgFundVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VestingPeriods = append(m.VestingPeriods, types.Period{})
-			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.VestingAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1820,7 +3158,7 @@ func (m *MsgFundVestingAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFundVestingAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgConvertVestingAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1843,10 +3181,10 @@ func (m *MsgFundVestingAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundVestingAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConvertVestingAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConvertVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1870,7 +3208,7 @@ func (m *MsgFundVestingAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClawback) Unmarshal(dAtA []byte) error {
+func (m *MsgFundVestingAccountWithTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1893,10 +3231,10 @@ func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClawback: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundVestingAccountWithTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClawback: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundVestingAccountWithTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1933,7 +3271,7 @@ func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1961,11 +3299,44 @@ func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccountAddress = string(dAtA[iNdEx:postIndex])
+			m.VestingAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1993,7 +3364,41 @@ func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DestAddress = string(dAtA[iNdEx:postIndex])
+			m.Template = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2016,7 +3421,7 @@ func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClawbackResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFundVestingAccountWithTemplateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2039,46 +3444,12 @@ func (m *MsgClawbackResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClawbackResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundVestingAccountWithTemplateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClawbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundVestingAccountWithTemplateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Coins = append(m.Coins, types1.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2100,7 +3471,7 @@ func (m *MsgClawbackResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateVestingFunder) Unmarshal(dAtA []byte) error {
+func (m *MsgSetUnvestedStaking) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2123,10 +3494,10 @@ func (m *MsgUpdateVestingFunder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateVestingFunder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetUnvestedStaking: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateVestingFunder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetUnvestedStaking: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2163,7 +3534,7 @@ func (m *MsgUpdateVestingFunder) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewFunderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2191,13 +3562,13 @@ func (m *MsgUpdateVestingFunder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewFunderAddress = string(dAtA[iNdEx:postIndex])
+			m.VestingAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2207,24 +3578,12 @@ func (m *MsgUpdateVestingFunder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.VestingAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2246,7 +3605,7 @@ func (m *MsgUpdateVestingFunder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateVestingFunderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetUnvestedStakingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2269,10 +3628,10 @@ func (m *MsgUpdateVestingFunderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateVestingFunderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetUnvestedStakingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateVestingFunderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetUnvestedStakingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2296,7 +3655,7 @@ func (m *MsgUpdateVestingFunderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertVestingAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2319,15 +3678,15 @@ func (m *MsgConvertVestingAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertVestingAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2355,7 +3714,40 @@ func (m *MsgConvertVestingAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VestingAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2378,7 +3770,7 @@ func (m *MsgConvertVestingAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertVestingAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2401,10 +3793,10 @@ func (m *MsgConvertVestingAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertVestingAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
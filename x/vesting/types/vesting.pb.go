@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	github_com_cosmos_cosmos_sdk_x_auth_vesting_types "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	types "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
@@ -46,6 +48,9 @@ type ClawbackVestingAccount struct {
 	LockupPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,4,rep,name=lockup_periods,json=lockupPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"lockup_periods"`
 	// vesting_periods defines the vesting schedule relative to the start_time
 	VestingPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,5,rep,name=vesting_periods,json=vestingPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"vesting_periods"`
+	// unvested_staking_enabled specifies whether the unvested coins of the account
+	// can be delegated. The delegated unvested coins remain subject to clawback.
+	UnvestedStakingEnabled bool `protobuf:"varint,6,opt,name=unvested_staking_enabled,json=unvestedStakingEnabled,proto3" json:"unvested_staking_enabled,omitempty"`
 }
 
 func (m *ClawbackVestingAccount) Reset()      { *m = ClawbackVestingAccount{} }
@@ -158,48 +163,277 @@ func (m *ClawbackProposal) GetDestinationAddress() string {
 	return ""
 }
 
+// VestingTemplate defines a named vesting schedule that can be used to fund
+// clawback vesting accounts with any amount of coins. The funded amount vests
+// in equal periods, and the amount of the periods that end before the cliff
+// vests together with the first period that ends at or after the cliff.
+type VestingTemplate struct {
+	// name is the unique identifier of the template
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// cliff is the duration in seconds from the start time before which no
+	// tokens vest
+	Cliff int64 `protobuf:"varint,2,opt,name=cliff,proto3" json:"cliff,omitempty"`
+	// period_length is the duration in seconds of each vesting period
+	PeriodLength int64 `protobuf:"varint,3,opt,name=period_length,json=periodLength,proto3" json:"period_length,omitempty"`
+	// num_periods is the number of vesting periods
+	NumPeriods uint32 `protobuf:"varint,4,opt,name=num_periods,json=numPeriods,proto3" json:"num_periods,omitempty"`
+	// lockup is the duration in seconds from the start time during which all
+	// tokens are locked. If zero, the tokens are unlocked at the start time.
+	Lockup int64 `protobuf:"varint,5,opt,name=lockup,proto3" json:"lockup,omitempty"`
+}
+
+func (m *VestingTemplate) Reset()         { *m = VestingTemplate{} }
+func (m *VestingTemplate) String() string { return proto.CompactTextString(m) }
+func (*VestingTemplate) ProtoMessage()    {}
+func (*VestingTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0001d894a8ee0c72, []int{2}
+}
+func (m *VestingTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingTemplate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VestingTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingTemplate.Merge(m, src)
+}
+func (m *VestingTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingTemplate proto.InternalMessageInfo
+
+func (m *VestingTemplate) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *VestingTemplate) GetCliff() int64 {
+	if m != nil {
+		return m.Cliff
+	}
+	return 0
+}
+
+func (m *VestingTemplate) GetPeriodLength() int64 {
+	if m != nil {
+		return m.PeriodLength
+	}
+	return 0
+}
+
+func (m *VestingTemplate) GetNumPeriods() uint32 {
+	if m != nil {
+		return m.NumPeriods
+	}
+	return 0
+}
+
+func (m *VestingTemplate) GetLockup() int64 {
+	if m != nil {
+		return m.Lockup
+	}
+	return 0
+}
+
+// Params defines the parameters for the vesting module.
+type Params struct {
+	// templates are the vesting schedule templates available to fund
+	// clawback vesting accounts
+	Templates []VestingTemplate `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0001d894a8ee0c72, []int{3}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetTemplates() []VestingTemplate {
+	if m != nil {
+		return m.Templates
+	}
+	return nil
+}
+
+// ScheduleEvent defines the coins that vest and unlock at a given time of the
+// schedule of a clawback vesting account.
+type ScheduleEvent struct {
+	// time is the time of the event
+	Time time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+	// vested defines the coins that vest at the time of the event
+	Vested github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=vested,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"vested"`
+	// unlocked defines the coins that unlock at the time of the event
+	Unlocked github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=unlocked,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unlocked"`
+	// spendable defines the coins that become spendable (vested and unlocked)
+	// at the time of the event
+	Spendable github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=spendable,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spendable"`
+}
+
+func (m *ScheduleEvent) Reset()         { *m = ScheduleEvent{} }
+func (m *ScheduleEvent) String() string { return proto.CompactTextString(m) }
+func (*ScheduleEvent) ProtoMessage()    {}
+func (*ScheduleEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0001d894a8ee0c72, []int{4}
+}
+func (m *ScheduleEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleEvent.Merge(m, src)
+}
+func (m *ScheduleEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleEvent proto.InternalMessageInfo
+
+func (m *ScheduleEvent) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *ScheduleEvent) GetVested() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Vested
+	}
+	return nil
+}
+
+func (m *ScheduleEvent) GetUnlocked() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Unlocked
+	}
+	return nil
+}
+
+func (m *ScheduleEvent) GetSpendable() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Spendable
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ClawbackVestingAccount)(nil), "evmos.vesting.v2.ClawbackVestingAccount")
 	proto.RegisterType((*ClawbackProposal)(nil), "evmos.vesting.v2.ClawbackProposal")
+	proto.RegisterType((*VestingTemplate)(nil), "evmos.vesting.v2.VestingTemplate")
+	proto.RegisterType((*Params)(nil), "evmos.vesting.v2.Params")
+	proto.RegisterType((*ScheduleEvent)(nil), "evmos.vesting.v2.ScheduleEvent")
 }
 
 func init() { proto.RegisterFile("evmos/vesting/v2/vesting.proto", fileDescriptor_0001d894a8ee0c72) }
 
 var fileDescriptor_0001d894a8ee0c72 = []byte{
-	// 522 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x53, 0x41, 0x8b, 0xd3, 0x40,
-	0x18, 0xcd, 0xd8, 0xae, 0xd2, 0x29, 0xad, 0xeb, 0x58, 0xa4, 0xf4, 0x90, 0x94, 0x45, 0xa1, 0x16,
-	0xcc, 0xb8, 0x15, 0x04, 0xf7, 0xb6, 0x51, 0xc4, 0xe3, 0x52, 0xc4, 0x83, 0x97, 0x30, 0x49, 0x66,
-	0xb3, 0xa1, 0x49, 0x26, 0x64, 0x26, 0x75, 0xfd, 0x07, 0x22, 0x88, 0x7b, 0x14, 0xbc, 0xf4, 0x28,
-	0x9e, 0xf6, 0x5f, 0xb8, 0xc7, 0x1e, 0x3d, 0xed, 0x4a, 0x7b, 0x58, 0x7f, 0x86, 0x64, 0x66, 0xb2,
-	0xa6, 0xa8, 0x57, 0x2f, 0xc9, 0xcc, 0x7b, 0x5f, 0xbe, 0x79, 0x6f, 0xbe, 0x17, 0x68, 0xd2, 0x79,
-	0xc2, 0x38, 0x9e, 0x53, 0x2e, 0xa2, 0x34, 0xc4, 0xf3, 0x49, 0xb5, 0xb4, 0xb3, 0x9c, 0x09, 0x86,
-	0xb6, 0x25, 0x6f, 0x57, 0xe0, 0x7c, 0x32, 0xb8, 0x45, 0x92, 0x28, 0x65, 0x58, 0x3e, 0x55, 0xd1,
-	0xe0, 0xae, 0xcf, 0xf8, 0x46, 0x97, 0x5d, 0x8f, 0x0a, 0xb2, 0xbb, 0xd9, 0x6a, 0xd0, 0x0b, 0x59,
-	0xc8, 0xe4, 0x12, 0x97, 0x2b, 0x8d, 0x5a, 0x21, 0x63, 0x61, 0x4c, 0xb1, 0xdc, 0x79, 0xc5, 0x21,
-	0x16, 0x51, 0x42, 0xb9, 0x20, 0x49, 0xa6, 0x0a, 0x76, 0xbe, 0x35, 0xe1, 0x9d, 0xa7, 0x31, 0x79,
-	0xe3, 0x11, 0x7f, 0xf6, 0x4a, 0x35, 0xdc, 0xf7, 0x7d, 0x56, 0xa4, 0x02, 0x79, 0xb0, 0xe7, 0x11,
-	0x4e, 0x5d, 0x7d, 0x8e, 0x4b, 0x14, 0xde, 0x07, 0x43, 0x30, 0x6a, 0x4f, 0xc6, 0xb6, 0x92, 0xf5,
-	0x5b, 0xbc, 0x92, 0x65, 0x3b, 0x84, 0xd3, 0xcd, 0x4e, 0x4e, 0x73, 0x79, 0x6e, 0x81, 0x29, 0xf2,
-	0xfe, 0x60, 0xd0, 0x3d, 0xd8, 0x3d, 0x2c, 0xd2, 0x80, 0xe6, 0x2e, 0x09, 0x82, 0x9c, 0x72, 0xde,
-	0xbf, 0x36, 0x04, 0xa3, 0xd6, 0xb4, 0xa3, 0xd0, 0x7d, 0x05, 0xa2, 0x17, 0x10, 0x72, 0x41, 0x72,
-	0xe1, 0x96, 0xf2, 0xfb, 0x0d, 0x29, 0x60, 0x60, 0x2b, 0x6f, 0x76, 0xe5, 0xcd, 0x7e, 0x59, 0x79,
-	0x73, 0x3a, 0x67, 0xe7, 0x96, 0x71, 0x72, 0x61, 0x81, 0x2f, 0x97, 0xa7, 0x63, 0x30, 0x6d, 0xc9,
-	0x8f, 0x4b, 0x1a, 0x7d, 0x00, 0xb0, 0x1b, 0x33, 0x7f, 0x56, 0x64, 0x6e, 0x46, 0xf3, 0x88, 0x05,
-	0xbc, 0xdf, 0x1c, 0x36, 0x46, 0xed, 0x89, 0xf9, 0x2f, 0x3f, 0x07, 0xb2, 0xcc, 0x79, 0x5e, 0xb6,
-	0xfc, 0x7a, 0x61, 0x3d, 0x09, 0x23, 0x71, 0x54, 0x78, 0xb6, 0xcf, 0x12, 0xac, 0x07, 0xa3, 0x5e,
-	0x0f, 0x78, 0x30, 0xc3, 0xc7, 0x98, 0x14, 0xe2, 0xe8, 0x6a, 0x54, 0xe2, 0x6d, 0x46, 0xb9, 0xee,
-	0xc0, 0x95, 0x96, 0x8e, 0x3a, 0x5d, 0x63, 0xe8, 0x23, 0x80, 0x37, 0xab, 0x0b, 0xae, 0x04, 0x6d,
-	0xfd, 0x57, 0x41, 0x5d, 0xcd, 0x69, 0x70, 0xef, 0xf1, 0xbb, 0x85, 0x65, 0x7c, 0x5a, 0x58, 0xc6,
-	0xfb, 0xcb, 0xd3, 0xf1, 0x7d, 0x15, 0xe0, 0xe3, 0x7a, 0x84, 0xff, 0x1e, 0x97, 0x9d, 0xcf, 0x00,
-	0x6e, 0x57, 0xd4, 0x41, 0xce, 0x32, 0xc6, 0x49, 0x8c, 0x7a, 0x70, 0x4b, 0x44, 0x22, 0xa6, 0x32,
-	0x34, 0xad, 0xa9, 0xda, 0xa0, 0x21, 0x6c, 0x07, 0x94, 0xfb, 0x79, 0x94, 0x89, 0x88, 0xa5, 0x7a,
-	0xe4, 0x75, 0x08, 0xf5, 0xe1, 0x8d, 0x2a, 0x10, 0x0d, 0xc9, 0x56, 0x5b, 0x84, 0xe1, 0xed, 0x40,
-	0x1e, 0x4c, 0xca, 0xc2, 0xab, 0xd8, 0x34, 0x65, 0x15, 0xaa, 0x51, 0x3a, 0x3b, 0x7b, 0xcd, 0x9f,
-	0x0b, 0xcb, 0x70, 0x9e, 0x9d, 0xad, 0x4c, 0xb0, 0x5c, 0x99, 0xe0, 0xc7, 0xca, 0x04, 0x27, 0x6b,
-	0xd3, 0x58, 0xae, 0x4d, 0xe3, 0xfb, 0xda, 0x34, 0x5e, 0x8f, 0x6b, 0xf7, 0xa7, 0xdc, 0xea, 0x9f,
-	0x76, 0xf2, 0xb0, 0xe6, 0x5b, 0x5e, 0x9c, 0x77, 0x5d, 0x66, 0xed, 0xd1, 0xaf, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x47, 0xd1, 0xdd, 0x7b, 0xd8, 0x03, 0x00, 0x00,
+	// 790 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xbf, 0x6f, 0xe3, 0x36,
+	0x14, 0x36, 0xe3, 0x1f, 0x8d, 0xe9, 0x3a, 0x49, 0xd9, 0x20, 0x50, 0x3d, 0x48, 0xae, 0xdb, 0x02,
+	0x6e, 0x80, 0x4a, 0x88, 0x8b, 0x16, 0x6d, 0x80, 0x0e, 0x71, 0x90, 0xa2, 0x2d, 0x8a, 0x22, 0x50,
+	0x82, 0x0e, 0x5d, 0x0c, 0x4a, 0xa2, 0x6d, 0xc1, 0x12, 0x29, 0x88, 0x94, 0x93, 0x76, 0xeb, 0x56,
+	0x14, 0x28, 0x9a, 0xe9, 0x70, 0x87, 0x5b, 0x32, 0x1e, 0x6e, 0xca, 0x9f, 0x91, 0x31, 0xe3, 0x4d,
+	0xc9, 0x21, 0x19, 0x72, 0x7f, 0xc6, 0x41, 0x24, 0xe5, 0x38, 0xb9, 0x1f, 0xc0, 0x0d, 0xb9, 0xc5,
+	0x26, 0xdf, 0xf7, 0xf8, 0xbe, 0xef, 0x89, 0xdf, 0x23, 0x34, 0xc9, 0x34, 0x66, 0xdc, 0x99, 0x12,
+	0x2e, 0x42, 0x3a, 0x72, 0xa6, 0xbd, 0x62, 0x69, 0x27, 0x29, 0x13, 0x0c, 0xad, 0x48, 0xdc, 0x2e,
+	0x82, 0xd3, 0x5e, 0xeb, 0x23, 0x1c, 0x87, 0x94, 0x39, 0xf2, 0x57, 0x25, 0xb5, 0x4c, 0x9f, 0xf1,
+	0xbc, 0x8a, 0x87, 0x39, 0x71, 0xa6, 0x1b, 0x1e, 0x11, 0x78, 0xc3, 0xf1, 0x59, 0x48, 0x35, 0xfe,
+	0xb9, 0xc6, 0x67, 0x2c, 0x3a, 0xe5, 0x16, 0x55, 0x6b, 0x75, 0xc4, 0x46, 0x4c, 0x2e, 0x9d, 0x7c,
+	0xa5, 0xa3, 0xd6, 0x88, 0xb1, 0x51, 0x44, 0x1c, 0xb9, 0xf3, 0xb2, 0xa1, 0x23, 0xc2, 0x98, 0x70,
+	0x81, 0xe3, 0x44, 0x25, 0x74, 0xfe, 0xae, 0xc2, 0xb5, 0xed, 0x08, 0x1f, 0x78, 0xd8, 0x9f, 0xfc,
+	0xae, 0x0a, 0x6e, 0xf9, 0x3e, 0xcb, 0xa8, 0x40, 0x1e, 0x5c, 0xcd, 0x25, 0x0d, 0x34, 0xcf, 0x00,
+	0xab, 0xb8, 0x01, 0xda, 0xa0, 0xdb, 0xe8, 0xad, 0xdb, 0x4a, 0xd6, 0x4d, 0x73, 0x4a, 0x96, 0xdd,
+	0xc7, 0x9c, 0xdc, 0xae, 0xd4, 0xaf, 0x9c, 0x9d, 0x5b, 0xc0, 0x45, 0xde, 0x2b, 0x08, 0xfa, 0x02,
+	0x2e, 0x0d, 0x33, 0x1a, 0x90, 0x74, 0x80, 0x83, 0x20, 0x25, 0x9c, 0x1b, 0x0b, 0x6d, 0xd0, 0xad,
+	0xbb, 0x4d, 0x15, 0xdd, 0x52, 0x41, 0xf4, 0x13, 0x84, 0x5c, 0xe0, 0x54, 0x0c, 0x72, 0xf9, 0x46,
+	0x59, 0x0a, 0x68, 0xd9, 0xaa, 0x37, 0xbb, 0xe8, 0xcd, 0xde, 0x2f, 0x7a, 0xeb, 0x37, 0x4f, 0xcf,
+	0xad, 0xd2, 0xd1, 0x85, 0x05, 0x9e, 0x5c, 0x9f, 0xac, 0x03, 0xb7, 0x2e, 0x0f, 0xe7, 0x30, 0xfa,
+	0x0f, 0xc0, 0xa5, 0x88, 0xf9, 0x93, 0x2c, 0x19, 0x24, 0x24, 0x0d, 0x59, 0xc0, 0x8d, 0x4a, 0xbb,
+	0xdc, 0x6d, 0xf4, 0xcc, 0x37, 0xf5, 0xb3, 0x2b, 0xd3, 0xfa, 0x3f, 0xe6, 0x25, 0x9f, 0x5e, 0x58,
+	0xdf, 0x8f, 0x42, 0x31, 0xce, 0x3c, 0xdb, 0x67, 0xb1, 0xa3, 0x2f, 0x46, 0xfd, 0x7d, 0xc5, 0x83,
+	0x89, 0x73, 0xe8, 0xe0, 0x4c, 0x8c, 0x67, 0x57, 0x25, 0xfe, 0x4c, 0x08, 0xd7, 0x15, 0xb8, 0xd2,
+	0xd2, 0x54, 0xec, 0x3a, 0x86, 0xfe, 0x07, 0x70, 0xb9, 0xf8, 0xc0, 0x85, 0xa0, 0xea, 0x7b, 0x15,
+	0xb4, 0xa4, 0xb1, 0x42, 0xd1, 0x77, 0xd0, 0xc8, 0x68, 0x1e, 0x23, 0xc1, 0x80, 0x0b, 0x3c, 0xc9,
+	0x95, 0x11, 0x8a, 0xbd, 0x88, 0x04, 0x46, 0xad, 0x0d, 0xba, 0x8b, 0xee, 0x5a, 0x81, 0xef, 0x29,
+	0x78, 0x47, 0xa1, 0x9b, 0xdf, 0xfe, 0x73, 0x6c, 0x95, 0x1e, 0x1e, 0x5b, 0xa5, 0x7f, 0xaf, 0x4f,
+	0xd6, 0xbf, 0x54, 0xa3, 0x71, 0x38, 0x3f, 0x1c, 0xaf, 0x37, 0x5a, 0xe7, 0x31, 0x80, 0x2b, 0x05,
+	0xb4, 0x9b, 0xb2, 0x84, 0x71, 0x1c, 0xa1, 0x55, 0x58, 0x15, 0xa1, 0x88, 0x88, 0xb4, 0x5b, 0xdd,
+	0x55, 0x1b, 0xd4, 0x86, 0x8d, 0x80, 0x70, 0x3f, 0x0d, 0x13, 0x11, 0x32, 0xaa, 0xcd, 0x32, 0x1f,
+	0x42, 0x06, 0xfc, 0xa0, 0xb0, 0x52, 0x59, 0xa2, 0xc5, 0x16, 0x39, 0xf0, 0xe3, 0x40, 0x12, 0xe3,
+	0x3c, 0x71, 0x66, 0xb8, 0x8a, 0xcc, 0x42, 0x73, 0x90, 0x76, 0xdd, 0x66, 0xe5, 0xc5, 0xb1, 0x55,
+	0xea, 0x3c, 0x02, 0x70, 0x59, 0x0b, 0xde, 0x27, 0x71, 0x12, 0x61, 0x41, 0x10, 0x82, 0x15, 0x8a,
+	0xe3, 0x42, 0x9b, 0x5c, 0xe7, 0x82, 0xfd, 0x28, 0x1c, 0x0e, 0xa5, 0xa8, 0xb2, 0xab, 0x36, 0xe8,
+	0x33, 0xd8, 0x54, 0xd7, 0x3a, 0x88, 0x08, 0x1d, 0x89, 0xb1, 0x14, 0x55, 0x76, 0x3f, 0x54, 0xc1,
+	0x5f, 0x65, 0x0c, 0x59, 0xb0, 0x41, 0xb3, 0x78, 0xce, 0x90, 0xa0, 0xdb, 0x74, 0x21, 0xcd, 0xe2,
+	0xe2, 0x4e, 0xd6, 0x60, 0x4d, 0xd9, 0xc6, 0xa8, 0xca, 0xe3, 0x7a, 0xd7, 0xd9, 0x87, 0xb5, 0x5d,
+	0x9c, 0xe2, 0x98, 0xa3, 0x5f, 0x60, 0x5d, 0x68, 0x75, 0xdc, 0x00, 0xd2, 0x40, 0x9f, 0xda, 0x77,
+	0x5f, 0x1f, 0xfb, 0x4e, 0x1f, 0xfd, 0x7a, 0xee, 0x21, 0x3d, 0x23, 0xb3, 0xe3, 0x9d, 0x07, 0x65,
+	0xd8, 0xdc, 0xf3, 0xc7, 0x24, 0xc8, 0x22, 0xb2, 0x33, 0x25, 0x54, 0xa0, 0x1f, 0x60, 0x45, 0x84,
+	0xba, 0xdf, 0x77, 0x9a, 0x3c, 0x79, 0x0c, 0x8d, 0x61, 0x4d, 0x19, 0xc6, 0x58, 0x90, 0xca, 0x3e,
+	0x29, 0xac, 0x9d, 0xbf, 0x08, 0x33, 0x5f, 0x6f, 0xb3, 0x90, 0xf6, 0xbf, 0xd1, 0xae, 0xee, 0xbe,
+	0xd5, 0xd5, 0xca, 0xc6, 0xf9, 0x01, 0x6d, 0x62, 0x5d, 0x1f, 0x45, 0x70, 0x31, 0xa3, 0xf9, 0xc7,
+	0x21, 0x81, 0x51, 0xbe, 0x27, 0xae, 0x19, 0x03, 0xa2, 0xb0, 0xce, 0x13, 0x42, 0x83, 0xdc, 0xfe,
+	0x46, 0xe5, 0x9e, 0xe8, 0x6e, 0x28, 0xfa, 0x3f, 0x9f, 0x5e, 0x9a, 0xe0, 0xec, 0xd2, 0x04, 0xcf,
+	0x2f, 0x4d, 0x70, 0x74, 0x65, 0x96, 0xce, 0xae, 0xcc, 0xd2, 0xb3, 0x2b, 0xb3, 0xf4, 0x87, 0x33,
+	0x57, 0x73, 0x2b, 0xfc, 0x8b, 0x44, 0xbf, 0x11, 0x71, 0xc0, 0xd2, 0x89, 0xb3, 0xcd, 0x78, 0xbc,
+	0x33, 0x8d, 0xe7, 0xe6, 0x50, 0x12, 0x78, 0x35, 0x79, 0x77, 0x5f, 0xbf, 0x1c, 0x00, 0x04, 0x91,
+	0xfe, 0x42, 0xc2, 0x06, 0x00, 0x00,
 }
 
 func (m *ClawbackVestingAccount) Marshal() (dAtA []byte, err error) {
@@ -222,6 +456,16 @@ func (m *ClawbackVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.UnvestedStakingEnabled {
+		i--
+		if m.UnvestedStakingEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.VestingPeriods) > 0 {
 		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -331,6 +575,166 @@ func (m *ClawbackProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VestingTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Lockup != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.Lockup))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.NumPeriods != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.NumPeriods))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PeriodLength != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.PeriodLength))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Cliff != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.Cliff))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Templates) > 0 {
+		for iNdEx := len(m.Templates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Templates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spendable) > 0 {
+		for iNdEx := len(m.Spendable) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spendable[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Unlocked) > 0 {
+		for iNdEx := len(m.Unlocked) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unlocked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Vested) > 0 {
+		for iNdEx := len(m.Vested) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vested[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintVesting(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintVesting(dAtA []byte, offset int, v uint64) int {
 	offset -= sovVesting(v)
 	base := offset
@@ -370,41 +774,530 @@ func (m *ClawbackVestingAccount) Size() (n int) {
 			n += 1 + l + sovVesting(uint64(l))
 		}
 	}
-	return n
-}
+	if m.UnvestedStakingEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *ClawbackProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.DestinationAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	return n
+}
+
+func (m *VestingTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	if m.Cliff != 0 {
+		n += 1 + sovVesting(uint64(m.Cliff))
+	}
+	if m.PeriodLength != 0 {
+		n += 1 + sovVesting(uint64(m.PeriodLength))
+	}
+	if m.NumPeriods != 0 {
+		n += 1 + sovVesting(uint64(m.NumPeriods))
+	}
+	if m.Lockup != 0 {
+		n += 1 + sovVesting(uint64(m.Lockup))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Templates) > 0 {
+		for _, e := range m.Templates {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ScheduleEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovVesting(uint64(l))
+	if len(m.Vested) > 0 {
+		for _, e := range m.Vested {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	if len(m.Unlocked) > 0 {
+		for _, e := range m.Unlocked {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	if len(m.Spendable) > 0 {
+		for _, e := range m.Spendable {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovVesting(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVesting(x uint64) (n int) {
+	return sovVesting(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ClawbackVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClawbackVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClawbackVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseVestingAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseVestingAccount == nil {
+				m.BaseVestingAccount = &types.BaseVestingAccount{}
+			}
+			if err := m.BaseVestingAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockupPeriods = append(m.LockupPeriods, types.Period{})
+			if err := m.LockupPeriods[len(m.LockupPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, types.Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnvestedStakingEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UnvestedStakingEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClawbackProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClawbackProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClawbackProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *ClawbackProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovVesting(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovVesting(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovVesting(uint64(l))
-	}
-	l = len(m.DestinationAddress)
-	if l > 0 {
-		n += 1 + l + sovVesting(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovVesting(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozVesting(x uint64) (n int) {
-	return sovVesting(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ClawbackVestingAccount) Unmarshal(dAtA []byte) error {
+func (m *VestingTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -427,17 +1320,17 @@ func (m *ClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClawbackVestingAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: VestingTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClawbackVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VestingTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseVestingAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVesting
@@ -447,33 +1340,29 @@ func (m *ClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthVesting
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthVesting
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BaseVestingAccount == nil {
-				m.BaseVestingAccount = &types.BaseVestingAccount{}
-			}
-			if err := m.BaseVestingAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cliff", wireType)
 			}
-			var stringLen uint64
+			m.Cliff = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVesting
@@ -483,29 +1372,16 @@ func (m *ClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Cliff |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthVesting
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthVesting
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FunderAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodLength", wireType)
 			}
-			var msglen int
+			m.PeriodLength = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVesting
@@ -515,30 +1391,35 @@ func (m *ClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PeriodLength |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthVesting
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthVesting
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPeriods", wireType)
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.NumPeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumPeriods |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockupPeriods", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lockup", wireType)
 			}
-			var msglen int
+			m.Lockup = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVesting
@@ -548,29 +1429,64 @@ func (m *ClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Lockup |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthVesting
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthVesting
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LockupPeriods = append(m.LockupPeriods, types.Period{})
-			if err := m.LockupPeriods[len(m.LockupPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
 			}
-			iNdEx = postIndex
-		case 5:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -597,8 +1513,8 @@ func (m *ClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VestingPeriods = append(m.VestingPeriods, types.Period{})
-			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Templates = append(m.Templates, VestingTemplate{})
+			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -623,7 +1539,7 @@ func (m *ClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClawbackProposal) Unmarshal(dAtA []byte) error {
+func (m *ScheduleEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -646,17 +1562,17 @@ func (m *ClawbackProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClawbackProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduleEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClawbackProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduleEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVesting
@@ -666,29 +1582,30 @@ func (m *ClawbackProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthVesting
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthVesting
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vested", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVesting
@@ -698,29 +1615,31 @@ func (m *ClawbackProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthVesting
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthVesting
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Vested = append(m.Vested, types1.Coin{})
+			if err := m.Vested[len(m.Vested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlocked", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVesting
@@ -730,29 +1649,31 @@ func (m *ClawbackProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthVesting
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthVesting
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Unlocked = append(m.Unlocked, types1.Coin{})
+			if err := m.Unlocked[len(m.Unlocked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spendable", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVesting
@@ -762,23 +1683,25 @@ func (m *ClawbackProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthVesting
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthVesting
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DestinationAddress = string(dAtA[iNdEx:postIndex])
+			m.Spendable = append(m.Spendable, types1.Coin{})
+			if err := m.Spendable[len(m.Spendable)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex